@@ -0,0 +1,323 @@
+package goshamir
+
+import (
+	"encoding/binary"
+	"errors"
+	"math/bits"
+	"sync"
+)
+
+// argon2Version is the Argon2 version implemented, 0x13 (RFC 9106).
+const argon2Version = 0x13
+
+// argon2SyncPoints is the number of slices each pass is divided into.
+const argon2SyncPoints = 4
+
+// argon2Block is a 1 KiB Argon2 memory block.
+type argon2Block [128]uint64
+
+// argon2idKey derives a key from password and salt with Argon2id (RFC
+// 9106), using memory KiB of memory, time passes and threads lanes. The
+// optional secret and data inputs are the K and X of RFC 9106. It is
+// implemented here to keep the module free of dependencies.
+func argon2idKey(password, salt, secret, data []byte, time, memory uint32, threads uint8, keyLen uint32) ([]byte, error) {
+	if time < 1 || threads < 1 || memory < 8*uint32(threads) || keyLen < 4 {
+		return nil, errors.New("invalid Argon2id parameters")
+	}
+
+	h0 := argon2InitHash(password, salt, secret, data, time, memory, uint32(threads), keyLen)
+	defer clear(h0)
+	lanes := uint32(threads)
+	memory = memory / (argon2SyncPoints * lanes) * (argon2SyncPoints * lanes)
+	b := make([]argon2Block, memory)
+	defer clear(b)
+	laneLen := memory / lanes
+	segLen := laneLen / argon2SyncPoints
+
+	seed := make([]byte, 72)
+	defer clear(seed)
+	copy(seed, h0)
+	var buf [1024]byte
+	for lane := range lanes {
+		for i := range uint32(2) {
+			binary.LittleEndian.PutUint32(seed[64:], i)
+			binary.LittleEndian.PutUint32(seed[68:], lane)
+			block := blake2bLong(seed, 1024)
+			for k := range b[lane*laneLen+i] {
+				b[lane*laneLen+i][k] = binary.LittleEndian.Uint64(block[k*8:])
+			}
+			clear(block)
+		}
+	}
+
+	for pass := range time {
+		for slice := range uint32(argon2SyncPoints) {
+			var wg sync.WaitGroup
+			for lane := range lanes {
+				wg.Add(1)
+				go func() {
+					defer wg.Done()
+					argon2Segment(b, pass, slice, lane, lanes, laneLen, segLen, time)
+				}()
+			}
+			wg.Wait()
+		}
+	}
+
+	var final argon2Block
+	for lane := range lanes {
+		for k, w := range b[lane*laneLen+laneLen-1] {
+			final[k] ^= w
+		}
+	}
+	for k, w := range final {
+		binary.LittleEndian.PutUint64(buf[k*8:], w)
+	}
+	defer clear(buf[:])
+	return blake2bLong(buf[:], int(keyLen)), nil
+}
+
+// argon2InitHash computes the 64-byte pre-hash H0 of the Argon2id inputs.
+func argon2InitHash(password, salt, secret, data []byte, time, memory, threads, keyLen uint32) []byte {
+	var in []byte
+	for _, v := range []uint32{threads, keyLen, memory, time, argon2Version, 2} {
+		in = binary.LittleEndian.AppendUint32(in, v)
+	}
+	for _, field := range [][]byte{password, salt, secret, data} {
+		in = binary.LittleEndian.AppendUint32(in, uint32(len(field)))
+		in = append(in, field...)
+	}
+	defer clear(in)
+	return blake2b(in, 64)
+}
+
+// argon2Segment fills one segment of a lane. As Argon2id requires, the
+// first half of the first pass picks reference blocks independently of the
+// password and the rest depends on the previous block.
+func argon2Segment(b []argon2Block, pass, slice, lane, lanes, laneLen, segLen, time uint32) {
+	independent := pass == 0 && slice < argon2SyncPoints/2
+	var addresses, input, zero argon2Block
+	if independent {
+		input[0] = uint64(pass)
+		input[1] = uint64(lane)
+		input[2] = uint64(slice)
+		input[3] = uint64(len(b))
+		input[4] = uint64(time)
+		input[5] = 2
+	}
+	index := uint32(0)
+	if pass == 0 && slice == 0 {
+		// The first two blocks of each lane were derived from H0.
+		index = 2
+		if independent {
+			input[6]++
+			argon2Compress(&addresses, &input, &zero, false)
+			argon2Compress(&addresses, &addresses, &zero, false)
+		}
+	}
+
+	offset := lane*laneLen + slice*segLen + index
+	for ; index < segLen; index, offset = index+1, offset+1 {
+		prev := offset - 1
+		if index == 0 && slice == 0 {
+			prev += laneLen
+		}
+		var random uint64
+		if independent {
+			if index%128 == 0 {
+				input[6]++
+				argon2Compress(&addresses, &input, &zero, false)
+				argon2Compress(&addresses, &addresses, &zero, false)
+			}
+			random = addresses[index%128]
+		} else {
+			random = b[prev][0]
+		}
+		ref := argon2RefIndex(random, pass, slice, lane, index, lanes, laneLen, segLen)
+		argon2Compress(&b[offset], &b[prev], &b[ref], pass > 0)
+	}
+}
+
+// argon2RefIndex maps the pseudo-random value of a block to the index of
+// the block it references, as in section 3.4.1.2 of RFC 9106.
+func argon2RefIndex(random uint64, pass, slice, lane, index, lanes, laneLen, segLen uint32) uint32 {
+	refLane := uint32(random>>32) % lanes
+	if pass == 0 && slice == 0 {
+		refLane = lane
+	}
+	area, start := 3*segLen, ((slice+1)%argon2SyncPoints)*segLen
+	if refLane == lane {
+		area += index
+	}
+	if pass == 0 {
+		area, start = slice*segLen, 0
+		if slice == 0 || refLane == lane {
+			area += index
+		}
+	}
+	if index == 0 || refLane == lane {
+		area--
+	}
+	x := random & 0xffffffff
+	x = x * x >> 32
+	x = x * uint64(area) >> 32
+	return refLane*laneLen + uint32((uint64(start)+uint64(area)-(x+1))%uint64(laneLen))
+}
+
+// argon2Compress sets out to G(x, y), or XORs G(x, y) into out if xor is
+// set, as later passes require.
+func argon2Compress(out, x, y *argon2Block, xor bool) {
+	var r argon2Block
+	for i := range r {
+		r[i] = x[i] ^ y[i]
+	}
+	z := r
+	for i := 0; i < 128; i += 16 {
+		argon2Permute(&z[i], &z[i+1], &z[i+2], &z[i+3], &z[i+4], &z[i+5], &z[i+6], &z[i+7],
+			&z[i+8], &z[i+9], &z[i+10], &z[i+11], &z[i+12], &z[i+13], &z[i+14], &z[i+15])
+	}
+	for i := 0; i < 16; i += 2 {
+		argon2Permute(&z[i], &z[i+1], &z[i+16], &z[i+17], &z[i+32], &z[i+33], &z[i+48], &z[i+49],
+			&z[i+64], &z[i+65], &z[i+80], &z[i+81], &z[i+96], &z[i+97], &z[i+112], &z[i+113])
+	}
+	for i := range out {
+		if xor {
+			out[i] ^= z[i] ^ r[i]
+		} else {
+			out[i] = z[i] ^ r[i]
+		}
+	}
+}
+
+// argon2Permute is the permutation P of RFC 9106, a BLAKE2b round using
+// the multiplication-hardened GB function.
+func argon2Permute(v0, v1, v2, v3, v4, v5, v6, v7, v8, v9, v10, v11, v12, v13, v14, v15 *uint64) {
+	argon2GB(v0, v4, v8, v12)
+	argon2GB(v1, v5, v9, v13)
+	argon2GB(v2, v6, v10, v14)
+	argon2GB(v3, v7, v11, v15)
+	argon2GB(v0, v5, v10, v15)
+	argon2GB(v1, v6, v11, v12)
+	argon2GB(v2, v7, v8, v13)
+	argon2GB(v3, v4, v9, v14)
+}
+
+func argon2GB(a, b, c, d *uint64) {
+	*a += *b + 2*uint64(uint32(*a))*uint64(uint32(*b))
+	*d = bits.RotateLeft64(*d^*a, -32)
+	*c += *d + 2*uint64(uint32(*c))*uint64(uint32(*d))
+	*b = bits.RotateLeft64(*b^*c, -24)
+	*a += *b + 2*uint64(uint32(*a))*uint64(uint32(*b))
+	*d = bits.RotateLeft64(*d^*a, -16)
+	*c += *d + 2*uint64(uint32(*c))*uint64(uint32(*d))
+	*b = bits.RotateLeft64(*b^*c, -63)
+}
+
+// blake2bLong is the variable-length hash H' of RFC 9106.
+func blake2bLong(in []byte, outLen int) []byte {
+	prefixed := binary.LittleEndian.AppendUint32(nil, uint32(outLen))
+	prefixed = append(prefixed, in...)
+	defer clear(prefixed)
+	if outLen <= 64 {
+		return blake2b(prefixed, outLen)
+	}
+	// Each 64-byte hash contributes its first half; the last one covers
+	// the remaining 33 to 64 bytes.
+	r := (outLen+31)/32 - 2
+	out := make([]byte, 0, outLen)
+	v := blake2b(prefixed, 64)
+	for range r - 1 {
+		out = append(out, v[:32]...)
+		v = blake2b(v, 64)
+	}
+	out = append(out, v[:32]...)
+	return append(out, blake2b(v, outLen-32*r)...)
+}
+
+var blake2bIV = [8]uint64{
+	0x6a09e667f3bcc908, 0xbb67ae8584caa73b, 0x3c6ef372fe94f82b, 0xa54ff53a5f1d36f1,
+	0x510e527fade682d1, 0x9b05688c2b3e6c1f, 0x1f83d9abfb41bd6b, 0x5be0cd19137e2179,
+}
+
+var blake2bSigma = [12][16]uint8{
+	{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15},
+	{14, 10, 4, 8, 9, 15, 13, 6, 1, 12, 0, 2, 11, 7, 5, 3},
+	{11, 8, 12, 0, 5, 2, 15, 13, 10, 14, 3, 6, 7, 1, 9, 4},
+	{7, 9, 3, 1, 13, 12, 11, 14, 2, 6, 5, 10, 4, 0, 15, 8},
+	{9, 0, 5, 7, 2, 4, 10, 15, 14, 1, 11, 12, 6, 8, 3, 13},
+	{2, 12, 6, 10, 0, 11, 8, 3, 4, 13, 7, 5, 15, 14, 1, 9},
+	{12, 5, 1, 15, 14, 13, 4, 10, 0, 7, 6, 3, 9, 2, 8, 11},
+	{13, 11, 7, 14, 12, 1, 3, 9, 5, 0, 15, 4, 8, 6, 2, 10},
+	{6, 15, 14, 9, 11, 3, 0, 8, 12, 2, 13, 7, 1, 4, 10, 5},
+	{10, 2, 8, 4, 7, 6, 1, 5, 15, 11, 9, 14, 3, 12, 13, 0},
+	{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15},
+	{14, 10, 4, 8, 9, 15, 13, 6, 1, 12, 0, 2, 11, 7, 5, 3},
+}
+
+// blake2b returns the unkeyed BLAKE2b hash (RFC 7693) of in, outLen bytes
+// long.
+func blake2b(in []byte, outLen int) []byte {
+	h := blake2bIV
+	h[0] ^= 0x01010000 ^ uint64(outLen)
+	var block [128]byte
+	var m [16]uint64
+	var counter uint64
+	for {
+		n := copy(block[:], in)
+		in = in[n:]
+		clear(block[n:])
+		counter += uint64(n)
+		for i := range m {
+			m[i] = binary.LittleEndian.Uint64(block[i*8:])
+		}
+		// The final block, possibly full, is the one with no input after it.
+		last := len(in) == 0
+		blake2bCompress(&h, &m, counter, last)
+		if last {
+			break
+		}
+	}
+	clear(block[:])
+	clear(m[:])
+	out := make([]byte, 64)
+	for i, w := range h {
+		binary.LittleEndian.PutUint64(out[i*8:], w)
+	}
+	return out[:outLen]
+}
+
+// blake2bCompress is the compression function F of RFC 7693, for inputs
+// shorter than 2^64 bytes.
+func blake2bCompress(h *[8]uint64, m *[16]uint64, counter uint64, last bool) {
+	var v [16]uint64
+	copy(v[:8], h[:])
+	copy(v[8:], blake2bIV[:])
+	v[12] ^= counter
+	if last {
+		v[14] = ^v[14]
+	}
+	for _, s := range blake2bSigma {
+		blake2bG(&v, 0, 4, 8, 12, m[s[0]], m[s[1]])
+		blake2bG(&v, 1, 5, 9, 13, m[s[2]], m[s[3]])
+		blake2bG(&v, 2, 6, 10, 14, m[s[4]], m[s[5]])
+		blake2bG(&v, 3, 7, 11, 15, m[s[6]], m[s[7]])
+		blake2bG(&v, 0, 5, 10, 15, m[s[8]], m[s[9]])
+		blake2bG(&v, 1, 6, 11, 12, m[s[10]], m[s[11]])
+		blake2bG(&v, 2, 7, 8, 13, m[s[12]], m[s[13]])
+		blake2bG(&v, 3, 4, 9, 14, m[s[14]], m[s[15]])
+	}
+	for i := range h {
+		h[i] ^= v[i] ^ v[i+8]
+	}
+}
+
+func blake2bG(v *[16]uint64, a, b, c, d int, x, y uint64) {
+	v[a] += v[b] + x
+	v[d] = bits.RotateLeft64(v[d]^v[a], -32)
+	v[c] += v[d]
+	v[b] = bits.RotateLeft64(v[b]^v[c], -24)
+	v[a] += v[b] + y
+	v[d] = bits.RotateLeft64(v[d]^v[a], -16)
+	v[c] += v[d]
+	v[b] = bits.RotateLeft64(v[b]^v[c], -63)
+}
@@ -0,0 +1,33 @@
+package goshamir
+
+import (
+	"bytes"
+	"encoding/hex"
+	"testing"
+)
+
+// --- Argon2id Tests ---
+
+func TestBlake2b_RFC7693(t *testing.T) {
+	tests := []struct{ in, want string }{
+		{"", "786a02f742015903c6c6fd852552d272912f4740e15847618a86e217f71f5419d25e1031afee585313896444934eb04b903a685b1448b755d56f701afe9be2ce"},
+		{"abc", "ba80a53f981c4d0d6a2797b69f12f6e94c212f14685ac4b74b12bb6fdbffa2d17d87c5392aab792dc252d5de4533cc9518d38aa8dbf1925ab92386edd4009923"},
+	}
+	for _, tt := range tests {
+		if got := hex.EncodeToString(blake2b([]byte(tt.in), 64)); got != tt.want {
+			t.Errorf("BLAKE2b-512(%q) = %s, expected %s", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestArgon2idKey_RFC9106(t *testing.T) {
+	// The Argon2id test vector of RFC 9106, section 5.3.
+	key, err := argon2idKey(bytes.Repeat([]byte{1}, 32), bytes.Repeat([]byte{2}, 16), bytes.Repeat([]byte{3}, 8), bytes.Repeat([]byte{4}, 12), 3, 32, 4, 32)
+	if err != nil {
+		t.Fatalf("argon2idKey failed: %v", err)
+	}
+	want := "0d640df58d78766c08c037a34a8b53c9d01ef0452d75b65eb52520e96b01e659"
+	if got := hex.EncodeToString(key); got != want {
+		t.Errorf("Argon2id = %s, expected %s", got, want)
+	}
+}
@@ -0,0 +1,152 @@
+package goshamir
+
+import (
+	"errors"
+	"fmt"
+)
+
+// AddShares returns a share of the sum of the two secrets that a and b
+// belong to. Both shares must have the same index, scheme and length, and
+// the two sets must have been split with the same threshold. Addition is
+// in the scheme's field, byte by byte: modulo 257 for SchemeV1GF257 and XOR
+// for SchemeV2GF256.
+//
+// For SchemeV1GF257, a sum can be the field element 256, which Combine
+// cannot represent as a byte and returns as 0. Use EvaluateAt with x = 0
+// to read results as field elements.
+func AddShares(a, b Share) (Share, error) {
+	return combineShareValues(a, b, fieldAdd)
+}
+
+// SubShares returns a share of the difference of the secrets of a and b,
+// with the same requirements as AddShares.
+func SubShares(a, b Share) (Share, error) {
+	return combineShareValues(a, b, fieldSub)
+}
+
+// ScaleShare returns a share of the secret of s multiplied by the public
+// constant c in the scheme's field. c must be below FieldPrime for
+// SchemeV1GF257 and below 256 for SchemeV2GF256.
+func ScaleShare(s Share, c uint16) (Share, error) {
+	scheme := schemeOf(s)
+	elems, err := shareElements(s, scheme)
+	if err != nil {
+		return Share{}, err
+	}
+	if c >= fieldSize(scheme) {
+		return Share{}, fmt.Errorf("constant %d is not a field element of scheme %s", c, scheme)
+	}
+	for i, y := range elems {
+		elems[i] = fieldMul(scheme, y, c)
+	}
+	return Share{Index: s.Index, Value: elementsValue(elems, scheme), Scheme: scheme}, nil
+}
+
+// combineShareValues applies op element-wise to two shares at one index.
+func combineShareValues(a, b Share, op func(Scheme, uint16, uint16) uint16) (Share, error) {
+	scheme, err := sharesScheme([]Share{a, b})
+	if err != nil {
+		return Share{}, err
+	}
+	if a.Index != b.Index {
+		return Share{}, fmt.Errorf("cannot combine shares with indices %d and %d", a.Index, b.Index)
+	}
+	if len(a.Value) != len(b.Value) {
+		return Share{}, errors.New("cannot combine shares of different lengths")
+	}
+	x, err := shareElements(a, scheme)
+	if err != nil {
+		return Share{}, err
+	}
+	y, err := shareElements(b, scheme)
+	if err != nil {
+		return Share{}, err
+	}
+	for i := range x {
+		x[i] = op(scheme, x[i], y[i])
+	}
+	return Share{Index: a.Index, Value: elementsValue(x, scheme), Scheme: scheme}, nil
+}
+
+// shareElements decodes the field elements of a share's value, checking
+// that the share can take part in share arithmetic.
+func shareElements(s Share, scheme Scheme) ([]uint16, error) {
+	if !scheme.Supported() {
+		return nil, unsupportedScheme(scheme)
+	}
+	if s.Index == 0 {
+		return nil, errors.New("share index must be non-zero")
+	}
+	return valueElements(s.Value, scheme)
+}
+
+// valueElements decodes field elements laid out as in Share.Value.
+func valueElements(value []byte, scheme Scheme) ([]uint16, error) {
+	if len(value) == 0 {
+		return nil, errors.New("share value cannot be empty")
+	}
+	if scheme == SchemeV2GF256 {
+		elems := make([]uint16, len(value))
+		for i, y := range value {
+			elems[i] = uint16(y)
+		}
+		return elems, nil
+	}
+	if len(value)%2 != 0 {
+		return nil, errors.New("share value length must be even")
+	}
+	elems := make([]uint16, len(value)/2)
+	for i := range elems {
+		y, _ := decodeFieldElement(value, i)
+		if y >= FieldPrime {
+			return nil, fmt.Errorf("share value out of field range [0, %d]", FieldPrime-1)
+		}
+		elems[i] = uint16(y)
+	}
+	return elems, nil
+}
+
+// elementsValue encodes field elements in the layout of Share.Value.
+func elementsValue(elems []uint16, scheme Scheme) []byte {
+	if scheme == SchemeV2GF256 {
+		value := make([]byte, len(elems))
+		for i, e := range elems {
+			value[i] = byte(e)
+		}
+		return value
+	}
+	value := make([]byte, 0, 2*len(elems))
+	for _, e := range elems {
+		value = appendFieldElement(value, uint64(e))
+	}
+	return value
+}
+
+// fieldSize returns the number of elements in the scheme's field.
+func fieldSize(scheme Scheme) uint16 {
+	if scheme == SchemeV2GF256 {
+		return 256
+	}
+	return FieldPrime
+}
+
+func fieldAdd(scheme Scheme, a, b uint16) uint16 {
+	if scheme == SchemeV2GF256 {
+		return a ^ b
+	}
+	return gfAdd(a, b)
+}
+
+func fieldSub(scheme Scheme, a, b uint16) uint16 {
+	if scheme == SchemeV2GF256 {
+		return a ^ b
+	}
+	return gfSub(a, b)
+}
+
+func fieldMul(scheme Scheme, a, b uint16) uint16 {
+	if scheme == SchemeV2GF256 {
+		return uint16(gf256Mul(byte(a), byte(b)))
+	}
+	return gfMul(a, b)
+}
@@ -0,0 +1,102 @@
+package goshamir
+
+import (
+	"testing"
+)
+
+// --- Share Arithmetic Tests ---
+
+func TestAddShares_SumsSecrets(t *testing.T) {
+	a, b := []byte{1, 2, 100, 0}, []byte{3, 40, 50, 255}
+
+	sharesA, _ := Split(a, 3, 2)
+	sharesB, _ := Split(b, 3, 2)
+	sums := make([]Share, 3)
+	for i := range sums {
+		var err error
+		if sums[i], err = AddShares(sharesA[i], sharesB[i]); err != nil {
+			t.Fatalf("AddShares failed: %v", err)
+		}
+	}
+	sum, err := EvaluateAt(sums[1:], 0)
+	if err != nil {
+		t.Fatalf("EvaluateAt failed: %v", err)
+	}
+	for i := range a {
+		y, _ := decodeFieldElement(sum, i)
+		if want := (int64(a[i]) + int64(b[i])) % FieldPrime; y != want {
+			t.Errorf("Byte %d: expected %d, got %d", i, want, y)
+		}
+	}
+
+	splitter, _ := NewSplitter(3, 2, WithScheme(SchemeV2GF256))
+	compactA, _ := splitter.Split(a)
+	compactB, _ := splitter.Split(b)
+	s0, _ := AddShares(compactA[0], compactB[0])
+	s2, _ := AddShares(compactA[2], compactB[2])
+	xor, err := Combine([]Share{s0, s2}, 2)
+	if err != nil {
+		t.Fatalf("Combine failed: %v", err)
+	}
+	for i := range a {
+		if xor[i] != a[i]^b[i] {
+			t.Errorf("Byte %d: expected %d, got %d", i, a[i]^b[i], xor[i])
+		}
+	}
+}
+
+func TestScaleShare_ScalesSecret(t *testing.T) {
+	secret := []byte{0, 1, 7, 200}
+	shares, _ := Split(secret, 3, 2)
+	scaled := make([]Share, 2)
+	for i := range scaled {
+		var err error
+		if scaled[i], err = ScaleShare(shares[i], 3); err != nil {
+			t.Fatalf("ScaleShare failed: %v", err)
+		}
+	}
+	product, _ := EvaluateAt(scaled, 0)
+	for i, b := range secret {
+		y, _ := decodeFieldElement(product, i)
+		if want := int64(b) * 3 % FieldPrime; y != want {
+			t.Errorf("Byte %d: expected %d, got %d", i, want, y)
+		}
+	}
+
+	splitter, _ := NewSplitter(3, 2, WithScheme(SchemeV2GF256))
+	compact, _ := splitter.Split(secret)
+	c0, _ := ScaleShare(compact[0], 0x53)
+	c1, _ := ScaleShare(compact[1], 0x53)
+	recovered, _ := Combine([]Share{c0, c1}, 2)
+	for i, b := range secret {
+		if recovered[i] != gf256Mul(b, 0x53) {
+			t.Errorf("Byte %d: expected %d, got %d", i, gf256Mul(b, 0x53), recovered[i])
+		}
+	}
+}
+
+func TestShareArithmetic_InvalidInput(t *testing.T) {
+	v1, _ := Split([]byte("ab"), 3, 2)
+	splitter, _ := NewSplitter(3, 2, WithScheme(SchemeV2GF256))
+	v2, _ := splitter.Split([]byte("ab"))
+	longer, _ := Split([]byte("abc"), 3, 2)
+
+	if _, err := AddShares(v1[0], v1[1]); err == nil {
+		t.Error("Expected error for different indices")
+	}
+	if _, err := AddShares(v1[0], v2[0]); err == nil {
+		t.Error("Expected error for mixed schemes")
+	}
+	if _, err := AddShares(v1[0], longer[0]); err == nil {
+		t.Error("Expected error for different lengths")
+	}
+	if _, err := ScaleShare(v1[0], FieldPrime); err == nil {
+		t.Error("Expected error for constant outside GF(257)")
+	}
+	if _, err := ScaleShare(v2[0], 256); err == nil {
+		t.Error("Expected error for constant outside GF(2^8)")
+	}
+	if _, err := ScaleShare(Share{Index: 1, Value: []byte{0xff, 0xff}}, 2); err == nil {
+		t.Error("Expected error for value outside the field")
+	}
+}
@@ -0,0 +1,367 @@
+// Package beacon provides the building blocks of a distributed randomness
+// beacon based on publicly verifiable secret sharing (PVSS), in the style
+// of Schoenmakers' scheme over the 2048-bit MODP group of RFC 3526.
+//
+// Each round runs in three phases:
+//
+//  1. Commit: every participant deals a random secret with Contribute and
+//     publishes the Contribution. It carries a Feldman commitment and one
+//     encrypted share per participant, each with a proof that anyone can
+//     check with VerifyContribution. The set of valid contributions is
+//     fixed at the end of this phase.
+//  2. Reveal: each dealer publishes the Opening of its secret, checked with
+//     VerifyOpening.
+//  3. Recover: for a dealer that withholds its opening, any threshold of
+//     participants publish DecryptedShares, from which RecoverContribution
+//     rebuilds the dealer's contribution.
+//
+// As in Schoenmakers' scheme, the Feldman commitments use the generator
+// g of package modp, while participant keys and decrypted shares use an
+// independent generator G whose discrete logarithm to base g nobody knows.
+// A dealer's contribution to the output is G^secret: the commitment
+// publishes only g^secret, from which G^secret cannot be computed, so the
+// output stays unknown until the reveal phase and the last dealer to commit
+// cannot grind its secret to bias it.
+//
+// Output hashes the contributions of every dealer fixed in the commit
+// phase. Because withheld openings are recovered rather than dropped, a
+// dealer who sees the other openings first cannot bias the output by
+// aborting, as long as fewer than threshold participants collude.
+package beacon
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"slices"
+
+	"github.com/fawwazid/go-shamir/internal/modp"
+	"github.com/fawwazid/go-shamir/vss"
+)
+
+// ErrInvalidContribution is returned when a contribution, opening or
+// decrypted share fails verification.
+var ErrInvalidContribution = errors.New("invalid beacon contribution")
+
+// shareBase is the generator G of participant keys and decrypted shares,
+// derived from a hash so that nobody knows its discrete logarithm to base
+// modp.G. Squaring maps the hash output into the subgroup of quadratic
+// residues.
+var shareBase = func() *big.Int {
+	var buf []byte
+	for counter := byte(0); len(buf) < modp.ElementSize+32; counter++ {
+		sum := sha256.Sum256(append([]byte("goshamir beacon G"), counter))
+		buf = append(buf, sum[:]...)
+	}
+	h := new(big.Int).SetBytes(buf)
+	h.Mod(h, modp.P)
+	return modp.Mul(h, h)
+}()
+
+// KeyPair is a participant's long-term encryption key for receiving
+// shares, with Public = G^Private. The private key must be kept secret.
+type KeyPair struct {
+	Index   uint8
+	Private *big.Int
+	Public  *big.Int
+}
+
+// GenerateKey creates the key pair of the participant with the given
+// index. A nil rand means crypto/rand.Reader.
+func GenerateKey(index uint8, rand io.Reader) (*KeyPair, error) {
+	if index == 0 {
+		return nil, errors.New("participant index must be non-zero")
+	}
+	x, err := modp.RandomScalar(rand)
+	if err != nil {
+		return nil, err
+	}
+	return &KeyPair{Index: index, Private: x, Public: modp.Exp(shareBase, x)}, nil
+}
+
+// Participant identifies a consortium member by share index and public key.
+type Participant struct {
+	Index     uint8
+	PublicKey *big.Int
+}
+
+// Round holds the public parameters of one beacon round. Every member must
+// use the same Round.
+type Round struct {
+	Number       uint64
+	Threshold    int
+	Participants []Participant
+}
+
+// EncryptedShare is a share encrypted to its recipient's public key, Y =
+// PublicKey^f(Index), with a proof that it matches the dealer's
+// commitment.
+type EncryptedShare struct {
+	Index uint8
+	Y     *big.Int
+	Proof modp.DLEQProof
+}
+
+// Contribution is a dealer's commit-phase message.
+type Contribution struct {
+	Round      uint64
+	Dealer     uint8
+	Commitment *vss.Commitment
+	Shares     []EncryptedShare
+}
+
+// Opening reveals a dealer's secret in the reveal phase.
+type Opening struct {
+	Dealer uint8
+	Secret *big.Int
+}
+
+// DecryptedShare is a participant's decryption of the share a dealer
+// encrypted to it, S = G^f(Index), with a proof of correct decryption.
+type DecryptedShare struct {
+	Dealer uint8
+	Index  uint8
+	S      *big.Int
+	Proof  modp.DLEQProof
+}
+
+// validate checks the round parameters.
+func (r *Round) validate() error {
+	n := len(r.Participants)
+	if r.Threshold < 2 || n < r.Threshold || n > 255 {
+		return errors.New("invalid round parameters")
+	}
+	seen := make(map[uint8]bool, n)
+	for _, p := range r.Participants {
+		if p.Index == 0 || seen[p.Index] {
+			return errors.New("participant indices must be distinct and non-zero")
+		}
+		if !modp.IsElement(p.PublicKey) {
+			return fmt.Errorf("participant %d has an invalid public key", p.Index)
+		}
+		seen[p.Index] = true
+	}
+	return nil
+}
+
+// participant returns the participant with the given index.
+func (r *Round) participant(index uint8) (Participant, bool) {
+	for _, p := range r.Participants {
+		if p.Index == index {
+			return p, true
+		}
+	}
+	return Participant{}, false
+}
+
+// context binds a proof to the round, dealer and recipient.
+func (r *Round) context(phase string, dealer, index uint8) []byte {
+	ctx := []byte("goshamir beacon " + phase)
+	ctx = binary.BigEndian.AppendUint64(ctx, r.Number)
+	return append(ctx, dealer, index)
+}
+
+// Contribute deals a fresh random secret as the participant dealer. It
+// returns the contribution to publish in the commit phase and the opening
+// to publish in the reveal phase, which must be kept secret until then.
+func (r *Round) Contribute(dealer uint8, rand io.Reader) (*Contribution, *Opening, error) {
+	if err := r.validate(); err != nil {
+		return nil, nil, err
+	}
+	if _, ok := r.participant(dealer); !ok {
+		return nil, nil, fmt.Errorf("dealer %d is not a participant", dealer)
+	}
+	secret, err := modp.RandomScalar(rand)
+	if err != nil {
+		return nil, nil, err
+	}
+	// Shares are dealt at x = 1..n and handed out by position, so the
+	// polynomial is evaluated at each participant's own index below.
+	maxIndex := 0
+	for _, p := range r.Participants {
+		maxIndex = max(maxIndex, int(p.Index))
+	}
+	shares, commitment, err := vss.Split(secret, maxIndex, r.Threshold, rand)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	c := &Contribution{Round: r.Number, Dealer: dealer, Commitment: commitment}
+	for _, p := range r.Participants {
+		f := shares[p.Index-1].Value
+		y := modp.Exp(p.PublicKey, f)
+		proof, err := modp.ProveDLEQ(f, modp.G, modp.Exp(modp.G, f), p.PublicKey, y, r.context("share", dealer, p.Index), rand)
+		if err != nil {
+			return nil, nil, err
+		}
+		c.Shares = append(c.Shares, EncryptedShare{Index: p.Index, Y: y, Proof: proof})
+	}
+	for _, s := range shares {
+		s.Value.SetInt64(0)
+	}
+	return c, &Opening{Dealer: dealer, Secret: secret}, nil
+}
+
+// VerifyContribution publicly checks that c carries one valid encrypted
+// share for every participant, consistent with its commitment.
+func (r *Round) VerifyContribution(c *Contribution) error {
+	if err := r.validate(); err != nil {
+		return err
+	}
+	if c == nil || c.Commitment == nil || c.Round != r.Number {
+		return ErrInvalidContribution
+	}
+	if _, ok := r.participant(c.Dealer); !ok {
+		return ErrInvalidContribution
+	}
+	if c.Commitment.Validate() != nil || c.Commitment.Threshold() != r.Threshold || len(c.Shares) != len(r.Participants) {
+		return ErrInvalidContribution
+	}
+	for i, s := range c.Shares {
+		p := r.Participants[i]
+		if s.Index != p.Index {
+			return ErrInvalidContribution
+		}
+		x := c.Commitment.ShareCommitment(s.Index)
+		if err := modp.VerifyDLEQ(s.Proof, modp.G, x, p.PublicKey, s.Y, r.context("share", c.Dealer, s.Index)); err != nil {
+			return fmt.Errorf("%w: share for participant %d", ErrInvalidContribution, s.Index)
+		}
+	}
+	return nil
+}
+
+// VerifyOpening checks that o opens the verified contribution c: that
+// g^Secret matches the commitment.
+func (r *Round) VerifyOpening(c *Contribution, o *Opening) error {
+	if o == nil || o.Secret == nil || o.Dealer != c.Dealer {
+		return ErrInvalidContribution
+	}
+	if modp.Exp(modp.G, o.Secret).Cmp(c.Commitment.PublicKey()) != 0 {
+		return ErrInvalidContribution
+	}
+	return nil
+}
+
+// DecryptShare decrypts the share of c encrypted to kp and proves the
+// decryption correct, for use when c's dealer withholds its opening. A nil
+// rand means crypto/rand.Reader.
+func (r *Round) DecryptShare(kp *KeyPair, c *Contribution, rand io.Reader) (*DecryptedShare, error) {
+	es, err := encryptedShareFor(c, kp.Index)
+	if err != nil {
+		return nil, err
+	}
+	// S = Y^(1/x) = G^f(i), so that Y = S^x and PublicKey = G^x.
+	inv := new(big.Int).ModInverse(kp.Private, modp.Q)
+	if inv == nil {
+		return nil, errors.New("invalid private key")
+	}
+	s := modp.Exp(es.Y, inv)
+	proof, err := modp.ProveDLEQ(kp.Private, shareBase, kp.Public, s, es.Y, r.context("decrypt", c.Dealer, kp.Index), rand)
+	if err != nil {
+		return nil, err
+	}
+	return &DecryptedShare{Dealer: c.Dealer, Index: kp.Index, S: s, Proof: proof}, nil
+}
+
+// VerifyDecryptedShare checks a decrypted share against c.
+func (r *Round) VerifyDecryptedShare(c *Contribution, ds *DecryptedShare) error {
+	if ds == nil || ds.Dealer != c.Dealer {
+		return ErrInvalidContribution
+	}
+	p, ok := r.participant(ds.Index)
+	if !ok {
+		return ErrInvalidContribution
+	}
+	es, err := encryptedShareFor(c, ds.Index)
+	if err != nil {
+		return err
+	}
+	if err := modp.VerifyDLEQ(ds.Proof, shareBase, p.PublicKey, ds.S, es.Y, r.context("decrypt", c.Dealer, ds.Index)); err != nil {
+		return fmt.Errorf("%w: decryption by participant %d", ErrInvalidContribution, ds.Index)
+	}
+	return nil
+}
+
+// RecoverContribution rebuilds G^secret for the verified contribution c
+// from at least threshold decrypted shares, each of which is verified.
+// Since every share's decryption is proven against the encrypted share,
+// and every encrypted share against the commitment, the result is the
+// value the dealer's opening would have given.
+func (r *Round) RecoverContribution(c *Contribution, shares []*DecryptedShare) (*big.Int, error) {
+	if len(shares) < r.Threshold {
+		return nil, fmt.Errorf("need at least %d decrypted shares, got %d", r.Threshold, len(shares))
+	}
+	shares = shares[:r.Threshold]
+	xs := make([]uint8, len(shares))
+	for i, ds := range shares {
+		if err := r.VerifyDecryptedShare(c, ds); err != nil {
+			return nil, err
+		}
+		xs[i] = ds.Index
+	}
+	basis, err := modp.LagrangeAtZero(xs)
+	if err != nil {
+		return nil, err
+	}
+	value := big.NewInt(1)
+	for i, ds := range shares {
+		value = modp.Mul(value, modp.Exp(ds.S, basis[i]))
+	}
+	return value, nil
+}
+
+// Output computes the beacon value from the contribution of every dealer
+// whose contribution was accepted in the commit phase. values maps each of
+// those dealers to G^secret, taken from its verified Opening (see
+// OpeningValue) or from RecoverContribution. A missing dealer is an error,
+// since dropping one would let it bias the output.
+func (r *Round) Output(accepted []*Contribution, values map[uint8]*big.Int) ([32]byte, error) {
+	if len(accepted) == 0 {
+		return [32]byte{}, errors.New("no accepted contributions")
+	}
+	dealers := make([]uint8, 0, len(accepted))
+	for _, c := range accepted {
+		v, ok := values[c.Dealer]
+		if !ok {
+			return [32]byte{}, fmt.Errorf("missing value for dealer %d", c.Dealer)
+		}
+		if !modp.IsElement(v) {
+			return [32]byte{}, fmt.Errorf("%w: value for dealer %d", ErrInvalidContribution, c.Dealer)
+		}
+		dealers = append(dealers, c.Dealer)
+	}
+	slices.Sort(dealers)
+	if len(slices.Compact(dealers)) != len(accepted) {
+		return [32]byte{}, errors.New("duplicate dealer in accepted contributions")
+	}
+
+	h := sha256.New()
+	h.Write([]byte("goshamir beacon output"))
+	h.Write(binary.BigEndian.AppendUint64(nil, r.Number))
+	for _, d := range dealers {
+		h.Write([]byte{d})
+		h.Write(modp.Encode(values[d]))
+	}
+	var out [32]byte
+	h.Sum(out[:0])
+	return out, nil
+}
+
+// OpeningValue returns G^secret for a verified opening, the form Output
+// expects.
+func OpeningValue(o *Opening) *big.Int {
+	return modp.Exp(shareBase, o.Secret)
+}
+
+// encryptedShareFor returns the share of c encrypted to index.
+func encryptedShareFor(c *Contribution, index uint8) (EncryptedShare, error) {
+	for _, s := range c.Shares {
+		if s.Index == index {
+			return s, nil
+		}
+	}
+	return EncryptedShare{}, fmt.Errorf("no share for participant %d", index)
+}
@@ -0,0 +1,175 @@
+package beacon
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+)
+
+// --- Beacon Tests ---
+
+func newTestRound(t *testing.T, n, k int) (*Round, []*KeyPair) {
+	t.Helper()
+	r := &Round{Number: 7, Threshold: k}
+	keys := make([]*KeyPair, n)
+	for i := range keys {
+		kp, err := GenerateKey(uint8(i+1), nil)
+		if err != nil {
+			t.Fatalf("GenerateKey failed: %v", err)
+		}
+		keys[i] = kp
+		r.Participants = append(r.Participants, Participant{Index: kp.Index, PublicKey: kp.Public})
+	}
+	return r, keys
+}
+
+func TestBeacon_RoundWithWithheldOpening(t *testing.T) {
+	r, keys := newTestRound(t, 3, 2)
+
+	// Commit phase.
+	var accepted []*Contribution
+	openings := make(map[uint8]*Opening)
+	for _, kp := range keys {
+		c, o, err := r.Contribute(kp.Index, nil)
+		if err != nil {
+			t.Fatalf("Contribute failed: %v", err)
+		}
+		if err := r.VerifyContribution(c); err != nil {
+			t.Fatalf("VerifyContribution failed: %v", err)
+		}
+		accepted = append(accepted, c)
+		openings[kp.Index] = o
+	}
+
+	// Reveal phase: dealer 2 withholds its opening.
+	values := make(map[uint8]*big.Int)
+	for _, c := range accepted {
+		if c.Dealer == 2 {
+			continue
+		}
+		if err := r.VerifyOpening(c, openings[c.Dealer]); err != nil {
+			t.Fatalf("VerifyOpening failed: %v", err)
+		}
+		values[c.Dealer] = OpeningValue(openings[c.Dealer])
+	}
+	if _, err := r.Output(accepted, values); err == nil {
+		t.Fatal("Expected error while a dealer's value is missing")
+	}
+
+	// Recover phase: participants 1 and 3 decrypt their shares of dealer 2.
+	withheld := accepted[1]
+	var decrypted []*DecryptedShare
+	for _, kp := range []*KeyPair{keys[2], keys[0]} {
+		ds, err := r.DecryptShare(kp, withheld, nil)
+		if err != nil {
+			t.Fatalf("DecryptShare failed: %v", err)
+		}
+		decrypted = append(decrypted, ds)
+	}
+	recovered, err := r.RecoverContribution(withheld, decrypted)
+	if err != nil {
+		t.Fatalf("RecoverContribution failed: %v", err)
+	}
+	values[2] = recovered
+
+	out, err := r.Output(accepted, values)
+	if err != nil {
+		t.Fatalf("Output failed: %v", err)
+	}
+
+	// Had dealer 2 opened, the output would be identical.
+	values[2] = OpeningValue(openings[2])
+	again, err := r.Output(accepted, values)
+	if err != nil {
+		t.Fatalf("Output failed: %v", err)
+	}
+	if out != again {
+		t.Error("Recovered contribution changed the beacon output")
+	}
+}
+
+func TestBeacon_RejectsInvalidMessages(t *testing.T) {
+	r, keys := newTestRound(t, 3, 2)
+	c, o, err := r.Contribute(1, nil)
+	if err != nil {
+		t.Fatalf("Contribute failed: %v", err)
+	}
+
+	// A share encrypted to the wrong value breaks its proof.
+	bad := *c
+	bad.Shares = append([]EncryptedShare(nil), c.Shares...)
+	bad.Shares[1].Y = c.Shares[2].Y
+	if err := r.VerifyContribution(&bad); !errors.Is(err, ErrInvalidContribution) {
+		t.Errorf("Expected ErrInvalidContribution for swapped share, got %v", err)
+	}
+
+	replay := *c
+	replay.Round++
+	if err := r.VerifyContribution(&replay); !errors.Is(err, ErrInvalidContribution) {
+		t.Errorf("Expected ErrInvalidContribution for other round, got %v", err)
+	}
+
+	wrong := &Opening{Dealer: 1, Secret: new(big.Int).Add(o.Secret, big.NewInt(1))}
+	if err := r.VerifyOpening(c, wrong); !errors.Is(err, ErrInvalidContribution) {
+		t.Errorf("Expected ErrInvalidContribution for wrong opening, got %v", err)
+	}
+
+	ds, err := r.DecryptShare(keys[1], c, nil)
+	if err != nil {
+		t.Fatalf("DecryptShare failed: %v", err)
+	}
+	forged := *ds
+	forged.Index = 3
+	if err := r.VerifyDecryptedShare(c, &forged); !errors.Is(err, ErrInvalidContribution) {
+		t.Errorf("Expected ErrInvalidContribution for forged decryption, got %v", err)
+	}
+	if _, err := r.RecoverContribution(c, []*DecryptedShare{ds}); err == nil {
+		t.Error("Expected error for too few decrypted shares")
+	}
+	if _, _, err := r.Contribute(9, nil); err == nil {
+		t.Error("Expected error for unknown dealer")
+	}
+}
+
+func TestBeacon_OutputHiddenUntilReveal(t *testing.T) {
+	r, keys := newTestRound(t, 3, 2)
+	var accepted []*Contribution
+	values := make(map[uint8]*big.Int)
+	commitPhase := make(map[uint8]*big.Int)
+	for _, kp := range keys {
+		c, o, err := r.Contribute(kp.Index, nil)
+		if err != nil {
+			t.Fatalf("Contribute failed: %v", err)
+		}
+		accepted = append(accepted, c)
+		values[c.Dealer] = OpeningValue(o)
+		commitPhase[c.Dealer] = c.Commitment.PublicKey()
+	}
+
+	// No group element published in the commit phase is a dealer's
+	// contribution to the output.
+	for _, c := range accepted {
+		v := values[c.Dealer]
+		published := []*big.Int{c.Commitment.PublicKey()}
+		for _, s := range c.Shares {
+			published = append(published, s.Y, c.Commitment.ShareCommitment(s.Index))
+		}
+		for _, x := range published {
+			if x.Cmp(v) == 0 {
+				t.Fatalf("contribution of dealer %d is public at commit time", c.Dealer)
+			}
+		}
+	}
+
+	out, err := r.Output(accepted, values)
+	if err != nil {
+		t.Fatalf("Output failed: %v", err)
+	}
+	guess, err := r.Output(accepted, commitPhase)
+	if err != nil {
+		t.Fatalf("Output failed: %v", err)
+	}
+	if guess == out {
+		t.Fatal("output can be computed from the commitments")
+	}
+}
@@ -0,0 +1,174 @@
+package goshamir
+
+import (
+	"errors"
+	"fmt"
+	"io"
+)
+
+// BeaverTriple is one party's shares of a multiplication triple: secrets
+// a and b drawn uniformly from the scheme's field, element by element,
+// and their product c = a·b. A triple lets parties multiply two shared
+// values without reconstructing them, and must be used only once.
+//
+// To multiply shared values x and y, each party calls Mask with its shares
+// of x and y and broadcasts the resulting shares of d = x - a and e = y - b.
+// The parties open d and e with EvaluateAt at x = 0, which reveals nothing
+// about x and y since a and b are uniformly random. Each party then calls
+// Multiply to obtain its share of x·y.
+type BeaverTriple struct {
+	A, B, C Share
+}
+
+// NewBeaverTriples deals triples of size elements to totalShares parties
+// with the given threshold, returning one BeaverTriple per party. The
+// dealer learns a, b and c, so it must be trusted, or replaced by an MPC
+// preprocessing protocol, and must erase them afterwards.
+func NewBeaverTriples(size, totalShares, threshold int, opts ...Option) ([]BeaverTriple, error) {
+	if size <= 0 {
+		return nil, errors.New("triple size must be positive")
+	}
+	splitter, err := NewSplitter(totalShares, threshold, opts...)
+	if err != nil {
+		return nil, err
+	}
+	scheme := splitter.Scheme()
+
+	a := make([]uint16, size)
+	b := make([]uint16, size)
+	c := make([]uint16, size)
+	defer clear(a)
+	defer clear(b)
+	defer clear(c)
+	if err := randomFieldElements(splitter.config.Rand, scheme, a); err != nil {
+		return nil, err
+	}
+	if err := randomFieldElements(splitter.config.Rand, scheme, b); err != nil {
+		return nil, err
+	}
+	for i := range c {
+		c[i] = fieldMul(scheme, a[i], b[i])
+	}
+
+	triples := make([]BeaverTriple, totalShares)
+	for _, part := range []struct {
+		elems []uint16
+		set   func(*BeaverTriple, Share)
+	}{
+		{a, func(t *BeaverTriple, s Share) { t.A = s }},
+		{b, func(t *BeaverTriple, s Share) { t.B = s }},
+		{c, func(t *BeaverTriple, s Share) { t.C = s }},
+	} {
+		shares, err := splitter.shareElements(part.elems)
+		if err != nil {
+			return nil, err
+		}
+		for i, s := range shares {
+			part.set(&triples[i], s)
+		}
+	}
+	return triples, nil
+}
+
+// Mask returns the party's shares of d = x - a and e = y - b, to be
+// broadcast to the other parties.
+func (t BeaverTriple) Mask(x, y Share) (d, e Share, err error) {
+	if d, err = SubShares(x, t.A); err != nil {
+		return Share{}, Share{}, fmt.Errorf("mask x: %w", err)
+	}
+	if e, err = SubShares(y, t.B); err != nil {
+		return Share{}, Share{}, fmt.Errorf("mask y: %w", err)
+	}
+	return d, e, nil
+}
+
+// Multiply returns the party's share of x·y from the opened values d and e,
+// given in the layout of Share.Value as returned by EvaluateAt. The share
+// is c + d·b + e·a + d·e.
+func (t BeaverTriple) Multiply(d, e []byte) (Share, error) {
+	scheme, err := sharesScheme([]Share{t.A, t.B, t.C})
+	if err != nil {
+		return Share{}, err
+	}
+	if t.A.Index != t.B.Index || t.A.Index != t.C.Index {
+		return Share{}, errors.New("triple shares have different indices")
+	}
+	a, err := shareElements(t.A, scheme)
+	if err != nil {
+		return Share{}, err
+	}
+	b, err := shareElements(t.B, scheme)
+	if err != nil {
+		return Share{}, err
+	}
+	c, err := shareElements(t.C, scheme)
+	if err != nil {
+		return Share{}, err
+	}
+	dv, err := valueElements(d, scheme)
+	if err != nil {
+		return Share{}, fmt.Errorf("opened d: %w", err)
+	}
+	ev, err := valueElements(e, scheme)
+	if err != nil {
+		return Share{}, fmt.Errorf("opened e: %w", err)
+	}
+	if len(a) != len(b) || len(a) != len(c) || len(a) != len(dv) || len(a) != len(ev) {
+		return Share{}, errors.New("triple and opened values have different lengths")
+	}
+
+	z := make([]uint16, len(c))
+	for i := range z {
+		z[i] = fieldAdd(scheme, c[i], fieldMul(scheme, dv[i], b[i]))
+		z[i] = fieldAdd(scheme, z[i], fieldMul(scheme, ev[i], a[i]))
+		z[i] = fieldAdd(scheme, z[i], fieldMul(scheme, dv[i], ev[i]))
+	}
+	clear(a)
+	clear(b)
+	clear(c)
+	return Share{Index: t.A.Index, Value: elementsValue(z, scheme), Scheme: scheme}, nil
+}
+
+// shareElements splits field elements rather than bytes, so that secrets
+// such as the GF(257) element 256 can be shared. It splits zeros and adds
+// the elements to every share, shifting the constant term of each random
+// polynomial.
+func (s *Splitter) shareElements(elems []uint16) ([]Share, error) {
+	shares, err := s.split(make([]byte, len(elems)), s.config.Rand)
+	if err != nil {
+		return nil, err
+	}
+	scheme := s.config.Scheme
+	for i := range shares {
+		values, err := valueElements(shares[i].Value, scheme)
+		if err != nil {
+			return nil, err
+		}
+		for j, e := range elems {
+			values[j] = fieldAdd(scheme, values[j], e)
+		}
+		s.putBytes(shares[i].Value)
+		shares[i].Value = elementsValue(values, scheme)
+		clear(values)
+	}
+	return shares, nil
+}
+
+// randomFieldElements fills dst with uniformly random elements of the
+// scheme's field.
+func randomFieldElements(r io.Reader, scheme Scheme, dst []uint16) error {
+	if scheme == SchemeV2GF256 {
+		buf := make([]byte, len(dst))
+		if _, err := io.ReadFull(r, buf); err != nil {
+			return fmt.Errorf("random element generation failed: %w", err)
+		}
+		for i, b := range buf {
+			dst[i] = uint16(b)
+		}
+		clear(buf)
+		return nil
+	}
+	scratch := make([]byte, 2*len(dst))
+	defer clear(scratch)
+	return readFieldElements(r, dst, scratch)
+}
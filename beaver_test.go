@@ -0,0 +1,93 @@
+package goshamir
+
+import (
+	"testing"
+)
+
+// --- Beaver Triple Tests ---
+
+// multiplyShared runs the Beaver multiplication protocol among the given
+// parties and returns the opened product in field representation.
+func multiplyShared(t *testing.T, triples []BeaverTriple, xs, ys []Share, parties []int) []byte {
+	t.Helper()
+	var ds, es []Share
+	for _, p := range parties {
+		d, e, err := triples[p].Mask(xs[p], ys[p])
+		if err != nil {
+			t.Fatalf("Mask failed: %v", err)
+		}
+		ds, es = append(ds, d), append(es, e)
+	}
+	d, err := EvaluateAt(ds, 0)
+	if err != nil {
+		t.Fatalf("Open d failed: %v", err)
+	}
+	e, err := EvaluateAt(es, 0)
+	if err != nil {
+		t.Fatalf("Open e failed: %v", err)
+	}
+
+	var zs []Share
+	for _, p := range parties {
+		z, err := triples[p].Multiply(d, e)
+		if err != nil {
+			t.Fatalf("Multiply failed: %v", err)
+		}
+		zs = append(zs, z)
+	}
+	product, err := EvaluateAt(zs, 0)
+	if err != nil {
+		t.Fatalf("Open product failed: %v", err)
+	}
+	return product
+}
+
+func TestBeaverTriples_MultiplyGF257(t *testing.T) {
+	x, y := []byte{0, 2, 16, 255}, []byte{9, 3, 16, 255}
+	triples, err := NewBeaverTriples(len(x), 5, 3)
+	if err != nil {
+		t.Fatalf("NewBeaverTriples failed: %v", err)
+	}
+	xs, _ := Split(x, 5, 3)
+	ys, _ := Split(y, 5, 3)
+
+	product := multiplyShared(t, triples, xs, ys, []int{0, 2, 4})
+	for i := range x {
+		got, _ := decodeFieldElement(product, i)
+		if want := int64(x[i]) * int64(y[i]) % FieldPrime; got != want {
+			t.Errorf("Element %d: expected %d, got %d", i, want, got)
+		}
+	}
+}
+
+func TestBeaverTriples_MultiplyGF256(t *testing.T) {
+	x, y := []byte{0x53, 1, 0, 0x80}, []byte{0xCA, 7, 9, 0x80}
+	triples, err := NewBeaverTriples(len(x), 3, 2, WithScheme(SchemeV2GF256))
+	if err != nil {
+		t.Fatalf("NewBeaverTriples failed: %v", err)
+	}
+	splitter, _ := NewSplitter(3, 2, WithScheme(SchemeV2GF256))
+	xs, _ := splitter.Split(x)
+	ys, _ := splitter.Split(y)
+
+	product := multiplyShared(t, triples, xs, ys, []int{1, 2})
+	for i := range x {
+		if want := gf256Mul(x[i], y[i]); product[i] != want {
+			t.Errorf("Element %d: expected %d, got %d", i, want, product[i])
+		}
+	}
+}
+
+func TestBeaverTriples_InvalidInput(t *testing.T) {
+	if _, err := NewBeaverTriples(0, 3, 2); err == nil {
+		t.Error("Expected error for empty triples")
+	}
+	triples, _ := NewBeaverTriples(2, 3, 2)
+	xs, _ := Split([]byte("ab"), 3, 2)
+	if _, _, err := triples[0].Mask(xs[1], xs[0]); err == nil {
+		t.Error("Expected error for mismatched share index")
+	}
+	if _, err := triples[0].Multiply([]byte{1, 0}, []byte{1, 0, 2, 0}); err == nil {
+		t.Error("Expected error for mismatched opened lengths")
+	}
+}
@@ -0,0 +1,90 @@
+package goshamir
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/binary"
+	"errors"
+	"math/big"
+)
+
+// bip32PrivateVersion prefixes mainnet extended private keys ("xprv").
+const bip32PrivateVersion = 0x0488ade4
+
+// secp256k1Order is the order n of the secp256k1 group. Master keys must
+// lie in [1, n).
+var secp256k1Order, _ = new(big.Int).SetString("fffffffffffffffffffffffffffffffebaaedce6af48a03bbfd25e8cd0364141", 16)
+
+// MnemonicMasterKey returns the BIP-32 master extended private key of the
+// wallet a mnemonic and passphrase derive, serialized as an "xprv" string
+// as wallets export it. Comparing it with the wallet's confirms that a
+// reconstructed mnemonic restores the wallet before any funds depend on
+// it.
+//
+// Only the master key is derived. Child keys and derivation paths, which
+// need secp256k1 arithmetic for non-hardened steps, are out of scope.
+func MnemonicMasterKey(mnemonic, passphrase string) (string, error) {
+	seed, err := MnemonicSeed(mnemonic, passphrase)
+	if err != nil {
+		return "", err
+	}
+	defer clear(seed)
+	return bip32MasterKey(seed)
+}
+
+// bip32MasterKey derives the master extended private key of seed.
+func bip32MasterKey(seed []byte) (string, error) {
+	mac := hmac.New(sha512.New, []byte("Bitcoin seed"))
+	mac.Write(seed)
+	i := mac.Sum(nil)
+	defer clear(i)
+	key, chainCode := i[:32], i[32:]
+	if k := new(big.Int).SetBytes(key); k.Sign() == 0 || k.Cmp(secp256k1Order) >= 0 {
+		return "", errors.New("seed yields an invalid master key")
+	}
+
+	// version | depth | parent fingerprint | child number | chain code |
+	// 0x00 | key, then a double SHA-256 checksum.
+	buf := binary.BigEndian.AppendUint32(nil, bip32PrivateVersion)
+	buf = append(buf, make([]byte, 1+4+4)...)
+	buf = append(buf, chainCode...)
+	buf = append(buf, 0)
+	buf = append(buf, key...)
+	defer clear(buf)
+	first := sha256.Sum256(buf)
+	second := sha256.Sum256(first[:])
+	buf = append(buf, second[:4]...)
+	return base58Encode(buf), nil
+}
+
+const base58Alphabet = "123456789ABCDEFGHJKLMNPQRSTUVWXYZabcdefghijkmnopqrstuvwxyz"
+
+// base58Encode encodes b in Bitcoin's base58 alphabet, keeping leading
+// zero bytes as '1' digits.
+func base58Encode(b []byte) string {
+	zeros := len(b) - len(bytes.TrimLeft(b, "\x00"))
+	// Little-endian base58 digits of b, updated one input byte at a time.
+	var digits []byte
+	for _, c := range b[zeros:] {
+		carry := int(c)
+		for j := range digits {
+			carry += int(digits[j]) << 8
+			digits[j] = byte(carry % 58)
+			carry /= 58
+		}
+		for carry > 0 {
+			digits = append(digits, byte(carry%58))
+			carry /= 58
+		}
+	}
+	out := make([]byte, zeros, zeros+len(digits))
+	for i := range out {
+		out[i] = base58Alphabet[0]
+	}
+	for i := len(digits) - 1; i >= 0; i-- {
+		out = append(out, base58Alphabet[digits[i]])
+	}
+	return string(out)
+}
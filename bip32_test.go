@@ -0,0 +1,64 @@
+package goshamir
+
+import (
+	"encoding/hex"
+	"strings"
+	"testing"
+)
+
+// --- BIP-32 Tests ---
+
+func TestBIP32MasterKey(t *testing.T) {
+	// The master keys of test vectors 1 and 2 of BIP-32.
+	tests := []struct{ seed, want string }{
+		{"000102030405060708090a0b0c0d0e0f", "xprv9s21ZrQH143K3QTDL4LXw2F7HEK3wJUD2nW2nRk4stbPy6cq3jPPqjiChkVvvNKmPGJxWUtg6LnF5kejMRNNU3TGtRBeJgk33yuGBxrMPHi"},
+		{"fffcf9f6f3f0edeae7e4e1dedbd8d5d2cfccc9c6c3c0bdbab7b4b1aeaba8a5a29f9c999693908d8a8784817e7b7875726f6c696663605d5a5754514e4b484542", "xprv9s21ZrQH143K31xYSDQpPDxsXRTUcvj2iNHm5NUtrGiGG5e2DtALGdso3pGz6ssrdK4PFmM8NSpSBHNqPqm55Qn3LqFtT2emdEXVYsCzC2U"},
+	}
+	for _, tt := range tests {
+		seed, _ := hex.DecodeString(tt.seed)
+		got, err := bip32MasterKey(seed)
+		if err != nil {
+			t.Fatalf("bip32MasterKey failed: %v", err)
+		}
+		if got != tt.want {
+			t.Errorf("bip32MasterKey(%s) = %s, want %s", tt.seed, got, tt.want)
+		}
+	}
+}
+
+func TestMnemonicMasterKey(t *testing.T) {
+	// The first BIP-39 reference vector, with passphrase "TREZOR".
+	got, err := MnemonicMasterKey(bip39Vectors[0].mnemonic, "TREZOR")
+	if err != nil {
+		t.Fatalf("MnemonicMasterKey failed: %v", err)
+	}
+	if want := "xprv9s21ZrQH143K3h3fDYiay8mocZ3afhfULfb5GX8kCBdno77K4HiA15Tg23wpbeF1pLfs1c5SPmYHrEpTuuRhxMwvKDwqdKiGJS9XFKzUsAF"; got != want {
+		t.Errorf("MnemonicMasterKey = %s, want %s", got, want)
+	}
+
+	// The first Japanese vector, whose seed needs NFKD normalization.
+	seed, _ := bip39Seed(strings.Repeat("あいこくしん　", 11)+"あおぞら", "㍍ガバヴァぱばぐゞちぢ十人十色")
+	got, _ = bip32MasterKey(seed)
+	if want := "xprv9s21ZrQH143K258jAiWPAM6JYT9hLA91MV3AZUKfxmLZJCjCHeSjBvMbDy8C1mJ2FL5ytExyS97FAe6pQ6SD5Jt9SwHaLorA8i5Eojokfo1"; got != want {
+		t.Errorf("bip32MasterKey of the Japanese vector = %s, want %s", got, want)
+	}
+
+	if _, err := MnemonicMasterKey("not a mnemonic", ""); err == nil {
+		t.Error("Expected error for an invalid mnemonic")
+	}
+}
+
+func TestBase58Encode(t *testing.T) {
+	tests := []struct{ in, want string }{
+		{"", ""},
+		{"00", "1"},
+		{"0000ff", "115Q"},
+		{"61", "2g"},
+	}
+	for _, tt := range tests {
+		in, _ := hex.DecodeString(tt.in)
+		if got := base58Encode(in); got != tt.want {
+			t.Errorf("base58Encode(%s) = %q, want %q", tt.in, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,137 @@
+package goshamir
+
+import (
+	"crypto/pbkdf2"
+	"crypto/sha256"
+	"crypto/sha512"
+	_ "embed"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/fawwazid/go-shamir/internal/norm"
+)
+
+// bip39English is the BIP-39 English wordlist, one word per line.
+//
+//go:embed bip39_english.txt
+var bip39English string
+
+var bip39Words, bip39Index = func() ([]string, map[string]int) {
+	words := strings.Split(strings.TrimSpace(bip39English), "\n")
+	index := make(map[string]int, len(words))
+	for i, w := range words {
+		index[w] = i
+	}
+	return words, index
+}()
+
+// ErrInvalidMnemonic is returned for mnemonics that are not valid BIP-39
+// English sentences, including those with a wrong checksum.
+var ErrInvalidMnemonic = errors.New("invalid BIP-39 mnemonic")
+
+// SplitMnemonicSeed validates a BIP-39 English mnemonic, including its
+// checksum, and splits the entropy it encodes. Splitting the entropy rather
+// than the words keeps shares small and guarantees that CombineMnemonicSeed
+// produces a mnemonic with a valid checksum.
+func SplitMnemonicSeed(mnemonic string, totalShares, threshold int, opts ...Option) ([]Share, error) {
+	entropy, err := mnemonicToEntropy(mnemonic)
+	if err != nil {
+		return nil, err
+	}
+	defer clear(entropy)
+	splitter, err := NewSplitter(totalShares, threshold, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return splitter.Split(entropy)
+}
+
+// CombineMnemonicSeed reconstructs the mnemonic from shares created by
+// SplitMnemonicSeed.
+func CombineMnemonicSeed(shares []Share, threshold int) (string, error) {
+	entropy, err := Combine(shares, threshold)
+	if err != nil {
+		return "", err
+	}
+	defer clear(entropy)
+	return entropyToMnemonic(entropy)
+}
+
+// MnemonicSeed returns the 64-byte BIP-39 seed of a mnemonic and optional
+// passphrase, from which BIP-32 wallets derive their master key. It can be
+// used to check that a reconstructed mnemonic yields the expected wallet.
+// As BIP-39 requires, both are NFKD-normalized first, so a passphrase with
+// accented letters gives the same seed however it was typed.
+func MnemonicSeed(mnemonic, passphrase string) ([]byte, error) {
+	mnemonic = norm.NFKD(mnemonic)
+	entropy, err := mnemonicToEntropy(mnemonic)
+	if err != nil {
+		return nil, err
+	}
+	clear(entropy)
+	return bip39Seed(strings.Join(strings.Fields(strings.ToLower(mnemonic)), " "), passphrase)
+}
+
+// bip39Seed derives the seed of a mnemonic sentence in any language,
+// without validating it.
+func bip39Seed(mnemonic, passphrase string) ([]byte, error) {
+	return pbkdf2.Key(sha512.New, norm.NFKD(mnemonic), []byte("mnemonic"+norm.NFKD(passphrase)), 2048, 64)
+}
+
+func mnemonicToEntropy(mnemonic string) ([]byte, error) {
+	words := strings.Fields(strings.ToLower(mnemonic))
+	if len(words) < 12 || len(words) > 24 || len(words)%3 != 0 {
+		return nil, fmt.Errorf("%w: %d words", ErrInvalidMnemonic, len(words))
+	}
+
+	// Each word carries 11 bits; the last len(words)/3 bits are the checksum.
+	bits := make([]byte, (len(words)*11+7)/8)
+	for i, w := range words {
+		idx, ok := bip39Index[w]
+		if !ok {
+			return nil, fmt.Errorf("%w: unknown word %q", ErrInvalidMnemonic, w)
+		}
+		for b := range 11 {
+			if idx&(1<<(10-b)) != 0 {
+				pos := i*11 + b
+				bits[pos/8] |= 0x80 >> (pos % 8)
+			}
+		}
+	}
+
+	checksumBits := len(words) / 3
+	entropy := bits[:checksumBits*4]
+	sum := sha256.Sum256(entropy)
+	got := bits[checksumBits*4] >> (8 - checksumBits)
+	want := sum[0] >> (8 - checksumBits)
+	clear(bits[len(entropy):])
+	if got != want {
+		clear(entropy)
+		return nil, fmt.Errorf("%w: checksum mismatch", ErrInvalidMnemonic)
+	}
+	return entropy, nil
+}
+
+func entropyToMnemonic(entropy []byte) (string, error) {
+	if len(entropy) < 16 || len(entropy) > 32 || len(entropy)%4 != 0 {
+		return "", fmt.Errorf("entropy of %d bytes cannot form a BIP-39 mnemonic", len(entropy))
+	}
+	sum := sha256.Sum256(entropy)
+	bits := append(append([]byte(nil), entropy...), sum[0])
+	defer clear(bits)
+
+	words := make([]string, len(entropy)*8/32*3)
+	for i := range words {
+		idx := 0
+		for b := range 11 {
+			pos := i*11 + b
+			idx <<= 1
+			if bits[pos/8]&(0x80>>(pos%8)) != 0 {
+				idx |= 1
+			}
+		}
+		words[i] = bip39Words[idx]
+	}
+	return strings.Join(words, " "), nil
+}
@@ -0,0 +1,142 @@
+package goshamir
+
+import (
+	"bytes"
+	"crypto/pbkdf2"
+	"crypto/sha512"
+	"encoding/hex"
+	"errors"
+	"strings"
+	"testing"
+)
+
+// --- BIP-39 Tests ---
+
+// Vectors from the BIP-39 reference test suite, with passphrase "TREZOR".
+var bip39Vectors = []struct {
+	entropy, mnemonic, seed string
+}{
+	{
+		"00000000000000000000000000000000",
+		"abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon about",
+		"c55257c360c07c72029aebc1b53c05ed0362ada38ead3e3e9efa3708e53495531f09a6987599d18264c1e1c92f2cf141630c7a3c4ab7c81b2f001698e7463b04",
+	},
+	{
+		"7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f7f",
+		"legal winner thank year wave sausage worth useful legal winner thank yellow",
+		"2e8905819b8723fe2c1d161860e5ee1830318dbf49a83bd451cfb8440c28bd6fa457fe1296106559a3c80937a1c1069be3a3a5bd381ee6260e8d9739fce1f607",
+	},
+	{
+		"ffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff",
+		"zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo zoo vote",
+		"dd48c104698c30cfe2b6142103248622fb7bb0ff692eebb00089b32d22484e1613912f0a5b694407be899ffd31ed3992c456cdf60f5d4564b8ba3f05a69890ad",
+	},
+}
+
+func TestBIP39_Vectors(t *testing.T) {
+	if len(bip39Words) != 2048 {
+		t.Fatalf("Expected 2048 words, got %d", len(bip39Words))
+	}
+	for _, v := range bip39Vectors {
+		entropy, _ := hex.DecodeString(v.entropy)
+		mnemonic, err := entropyToMnemonic(entropy)
+		if err != nil || mnemonic != v.mnemonic {
+			t.Errorf("entropyToMnemonic(%s) = %q, %v", v.entropy, mnemonic, err)
+		}
+		got, err := mnemonicToEntropy(v.mnemonic)
+		if err != nil || hex.EncodeToString(got) != v.entropy {
+			t.Errorf("mnemonicToEntropy(%q) = %x, %v", v.mnemonic, got, err)
+		}
+		seed, err := MnemonicSeed(v.mnemonic, "TREZOR")
+		if err != nil || hex.EncodeToString(seed) != v.seed {
+			t.Errorf("MnemonicSeed(%q) = %x, %v", v.mnemonic, seed, err)
+		}
+	}
+}
+
+func TestMnemonicSeed_NormalizesPassphrase(t *testing.T) {
+	// BIP-39 salts PBKDF2 with the NFKD form of the passphrase. The NFKD
+	// form of "Ünïcödé ﬁat" is spelled out by hand below: each accented
+	// letter decomposes into its base letter and a combining mark, and
+	// the "ﬁ" ligature into "fi".
+	mnemonic := bip39Vectors[0].mnemonic
+	composed := "\u00dcn\u00efc\u00f6d\u00e9 \ufb01at"
+	decomposed := "U\u0308ni\u0308co\u0308de\u0301 fiat"
+	want, _ := pbkdf2.Key(sha512.New, mnemonic, []byte("mnemonic"+decomposed), 2048, 64)
+
+	for _, passphrase := range []string{composed, decomposed} {
+		seed, err := MnemonicSeed(mnemonic, passphrase)
+		if err != nil {
+			t.Fatalf("MnemonicSeed failed: %v", err)
+		}
+		if !bytes.Equal(seed, want) {
+			t.Errorf("MnemonicSeed(%+q) = %x, want %x", passphrase, seed, want)
+		}
+	}
+	ascii, _ := MnemonicSeed(mnemonic, "TREZOR")
+	if hex.EncodeToString(ascii) != bip39Vectors[0].seed {
+		t.Error("Expected ASCII passphrases to be unchanged by normalization")
+	}
+}
+
+func TestBIP39Seed_JapaneseVectors(t *testing.T) {
+	// The first two vectors of the Japanese BIP-39 test suite
+	// (github.com/bip32JP/bip32JP.github.io, test_JP_BIP39.json). Their
+	// words are separated by ideographic spaces and their passphrase holds
+	// compatibility characters and voiced kana, all of which NFKD rewrites.
+	passphrase := "㍍ガバヴァぱばぐゞちぢ十人十色"
+	vectors := []struct{ mnemonic, seed string }{
+		{
+			strings.Repeat("あいこくしん　", 11) + "あおぞら",
+			"a262d6fb6122ecf45be09c50492b31f92e9beb7d9a845987a02cefda57a15f9c467a17872029a9e92299b5cbdf306e3a0ee620245cbd508959b6cb7ca637bd55",
+		},
+		{
+			"そつう　れきだい　ほんやく　わかす　りくつ　ばいか　ろせん　やちん　そつう　れきだい　ほんやく　わかめ",
+			"aee025cbe6ca256862f889e48110a6a382365142f7d16f2b9545285b3af64e542143a577e9c144e101a6bdca18f8d97ec3366ebf5b088b1c1af9bc31346e60d9",
+		},
+	}
+	for _, v := range vectors {
+		seed, err := bip39Seed(v.mnemonic, passphrase)
+		if err != nil {
+			t.Fatalf("bip39Seed failed: %v", err)
+		}
+		if got := hex.EncodeToString(seed); got != v.seed {
+			t.Errorf("bip39Seed(%q) = %s, want %s", v.mnemonic, got, v.seed)
+		}
+	}
+}
+func TestSplitMnemonicSeed(t *testing.T) {
+	mnemonic := bip39Vectors[2].mnemonic
+	shares, err := SplitMnemonicSeed(mnemonic, 5, 3, WithScheme(SchemeV2GF256))
+	if err != nil {
+		t.Fatalf("SplitMnemonicSeed failed: %v", err)
+	}
+	if len(shares[0].Value) != 32 {
+		t.Errorf("Expected shares of the 32-byte entropy, got %d bytes", len(shares[0].Value))
+	}
+
+	restored, err := CombineMnemonicSeed(shares[1:4], 3)
+	if err != nil {
+		t.Fatalf("CombineMnemonicSeed failed: %v", err)
+	}
+	if restored != mnemonic {
+		t.Errorf("Expected %q, got %q", mnemonic, restored)
+	}
+}
+
+func TestSplitMnemonicSeed_Invalid(t *testing.T) {
+	for _, m := range []string{
+		"abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon",
+		"abandon abandon abandon",
+		"abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon bitcoinz",
+	} {
+		if _, err := SplitMnemonicSeed(m, 3, 2); !errors.Is(err, ErrInvalidMnemonic) {
+			t.Errorf("Expected ErrInvalidMnemonic for %q, got %v", m, err)
+		}
+	}
+
+	shares, _ := Split([]byte("short"), 2, 2)
+	if _, err := CombineMnemonicSeed(shares, 2); err == nil {
+		t.Error("Expected error for entropy of invalid length")
+	}
+}
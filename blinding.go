@@ -0,0 +1,111 @@
+package goshamir
+
+import (
+	"fmt"
+	"io"
+)
+
+// WithBlinding enables or disables blinded reconstruction for Reconstructor
+// and the streaming combine APIs. Each combine then draws fresh random
+// masks from the configured random source: every share value is offset by
+// a mask, with the masks chosen so that their weighted sum is zero, and the
+// Lagrange basis is multiplied by a random factor that is removed from the
+// result. Intermediate values no longer correlate with the raw share
+// values, which reduces what power or electromagnetic side channels on
+// custodial hardware can observe.
+//
+// Blinding costs randomness and several field operations per share byte,
+// and it does not make the code constant time. It is off by default.
+func WithBlinding(enabled bool) Option {
+	return func(c *Config) {
+		c.Blinding = enabled
+	}
+}
+
+// combineBlinded reconstructs the secret from shares ordered like
+// r.indices, as sum((y_i + m_i) * β_i) / ρ, where β_i = ρ*λ_i and the
+// masks satisfy sum(λ_i * m_i) = 0.
+func (r *Reconstructor) combineBlinded(ordered []Share, scheme Scheme) ([]byte, error) {
+	lambda := append([]uint16(nil), r.basis...)
+	if scheme == SchemeV2GF256 {
+		for i, b := range r.basis256 {
+			lambda[i] = uint16(b)
+		}
+	}
+	k := len(lambda)
+
+	rho, err := randomNonZeroElement(r.config.Rand, scheme)
+	if err != nil {
+		return nil, err
+	}
+	rhoInv := fieldInv(scheme, rho)
+	beta := make([]uint16, k)
+	for i, l := range lambda {
+		beta[i] = fieldMul(scheme, l, rho)
+	}
+	defer clear(beta)
+
+	// The last mask cancels the weighted sum of the others.
+	lastInv := fieldInv(scheme, lambda[k-1])
+
+	values := make([][]uint16, k)
+	for i, s := range ordered {
+		v, err := valueElements(s.Value, scheme)
+		if err != nil {
+			return nil, fmt.Errorf("share with index %d: %w", r.indices[i], err)
+		}
+		values[i] = v
+	}
+	defer func() {
+		for _, v := range values {
+			clear(v)
+		}
+	}()
+
+	masks := make([]uint16, k)
+	defer clear(masks)
+	secret := make([]uint16, len(values[0]))
+	for pos := range secret {
+		if err := randomFieldElements(r.config.Rand, scheme, masks[:k-1]); err != nil {
+			return nil, err
+		}
+		var weighted uint16
+		for i, m := range masks[:k-1] {
+			weighted = fieldAdd(scheme, weighted, fieldMul(scheme, lambda[i], m))
+		}
+		masks[k-1] = fieldMul(scheme, fieldSub(scheme, 0, weighted), lastInv)
+
+		var acc uint16
+		for i := range values {
+			blinded := fieldAdd(scheme, values[i][pos], masks[i])
+			acc = fieldAdd(scheme, acc, fieldMul(scheme, blinded, beta[i]))
+		}
+		secret[pos] = fieldMul(scheme, acc, rhoInv)
+	}
+	defer clear(secret)
+
+	out := make([]byte, len(secret))
+	for i, e := range secret {
+		out[i] = byte(e % 256)
+	}
+	return out, nil
+}
+
+// fieldInv returns the multiplicative inverse of a non-zero element.
+func fieldInv(scheme Scheme, a uint16) uint16 {
+	if scheme == SchemeV2GF256 {
+		return uint16(gf256Inv(byte(a)))
+	}
+	return gfInv(a)
+}
+
+// randomNonZeroElement returns a uniformly random non-zero field element.
+func randomNonZeroElement(r io.Reader, scheme Scheme) (uint16, error) {
+	var e [1]uint16
+	for e[0] == 0 {
+		if err := randomFieldElements(r, scheme, e[:]); err != nil {
+			return 0, err
+		}
+	}
+	return e[0], nil
+}
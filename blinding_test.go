@@ -0,0 +1,60 @@
+package goshamir
+
+import (
+	"bytes"
+	"crypto/rand"
+	"io"
+	"testing"
+)
+
+// --- Blinding Tests ---
+
+func TestWithBlinding_Reconstructor(t *testing.T) {
+	secret := make([]byte, 300)
+	if _, err := rand.Read(secret); err != nil {
+		t.Fatalf("Failed to generate random secret: %v", err)
+	}
+
+	for _, scheme := range []Scheme{SchemeV1GF257, SchemeV2GF256} {
+		splitter, _ := NewSplitter(5, 3, WithScheme(scheme))
+		shares, err := splitter.Split(secret)
+		if err != nil {
+			t.Fatalf("Split failed: %v", err)
+		}
+
+		r, err := NewReconstructor([]uint8{5, 2, 3}, WithBlinding(true))
+		if err != nil {
+			t.Fatalf("NewReconstructor failed: %v", err)
+		}
+		recovered, err := r.Combine(shares)
+		if err != nil {
+			t.Fatalf("Combine failed: %v", err)
+		}
+		if !bytes.Equal(secret, recovered) {
+			t.Errorf("%s: blinded reconstruction does not match original", scheme)
+		}
+	}
+}
+
+func TestWithBlinding_UsesRandomness(t *testing.T) {
+	shares, _ := Split([]byte("masked"), 3, 2)
+	r, _ := NewReconstructor([]uint8{1, 2}, WithBlinding(true), WithRandom(failingReader{}))
+	if _, err := r.Combine(shares); err == nil {
+		t.Error("Expected error when the mask source fails")
+	}
+}
+
+func TestWithBlinding_Streams(t *testing.T) {
+	secret := bytes.Repeat([]byte("stream"), 1000)
+	bufs := []*bytes.Buffer{new(bytes.Buffer), new(bytes.Buffer), new(bytes.Buffer)}
+	if err := SplitStream([]io.Writer{bufs[0], bufs[1], bufs[2]}, bytes.NewReader(secret), 2); err != nil {
+		t.Fatalf("SplitStream failed: %v", err)
+	}
+	var out bytes.Buffer
+	if err := CombineStream(&out, []io.Reader{bufs[2], bufs[0]}, 2, WithBlinding(true)); err != nil {
+		t.Fatalf("CombineStream failed: %v", err)
+	}
+	if !bytes.Equal(secret, out.Bytes()) {
+		t.Error("Blinded stream reconstruction does not match original")
+	}
+}
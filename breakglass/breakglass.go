@@ -0,0 +1,376 @@
+// Package breakglass provides an emergency path for reconstructing a
+// secret with fewer shares than the normal threshold, for when custodians
+// are unreachable. Because a reduced quorum is easier to abuse, the path
+// is deliberately slow and loud: every attempt must carry a justification
+// signed by an authorized requester, every custodian is alerted when it is
+// opened, and reconstruction is refused until a delay has passed, giving
+// custodians time to cancel a request they did not expect.
+//
+// The reduced quorum only protects anything if the shares presented to it
+// are kept apart from the regular custodians, for example as a sealed
+// emergency share (see the timelock package) combined with one custodian's
+// share. A Guard keeps its requests in memory, so the process enforcing the
+// delay must outlive it; record alerts externally for a durable trail.
+package breakglass
+
+import (
+	"context"
+	"crypto"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"slices"
+	"sync"
+	"time"
+
+	goshamir "github.com/fawwazid/go-shamir"
+)
+
+// justificationMagic starts the signed form of a Justification and domain
+// separates its signatures.
+const justificationMagic = "goshamir breakglass justification v1"
+
+var (
+	// ErrUnauthorized is returned when a justification is not signed by
+	// one of the policy's requesters.
+	ErrUnauthorized = errors.New("justification not signed by an authorized requester")
+	// ErrDelayPending is returned by Guard.Combine before the delay of a
+	// request has passed.
+	ErrDelayPending = errors.New("break-glass delay has not passed")
+	// ErrUnknownRequest is returned for requests that were never opened.
+	ErrUnknownRequest = errors.New("unknown break-glass request")
+	// ErrRequestClosed is returned for requests that were cancelled or
+	// already used.
+	ErrRequestClosed = errors.New("break-glass request is closed")
+)
+
+// Justification explains why the break-glass path is used. It is signed by
+// the requester and sent to every custodian with the alert.
+type Justification struct {
+	Requester string `json:"requester"`
+	Reason    string `json:"reason"`
+	// Ticket optionally refers to an incident or change record.
+	Ticket    string            `json:"ticket,omitempty"`
+	Time      time.Time         `json:"time"`
+	PublicKey ed25519.PublicKey `json:"public_key"`
+	Signature []byte            `json:"signature"`
+}
+
+// Sign signs the justification with the requester's Ed25519 key, setting
+// PublicKey and, if zero, Time. The signer may be an ed25519.PrivateKey or
+// any crypto.Signer holding an Ed25519 key.
+func (j *Justification) Sign(signer crypto.Signer) error {
+	pub, ok := signer.Public().(ed25519.PublicKey)
+	if !ok {
+		return errors.New("signer must hold an Ed25519 key")
+	}
+	if j.Time.IsZero() {
+		j.Time = time.Now()
+	}
+	j.Time = j.Time.UTC().Truncate(time.Second)
+	j.PublicKey = pub
+	sig, err := signer.Sign(rand.Reader, j.signedMessage(), crypto.Hash(0))
+	if err != nil {
+		return fmt.Errorf("signing justification failed: %w", err)
+	}
+	j.Signature = sig
+	return nil
+}
+
+// Verify checks the justification's signature against its PublicKey. It
+// does not check that the key is authorized; Guard.Request does.
+func (j *Justification) Verify() error {
+	if j.Requester == "" || j.Reason == "" {
+		return errors.New("justification must name a requester and a reason")
+	}
+	if len(j.PublicKey) != ed25519.PublicKeySize || !ed25519.Verify(j.PublicKey, j.signedMessage(), j.Signature) {
+		return errors.New("justification signature is invalid")
+	}
+	return nil
+}
+
+func (j *Justification) signedMessage() []byte {
+	msg := appendField(nil, []byte(justificationMagic))
+	msg = appendField(msg, []byte(j.Requester))
+	msg = appendField(msg, []byte(j.Reason))
+	msg = appendField(msg, []byte(j.Ticket))
+	msg = binary.BigEndian.AppendUint64(msg, uint64(j.Time.Unix()))
+	return appendField(msg, j.PublicKey)
+}
+
+func appendField(b, field []byte) []byte {
+	b = binary.BigEndian.AppendUint32(b, uint32(len(field)))
+	return append(b, field...)
+}
+
+// Policy configures the break-glass path.
+type Policy struct {
+	// Threshold is the reduced number of shares that reconstruct the
+	// secret through the break-glass path.
+	Threshold int
+	// Delay is the minimum time between a request and reconstruction.
+	Delay time.Duration
+	// Custodians are alerted of every request, cancellation and
+	// reconstruction.
+	Custodians []string
+	// Requesters are the keys allowed to sign justifications.
+	Requesters []ed25519.PublicKey
+}
+
+// Validate checks that the policy is usable.
+func (p *Policy) Validate() error {
+	if p.Threshold < goshamir.MinThreshold || p.Threshold > goshamir.MaxShares {
+		return fmt.Errorf("threshold must be between %d and %d", goshamir.MinThreshold, goshamir.MaxShares)
+	}
+	if p.Delay <= 0 {
+		return errors.New("break-glass delay must be positive")
+	}
+	if len(p.Custodians) == 0 {
+		return errors.New("break-glass policy must name custodians to alert")
+	}
+	if len(p.Requesters) == 0 {
+		return errors.New("break-glass policy must name at least one requester")
+	}
+	for i, k := range p.Requesters {
+		if len(k) != ed25519.PublicKeySize {
+			return fmt.Errorf("requester key %d is invalid", i)
+		}
+	}
+	return nil
+}
+
+// AlertKind identifies a break-glass alert.
+type AlertKind string
+
+const (
+	// AlertRequested is sent when a request is opened.
+	AlertRequested AlertKind = "requested"
+	// AlertCancelled is sent when a request is cancelled.
+	AlertCancelled AlertKind = "cancelled"
+	// AlertReconstructed is sent when a request was used to reconstruct
+	// the secret.
+	AlertReconstructed AlertKind = "reconstructed"
+)
+
+// Alert is sent to each custodian. Alerts never carry share material.
+type Alert struct {
+	Kind      AlertKind `json:"kind"`
+	RequestID string    `json:"request_id"`
+	// Custodian is the custodian the alert is addressed to.
+	Custodian     string        `json:"custodian"`
+	Justification Justification `json:"justification"`
+	NotBefore     time.Time     `json:"not_before"`
+	// By names who cancelled the request, for AlertCancelled.
+	By   string    `json:"by,omitempty"`
+	Time time.Time `json:"time"`
+}
+
+// Text returns a one-line, human-readable description of the alert.
+func (a Alert) Text() string {
+	j := a.Justification
+	switch a.Kind {
+	case AlertRequested:
+		return fmt.Sprintf("%s: %s requested break-glass access (%s); it can proceed at %s unless cancelled.",
+			a.Custodian, j.Requester, j.Reason, a.NotBefore.Format(time.RFC3339))
+	case AlertCancelled:
+		return fmt.Sprintf("%s: break-glass request %s by %s was cancelled by %s.", a.Custodian, a.RequestID, j.Requester, a.By)
+	case AlertReconstructed:
+		return fmt.Sprintf("%s: break-glass request %s by %s was used to reconstruct the secret.", a.Custodian, a.RequestID, j.Requester)
+	}
+	return fmt.Sprintf("%s: break-glass request %s: %s.", a.Custodian, a.RequestID, a.Kind)
+}
+
+// Notifier delivers alerts to custodians.
+type Notifier interface {
+	Notify(ctx context.Context, a Alert) error
+}
+
+// NotifierFunc adapts a function to the Notifier interface.
+type NotifierFunc func(ctx context.Context, a Alert) error
+
+// Notify calls f(ctx, a).
+func (f NotifierFunc) Notify(ctx context.Context, a Alert) error {
+	return f(ctx, a)
+}
+
+// State is the state of a break-glass request.
+type State string
+
+const (
+	// StatePending means the request is waiting out its delay or ready.
+	StatePending State = "pending"
+	// StateCancelled means a custodian cancelled the request.
+	StateCancelled State = "cancelled"
+	// StateUsed means the request was used to reconstruct the secret.
+	StateUsed State = "used"
+)
+
+// Request is a break-glass request.
+type Request struct {
+	ID            string        `json:"id"`
+	Justification Justification `json:"justification"`
+	RequestedAt   time.Time     `json:"requested_at"`
+	NotBefore     time.Time     `json:"not_before"`
+	State         State         `json:"state"`
+}
+
+// Guard enforces a break-glass policy. It is safe for concurrent use.
+type Guard struct {
+	policy   Policy
+	notifier Notifier
+	now      func() time.Time
+
+	mu       sync.Mutex
+	requests map[string]*Request
+	order    []string
+}
+
+// Option configures a Guard.
+type Option func(*Guard)
+
+// WithClock sets the clock used for delays. Defaults to time.Now.
+func WithClock(now func() time.Time) Option {
+	return func(g *Guard) {
+		g.now = now
+	}
+}
+
+// New returns a Guard enforcing policy and alerting through notifier.
+func New(policy Policy, notifier Notifier, opts ...Option) (*Guard, error) {
+	if err := policy.Validate(); err != nil {
+		return nil, err
+	}
+	if notifier == nil {
+		return nil, errors.New("break-glass requires a notifier")
+	}
+	g := &Guard{policy: policy, notifier: notifier, now: time.Now, requests: make(map[string]*Request)}
+	for _, opt := range opts {
+		if opt != nil {
+			opt(g)
+		}
+	}
+	return g, nil
+}
+
+// Request opens a break-glass request after verifying its justification
+// and alerting every custodian. If any alert cannot be delivered, the
+// request is not opened and the error is returned, so the path cannot be
+// used silently.
+func (g *Guard) Request(ctx context.Context, j Justification) (Request, error) {
+	if err := j.Verify(); err != nil {
+		return Request{}, err
+	}
+	if !slices.ContainsFunc(g.policy.Requesters, func(k ed25519.PublicKey) bool { return k.Equal(j.PublicKey) }) {
+		return Request{}, ErrUnauthorized
+	}
+	sum := sha256.Sum256(j.Signature)
+	now := g.now()
+	r := Request{
+		ID:            hex.EncodeToString(sum[:8]),
+		Justification: j,
+		RequestedAt:   now,
+		NotBefore:     now.Add(g.policy.Delay),
+		State:         StatePending,
+	}
+	g.mu.Lock()
+	if _, ok := g.requests[r.ID]; ok {
+		g.mu.Unlock()
+		return Request{}, fmt.Errorf("justification already used for request %s", r.ID)
+	}
+	g.mu.Unlock()
+
+	if err := g.alert(ctx, AlertRequested, r, ""); err != nil {
+		return Request{}, err
+	}
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	if _, ok := g.requests[r.ID]; ok {
+		return Request{}, fmt.Errorf("justification already used for request %s", r.ID)
+	}
+	g.requests[r.ID] = &r
+	g.order = append(g.order, r.ID)
+	return r, nil
+}
+
+// Cancel closes a pending request, alerting every custodian. Any custodian
+// who did not expect the request should cancel it.
+func (g *Guard) Cancel(ctx context.Context, id, by string) error {
+	g.mu.Lock()
+	r, err := g.pending(id)
+	if err != nil {
+		g.mu.Unlock()
+		return err
+	}
+	r.State = StateCancelled
+	snapshot := *r
+	g.mu.Unlock()
+	return g.alert(ctx, AlertCancelled, snapshot, by)
+}
+
+// Combine reconstructs the secret from shares with the policy's reduced
+// threshold, once the request's delay has passed. Each request can be used
+// once. Custodians are alerted after reconstruction; a failed alert is
+// returned as an error alongside the secret.
+func (g *Guard) Combine(ctx context.Context, id string, shares []goshamir.Share) ([]byte, error) {
+	g.mu.Lock()
+	r, err := g.pending(id)
+	if err != nil {
+		g.mu.Unlock()
+		return nil, err
+	}
+	if now := g.now(); now.Before(r.NotBefore) {
+		g.mu.Unlock()
+		return nil, fmt.Errorf("%w: request %s can proceed in %s", ErrDelayPending, id, r.NotBefore.Sub(now).Round(time.Second))
+	}
+	secret, err := goshamir.Combine(shares, g.policy.Threshold)
+	if err != nil {
+		g.mu.Unlock()
+		return nil, err
+	}
+	r.State = StateUsed
+	snapshot := *r
+	g.mu.Unlock()
+	return secret, g.alert(ctx, AlertReconstructed, snapshot, "")
+}
+
+// Requests returns all requests, oldest first, for auditing.
+func (g *Guard) Requests() []Request {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	out := make([]Request, len(g.order))
+	for i, id := range g.order {
+		out[i] = *g.requests[id]
+	}
+	return out
+}
+
+// pending returns the pending request with the given ID. g.mu must be
+// held.
+func (g *Guard) pending(id string) (*Request, error) {
+	r, ok := g.requests[id]
+	if !ok {
+		return nil, ErrUnknownRequest
+	}
+	if r.State != StatePending {
+		return nil, fmt.Errorf("%w: request %s is %s", ErrRequestClosed, id, r.State)
+	}
+	return r, nil
+}
+
+// alert sends an alert to every custodian, returning the joined errors of
+// those that failed.
+func (g *Guard) alert(ctx context.Context, kind AlertKind, r Request, by string) error {
+	var errs []error
+	now := g.now()
+	for _, c := range g.policy.Custodians {
+		a := Alert{Kind: kind, RequestID: r.ID, Custodian: c, Justification: r.Justification, NotBefore: r.NotBefore, By: by, Time: now}
+		if err := g.notifier.Notify(context.WithoutCancel(ctx), a); err != nil {
+			errs = append(errs, fmt.Errorf("alerting %s: %w", c, err))
+		}
+	}
+	return errors.Join(errs...)
+}
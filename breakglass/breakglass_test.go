@@ -0,0 +1,186 @@
+package breakglass
+
+import (
+	"bytes"
+	"context"
+	"crypto/ed25519"
+	"errors"
+	"slices"
+	"sync"
+	"testing"
+	"time"
+
+	goshamir "github.com/fawwazid/go-shamir"
+)
+
+// --- Break-Glass Tests ---
+
+type alertRecorder struct {
+	mu     sync.Mutex
+	alerts []Alert
+	fail   bool
+}
+
+func (r *alertRecorder) Notify(_ context.Context, a Alert) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.fail {
+		return errors.New("pager unreachable")
+	}
+	r.alerts = append(r.alerts, a)
+	return nil
+}
+
+func (r *alertRecorder) custodians(kind AlertKind) []string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var out []string
+	for _, a := range r.alerts {
+		if a.Kind == kind {
+			out = append(out, a.Custodian)
+		}
+	}
+	return out
+}
+
+func newTestGuard(t *testing.T, now *time.Time) (*Guard, *alertRecorder, ed25519.PrivateKey) {
+	t.Helper()
+	pub, priv, err := ed25519.GenerateKey(nil)
+	if err != nil {
+		t.Fatalf("GenerateKey failed: %v", err)
+	}
+	rec := &alertRecorder{}
+	g, err := New(Policy{
+		Threshold:  2,
+		Delay:      24 * time.Hour,
+		Custodians: []string{"alice", "bob", "carol"},
+		Requesters: []ed25519.PublicKey{pub},
+	}, rec, WithClock(func() time.Time { return *now }))
+	if err != nil {
+		t.Fatalf("New failed: %v", err)
+	}
+	return g, rec, priv
+}
+
+func signedJustification(t *testing.T, key ed25519.PrivateKey, reason string) Justification {
+	t.Helper()
+	j := Justification{Requester: "oncall", Reason: reason, Ticket: "INC-42"}
+	if err := j.Sign(key); err != nil {
+		t.Fatalf("Sign failed: %v", err)
+	}
+	return j
+}
+
+func TestBreakGlass_DelayThenCombine(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2026, 3, 1, 9, 0, 0, 0, time.UTC)
+	g, rec, key := newTestGuard(t, &now)
+	secret := []byte("root credentials")
+	shares, _ := goshamir.Split(secret, 3, 2)
+
+	r, err := g.Request(ctx, signedJustification(t, key, "custodians unreachable during outage"))
+	if err != nil {
+		t.Fatalf("Request failed: %v", err)
+	}
+	if got := rec.custodians(AlertRequested); !slices.Equal(got, []string{"alice", "bob", "carol"}) {
+		t.Fatalf("alerted %v", got)
+	}
+
+	now = now.Add(23 * time.Hour)
+	if _, err := g.Combine(ctx, r.ID, shares[:2]); !errors.Is(err, ErrDelayPending) {
+		t.Fatalf("expected ErrDelayPending, got %v", err)
+	}
+
+	now = now.Add(time.Hour)
+	got, err := g.Combine(ctx, r.ID, shares[:2])
+	if err != nil {
+		t.Fatalf("Combine failed: %v", err)
+	}
+	if !bytes.Equal(got, secret) {
+		t.Fatalf("Combine = %q, want %q", got, secret)
+	}
+	if len(rec.custodians(AlertReconstructed)) != 3 {
+		t.Fatal("custodians not alerted of reconstruction")
+	}
+	if _, err := g.Combine(ctx, r.ID, shares[:2]); !errors.Is(err, ErrRequestClosed) {
+		t.Fatalf("expected ErrRequestClosed on reuse, got %v", err)
+	}
+	if reqs := g.Requests(); len(reqs) != 1 || reqs[0].State != StateUsed {
+		t.Fatalf("Requests = %+v", reqs)
+	}
+}
+
+func TestBreakGlass_Cancel(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+	g, rec, key := newTestGuard(t, &now)
+	r, err := g.Request(ctx, signedJustification(t, key, "suspicious"))
+	if err != nil {
+		t.Fatalf("Request failed: %v", err)
+	}
+	if err := g.Cancel(ctx, r.ID, "bob"); err != nil {
+		t.Fatalf("Cancel failed: %v", err)
+	}
+	if len(rec.custodians(AlertCancelled)) != 3 {
+		t.Fatal("custodians not alerted of cancellation")
+	}
+	now = now.Add(48 * time.Hour)
+	shares, _ := goshamir.Split([]byte("x"), 2, 2)
+	if _, err := g.Combine(ctx, r.ID, shares); !errors.Is(err, ErrRequestClosed) {
+		t.Fatalf("expected ErrRequestClosed, got %v", err)
+	}
+}
+
+func TestBreakGlass_RejectsUnauthorizedAndTampered(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+	g, _, key := newTestGuard(t, &now)
+
+	_, other, _ := ed25519.GenerateKey(nil)
+	if _, err := g.Request(ctx, signedJustification(t, other, "let me in")); !errors.Is(err, ErrUnauthorized) {
+		t.Fatalf("expected ErrUnauthorized, got %v", err)
+	}
+
+	j := signedJustification(t, key, "planned drill")
+	j.Reason = "something else"
+	if _, err := g.Request(ctx, j); err == nil {
+		t.Fatal("expected error for tampered justification")
+	}
+
+	if _, err := g.Combine(ctx, "nope", nil); !errors.Is(err, ErrUnknownRequest) {
+		t.Fatalf("expected ErrUnknownRequest, got %v", err)
+	}
+}
+
+func TestBreakGlass_AlertFailureBlocksRequest(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+	g, rec, key := newTestGuard(t, &now)
+	rec.fail = true
+	if _, err := g.Request(ctx, signedJustification(t, key, "outage")); err == nil {
+		t.Fatal("expected error when alerts cannot be delivered")
+	}
+	if len(g.Requests()) != 0 {
+		t.Fatal("request opened without alerting custodians")
+	}
+}
+
+func TestBreakGlass_PolicyValidate(t *testing.T) {
+	pub, _, _ := ed25519.GenerateKey(nil)
+	valid := Policy{Threshold: 2, Delay: time.Hour, Custodians: []string{"a"}, Requesters: []ed25519.PublicKey{pub}}
+	if err := valid.Validate(); err != nil {
+		t.Fatalf("Validate failed: %v", err)
+	}
+	for name, mutate := range map[string]func(*Policy){
+		"threshold":  func(p *Policy) { p.Threshold = 1 },
+		"delay":      func(p *Policy) { p.Delay = 0 },
+		"custodians": func(p *Policy) { p.Custodians = nil },
+		"requesters": func(p *Policy) { p.Requesters = nil },
+	} {
+		p := valid
+		mutate(&p)
+		if err := p.Validate(); err == nil {
+			t.Errorf("%s: expected validation error", name)
+		}
+	}
+}
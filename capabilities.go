@@ -0,0 +1,79 @@
+package goshamir
+
+import "runtime/debug"
+
+// modulePath is the import path of this module, used to find its version in
+// the build information.
+const modulePath = "github.com/fawwazid/go-shamir"
+
+// SchemeInfo describes a supported scheme.
+type SchemeInfo struct {
+	Scheme Scheme
+	// Field names the arithmetic field, such as "GF(2^8)".
+	Field string
+	// ShareOverhead is the number of share value bytes per secret byte.
+	ShareOverhead int
+	// MaxSecretSize is the largest secret, in bytes, whose shares can be
+	// encoded with MarshalBinary. Split and Combine are otherwise bounded
+	// only by memory, and the streaming APIs not at all.
+	MaxSecretSize int
+}
+
+// CapabilityInfo describes what this build of the library supports, so
+// applications can adapt their limits and report them in diagnostics.
+type CapabilityInfo struct {
+	// Version is the module version the program was built with, or
+	// "(devel)" when it cannot be determined.
+	Version string
+	// Schemes lists the supported schemes, oldest first.
+	Schemes []SchemeInfo
+	// DefaultScheme is the scheme used when none is selected.
+	DefaultScheme Scheme
+	MinThreshold  int
+	MaxShares     int
+	// StreamChunkSize is the number of secret bytes per chunk of the
+	// streaming APIs.
+	StreamChunkSize int
+	// SIMD reports whether field arithmetic uses vector instructions. All
+	// arithmetic is currently portable Go, so it is false.
+	SIMD bool
+	// Backend names the field arithmetic implementation.
+	Backend string
+}
+
+// Capabilities returns the schemes and limits supported by this build.
+func Capabilities() CapabilityInfo {
+	info := CapabilityInfo{
+		DefaultScheme:   SchemeV1GF257,
+		MinThreshold:    MinThreshold,
+		MaxShares:       MaxShares,
+		StreamChunkSize: streamChunkSize,
+		Backend:         "generic",
+	}
+	for _, s := range []Scheme{SchemeV1GF257, SchemeV2GF256} {
+		field := "GF(257)"
+		if s == SchemeV2GF256 {
+			field = "GF(2^8)"
+		}
+		info.Schemes = append(info.Schemes, SchemeInfo{
+			Scheme:        s,
+			Field:         field,
+			ShareOverhead: s.bytesPerElement(),
+			MaxSecretSize: maxEnvelopeField / s.bytesPerElement(),
+		})
+	}
+	if bi, ok := debug.ReadBuildInfo(); ok {
+		if bi.Main.Path == modulePath {
+			info.Version = bi.Main.Version
+		}
+		for _, dep := range bi.Deps {
+			if dep.Path == modulePath {
+				info.Version = dep.Version
+			}
+		}
+	}
+	if info.Version == "" {
+		info.Version = "(devel)"
+	}
+	return info
+}
@@ -0,0 +1,30 @@
+package goshamir
+
+import "testing"
+
+// --- Capabilities Tests ---
+
+func TestCapabilities(t *testing.T) {
+	c := Capabilities()
+	if c.MaxShares != MaxShares || c.MinThreshold != MinThreshold || c.DefaultScheme != NewConfig().Scheme {
+		t.Errorf("Unexpected limits: %+v", c)
+	}
+	if c.Version == "" || c.Backend == "" || c.SIMD {
+		t.Errorf("Unexpected build info: %+v", c)
+	}
+	if len(c.Schemes) != 2 {
+		t.Fatalf("Expected 2 schemes, got %d", len(c.Schemes))
+	}
+	for _, s := range c.Schemes {
+		if !s.Scheme.Supported() || s.Field == "" || s.MaxSecretSize <= 0 {
+			t.Errorf("Unexpected scheme info: %+v", s)
+		}
+		// A secret of MaxSecretSize bytes must fit in an envelope.
+		if s.MaxSecretSize*s.ShareOverhead > maxEnvelopeField {
+			t.Errorf("Scheme %s: MaxSecretSize %d exceeds the envelope limit", s.Scheme, s.MaxSecretSize)
+		}
+	}
+	if c.Schemes[1].ShareOverhead != 1 || c.Schemes[0].ShareOverhead != 2 {
+		t.Errorf("Unexpected overheads: %+v", c.Schemes)
+	}
+}
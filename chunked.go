@@ -0,0 +1,252 @@
+package goshamir
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash"
+	"io"
+)
+
+const (
+	// chunkHeaderSize is the size of the flags byte and length prefix.
+	chunkHeaderSize = 5
+	// chunkTagSize is the size of the HMAC-SHA256 tag following each payload.
+	chunkTagSize = sha256.Size
+	// chunkOverhead is the framing cost of a single chunk.
+	chunkOverhead = chunkHeaderSize + chunkTagSize
+	// maxChunkPayload bounds the payload of a single chunk so a corrupted
+	// length prefix cannot trigger an unbounded allocation.
+	maxChunkPayload = 1 << 24
+
+	chunkFlagFinal = 1
+)
+
+// ErrChunkCorrupt is returned when a chunk fails authentication.
+var ErrChunkCorrupt = errors.New("chunk authentication failed")
+
+// ErrChunkTruncated is returned when a chunked stream ends before its final
+// chunk.
+var ErrChunkTruncated = errors.New("chunked stream truncated")
+
+// ChunkError reports which chunk of a chunked stream could not be read, so
+// corruption in a large share can be localized.
+type ChunkError struct {
+	Chunk uint64
+	Err   error
+}
+
+func (e *ChunkError) Error() string {
+	return fmt.Sprintf("chunk %d: %v", e.Chunk, e.Err)
+}
+
+func (e *ChunkError) Unwrap() error {
+	return e.Err
+}
+
+// ChunkWriter frames data written to it as authenticated chunks. Each chunk
+// is laid out as
+//
+//	flags (1 byte) | payload length (4 bytes, big-endian) | payload | tag
+//
+// where tag is HMAC-SHA256 over the chunk sequence number, the flags, the
+// length and the payload. Close appends an empty final chunk so truncation
+// is detectable.
+type ChunkWriter struct {
+	w      io.Writer
+	mac    hash.Hash
+	seq    uint64
+	closed bool
+	frame  []byte
+}
+
+// NewChunkWriter returns a ChunkWriter authenticating chunks with key.
+func NewChunkWriter(w io.Writer, key []byte) *ChunkWriter {
+	return newChunkWriterAt(w, key, 0)
+}
+
+// newChunkWriterAt returns a ChunkWriter whose first chunk has sequence
+// number seq, for appending to a partially written stream.
+func newChunkWriterAt(w io.Writer, key []byte, seq uint64) *ChunkWriter {
+	return &ChunkWriter{w: w, mac: hmac.New(sha256.New, key), seq: seq}
+}
+
+// Write writes p as one chunk, or several if p exceeds the maximum chunk
+// payload. Each call to Write produces at least one chunk, so callers
+// control the granularity at which corruption is localized.
+func (cw *ChunkWriter) Write(p []byte) (int, error) {
+	if cw.closed {
+		return 0, errors.New("write to closed chunk writer")
+	}
+	written := 0
+	for len(p) > 0 {
+		n := min(len(p), maxChunkPayload)
+		if err := cw.writeChunk(0, p[:n]); err != nil {
+			return written, err
+		}
+		written += n
+		p = p[n:]
+	}
+	return written, nil
+}
+
+// Close writes the final chunk. It does not close the underlying writer.
+func (cw *ChunkWriter) Close() error {
+	if cw.closed {
+		return nil
+	}
+	cw.closed = true
+	return cw.writeChunk(chunkFlagFinal, nil)
+}
+
+func (cw *ChunkWriter) writeChunk(flags byte, payload []byte) error {
+	frame := cw.frame[:0]
+	frame = append(frame, flags)
+	frame = binary.BigEndian.AppendUint32(frame, uint32(len(payload)))
+	frame = append(frame, payload...)
+	frame = chunkTag(cw.mac, cw.seq, frame[:chunkHeaderSize], payload, frame)
+	cw.frame = frame
+
+	_, err := cw.w.Write(frame)
+	clear(frame)
+	if err != nil {
+		return err
+	}
+	cw.seq++
+	return nil
+}
+
+// ChunkReader verifies and unframes a stream written by ChunkWriter.
+// Payload bytes are only returned once their chunk has been authenticated.
+type ChunkReader struct {
+	r       io.Reader
+	mac     hash.Hash
+	seq     uint64
+	pending []byte
+	buf     []byte
+	err     error
+}
+
+// NewChunkReader returns a ChunkReader verifying chunks with key.
+func NewChunkReader(r io.Reader, key []byte) *ChunkReader {
+	return &ChunkReader{r: r, mac: hmac.New(sha256.New, key)}
+}
+
+// Read returns authenticated payload bytes. A chunk that fails verification
+// yields a *ChunkError wrapping ErrChunkCorrupt; a stream missing its final
+// chunk yields one wrapping ErrChunkTruncated.
+func (cr *ChunkReader) Read(p []byte) (int, error) {
+	for len(cr.pending) == 0 {
+		if cr.err != nil {
+			return 0, cr.err
+		}
+		cr.err = cr.nextChunk()
+	}
+	n := copy(p, cr.pending)
+	cr.pending = cr.pending[n:]
+	return n, nil
+}
+
+func (cr *ChunkReader) nextChunk() error {
+	var header [chunkHeaderSize]byte
+	if _, err := io.ReadFull(cr.r, header[:]); err != nil {
+		if err == io.EOF || err == io.ErrUnexpectedEOF {
+			return &ChunkError{Chunk: cr.seq, Err: ErrChunkTruncated}
+		}
+		return err
+	}
+	flags := header[0]
+	length := binary.BigEndian.Uint32(header[1:])
+	if flags&^chunkFlagFinal != 0 || length > maxChunkPayload || (flags == chunkFlagFinal && length != 0) {
+		return &ChunkError{Chunk: cr.seq, Err: ErrChunkCorrupt}
+	}
+
+	if cap(cr.buf) < int(length)+chunkTagSize {
+		cr.buf = make([]byte, int(length)+chunkTagSize)
+	}
+	body := cr.buf[:int(length)+chunkTagSize]
+	if _, err := io.ReadFull(cr.r, body); err != nil {
+		if err == io.EOF || err == io.ErrUnexpectedEOF {
+			return &ChunkError{Chunk: cr.seq, Err: ErrChunkTruncated}
+		}
+		return err
+	}
+	payload, tag := body[:length], body[length:]
+	expected := chunkTag(cr.mac, cr.seq, header[:], payload, nil)
+	if !hmac.Equal(tag, expected) {
+		return &ChunkError{Chunk: cr.seq, Err: ErrChunkCorrupt}
+	}
+
+	cr.seq++
+	if flags == chunkFlagFinal {
+		return io.EOF
+	}
+	cr.pending = payload
+	return nil
+}
+
+// chunkTag appends the tag of a chunk to dst.
+func chunkTag(mac hash.Hash, seq uint64, header, payload, dst []byte) []byte {
+	var seqBytes [8]byte
+	binary.BigEndian.PutUint64(seqBytes[:], seq)
+	mac.Reset()
+	mac.Write(seqBytes[:])
+	mac.Write(header)
+	mac.Write(payload)
+	return mac.Sum(dst)
+}
+
+// WithChunkMAC makes the streaming APIs frame each share stream as
+// authenticated chunks (see ChunkWriter), keyed per share from key. The
+// same key must be supplied to CombineStream. If the key is not secret the
+// framing still detects and localizes accidental corruption, but not
+// deliberate tampering. A nil key disables the option; an empty key is
+// rejected by NewSplitter and the streaming combine APIs. The key is
+// copied, so later changes to key do not affect streams in progress.
+func WithChunkMAC(key []byte) Option {
+	return func(c *Config) {
+		c.ChunkMACKey = bytes.Clone(key)
+	}
+}
+
+// errEmptyChunkMACKey is returned when WithChunkMAC is given an empty key.
+var errEmptyChunkMACKey = errors.New("chunk MAC key cannot be empty")
+
+// validateChunkMACKey rejects an empty but non-nil chunk MAC key, which
+// would otherwise enable framing keyed with nothing.
+func validateChunkMACKey(cfg Config) error {
+	if cfg.ChunkMACKey != nil && len(cfg.ChunkMACKey) == 0 {
+		return errEmptyChunkMACKey
+	}
+	return nil
+}
+
+// chunkShareKey derives the chunk authentication key of one share stream, so
+// chunks cannot be moved between shares undetected.
+func chunkShareKey(key []byte, index uint8) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte("goshamir chunk mac"))
+	mac.Write([]byte{index})
+	return mac.Sum(nil)
+}
+
+// chunkedSecretSize estimates the secret size held in a chunked share
+// stream of the given size (excluding the stream header).
+func chunkedSecretSize(size int64, scheme Scheme) int64 {
+	size -= chunkOverhead
+	if size < 0 {
+		return -1
+	}
+	width := int64(scheme.bytesPerElement())
+	frame := width*streamChunkSize + chunkOverhead
+	full, rem := size/frame, size%frame
+	if rem > chunkOverhead {
+		rem -= chunkOverhead
+	} else {
+		rem = 0
+	}
+	return full*streamChunkSize + rem/width
+}
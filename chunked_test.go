@@ -0,0 +1,162 @@
+package goshamir
+
+import (
+	"bytes"
+	"crypto/rand"
+	"errors"
+	"io"
+	"testing"
+)
+
+// --- Chunked Format Tests ---
+
+func TestChunkWriter_RoundTrip(t *testing.T) {
+	key := []byte("integrity key")
+	var buf bytes.Buffer
+	cw := NewChunkWriter(&buf, key)
+	for _, part := range []string{"first", "second", "third"} {
+		if _, err := cw.Write([]byte(part)); err != nil {
+			t.Fatalf("Write failed: %v", err)
+		}
+	}
+	if err := cw.Close(); err != nil {
+		t.Fatalf("Close failed: %v", err)
+	}
+
+	got, err := io.ReadAll(NewChunkReader(&buf, key))
+	if err != nil {
+		t.Fatalf("ReadAll failed: %v", err)
+	}
+	if string(got) != "firstsecondthird" {
+		t.Errorf("Expected %q, got %q", "firstsecondthird", got)
+	}
+}
+
+func TestChunkReader_LocalizesCorruption(t *testing.T) {
+	key := []byte("integrity key")
+	var buf bytes.Buffer
+	cw := NewChunkWriter(&buf, key)
+	cw.Write([]byte("aaaa"))
+	cw.Write([]byte("bbbb"))
+	cw.Write([]byte("cccc"))
+	cw.Close()
+
+	data := buf.Bytes()
+	// Flip a payload byte of the second chunk.
+	data[chunkOverhead+4+chunkHeaderSize] ^= 0x01
+
+	r := NewChunkReader(bytes.NewReader(data), key)
+	got, err := io.ReadAll(r)
+	var chunkErr *ChunkError
+	if !errors.As(err, &chunkErr) || !errors.Is(err, ErrChunkCorrupt) {
+		t.Fatalf("Expected ChunkError wrapping ErrChunkCorrupt, got %v", err)
+	}
+	if chunkErr.Chunk != 1 {
+		t.Errorf("Expected corruption in chunk 1, got chunk %d", chunkErr.Chunk)
+	}
+	if string(got) != "aaaa" {
+		t.Errorf("Expected verified prefix %q, got %q", "aaaa", got)
+	}
+}
+
+func TestChunkReader_DetectsTruncationAndWrongKey(t *testing.T) {
+	var buf bytes.Buffer
+	cw := NewChunkWriter(&buf, []byte("k1"))
+	cw.Write([]byte("payload"))
+	cw.Close()
+	data := buf.Bytes()
+
+	_, err := io.ReadAll(NewChunkReader(bytes.NewReader(data[:len(data)-chunkOverhead]), []byte("k1")))
+	if !errors.Is(err, ErrChunkTruncated) {
+		t.Errorf("Expected ErrChunkTruncated, got %v", err)
+	}
+
+	_, err = io.ReadAll(NewChunkReader(bytes.NewReader(data), []byte("k2")))
+	if !errors.Is(err, ErrChunkCorrupt) {
+		t.Errorf("Expected ErrChunkCorrupt, got %v", err)
+	}
+}
+
+func TestSplitStream_ChunkMAC(t *testing.T) {
+	key := []byte("stream integrity key")
+	secret := make([]byte, 2*streamChunkSize+5)
+	if _, err := rand.Read(secret); err != nil {
+		t.Fatalf("Failed to generate random secret: %v", err)
+	}
+
+	bufs := []*bytes.Buffer{new(bytes.Buffer), new(bytes.Buffer), new(bytes.Buffer)}
+	writers := []io.Writer{bufs[0], bufs[1], bufs[2]}
+	if err := SplitStream(writers, bytes.NewReader(secret), 2, WithChunkMAC(key)); err != nil {
+		t.Fatalf("SplitStream failed: %v", err)
+	}
+
+	var total int64
+	progress := func(_, n int64) { total = n }
+	var out bytes.Buffer
+	readers := []io.Reader{bytes.NewReader(bufs[1].Bytes()), bytes.NewReader(bufs[2].Bytes())}
+	if err := CombineStream(&out, readers, 2, WithChunkMAC(key), WithProgress(progress)); err != nil {
+		t.Fatalf("CombineStream failed: %v", err)
+	}
+	if !bytes.Equal(secret, out.Bytes()) {
+		t.Error("Recovered secret does not match original")
+	}
+	if total != int64(len(secret)) {
+		t.Errorf("Expected total %d, got %d", len(secret), total)
+	}
+
+	// Corrupt the last chunk of one share stream.
+	corrupted := append([]byte(nil), bufs[2].Bytes()...)
+	corrupted[len(corrupted)-chunkOverhead-1] ^= 0x80
+	out.Reset()
+	readers = []io.Reader{bytes.NewReader(bufs[1].Bytes()), bytes.NewReader(corrupted)}
+	err := CombineStream(&out, readers, 2, WithChunkMAC(key))
+	var chunkErr *ChunkError
+	if !errors.As(err, &chunkErr) || chunkErr.Chunk != 2 {
+		t.Fatalf("Expected corruption in chunk 2, got %v", err)
+	}
+	if !bytes.Equal(secret[:2*streamChunkSize], out.Bytes()) {
+		t.Error("Expected the chunks before the corruption to be recovered")
+	}
+}
+
+func TestWithChunkMAC_EmptyKey(t *testing.T) {
+	if _, err := NewSplitter(3, 2, WithChunkMAC([]byte{})); !errors.Is(err, errEmptyChunkMACKey) {
+		t.Fatalf("NewSplitter: expected errEmptyChunkMACKey, got %v", err)
+	}
+	writers := []io.Writer{new(bytes.Buffer), new(bytes.Buffer), new(bytes.Buffer)}
+	if err := SplitStream(writers, bytes.NewReader([]byte("secret")), 2, WithChunkMAC([]byte{})); !errors.Is(err, errEmptyChunkMACKey) {
+		t.Fatalf("SplitStream: expected errEmptyChunkMACKey, got %v", err)
+	}
+	readers := []io.Reader{bytes.NewReader([]byte{1}), bytes.NewReader([]byte{2})}
+	if err := CombineStream(io.Discard, readers, 2, WithChunkMAC([]byte{})); !errors.Is(err, errEmptyChunkMACKey) {
+		t.Fatalf("CombineStream: expected errEmptyChunkMACKey, got %v", err)
+	}
+
+	// A nil key leaves the streams unframed.
+	if err := SplitStream(writers, bytes.NewReader([]byte("secret")), 2, WithChunkMAC(nil)); err != nil {
+		t.Fatalf("SplitStream with a nil key failed: %v", err)
+	}
+}
+
+func TestWithChunkMAC_CopiesKey(t *testing.T) {
+	key := []byte("stream integrity key")
+	s, err := NewStreamSplitter(2, 2, WithChunkMAC(key))
+	if err != nil {
+		t.Fatalf("NewStreamSplitter failed: %v", err)
+	}
+	// Wiping the caller's key must not change the key of the splitter.
+	clear(key)
+	bufs := []*bytes.Buffer{new(bytes.Buffer), new(bytes.Buffer)}
+	if err := s.Split([]io.Writer{bufs[0], bufs[1]}, bytes.NewReader([]byte("secret"))); err != nil {
+		t.Fatalf("Split failed: %v", err)
+	}
+
+	var out bytes.Buffer
+	readers := []io.Reader{bufs[0], bufs[1]}
+	if err := CombineStream(&out, readers, 2, WithChunkMAC([]byte("stream integrity key"))); err != nil {
+		t.Fatalf("CombineStream failed: %v", err)
+	}
+	if out.String() != "secret" {
+		t.Errorf("Expected %q, got %q", "secret", out.String())
+	}
+}
@@ -0,0 +1,57 @@
+package main
+
+import (
+	"errors"
+	"net"
+	"time"
+)
+
+// writeTimeout bounds how long a client may take to read the secret.
+const writeTimeout = 5 * time.Second
+
+// agent serves a secret to allowed local peers.
+type agent struct {
+	secret  *lockedBuffer
+	allowed map[uint32]bool
+	logf    func(format string, args ...any)
+}
+
+// serve accepts connections until ln is closed.
+func (a *agent) serve(ln *net.UnixListener) error {
+	for {
+		conn, err := ln.AcceptUnix()
+		if errors.Is(err, net.ErrClosed) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		go a.handle(conn)
+	}
+}
+
+func (a *agent) handle(conn *net.UnixConn) {
+	defer conn.Close()
+	cred, err := peerCred(conn)
+	if err != nil {
+		a.logf("rejecting connection: %v", err)
+		return
+	}
+	if !a.allowed[cred.uid] {
+		a.logf("rejecting connection from uid %d pid %d", cred.uid, cred.pid)
+		return
+	}
+	conn.SetWriteDeadline(time.Now().Add(writeTimeout))
+	if _, err := conn.Write(a.secret.bytes()); err != nil {
+		a.logf("sending secret to pid %d failed: %v", cred.pid, err)
+		return
+	}
+	a.logf("served secret to uid %d pid %d", cred.uid, cred.pid)
+}
+
+// peer identifies the process at the other end of a connection.
+type peer struct {
+	pid int32
+	uid uint32
+	gid uint32
+}
@@ -0,0 +1,127 @@
+//go:build linux
+
+package main
+
+import (
+	"io"
+	"net"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	goshamir "github.com/fawwazid/go-shamir"
+)
+
+// startAgent serves secret on a temporary socket for the given UIDs.
+func startAgent(t *testing.T, secret []byte, allowed map[uint32]bool) string {
+	t.Helper()
+	buf, err := newLockedBuffer(secret)
+	if err != nil {
+		t.Skipf("locked memory unavailable: %v", err)
+	}
+	t.Cleanup(buf.destroy)
+
+	path := filepath.Join(t.TempDir(), "agent.sock")
+	ln, err := listen(path, allowed)
+	if err != nil {
+		t.Fatalf("listen failed: %v", err)
+	}
+	t.Cleanup(func() { ln.Close() })
+
+	a := &agent{secret: buf, allowed: allowed, logf: t.Logf}
+	go a.serve(ln)
+	return path
+}
+
+func readAgent(t *testing.T, path string) []byte {
+	t.Helper()
+	conn, err := net.Dial("unix", path)
+	if err != nil {
+		t.Fatalf("Dial failed: %v", err)
+	}
+	defer conn.Close()
+	data, err := io.ReadAll(conn)
+	if err != nil {
+		t.Fatalf("Read failed: %v", err)
+	}
+	return data
+}
+
+// --- Agent Tests ---
+
+func TestAgent_ServesAllowedPeer(t *testing.T) {
+	path := startAgent(t, []byte("agent secret"), map[uint32]bool{uint32(os.Getuid()): true})
+	if got := readAgent(t, path); string(got) != "agent secret" {
+		t.Errorf("Expected %q, got %q", "agent secret", got)
+	}
+	info, err := os.Stat(path)
+	if err != nil {
+		t.Fatalf("Stat failed: %v", err)
+	}
+	if info.Mode().Perm() != 0o600 {
+		t.Errorf("Expected socket mode 0600, got %v", info.Mode().Perm())
+	}
+}
+
+func TestAgent_RejectsOtherPeers(t *testing.T) {
+	path := startAgent(t, []byte("agent secret"), map[uint32]bool{uint32(os.Getuid()) + 1: true})
+	if got := readAgent(t, path); len(got) != 0 {
+		t.Errorf("Expected no data for a disallowed peer, got %q", got)
+	}
+}
+
+func TestLockedBuffer_ReadOnly(t *testing.T) {
+	buf, err := newLockedBuffer([]byte("abc"))
+	if err != nil {
+		t.Skipf("locked memory unavailable: %v", err)
+	}
+	if string(buf.bytes()) != "abc" {
+		t.Errorf("Expected %q, got %q", "abc", buf.bytes())
+	}
+	buf.destroy()
+}
+
+// --- Command Tests ---
+
+func TestCombineShareFiles(t *testing.T) {
+	shares, _ := goshamir.Split([]byte("from files"), 3, 2)
+	encoded, _ := goshamir.EncodeSharesToHex(shares)
+	dir := t.TempDir()
+	a := filepath.Join(dir, "alice.share")
+	b := filepath.Join(dir, "bob.share")
+	os.WriteFile(a, []byte("alice "+encoded[0]+"\n"), 0o600)
+	os.WriteFile(b, []byte(encoded[2]+"\n"), 0o600)
+
+	secret, err := combineShareFiles([]string{a, b}, nil, 2)
+	if err != nil {
+		t.Fatalf("combineShareFiles failed: %v", err)
+	}
+	if string(secret) != "from files" {
+		t.Errorf("Expected %q, got %q", "from files", secret)
+	}
+
+	secret, err = combineShareFiles(nil, strings.NewReader(encoded[1]+"\n"+encoded[0]), 2)
+	if err != nil || string(secret) != "from files" {
+		t.Errorf("Reading from stdin failed: %q, %v", secret, err)
+	}
+	if _, err := combineShareFiles(nil, strings.NewReader("\n"), 2); err == nil {
+		t.Error("Expected error for no shares")
+	}
+	if _, err := combineShareFiles([]string{filepath.Join(dir, "missing")}, nil, 2); err == nil {
+		t.Error("Expected error for missing file")
+	}
+}
+
+func TestRun_InvalidArgs(t *testing.T) {
+	var stderr strings.Builder
+	if err := run(nil, strings.NewReader(""), &stderr); err == nil {
+		t.Error("Expected error without -k and -socket")
+	}
+	if err := run([]string{"-k", "2", "-socket", "x", "-allow-uid", "root"}, strings.NewReader(""), &stderr); err == nil {
+		t.Error("Expected error for non-numeric uid")
+	}
+	if _, err := parseUIDs("0, 1000"); err != nil {
+		t.Errorf("parseUIDs failed: %v", err)
+	}
+}
@@ -0,0 +1,177 @@
+// Command shamir-agent reconstructs a secret from shares at start-up and
+// serves it to local processes over a Unix socket, so that applications
+// never need access to the share files themselves.
+//
+// Usage:
+//
+//	shamir-agent -k threshold -socket path [-allow-uid uids] [share-file ...]
+//
+// Shares are read one per line from the given files or, if none are given,
+// from standard input. A line may start with a label, as printed by
+// shamir split, which is ignored.
+//
+// The secret is kept in locked memory excluded from core dumps and is
+// wiped on exit. Each connection to the socket is checked against the
+// allowed user IDs using the peer credentials reported by the kernel; an
+// allowed peer receives the secret and the connection is closed:
+//
+//	socat - UNIX-CONNECT:/run/shamir-agent.sock
+//
+// The agent requires Linux.
+package main
+
+import (
+	"bufio"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"net"
+	"os"
+	"os/signal"
+	"strconv"
+	"strings"
+	"syscall"
+
+	goshamir "github.com/fawwazid/go-shamir"
+)
+
+func main() {
+	if err := run(os.Args[1:], os.Stdin, os.Stderr); err != nil {
+		fmt.Fprintf(os.Stderr, "shamir-agent: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+func run(args []string, stdin io.Reader, stderr io.Writer) error {
+	fs := flag.NewFlagSet("shamir-agent", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	threshold := fs.Int("k", 0, "number of shares required to reconstruct the secret (required)")
+	socket := fs.String("socket", "", "path of the Unix socket to serve the secret on (required)")
+	allowUIDs := fs.String("allow-uid", strconv.Itoa(os.Getuid()), "comma-separated user IDs allowed to read the secret")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if *threshold == 0 || *socket == "" {
+		return errors.New("-k and -socket are required")
+	}
+	allowed, err := parseUIDs(*allowUIDs)
+	if err != nil {
+		return err
+	}
+
+	if err := disableCoreDumps(); err != nil {
+		return fmt.Errorf("disabling core dumps: %w", err)
+	}
+	secret, err := combineShareFiles(fs.Args(), stdin, *threshold)
+	if err != nil {
+		return err
+	}
+	buf, err := newLockedBuffer(secret)
+	clear(secret)
+	if err != nil {
+		return err
+	}
+	defer buf.destroy()
+
+	ln, err := listen(*socket, allowed)
+	if err != nil {
+		return err
+	}
+	defer os.Remove(*socket)
+
+	logger := log.New(stderr, "shamir-agent: ", log.LstdFlags)
+	a := &agent{secret: buf, allowed: allowed, logf: logger.Printf}
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, syscall.SIGINT, syscall.SIGTERM)
+	go func() {
+		<-signals
+		ln.Close()
+	}()
+	logger.Printf("serving secret on %s", *socket)
+	return a.serve(ln)
+}
+
+// listen creates the agent's socket. Its permissions only admit other users
+// if they are allowed; the peer credential check is enforced regardless.
+func listen(path string, allowed map[uint32]bool) (*net.UnixListener, error) {
+	if _, err := os.Lstat(path); err == nil {
+		return nil, fmt.Errorf("%s already exists", path)
+	}
+	ln, err := net.ListenUnix("unix", &net.UnixAddr{Name: path, Net: "unix"})
+	if err != nil {
+		return nil, err
+	}
+	mode := os.FileMode(0o600)
+	if len(allowed) > 1 || !allowed[uint32(os.Getuid())] {
+		mode = 0o666
+	}
+	if err := os.Chmod(path, mode); err != nil {
+		ln.Close()
+		return nil, err
+	}
+	return ln, nil
+}
+
+func parseUIDs(list string) (map[uint32]bool, error) {
+	allowed := make(map[uint32]bool)
+	for _, field := range strings.Split(list, ",") {
+		uid, err := strconv.ParseUint(strings.TrimSpace(field), 10, 32)
+		if err != nil {
+			return nil, fmt.Errorf("invalid user ID %q", field)
+		}
+		allowed[uint32(uid)] = true
+	}
+	return allowed, nil
+}
+
+// combineShareFiles reads shares from the named files, or stdin if there
+// are none, and reconstructs the secret.
+func combineShareFiles(names []string, stdin io.Reader, threshold int) ([]byte, error) {
+	var encoded []string
+	if len(names) == 0 {
+		lines, err := readShareLines(stdin)
+		if err != nil {
+			return nil, err
+		}
+		encoded = lines
+	}
+	for _, name := range names {
+		f, err := os.Open(name)
+		if err != nil {
+			return nil, err
+		}
+		lines, err := readShareLines(f)
+		f.Close()
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", name, err)
+		}
+		encoded = append(encoded, lines...)
+	}
+	if len(encoded) == 0 {
+		return nil, errors.New("no shares given")
+	}
+
+	shares, err := goshamir.DecodeSharesFromHex(encoded)
+	if err != nil {
+		return nil, err
+	}
+	return goshamir.Combine(shares, threshold)
+}
+
+// readShareLines returns the last field of each non-empty line, dropping any
+// label in front of the share.
+func readShareLines(r io.Reader) ([]string, error) {
+	var shares []string
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		if fields := strings.Fields(scanner.Text()); len(fields) > 0 {
+			shares = append(shares, fields[len(fields)-1])
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("reading shares: %w", err)
+	}
+	return shares, nil
+}
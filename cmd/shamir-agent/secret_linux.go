@@ -0,0 +1,86 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"syscall"
+)
+
+// madvDontDump is MADV_DONTDUMP, which the syscall package does not define.
+const madvDontDump = 0x10
+
+// lockedBuffer holds a secret in memory mapped outside the Go heap, locked
+// into RAM, excluded from core dumps and read-only while in use.
+type lockedBuffer struct {
+	mem []byte
+	n   int
+}
+
+func newLockedBuffer(secret []byte) (*lockedBuffer, error) {
+	size := (len(secret) + syscall.Getpagesize() - 1) &^ (syscall.Getpagesize() - 1)
+	mem, err := syscall.Mmap(-1, 0, size, syscall.PROT_READ|syscall.PROT_WRITE, syscall.MAP_ANON|syscall.MAP_PRIVATE)
+	if err != nil {
+		return nil, fmt.Errorf("allocating secret memory: %w", err)
+	}
+	if err := syscall.Mlock(mem); err != nil {
+		syscall.Munmap(mem)
+		return nil, fmt.Errorf("locking secret memory (check RLIMIT_MEMLOCK): %w", err)
+	}
+	if err := syscall.Madvise(mem, madvDontDump); err != nil {
+		syscall.Munmap(mem)
+		return nil, fmt.Errorf("excluding secret memory from core dumps: %w", err)
+	}
+	copy(mem, secret)
+	if err := syscall.Mprotect(mem, syscall.PROT_READ); err != nil {
+		clear(mem)
+		syscall.Munmap(mem)
+		return nil, err
+	}
+	return &lockedBuffer{mem: mem, n: len(secret)}, nil
+}
+
+func (l *lockedBuffer) bytes() []byte {
+	return l.mem[:l.n]
+}
+
+// destroy wipes and unmaps the secret.
+func (l *lockedBuffer) destroy() {
+	if syscall.Mprotect(l.mem, syscall.PROT_READ|syscall.PROT_WRITE) == nil {
+		clear(l.mem)
+	}
+	syscall.Munlock(l.mem)
+	syscall.Munmap(l.mem)
+}
+
+// disableCoreDumps keeps the kernel from writing the process's memory to
+// disk on a crash and other unprivileged processes from attaching to it.
+func disableCoreDumps() error {
+	if _, _, errno := syscall.RawSyscall(syscall.SYS_PRCTL, syscall.PR_SET_DUMPABLE, 0, 0); errno != 0 {
+		return errno
+	}
+	return nil
+}
+
+// peerCred returns the credentials of the process connected to conn, as
+// recorded by the kernel when it connected.
+func peerCred(conn *net.UnixConn) (peer, error) {
+	raw, err := conn.SyscallConn()
+	if err != nil {
+		return peer{}, err
+	}
+	var cred *syscall.Ucred
+	var credErr error
+	if err := raw.Control(func(fd uintptr) {
+		cred, credErr = syscall.GetsockoptUcred(int(fd), syscall.SOL_SOCKET, syscall.SO_PEERCRED)
+	}); err != nil {
+		return peer{}, err
+	}
+	if credErr != nil {
+		return peer{}, fmt.Errorf("reading peer credentials: %w", credErr)
+	}
+	if cred == nil {
+		return peer{}, errors.New("no peer credentials")
+	}
+	return peer{pid: cred.Pid, uid: cred.Uid, gid: cred.Gid}, nil
+}
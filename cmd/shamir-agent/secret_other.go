@@ -0,0 +1,22 @@
+//go:build !linux
+
+package main
+
+import (
+	"errors"
+	"net"
+)
+
+var errUnsupported = errors.New("shamir-agent requires Linux for locked memory and peer credentials")
+
+type lockedBuffer struct{}
+
+func newLockedBuffer([]byte) (*lockedBuffer, error) { return nil, errUnsupported }
+
+func (l *lockedBuffer) bytes() []byte { return nil }
+
+func (l *lockedBuffer) destroy() {}
+
+func disableCoreDumps() error { return errUnsupported }
+
+func peerCred(*net.UnixConn) (peer, error) { return peer{}, errUnsupported }
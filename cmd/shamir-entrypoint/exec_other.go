@@ -0,0 +1,9 @@
+//go:build !unix
+
+package main
+
+import "errors"
+
+func execProcess(string, []string, []string) error {
+	return errors.New("replacing the process is not supported on this platform")
+}
@@ -0,0 +1,9 @@
+//go:build unix
+
+package main
+
+import "syscall"
+
+func execProcess(path string, argv, env []string) error {
+	return syscall.Exec(path, argv, env)
+}
@@ -0,0 +1,202 @@
+// Command shamir-entrypoint is a container entrypoint that unseals a secret
+// at boot: it gathers shares from mounted files, environment variables and
+// systemd credentials, combines them, exports the secret to a file or an
+// environment variable and then replaces itself with the real process.
+//
+// Usage:
+//
+//	shamir-entrypoint -k threshold [-share-dir dir] [-share-file file]
+//	    [-share-env prefix] [-credentials]
+//	    [-export-file path] [-export-env name] [-encoding raw|hex|base64]
+//	    -- command [arg ...]
+//
+// For example, with Docker secrets mounted under /run/secrets:
+//
+//	ENTRYPOINT ["shamir-entrypoint", "-k", "2", "-share-dir", "/run/secrets", \
+//	    "-export-file", "/dev/shm/db-key", "--", "/app/server"]
+//
+// Each share file holds one share, as written by shamir split. Variables
+// holding shares are removed from the environment of the command. Export
+// files should live on a tmpfs so the secret never reaches disk.
+package main
+
+import (
+	"bufio"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	goshamir "github.com/fawwazid/go-shamir"
+	"github.com/fawwazid/go-shamir/systemd"
+)
+
+// execFunc replaces the current process, as syscall.Exec.
+type execFunc func(path string, argv, env []string) error
+
+func main() {
+	if err := run(os.Args[1:], os.Environ(), os.Stderr, execProcess); err != nil {
+		fmt.Fprintf(os.Stderr, "shamir-entrypoint: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+// listFlag collects the values of a repeatable flag.
+type listFlag []string
+
+func (l *listFlag) String() string { return strings.Join(*l, ",") }
+
+func (l *listFlag) Set(v string) error {
+	*l = append(*l, v)
+	return nil
+}
+
+func run(args, environ []string, stderr io.Writer, execve execFunc) error {
+	fs := flag.NewFlagSet("shamir-entrypoint", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	var dirs, files listFlag
+	fs.Var(&dirs, "share-dir", "directory whose files each hold a share (repeatable)")
+	fs.Var(&files, "share-file", "file holding a share (repeatable)")
+	threshold := fs.Int("k", 0, "number of shares required to reconstruct the secret (required)")
+	envPrefix := fs.String("share-env", "SHAMIR_SHARE_", "prefix of environment variables holding shares")
+	credentials := fs.Bool("credentials", false, "read shares from systemd credentials")
+	exportFile := fs.String("export-file", "", "write the secret to this file, readable only by its owner")
+	exportEnv := fs.String("export-env", "", "pass the secret to the command in this environment variable")
+	encoding := fs.String("encoding", "raw", "encoding of the exported secret: raw, hex or base64")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if *threshold == 0 {
+		return errors.New("-k is required")
+	}
+	if fs.NArg() == 0 {
+		return errors.New("no command given")
+	}
+	if *exportFile == "" && *exportEnv == "" {
+		return errors.New("-export-file or -export-env is required")
+	}
+
+	encoded, env, err := gatherShares(dirs, files, *envPrefix, environ)
+	if err != nil {
+		return err
+	}
+	shares, err := goshamir.DecodeSharesFromHex(encoded)
+	if err != nil {
+		return err
+	}
+	if *credentials {
+		creds, err := systemd.ReadShares()
+		if err != nil {
+			return err
+		}
+		shares = append(shares, creds...)
+	}
+	if len(shares) == 0 {
+		return errors.New("no shares found")
+	}
+	secret, err := goshamir.Combine(shares, *threshold)
+	if err != nil {
+		return err
+	}
+	defer clear(secret)
+
+	exported, err := encodeSecret(secret, *encoding)
+	if err != nil {
+		return err
+	}
+	if *exportFile != "" {
+		if err := os.WriteFile(*exportFile, exported, 0o400); err != nil {
+			return fmt.Errorf("exporting secret: %w", err)
+		}
+	}
+	if *exportEnv != "" {
+		if strings.IndexByte(string(exported), 0) >= 0 {
+			return errors.New("secret contains a NUL byte; use -encoding hex or base64 with -export-env")
+		}
+		env = append(env, *exportEnv+"="+string(exported))
+	}
+
+	path, err := exec.LookPath(fs.Arg(0))
+	if err != nil {
+		return err
+	}
+	return execve(path, fs.Args(), env)
+}
+
+// gatherShares reads encoded shares from the given directories, files and
+// environment variables, returning the environment without those
+// variables.
+func gatherShares(dirs, files []string, envPrefix string, environ []string) ([]string, []string, error) {
+	for _, dir := range dirs {
+		entries, err := os.ReadDir(dir)
+		if err != nil {
+			return nil, nil, err
+		}
+		for _, e := range entries {
+			// Skip Kubernetes' ..data symlinks and other hidden entries.
+			if !strings.HasPrefix(e.Name(), ".") && !e.IsDir() {
+				files = append(files, filepath.Join(dir, e.Name()))
+			}
+		}
+	}
+
+	var encoded []string
+	for _, name := range files {
+		f, err := os.Open(name)
+		if err != nil {
+			return nil, nil, err
+		}
+		lines, err := readShareLines(f)
+		f.Close()
+		if err != nil {
+			return nil, nil, fmt.Errorf("%s: %w", name, err)
+		}
+		encoded = append(encoded, lines...)
+	}
+
+	var env []string
+	for _, kv := range environ {
+		name, value, _ := strings.Cut(kv, "=")
+		if envPrefix != "" && strings.HasPrefix(name, envPrefix) {
+			encoded = append(encoded, strings.TrimSpace(value))
+			continue
+		}
+		env = append(env, kv)
+	}
+	return encoded, env, nil
+}
+
+// readShareLines returns the last field of each non-empty line, dropping any
+// label in front of the share.
+func readShareLines(r io.Reader) ([]string, error) {
+	var shares []string
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		if fields := strings.Fields(scanner.Text()); len(fields) > 0 {
+			shares = append(shares, fields[len(fields)-1])
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("reading shares: %w", err)
+	}
+	return shares, nil
+}
+
+func encodeSecret(secret []byte, encoding string) ([]byte, error) {
+	switch encoding {
+	case "raw":
+		return secret, nil
+	case "hex":
+		return []byte(hex.EncodeToString(secret)), nil
+	case "base64":
+		return []byte(base64.StdEncoding.EncodeToString(secret)), nil
+	default:
+		return nil, fmt.Errorf("unknown encoding %q", encoding)
+	}
+}
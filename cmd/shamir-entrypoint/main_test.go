@@ -0,0 +1,93 @@
+package main
+
+import (
+	"encoding/hex"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+
+	goshamir "github.com/fawwazid/go-shamir"
+)
+
+// execCall records the arguments of a replaced exec.
+type execCall struct {
+	path      string
+	argv, env []string
+}
+
+func runEntrypoint(t *testing.T, environ []string, args ...string) (*execCall, error) {
+	t.Helper()
+	var call *execCall
+	var stderr strings.Builder
+	err := run(args, environ, &stderr, func(path string, argv, env []string) error {
+		call = &execCall{path, argv, env}
+		return nil
+	})
+	return call, err
+}
+
+// --- Entrypoint Tests ---
+
+func TestEntrypoint_ExportsFileAndEnv(t *testing.T) {
+	shares, _ := goshamir.Split([]byte("db-key"), 3, 2)
+	encoded, _ := goshamir.EncodeSharesToHex(shares)
+
+	secrets := t.TempDir()
+	os.WriteFile(filepath.Join(secrets, "share-alice"), []byte("alice "+encoded[0]+"\n"), 0o400)
+	os.Mkdir(filepath.Join(secrets, "..data"), 0o700)
+	export := filepath.Join(t.TempDir(), "db-key")
+	environ := []string{"PATH=" + os.Getenv("PATH"), "SHAMIR_SHARE_BOB=" + encoded[1], "HOME=/app"}
+
+	call, err := runEntrypoint(t, environ,
+		"-k", "2", "-share-dir", secrets, "-export-file", export,
+		"-export-env", "DB_KEY", "-encoding", "hex", "--", "sh", "-c", "true")
+	if err != nil {
+		t.Fatalf("run failed: %v", err)
+	}
+	if call == nil || filepath.Base(call.path) != "sh" || !slices.Equal(call.argv, []string{"sh", "-c", "true"}) {
+		t.Fatalf("Unexpected exec %+v", call)
+	}
+
+	want := hex.EncodeToString([]byte("db-key"))
+	if data, _ := os.ReadFile(export); string(data) != want {
+		t.Errorf("Expected exported file %q, got %q", want, data)
+	}
+	if !slices.Contains(call.env, "DB_KEY="+want) {
+		t.Error("Secret was not exported to the environment")
+	}
+	if !slices.Contains(call.env, "HOME=/app") {
+		t.Error("Unrelated variables were not passed through")
+	}
+	for _, kv := range call.env {
+		if strings.HasPrefix(kv, "SHAMIR_SHARE_") {
+			t.Errorf("Share variable %q leaked to the command", kv)
+		}
+	}
+}
+
+func TestEntrypoint_Errors(t *testing.T) {
+	shares, _ := goshamir.Split([]byte("a\x00b"), 3, 2)
+	encoded, _ := goshamir.EncodeSharesToHex(shares)
+	environ := []string{"PATH=" + os.Getenv("PATH"), "SHAMIR_SHARE_1=" + encoded[0], "SHAMIR_SHARE_2=" + encoded[2]}
+
+	cases := map[string][]string{
+		"missing threshold": {"-export-env", "X", "--", "true"},
+		"missing command":   {"-k", "2", "-export-env", "X"},
+		"missing export":    {"-k", "2", "--", "true"},
+		"NUL in env":        {"-k", "2", "-export-env", "X", "--", "true"},
+		"unknown encoding":  {"-k", "2", "-export-env", "X", "-encoding", "rot13", "--", "true"},
+		"unknown command":   {"-k", "2", "-export-env", "X", "-encoding", "base64", "--", "no-such-command-xyz"},
+		"too few shares":    {"-k", "3", "-export-env", "X", "-encoding", "base64", "--", "true"},
+	}
+	for name, args := range cases {
+		if call, err := runEntrypoint(t, environ, args...); err == nil || call != nil {
+			t.Errorf("%s: expected error without exec, got %v", name, err)
+		}
+	}
+
+	if _, err := runEntrypoint(t, environ, "-k", "2", "-export-env", "X", "-encoding", "base64", "--", "true"); err != nil {
+		t.Errorf("Expected success with base64 encoding, got %v", err)
+	}
+}
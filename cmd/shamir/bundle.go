@@ -0,0 +1,42 @@
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"strings"
+
+	goshamir "github.com/fawwazid/go-shamir"
+)
+
+func runVerifyBundle(args []string, _ io.Reader, stdout, stderr io.Writer) error {
+	fs := flag.NewFlagSet("verify-bundle", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	expected := fs.String("digest", "", "manifest digest announced by the dealer")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if fs.NArg() != 1 {
+		return errors.New("exactly one bundle directory must be given")
+	}
+
+	m, err := goshamir.VerifyBundleManifest(fs.Arg(0))
+	if err != nil {
+		return err
+	}
+	digest, err := m.Digest()
+	if err != nil {
+		return err
+	}
+	if *expected != "" && !strings.EqualFold(*expected, digest) {
+		return fmt.Errorf("manifest digest %s does not match expected %s", digest, *expected)
+	}
+	fmt.Fprintf(stdout, "set %s: %d shares, threshold %d, scheme %s\n", m.SetID, m.TotalShares, m.Threshold, m.Scheme)
+	fmt.Fprintf(stdout, "merkle root: %s\n", m.MerkleRoot)
+	fmt.Fprintf(stdout, "manifest digest: %s\n", digest)
+	if *expected == "" {
+		fmt.Fprintln(stdout, "compare the digest with the one announced by the dealer, or pass -digest")
+	}
+	return nil
+}
@@ -0,0 +1,41 @@
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+
+	goshamir "github.com/fawwazid/go-shamir"
+)
+
+// runCapabilities prints the schemes and limits of the library the tool was
+// built with, for bug reports and diagnostics.
+func runCapabilities(args []string, _ io.Reader, stdout, stderr io.Writer) error {
+	fs := flag.NewFlagSet("capabilities", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	asJSON := fs.Bool("json", false, "print as JSON")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if fs.NArg() > 0 {
+		return fmt.Errorf("unexpected argument %q", fs.Arg(0))
+	}
+
+	c := goshamir.Capabilities()
+	if *asJSON {
+		enc := json.NewEncoder(stdout)
+		enc.SetIndent("", "  ")
+		return enc.Encode(c)
+	}
+	fmt.Fprintf(stdout, "version:        %s\n", c.Version)
+	fmt.Fprintf(stdout, "default scheme: %s\n", c.DefaultScheme)
+	fmt.Fprintf(stdout, "threshold:      %d to %d\n", c.MinThreshold, c.MaxShares)
+	fmt.Fprintf(stdout, "max shares:     %d\n", c.MaxShares)
+	fmt.Fprintf(stdout, "backend:        %s (simd: %t)\n", c.Backend, c.SIMD)
+	for _, s := range c.Schemes {
+		fmt.Fprintf(stdout, "scheme %s:      %s, %d byte(s) per secret byte, secrets up to %d bytes\n",
+			s.Scheme, s.Field, s.ShareOverhead, s.MaxSecretSize)
+	}
+	return nil
+}
@@ -0,0 +1,69 @@
+// Command shamir provides operator tooling for go-shamir shares.
+//
+// Usage:
+//
+//	shamir capabilities [-json]
+//	shamir inspect [share ...]
+//	shamir migrate -k quorum [-n shares] [share ...]
+//	shamir reveal [-clipboard | -qr [-invert]] [-timeout duration] [file]
+//	shamir split -n shares -k threshold [-label template] [-out dir | -bundle dir] [file]
+//	shamir verify-bundle [-digest hex] dir
+//
+// Shares are read as hex strings from the arguments or, if none are given,
+// one per line from standard input. A line may start with a label, as
+// printed by split, which is ignored.
+package main
+
+import (
+	"fmt"
+	"io"
+	"os"
+)
+
+// command is a subcommand of the tool. run receives the arguments after the
+// subcommand name and returns an error to be reported on stderr.
+type command struct {
+	name  string
+	usage string
+	run   func(args []string, stdin io.Reader, stdout, stderr io.Writer) error
+}
+
+var commands = []command{
+	{"capabilities", "print supported schemes and limits", runCapabilities},
+	{"inspect", "report scheme, index, fingerprint and corruption of shares", runInspect},
+	{"migrate", "re-split legacy GF(257) shares into compact GF(256) shares", runMigrate},
+	{"reveal", "show or copy a share for a limited time, then clear it", runReveal},
+	{"split", "split a secret into labeled shares", runSplit},
+	{"verify-bundle", "verify an offline bundle against its manifest", runVerifyBundle},
+}
+
+func main() {
+	os.Exit(run(os.Args[1:], os.Stdin, os.Stdout, os.Stderr))
+}
+
+func run(args []string, stdin io.Reader, stdout, stderr io.Writer) int {
+	if len(args) == 0 {
+		printUsage(stderr)
+		return 2
+	}
+	for _, c := range commands {
+		if c.name == args[0] {
+			if err := c.run(args[1:], stdin, stdout, stderr); err != nil {
+				fmt.Fprintf(stderr, "shamir %s: %v\n", c.name, err)
+				return 1
+			}
+			return 0
+		}
+	}
+	fmt.Fprintf(stderr, "shamir: unknown command %q\n", args[0])
+	printUsage(stderr)
+	return 2
+}
+
+func printUsage(w io.Writer) {
+	fmt.Fprintln(w, "usage: shamir <command> [arguments]")
+	fmt.Fprintln(w, "\ncommands:")
+	for _, c := range commands {
+		fmt.Fprintf(w, "  %-10s %s\n", c.name, c.usage)
+	}
+}
@@ -0,0 +1,368 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	goshamir "github.com/fawwazid/go-shamir"
+)
+
+func runCommand(t *testing.T, stdin string, args ...string) (string, string, int) {
+	t.Helper()
+	var stdout, stderr bytes.Buffer
+	code := run(args, strings.NewReader(stdin), &stdout, &stderr)
+	return stdout.String(), stderr.String(), code
+}
+
+// --- Command Tests ---
+
+func TestRun_Usage(t *testing.T) {
+	if _, stderr, code := runCommand(t, ""); code != 2 || !strings.Contains(stderr, "migrate") {
+		t.Errorf("Expected usage with exit code 2, got %d: %q", code, stderr)
+	}
+	if _, stderr, code := runCommand(t, "", "bogus"); code != 2 || !strings.Contains(stderr, "unknown command") {
+		t.Errorf("Expected unknown command error, got %d: %q", code, stderr)
+	}
+}
+
+// --- Migrate Tests ---
+
+func TestMigrate(t *testing.T) {
+	shares, _ := goshamir.Split([]byte("operator secret"), 3, 2)
+	encoded, _ := goshamir.EncodeSharesToHex(shares)
+
+	stdout, stderr, code := runCommand(t, encoded[0]+"\n\n"+encoded[2]+"\n", "migrate", "-k", "2", "-n", "3")
+	if code != 0 {
+		t.Fatalf("migrate failed with code %d: %s", code, stderr)
+	}
+	lines := strings.Fields(stdout)
+	if len(lines) != 3 {
+		t.Fatalf("Expected 3 shares, got %d", len(lines))
+	}
+	for _, l := range lines {
+		if !strings.HasPrefix(l, "v2:") {
+			t.Errorf("Expected compact share, got %q", l)
+		}
+	}
+
+	migrated, err := goshamir.DecodeSharesFromHex(lines[1:])
+	if err != nil {
+		t.Fatalf("Decode failed: %v", err)
+	}
+	secret, err := goshamir.Combine(migrated, 2)
+	if err != nil {
+		t.Fatalf("Combine failed: %v", err)
+	}
+	if string(secret) != "operator secret" {
+		t.Errorf("Expected %q, got %q", "operator secret", secret)
+	}
+}
+
+func TestMigrate_Errors(t *testing.T) {
+	if _, _, code := runCommand(t, "", "migrate", "1:00"); code != 1 {
+		t.Errorf("Expected failure without -k, got %d", code)
+	}
+	if _, _, code := runCommand(t, "", "migrate", "-k", "2"); code != 1 {
+		t.Errorf("Expected failure without shares, got %d", code)
+	}
+	if _, _, code := runCommand(t, "", "migrate", "-k", "2", "1:zz", "2:00"); code != 1 {
+		t.Errorf("Expected failure for invalid hex, got %d", code)
+	}
+}
+
+// --- Inspect Tests ---
+
+func TestCapabilities(t *testing.T) {
+	stdout, stderr, code := runCommand(t, "", "capabilities")
+	if code != 0 {
+		t.Fatalf("capabilities failed with code %d: %s", code, stderr)
+	}
+	for _, want := range []string{"default scheme: v1", "max shares:     255", "scheme v2:      GF(2^8)"} {
+		if !strings.Contains(stdout, want) {
+			t.Errorf("Expected output to contain %q:\n%s", want, stdout)
+		}
+	}
+
+	stdout, _, code = runCommand(t, "", "capabilities", "-json")
+	var c goshamir.CapabilityInfo
+	if code != 0 || json.Unmarshal([]byte(stdout), &c) != nil || len(c.Schemes) != 2 {
+		t.Errorf("Unexpected JSON output %q", stdout)
+	}
+}
+
+func TestInspect(t *testing.T) {
+	shares, _ := goshamir.Split([]byte("secret"), 3, 2)
+	encoded, _ := goshamir.EncodeSharesToHex(shares)
+
+	stdout, stderr, code := runCommand(t, "", "inspect", encoded[0], encoded[2])
+	if code != 0 {
+		t.Fatalf("inspect failed with code %d: %s", code, stderr)
+	}
+	for _, want := range []string{"scheme:      v1", "index:       3", "12 bytes (6 byte secret)", "set id:      not recorded", "status:      ok"} {
+		if !strings.Contains(stdout, want) {
+			t.Errorf("Expected output to contain %q:\n%s", want, stdout)
+		}
+	}
+}
+
+func TestInspect_Corruption(t *testing.T) {
+	shares, _ := goshamir.Split([]byte("secret"), 3, 2)
+	encoded, _ := goshamir.EncodeSharesToHex(shares)
+
+	stdout, stderr, code := runCommand(t, encoded[0]+"\n1:ffff\nnot-a-share\n"+encoded[0]+"\n", "inspect")
+	if code != 1 || !strings.Contains(stderr, "problems found") {
+		t.Fatalf("Expected problems to be reported, got %d: %s", code, stderr)
+	}
+	for _, want := range []string{"out of field range", "cannot decode", "inconsistent lengths", "conflicting shares with index 1"} {
+		if !strings.Contains(stdout, want) {
+			t.Errorf("Expected output to contain %q:\n%s", want, stdout)
+		}
+	}
+}
+
+// --- Split Tests ---
+
+func TestSplit(t *testing.T) {
+	stdout, stderr, code := runCommand(t, "top secret", "split", "-n", "3", "-k", "2", "-set-id", "cafe")
+	if code != 0 {
+		t.Fatalf("split failed with code %d: %s", code, stderr)
+	}
+	lines := strings.Split(strings.TrimSpace(stdout), "\n")
+	if len(lines) != 3 || !strings.HasPrefix(lines[1], "share-cafe-002-of-3 2:") {
+		t.Fatalf("Unexpected output:\n%s", stdout)
+	}
+
+	// Labeled lines can be fed back to other commands.
+	if _, stderr, code := runCommand(t, lines[0]+"\n"+lines[2]+"\n", "migrate", "-k", "2"); code != 0 {
+		t.Errorf("migrate of labeled shares failed: %s", stderr)
+	}
+}
+
+func TestSplit_Files(t *testing.T) {
+	dir := t.TempDir()
+	_, stderr, code := runCommand(t, "top secret", "split", "-n", "3", "-k", "2", "-scheme", "2",
+		"-label", "backup-{{.Index}}-of-{{.Total}}", "-out", dir)
+	if code != 0 {
+		t.Fatalf("split failed with code %d: %s", code, stderr)
+	}
+
+	var encoded []string
+	for _, name := range []string{"backup-1-of-3", "backup-3-of-3"} {
+		data, err := os.ReadFile(filepath.Join(dir, name))
+		if err != nil {
+			t.Fatalf("Reading share file failed: %v", err)
+		}
+		encoded = append(encoded, strings.TrimSpace(string(data)))
+	}
+	shares, _ := goshamir.DecodeSharesFromHex(encoded)
+	secret, err := goshamir.Combine(shares, 2)
+	if err != nil {
+		t.Fatalf("Combine failed: %v", err)
+	}
+	if string(secret) != "top secret" {
+		t.Errorf("Expected %q, got %q", "top secret", secret)
+	}
+}
+
+func TestSplit_Errors(t *testing.T) {
+	if _, _, code := runCommand(t, "x", "split", "-n", "3"); code != 1 {
+		t.Errorf("Expected failure without -k, got %d", code)
+	}
+	if _, _, code := runCommand(t, "x", "split", "-n", "3", "-k", "2", "-label", "same"); code != 1 {
+		t.Errorf("Expected failure for non-unique labels, got %d", code)
+	}
+	if _, _, code := runCommand(t, "x", "split", "-n", "3", "-k", "2", "-scheme", "9"); code != 1 {
+		t.Errorf("Expected failure for unsupported scheme, got %d", code)
+	}
+}
+
+func TestSplit_FilesNotOverwritten(t *testing.T) {
+	dir := t.TempDir()
+	args := []string{"split", "-n", "2", "-k", "2", "-set-id", "x", "-out", dir}
+	if _, stderr, code := runCommand(t, "one", args...); code != 0 {
+		t.Fatalf("split failed: %s", stderr)
+	}
+	if _, _, code := runCommand(t, "two", args...); code != 1 {
+		t.Errorf("Expected failure when share files exist, got %d", code)
+	}
+}
+
+func TestSplit_Shred(t *testing.T) {
+	dir := t.TempDir()
+	secretFile := filepath.Join(dir, "secret.key")
+	os.WriteFile(secretFile, []byte("destroy me"), 0o600)
+
+	if _, _, code := runCommand(t, "", "split", "-n", "2", "-k", "2", "-shred"); code != 1 {
+		t.Errorf("Expected -shred without a file to fail, got %d", code)
+	}
+
+	// A failed split must leave the original in place.
+	if _, _, code := runCommand(t, "", "split", "-n", "2", "-k", "2", "-out", filepath.Join(dir, "missing"), "-shred", secretFile); code != 1 {
+		t.Fatalf("Expected split into a missing directory to fail, got %d", code)
+	}
+	if _, err := os.Stat(secretFile); err != nil {
+		t.Fatalf("Secret file removed after failed split: %v", err)
+	}
+
+	if _, stderr, code := runCommand(t, "", "split", "-n", "2", "-k", "2", "-shred", secretFile); code != 0 {
+		t.Fatalf("split failed: %s", stderr)
+	}
+	if _, err := os.Stat(secretFile); !os.IsNotExist(err) {
+		t.Errorf("Expected secret file to be removed, got %v", err)
+	}
+}
+
+// --- Offline Bundle Tests ---
+
+func TestSplit_Bundle(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "bundle")
+	stdout, stderr, code := runCommand(t, "offline", "split", "-n", "3", "-k", "2", "-set-id", "vault", "-bundle", dir)
+	if code != 0 {
+		t.Fatalf("split failed with code %d: %s", code, stderr)
+	}
+	_, digest, ok := strings.Cut(strings.TrimSpace(stdout), "manifest digest: ")
+	if !ok {
+		t.Fatalf("Expected manifest digest in output, got %q", stdout)
+	}
+
+	stdout, stderr, code = runCommand(t, "", "verify-bundle", "-digest", digest, dir)
+	if code != 0 || !strings.Contains(stdout, "set vault: 3 shares, threshold 2") {
+		t.Fatalf("verify-bundle failed with code %d: %s%s", code, stdout, stderr)
+	}
+	if _, _, code := runCommand(t, "", "verify-bundle", "-digest", strings.Repeat("0", 64), dir); code != 1 {
+		t.Errorf("Expected digest mismatch to fail, got %d", code)
+	}
+	os.Remove(filepath.Join(dir, "share-002.txt"))
+	if _, _, code := runCommand(t, "", "verify-bundle", dir); code != 1 {
+		t.Errorf("Expected incomplete bundle to fail, got %d", code)
+	}
+	if _, _, code := runCommand(t, "x", "split", "-n", "3", "-k", "2", "-out", dir, "-bundle", dir); code != 1 {
+		t.Errorf("Expected -out with -bundle to fail, got %d", code)
+	}
+}
+
+// --- Reveal Tests ---
+
+// fakeClipboard replaces the clipboard tools for the duration of a test.
+func fakeClipboard(t *testing.T) *[]byte {
+	t.Helper()
+	var board []byte
+	oldLook, oldCopy, oldPaste := lookPath, clipboardCopy, clipboardPaste
+	t.Cleanup(func() { lookPath, clipboardCopy, clipboardPaste = oldLook, oldCopy, oldPaste })
+	lookPath = func(name string) (string, error) {
+		if name == "xclip" {
+			return "/usr/bin/xclip", nil
+		}
+		return "", errors.New("not found")
+	}
+	clipboardCopy = func(argv []string, data []byte) error {
+		if argv[0] != "xclip" {
+			t.Errorf("Unexpected copy tool %v", argv)
+		}
+		board = bytes.Clone(data)
+		return nil
+	}
+	clipboardPaste = func([]string) ([]byte, error) { return bytes.Clone(board), nil }
+	return &board
+}
+
+func TestReveal_Clipboard(t *testing.T) {
+	board := fakeClipboard(t)
+	shares, _ := goshamir.Split([]byte("reveal me"), 2, 2)
+	encoded, _ := goshamir.EncodeSharesToHex(shares)
+
+	// Observe the clipboard while the share is revealed.
+	var during []byte
+	copyFn := clipboardCopy
+	clipboardCopy = func(argv []string, data []byte) error {
+		err := copyFn(argv, data)
+		if during == nil {
+			during = bytes.Clone(*board)
+		}
+		return err
+	}
+	_, stderr, code := runCommand(t, "share-1-of-2 "+encoded[0]+"\n", "reveal", "-clipboard", "-timeout", "10ms")
+	if code != 0 {
+		t.Fatalf("reveal failed with code %d: %s", code, stderr)
+	}
+	if string(during) != encoded[0] {
+		t.Errorf("Expected share on the clipboard, got %q", during)
+	}
+	if len(*board) != 0 || !strings.Contains(stderr, "clipboard cleared") {
+		t.Errorf("Expected clipboard to be cleared, got %q: %s", *board, stderr)
+	}
+}
+
+func TestReveal_ClipboardReplaced(t *testing.T) {
+	board := fakeClipboard(t)
+	shares, _ := goshamir.Split([]byte("reveal me"), 2, 2)
+	encoded, _ := goshamir.EncodeSharesToHex(shares)
+	clipboardPaste = func([]string) ([]byte, error) { return []byte("something else"), nil }
+
+	_, stderr, code := runCommand(t, encoded[1], "reveal", "-clipboard", "-timeout", "1ms")
+	if code != 0 || !strings.Contains(stderr, "left untouched") {
+		t.Fatalf("Expected clipboard to be left alone, got %d: %s", code, stderr)
+	}
+	if string(*board) != encoded[1] {
+		t.Errorf("Clipboard was modified: %q", *board)
+	}
+}
+
+func TestReveal_Terminal(t *testing.T) {
+	shares, _ := goshamir.Split([]byte("reveal me"), 2, 2)
+	encoded, _ := goshamir.EncodeSharesToHex(shares)
+	file := filepath.Join(t.TempDir(), "share")
+	os.WriteFile(file, []byte(encoded[0]+"\n"), 0o600)
+
+	if _, stderr, code := runCommand(t, "", "reveal", file); code != 1 || !strings.Contains(stderr, "not a terminal") {
+		t.Errorf("Expected refusal to reveal to a non-terminal, got %d: %s", code, stderr)
+	}
+
+	old := isTerminal
+	t.Cleanup(func() { isTerminal = old })
+	isTerminal = func(io.Writer) bool { return true }
+	stdout, stderr, code := runCommand(t, "", "reveal", "-timeout", "1ms", file)
+	if code != 0 {
+		t.Fatalf("reveal failed with code %d: %s", code, stderr)
+	}
+	if !strings.HasPrefix(stdout, enterAltScreen) || !strings.HasSuffix(stdout, leaveAltScreen) || !strings.Contains(stdout, encoded[0]) {
+		t.Errorf("Expected share on the alternate screen, got %q", stdout)
+	}
+}
+
+func TestReveal_Errors(t *testing.T) {
+	if _, _, code := runCommand(t, "", "reveal", "-clipboard"); code != 1 {
+		t.Errorf("Expected failure without a share, got %d", code)
+	}
+	if _, _, code := runCommand(t, "zz", "reveal", "-clipboard"); code != 1 {
+		t.Errorf("Expected failure for an invalid share, got %d", code)
+	}
+	if _, _, code := runCommand(t, "", "reveal", "-timeout", "0s"); code != 1 {
+		t.Errorf("Expected failure for a zero timeout, got %d", code)
+	}
+}
+
+func TestReveal_QR(t *testing.T) {
+	shares, _ := goshamir.Split([]byte("scan me"), 2, 2)
+	encoded, _ := goshamir.EncodeSharesToHex(shares)
+	old := isTerminal
+	t.Cleanup(func() { isTerminal = old })
+	isTerminal = func(io.Writer) bool { return true }
+
+	stdout, stderr, code := runCommand(t, encoded[0], "reveal", "-qr", "-timeout", "1ms")
+	if code != 0 {
+		t.Fatalf("reveal -qr failed with code %d: %s", code, stderr)
+	}
+	if !strings.Contains(stdout, "▀") || !strings.Contains(stdout, encoded[0]) {
+		t.Errorf("Expected a QR code and the share, got %q", stdout)
+	}
+	if _, _, code := runCommand(t, encoded[0], "reveal", "-qr", "-clipboard"); code != 1 {
+		t.Errorf("Expected -qr with -clipboard to fail, got %d", code)
+	}
+}
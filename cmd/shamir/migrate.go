@@ -0,0 +1,74 @@
+package main
+
+import (
+	"bufio"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"strings"
+
+	goshamir "github.com/fawwazid/go-shamir"
+)
+
+func runMigrate(args []string, stdin io.Reader, stdout, stderr io.Writer) error {
+	fs := flag.NewFlagSet("migrate", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	quorum := fs.Int("k", 0, "threshold of the legacy shares (required)")
+	total := fs.Int("n", 0, "number of new shares to create (default: number of input shares)")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if *quorum == 0 {
+		return errors.New("-k is required")
+	}
+
+	encoded, err := readShares(fs.Args(), stdin)
+	if err != nil {
+		return err
+	}
+	old, err := goshamir.DecodeSharesFromHex(encoded)
+	if err != nil {
+		return err
+	}
+	if *total == 0 {
+		*total = len(old)
+	}
+
+	shares, err := goshamir.MigrateShares(old, *quorum, *total)
+	if err != nil {
+		return err
+	}
+	out, err := goshamir.EncodeSharesToHex(shares)
+	if err != nil {
+		return err
+	}
+	for _, s := range out {
+		if _, err := fmt.Fprintln(stdout, s); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// readShares returns args if any were given, and otherwise the last field of
+// each non-empty line of stdin, dropping any label in front of the share.
+func readShares(args []string, stdin io.Reader) ([]string, error) {
+	if len(args) > 0 {
+		return args, nil
+	}
+	var shares []string
+	scanner := bufio.NewScanner(stdin)
+	for scanner.Scan() {
+		if fields := strings.Fields(scanner.Text()); len(fields) > 0 {
+			shares = append(shares, fields[len(fields)-1])
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("reading shares: %w", err)
+	}
+	if len(shares) == 0 {
+		return nil, errors.New("no shares given")
+	}
+	return shares, nil
+}
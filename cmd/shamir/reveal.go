@@ -0,0 +1,190 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"os/signal"
+	"strings"
+	"syscall"
+	"time"
+
+	goshamir "github.com/fawwazid/go-shamir"
+	"github.com/fawwazid/go-shamir/internal/qr"
+)
+
+// Terminal control sequences switching to and from the alternate screen,
+// which is not kept in scrollback.
+const (
+	enterAltScreen = "\x1b[?1049h\x1b[H\x1b[2J"
+	leaveAltScreen = "\x1b[2J\x1b[?1049l"
+)
+
+// clipboardTool holds the external commands that copy standard input to
+// the clipboard and print the clipboard.
+type clipboardTool struct {
+	copy  []string
+	paste []string
+}
+
+// clipboardTools are tried in order; the first whose copy command is
+// installed is used.
+var clipboardTools = []clipboardTool{
+	{copy: []string{"wl-copy"}, paste: []string{"wl-paste", "--no-newline"}},
+	{copy: []string{"xclip", "-selection", "clipboard"}, paste: []string{"xclip", "-selection", "clipboard", "-o"}},
+	{copy: []string{"xsel", "--clipboard", "--input"}, paste: []string{"xsel", "--clipboard", "--output"}},
+	{copy: []string{"pbcopy"}, paste: []string{"pbpaste"}},
+	{copy: []string{"clip.exe"}, paste: []string{"powershell.exe", "-NoProfile", "-Command", "Get-Clipboard"}},
+}
+
+// Replaceable in tests.
+var (
+	lookPath       = exec.LookPath
+	clipboardCopy  = copyWithTool
+	clipboardPaste = pasteWithTool
+	isTerminal     = func(w io.Writer) bool {
+		f, ok := w.(*os.File)
+		if !ok {
+			return false
+		}
+		fi, err := f.Stat()
+		return err == nil && fi.Mode()&os.ModeCharDevice != 0
+	}
+)
+
+// runReveal shows a single share for a limited time, either on the
+// terminal's alternate screen or on the clipboard, and then clears it. The
+// share is read from a file or standard input, never from the arguments,
+// so that it stays out of shell history.
+func runReveal(args []string, stdin io.Reader, stdout, stderr io.Writer) error {
+	fs := flag.NewFlagSet("reveal", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	toClipboard := fs.Bool("clipboard", false, "copy the share to the clipboard instead of displaying it")
+	showQR := fs.Bool("qr", false, "display the share as a QR code for scanning")
+	invert := fs.Bool("invert", false, "draw the QR code for terminals with dark text on a light background")
+	timeout := fs.Duration("timeout", 30*time.Second, "how long to reveal the share before clearing it")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if fs.NArg() > 1 {
+		return errors.New("at most one share file may be given")
+	}
+	if *timeout <= 0 {
+		return errors.New("-timeout must be positive")
+	}
+
+	share, err := readRevealShare(fs.Arg(0), stdin)
+	if err != nil {
+		return err
+	}
+	defer clear(share)
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+	ctx, cancel := context.WithTimeout(ctx, *timeout)
+	defer cancel()
+
+	if *toClipboard && *showQR {
+		return errors.New("-clipboard and -qr are mutually exclusive")
+	}
+	if *toClipboard {
+		return revealClipboard(ctx, share, *timeout, stderr)
+	}
+	if !isTerminal(stdout) {
+		return errors.New("standard output is not a terminal; use -clipboard or inspect")
+	}
+	var code string
+	if *showQR {
+		c, err := qr.Encode(share, qr.M)
+		if err != nil {
+			return err
+		}
+		code = strings.ReplaceAll(c.HalfBlocks(4, *invert), "\n", "\r\n")
+	}
+	fmt.Fprint(stdout, enterAltScreen)
+	fmt.Fprintf(stdout, "%s%s\r\n\r\nThis share will be cleared in %s. Press Ctrl-C to clear it now.\r\n", code, share, *timeout)
+	<-ctx.Done()
+	fmt.Fprint(stdout, leaveAltScreen)
+	return nil
+}
+
+// revealClipboard copies share to the clipboard until ctx is done, then
+// clears the clipboard unless it has since been replaced.
+func revealClipboard(ctx context.Context, share []byte, timeout time.Duration, stderr io.Writer) error {
+	tool, err := findClipboardTool()
+	if err != nil {
+		return err
+	}
+	if err := clipboardCopy(tool.copy, share); err != nil {
+		return fmt.Errorf("copying to clipboard failed: %w", err)
+	}
+	fmt.Fprintf(stderr, "share copied to the clipboard; it will be cleared in %s (Ctrl-C to clear now)\n", timeout)
+	<-ctx.Done()
+
+	if current, err := clipboardPaste(tool.paste); err == nil && !bytes.Equal(bytes.TrimSpace(current), share) {
+		clear(current)
+		fmt.Fprintln(stderr, "clipboard has changed; left untouched")
+		return nil
+	}
+	if err := clipboardCopy(tool.copy, nil); err != nil {
+		return fmt.Errorf("clearing clipboard failed: %w", err)
+	}
+	fmt.Fprintln(stderr, "clipboard cleared")
+	return nil
+}
+
+func findClipboardTool() (clipboardTool, error) {
+	for _, t := range clipboardTools {
+		if _, err := lookPath(t.copy[0]); err == nil {
+			return t, nil
+		}
+	}
+	return clipboardTool{}, errors.New("no clipboard tool found (install wl-clipboard, xclip or xsel)")
+}
+
+// copyWithTool runs a copy command with data on its standard input. Tools
+// such as xclip keep serving the selection in the background, so their
+// output is left unconnected for Run not to wait for them.
+func copyWithTool(argv []string, data []byte) error {
+	cmd := exec.Command(argv[0], argv[1:]...)
+	cmd.Stdin = bytes.NewReader(data)
+	return cmd.Run()
+}
+
+func pasteWithTool(argv []string) ([]byte, error) {
+	return exec.Command(argv[0], argv[1:]...).Output()
+}
+
+// readRevealShare reads the first share from the named file, or from stdin
+// if name is empty or "-", and returns it in canonical hex form.
+func readRevealShare(name string, stdin io.Reader) ([]byte, error) {
+	data, err := readSecret(name, stdin)
+	if err != nil {
+		return nil, err
+	}
+	defer clear(data)
+	var line string
+	for l := range strings.Lines(string(data)) {
+		if fields := strings.Fields(l); len(fields) > 0 {
+			line = fields[len(fields)-1]
+			break
+		}
+	}
+	if line == "" {
+		return nil, errors.New("no share given")
+	}
+	shares, err := goshamir.DecodeSharesFromHex([]string{line})
+	if err != nil {
+		return nil, err
+	}
+	encoded, err := goshamir.EncodeSharesToHex(shares)
+	if err != nil {
+		return nil, err
+	}
+	return []byte(encoded[0]), nil
+}
@@ -0,0 +1,113 @@
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+
+	goshamir "github.com/fawwazid/go-shamir"
+)
+
+func runSplit(args []string, stdin io.Reader, stdout, stderr io.Writer) error {
+	fs := flag.NewFlagSet("split", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	total := fs.Int("n", 0, "number of shares to create (required)")
+	threshold := fs.Int("k", 0, "number of shares needed to recover (required)")
+	scheme := fs.Uint("scheme", uint(goshamir.SchemeV1GF257), "share scheme version")
+	label := fs.String("label", goshamir.DefaultLabelTemplate, "label template for shares and file names")
+	setID := fs.String("set-id", "", "identifier of the share set (default: random)")
+	out := fs.String("out", "", "directory to write one file per share to (default: stdout)")
+	bundle := fs.String("bundle", "", "directory to write an offline bundle with manifest to, for air-gapped transfer")
+	shred := fs.Bool("shred", false, "overwrite and remove the secret file after a successful split (best effort, see docs)")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if *total == 0 || *threshold == 0 {
+		return errors.New("-n and -k are required")
+	}
+	if fs.NArg() > 1 {
+		return errors.New("at most one secret file may be given")
+	}
+	if *out != "" && *bundle != "" {
+		return errors.New("-out and -bundle are mutually exclusive")
+	}
+	if *shred && (fs.Arg(0) == "" || fs.Arg(0) == "-") {
+		return errors.New("-shred requires a secret file")
+	}
+
+	tmpl, err := goshamir.ParseLabelTemplate(*label)
+	if err != nil {
+		return err
+	}
+	if *setID == "" {
+		if *setID, err = goshamir.NewSetID(); err != nil {
+			return err
+		}
+	}
+
+	secret, err := readSecret(fs.Arg(0), stdin)
+	if err != nil {
+		return err
+	}
+	defer clear(secret)
+
+	splitter, err := goshamir.NewSplitter(*total, *threshold, goshamir.WithScheme(goshamir.Scheme(*scheme)))
+	if err != nil {
+		return err
+	}
+	shares, err := splitter.Split(secret)
+	if err != nil {
+		return err
+	}
+	labels, err := tmpl.Labels(*setID, *threshold, shares)
+	if err != nil {
+		return err
+	}
+	switch {
+	case *bundle != "":
+		m, err := goshamir.WriteOfflineBundle(*bundle, *setID, *threshold, shares)
+		if err != nil {
+			return err
+		}
+		digest, err := m.Digest()
+		if err != nil {
+			return err
+		}
+		fmt.Fprintf(stdout, "wrote offline bundle %s\nmanifest digest: %s\n", *bundle, digest)
+	case *out == "":
+		encoded, err := goshamir.EncodeSharesToHex(shares)
+		if err != nil {
+			return err
+		}
+		for i, e := range encoded {
+			if _, err := fmt.Fprintf(stdout, "%s %s\n", labels[i], e); err != nil {
+				return err
+			}
+		}
+	default:
+		paths, err := goshamir.WriteShareFiles(*out, labels, shares)
+		if err != nil {
+			return err
+		}
+		for _, p := range paths {
+			fmt.Fprintln(stdout, p)
+		}
+	}
+
+	// Only destroy the original once every share has been written.
+	if *shred {
+		return goshamir.ShredOriginal(fs.Arg(0))
+	}
+	return nil
+}
+
+// readSecret reads the secret from the named file, or from stdin if name is
+// empty or "-".
+func readSecret(name string, stdin io.Reader) ([]byte, error) {
+	if name == "" || name == "-" {
+		return io.ReadAll(stdin)
+	}
+	return os.ReadFile(name)
+}
@@ -0,0 +1,181 @@
+package goshamir
+
+import "fmt"
+
+// CombineProblem classifies why shares cannot be combined.
+type CombineProblem int
+
+const (
+	// ProblemInvalidThreshold means the threshold is out of range.
+	ProblemInvalidThreshold CombineProblem = iota + 1
+	// ProblemInsufficientShares means fewer distinct shares than the
+	// threshold were provided.
+	ProblemInsufficientShares
+	// ProblemEmptyShare means a share has no value.
+	ProblemEmptyShare
+	// ProblemLengthMismatch means shares have different lengths, so they
+	// belong to different secrets or one is truncated.
+	ProblemLengthMismatch
+	// ProblemMixedSchemes means shares were created with different
+	// schemes.
+	ProblemMixedSchemes
+	// ProblemUnsupportedScheme means the shares use a scheme this version
+	// cannot combine. The error wraps ErrUnsupportedScheme.
+	ProblemUnsupportedScheme
+	// ProblemZeroIndex means a share has the invalid index 0.
+	ProblemZeroIndex
+	// ProblemDuplicateIndex means two shares have the same index.
+	ProblemDuplicateIndex
+	// ProblemCorruptValue means a share value is not a valid encoding for
+	// its scheme.
+	ProblemCorruptValue
+	// ProblemMixedNesting means sub-shares of different parents, or shares
+	// and sub-shares, were combined together. See CombineNested.
+	ProblemMixedNesting
+	// ProblemPeppered means a share value is still encrypted under a
+	// pepper. See WithPepper.
+	ProblemPeppered
+)
+
+// String returns a short name for the problem, such as
+// "duplicate-index".
+func (p CombineProblem) String() string {
+	switch p {
+	case ProblemInvalidThreshold:
+		return "invalid-threshold"
+	case ProblemInsufficientShares:
+		return "insufficient-shares"
+	case ProblemEmptyShare:
+		return "empty-share"
+	case ProblemLengthMismatch:
+		return "length-mismatch"
+	case ProblemMixedSchemes:
+		return "mixed-schemes"
+	case ProblemUnsupportedScheme:
+		return "unsupported-scheme"
+	case ProblemZeroIndex:
+		return "zero-index"
+	case ProblemDuplicateIndex:
+		return "duplicate-index"
+	case ProblemCorruptValue:
+		return "corrupt-value"
+	case ProblemMixedNesting:
+		return "mixed-nesting"
+	case ProblemPeppered:
+		return "peppered"
+	}
+	return fmt.Sprintf("problem(%d)", int(p))
+}
+
+// CombineError explains why a set of shares cannot be combined, so that
+// recovery interfaces can tell custodians what to fix. Combine and the
+// functions built on it return a *CombineError for invalid input; retrieve
+// it with errors.As. Fields that do not apply to the problem are zero,
+// except Position and Other, which are -1.
+type CombineError struct {
+	Problem CombineProblem
+	// Position is the offending share's position in the slice passed in.
+	Position int
+	// Index is the offending share's index.
+	Index uint8
+	// Other is the position of the share it conflicts with, such as the
+	// first share with a duplicated index.
+	Other int
+	// Need is the threshold, and Have the number of distinct valid share
+	// indices provided.
+	Need, Have int
+	// Scheme is the offending share's scheme, and Expected the scheme of
+	// the shares before it.
+	Scheme, Expected Scheme
+	// Length is the offending share's value length, and ExpectedLength the
+	// length of the first share.
+	Length, ExpectedLength int
+	// Value is the invalid field element, for ProblemCorruptValue.
+	Value int
+}
+
+// Error returns a sentence describing the problem, such as "need 1 more
+// distinct share (have 2 of 3)".
+func (e *CombineError) Error() string {
+	switch e.Problem {
+	case ProblemInvalidThreshold:
+		if e.Need < MinThreshold {
+			return fmt.Sprintf("threshold must be at least %d", MinThreshold)
+		}
+		return fmt.Sprintf("threshold must be <= %d", MaxShares)
+	case ProblemInsufficientShares:
+		if e.Have == 0 {
+			return fmt.Sprintf("no shares provided: need %d", e.Need)
+		}
+		more := e.Need - e.Have
+		msg := fmt.Sprintf("need %d more distinct %s (have %d of %d)", more, plural(more, "share"), e.Have, e.Need)
+		switch {
+		case e.Other >= 0:
+			msg += fmt.Sprintf(": share index %d appears twice", e.Index)
+		case e.Position >= 0:
+			msg += fmt.Sprintf(": share at position %d has index 0", e.Position)
+		}
+		return msg
+	case ProblemEmptyShare:
+		return fmt.Sprintf("share %d is empty", e.Index)
+	case ProblemLengthMismatch:
+		return fmt.Sprintf("share %d has inconsistent length: %d bytes, others %d", e.Index, e.Length, e.ExpectedLength)
+	case ProblemMixedSchemes:
+		return fmt.Sprintf("share %d has scheme %s, others %s", e.Index, e.Scheme, e.Expected)
+	case ProblemUnsupportedScheme:
+		return fmt.Sprintf("share %d: %v", e.Index, unsupportedScheme(e.Scheme))
+	case ProblemZeroIndex:
+		return fmt.Sprintf("share at position %d has index 0, which is invalid", e.Position)
+	case ProblemDuplicateIndex:
+		return fmt.Sprintf("share index %d appears twice (positions %d and %d)", e.Index, e.Other, e.Position)
+	case ProblemCorruptValue:
+		if e.Length%2 != 0 {
+			return fmt.Sprintf("share %d is corrupt: odd length %d", e.Index, e.Length)
+		}
+		return fmt.Sprintf("share %d is corrupt: value %d out of field range [0, %d]", e.Index, e.Value, FieldPrime-1)
+	case ProblemMixedNesting:
+		return fmt.Sprintf("share %d has different parents than the others; combine nested shares with CombineNested", e.Index)
+	case ProblemPeppered:
+		return fmt.Sprintf("share %d is peppered; remove the pepper with UnpepperShares or a Reconstructor using WithPepper", e.Index)
+	}
+	return e.Problem.String()
+}
+
+// Unwrap returns ErrUnsupportedScheme for ProblemUnsupportedScheme.
+func (e *CombineError) Unwrap() error {
+	if e.Problem == ProblemUnsupportedScheme {
+		return ErrUnsupportedScheme
+	}
+	return nil
+}
+
+// newCombineError returns a CombineError for the share at position pos.
+func newCombineError(p CombineProblem, shares []Share, pos int) *CombineError {
+	e := &CombineError{Problem: p, Position: pos, Other: -1}
+	if pos >= 0 && pos < len(shares) {
+		e.Index = shares[pos].Index
+		e.Scheme = schemeOf(shares[pos])
+		e.Length = len(shares[pos].Value)
+	}
+	return e
+}
+
+// distinctIndices returns the number of distinct non-zero share indices.
+func distinctIndices(shares []Share) int {
+	var seen [MaxShares + 1]bool
+	n := 0
+	for _, s := range shares {
+		if s.Index != 0 && !seen[s.Index] {
+			seen[s.Index] = true
+			n++
+		}
+	}
+	return n
+}
+
+func plural(n int, word string) string {
+	if n == 1 {
+		return word
+	}
+	return word + "s"
+}
@@ -0,0 +1,76 @@
+package goshamir
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+// --- CombineError Tests ---
+
+func TestCombine_StructuredErrors(t *testing.T) {
+	shares, _ := Split([]byte("secret"), 5, 3)
+	splitter, _ := NewSplitter(5, 3, WithScheme(SchemeV2GF256))
+	compact, _ := splitter.Split([]byte("secret!!!!!!"))
+	corrupt := Share{Index: 4, Value: append([]byte{0xFF, 0xFF}, shares[3].Value[2:]...)}
+	short := Share{Index: 4, Value: shares[3].Value[:6]}
+
+	tests := []struct {
+		name      string
+		shares    []Share
+		threshold int
+		problem   CombineProblem
+		position  int
+		index     uint8
+		want      string
+	}{
+		{"one missing", shares[:2], 3, ProblemInsufficientShares, -1, 0, "need 1 more distinct share (have 2 of 3)"},
+		{"two missing", shares[:1], 3, ProblemInsufficientShares, -1, 0, "need 2 more distinct shares (have 1 of 3)"},
+		{"none", nil, 3, ProblemInsufficientShares, -1, 0, "no shares provided: need 3"},
+		{"repeated", []Share{shares[0], shares[1], shares[0]}, 3, ProblemInsufficientShares, 2, 1, "need 1 more distinct share (have 2 of 3): share index 1 appears twice"},
+		{"duplicate", []Share{shares[0], shares[1], shares[1], shares[2]}, 3, ProblemDuplicateIndex, 2, 2, "share index 2 appears twice (positions 1 and 2)"},
+		{"zero index", []Share{shares[0], {Value: shares[1].Value}, shares[2], shares[3]}, 3, ProblemZeroIndex, 1, 0, "share at position 1 has index 0"},
+		{"mixed schemes", []Share{shares[0], shares[1], compact[2]}, 3, ProblemMixedSchemes, 2, 3, "share 3 has scheme v2, others v1"},
+		{"length", []Share{shares[0], shares[1], short}, 3, ProblemLengthMismatch, 2, 4, "share 4 has inconsistent length: 6 bytes, others 12"},
+		{"empty", []Share{{Index: 1}, shares[1], shares[2]}, 3, ProblemEmptyShare, 0, 1, "share 1 is empty"},
+		{"corrupt", []Share{shares[0], corrupt, shares[2]}, 3, ProblemCorruptValue, 1, 4, "share 4 is corrupt: value 65535 out of field range"},
+		{"threshold", shares, 1, ProblemInvalidThreshold, -1, 0, "threshold must be at least 2"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Combine(tt.shares, tt.threshold)
+			var ce *CombineError
+			if !errors.As(err, &ce) {
+				t.Fatalf("Expected a CombineError, got %v", err)
+			}
+			if ce.Problem != tt.problem || ce.Position != tt.position || ce.Index != tt.index {
+				t.Errorf("Expected %s at position %d (index %d), got %s at %d (index %d)",
+					tt.problem, tt.position, tt.index, ce.Problem, ce.Position, ce.Index)
+			}
+			if !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("Expected error containing %q, got %q", tt.want, err)
+			}
+		})
+	}
+}
+
+func TestCombineError_Fields(t *testing.T) {
+	shares, _ := Split([]byte("secret"), 3, 3)
+	_, err := Combine([]Share{shares[0], shares[2], shares[0]}, 3)
+	var ce *CombineError
+	if !errors.As(err, &ce) {
+		t.Fatalf("Expected a CombineError, got %v", err)
+	}
+	if ce.Need != 3 || ce.Have != 2 || ce.Other != 0 || ce.Position != 2 {
+		t.Errorf("Unexpected fields: %+v", ce)
+	}
+
+	unknown := []Share{{Index: 1, Value: []byte{1}, Scheme: 9}, {Index: 2, Value: []byte{2}, Scheme: 9}}
+	_, err = Combine(unknown, 2)
+	if !errors.As(err, &ce) || ce.Problem != ProblemUnsupportedScheme || !errors.Is(err, ErrUnsupportedScheme) {
+		t.Errorf("Expected an unsupported scheme CombineError, got %v", err)
+	}
+	if ProblemDuplicateIndex.String() != "duplicate-index" {
+		t.Errorf("Unexpected problem name %q", ProblemDuplicateIndex)
+	}
+}
@@ -0,0 +1,98 @@
+package goshamir
+
+import (
+	"encoding/base32"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"strings"
+)
+
+// compactPrefix starts every compact share, in the style of the "$id$"
+// prefixes of crypt(3) hashes.
+const compactPrefix = "$gs1$"
+
+// compactHeaderLen is the length of the fixed header after compactPrefix:
+// one character of scheme, two of index and seven of CRC-32C.
+const compactHeaderLen = 1 + 2 + 7
+
+// crockford is Crockford's base32 alphabet, which leaves out I, L, O and U
+// so that shares survive being read aloud or retyped.
+var crockford = base32.NewEncoding("0123456789ABCDEFGHJKMNPQRSTVWXYZ").WithPadding(base32.NoPadding)
+
+// crockfordNormalizer maps the characters Crockford's base32 decodes
+// leniently: lowercase letters, O for zero and I or L for one. Hyphens,
+// which may be inserted for readability, are dropped.
+var crockfordNormalizer = strings.NewReplacer("O", "0", "I", "1", "L", "1", "-", "")
+
+// ErrCompactChecksum is returned when a compact share fails its CRC-32C
+// check.
+var ErrCompactChecksum = errors.New("compact share checksum mismatch")
+
+// EncodeShareCompact encodes a share on a single line for pasting into
+// chat messages and tickets, such as
+//
+//	$gs1$207WQ3C1FA$8Z4XG6N2KD...
+//
+// After the "$gs1$" prefix comes a fixed header of the scheme, the index in
+// two characters and a CRC-32C of the share in seven, then "$" and the
+// value. Header and value use Crockford's base32, whose alphabet has no
+// look-alike characters, so the text contains nothing chat clients
+// reformat. Watermarks and sub-share parents cannot be encoded.
+func EncodeShareCompact(s Share) (string, error) {
+	if len(s.Value) == 0 {
+		return "", errors.New("share value must not be empty")
+	}
+	if len(s.Watermark) > 0 || len(s.Parents) > 0 {
+		return "", errors.New("compact encoding does not support watermarks or sub-shares")
+	}
+	scheme := schemeOf(s)
+	if scheme > 31 {
+		return "", unsupportedScheme(scheme)
+	}
+	// The header packs 5 bits of scheme, 10 of index and 35 of checksum
+	// into 50 bits: ten base32 characters.
+	header := uint64(scheme)<<45 | uint64(s.Index)<<35 | uint64(compactChecksum(scheme, s.Index, s.Value))
+	var buf [8]byte
+	binary.BigEndian.PutUint64(buf[:], header<<14)
+	return compactPrefix + crockford.EncodeToString(buf[:])[:compactHeaderLen] + "$" + crockford.EncodeToString(s.Value), nil
+}
+
+// DecodeShareCompact decodes a share encoded with EncodeShareCompact. It
+// ignores case and hyphens, and reads O as zero and I or L as one.
+func DecodeShareCompact(text string) (Share, error) {
+	text = strings.TrimSpace(text)
+	if len(text) < len(compactPrefix) || !strings.EqualFold(text[:len(compactPrefix)], compactPrefix) {
+		return Share{}, fmt.Errorf("%w: missing %q prefix", ErrInvalidEncodedShare, compactPrefix)
+	}
+	header, value, ok := strings.Cut(crockfordNormalizer.Replace(strings.ToUpper(text[len(compactPrefix):])), "$")
+	if !ok || len(header) != compactHeaderLen || value == "" {
+		return Share{}, ErrInvalidEncodedShare
+	}
+	raw, err := crockford.DecodeString(header + "000000")
+	if err != nil {
+		return Share{}, ErrInvalidEncodedShare
+	}
+	packed := binary.BigEndian.Uint64(raw[:8]) >> 14
+	scheme, index, sum := Scheme(packed>>45), packed>>35&0x3ff, uint32(packed&(1<<35-1))
+	if index == 0 || index > MaxShares || packed>>32&7 != 0 {
+		return Share{}, ErrInvalidEncodedShare
+	}
+	// Re-encoding rejects values whose unused trailing bits are set, which
+	// the decoder would otherwise ignore.
+	data, err := crockford.DecodeString(value)
+	if err != nil || len(data) == 0 || crockford.EncodeToString(data) != value {
+		return Share{}, ErrInvalidEncodedShare
+	}
+	if compactChecksum(scheme, uint8(index), data) != sum {
+		return Share{}, ErrCompactChecksum
+	}
+	return Share{Index: uint8(index), Value: data, Scheme: scheme}, nil
+}
+
+// compactChecksum returns the CRC-32C of the scheme, index and value of a
+// compact share.
+func compactChecksum(scheme Scheme, index uint8, value []byte) uint32 {
+	return crc32.Update(crc32.Checksum([]byte{byte(scheme), index}, crc32c), crc32c, value)
+}
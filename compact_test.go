@@ -0,0 +1,82 @@
+package goshamir
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+)
+
+// --- Compact Encoding Tests ---
+
+func TestEncodeShareCompact_RoundTrip(t *testing.T) {
+	for _, scheme := range []Scheme{SchemeV1GF257, SchemeV2GF256} {
+		s, _ := NewSplitter(MaxShares, 3, WithScheme(scheme))
+		shares, err := s.Split([]byte("compact share"))
+		if err != nil {
+			t.Fatalf("Split failed: %v", err)
+		}
+		for _, share := range []Share{shares[0], shares[6], shares[MaxShares-1]} {
+			text, err := EncodeShareCompact(share)
+			if err != nil {
+				t.Fatalf("EncodeShareCompact failed: %v", err)
+			}
+			if !strings.HasPrefix(text, "$gs1$") || strings.ContainsAny(text, "ILOU \n") || strings.Count(text, "$") != 3 {
+				t.Fatalf("unexpected compact text %q", text)
+			}
+			got, err := DecodeShareCompact(text)
+			if err != nil {
+				t.Fatalf("DecodeShareCompact failed: %v", err)
+			}
+			if got.Index != share.Index || schemeOf(got) != scheme || !bytes.Equal(got.Value, share.Value) {
+				t.Fatalf("round trip mismatch for %q", text)
+			}
+		}
+	}
+}
+
+func TestEncodeShareCompact_HeaderShowsSchemeAndIndex(t *testing.T) {
+	text, err := EncodeShareCompact(Share{Index: 7, Value: []byte{1, 2, 3}, Scheme: SchemeV2GF256})
+	if err != nil {
+		t.Fatalf("EncodeShareCompact failed: %v", err)
+	}
+	if !strings.HasPrefix(text, "$gs1$207") {
+		t.Fatalf("expected header to start with scheme 2 and index 07, got %q", text)
+	}
+}
+
+func TestDecodeShareCompact_Lenient(t *testing.T) {
+	share := Share{Index: 1, Value: bytes.Repeat([]byte{0}, 10), Scheme: SchemeV2GF256}
+	text, _ := EncodeShareCompact(share)
+	// Lowercase, O for zero, L for one and hyphens all decode.
+	sloppy := strings.ToLower(text[:5]) + strings.ReplaceAll(strings.ReplaceAll(strings.ToLower(text[5:]), "0", "o"), "1", "l")
+	sloppy = sloppy[:12] + "-" + sloppy[12:]
+	got, err := DecodeShareCompact(" " + sloppy + "\n")
+	if err != nil {
+		t.Fatalf("DecodeShareCompact failed on %q: %v", sloppy, err)
+	}
+	if got.Index != 1 || !bytes.Equal(got.Value, share.Value) {
+		t.Fatal("lenient decode mismatch")
+	}
+}
+
+func TestDecodeShareCompact_Invalid(t *testing.T) {
+	shares, _ := Split([]byte("k"), 3, 2)
+	text, _ := EncodeShareCompact(shares[0])
+	last := text[len(text)-1]
+	flipped := byte('0')
+	if last == '0' {
+		flipped = '2'
+	}
+	if _, err := DecodeShareCompact(text[:len(text)-1] + string(flipped)); !errors.Is(err, ErrCompactChecksum) && !errors.Is(err, ErrInvalidEncodedShare) {
+		t.Fatalf("expected an error for a typo, got %v", err)
+	}
+	for _, bad := range []string{"", "gs1$207", "$gs1$207$AB", "$gs1$207WQ3C1FA$", "$gs1$207WQ3C1FA$UUUU", "$gs1$000000000$AB"} {
+		if _, err := DecodeShareCompact(bad); err == nil {
+			t.Errorf("DecodeShareCompact(%q): expected error", bad)
+		}
+	}
+	if _, err := EncodeShareCompact(Share{Index: 1, Value: []byte{1}, Watermark: []byte{2}}); err == nil {
+		t.Error("expected error for watermarked share")
+	}
+}
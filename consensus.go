@@ -0,0 +1,86 @@
+package goshamir
+
+import (
+	"cmp"
+	"crypto/subtle"
+	"errors"
+	"fmt"
+	"slices"
+)
+
+// ErrTampering is returned by CombineConsensus when quorums of the shares
+// reconstruct different secrets, which means at least one share was
+// corrupted or tampered with.
+var ErrTampering = errors.New("quorums reconstructed different secrets")
+
+// ConsensusError reports the quorums whose secret disagreed with the
+// first. It wraps ErrTampering.
+type ConsensusError struct {
+	// Quorums lists the share indices of every quorum that disagreed with
+	// the first quorum, which has the lowest indices.
+	Quorums [][]uint8
+}
+
+// Error implements error.
+func (e *ConsensusError) Error() string {
+	return fmt.Sprintf("%v: %d quorums disagree, first %v", ErrTampering, len(e.Quorums), e.Quorums[0])
+}
+
+// Unwrap returns ErrTampering.
+func (e *ConsensusError) Unwrap() error {
+	return ErrTampering
+}
+
+// CombineConsensus reconstructs the secret from several distinct quorums
+// of shares and returns it only if all of them agree, so that a corrupted
+// or tampered share is detected instead of silently yielding a wrong
+// secret. At least threshold+1 shares are needed.
+//
+// The shares are sorted by index and every window of threshold consecutive
+// shares is combined. Neighbouring windows share all but one share, so
+// they only agree when every supplied share lies on the same polynomial:
+// agreement means all quorums of the shares would agree. Disagreement is
+// reported as a *ConsensusError. Use CombineSelect with SelectVote to
+// recover despite a minority of bad shares.
+func CombineConsensus(shares []Share, threshold int) (_ []byte, err error) {
+	defer recoverInternal(&err)
+
+	if err := validateCombineParams(shares, threshold); err != nil {
+		return nil, err
+	}
+	if len(shares) <= threshold {
+		return nil, fmt.Errorf("consensus needs at least %d shares, got %d", threshold+1, len(shares))
+	}
+	if err := validateShareIndices(shares); err != nil {
+		return nil, err
+	}
+	sorted := slices.Clone(shares)
+	slices.SortFunc(sorted, func(a, b Share) int { return cmp.Compare(a.Index, b.Index) })
+
+	secret, err := Combine(sorted, threshold)
+	if err != nil {
+		return nil, err
+	}
+	var disagree [][]uint8
+	for i := 1; i+threshold <= len(sorted); i++ {
+		window := sorted[i : i+threshold]
+		other, err := Combine(window, threshold)
+		if err != nil {
+			clear(secret)
+			return nil, err
+		}
+		if len(other) != len(secret) || subtle.ConstantTimeCompare(other, secret) != 1 {
+			indices := make([]uint8, len(window))
+			for j, s := range window {
+				indices[j] = s.Index
+			}
+			disagree = append(disagree, indices)
+		}
+		clear(other)
+	}
+	if len(disagree) > 0 {
+		clear(secret)
+		return nil, &ConsensusError{Quorums: disagree}
+	}
+	return secret, nil
+}
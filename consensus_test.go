@@ -0,0 +1,46 @@
+package goshamir
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+)
+
+// --- Consensus Tests ---
+
+func TestCombineConsensus(t *testing.T) {
+	secret := []byte("agreed upon")
+	shares, _ := Split(secret, 5, 3)
+	got, err := CombineConsensus([]Share{shares[3], shares[0], shares[4], shares[1]}, 3)
+	if err != nil {
+		t.Fatalf("CombineConsensus failed: %v", err)
+	}
+	if !bytes.Equal(got, secret) {
+		t.Fatalf("Expected %q, got %q", secret, got)
+	}
+}
+
+func TestCombineConsensus_DetectsTampering(t *testing.T) {
+	secret := []byte("agreed upon")
+	for _, tampered := range []int{0, 2, 4} {
+		s, _ := NewSplitter(5, 3, WithScheme(SchemeV2GF256))
+		shares, _ := s.Split(secret)
+		shares[tampered].Value[3] ^= 0x10
+
+		_, err := CombineConsensus(shares, 3)
+		if !errors.Is(err, ErrTampering) {
+			t.Fatalf("tampered share %d: expected ErrTampering, got %v", tampered+1, err)
+		}
+		var ce *ConsensusError
+		if !errors.As(err, &ce) || len(ce.Quorums) == 0 {
+			t.Fatalf("expected *ConsensusError, got %T", err)
+		}
+	}
+}
+
+func TestCombineConsensus_NeedsExtraShares(t *testing.T) {
+	shares, _ := Split([]byte("x"), 3, 3)
+	if _, err := CombineConsensus(shares, 3); err == nil {
+		t.Fatal("expected error without extra shares")
+	}
+}
@@ -0,0 +1,88 @@
+package goshamir
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Custodian is a person or system entrusted with one share.
+type Custodian struct {
+	Name    string
+	Contact string
+	// PublicKey is the custodian's public key in whatever encoding the
+	// surrounding tooling uses, for example to encrypt the share in transit.
+	PublicKey []byte
+	// Index is the share index assigned to the custodian. Zero lets
+	// AssignShares pick one of the remaining shares.
+	Index uint8
+	// Roles optionally tags the custodian's share with roles, such as
+	// "officer", for role policies enforced by CombineBundles.
+	Roles []string
+}
+
+// CustodianBundle pairs a custodian with the share they hold.
+type CustodianBundle struct {
+	Custodian Custodian
+	Share     Share
+}
+
+// AssignShares distributes shares to custodians, one share each. Custodians
+// with a fixed Index receive that share; the others receive the remaining
+// shares in order. Bundles are returned in the order of custodians, and the
+// returned custodians have their Index filled in.
+func AssignShares(shares []Share, custodians []Custodian) ([]CustodianBundle, error) {
+	if len(custodians) != len(shares) {
+		return nil, fmt.Errorf("got %d custodians for %d shares", len(custodians), len(shares))
+	}
+
+	byIndex := make(map[uint8]int, len(shares))
+	for i, s := range shares {
+		if s.Index == 0 {
+			return nil, errors.New("share index must be non-zero")
+		}
+		if _, ok := byIndex[s.Index]; ok {
+			return nil, errors.New("duplicate share index found")
+		}
+		byIndex[s.Index] = i
+	}
+
+	taken := make([]bool, len(shares))
+	names := make(map[string]bool, len(custodians))
+	bundles := make([]CustodianBundle, len(custodians))
+	for i, c := range custodians {
+		if c.Name == "" {
+			return nil, fmt.Errorf("custodian %d has no name", i)
+		}
+		if names[c.Name] {
+			return nil, fmt.Errorf("duplicate custodian name %q", c.Name)
+		}
+		names[c.Name] = true
+		bundles[i].Custodian = c
+		if c.Index == 0 {
+			continue
+		}
+		pos, ok := byIndex[c.Index]
+		if !ok {
+			return nil, fmt.Errorf("custodian %q: no share with index %d", c.Name, c.Index)
+		}
+		if taken[pos] {
+			return nil, fmt.Errorf("custodian %q: share %d is already assigned", c.Name, c.Index)
+		}
+		taken[pos] = true
+		bundles[i].Share = shares[pos]
+	}
+
+	next := 0
+	for i := range bundles {
+		if bundles[i].Custodian.Index != 0 {
+			continue
+		}
+		for taken[next] {
+			next++
+		}
+		taken[next] = true
+		bundles[i].Share = shares[next]
+		bundles[i].Custodian.Index = shares[next].Index
+	}
+	return bundles, nil
+}
@@ -0,0 +1,55 @@
+package goshamir
+
+import "testing"
+
+// --- AssignShares Tests ---
+
+func TestAssignShares(t *testing.T) {
+	shares, _ := Split([]byte("secret"), 3, 2)
+	custodians := []Custodian{
+		{Name: "alice", Contact: "alice@example.com"},
+		{Name: "bob", Index: 1},
+		{Name: "carol"},
+	}
+
+	bundles, err := AssignShares(shares, custodians)
+	if err != nil {
+		t.Fatalf("AssignShares failed: %v", err)
+	}
+	want := map[string]uint8{"alice": 2, "bob": 1, "carol": 3}
+	for i, b := range bundles {
+		if b.Custodian.Name != custodians[i].Name {
+			t.Errorf("Bundle %d: expected %s, got %s", i, custodians[i].Name, b.Custodian.Name)
+		}
+		if b.Share.Index != want[b.Custodian.Name] || b.Custodian.Index != b.Share.Index {
+			t.Errorf("%s: got share %d, custodian index %d", b.Custodian.Name, b.Share.Index, b.Custodian.Index)
+		}
+	}
+	if custodians[0].Index != 0 {
+		t.Error("AssignShares modified the input custodians")
+	}
+}
+
+func TestAssignShares_Errors(t *testing.T) {
+	shares, _ := Split([]byte("secret"), 2, 2)
+	tests := []struct {
+		name       string
+		custodians []Custodian
+	}{
+		{"count mismatch", []Custodian{{Name: "a"}}},
+		{"unnamed", []Custodian{{Name: "a"}, {}}},
+		{"duplicate name", []Custodian{{Name: "a"}, {Name: "a"}}},
+		{"unknown index", []Custodian{{Name: "a", Index: 7}, {Name: "b"}}},
+		{"index taken twice", []Custodian{{Name: "a", Index: 1}, {Name: "b", Index: 1}}},
+	}
+	for _, tt := range tests {
+		if _, err := AssignShares(shares, tt.custodians); err == nil {
+			t.Errorf("%s: expected error", tt.name)
+		}
+	}
+
+	dup := []Share{shares[0], shares[0]}
+	if _, err := AssignShares(dup, []Custodian{{Name: "a"}, {Name: "b"}}); err == nil {
+		t.Error("Expected error for duplicate share indices")
+	}
+}
@@ -0,0 +1,293 @@
+package goshamir
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"sync"
+	"time"
+)
+
+// custodyEventMagic starts the signed form of a CustodyEvent and domain
+// separates its signatures.
+const custodyEventMagic = "goshamir custody event v1"
+
+// maxCustodyEventSize bounds the events ReadCustodyLog accepts.
+const maxCustodyEventSize = 1 << 20
+
+// ErrCustodyLogTampered is returned when a custody log fails verification:
+// an event was altered, removed, reordered or not signed by the dealer.
+var ErrCustodyLogTampered = errors.New("custody log verification failed")
+
+// CustodyEventKind identifies a step in the lifecycle of a share set.
+type CustodyEventKind int
+
+const (
+	// EventSplit records that a secret was split into a share set.
+	EventSplit CustodyEventKind = iota + 1
+	// EventDistribute records that shares were handed to custodians.
+	EventDistribute
+	// EventVerify records that shares were checked, for example with a
+	// heartbeat or receipt.
+	EventVerify
+	// EventRefresh records that the shares of a set were refreshed.
+	EventRefresh
+	// EventCombine records that the secret was reconstructed.
+	EventCombine
+)
+
+// String returns the kind's name, such as "split".
+func (k CustodyEventKind) String() string {
+	switch k {
+	case EventSplit:
+		return "split"
+	case EventDistribute:
+		return "distribute"
+	case EventVerify:
+		return "verify"
+	case EventRefresh:
+		return "refresh"
+	case EventCombine:
+		return "combine"
+	}
+	return fmt.Sprintf("CustodyEventKind(%d)", int(k))
+}
+
+// CustodyEvent is one entry of a CustodyLog. Events name shares by index
+// and never carry share values.
+type CustodyEvent struct {
+	// Seq is the position of the event in its log, from 0.
+	Seq  uint64
+	Kind CustodyEventKind
+	// SetID names the share set, Actor who performed the step.
+	SetID string
+	Actor string
+	// Indices lists the shares involved, if any.
+	Indices []uint8
+	Detail  string
+	Time    time.Time
+	// PrevHash is the Hash of the previous event, or all zeros for the
+	// first, chaining every event to the whole history before it.
+	PrevHash  []byte
+	Signature []byte
+}
+
+// Hash returns the SHA-256 of the event, including its signature. The hash
+// of the last event, the log's head, commits to the whole log.
+func (e CustodyEvent) Hash() []byte {
+	data, _ := e.MarshalBinary()
+	h := sha256.Sum256(data)
+	return h[:]
+}
+
+// MarshalBinary encodes the event, including its signature.
+func (e CustodyEvent) MarshalBinary() ([]byte, error) {
+	return appendField(e.signedMessage(), e.Signature), nil
+}
+
+// UnmarshalBinary decodes an event produced by MarshalBinary. It does not
+// verify the signature.
+func (e *CustodyEvent) UnmarshalBinary(data []byte) error {
+	fr := fieldReader{data: data}
+	if string(fr.next()) != custodyEventMagic {
+		return errors.New("invalid custody event")
+	}
+	var out CustodyEvent
+	seq := fr.next()
+	kind := fr.next()
+	out.SetID = string(fr.next())
+	out.Actor = string(fr.next())
+	out.Indices = fr.next()
+	out.Detail = string(fr.next())
+	at := fr.next()
+	out.PrevHash = fr.next()
+	out.Signature = fr.next()
+	if fr.err != nil || len(fr.data) != 0 || len(seq) != 8 || len(kind) != 1 || len(at) != 8 || len(out.PrevHash) != sha256.Size {
+		return errors.New("invalid custody event")
+	}
+	if len(out.Indices) == 0 {
+		out.Indices = nil
+	}
+	out.Seq = binary.BigEndian.Uint64(seq)
+	out.Kind = CustodyEventKind(kind[0])
+	out.Time = time.Unix(0, int64(binary.BigEndian.Uint64(at))).UTC()
+	*e = out
+	return nil
+}
+
+// signedMessage returns the canonical encoding of everything the signature
+// covers.
+func (e *CustodyEvent) signedMessage() []byte {
+	buf := appendField(nil, []byte(custodyEventMagic))
+	buf = appendField(buf, binary.BigEndian.AppendUint64(nil, e.Seq))
+	buf = appendField(buf, []byte{byte(e.Kind)})
+	buf = appendField(buf, []byte(e.SetID))
+	buf = appendField(buf, []byte(e.Actor))
+	buf = appendField(buf, e.Indices)
+	buf = appendField(buf, []byte(e.Detail))
+	buf = appendField(buf, binary.BigEndian.AppendUint64(nil, uint64(e.Time.UnixNano())))
+	return appendField(buf, e.PrevHash)
+}
+
+// CustodyLog is an append-only, hash-chained log of the lifecycle of share
+// sets, from split through distribution, verification and refresh to
+// combination. Every event is signed by the dealer and chained to the one
+// before it, so the log is tamper-evident: VerifyCustodyLog detects altered,
+// removed or reordered events, and comparing its head against a copy kept
+// elsewhere also detects events cut from the end. A CustodyLog is safe for
+// concurrent use.
+type CustodyLog struct {
+	mu     sync.Mutex
+	signer crypto.Signer
+	events []CustodyEvent
+}
+
+// NewCustodyLog starts a custody log signed with the dealer's Ed25519 key,
+// or continues one: events previously returned by Events or
+// ReadCustodyLog are verified against the key and appended to.
+func NewCustodyLog(signer crypto.Signer, events ...CustodyEvent) (*CustodyLog, error) {
+	key, ok := signer.Public().(ed25519.PublicKey)
+	if !ok {
+		return nil, errors.New("signer must hold an Ed25519 key")
+	}
+	if _, err := VerifyCustodyLog(events, key); err != nil {
+		return nil, err
+	}
+	return &CustodyLog{signer: signer, events: append([]CustodyEvent(nil), events...)}, nil
+}
+
+// Append signs e and adds it to the log. Seq and PrevHash are assigned by
+// the log, and a zero Time is set to the current time. The event as
+// recorded is returned.
+func (l *CustodyLog) Append(e CustodyEvent) (CustodyEvent, error) {
+	if e.Kind < EventSplit || e.Kind > EventCombine {
+		return CustodyEvent{}, fmt.Errorf("unknown custody event kind %v", e.Kind)
+	}
+	if e.SetID == "" {
+		return CustodyEvent{}, errors.New("custody event needs a set ID")
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if e.Time.IsZero() {
+		e.Time = time.Now()
+	}
+	e.Time = e.Time.UTC().Round(0)
+	e.Seq = uint64(len(l.events))
+	e.PrevHash = make([]byte, sha256.Size)
+	if n := len(l.events); n > 0 {
+		if e.Time.Before(l.events[n-1].Time) {
+			return CustodyEvent{}, errors.New("custody event is older than the previous event")
+		}
+		e.PrevHash = l.events[n-1].Hash()
+	}
+	e.Indices = append([]uint8(nil), e.Indices...)
+	if len(e.Indices) == 0 {
+		e.Indices = nil
+	}
+	sig, err := l.signer.Sign(rand.Reader, e.signedMessage(), crypto.Hash(0))
+	if err != nil {
+		return CustodyEvent{}, fmt.Errorf("signing custody event failed: %w", err)
+	}
+	e.Signature = sig
+	l.events = append(l.events, e)
+	return e, nil
+}
+
+// Events returns a copy of the log's events, oldest first.
+func (l *CustodyLog) Events() []CustodyEvent {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return append([]CustodyEvent(nil), l.events...)
+}
+
+// Head returns the hash of the last event, or nil for an empty log.
+// Publishing or recording the head elsewhere lets a later verification
+// detect events cut from the end of the log.
+func (l *CustodyLog) Head() []byte {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if len(l.events) == 0 {
+		return nil
+	}
+	return l.events[len(l.events)-1].Hash()
+}
+
+// VerifyCustodyLog checks a complete custody log against the dealer's
+// key: events must be numbered from 0, each chained to the one before it,
+// in time order and signed by key. It returns the log's head, to be
+// compared with a head recorded earlier; an empty log has a nil head. The
+// error wraps ErrCustodyLogTampered and names the first bad event.
+func VerifyCustodyLog(events []CustodyEvent, key ed25519.PublicKey) ([]byte, error) {
+	if len(key) != ed25519.PublicKeySize {
+		return nil, errors.New("invalid dealer public key")
+	}
+	prev := make([]byte, sha256.Size)
+	var prevTime time.Time
+	for i, e := range events {
+		switch {
+		case e.Seq != uint64(i):
+			return nil, fmt.Errorf("%w: event %d has sequence number %d", ErrCustodyLogTampered, i, e.Seq)
+		case !bytes.Equal(e.PrevHash, prev):
+			return nil, fmt.Errorf("%w: event %d does not follow event %d", ErrCustodyLogTampered, i, i-1)
+		case e.Time.Before(prevTime):
+			return nil, fmt.Errorf("%w: event %d is older than event %d", ErrCustodyLogTampered, i, i-1)
+		case !ed25519.Verify(key, e.signedMessage(), e.Signature):
+			return nil, fmt.Errorf("%w: event %d has an invalid signature", ErrCustodyLogTampered, i)
+		}
+		prev, prevTime = e.Hash(), e.Time
+	}
+	if len(events) == 0 {
+		return nil, nil
+	}
+	return prev, nil
+}
+
+// WriteCustodyLog writes events to w, each as a length-prefixed
+// MarshalBinary encoding. Appending new events to a file written this way
+// extends the log without rewriting it.
+func WriteCustodyLog(w io.Writer, events []CustodyEvent) error {
+	for _, e := range events {
+		data, err := e.MarshalBinary()
+		if err != nil {
+			return err
+		}
+		if _, err := w.Write(appendField(nil, data)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ReadCustodyLog reads events written by WriteCustodyLog. It does not
+// verify them; pass the result to VerifyCustodyLog or NewCustodyLog.
+func ReadCustodyLog(r io.Reader) ([]CustodyEvent, error) {
+	var events []CustodyEvent
+	var size [4]byte
+	for {
+		if _, err := io.ReadFull(r, size[:]); err == io.EOF {
+			return events, nil
+		} else if err != nil {
+			return nil, fmt.Errorf("reading custody event %d: %w", len(events), err)
+		}
+		n := binary.BigEndian.Uint32(size[:])
+		if n > maxCustodyEventSize {
+			return nil, fmt.Errorf("custody event %d is too large", len(events))
+		}
+		data := make([]byte, n)
+		if _, err := io.ReadFull(r, data); err != nil {
+			return nil, fmt.Errorf("reading custody event %d: %w", len(events), err)
+		}
+		var e CustodyEvent
+		if err := e.UnmarshalBinary(data); err != nil {
+			return nil, fmt.Errorf("custody event %d: %w", len(events), err)
+		}
+		events = append(events, e)
+	}
+}
@@ -0,0 +1,142 @@
+package goshamir
+
+import (
+	"bytes"
+	"crypto/ed25519"
+	"errors"
+	"testing"
+	"time"
+)
+
+// --- Custody Log Tests ---
+
+func newTestCustodyLog(t *testing.T) (*CustodyLog, ed25519.PublicKey, ed25519.PrivateKey) {
+	t.Helper()
+	pub, priv, _ := ed25519.GenerateKey(nil)
+	l, err := NewCustodyLog(priv)
+	if err != nil {
+		t.Fatalf("NewCustodyLog failed: %v", err)
+	}
+	for _, e := range []CustodyEvent{
+		{Kind: EventSplit, SetID: "db", Actor: "dealer", Detail: "3 of 5"},
+		{Kind: EventDistribute, SetID: "db", Actor: "dealer", Indices: []uint8{1, 2, 3, 4, 5}},
+		{Kind: EventVerify, SetID: "db", Actor: "alice", Indices: []uint8{1}},
+		{Kind: EventCombine, SetID: "db", Actor: "ops", Indices: []uint8{1, 3, 5}},
+	} {
+		if _, err := l.Append(e); err != nil {
+			t.Fatalf("Append failed: %v", err)
+		}
+	}
+	return l, pub, priv
+}
+
+func TestCustodyLog_Verify(t *testing.T) {
+	l, pub, _ := newTestCustodyLog(t)
+	events := l.Events()
+	head, err := VerifyCustodyLog(events, pub)
+	if err != nil {
+		t.Fatalf("VerifyCustodyLog failed: %v", err)
+	}
+	if !bytes.Equal(head, l.Head()) {
+		t.Fatal("head mismatch")
+	}
+	if events[3].Seq != 3 || events[3].Kind != EventCombine || !bytes.Equal(events[0].PrevHash, make([]byte, 32)) {
+		t.Fatalf("unexpected event %+v", events[3])
+	}
+
+	other, _, _ := ed25519.GenerateKey(nil)
+	if _, err := VerifyCustodyLog(events, other); !errors.Is(err, ErrCustodyLogTampered) {
+		t.Fatalf("expected ErrCustodyLogTampered for another key, got %v", err)
+	}
+}
+
+func TestCustodyLog_DetectsTampering(t *testing.T) {
+	l, pub, _ := newTestCustodyLog(t)
+	tamper := map[string]func([]CustodyEvent) []CustodyEvent{
+		"altered": func(ev []CustodyEvent) []CustodyEvent {
+			ev[2].Actor = "mallory"
+			return ev
+		},
+		"removed": func(ev []CustodyEvent) []CustodyEvent {
+			return append(ev[:1], ev[2:]...)
+		},
+		"reordered": func(ev []CustodyEvent) []CustodyEvent {
+			ev[1], ev[2] = ev[2], ev[1]
+			return ev
+		},
+		"renumbered": func(ev []CustodyEvent) []CustodyEvent {
+			ev = append(ev[:1], ev[2:]...)
+			for i := range ev {
+				ev[i].Seq = uint64(i)
+			}
+			return ev
+		},
+	}
+	for name, f := range tamper {
+		t.Run(name, func(t *testing.T) {
+			if _, err := VerifyCustodyLog(f(l.Events()), pub); !errors.Is(err, ErrCustodyLogTampered) {
+				t.Fatalf("expected ErrCustodyLogTampered, got %v", err)
+			}
+		})
+	}
+
+	// Truncation verifies but changes the head.
+	head, err := VerifyCustodyLog(l.Events()[:3], pub)
+	if err != nil || bytes.Equal(head, l.Head()) {
+		t.Fatalf("expected a different head for a truncated log, got %v", err)
+	}
+}
+
+func TestCustodyLog_PersistAndContinue(t *testing.T) {
+	l, pub, priv := newTestCustodyLog(t)
+	var buf bytes.Buffer
+	if err := WriteCustodyLog(&buf, l.Events()); err != nil {
+		t.Fatalf("WriteCustodyLog failed: %v", err)
+	}
+	events, err := ReadCustodyLog(bytes.NewReader(buf.Bytes()))
+	if err != nil {
+		t.Fatalf("ReadCustodyLog failed: %v", err)
+	}
+	resumed, err := NewCustodyLog(priv, events...)
+	if err != nil {
+		t.Fatalf("NewCustodyLog failed: %v", err)
+	}
+	if !bytes.Equal(resumed.Head(), l.Head()) {
+		t.Fatal("head changed across persistence")
+	}
+	e, err := resumed.Append(CustodyEvent{Kind: EventRefresh, SetID: "db", Actor: "dealer"})
+	if err != nil {
+		t.Fatalf("Append failed: %v", err)
+	}
+	if err := WriteCustodyLog(&buf, []CustodyEvent{e}); err != nil {
+		t.Fatalf("WriteCustodyLog failed: %v", err)
+	}
+	events, err = ReadCustodyLog(&buf)
+	if err != nil {
+		t.Fatalf("ReadCustodyLog failed: %v", err)
+	}
+	if _, err := VerifyCustodyLog(events, pub); err != nil || len(events) != 5 {
+		t.Fatalf("VerifyCustodyLog failed on %d events: %v", len(events), err)
+	}
+
+	events[1].Detail = "forged"
+	if _, err := NewCustodyLog(priv, events...); !errors.Is(err, ErrCustodyLogTampered) {
+		t.Fatalf("expected NewCustodyLog to reject a tampered log, got %v", err)
+	}
+	if _, err := ReadCustodyLog(bytes.NewReader([]byte{0, 0, 0, 9, 1})); err == nil {
+		t.Fatal("expected error for a truncated log")
+	}
+}
+
+func TestCustodyLog_AppendInvalid(t *testing.T) {
+	l, _, _ := newTestCustodyLog(t)
+	if _, err := l.Append(CustodyEvent{Kind: CustodyEventKind(42), SetID: "db"}); err == nil {
+		t.Error("expected error for unknown kind")
+	}
+	if _, err := l.Append(CustodyEvent{Kind: EventVerify}); err == nil {
+		t.Error("expected error for missing set ID")
+	}
+	if _, err := l.Append(CustodyEvent{Kind: EventVerify, SetID: "db", Time: time.Unix(0, 0)}); err == nil {
+		t.Error("expected error for an event older than the log")
+	}
+}
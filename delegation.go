@@ -0,0 +1,269 @@
+package goshamir
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// DelegationTreeVersion is the version of the DelegationTree JSON format.
+const DelegationTreeVersion = 1
+
+// ErrTreeUnsatisfied is returned by DelegationTree.Combine when the
+// custodians presenting shares cannot satisfy the tree.
+var ErrTreeUnsatisfied = errors.New("delegation tree not satisfied")
+
+// Holder is a node of a DelegationTree. A holder without members is an
+// individual custodian holding a share. A holder with members is an
+// organizational unit, such as a department, whose share is split among
+// its members with SplitShare and rebuilt from Threshold of them.
+type Holder struct {
+	Name      string   `json:"name"`
+	Threshold int      `json:"threshold,omitempty"`
+	Members   []Holder `json:"members,omitempty"`
+}
+
+// DelegationTree is a K-of-N custody policy whose holders may delegate
+// their share to a quorum of their own, to any depth, such as "2 of the
+// CEO, finance and legal, where finance is any 2 of its 3 officers".
+// Trees serialize to JSON with MarshalJSON and ParseDelegationTree, so the
+// policy can be stored alongside the shares.
+type DelegationTree struct {
+	Threshold int      `json:"threshold"`
+	Holders   []Holder `json:"holders"`
+}
+
+// DelegatedShare is the share of one custodian of a DelegationTree.
+type DelegatedShare struct {
+	// Path names the custodian and the units above them, outermost first,
+	// separated by "/", as in "finance/alice".
+	Path  string
+	Share Share
+}
+
+// ParseDelegationTree decodes and validates a tree produced by MarshalJSON.
+func ParseDelegationTree(data []byte) (*DelegationTree, error) {
+	var doc struct {
+		Version int `json:"version"`
+		DelegationTree
+	}
+	if err := json.Unmarshal(data, &doc); err != nil {
+		return nil, fmt.Errorf("invalid delegation tree: %w", err)
+	}
+	if doc.Version != DelegationTreeVersion {
+		return nil, fmt.Errorf("unsupported delegation tree version %d", doc.Version)
+	}
+	t := doc.DelegationTree
+	if err := t.Validate(); err != nil {
+		return nil, err
+	}
+	return &t, nil
+}
+
+// MarshalJSON encodes the tree with a format version.
+func (t DelegationTree) MarshalJSON() ([]byte, error) {
+	type tree DelegationTree
+	return json.Marshal(struct {
+		Version int `json:"version"`
+		tree
+	}{DelegationTreeVersion, tree(t)})
+}
+
+// Validate checks that every level of the tree describes a split this
+// library can perform and that names are unique among siblings.
+func (t *DelegationTree) Validate() error {
+	return validateHolders("", t.Threshold, t.Holders)
+}
+
+func validateHolders(path string, threshold int, holders []Holder) error {
+	if err := validateShareCounts(len(holders), threshold); err != nil {
+		if path == "" {
+			return err
+		}
+		return fmt.Errorf("unit %q: %w", path, err)
+	}
+	seen := make(map[string]bool, len(holders))
+	for _, h := range holders {
+		if h.Name == "" || strings.Contains(h.Name, "/") {
+			return fmt.Errorf("invalid holder name %q in %q", h.Name, path)
+		}
+		if seen[h.Name] {
+			return fmt.Errorf("duplicate holder %q in %q", h.Name, path)
+		}
+		seen[h.Name] = true
+		if len(h.Members) == 0 {
+			if h.Threshold != 0 {
+				return fmt.Errorf("holder %q has a threshold but no members", joinPath(path, h.Name))
+			}
+			continue
+		}
+		if err := validateHolders(joinPath(path, h.Name), h.Threshold, h.Members); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Custodians returns the paths of all custodians, in tree order.
+func (t *DelegationTree) Custodians() []string {
+	var paths []string
+	var walk func(path string, holders []Holder)
+	walk = func(path string, holders []Holder) {
+		for _, h := range holders {
+			if len(h.Members) == 0 {
+				paths = append(paths, joinPath(path, h.Name))
+			} else {
+				walk(joinPath(path, h.Name), h.Members)
+			}
+		}
+	}
+	walk("", t.Holders)
+	return paths
+}
+
+// Satisfied reports whether the custodians with the given paths can
+// reconstruct the secret together.
+func (t *DelegationTree) Satisfied(paths []string) bool {
+	return satisfied("", t.Threshold, t.Holders, paths)
+}
+
+func satisfied(path string, threshold int, holders []Holder, paths []string) bool {
+	n := 0
+	for _, h := range holders {
+		p := joinPath(path, h.Name)
+		if len(h.Members) == 0 && slices.Contains(paths, p) ||
+			len(h.Members) > 0 && satisfied(p, h.Threshold, h.Members, paths) {
+			n++
+		}
+	}
+	return n >= threshold
+}
+
+// Split splits secret according to the tree, returning one share per
+// custodian in tree order. Options apply at every level.
+func (t *DelegationTree) Split(secret []byte, opts ...Option) ([]DelegatedShare, error) {
+	if err := t.Validate(); err != nil {
+		return nil, err
+	}
+	splitter, err := NewSplitter(len(t.Holders), t.Threshold, opts...)
+	if err != nil {
+		return nil, err
+	}
+	shares, err := splitter.Split(secret)
+	if err != nil {
+		return nil, err
+	}
+	return delegate("", t.Holders, shares, opts)
+}
+
+func delegate(path string, holders []Holder, shares []Share, opts []Option) ([]DelegatedShare, error) {
+	var out []DelegatedShare
+	for i, h := range holders {
+		p := joinPath(path, h.Name)
+		if len(h.Members) == 0 {
+			out = append(out, DelegatedShare{Path: p, Share: shares[i]})
+			continue
+		}
+		subs, err := SplitShare(shares[i], len(h.Members), h.Threshold, opts...)
+		clear(shares[i].Value)
+		if err != nil {
+			return nil, fmt.Errorf("unit %q: %w", p, err)
+		}
+		delegated, err := delegate(p, h.Members, subs, opts)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, delegated...)
+	}
+	return out, nil
+}
+
+// Combine reconstructs the secret from custodians' shares, resolving each
+// unit's quorum recursively. Each share is checked against the position of
+// its custodian in the tree, so shares cannot be presented under another
+// custodian's path. If the custodians cannot satisfy the tree, the error
+// names the units lacking a quorum.
+func (t *DelegationTree) Combine(shares []DelegatedShare) ([]byte, error) {
+	if err := t.Validate(); err != nil {
+		return nil, err
+	}
+	positions := make(map[string][]ShareParent)
+	walkPositions("", t.Holders, nil, positions)
+
+	var paths []string
+	var flat []Share
+	for _, ds := range shares {
+		pos, ok := positions[ds.Path]
+		if !ok {
+			return nil, fmt.Errorf("unknown custodian %q", ds.Path)
+		}
+		parents, index := pos[:len(pos)-1], pos[len(pos)-1].Index
+		if ds.Share.Index != index || !slices.Equal(ds.Share.Parents, parents) {
+			return nil, fmt.Errorf("share of %q does not match its position in the tree", ds.Path)
+		}
+		if slices.Contains(paths, ds.Path) {
+			return nil, fmt.Errorf("custodian %q appears twice", ds.Path)
+		}
+		paths = append(paths, ds.Path)
+		flat = append(flat, ds.Share)
+	}
+	if !t.Satisfied(paths) {
+		return nil, fmt.Errorf("%w: %s", ErrTreeUnsatisfied, strings.Join(missingQuorums("", t.Threshold, t.Holders, paths), "; "))
+	}
+	return CombineNested(flat, t.Threshold)
+}
+
+// walkPositions records, for each custodian path, the parents its share
+// carries followed by its own index (with a zero threshold).
+func walkPositions(path string, holders []Holder, parents []ShareParent, out map[string][]ShareParent) {
+	for i, h := range holders {
+		p := joinPath(path, h.Name)
+		if len(h.Members) == 0 {
+			out[p] = append(slices.Clone(parents), ShareParent{Index: uint8(i + 1)})
+			continue
+		}
+		walkPositions(p, h.Members, append(slices.Clone(parents), ShareParent{Index: uint8(i + 1), Threshold: uint8(h.Threshold)}), out)
+	}
+}
+
+// missingQuorums explains which quorums are not met, as in
+// "root: need 1 more of ceo, finance, legal".
+func missingQuorums(path string, threshold int, holders []Holder, paths []string) []string {
+	var problems, unmet []string
+	n := 0
+	for _, h := range holders {
+		p := joinPath(path, h.Name)
+		switch {
+		case len(h.Members) == 0 && slices.Contains(paths, p):
+			n++
+		case len(h.Members) > 0 && satisfied(p, h.Threshold, h.Members, paths):
+			n++
+		default:
+			unmet = append(unmet, h.Name)
+		}
+	}
+	if n >= threshold {
+		return nil
+	}
+	name := path
+	if name == "" {
+		name = "root"
+	}
+	problems = append(problems, fmt.Sprintf("%s: need %d more of %s", name, threshold-n, strings.Join(unmet, ", ")))
+	for _, h := range holders {
+		p := joinPath(path, h.Name)
+		if len(h.Members) > 0 && slices.Contains(unmet, h.Name) {
+			problems = append(problems, missingQuorums(p, h.Threshold, h.Members, paths)...)
+		}
+	}
+	return problems
+}
+
+func joinPath(path, name string) string {
+	if path == "" {
+		return name
+	}
+	return path + "/" + name
+}
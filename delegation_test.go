@@ -0,0 +1,125 @@
+package goshamir
+
+import (
+	"errors"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func testDelegationTree() *DelegationTree {
+	return &DelegationTree{
+		Threshold: 2,
+		Holders: []Holder{
+			{Name: "ceo"},
+			{Name: "finance", Threshold: 2, Members: []Holder{
+				{Name: "alice"},
+				{Name: "bob"},
+				{Name: "treasury", Threshold: 2, Members: []Holder{{Name: "carol"}, {Name: "dan"}}},
+			}},
+			{Name: "legal", Threshold: 2, Members: []Holder{{Name: "erin"}, {Name: "frank"}}},
+		},
+	}
+}
+
+func pick(shares []DelegatedShare, paths ...string) []DelegatedShare {
+	var out []DelegatedShare
+	for _, ds := range shares {
+		if slices.Contains(paths, ds.Path) {
+			out = append(out, ds)
+		}
+	}
+	return out
+}
+
+// --- DelegationTree Tests ---
+
+func TestDelegationTree_SplitCombine(t *testing.T) {
+	tree := testDelegationTree()
+	shares, err := tree.Split([]byte("org secret"))
+	if err != nil {
+		t.Fatalf("Split failed: %v", err)
+	}
+	want := []string{"ceo", "finance/alice", "finance/bob", "finance/treasury/carol", "finance/treasury/dan", "legal/erin", "legal/frank"}
+	if got := tree.Custodians(); !slices.Equal(got, want) {
+		t.Fatalf("Expected custodians %v, got %v", want, got)
+	}
+	for i, ds := range shares {
+		if ds.Path != want[i] {
+			t.Errorf("Share %d: expected path %s, got %s", i, want[i], ds.Path)
+		}
+	}
+
+	for _, quorum := range [][]string{
+		{"ceo", "legal/erin", "legal/frank"},
+		{"finance/alice", "finance/treasury/carol", "finance/treasury/dan", "ceo"},
+		{"finance/alice", "finance/bob", "legal/erin", "legal/frank"},
+	} {
+		if !tree.Satisfied(quorum) {
+			t.Errorf("Expected %v to satisfy the tree", quorum)
+		}
+		secret, err := tree.Combine(pick(shares, quorum...))
+		if err != nil || string(secret) != "org secret" {
+			t.Errorf("Combine with %v failed: %q, %v", quorum, secret, err)
+		}
+	}
+
+	_, err = tree.Combine(pick(shares, "ceo", "finance/alice", "finance/treasury/carol", "legal/erin"))
+	if !errors.Is(err, ErrTreeUnsatisfied) {
+		t.Fatalf("Expected ErrTreeUnsatisfied, got %v", err)
+	}
+	for _, want := range []string{"root: need 1 more of finance, legal", "finance: need 1 more of bob, treasury", "finance/treasury: need 1 more of dan", "legal: need 1 more of frank"} {
+		if !strings.Contains(err.Error(), want) {
+			t.Errorf("Expected error to contain %q, got %q", want, err)
+		}
+	}
+}
+
+func TestDelegationTree_Positions(t *testing.T) {
+	tree := testDelegationTree()
+	shares, _ := tree.Split([]byte("org secret"))
+	ceo, erin := pick(shares, "ceo")[0], pick(shares, "legal/erin")[0]
+
+	swapped := []DelegatedShare{{Path: "legal/frank", Share: erin.Share}, erin, ceo}
+	if _, err := tree.Combine(swapped); err == nil || !strings.Contains(err.Error(), "does not match its position") {
+		t.Errorf("Expected position error, got %v", err)
+	}
+	if _, err := tree.Combine([]DelegatedShare{ceo, ceo}); err == nil {
+		t.Error("Expected error for a repeated custodian")
+	}
+	if _, err := tree.Combine([]DelegatedShare{{Path: "mallory", Share: ceo.Share}}); err == nil {
+		t.Error("Expected error for an unknown custodian")
+	}
+}
+
+func TestDelegationTree_JSON(t *testing.T) {
+	tree := testDelegationTree()
+	data, err := tree.MarshalJSON()
+	if err != nil {
+		t.Fatalf("MarshalJSON failed: %v", err)
+	}
+	if !strings.HasPrefix(string(data), `{"version":1,"threshold":2,"holders":[{"name":"ceo"},{"name":"finance","threshold":2`) {
+		t.Errorf("Unexpected JSON %s", data)
+	}
+	parsed, err := ParseDelegationTree(data)
+	if err != nil {
+		t.Fatalf("ParseDelegationTree failed: %v", err)
+	}
+	if !slices.Equal(parsed.Custodians(), tree.Custodians()) || parsed.Holders[1].Members[2].Threshold != 2 {
+		t.Errorf("Tree changed in round trip: %+v", parsed)
+	}
+
+	for _, bad := range []string{
+		`{"version":2,"threshold":2,"holders":[{"name":"a"},{"name":"b"}]}`,
+		`{"version":1,"threshold":3,"holders":[{"name":"a"},{"name":"b"}]}`,
+		`{"version":1,"threshold":2,"holders":[{"name":"a"},{"name":"a"}]}`,
+		`{"version":1,"threshold":2,"holders":[{"name":"a/b"},{"name":"c"}]}`,
+		`{"version":1,"threshold":2,"holders":[{"name":"a","threshold":2},{"name":"b"}]}`,
+		`{"version":1,"threshold":2,"holders":[{"name":"a","threshold":1,"members":[{"name":"x"}]},{"name":"b"}]}`,
+		`not json`,
+	} {
+		if _, err := ParseDelegationTree([]byte(bad)); err == nil {
+			t.Errorf("Expected error for %s", bad)
+		}
+	}
+}
@@ -0,0 +1,69 @@
+package goshamir
+
+import (
+	"crypto/hkdf"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"io"
+)
+
+const (
+	// SeedSize is the size in bytes of the random seed split by
+	// SplitSeedAndDerive.
+	SeedSize = 32
+	// DerivedKeySize is the size in bytes of keys returned by
+	// SplitSeedAndDerive and CombineAndDerive.
+	DerivedKeySize = 32
+)
+
+// SplitSeedAndDerive generates a random seed, splits the seed into shares
+// and returns a key derived from it with HKDF-SHA256 under info. The
+// application works with the derived key while the shares only ever hold
+// the seed; CombineAndDerive with the same info re-derives the identical key.
+// Different info values yield independent keys from the same shares.
+func SplitSeedAndDerive(info []byte, totalShares, threshold int, opts ...Option) ([]Share, []byte, error) {
+	splitter, err := NewSplitter(totalShares, threshold, opts...)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	seed := make([]byte, SeedSize)
+	defer clear(seed)
+	if _, err := io.ReadFull(splitter.config.Rand, seed); err != nil {
+		return nil, nil, fmt.Errorf("seed generation failed: %w", err)
+	}
+
+	key, err := deriveKey(seed, info)
+	if err != nil {
+		return nil, nil, err
+	}
+	shares, err := splitter.Split(seed)
+	if err != nil {
+		clear(key)
+		return nil, nil, err
+	}
+	return shares, key, nil
+}
+
+// CombineAndDerive reconstructs the seed from shares created by
+// SplitSeedAndDerive and returns the key derived from it under info.
+func CombineAndDerive(shares []Share, threshold int, info []byte) ([]byte, error) {
+	seed, err := Combine(shares, threshold)
+	if err != nil {
+		return nil, err
+	}
+	defer clear(seed)
+	if len(seed) != SeedSize {
+		return nil, fmt.Errorf("reconstructed seed has %d bytes, expected %d", len(seed), SeedSize)
+	}
+	return deriveKey(seed, info)
+}
+
+func deriveKey(seed, info []byte) ([]byte, error) {
+	key, err := hkdf.Key(sha256.New, seed, nil, string(info), DerivedKeySize)
+	if err != nil {
+		return nil, errors.New("key derivation failed")
+	}
+	return key, nil
+}
@@ -0,0 +1,60 @@
+package goshamir
+
+import (
+	"bytes"
+	"testing"
+)
+
+// --- Key Derivation Tests ---
+
+func TestSplitSeedAndDerive_RoundTrip(t *testing.T) {
+	info := []byte("backup-encryption-key/v1")
+	shares, key, err := SplitSeedAndDerive(info, 5, 3)
+	if err != nil {
+		t.Fatalf("SplitSeedAndDerive failed: %v", err)
+	}
+	if len(key) != DerivedKeySize {
+		t.Fatalf("Expected %d-byte key, got %d", DerivedKeySize, len(key))
+	}
+
+	derived, err := CombineAndDerive([]Share{shares[4], shares[2], shares[0]}, 3, info)
+	if err != nil {
+		t.Fatalf("CombineAndDerive failed: %v", err)
+	}
+	if !bytes.Equal(key, derived) {
+		t.Error("Derived key does not match")
+	}
+
+	// The shares must hold the seed, not the key itself.
+	seed, err := Combine(shares[:3], 3)
+	if err != nil {
+		t.Fatalf("Combine failed: %v", err)
+	}
+	if bytes.Equal(seed, key) {
+		t.Error("Shares must not contain the derived key")
+	}
+
+	other, err := CombineAndDerive(shares[:3], 3, []byte("other purpose"))
+	if err != nil {
+		t.Fatalf("CombineAndDerive failed: %v", err)
+	}
+	if bytes.Equal(key, other) {
+		t.Error("Different info must derive a different key")
+	}
+}
+
+func TestCombineAndDerive_WrongSeedLength(t *testing.T) {
+	shares, err := Split([]byte("not a seed"), 3, 2)
+	if err != nil {
+		t.Fatalf("Split failed: %v", err)
+	}
+	if _, err := CombineAndDerive(shares, 2, nil); err == nil {
+		t.Error("Expected error for shares that do not hold a seed")
+	}
+}
+
+func TestSplitSeedAndDerive_InvalidParams(t *testing.T) {
+	if _, _, err := SplitSeedAndDerive(nil, 2, 3); err == nil {
+		t.Error("Expected error when totalShares < threshold")
+	}
+}
@@ -0,0 +1,163 @@
+package goshamir
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// ErrForeignShare is returned by DetectShareFormat for shares it
+// recognizes but cannot convert. The error explains why and what to do
+// instead.
+var ErrForeignShare = errors.New("share format is not compatible")
+
+// ShareFormat identifies the tool and encoding a share was produced with.
+type ShareFormat int
+
+const (
+	// FormatUnknown means the input is not a recognized share.
+	FormatUnknown ShareFormat = iota
+	// FormatHex is this library's "index:hex" text form, including the
+	// "v2:" prefixed form of GF(2^8) shares.
+	FormatHex
+	// FormatEnvelope is this library's binary envelope, from
+	// Share.MarshalBinary.
+	FormatEnvelope
+	// FormatV2Text is the base64 text form of package v2.
+	FormatV2Text
+	// FormatWeighted is a weighted share from WeightedShare.MarshalBinary.
+	FormatWeighted
+	// FormatVault is a HashiCorp Vault unseal or recovery key share.
+	FormatVault
+	// FormatSSSS is a share of the ssss(1) command-line tool.
+	FormatSSSS
+	// FormatSLIP39 is a SLIP-39 mnemonic share.
+	FormatSLIP39
+	// FormatBIP39 is a BIP-39 mnemonic, which is a whole secret rather
+	// than a share.
+	FormatBIP39
+	// FormatCompact is this library's one-line Crockford base32 form,
+	// from EncodeShareCompact.
+	FormatCompact
+)
+
+// String returns a short name for the format, such as "vault".
+func (f ShareFormat) String() string {
+	switch f {
+	case FormatUnknown:
+		return "unknown"
+	case FormatHex:
+		return "hex"
+	case FormatEnvelope:
+		return "envelope"
+	case FormatV2Text:
+		return "v2-text"
+	case FormatWeighted:
+		return "weighted"
+	case FormatVault:
+		return "vault"
+	case FormatSSSS:
+		return "ssss"
+	case FormatSLIP39:
+		return "slip39"
+	case FormatBIP39:
+		return "bip39"
+	case FormatCompact:
+		return "compact"
+	}
+	return fmt.Sprintf("ShareFormat(%d)", int(f))
+}
+
+// ssssPattern matches an ssss(1) share: an optional token, the share
+// number and the hex share value.
+var ssssPattern = regexp.MustCompile(`^(?:([^\s-]+)-)?([0-9]+)-([0-9a-f]+)$`)
+
+// DetectShareFormat recognizes a share produced by this library or another
+// common tool, because recoveries often mix tools. The format is always
+// reported. Shares of this library, and Vault key shares, which use the
+// same field as SchemeV2GF256, are converted and returned. For other
+// recognized formats, the error wraps ErrForeignShare and explains the
+// incompatibility. Vault shares carry no marker, so input matching no other
+// format that decodes as base64 or hex is taken to be one.
+func DetectShareFormat(data []byte) (ShareFormat, Share, error) {
+	if len(data) >= 2 && data[0] == envelopeMagic[0] && data[1] == envelopeMagic[1] {
+		var s Share
+		if err := s.UnmarshalBinary(data); err != nil {
+			return FormatEnvelope, Share{}, err
+		}
+		return FormatEnvelope, s, nil
+	}
+	if len(data) >= 2 && data[0] == weightedMagic[0] && data[1] == weightedMagic[1] {
+		var w WeightedShare
+		if err := w.UnmarshalBinary(data); err == nil {
+			return FormatWeighted, Share{}, fmt.Errorf("%w: weighted share holding %d points; decode it with WeightedShare.UnmarshalBinary", ErrForeignShare, w.Weight())
+		}
+	}
+
+	text := strings.TrimSpace(string(data))
+	if text == "" {
+		return FormatUnknown, Share{}, errors.New("no share data")
+	}
+	if shares, err := DecodeSharesFromHex([]string{text}); err == nil {
+		return FormatHex, shares[0], nil
+	}
+	if len(text) >= len(compactPrefix) && strings.EqualFold(text[:len(compactPrefix)], compactPrefix) {
+		s, err := DecodeShareCompact(text)
+		return FormatCompact, s, err
+	}
+	if raw, err := base64.RawURLEncoding.DecodeString(text); err == nil && bytes.HasPrefix(raw, envelopeMagic[:]) {
+		var s Share
+		if err := s.UnmarshalBinary(raw); err == nil {
+			return FormatV2Text, s, nil
+		}
+	}
+	if m := ssssPattern.FindStringSubmatch(text); m != nil {
+		return FormatSSSS, Share{}, fmt.Errorf("%w: ssss(1) share %s works in GF(2^%d) with a diffusion layer; combine it with package ssss or ssss-combine", ErrForeignShare, m[2], 4*len(m[3]))
+	}
+	if words := strings.Fields(strings.ToLower(text)); len(words) > 1 {
+		return detectMnemonic(words)
+	}
+	if s, ok := decodeVaultShare(text); ok {
+		return FormatVault, s, nil
+	}
+	return FormatUnknown, Share{}, errors.New("unrecognized share format")
+}
+
+// detectMnemonic tells BIP-39 mnemonics from SLIP-39 shares.
+func detectMnemonic(words []string) (ShareFormat, Share, error) {
+	inBIP39 := true
+	for _, w := range words {
+		if _, ok := bip39Index[w]; !ok {
+			inBIP39 = false
+			break
+		}
+	}
+	switch {
+	case inBIP39 && len(words)%3 == 0 && len(words) >= 12 && len(words) <= 24:
+		return FormatBIP39, Share{}, fmt.Errorf("%w: a %d-word BIP-39 mnemonic is a whole secret, not a share; split it with SplitMnemonicSeed", ErrForeignShare, len(words))
+	case len(words) >= 20:
+		return FormatSLIP39, Share{}, fmt.Errorf("%w: SLIP-39 shares are encrypted and checksummed with their own scheme; recover the master secret with a SLIP-39 wallet or library, then split it again", ErrForeignShare)
+	}
+	return FormatUnknown, Share{}, errors.New("unrecognized mnemonic")
+}
+
+// decodeVaultShare decodes a Vault key share, in base64 or hex: the share
+// value followed by its x-coordinate byte. Vault's Shamir implementation
+// works in GF(2^8) with the AES polynomial, like SchemeV2GF256.
+func decodeVaultShare(text string) (Share, bool) {
+	// Hex digits are also valid base64, so hex is tried first.
+	raw, err := hex.DecodeString(text)
+	if err != nil {
+		if raw, err = base64.StdEncoding.DecodeString(text); err != nil {
+			return Share{}, false
+		}
+	}
+	if len(raw) < 2 || raw[len(raw)-1] == 0 {
+		return Share{}, false
+	}
+	return Share{Index: raw[len(raw)-1], Value: raw[:len(raw)-1], Scheme: SchemeV2GF256}, true
+}
@@ -0,0 +1,95 @@
+package goshamir
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"strings"
+	"testing"
+)
+
+// --- Share Format Detection Tests ---
+
+func TestDetectShareFormat_Native(t *testing.T) {
+	shares, _ := Split([]byte("native"), 3, 2)
+	encoded, _ := EncodeSharesToHex(shares[:1])
+	envelope, _ := shares[1].MarshalBinary()
+	compact, _ := EncodeShareCompact(shares[2])
+
+	tests := []struct {
+		name  string
+		input []byte
+		want  ShareFormat
+		index uint8
+	}{
+		{"hex", []byte(encoded[0] + "\n"), FormatHex, 1},
+		{"envelope", envelope, FormatEnvelope, 2},
+		{"v2 text", []byte(base64.RawURLEncoding.EncodeToString(envelope)), FormatV2Text, 2},
+		{"compact", []byte(compact), FormatCompact, 3},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			format, s, err := DetectShareFormat(tt.input)
+			if err != nil {
+				t.Fatalf("DetectShareFormat failed: %v", err)
+			}
+			if format != tt.want || s.Index != tt.index {
+				t.Fatalf("got %v share %d, want %v share %d", format, s.Index, tt.want, tt.index)
+			}
+		})
+	}
+}
+
+func TestDetectShareFormat_Vault(t *testing.T) {
+	// Vault appends the x-coordinate to each share and encodes it in
+	// base64; its arithmetic is GF(2^8) with the AES polynomial.
+	secret := []byte("vault unseal key material 32 by!")
+	s, _ := NewSplitter(5, 3, WithScheme(SchemeV2GF256))
+	shares, _ := s.Split(secret)
+	var converted []Share
+	for i, sh := range shares[:3] {
+		raw := append(bytes.Clone(sh.Value), sh.Index)
+		text := base64.StdEncoding.EncodeToString(raw)
+		if i == 1 {
+			text = hex.EncodeToString(raw)
+		}
+		format, share, err := DetectShareFormat([]byte(text))
+		if err != nil || format != FormatVault {
+			t.Fatalf("DetectShareFormat = %v, %v", format, err)
+		}
+		converted = append(converted, share)
+	}
+	got, err := Combine(converted, 3)
+	if err != nil || !bytes.Equal(got, secret) {
+		t.Fatalf("Combine of Vault shares = %q, %v", got, err)
+	}
+}
+
+func TestDetectShareFormat_Foreign(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  ShareFormat
+		hint  string
+	}{
+		{"ssss", "3-5c9a6b2e4d1f", FormatSSSS, "ssss-combine"},
+		{"ssss token", "backup-1-0123456789abcdef", FormatSSSS, "GF(2^64)"},
+		{"bip39", "abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon about", FormatBIP39, "SplitMnemonicSeed"},
+		{"slip39", "duckling enlarge academic academic agency result length solution fridge kidney coal piece deal husband erode duke ajar critical decision keyboard", FormatSLIP39, "SLIP-39"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			format, _, err := DetectShareFormat([]byte(tt.input))
+			if format != tt.want {
+				t.Fatalf("format = %v, want %v", format, tt.want)
+			}
+			if !errors.Is(err, ErrForeignShare) || !strings.Contains(err.Error(), tt.hint) {
+				t.Fatalf("unexpected error %v", err)
+			}
+		})
+	}
+	if format, _, err := DetectShareFormat([]byte("hello?")); format != FormatUnknown || err == nil {
+		t.Fatalf("expected unknown format, got %v, %v", format, err)
+	}
+}
@@ -0,0 +1,54 @@
+package goshamir
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/sha256"
+	"io"
+	"sync"
+)
+
+// deterministicLabel domain-separates the coefficient key from other uses
+// of a test seed.
+const deterministicLabel = "go-shamir deterministic coefficients v1\x00"
+
+// WithDeterministicCoefficients derives every random value, including
+// polynomial coefficients, from seed instead of crypto/rand, so the same
+// secret, parameters and seed always produce the same shares. It lets
+// downstream integration tests compare shares against golden files.
+//
+// UNSAFE: it is for tests only. Anyone who knows the seed can compute the
+// secret from a single share, and reusing a seed across secrets leaks their
+// difference. Never use it with real secrets. Splitters built with it log
+// a warning through WithLogger.
+//
+// The output is deterministic only for sequential use: concurrent Split
+// calls on one Splitter draw from the same stream in an unspecified order.
+// Each constructor applying the option starts the stream afresh. The
+// derivation (AES-256-CTR keyed with SHA-256 of a label and seed, zero IV)
+// is stable across releases for a given scheme and operation.
+func WithDeterministicCoefficients(seed []byte) Option {
+	key := sha256.Sum256(append([]byte(deterministicLabel), seed...))
+	return func(c *Config) {
+		block, err := aes.NewCipher(key[:])
+		if err != nil {
+			panic(err) // unreachable: the key is always 32 bytes
+		}
+		var iv [aes.BlockSize]byte
+		c.Rand = &lockedReader{r: cipher.StreamReader{S: cipher.NewCTR(block, iv[:]), R: zeroReader{}}}
+		c.Deterministic = true
+	}
+}
+
+// lockedReader serializes reads from a reader that is not safe for
+// concurrent use.
+type lockedReader struct {
+	mu sync.Mutex
+	r  io.Reader
+}
+
+func (l *lockedReader) Read(p []byte) (int, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.r.Read(p)
+}
@@ -0,0 +1,72 @@
+package goshamir
+
+import (
+	"bytes"
+	"log/slog"
+	"slices"
+	"strings"
+	"testing"
+)
+
+// --- WithDeterministicCoefficients Tests ---
+
+func TestWithDeterministicCoefficients_Golden(t *testing.T) {
+	// These values must never change: downstream projects compare them
+	// against their golden files.
+	golden := map[Scheme][]string{
+		SchemeV1GF257: {"1:e4009c00fb003e009f00", "2:5f00d30089001000cf00", "3:db0009001700e300ff00"},
+		SchemeV2GF256: {"v2:1:70f8c51ee9", "v2:2:5844258878", "v2:3:40d98cfafe"},
+	}
+	for scheme, want := range golden {
+		splitter, err := NewSplitter(3, 2, WithScheme(scheme), WithDeterministicCoefficients([]byte("golden")))
+		if err != nil {
+			t.Fatalf("NewSplitter failed: %v", err)
+		}
+		shares, err := splitter.Split([]byte("hello"))
+		if err != nil {
+			t.Fatalf("Split failed: %v", err)
+		}
+		got, _ := EncodeSharesToHex(shares)
+		if !slices.Equal(got, want) {
+			t.Errorf("Scheme %s: expected %q, got %q", scheme, want, got)
+		}
+		secret, err := Combine(shares[1:], 2)
+		if err != nil || string(secret) != "hello" {
+			t.Errorf("Combine failed: %q, %v", secret, err)
+		}
+	}
+}
+
+func TestWithDeterministicCoefficients_Seeds(t *testing.T) {
+	split := func(seed string) []Share {
+		splitter, _ := NewSplitter(5, 3, WithDeterministicCoefficients([]byte(seed)))
+		shares, err := splitter.Split([]byte("deterministic"))
+		if err != nil {
+			t.Fatalf("Split failed: %v", err)
+		}
+		return shares
+	}
+	a, b, c := split("seed"), split("seed"), split("other")
+	for i := range a {
+		if !bytes.Equal(a[i].Value, b[i].Value) {
+			t.Errorf("Share %d differs for the same seed", i+1)
+		}
+	}
+	if bytes.Equal(a[0].Value, c[0].Value) {
+		t.Error("Expected different shares for different seeds")
+	}
+}
+
+func TestWithDeterministicCoefficients_Warns(t *testing.T) {
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewTextHandler(&buf, nil))
+	NewSplitter(3, 2, WithLogger(logger), WithDeterministicCoefficients(nil))
+	if !strings.Contains(buf.String(), "deterministic coefficients") {
+		t.Errorf("Expected a warning, got %q", buf.String())
+	}
+	buf.Reset()
+	NewSplitter(3, 2, WithLogger(logger))
+	if buf.Len() != 0 {
+		t.Errorf("Unexpected log output %q", buf.String())
+	}
+}
@@ -0,0 +1,104 @@
+package goshamir
+
+// Check is the outcome of one of the checks performed by CanCombine.
+type Check struct {
+	// Name identifies the check: "threshold", "quorum", "length",
+	// "scheme", "indices", "values" or "nesting".
+	Name string
+	OK   bool
+	// Problem describes why the check failed, and is empty if it passed.
+	Problem string
+}
+
+// Report describes whether a set of shares would reconstruct a secret.
+type Report struct {
+	// OK reports whether Combine would succeed with the same arguments.
+	OK        bool
+	Threshold int
+	// Provided is the number of shares given; Used is the number Combine
+	// would read, which is at most Threshold.
+	Provided int
+	Used     int
+	Scheme   Scheme
+	// SecretLength is the size of the secret Combine would return, or -1
+	// if it cannot be determined.
+	SecretLength int
+	// Fingerprints identifies the used shares, as reported by InspectShare.
+	Fingerprints []string
+	Checks       []Check
+}
+
+// Failed returns the checks that did not pass.
+func (r Report) Failed() []Check {
+	var failed []Check
+	for _, c := range r.Checks {
+		if !c.OK {
+			failed = append(failed, c)
+		}
+	}
+	return failed
+}
+
+// CanCombine reports whether Combine(shares, threshold) would succeed,
+// without reconstructing the secret, for validating shares before a
+// recovery ceremony. Every check is run, so the report lists all problems
+// found; the returned error is the one Combine would return, or nil.
+//
+// Like Combine, CanCombine only reads the first threshold shares. Shares
+// from different secrets with matching parameters cannot be told apart
+// without a commitment; see NewShareMerkleTree.
+func CanCombine(shares []Share, threshold int) (_ Report, err error) {
+	defer recoverInternal(&err)
+
+	used := shares
+	if len(used) > threshold && threshold > 0 {
+		used = used[:threshold]
+	}
+	r := Report{Threshold: threshold, Provided: len(shares), Used: len(used), SecretLength: -1}
+	for _, s := range used {
+		r.Fingerprints = append(r.Fingerprints, shareFingerprint(s))
+	}
+	check := func(name string, err error) {
+		c := Check{Name: name, OK: err == nil}
+		if err != nil {
+			c.Problem = err.Error()
+		}
+		r.Checks = append(r.Checks, c)
+	}
+
+	check("threshold", checkThreshold(threshold))
+	check("quorum", checkQuorum(shares, threshold))
+	if len(used) == 0 {
+		return r, validateCombineParams(shares, threshold)
+	}
+	check("length", checkLengths(used))
+	scheme, schemeErr := sharesScheme(used)
+	check("scheme", schemeErr)
+	check("indices", validateShareIndices(used))
+	if schemeErr == nil {
+		r.Scheme = scheme
+		valuesErr := checkValues(used, scheme)
+		check("values", valuesErr)
+		if valuesErr == nil && checkLengths(used) == nil {
+			r.SecretLength = len(used[0].Value) / scheme.bytesPerElement()
+		}
+	} else {
+		r.Scheme = schemeOf(used[0])
+	}
+	check("nesting", checkNesting(used))
+
+	// Report the error Combine would, checking in the same order.
+	err = validateCombineParams(shares, threshold)
+	if err == nil {
+		_, err = sharesScheme(used)
+	}
+	if err == nil {
+		err = validateShareIndices(used)
+	}
+	if err == nil {
+		err = checkValues(used, scheme)
+	}
+
+	r.OK = err == nil
+	return r, err
+}
@@ -0,0 +1,94 @@
+package goshamir
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+// --- CanCombine Tests ---
+
+func TestCanCombine(t *testing.T) {
+	shares, _ := Split([]byte("ceremony"), 5, 3)
+	r, err := CanCombine(shares, 3)
+	if err != nil || !r.OK {
+		t.Fatalf("CanCombine failed: %v", err)
+	}
+	if r.Provided != 5 || r.Used != 3 || r.Scheme != SchemeV1GF257 || r.SecretLength != 8 {
+		t.Errorf("Unexpected report: %+v", r)
+	}
+	if len(r.Fingerprints) != 3 || r.Fingerprints[0] != InspectShare(shares[0]).Fingerprint {
+		t.Errorf("Unexpected fingerprints %q", r.Fingerprints)
+	}
+	if len(r.Failed()) != 0 || len(r.Checks) != 7 {
+		t.Errorf("Unexpected checks %+v", r.Checks)
+	}
+
+	splitter, _ := NewSplitter(3, 2, WithScheme(SchemeV2GF256))
+	compact, _ := splitter.Split([]byte("ceremony"))
+	if r, err := CanCombine(compact, 2); err != nil || r.Scheme != SchemeV2GF256 || r.SecretLength != 8 {
+		t.Errorf("Unexpected compact report: %+v, %v", r, err)
+	}
+}
+
+func TestCanCombine_DoesNotRevealSecret(t *testing.T) {
+	secret := []byte("do not leak me")
+	shares, _ := Split(secret, 3, 2)
+	r, _ := CanCombine(shares, 2)
+	var buf bytes.Buffer
+	for _, c := range r.Checks {
+		buf.WriteString(c.Problem)
+	}
+	for _, f := range r.Fingerprints {
+		buf.WriteString(f)
+	}
+	if bytes.Contains(buf.Bytes(), secret) {
+		t.Error("Report contains the secret")
+	}
+}
+
+func TestCanCombine_MatchesCombine(t *testing.T) {
+	shares, _ := Split([]byte("secret"), 4, 3)
+	dup := append([]Share(nil), shares[0], shares[1], shares[0])
+	outOfRange := []Share{shares[0], {Index: 2, Value: bytes.Repeat([]byte{0xFF}, 12)}, shares[2]}
+	mixed := []Share{shares[0], shares[1], {Index: 3, Value: shares[2].Value, Scheme: SchemeV2GF256}}
+	short := []Share{shares[0], shares[1], {Index: 3, Value: shares[2].Value[:4]}}
+
+	tests := []struct {
+		name      string
+		shares    []Share
+		threshold int
+		failed    []string
+	}{
+		{"too few", shares[:2], 3, []string{"quorum"}},
+		{"bad threshold", shares, 1, []string{"threshold"}},
+		{"none", nil, 2, []string{"quorum"}},
+		{"duplicate", dup, 3, []string{"quorum", "indices"}},
+		{"out of range", outOfRange, 3, []string{"values"}},
+		{"mixed schemes", mixed, 3, []string{"scheme"}},
+		{"short", short, 3, []string{"length"}},
+		{"several", append(dup[:2:2], Share{Index: 1, Value: []byte{1}}), 3, []string{"quorum", "length", "indices"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, err := CanCombine(tt.shares, tt.threshold)
+			_, combineErr := Combine(tt.shares, tt.threshold)
+			if err == nil || r.OK {
+				t.Fatal("Expected CanCombine to fail")
+			}
+			if combineErr == nil || err.Error() != combineErr.Error() {
+				t.Errorf("CanCombine error %q differs from Combine error %v", err, combineErr)
+			}
+			var failed []string
+			for _, c := range r.Failed() {
+				if c.Problem == "" {
+					t.Errorf("Check %s failed without a problem", c.Name)
+				}
+				failed = append(failed, c.Name)
+			}
+			if strings.Join(failed, ",") != strings.Join(tt.failed, ",") {
+				t.Errorf("Expected failed checks %v, got %v", tt.failed, failed)
+			}
+		})
+	}
+}
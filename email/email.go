@@ -0,0 +1,279 @@
+// Package email distributes shares to custodians by email. Each custodian
+// receives their share protected with goshamir.ProtectShare under a PIN
+// delivered through a separate channel, as an attachment to a message
+// rendered from a template with instructions.
+package email
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"mime"
+	"mime/multipart"
+	"mime/quotedprintable"
+	"net/mail"
+	"net/smtp"
+	"net/textproto"
+	"strings"
+	"text/template"
+	"time"
+
+	goshamir "github.com/fawwazid/go-shamir"
+)
+
+// DefaultSubject is the subject template used when Mailer.Subject is nil.
+const DefaultSubject = `Your share of {{.SetID}} ({{.Index}} of {{.Total}})`
+
+// DefaultBody is the body template used when Mailer.Body is nil.
+const DefaultBody = `Hello {{.Name}},
+
+You have been entrusted with share {{.Index}} of {{.Total}} of the secret
+{{.SetID}}. Any {{.Threshold}} custodians together can recover the secret;
+fewer reveal nothing about it.
+
+Your share is attached as {{.Attachment}}. It is encrypted with a PIN that
+you will receive separately. Do not store the PIN with the share.
+
+Keep the attachment somewhere safe and offline, and delete this message
+once you have done so. If you did not expect this message, contact the
+sender immediately.
+`
+
+// Message is the data available to subject and body templates.
+type Message struct {
+	SetID      string
+	Name       string
+	Contact    string
+	Index      uint8
+	Total      int
+	Threshold  int
+	Attachment string
+}
+
+// Delivery reports the outcome of sending one custodian's share.
+type Delivery struct {
+	Custodian string
+	Contact   string
+	Attempts  int
+	// Err is nil if the message was accepted by the SMTP server.
+	Err    error
+	SentAt time.Time
+}
+
+// SendFunc sends a message, as smtp.SendMail.
+type SendFunc func(addr string, a smtp.Auth, from string, to []string, msg []byte) error
+
+// Mailer sends share bundles through an SMTP server.
+type Mailer struct {
+	// Addr is the SMTP server's host:port. STARTTLS is used when the
+	// server offers it.
+	Addr string
+	Auth smtp.Auth
+	From string
+	// Subject and Body render each message from a Message. They default
+	// to DefaultSubject and DefaultBody.
+	Subject *template.Template
+	Body    *template.Template
+	// PIN returns the PIN protecting a custodian's share. It is required;
+	// deliver the PIN through another channel than email.
+	PIN func(c goshamir.Custodian) ([]byte, error)
+	// Retries is the number of additional attempts after a temporary
+	// failure, waiting Backoff, doubled after each attempt, in between.
+	Retries int
+	Backoff time.Duration
+	// KDFParams, if non-zero, overrides goshamir.DefaultKDFParams for
+	// protecting shares.
+	KDFParams goshamir.KDFParams
+	// OnDelivery, if set, is called after each custodian's message has
+	// been sent or has finally failed.
+	OnDelivery func(Delivery)
+	// Send delivers messages. Defaults to smtp.SendMail.
+	Send SendFunc
+}
+
+// Distribute emails each custodian their PIN-protected share. It keeps
+// going after a failed delivery and returns the status of every delivery,
+// in the order of bundles, together with an error if any failed.
+// Permanent SMTP errors (5xx replies) are not retried.
+func (m *Mailer) Distribute(ctx context.Context, setID string, threshold int, bundles []goshamir.CustodianBundle) ([]Delivery, error) {
+	if m.PIN == nil {
+		return nil, errors.New("a PIN function is required")
+	}
+	from, err := mail.ParseAddress(m.From)
+	if err != nil {
+		return nil, fmt.Errorf("invalid sender address: %w", err)
+	}
+	subject, body, err := m.templates()
+	if err != nil {
+		return nil, err
+	}
+
+	deliveries := make([]Delivery, len(bundles))
+	failed := 0
+	for i, b := range bundles {
+		d := Delivery{Custodian: b.Custodian.Name, Contact: b.Custodian.Contact}
+		msg := Message{
+			SetID:      setID,
+			Name:       b.Custodian.Name,
+			Contact:    b.Custodian.Contact,
+			Index:      b.Share.Index,
+			Total:      len(bundles),
+			Threshold:  threshold,
+			Attachment: fmt.Sprintf("%s-share-%d.bin", setID, b.Share.Index),
+		}
+		d.Attempts, d.Err = m.deliver(ctx, from, subject, body, b, msg)
+		if d.Err == nil {
+			d.SentAt = time.Now()
+		} else {
+			failed++
+		}
+		deliveries[i] = d
+		if m.OnDelivery != nil {
+			m.OnDelivery(d)
+		}
+		if ctx.Err() != nil {
+			break
+		}
+	}
+	if err := ctx.Err(); err != nil {
+		return deliveries, err
+	}
+	if failed > 0 {
+		return deliveries, fmt.Errorf("%d of %d deliveries failed", failed, len(bundles))
+	}
+	return deliveries, nil
+}
+
+func (m *Mailer) templates() (*template.Template, *template.Template, error) {
+	subject, body := m.Subject, m.Body
+	var err error
+	if subject == nil {
+		if subject, err = template.New("subject").Parse(DefaultSubject); err != nil {
+			return nil, nil, err
+		}
+	}
+	if body == nil {
+		if body, err = template.New("body").Parse(DefaultBody); err != nil {
+			return nil, nil, err
+		}
+	}
+	return subject, body, nil
+}
+
+// deliver builds and sends one custodian's message, retrying temporary
+// failures. It returns the number of attempts made.
+func (m *Mailer) deliver(ctx context.Context, from *mail.Address, subject, body *template.Template, b goshamir.CustodianBundle, msg Message) (int, error) {
+	to, err := mail.ParseAddress(b.Custodian.Contact)
+	if err != nil {
+		return 0, fmt.Errorf("invalid address for %q: %w", b.Custodian.Name, err)
+	}
+	pin, err := m.PIN(b.Custodian)
+	if err != nil {
+		return 0, fmt.Errorf("PIN for %q: %w", b.Custodian.Name, err)
+	}
+	var opts []goshamir.Option
+	if m.KDFParams != (goshamir.KDFParams{}) {
+		opts = append(opts, goshamir.WithKDFParams(m.KDFParams))
+	}
+	protected, err := goshamir.ProtectShare(b.Share, pin, opts...)
+	clear(pin)
+	if err != nil {
+		return 0, err
+	}
+	raw, err := buildMessage(from, to, subject, body, msg, protected)
+	if err != nil {
+		return 0, err
+	}
+
+	send := m.Send
+	if send == nil {
+		send = smtp.SendMail
+	}
+	backoff := m.Backoff
+	for attempt := 1; ; attempt++ {
+		err = send(m.Addr, m.Auth, from.Address, []string{to.Address}, raw)
+		if err == nil || attempt > m.Retries || permanent(err) {
+			return attempt, err
+		}
+		select {
+		case <-ctx.Done():
+			return attempt, ctx.Err()
+		case <-time.After(backoff):
+		}
+		backoff *= 2
+	}
+}
+
+// permanent reports whether err is a permanent SMTP failure.
+func permanent(err error) bool {
+	var tp *textproto.Error
+	return errors.As(err, &tp) && tp.Code >= 500
+}
+
+// buildMessage renders a multipart/mixed message with the protected share
+// attached.
+func buildMessage(from, to *mail.Address, subject, body *template.Template, msg Message, attachment []byte) ([]byte, error) {
+	var subj, text strings.Builder
+	if err := subject.Execute(&subj, msg); err != nil {
+		return nil, fmt.Errorf("subject template failed: %w", err)
+	}
+	if err := body.Execute(&text, msg); err != nil {
+		return nil, fmt.Errorf("body template failed: %w", err)
+	}
+	if strings.ContainsAny(subj.String(), "\r\n") {
+		return nil, errors.New("subject contains a line break")
+	}
+
+	var buf bytes.Buffer
+	mw := multipart.NewWriter(&buf)
+	fmt.Fprintf(&buf, "From: %s\r\n", from)
+	fmt.Fprintf(&buf, "To: %s\r\n", to)
+	fmt.Fprintf(&buf, "Subject: %s\r\n", mime.QEncoding.Encode("utf-8", subj.String()))
+	fmt.Fprintf(&buf, "Date: %s\r\n", time.Now().Format(time.RFC1123Z))
+	fmt.Fprintf(&buf, "Message-ID: %s\r\n", messageID(from))
+	fmt.Fprintf(&buf, "MIME-Version: 1.0\r\n")
+	fmt.Fprintf(&buf, "Content-Type: multipart/mixed; boundary=%q\r\n\r\n", mw.Boundary())
+
+	part, err := mw.CreatePart(textproto.MIMEHeader{
+		"Content-Type":              {"text/plain; charset=utf-8"},
+		"Content-Transfer-Encoding": {"quoted-printable"},
+	})
+	if err != nil {
+		return nil, err
+	}
+	qp := quotedprintable.NewWriter(part)
+	qp.Write([]byte(strings.ReplaceAll(text.String(), "\n", "\r\n")))
+	qp.Close()
+
+	part, err = mw.CreatePart(textproto.MIMEHeader{
+		"Content-Type":              {"application/octet-stream"},
+		"Content-Transfer-Encoding": {"base64"},
+		"Content-Disposition":       {mime.FormatMediaType("attachment", map[string]string{"filename": msg.Attachment})},
+	})
+	if err != nil {
+		return nil, err
+	}
+	encoded := base64.StdEncoding.EncodeToString(attachment)
+	for len(encoded) > 76 {
+		fmt.Fprintf(part, "%s\r\n", encoded[:76])
+		encoded = encoded[76:]
+	}
+	fmt.Fprintf(part, "%s\r\n", encoded)
+	if err := mw.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func messageID(from *mail.Address) string {
+	var b [16]byte
+	rand.Read(b[:])
+	domain := "localhost"
+	if _, d, ok := strings.Cut(from.Address, "@"); ok {
+		domain = d
+	}
+	return fmt.Sprintf("<%x@%s>", b, domain)
+}
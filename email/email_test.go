@@ -0,0 +1,195 @@
+package email
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"errors"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net/mail"
+	"net/smtp"
+	"net/textproto"
+	"strings"
+	"testing"
+	"text/template"
+
+	goshamir "github.com/fawwazid/go-shamir"
+)
+
+// fastKDF keeps ProtectShare cheap in tests.
+var fastKDF = goshamir.KDFParams{LogN: 4, R: 8, P: 1}
+
+type sentMessage struct {
+	to  []string
+	msg []byte
+}
+
+func testBundles(t *testing.T) []goshamir.CustodianBundle {
+	t.Helper()
+	shares, _ := goshamir.Split([]byte("mailed secret"), 3, 2)
+	bundles, err := goshamir.AssignShares(shares, []goshamir.Custodian{
+		{Name: "Alice", Contact: "alice@example.com"},
+		{Name: "Bob", Contact: "Bob <bob@example.com>"},
+		{Name: "Carol", Contact: "carol@example.com"},
+	})
+	if err != nil {
+		t.Fatalf("AssignShares failed: %v", err)
+	}
+	return bundles
+}
+
+func pinFor(c goshamir.Custodian) ([]byte, error) {
+	return []byte("pin-" + c.Name), nil
+}
+
+// attachment extracts the decoded attachment from a raw message.
+func attachment(t *testing.T, raw []byte) (*mail.Message, []byte) {
+	t.Helper()
+	msg, err := mail.ReadMessage(bytes.NewReader(raw))
+	if err != nil {
+		t.Fatalf("ReadMessage failed: %v", err)
+	}
+	_, params, _ := mime.ParseMediaType(msg.Header.Get("Content-Type"))
+	mr := multipart.NewReader(msg.Body, params["boundary"])
+	for {
+		part, err := mr.NextPart()
+		if err == io.EOF {
+			t.Fatal("No attachment found")
+		}
+		if err != nil {
+			t.Fatalf("NextPart failed: %v", err)
+		}
+		if part.FileName() != "" {
+			data, _ := io.ReadAll(base64.NewDecoder(base64.StdEncoding, part))
+			return msg, data
+		}
+	}
+}
+
+// --- Mailer Tests ---
+
+func TestMailer_Distribute(t *testing.T) {
+	var sent []sentMessage
+	var reported []Delivery
+	m := &Mailer{
+		Addr:      "smtp.example.com:587",
+		From:      "Vault Team <vault@example.com>",
+		PIN:       pinFor,
+		KDFParams: fastKDF,
+		Send: func(addr string, _ smtp.Auth, from string, to []string, msg []byte) error {
+			if addr != "smtp.example.com:587" || from != "vault@example.com" {
+				t.Errorf("Unexpected envelope %s %s", addr, from)
+			}
+			sent = append(sent, sentMessage{to, msg})
+			return nil
+		},
+		OnDelivery: func(d Delivery) { reported = append(reported, d) },
+	}
+	bundles := testBundles(t)
+
+	deliveries, err := m.Distribute(context.Background(), "db-root", 2, bundles)
+	if err != nil {
+		t.Fatalf("Distribute failed: %v", err)
+	}
+	if len(deliveries) != 3 || len(sent) != 3 || len(reported) != 3 {
+		t.Fatalf("Expected 3 deliveries, got %d sent %d reported %d", len(deliveries), len(sent), len(reported))
+	}
+	if sent[1].to[0] != "bob@example.com" || deliveries[1].Attempts != 1 || deliveries[1].SentAt.IsZero() {
+		t.Errorf("Unexpected delivery %+v to %v", deliveries[1], sent[1].to)
+	}
+
+	var recovered []goshamir.Share
+	for i, s := range sent {
+		msg, protected := attachment(t, s.msg)
+		subject, _ := new(mime.WordDecoder).DecodeHeader(msg.Header.Get("Subject"))
+		if !strings.Contains(subject, "db-root") {
+			t.Errorf("Unexpected subject %q", subject)
+		}
+		if bytes.Contains(s.msg, bundles[i].Share.Value) {
+			t.Error("Message contains the unprotected share")
+		}
+		share, err := goshamir.UnprotectShare(protected, []byte("pin-"+bundles[i].Custodian.Name))
+		if err != nil {
+			t.Fatalf("UnprotectShare failed: %v", err)
+		}
+		recovered = append(recovered, share)
+	}
+	secret, err := goshamir.Combine(recovered[:2], 2)
+	if err != nil || string(secret) != "mailed secret" {
+		t.Errorf("Combine of mailed shares failed: %q, %v", secret, err)
+	}
+}
+
+func TestMailer_RetriesAndFailures(t *testing.T) {
+	attempts := map[string]int{}
+	m := &Mailer{
+		From:      "vault@example.com",
+		PIN:       pinFor,
+		KDFParams: fastKDF,
+		Retries:   2,
+		Send: func(_ string, _ smtp.Auth, _ string, to []string, _ []byte) error {
+			attempts[to[0]]++
+			switch to[0] {
+			case "alice@example.com":
+				if attempts[to[0]] < 3 {
+					return &textproto.Error{Code: 421, Msg: "try again later"}
+				}
+				return nil
+			case "bob@example.com":
+				return &textproto.Error{Code: 550, Msg: "no such user"}
+			default:
+				return errors.New("connection refused")
+			}
+		},
+	}
+
+	deliveries, err := m.Distribute(context.Background(), "set", 2, testBundles(t))
+	if err == nil || !strings.Contains(err.Error(), "2 of 3 deliveries failed") {
+		t.Errorf("Expected partial failure, got %v", err)
+	}
+	if deliveries[0].Err != nil || deliveries[0].Attempts != 3 {
+		t.Errorf("Expected Alice to succeed on the third attempt, got %+v", deliveries[0])
+	}
+	if deliveries[1].Err == nil || deliveries[1].Attempts != 1 {
+		t.Errorf("Expected permanent failure without retry for Bob, got %+v", deliveries[1])
+	}
+	if deliveries[2].Err == nil || deliveries[2].Attempts != 3 {
+		t.Errorf("Expected Carol to fail after 3 attempts, got %+v", deliveries[2])
+	}
+}
+
+func TestMailer_CustomTemplateAndErrors(t *testing.T) {
+	var raw []byte
+	m := &Mailer{
+		From:      "vault@example.com",
+		PIN:       pinFor,
+		KDFParams: fastKDF,
+		Body:      template.Must(template.New("b").Parse("Share {{.Index}}/{{.Total}} for {{.Name}}, need {{.Threshold}}.")),
+		Send: func(_ string, _ smtp.Auth, _ string, _ []string, msg []byte) error {
+			raw = msg
+			return nil
+		},
+	}
+	bundles := testBundles(t)
+	if _, err := m.Distribute(context.Background(), "set", 2, bundles[:1]); err != nil {
+		t.Fatalf("Distribute failed: %v", err)
+	}
+	if !bytes.Contains(raw, []byte("Share 1/1 for Alice, need 2.")) {
+		t.Errorf("Custom body not rendered:\n%s", raw)
+	}
+
+	bad := bundles[0]
+	bad.Custodian.Contact = "not an address"
+	if d, err := m.Distribute(context.Background(), "set", 2, []goshamir.CustodianBundle{bad}); err == nil || d[0].Err == nil {
+		t.Error("Expected error for invalid contact address")
+	}
+	if _, err := (&Mailer{From: "vault@example.com"}).Distribute(context.Background(), "set", 2, bundles); err == nil {
+		t.Error("Expected error without a PIN function")
+	}
+	m.Subject = template.Must(template.New("s").Parse("line\r\nBcc: evil@example.com"))
+	if _, err := m.Distribute(context.Background(), "set", 2, bundles[:1]); err == nil {
+		t.Error("Expected error for header injection in subject")
+	}
+}
@@ -0,0 +1,16 @@
+// Package embedded is a constrained implementation of Shamir's Secret
+// Sharing for microcontroller-based share custodians such as hardware
+// wallets and HSM sticks. It is compiled only under TinyGo, or with the
+// goshamir_embedded build tag:
+//
+//	tinygo build -target=pico ./...
+//	go test -tags goshamir_embedded ./embedded
+//
+// The package never allocates on the heap and imports neither math/big nor
+// fmt. Shares are fixed-size arrays, randomness is supplied by the caller
+// as a byte slice (typically filled from a hardware RNG), and field
+// arithmetic is bitwise and constant time rather than table driven.
+//
+// Shares use the field and layout of SchemeV2GF256 in the main package:
+// Share.Value[:Share.Len] is interchangeable with Share.Value there.
+package embedded
@@ -0,0 +1,117 @@
+//go:build tinygo || goshamir_embedded
+
+package embedded
+
+import "errors"
+
+const (
+	// MaxSecretSize is the largest secret, in bytes, a Share can hold.
+	MaxSecretSize = 64
+	// MaxShares is the maximum number of shares.
+	MaxShares = 255
+	// MinThreshold is the minimum threshold.
+	MinThreshold = 2
+)
+
+var (
+	// ErrInvalidParams is returned for out-of-range sizes, counts or
+	// thresholds.
+	ErrInvalidParams = errors.New("invalid parameters")
+	// ErrShortRandom is returned when too few random bytes are supplied.
+	ErrShortRandom = errors.New("insufficient random bytes")
+	// ErrInvalidShares is returned when shares cannot be combined.
+	ErrInvalidShares = errors.New("invalid shares")
+)
+
+// Share is a fixed-size share. Only the first Len bytes of Value are used.
+type Share struct {
+	Index uint8
+	Len   uint8
+	Value [MaxSecretSize]byte
+}
+
+// RandomSize returns the number of random bytes Split needs.
+func RandomSize(secretLen, threshold int) int {
+	return secretLen * (threshold - 1)
+}
+
+// Split fills dst with one share per element, len(dst) shares in total, any
+// threshold of which recover secret. random must hold at least
+// RandomSize(len(secret), threshold) uniformly random bytes; it is wiped
+// before Split returns.
+func Split(dst []Share, secret, random []byte, threshold int) error {
+	n := len(dst)
+	if len(secret) == 0 || len(secret) > MaxSecretSize {
+		return ErrInvalidParams
+	}
+	if threshold < MinThreshold || n < threshold || n > MaxShares {
+		return ErrInvalidParams
+	}
+	degree := threshold - 1
+	if len(random) < RandomSize(len(secret), threshold) {
+		return ErrShortRandom
+	}
+
+	for i := range dst {
+		dst[i].Index = uint8(i + 1)
+		dst[i].Len = uint8(len(secret))
+		dst[i].Value = [MaxSecretSize]byte{}
+	}
+	for b, s := range secret {
+		coeffs := random[b*degree : (b+1)*degree]
+		for i := range dst {
+			// Horner's rule from the highest coefficient down.
+			x := dst[i].Index
+			var y byte
+			for j := degree - 1; j >= 0; j-- {
+				y = mul(y^coeffs[j], x)
+			}
+			dst[i].Value[b] = y ^ s
+		}
+	}
+	for i := range random {
+		random[i] = 0
+	}
+	return nil
+}
+
+// Combine recovers the secret from shares into dst and returns its length.
+// Every share is used, so exactly threshold shares should be passed.
+func Combine(dst []byte, shares []Share) (int, error) {
+	if len(shares) < MinThreshold || len(shares) > MaxShares {
+		return 0, ErrInvalidShares
+	}
+	size := int(shares[0].Len)
+	if size == 0 || size > MaxSecretSize || len(dst) < size {
+		return 0, ErrInvalidShares
+	}
+	for i, s := range shares {
+		if s.Index == 0 || int(s.Len) != size {
+			return 0, ErrInvalidShares
+		}
+		for _, t := range shares[:i] {
+			if t.Index == s.Index {
+				return 0, ErrInvalidShares
+			}
+		}
+	}
+
+	for b := range dst[:size] {
+		dst[b] = 0
+	}
+	for i, s := range shares {
+		// λ_i = Π x_j / (x_j - x_i), where subtraction is XOR.
+		num, den := byte(1), byte(1)
+		for j, t := range shares {
+			if i != j {
+				num = mul(num, t.Index)
+				den = mul(den, t.Index^s.Index)
+			}
+		}
+		lambda := mul(num, inv(den))
+		for b := range dst[:size] {
+			dst[b] ^= mul(s.Value[b], lambda)
+		}
+	}
+	return size, nil
+}
@@ -0,0 +1,110 @@
+//go:build goshamir_embedded && !tinygo
+
+package embedded
+
+import (
+	"bytes"
+	"crypto/rand"
+	"testing"
+
+	goshamir "github.com/fawwazid/go-shamir"
+)
+
+// --- Embedded Tests ---
+
+func TestArithmetic(t *testing.T) {
+	// 0x53 * 0xCA = 1 is the worked example from FIPS-197.
+	if mul(0x53, 0xCA) != 1 {
+		t.Error("Unexpected product for FIPS-197 example")
+	}
+	for a := 1; a < 256; a++ {
+		if got := mul(byte(a), inv(byte(a))); got != 1 {
+			t.Fatalf("%d * inverse = %d, expected 1", a, got)
+		}
+	}
+}
+
+func TestSplitCombine(t *testing.T) {
+	secret := []byte("hardware wallet seed, 32 bytes!!")
+	random := make([]byte, RandomSize(len(secret), 3))
+	rand.Read(random)
+
+	var shares [5]Share
+	if err := Split(shares[:], secret, random, 3); err != nil {
+		t.Fatalf("Split failed: %v", err)
+	}
+	if !bytes.Equal(random, make([]byte, len(random))) {
+		t.Error("Expected random bytes to be wiped")
+	}
+
+	var out [MaxSecretSize]byte
+	n, err := Combine(out[:], []Share{shares[4], shares[1], shares[2]})
+	if err != nil {
+		t.Fatalf("Combine failed: %v", err)
+	}
+	if !bytes.Equal(secret, out[:n]) {
+		t.Errorf("Expected %q, got %q", secret, out[:n])
+	}
+
+	if _, err := Combine(out[:], []Share{shares[0], shares[0]}); err == nil {
+		t.Error("Expected error for duplicate shares")
+	}
+	if err := Split(shares[:], secret, random[:3], 3); err != ErrShortRandom {
+		t.Errorf("Expected ErrShortRandom, got %v", err)
+	}
+	if err := Split(shares[:], make([]byte, MaxSecretSize+1), random, 3); err != ErrInvalidParams {
+		t.Errorf("Expected ErrInvalidParams, got %v", err)
+	}
+}
+
+func TestCompatibleWithSchemeV2(t *testing.T) {
+	secret := []byte("interoperable")
+	random := make([]byte, RandomSize(len(secret), 2))
+	rand.Read(random)
+	var shares [3]Share
+	if err := Split(shares[:], secret, random, 2); err != nil {
+		t.Fatalf("Split failed: %v", err)
+	}
+
+	var converted []goshamir.Share
+	for _, s := range shares[1:] {
+		converted = append(converted, goshamir.Share{Index: s.Index, Value: s.Value[:s.Len], Scheme: goshamir.SchemeV2GF256})
+	}
+	recovered, err := goshamir.Combine(converted, 2)
+	if err != nil {
+		t.Fatalf("goshamir.Combine failed: %v", err)
+	}
+	if !bytes.Equal(secret, recovered) {
+		t.Error("Main package could not combine embedded shares")
+	}
+
+	splitter, _ := goshamir.NewSplitter(3, 2, goshamir.WithScheme(goshamir.SchemeV2GF256))
+	compact, _ := splitter.Split(secret)
+	var back [2]Share
+	for i, s := range compact[:2] {
+		back[i] = Share{Index: s.Index, Len: uint8(len(s.Value))}
+		copy(back[i].Value[:], s.Value)
+	}
+	var out [MaxSecretSize]byte
+	n, err := Combine(out[:], back[:])
+	if err != nil {
+		t.Fatalf("Combine failed: %v", err)
+	}
+	if !bytes.Equal(secret, out[:n]) {
+		t.Error("Embedded package could not combine main package shares")
+	}
+}
+
+func TestNoAllocations(t *testing.T) {
+	secret := []byte("no heap")
+	random := make([]byte, RandomSize(len(secret), 3))
+	var shares [4]Share
+	var out [MaxSecretSize]byte
+	allocs := testing.AllocsPerRun(100, func() {
+		Split(shares[:], secret, random, 3)
+		Combine(out[:], shares[:3])
+	})
+	if allocs != 0 {
+		t.Errorf("Expected no allocations, got %v", allocs)
+	}
+}
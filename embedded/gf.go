@@ -0,0 +1,30 @@
+//go:build tinygo || goshamir_embedded
+
+package embedded
+
+// mul multiplies in GF(2^8) with the AES polynomial x^8 + x^4 + x^3 + x + 1.
+// It runs in constant time: every iteration performs the same operations
+// regardless of the operands.
+func mul(a, b byte) byte {
+	var p byte
+	for range 8 {
+		p ^= a & -(b & 1)
+		hi := -(a >> 7)
+		a = a<<1 ^ 0x1B&hi
+		b >>= 1
+	}
+	return p
+}
+
+// inv returns the multiplicative inverse of a as a^254, in constant time.
+// The inverse of zero is zero.
+func inv(a byte) byte {
+	// a^254 = a^(2+4+8+16+32+64+128).
+	sq := mul(a, a)
+	r := sq
+	for range 6 {
+		sq = mul(sq, sq)
+		r = mul(r, sq)
+	}
+	return r
+}
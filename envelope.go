@@ -0,0 +1,207 @@
+package goshamir
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash/crc32"
+)
+
+// Share envelope layout. The fixed header is followed by uvarint-prefixed
+// fields and a CRC-32C of everything before it:
+//
+//	magic "SH" | version | scheme | index
+//	uvarint len | value
+//	uvarint len | watermark
+//	uvarint len | parents (version 2 and 3: index, threshold pairs)
+//	uvarint len | flags (version 3 only: bit 0 set for peppered shares)
+//	crc32c (4 bytes, big-endian)
+//
+// Version 2 is only written for sub-shares and version 3 for peppered
+// shares, so envelopes of ordinary shares remain readable by older
+// releases. In version 3 the parents field may be empty.
+const (
+	envelopeVersion    = 1
+	envelopeVersion2   = 2
+	envelopeVersion3   = 3
+	envelopePeppered   = 1 << 0
+	envelopeHeaderSize = 5
+	envelopeCRCSize    = 4
+	// maxEnvelopeField bounds decoded field lengths so a corrupt length
+	// cannot trigger a huge allocation.
+	maxEnvelopeField = 1 << 30
+)
+
+var envelopeMagic = [2]byte{'S', 'H'}
+
+var crc32c = crc32.MakeTable(crc32.Castagnoli)
+
+// ErrInvalidEnvelope is returned when a share envelope is malformed.
+var ErrInvalidEnvelope = errors.New("invalid share envelope")
+
+// ErrEnvelopeChecksum is returned when a share envelope fails its CRC-32C
+// check, indicating storage corruption.
+var ErrEnvelopeChecksum = errors.New("share envelope checksum mismatch")
+
+// MarshalBinary encodes the share as a compact, versioned envelope with a
+// CRC-32C checksum, suitable for database BLOB columns. The checksum
+// detects accidental corruption only; it offers no protection against
+// tampering.
+func (s Share) MarshalBinary() ([]byte, error) {
+	if s.Index == 0 {
+		return nil, errors.New("share index must be non-zero")
+	}
+	if len(s.Value) == 0 {
+		return nil, errors.New("share value cannot be empty")
+	}
+	version := byte(envelopeVersion)
+	switch {
+	case s.Peppered:
+		version = envelopeVersion3
+	case len(s.Parents) > 0:
+		version = envelopeVersion2
+	}
+	buf := make([]byte, 0, envelopeHeaderSize+4*binary.MaxVarintLen32+len(s.Value)+len(s.Watermark)+2*len(s.Parents)+1+envelopeCRCSize)
+	buf = append(buf, envelopeMagic[0], envelopeMagic[1], version, byte(schemeOf(s)), s.Index)
+	buf = binary.AppendUvarint(buf, uint64(len(s.Value)))
+	buf = append(buf, s.Value...)
+	buf = binary.AppendUvarint(buf, uint64(len(s.Watermark)))
+	buf = append(buf, s.Watermark...)
+	if version >= envelopeVersion2 {
+		buf = binary.AppendUvarint(buf, uint64(2*len(s.Parents)))
+		for _, p := range s.Parents {
+			buf = append(buf, p.Index, p.Threshold)
+		}
+	}
+	if version == envelopeVersion3 {
+		buf = append(buf, 1, envelopePeppered)
+	}
+	return binary.BigEndian.AppendUint32(buf, crc32.Checksum(buf, crc32c)), nil
+}
+
+// UnmarshalBinary decodes an envelope produced by MarshalBinary, verifying
+// its checksum before parsing.
+func (s *Share) UnmarshalBinary(data []byte) error {
+	if len(data) < envelopeHeaderSize+envelopeCRCSize || data[0] != envelopeMagic[0] || data[1] != envelopeMagic[1] {
+		return ErrInvalidEnvelope
+	}
+	if data[2] < envelopeVersion || data[2] > envelopeVersion3 {
+		return fmt.Errorf("%w: unsupported version %d", ErrInvalidEnvelope, data[2])
+	}
+	body, sum := data[:len(data)-envelopeCRCSize], data[len(data)-envelopeCRCSize:]
+	if crc32.Checksum(body, crc32c) != binary.BigEndian.Uint32(sum) {
+		ReportTelemetry(TelemetryEvent{Kind: TelemetryNearMiss, Op: "Share.UnmarshalBinary", Format: "envelope", Scheme: Scheme(data[3]), Reason: "envelope checksum mismatch"})
+		return ErrEnvelopeChecksum
+	}
+
+	scheme, index := Scheme(data[3]), data[4]
+	rest := body[envelopeHeaderSize:]
+	value, rest, err := envelopeField(rest)
+	if err != nil {
+		return err
+	}
+	watermark, rest, err := envelopeField(rest)
+	if err != nil {
+		return err
+	}
+	var parents []ShareParent
+	if data[2] >= envelopeVersion2 {
+		var field []byte
+		if field, rest, err = envelopeField(rest); err != nil {
+			return err
+		}
+		// Only version 3 envelopes may carry an empty parents field.
+		if len(field) > 0 || data[2] == envelopeVersion2 {
+			if parents, err = decodeParents(field); err != nil {
+				return err
+			}
+		}
+	}
+	var peppered bool
+	if data[2] == envelopeVersion3 {
+		var flags []byte
+		if flags, rest, err = envelopeField(rest); err != nil {
+			return err
+		}
+		if len(flags) != 1 || flags[0]&^envelopePeppered != 0 {
+			return ErrInvalidEnvelope
+		}
+		peppered = flags[0]&envelopePeppered != 0
+	}
+	if len(rest) != 0 || index == 0 || len(value) == 0 || scheme == 0 {
+		return ErrInvalidEnvelope
+	}
+
+	if scheme == SchemeV1GF257 {
+		ReportTelemetry(TelemetryEvent{Kind: TelemetryDeprecatedFormat, Op: "Share.UnmarshalBinary", Format: "envelope", Scheme: scheme, Reason: "legacy GF(257) share"})
+	}
+	*s = Share{Index: index, Value: value, Scheme: scheme, Parents: parents, Peppered: peppered}
+	if len(watermark) > 0 {
+		s.Watermark = watermark
+	}
+	return nil
+}
+
+// decodeParents decodes the parents field of a version 2 envelope.
+func decodeParents(b []byte) ([]ShareParent, error) {
+	if len(b) == 0 || len(b)%2 != 0 {
+		return nil, ErrInvalidEnvelope
+	}
+	parents := make([]ShareParent, len(b)/2)
+	for i := range parents {
+		parents[i] = ShareParent{Index: b[2*i], Threshold: b[2*i+1]}
+		if parents[i].Index == 0 || parents[i].Threshold < MinThreshold {
+			return nil, ErrInvalidEnvelope
+		}
+	}
+	return parents, nil
+}
+
+// envelopeField reads one uvarint-prefixed field, returning a copy.
+func envelopeField(b []byte) ([]byte, []byte, error) {
+	n, size := binary.Uvarint(b)
+	if size <= 0 || n > maxEnvelopeField || n > uint64(len(b)-size) {
+		return nil, nil, ErrInvalidEnvelope
+	}
+	field := append([]byte(nil), b[size:size+int(n)]...)
+	return field, b[size+int(n):], nil
+}
+
+// ValueShare returns a driver.Valuer storing s as its binary envelope, for
+// use as a database/sql query argument:
+//
+//	db.Exec("INSERT INTO shares (id, share) VALUES (?, ?)", id, goshamir.ValueShare(s))
+func ValueShare(s Share) driver.Valuer {
+	return shareValuer{s}
+}
+
+// ScanShare returns a sql.Scanner decoding a binary envelope column into
+// dst:
+//
+//	err := row.Scan(goshamir.ScanShare(&s))
+func ScanShare(dst *Share) sql.Scanner {
+	return shareScanner{dst}
+}
+
+type shareValuer struct{ s Share }
+
+func (v shareValuer) Value() (driver.Value, error) {
+	return v.s.MarshalBinary()
+}
+
+type shareScanner struct{ dst *Share }
+
+func (sc shareScanner) Scan(src any) error {
+	switch v := src.(type) {
+	case []byte:
+		return sc.dst.UnmarshalBinary(v)
+	case string:
+		return sc.dst.UnmarshalBinary([]byte(v))
+	case nil:
+		return errors.New("cannot scan NULL into a share")
+	default:
+		return fmt.Errorf("cannot scan %T into a share", src)
+	}
+}
@@ -0,0 +1,92 @@
+package goshamir
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+)
+
+// --- Envelope Tests ---
+
+func TestShareEnvelope_RoundTrip(t *testing.T) {
+	splitter, _ := NewSplitter(3, 2, WithScheme(SchemeV2GF256))
+	compact, _ := splitter.Split([]byte("stored in a database"))
+	legacy, _ := Split([]byte("legacy"), 3, 2)
+	marked := legacy[1]
+	marked.Watermark = bytes.Repeat([]byte{0xAB}, WatermarkSize)
+
+	for _, s := range []Share{compact[0], legacy[2], marked} {
+		data, err := s.MarshalBinary()
+		if err != nil {
+			t.Fatalf("MarshalBinary failed: %v", err)
+		}
+		if !bytes.HasPrefix(data, []byte("SH\x01")) {
+			t.Errorf("Unexpected envelope header % x", data[:3])
+		}
+
+		var decoded Share
+		if err := decoded.UnmarshalBinary(data); err != nil {
+			t.Fatalf("UnmarshalBinary failed: %v", err)
+		}
+		if decoded.Index != s.Index || schemeOf(decoded) != schemeOf(s) ||
+			!bytes.Equal(decoded.Value, s.Value) || !bytes.Equal(decoded.Watermark, s.Watermark) {
+			t.Errorf("Round trip mismatch: got %+v, expected %+v", decoded, s)
+		}
+	}
+}
+
+func TestShareEnvelope_DetectsCorruption(t *testing.T) {
+	shares, _ := Split([]byte("bit rot"), 3, 2)
+	data, _ := shares[0].MarshalBinary()
+
+	for i := range data {
+		corrupt := bytes.Clone(data)
+		corrupt[i] ^= 0x10
+		var s Share
+		if err := s.UnmarshalBinary(corrupt); err == nil {
+			t.Errorf("Expected error for corrupted byte %d", i)
+		}
+	}
+
+	corrupt := bytes.Clone(data)
+	corrupt[envelopeHeaderSize+2] ^= 1
+	var s Share
+	if err := s.UnmarshalBinary(corrupt); !errors.Is(err, ErrEnvelopeChecksum) {
+		t.Errorf("Expected ErrEnvelopeChecksum, got %v", err)
+	}
+	if err := s.UnmarshalBinary(data[:len(data)-1]); err == nil {
+		t.Error("Expected error for truncated envelope")
+	}
+	if _, err := (Share{Index: 1}).MarshalBinary(); err == nil {
+		t.Error("Expected error for empty share")
+	}
+}
+
+func TestShareEnvelope_SQLAdapters(t *testing.T) {
+	shares, _ := Split([]byte("row"), 3, 2)
+	v, err := ValueShare(shares[1]).Value()
+	if err != nil {
+		t.Fatalf("Value failed: %v", err)
+	}
+	blob, ok := v.([]byte)
+	if !ok {
+		t.Fatalf("Expected []byte driver value, got %T", v)
+	}
+
+	var s Share
+	if err := ScanShare(&s).Scan(blob); err != nil {
+		t.Fatalf("Scan failed: %v", err)
+	}
+	if s.Index != 2 || !bytes.Equal(s.Value, shares[1].Value) {
+		t.Error("Scanned share does not match")
+	}
+	if err := ScanShare(&s).Scan(string(blob)); err != nil {
+		t.Errorf("Scan of string failed: %v", err)
+	}
+	if err := ScanShare(&s).Scan(nil); err == nil {
+		t.Error("Expected error for NULL")
+	}
+	if err := ScanShare(&s).Scan(int64(3)); err == nil {
+		t.Error("Expected error for integer column")
+	}
+}
@@ -0,0 +1,124 @@
+package goshamir
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"errors"
+	"fmt"
+	"io"
+)
+
+// Escrow blob layout: version | nonce | AES-256-GCM ciphertext, with the
+// version and nonce as associated data.
+const (
+	escrowVersion    = 1
+	escrowKeySize    = 32
+	escrowNonceSize  = 12
+	escrowHeaderSize = 1 + escrowNonceSize
+)
+
+// ErrEscrowKey is returned by OpenEscrow when the key shares do not open
+// the escrow, because they are wrong or the escrow was modified.
+var ErrEscrowKey = errors.New("escrow key shares do not open the escrow")
+
+// EscrowGroup is the disaster-recovery custodian group of SplitWithEscrow.
+type EscrowGroup struct {
+	// Shares is the number of escrow key shares to produce.
+	Shares int
+	// Threshold is the number of key shares needed to open the escrow.
+	Threshold int
+}
+
+// EscrowSplit is the result of SplitWithEscrow.
+type EscrowSplit struct {
+	// Shares are the everyday custodians' shares of the secret.
+	Shares []Share
+	// Escrow is a sealed copy of the whole secret. It can be stored
+	// offsite, such as in a safe deposit box or cold storage.
+	Escrow []byte
+	// KeyShares are the disaster-recovery group's shares of the key
+	// sealing Escrow.
+	KeyShares []Share
+}
+
+// SplitWithEscrow splits secret among totalShares custodians, any
+// threshold of whom can reconstruct it, and in the same call prepares a
+// second tier for disaster recovery: a copy of the secret sealed with
+// AES-256-GCM under a fresh key, which is split among a different group.
+// If the everyday custodians are lost, escrow.Threshold holders of the key
+// shares open the copy with OpenEscrow. The two groups' shares are
+// independent, so mixing them reveals nothing. Options apply to both
+// splits; the key and nonce are read from the configured random source.
+func SplitWithEscrow(secret []byte, totalShares, threshold int, escrow EscrowGroup, opts ...Option) (*EscrowSplit, error) {
+	s, err := NewSplitter(totalShares, threshold, opts...)
+	if err != nil {
+		return nil, err
+	}
+	keySplitter, err := NewSplitter(escrow.Shares, escrow.Threshold, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("escrow group: %w", err)
+	}
+	shares, err := s.Split(secret)
+	if err != nil {
+		return nil, err
+	}
+
+	cfg := NewConfig(opts...)
+	key := make([]byte, escrowKeySize)
+	defer clear(key)
+	blob := make([]byte, escrowHeaderSize, escrowHeaderSize+len(secret)+16)
+	blob[0] = escrowVersion
+	if _, err := io.ReadFull(cfg.Rand, key); err != nil {
+		return nil, fmt.Errorf("escrow key generation failed: %w", err)
+	}
+	if _, err := io.ReadFull(cfg.Rand, blob[1:escrowHeaderSize]); err != nil {
+		return nil, fmt.Errorf("escrow nonce generation failed: %w", err)
+	}
+	aead, err := escrowAEAD(key)
+	if err != nil {
+		return nil, err
+	}
+	blob = aead.Seal(blob, blob[1:escrowHeaderSize], secret, blob[:escrowHeaderSize])
+
+	keyShares, err := keySplitter.Split(key)
+	if err != nil {
+		return nil, err
+	}
+	return &EscrowSplit{Shares: shares, Escrow: blob, KeyShares: keyShares}, nil
+}
+
+// OpenEscrow recovers the secret sealed by SplitWithEscrow from the escrow
+// and at least threshold of its key shares.
+func OpenEscrow(escrow []byte, keyShares []Share, threshold int) ([]byte, error) {
+	if len(escrow) < escrowHeaderSize+16 {
+		return nil, errors.New("escrow too short")
+	}
+	if escrow[0] != escrowVersion {
+		return nil, fmt.Errorf("unsupported escrow version %d", escrow[0])
+	}
+	key, err := Combine(keyShares, threshold)
+	if err != nil {
+		return nil, err
+	}
+	defer clear(key)
+	if len(key) != escrowKeySize {
+		return nil, ErrEscrowKey
+	}
+	aead, err := escrowAEAD(key)
+	if err != nil {
+		return nil, err
+	}
+	secret, err := aead.Open(nil, escrow[1:escrowHeaderSize], escrow[escrowHeaderSize:], escrow[:escrowHeaderSize])
+	if err != nil {
+		return nil, ErrEscrowKey
+	}
+	return secret, nil
+}
+
+func escrowAEAD(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
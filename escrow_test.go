@@ -0,0 +1,54 @@
+package goshamir
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+)
+
+// --- Escrow Tests ---
+
+func TestSplitWithEscrow(t *testing.T) {
+	secret := []byte("root CA private key")
+	dr, err := SplitWithEscrow(secret, 5, 3, EscrowGroup{Shares: 3, Threshold: 2}, WithScheme(SchemeV2GF256))
+	if err != nil {
+		t.Fatalf("SplitWithEscrow failed: %v", err)
+	}
+	if len(dr.Shares) != 5 || len(dr.KeyShares) != 3 {
+		t.Fatalf("got %d shares and %d key shares", len(dr.Shares), len(dr.KeyShares))
+	}
+	if bytes.Contains(dr.Escrow, secret) {
+		t.Fatal("escrow holds the plaintext secret")
+	}
+
+	got, err := Combine(dr.Shares[1:4], 3)
+	if err != nil || !bytes.Equal(got, secret) {
+		t.Fatalf("Combine of primary shares = %q, %v", got, err)
+	}
+	got, err = OpenEscrow(dr.Escrow, []Share{dr.KeyShares[2], dr.KeyShares[0]}, 2)
+	if err != nil {
+		t.Fatalf("OpenEscrow failed: %v", err)
+	}
+	if !bytes.Equal(got, secret) {
+		t.Fatalf("Expected %q, got %q", secret, got)
+	}
+}
+
+func TestOpenEscrow_Rejects(t *testing.T) {
+	dr, err := SplitWithEscrow([]byte("secret"), 3, 2, EscrowGroup{Shares: 3, Threshold: 2})
+	if err != nil {
+		t.Fatalf("SplitWithEscrow failed: %v", err)
+	}
+	other, _ := SplitWithEscrow([]byte("secret"), 3, 2, EscrowGroup{Shares: 3, Threshold: 2})
+	if _, err := OpenEscrow(dr.Escrow, other.KeyShares[:2], 2); !errors.Is(err, ErrEscrowKey) {
+		t.Errorf("expected ErrEscrowKey for another set's key shares, got %v", err)
+	}
+	tampered := bytes.Clone(dr.Escrow)
+	tampered[len(tampered)-1] ^= 1
+	if _, err := OpenEscrow(tampered, dr.KeyShares[:2], 2); !errors.Is(err, ErrEscrowKey) {
+		t.Errorf("expected ErrEscrowKey for tampered escrow, got %v", err)
+	}
+	if _, err := SplitWithEscrow([]byte("secret"), 3, 2, EscrowGroup{Shares: 1, Threshold: 2}); err == nil {
+		t.Error("expected error for invalid escrow group")
+	}
+}
@@ -0,0 +1,108 @@
+package goshamir
+
+import (
+	"bytes"
+	"encoding/base64"
+	"errors"
+	"fmt"
+)
+
+// ShareEncoding identifies a share encoding for EstimateEncodedSize.
+type ShareEncoding int
+
+const (
+	// EncodingHex is the "index:hex" text of EncodeSharesToHex.
+	EncodingHex ShareEncoding = iota + 1
+	// EncodingEnvelope is the binary envelope of Share.MarshalBinary.
+	EncodingEnvelope
+	// EncodingV2Text is the base64 text of package v2's Encode.
+	EncodingV2Text
+	// EncodingPlateDigits is EncodeSharePlate with AlphabetDigits.
+	EncodingPlateDigits
+	// EncodingPlateLetters is EncodeSharePlate with AlphabetLetters.
+	EncodingPlateLetters
+	// EncodingCompact is the one-line text of EncodeShareCompact.
+	EncodingCompact
+)
+
+// String returns the encoding's name, such as "hex".
+func (e ShareEncoding) String() string {
+	switch e {
+	case EncodingHex:
+		return "hex"
+	case EncodingEnvelope:
+		return "envelope"
+	case EncodingV2Text:
+		return "v2-text"
+	case EncodingPlateDigits:
+		return "plate-digits"
+	case EncodingPlateLetters:
+		return "plate-letters"
+	case EncodingCompact:
+		return "compact"
+	}
+	return fmt.Sprintf("ShareEncoding(%d)", int(e))
+}
+
+// EstimateShareSize returns the length in bytes of each share value a
+// Splitter with the given scheme and options produces for a secret of
+// secretLen bytes, so that interfaces can show how big backups will be
+// before any secret is split. Of the options, only WithPepper changes the
+// size.
+func EstimateShareSize(secretLen int, scheme Scheme, opts ...Option) (int, error) {
+	if secretLen <= 0 {
+		return 0, errors.New("secret length must be positive")
+	}
+	if !scheme.Supported() {
+		return 0, unsupportedScheme(scheme)
+	}
+	size := secretLen * scheme.bytesPerElement()
+	if len(NewConfig(opts...).Pepper) > 0 {
+		size += pepperOverhead
+	}
+	return size, nil
+}
+
+// EstimateEncodedSize returns the length in bytes, or characters for the
+// text encodings, of one share encoded with enc, for a secret of secretLen
+// bytes split with the given scheme and options. It is an upper bound: the
+// estimate assumes index 255 and, for the plate encodings, the largest
+// value, and shares with lower indices may encode a few characters
+// shorter. Watermarks and sub-share parents are not counted.
+func EstimateEncodedSize(secretLen int, scheme Scheme, enc ShareEncoding, opts ...Option) (int, error) {
+	size, err := EstimateShareSize(secretLen, scheme, opts...)
+	if err != nil {
+		return 0, err
+	}
+	worst := Share{Index: MaxShares, Value: bytes.Repeat([]byte{0xff}, size), Scheme: scheme}
+	switch enc {
+	case EncodingHex:
+		return len(encodeShareToHex(worst)), nil
+	case EncodingEnvelope, EncodingV2Text:
+		env, err := worst.MarshalBinary()
+		if err != nil {
+			return 0, err
+		}
+		if enc == EncodingV2Text {
+			return base64.RawURLEncoding.EncodedLen(len(env)), nil
+		}
+		return len(env), nil
+	case EncodingPlateDigits, EncodingPlateLetters:
+		alphabet := AlphabetDigits
+		if enc == EncodingPlateLetters {
+			alphabet = AlphabetLetters
+		}
+		text, err := EncodeSharePlate(worst, alphabet)
+		if err != nil {
+			return 0, err
+		}
+		return len(text), nil
+	case EncodingCompact:
+		text, err := EncodeShareCompact(worst)
+		if err != nil {
+			return 0, err
+		}
+		return len(text), nil
+	}
+	return 0, fmt.Errorf("unknown share encoding %v", enc)
+}
@@ -0,0 +1,92 @@
+package goshamir
+
+import (
+	"encoding/base64"
+	"testing"
+)
+
+// --- Size Estimation Tests ---
+
+func TestEstimateShareSize(t *testing.T) {
+	tests := []struct {
+		scheme Scheme
+		opts   []Option
+		want   int
+	}{
+		{SchemeV1GF257, nil, 64},
+		{SchemeV2GF256, nil, 32},
+		{SchemeV2GF256, []Option{WithPepper([]byte("pepper"))}, 32 + pepperOverhead},
+	}
+	for _, tt := range tests {
+		got, err := EstimateShareSize(32, tt.scheme, tt.opts...)
+		if err != nil {
+			t.Fatalf("EstimateShareSize failed: %v", err)
+		}
+		if got != tt.want {
+			t.Errorf("%v: got %d, want %d", tt.scheme, got, tt.want)
+		}
+	}
+	if _, err := EstimateShareSize(0, SchemeV2GF256); err == nil {
+		t.Error("expected error for empty secret")
+	}
+	if _, err := EstimateShareSize(32, Scheme(9)); err == nil {
+		t.Error("expected error for unsupported scheme")
+	}
+}
+
+func TestEstimateEncodedSize_BoundsActualEncodings(t *testing.T) {
+	for _, scheme := range []Scheme{SchemeV1GF257, SchemeV2GF256} {
+		for _, secretLen := range []int{1, 16, 32, 64} {
+			s, _ := NewSplitter(MaxShares, 2, WithScheme(scheme))
+			shares, err := s.Split(make([]byte, secretLen))
+			if err != nil {
+				t.Fatalf("Split failed: %v", err)
+			}
+			for _, enc := range []ShareEncoding{EncodingHex, EncodingEnvelope, EncodingV2Text, EncodingPlateDigits, EncodingPlateLetters, EncodingCompact} {
+				estimate, err := EstimateEncodedSize(secretLen, scheme, enc)
+				if err != nil {
+					t.Fatalf("EstimateEncodedSize failed: %v", err)
+				}
+				longest := 0
+				for _, sh := range shares {
+					longest = max(longest, encodedLen(t, sh, enc))
+				}
+				if longest > estimate {
+					t.Fatalf("%v %v %d bytes: actual %d exceeds estimate %d", scheme, enc, secretLen, longest, estimate)
+				}
+				if enc != EncodingPlateDigits && enc != EncodingPlateLetters && longest != estimate {
+					t.Errorf("%v %v %d bytes: estimate %d, longest %d", scheme, enc, secretLen, estimate, longest)
+				}
+			}
+		}
+	}
+	if _, err := EstimateEncodedSize(32, SchemeV2GF256, ShareEncoding(99)); err == nil {
+		t.Error("expected error for unknown encoding")
+	}
+}
+
+func encodedLen(t *testing.T, s Share, enc ShareEncoding) int {
+	t.Helper()
+	switch enc {
+	case EncodingHex:
+		return len(encodeShareToHex(s))
+	case EncodingEnvelope, EncodingV2Text:
+		env, err := s.MarshalBinary()
+		if err != nil {
+			t.Fatalf("MarshalBinary failed: %v", err)
+		}
+		if enc == EncodingV2Text {
+			return len(base64.RawURLEncoding.EncodeToString(env))
+		}
+		return len(env)
+	case EncodingCompact:
+		text, _ := EncodeShareCompact(s)
+		return len(text)
+	case EncodingPlateDigits:
+		text, _ := EncodeSharePlate(s, AlphabetDigits)
+		return len(text)
+	default:
+		text, _ := EncodeSharePlate(s, AlphabetLetters)
+		return len(text)
+	}
+}
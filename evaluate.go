@@ -0,0 +1,57 @@
+package goshamir
+
+import (
+	"errors"
+	"fmt"
+)
+
+// EvaluateAt interpolates the polynomial through shares and returns its
+// value at x in the layout of Share.Value for the shares' scheme. Every
+// share is used, so exactly threshold shares should be passed; extra shares
+// only agree with the result if they lie on the same polynomial.
+//
+// Evaluating at a new index produces a fresh share of the same secret,
+// which is the building block for share recovery and refresh protocols.
+// Evaluating at an existing index returns that share's value. Evaluating
+// at x = 0 yields the secret in field representation, and with it the
+// whole secret, so results must be handled with the same care as shares.
+func EvaluateAt(shares []Share, x uint8) (_ []byte, err error) {
+	defer recoverInternal(&err)
+
+	if err := validateCombineParams(shares, len(shares)); err != nil {
+		return nil, err
+	}
+	scheme, err := sharesScheme(shares)
+	if err != nil {
+		return nil, err
+	}
+	if err := validateShareIndices(shares); err != nil {
+		return nil, err
+	}
+
+	xs := make([]uint8, len(shares))
+	for i, s := range shares {
+		xs[i] = s.Index
+	}
+	if scheme == SchemeV2GF256 {
+		return dotGF256(shares, gf256LagrangeBasisAt(xs, x)), nil
+	}
+
+	if len(shares[0].Value)%2 != 0 {
+		return nil, errors.New("share value length must be even")
+	}
+	basis := lagrangeBasisAt(xs, x)
+	value := make([]byte, 0, len(shares[0].Value))
+	for bytePos := range len(shares[0].Value) / 2 {
+		var acc uint32
+		for i, s := range shares {
+			y, _ := decodeFieldElement(s.Value, bytePos)
+			if y >= FieldPrime {
+				return nil, fmt.Errorf("share %d: decoded value %d out of field range [0, %d]", i, y, FieldPrime-1)
+			}
+			acc += uint32(y) * uint32(basis[i])
+		}
+		value = appendFieldElement(value, uint64(acc%FieldPrime))
+	}
+	return value, nil
+}
@@ -0,0 +1,80 @@
+package goshamir
+
+import (
+	"bytes"
+	"testing"
+)
+
+// --- EvaluateAt Tests ---
+
+func TestEvaluateAt_RecoversMissingShare(t *testing.T) {
+	for _, scheme := range []Scheme{SchemeV1GF257, SchemeV2GF256} {
+		splitter, err := NewSplitter(5, 3, WithScheme(scheme))
+		if err != nil {
+			t.Fatalf("NewSplitter failed: %v", err)
+		}
+		shares, err := splitter.Split([]byte("evaluate me anywhere"))
+		if err != nil {
+			t.Fatalf("Split failed: %v", err)
+		}
+
+		quorum := []Share{shares[0], shares[2], shares[4]}
+		for _, lost := range []Share{shares[1], shares[3], shares[2]} {
+			value, err := EvaluateAt(quorum, lost.Index)
+			if err != nil {
+				t.Fatalf("EvaluateAt failed: %v", err)
+			}
+			if !bytes.Equal(value, lost.Value) {
+				t.Errorf("%s: value at %d does not match the original share", scheme, lost.Index)
+			}
+		}
+
+		// A share evaluated at a new index combines like any other.
+		value, err := EvaluateAt(quorum, 200)
+		if err != nil {
+			t.Fatalf("EvaluateAt failed: %v", err)
+		}
+		fresh := Share{Index: 200, Value: value, Scheme: scheme}
+		recovered, err := Combine([]Share{shares[1], fresh, shares[3]}, 3)
+		if err != nil {
+			t.Fatalf("Combine failed: %v", err)
+		}
+		if string(recovered) != "evaluate me anywhere" {
+			t.Errorf("%s: recovered %q with evaluated share", scheme, recovered)
+		}
+	}
+}
+
+func TestEvaluateAt_Zero(t *testing.T) {
+	secret := []byte{0, 1, 128, 255}
+	shares, err := Split(secret, 4, 2)
+	if err != nil {
+		t.Fatalf("Split failed: %v", err)
+	}
+	value, err := EvaluateAt(shares[2:], 0)
+	if err != nil {
+		t.Fatalf("EvaluateAt failed: %v", err)
+	}
+	for i, b := range secret {
+		y, _ := decodeFieldElement(value, i)
+		if y != int64(b) {
+			t.Errorf("Byte %d: expected %d, got %d", i, b, y)
+		}
+	}
+}
+
+func TestEvaluateAt_InvalidShares(t *testing.T) {
+	shares, _ := Split([]byte("x"), 3, 2)
+	cases := map[string][]Share{
+		"nil":       nil,
+		"single":    shares[:1],
+		"duplicate": {shares[0], shares[0]},
+		"odd":       {{Index: 1, Value: []byte{1}}, {Index: 2, Value: []byte{2}}},
+		"range":     {{Index: 1, Value: []byte{0xff, 0xff}}, {Index: 2, Value: []byte{0, 0}}},
+	}
+	for name, input := range cases {
+		if _, err := EvaluateAt(input, 4); err == nil {
+			t.Errorf("%s: expected error", name)
+		}
+	}
+}
@@ -0,0 +1,223 @@
+// Package evm encodes share set commitments as EVM contract calldata, for
+// on-chain registries of custody arrangements, and verifies shares against
+// commitments published on chain.
+//
+// The encoders target a registry contract with the functions
+//
+//	function registerShareSet(bytes32 setId, bytes32 merkleRoot, uint8 threshold, uint8 total)
+//	function registerShareCommitments(bytes32 setId, bytes32[] commitments)
+//
+// where merkleRoot is the root of a goshamir.ShareMerkleTree and each
+// commitment is a blinded commitment from the same tree. Custodians verify
+// their shares with the tree's inclusion proofs, which carry the
+// blindings.
+package evm
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+
+	goshamir "github.com/fawwazid/go-shamir"
+)
+
+const (
+	// RegisterShareSetSignature is the canonical signature of the registry
+	// function taking a ShareSetRecord.
+	RegisterShareSetSignature = "registerShareSet(bytes32,bytes32,uint8,uint8)"
+	// RegisterCommitmentsSignature is the canonical signature of the
+	// registry function taking per-share commitments.
+	RegisterCommitmentsSignature = "registerShareCommitments(bytes32,bytes32[])"
+)
+
+// wordSize is the size of an ABI word.
+const wordSize = 32
+
+// ErrCommitmentMismatch is returned when shares do not match a commitment
+// published on chain.
+var ErrCommitmentMismatch = errors.New("shares do not match the on-chain commitment")
+
+// Selector returns the 4-byte function selector of a canonical function
+// signature.
+func Selector(signature string) [4]byte {
+	h := Keccak256([]byte(signature))
+	return [4]byte(h[:4])
+}
+
+// SetIDHash maps a share set identifier to the bytes32 used on chain.
+func SetIDHash(setID string) [32]byte {
+	return Keccak256([]byte(setID))
+}
+
+// ShareSetRecord is the on-chain commitment to a share set.
+type ShareSetRecord struct {
+	SetID      [32]byte
+	MerkleRoot [32]byte
+	Threshold  uint8
+	Total      uint8
+}
+
+// NewShareSetRecord commits to a complete share set through the Merkle
+// tree of its shares.
+func NewShareSetRecord(setID string, tree *goshamir.ShareMerkleTree, threshold int) (ShareSetRecord, error) {
+	if tree == nil {
+		return ShareSetRecord{}, errors.New("share tree cannot be nil")
+	}
+	total := len(tree.Indices())
+	if threshold < goshamir.MinThreshold || threshold > total || total > goshamir.MaxShares {
+		return ShareSetRecord{}, errors.New("invalid threshold for share set")
+	}
+	return ShareSetRecord{
+		SetID:      SetIDHash(setID),
+		MerkleRoot: [32]byte(tree.Root()),
+		Threshold:  uint8(threshold),
+		Total:      uint8(total),
+	}, nil
+}
+
+// Calldata returns the ABI-encoded call to registerShareSet.
+func (r ShareSetRecord) Calldata() []byte {
+	sel := Selector(RegisterShareSetSignature)
+	buf := make([]byte, 0, 4+4*wordSize)
+	buf = append(buf, sel[:]...)
+	buf = append(buf, r.SetID[:]...)
+	buf = append(buf, r.MerkleRoot[:]...)
+	buf = appendUint(buf, uint64(r.Threshold))
+	return appendUint(buf, uint64(r.Total))
+}
+
+// DecodeShareSetRecord decodes calldata of a registerShareSet call, for
+// example as read from a transaction on chain.
+func DecodeShareSetRecord(calldata []byte) (ShareSetRecord, error) {
+	sel := Selector(RegisterShareSetSignature)
+	if len(calldata) != 4+4*wordSize || !bytes.Equal(calldata[:4], sel[:]) {
+		return ShareSetRecord{}, errors.New("not registerShareSet calldata")
+	}
+	args := calldata[4:]
+	threshold, err := readUint8(args[2*wordSize:])
+	if err != nil {
+		return ShareSetRecord{}, err
+	}
+	total, err := readUint8(args[3*wordSize:])
+	if err != nil {
+		return ShareSetRecord{}, err
+	}
+	return ShareSetRecord{
+		SetID:      [32]byte(args[:wordSize]),
+		MerkleRoot: [32]byte(args[wordSize : 2*wordSize]),
+		Threshold:  threshold,
+		Total:      total,
+	}, nil
+}
+
+// VerifyShare checks that a custodian's share belongs to the committed set,
+// using the inclusion proof issued with it. The share itself is never
+// published.
+func (r ShareSetRecord) VerifyShare(s goshamir.Share, proof *goshamir.InclusionProof) error {
+	if proof == nil || proof.TreeSize != int(r.Total) {
+		return ErrCommitmentMismatch
+	}
+	if err := goshamir.VerifyShareInclusion(r.MerkleRoot[:], s, proof); err != nil {
+		return fmt.Errorf("%w: %v", ErrCommitmentMismatch, err)
+	}
+	return nil
+}
+
+// VerifyShares checks that shares, with their inclusion proofs in the same
+// order, are exactly the committed set.
+func (r ShareSetRecord) VerifyShares(shares []goshamir.Share, proofs []*goshamir.InclusionProof) error {
+	if len(shares) != int(r.Total) {
+		return fmt.Errorf("%w: got %d shares, committed to %d", ErrCommitmentMismatch, len(shares), r.Total)
+	}
+	if len(proofs) != len(shares) {
+		return fmt.Errorf("got %d proofs for %d shares", len(proofs), len(shares))
+	}
+	seen := make(map[int]bool, len(shares))
+	for i, s := range shares {
+		if err := r.VerifyShare(s, proofs[i]); err != nil {
+			return err
+		}
+		if seen[proofs[i].LeafIndex] {
+			return fmt.Errorf("%w: share %d appears twice", ErrCommitmentMismatch, s.Index)
+		}
+		seen[proofs[i].LeafIndex] = true
+	}
+	return nil
+}
+
+// CommitmentsCalldata returns the ABI-encoded call to
+// registerShareCommitments with the blinded commitment of each share in
+// the tree, ordered by index.
+func CommitmentsCalldata(setID [32]byte, tree *goshamir.ShareMerkleTree) ([]byte, error) {
+	indices := tree.Indices()
+	sel := Selector(RegisterCommitmentsSignature)
+	buf := make([]byte, 0, 4+(3+len(indices))*wordSize)
+	buf = append(buf, sel[:]...)
+	buf = append(buf, setID[:]...)
+	// Offset of the dynamic array, counted from the start of the arguments.
+	buf = appendUint(buf, 2*wordSize)
+	buf = appendUint(buf, uint64(len(indices)))
+	for _, index := range indices {
+		c, err := tree.Commitment(index)
+		if err != nil {
+			return nil, err
+		}
+		buf = append(buf, c...)
+	}
+	return buf, nil
+}
+
+// DecodeCommitmentsCalldata decodes calldata of a registerShareCommitments
+// call.
+func DecodeCommitmentsCalldata(calldata []byte) ([32]byte, [][32]byte, error) {
+	sel := Selector(RegisterCommitmentsSignature)
+	if len(calldata) < 4+3*wordSize || !bytes.Equal(calldata[:4], sel[:]) {
+		return [32]byte{}, nil, errors.New("not registerShareCommitments calldata")
+	}
+	args := calldata[4:]
+	offset, err := readUint(args[wordSize:], uint64(len(args)-wordSize))
+	if err != nil {
+		return [32]byte{}, nil, err
+	}
+	count, err := readUint(args[offset:], uint64(len(args)-int(offset)-wordSize)/wordSize)
+	if err != nil {
+		return [32]byte{}, nil, err
+	}
+	elems := args[offset+wordSize:]
+	if uint64(len(elems)) != count*wordSize {
+		return [32]byte{}, nil, errors.New("malformed commitments array")
+	}
+	commitments := make([][32]byte, count)
+	for i := range commitments {
+		commitments[i] = [32]byte(elems[i*wordSize:])
+	}
+	return [32]byte(args[:wordSize]), commitments, nil
+}
+
+func appendUint(buf []byte, v uint64) []byte {
+	buf = append(buf, make([]byte, wordSize-8)...)
+	return binary.BigEndian.AppendUint64(buf, v)
+}
+
+// readUint decodes an ABI uint word that must not exceed max.
+func readUint(word []byte, max uint64) (uint64, error) {
+	if len(word) < wordSize {
+		return 0, errors.New("truncated ABI word")
+	}
+	for _, b := range word[:wordSize-8] {
+		if b != 0 {
+			return 0, errors.New("ABI value out of range")
+		}
+	}
+	v := binary.BigEndian.Uint64(word[wordSize-8 : wordSize])
+	if v > max {
+		return 0, errors.New("ABI value out of range")
+	}
+	return v, nil
+}
+
+func readUint8(word []byte) (uint8, error) {
+	v, err := readUint(word, 255)
+	return uint8(v), err
+}
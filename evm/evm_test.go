@@ -0,0 +1,106 @@
+package evm
+
+import (
+	"encoding/hex"
+	"errors"
+	"testing"
+
+	goshamir "github.com/fawwazid/go-shamir"
+)
+
+// --- Calldata Tests ---
+
+func TestSelector(t *testing.T) {
+	if sel := Selector("transfer(address,uint256)"); hex.EncodeToString(sel[:]) != "a9059cbb" {
+		t.Errorf("Unexpected selector %x", sel)
+	}
+}
+
+func TestShareSetRecord(t *testing.T) {
+	shares, _ := goshamir.Split([]byte("secret"), 5, 3)
+	tree, _ := goshamir.NewShareMerkleTree(shares)
+	record, err := NewShareSetRecord("set-1", tree, 3)
+	if err != nil {
+		t.Fatalf("NewShareSetRecord failed: %v", err)
+	}
+
+	calldata := record.Calldata()
+	if len(calldata) != 4+4*32 {
+		t.Fatalf("Unexpected calldata length %d", len(calldata))
+	}
+	if calldata[4+3*32-1] != 3 || calldata[4+4*32-1] != 5 {
+		t.Error("Expected threshold and total in the last byte of their words")
+	}
+
+	// A verifier decodes the published calldata and checks local shares.
+	published, err := DecodeShareSetRecord(calldata)
+	if err != nil {
+		t.Fatalf("DecodeShareSetRecord failed: %v", err)
+	}
+	if published != record {
+		t.Errorf("Decoded record %+v does not match %+v", published, record)
+	}
+	proofs := make([]*goshamir.InclusionProof, len(shares))
+	for i, s := range shares {
+		proofs[i], _ = tree.Proof(s.Index)
+	}
+	if err := published.VerifyShares(shares, proofs); err != nil {
+		t.Errorf("VerifyShares failed: %v", err)
+	}
+	if err := published.VerifyShares(shares[:4], proofs[:4]); !errors.Is(err, ErrCommitmentMismatch) {
+		t.Errorf("Expected ErrCommitmentMismatch for partial set, got %v", err)
+	}
+	duplicated := []goshamir.Share{shares[0], shares[1], shares[2], shares[3], shares[3]}
+	if err := published.VerifyShares(duplicated, append(proofs[:4:4], proofs[3])); !errors.Is(err, ErrCommitmentMismatch) {
+		t.Errorf("Expected ErrCommitmentMismatch for duplicated share, got %v", err)
+	}
+
+	proof, _ := tree.Proof(2)
+	if err := published.VerifyShare(shares[1], proof); err != nil {
+		t.Errorf("VerifyShare failed: %v", err)
+	}
+	if err := published.VerifyShare(shares[2], proof); !errors.Is(err, ErrCommitmentMismatch) {
+		t.Errorf("Expected ErrCommitmentMismatch for wrong share, got %v", err)
+	}
+
+	calldata[4+3*32-2] = 1
+	if _, err := DecodeShareSetRecord(calldata); err == nil {
+		t.Error("Expected error for out-of-range uint8")
+	}
+	if _, err := DecodeShareSetRecord(calldata[:10]); err == nil {
+		t.Error("Expected error for truncated calldata")
+	}
+}
+
+func TestCommitmentsCalldata(t *testing.T) {
+	shares, _ := goshamir.Split([]byte("secret"), 3, 2)
+	tree, _ := goshamir.NewShareMerkleTree(shares)
+	setID := SetIDHash("set-1")
+	calldata, err := CommitmentsCalldata(setID, tree)
+	if err != nil {
+		t.Fatalf("CommitmentsCalldata failed: %v", err)
+	}
+
+	gotID, commitments, err := DecodeCommitmentsCalldata(calldata)
+	if err != nil {
+		t.Fatalf("DecodeCommitmentsCalldata failed: %v", err)
+	}
+	if gotID != setID || len(commitments) != 3 {
+		t.Fatalf("Unexpected decoded calldata: %x, %d commitments", gotID, len(commitments))
+	}
+	for i, s := range shares {
+		proof, _ := tree.Proof(s.Index)
+		if commitments[i] != [32]byte(goshamir.ShareCommitment(s, proof.Blinding)) {
+			t.Errorf("Commitment %d does not match share", i)
+		}
+	}
+
+	if _, _, err := DecodeCommitmentsCalldata(calldata[:len(calldata)-1]); err == nil {
+		t.Error("Expected error for truncated array")
+	}
+	bad := append([]byte(nil), calldata...)
+	bad[4+2*32-1] = 0xFF
+	if _, _, err := DecodeCommitmentsCalldata(bad); err == nil {
+		t.Error("Expected error for bad offset")
+	}
+}
@@ -0,0 +1,33 @@
+package evm
+
+import (
+	"bytes"
+	"encoding/hex"
+	"testing"
+)
+
+// --- Keccak256 Tests ---
+
+func TestKeccak256(t *testing.T) {
+	tests := []struct {
+		input string
+		want  string
+	}{
+		{"", "c5d2460186f7233c927e7db2dcc703c0e500b653ca82273b7bfad8045d85a470"},
+		{"abc", "4e03657aea45a94fc7d47ba826c8d667c0d1e6e33a64a036ec44f58fa12d6c45"},
+		{"transfer(address,uint256)", "a9059cbb2ab09eb219583f4a59a5d0623ade346d962bcd4e46b11da047c9049b"},
+	}
+	for _, tt := range tests {
+		got := Keccak256([]byte(tt.input))
+		if hex.EncodeToString(got[:]) != tt.want {
+			t.Errorf("Keccak256(%q) = %x, expected %s", tt.input, got, tt.want)
+		}
+	}
+
+	// Inputs spanning several blocks hash the same however they are split.
+	long := bytes.Repeat([]byte("0123456789"), 50)
+	whole := Keccak256(long)
+	if parts := Keccak256(long[:135], long[135:136], long[136:]); parts != whole {
+		t.Error("Expected split input to hash like the whole")
+	}
+}
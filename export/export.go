@@ -0,0 +1,256 @@
+// Package export wraps shares as items that password managers can import,
+// so custodians can keep their share in the vault they already use. Each
+// share becomes a secure note with the share in a concealed field, its set
+// ID, index, threshold and fingerprint in separate fields, and recovery
+// instructions in the notes.
+//
+// Exported files hold shares in the clear. Import them straight away and
+// delete them; never write more than one custodian's shares to one file.
+package export
+
+import (
+	"crypto/rand"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+
+	goshamir "github.com/fawwazid/go-shamir"
+)
+
+// Entry is one share to export.
+type Entry struct {
+	SetID     string
+	Threshold int
+	Total     int
+	// Custodian is the name of the share's holder, if known.
+	Custodian string
+	Share     goshamir.Share
+}
+
+// Entries returns the entries for a distributed share set.
+func Entries(setID string, threshold int, bundles []goshamir.CustodianBundle) []Entry {
+	entries := make([]Entry, len(bundles))
+	for i, b := range bundles {
+		entries[i] = Entry{SetID: setID, Threshold: threshold, Total: len(bundles), Custodian: b.Custodian.Name, Share: b.Share}
+	}
+	return entries
+}
+
+// field is a named value of an exported item.
+type field struct {
+	name      string
+	value     string
+	concealed bool
+}
+
+// Title returns the item title used for the entry.
+func (e Entry) Title() string {
+	return fmt.Sprintf("Shamir share %d of %d (%s)", e.Share.Index, e.Total, e.SetID)
+}
+
+// Notes returns the recovery instructions stored with the entry.
+func (e Entry) Notes() string {
+	notes := fmt.Sprintf("Share %d of %d of the secret %q, split with go-shamir.\n"+
+		"Any %d shares together recover the secret; fewer reveal nothing about it.\n"+
+		"The share is in the \"share\" field. Do not copy it anywhere else.\n"+
+		"To check it without revealing it, compare its fingerprint with the one recorded by the dealer.",
+		e.Share.Index, e.Total, e.SetID, e.Threshold)
+	if e.Custodian != "" {
+		notes = "Custodian: " + e.Custodian + "\n" + notes
+	}
+	return notes
+}
+
+func (e Entry) fields() ([]field, error) {
+	if e.SetID == "" {
+		return nil, errors.New("set ID cannot be empty")
+	}
+	if e.Threshold < 2 || e.Total < e.Threshold {
+		return nil, fmt.Errorf("invalid threshold %d of %d", e.Threshold, e.Total)
+	}
+	encoded, err := goshamir.EncodeSharesToHex([]goshamir.Share{e.Share})
+	if err != nil {
+		return nil, err
+	}
+	info := goshamir.InspectShare(e.Share)
+	return []field{
+		{"share", encoded[0], true},
+		{"set_id", e.SetID, false},
+		{"index", strconv.Itoa(int(e.Share.Index)), false},
+		{"threshold", strconv.Itoa(e.Threshold), false},
+		{"total_shares", strconv.Itoa(e.Total), false},
+		{"scheme", info.Scheme.String(), false},
+		{"fingerprint", info.Fingerprint, false},
+	}, nil
+}
+
+// Bitwarden item and field types used by the export format.
+const (
+	bitwardenSecureNote  = 2
+	bitwardenFieldText   = 0
+	bitwardenFieldHidden = 1
+)
+
+type bitwardenExport struct {
+	Encrypted bool              `json:"encrypted"`
+	Folders   []bitwardenFolder `json:"folders"`
+	Items     []bitwardenItem   `json:"items"`
+}
+
+type bitwardenFolder struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+type bitwardenItem struct {
+	ID         string           `json:"id"`
+	FolderID   *string          `json:"folderId"`
+	Type       int              `json:"type"`
+	Reprompt   int              `json:"reprompt"`
+	Name       string           `json:"name"`
+	Notes      string           `json:"notes"`
+	Favorite   bool             `json:"favorite"`
+	Fields     []bitwardenField `json:"fields"`
+	SecureNote struct {
+		Type int `json:"type"`
+	} `json:"secureNote"`
+}
+
+type bitwardenField struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+	Type  int    `json:"type"`
+}
+
+// BitwardenJSON writes entries in Bitwarden's unencrypted JSON export
+// format, as secure notes that require the master password to be
+// re-entered before viewing. If folder is not empty, the items are placed
+// in a folder of that name.
+func BitwardenJSON(w io.Writer, entries []Entry, folder string) error {
+	out := bitwardenExport{Folders: []bitwardenFolder{}, Items: []bitwardenItem{}}
+	var folderID *string
+	if folder != "" {
+		id := newUUID()
+		folderID = &id
+		out.Folders = append(out.Folders, bitwardenFolder{ID: id, Name: folder})
+	}
+	for _, e := range entries {
+		fields, err := e.fields()
+		if err != nil {
+			return err
+		}
+		item := bitwardenItem{
+			ID:       newUUID(),
+			FolderID: folderID,
+			Type:     bitwardenSecureNote,
+			Reprompt: 1,
+			Name:     e.Title(),
+			Notes:    e.Notes(),
+		}
+		for _, f := range fields {
+			typ := bitwardenFieldText
+			if f.concealed {
+				typ = bitwardenFieldHidden
+			}
+			item.Fields = append(item.Fields, bitwardenField{Name: f.name, Value: f.value, Type: typ})
+		}
+		out.Items = append(out.Items, item)
+	}
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(out)
+}
+
+// BitwardenCSV writes entries in Bitwarden's CSV import format, as secure
+// notes with custom fields.
+func BitwardenCSV(w io.Writer, entries []Entry, folder string) error {
+	cw := csv.NewWriter(w)
+	cw.Write([]string{"folder", "favorite", "type", "name", "notes", "fields", "reprompt", "login_uri", "login_username", "login_password", "login_totp"})
+	for _, e := range entries {
+		fields, err := e.fields()
+		if err != nil {
+			return err
+		}
+		var custom string
+		for i, f := range fields {
+			if i > 0 {
+				custom += "\n"
+			}
+			custom += f.name + ": " + f.value
+		}
+		cw.Write([]string{folder, "", "note", e.Title(), e.Notes(), custom, "1", "", "", "", ""})
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+type onePasswordItem struct {
+	Title    string             `json:"title"`
+	Category string             `json:"category"`
+	Tags     []string           `json:"tags"`
+	Fields   []onePasswordField `json:"fields"`
+}
+
+type onePasswordField struct {
+	ID      string `json:"id"`
+	Type    string `json:"type"`
+	Purpose string `json:"purpose,omitempty"`
+	Label   string `json:"label"`
+	Value   string `json:"value"`
+}
+
+// OnePasswordTemplate returns an item template for the 1Password CLI, to be
+// imported with "op item create --template <file>". The item is a secure
+// note tagged "shamir" with the share in a concealed field.
+func OnePasswordTemplate(e Entry) ([]byte, error) {
+	fields, err := e.fields()
+	if err != nil {
+		return nil, err
+	}
+	item := onePasswordItem{
+		Title:    e.Title(),
+		Category: "SECURE_NOTE",
+		Tags:     []string{"shamir", e.SetID},
+		Fields:   []onePasswordField{{ID: "notesPlain", Type: "STRING", Purpose: "NOTES", Label: "notesPlain", Value: e.Notes()}},
+	}
+	for _, f := range fields {
+		typ := "STRING"
+		if f.concealed {
+			typ = "CONCEALED"
+		}
+		item.Fields = append(item.Fields, onePasswordField{ID: f.name, Type: typ, Label: f.name, Value: f.value})
+	}
+	return json.MarshalIndent(item, "", "  ")
+}
+
+// OnePasswordCSV writes entries in the CSV layout accepted by 1Password's
+// import, with the share as the password and its details in the notes.
+func OnePasswordCSV(w io.Writer, entries []Entry) error {
+	cw := csv.NewWriter(w)
+	cw.Write([]string{"Title", "Website", "Username", "Password", "Notes", "Tags"})
+	for _, e := range entries {
+		fields, err := e.fields()
+		if err != nil {
+			return err
+		}
+		notes := e.Notes() + "\n"
+		for _, f := range fields[1:] {
+			notes += "\n" + f.name + ": " + f.value
+		}
+		cw.Write([]string{e.Title(), "", e.SetID, fields[0].value, notes, "shamir"})
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+// newUUID returns a random version 4 UUID.
+func newUUID() string {
+	var b [16]byte
+	rand.Read(b[:])
+	b[6] = b[6]&0x0f | 0x40
+	b[8] = b[8]&0x3f | 0x80
+	return fmt.Sprintf("%x-%x-%x-%x-%x", b[0:4], b[4:6], b[6:8], b[8:10], b[10:])
+}
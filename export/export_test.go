@@ -0,0 +1,140 @@
+package export
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	goshamir "github.com/fawwazid/go-shamir"
+)
+
+func testEntries(t *testing.T) ([]goshamir.Share, []Entry) {
+	t.Helper()
+	shares, _ := goshamir.Split([]byte("vault secret"), 3, 2)
+	bundles, err := goshamir.AssignShares(shares, []goshamir.Custodian{{Name: "Alice"}, {Name: "Bob"}, {Name: "Carol"}})
+	if err != nil {
+		t.Fatalf("AssignShares failed: %v", err)
+	}
+	return shares, Entries("prod-db", 2, bundles)
+}
+
+// shareFromHex decodes an exported share field.
+func shareFromHex(t *testing.T, s string) goshamir.Share {
+	t.Helper()
+	shares, err := goshamir.DecodeSharesFromHex([]string{s})
+	if err != nil {
+		t.Fatalf("DecodeSharesFromHex failed: %v", err)
+	}
+	return shares[0]
+}
+
+// --- Bitwarden Tests ---
+
+func TestBitwardenJSON(t *testing.T) {
+	_, entries := testEntries(t)
+	var buf bytes.Buffer
+	if err := BitwardenJSON(&buf, entries, "Shamir"); err != nil {
+		t.Fatalf("BitwardenJSON failed: %v", err)
+	}
+	var out bitwardenExport
+	if err := json.Unmarshal(buf.Bytes(), &out); err != nil {
+		t.Fatalf("Invalid JSON: %v", err)
+	}
+	if out.Encrypted || len(out.Folders) != 1 || len(out.Items) != 3 {
+		t.Fatalf("Unexpected export %+v", out)
+	}
+	item := out.Items[1]
+	if *item.FolderID != out.Folders[0].ID || item.Type != 2 || item.Reprompt != 1 || item.Name != "Shamir share 2 of 3 (prod-db)" {
+		t.Errorf("Unexpected item %+v", item)
+	}
+	if !strings.Contains(item.Notes, "Custodian: Bob") || !strings.Contains(item.Notes, "Any 2 shares") {
+		t.Errorf("Unexpected notes %q", item.Notes)
+	}
+	if f := item.Fields[0]; f.Name != "share" || f.Type != 1 {
+		t.Errorf("Expected hidden share field, got %+v", f)
+	}
+	recovered, err := goshamir.Combine([]goshamir.Share{shareFromHex(t, out.Items[0].Fields[0].Value), shareFromHex(t, item.Fields[0].Value)}, 2)
+	if err != nil || string(recovered) != "vault secret" {
+		t.Errorf("Combine of exported shares failed: %v", err)
+	}
+
+	buf.Reset()
+	BitwardenJSON(&buf, entries[:1], "")
+	if !strings.Contains(buf.String(), `"folderId": null`) || !strings.Contains(buf.String(), `"folders": []`) {
+		t.Errorf("Expected no folder, got %s", buf.String())
+	}
+}
+
+func TestBitwardenCSV(t *testing.T) {
+	_, entries := testEntries(t)
+	var buf bytes.Buffer
+	if err := BitwardenCSV(&buf, entries, "Shamir"); err != nil {
+		t.Fatalf("BitwardenCSV failed: %v", err)
+	}
+	records, err := csv.NewReader(&buf).ReadAll()
+	if err != nil || len(records) != 4 {
+		t.Fatalf("Invalid CSV: %v", err)
+	}
+	if records[0][5] != "fields" || records[1][0] != "Shamir" || records[1][2] != "note" {
+		t.Errorf("Unexpected records %q", records[:2])
+	}
+	if !strings.Contains(records[3][5], "index: 3\n") || !strings.HasPrefix(records[3][5], "share: ") {
+		t.Errorf("Unexpected fields %q", records[3][5])
+	}
+}
+
+// --- 1Password Tests ---
+
+func TestOnePasswordTemplate(t *testing.T) {
+	shares, entries := testEntries(t)
+	data, err := OnePasswordTemplate(entries[2])
+	if err != nil {
+		t.Fatalf("OnePasswordTemplate failed: %v", err)
+	}
+	var item onePasswordItem
+	if err := json.Unmarshal(data, &item); err != nil {
+		t.Fatalf("Invalid JSON: %v", err)
+	}
+	if item.Category != "SECURE_NOTE" || item.Fields[0].Purpose != "NOTES" {
+		t.Errorf("Unexpected item %+v", item)
+	}
+	share := item.Fields[1]
+	if share.Label != "share" || share.Type != "CONCEALED" || !bytes.Equal(shareFromHex(t, share.Value).Value, shares[2].Value) {
+		t.Errorf("Unexpected share field %+v", share)
+	}
+	fingerprint := goshamir.InspectShare(shares[2]).Fingerprint
+	if !strings.Contains(string(data), fingerprint) {
+		t.Error("Expected the share fingerprint in the item")
+	}
+}
+
+func TestOnePasswordCSV(t *testing.T) {
+	shares, entries := testEntries(t)
+	var buf bytes.Buffer
+	if err := OnePasswordCSV(&buf, entries); err != nil {
+		t.Fatalf("OnePasswordCSV failed: %v", err)
+	}
+	records, _ := csv.NewReader(&buf).ReadAll()
+	if len(records) != 4 || records[0][3] != "Password" {
+		t.Fatalf("Unexpected records %q", records)
+	}
+	if !bytes.Equal(shareFromHex(t, records[1][3]).Value, shares[0].Value) || !strings.Contains(records[1][4], "threshold: 2") {
+		t.Errorf("Unexpected record %q", records[1])
+	}
+}
+
+func TestExport_InvalidEntries(t *testing.T) {
+	_, entries := testEntries(t)
+	bad := entries[0]
+	bad.Threshold = 5
+	if err := BitwardenJSON(&bytes.Buffer{}, []Entry{bad}, ""); err == nil {
+		t.Error("Expected error for threshold above total")
+	}
+	bad = entries[0]
+	bad.SetID = ""
+	if _, err := OnePasswordTemplate(bad); err == nil {
+		t.Error("Expected error for empty set ID")
+	}
+}
@@ -0,0 +1,103 @@
+package goshamir
+
+import (
+	"fmt"
+	"io"
+)
+
+// gf257Inverse holds the multiplicative inverse of every non-zero element of
+// GF(257). Index 0 has no inverse and is left as zero.
+var gf257Inverse = func() [FieldPrime]uint16 {
+	var inv [FieldPrime]uint16
+	for a := uint32(1); a < FieldPrime; a++ {
+		// Fermat's little theorem: a^(p-2) = a^-1 (mod p).
+		result, base, exp := uint32(1), a, uint32(FieldPrime-2)
+		for exp > 0 {
+			if exp&1 == 1 {
+				result = result * base % FieldPrime
+			}
+			base = base * base % FieldPrime
+			exp >>= 1
+		}
+		inv[a] = uint16(result)
+	}
+	return inv
+}()
+
+func gfAdd(a, b uint16) uint16 {
+	return uint16((uint32(a) + uint32(b)) % FieldPrime)
+}
+
+func gfSub(a, b uint16) uint16 {
+	return uint16((uint32(a) + FieldPrime - uint32(b)%FieldPrime) % FieldPrime)
+}
+
+func gfMul(a, b uint16) uint16 {
+	return uint16(uint32(a) * uint32(b) % FieldPrime)
+}
+
+// gfInv returns the multiplicative inverse of a. The caller must ensure a is
+// non-zero modulo FieldPrime.
+func gfInv(a uint16) uint16 {
+	return gf257Inverse[a%FieldPrime]
+}
+
+// indexPowers returns x^0, x^1, ..., x^(count-1) in GF(257).
+func indexPowers(x uint8, count int) []uint16 {
+	powers := make([]uint16, count)
+	p := uint16(1)
+	for j := range powers {
+		powers[j] = p
+		p = gfMul(p, uint16(x))
+	}
+	return powers
+}
+
+// readFieldElements fills dst with uniformly distributed GF(257) elements
+// drawn from r. Two random bytes are consumed per element and the single
+// value that would bias the reduction (0xFFFF) is rejected. scratch must have
+// room for at least 2*len(dst) bytes.
+func readFieldElements(r io.Reader, dst []uint16, scratch []byte) error {
+	filled := 0
+	for filled < len(dst) {
+		need := len(dst) - filled
+		buf := scratch[:2*need]
+		if _, err := io.ReadFull(r, buf); err != nil {
+			return fmt.Errorf("random coefficient generation failed: %w", err)
+		}
+		for i := 0; i < need; i++ {
+			v := uint16(buf[2*i]) | uint16(buf[2*i+1])<<8
+			if v == 0xFFFF {
+				continue
+			}
+			dst[filled] = v % FieldPrime
+			filled++
+		}
+	}
+	return nil
+}
+
+// lagrangeBasisAtZero returns the Lagrange basis polynomials for the given
+// distinct, non-zero x-coordinates evaluated at x = 0, so that
+// f(0) = sum(basis[i] * y[i]).
+func lagrangeBasisAtZero(xs []uint8) []uint16 {
+	return lagrangeBasisAt(xs, 0)
+}
+
+// lagrangeBasisAt returns the Lagrange basis polynomials for the given
+// distinct x-coordinates evaluated at x, so that f(x) = sum(basis[i] * y[i]).
+func lagrangeBasisAt(xs []uint8, x uint8) []uint16 {
+	basis := make([]uint16, len(xs))
+	for i, xi := range xs {
+		num, den := uint16(1), uint16(1)
+		for j, xj := range xs {
+			if i == j {
+				continue
+			}
+			num = gfMul(num, gfSub(uint16(xj), uint16(x)))
+			den = gfMul(den, gfSub(uint16(xj), uint16(xi)))
+		}
+		basis[i] = gfMul(num, gfInv(den))
+	}
+	return basis
+}
@@ -0,0 +1,257 @@
+// Package field exposes the finite-field and polynomial arithmetic that
+// goshamir's shares are built on, for protocol developers building custom
+// schemes without copying the internals.
+//
+// Two fields are provided: GF257, the prime field of share scheme v1, and
+// GF256, GF(2^8) with the AES reduction polynomial, of scheme v2. Elements
+// of either are held in an Element. The arithmetic is not constant time,
+// matching the share operations of the root package.
+package field
+
+import (
+	"errors"
+	"fmt"
+	"io"
+)
+
+// Element is an element of a Field: a value below 257 in GF257 and below
+// 256 in GF256. Operations reduce their arguments into the field first,
+// modulo 257 in GF257 and to the low 8 bits in GF256.
+type Element = uint16
+
+// Field is one of the finite fields shares are computed in.
+type Field uint8
+
+const (
+	// GF257 is the prime field of integers modulo 257, used by
+	// goshamir.SchemeV1GF257.
+	GF257 Field = iota + 1
+	// GF256 is GF(2^8) with the reduction polynomial
+	// x^8 + x^4 + x^3 + x + 1, used by goshamir.SchemeV2GF256.
+	GF256
+)
+
+// prime is the modulus of GF257.
+const prime = 257
+
+var (
+	// ErrZeroInverse is returned when inverting or dividing by zero.
+	ErrZeroInverse = errors.New("zero has no multiplicative inverse")
+	// ErrDuplicatePoint is returned when interpolation points share an
+	// x-coordinate.
+	ErrDuplicatePoint = errors.New("interpolation points must have distinct x-coordinates")
+)
+
+// gf257Inverse holds the multiplicative inverse of every non-zero element of
+// GF(257). Index 0 has no inverse and is left as zero.
+var gf257Inverse = func() [prime]uint16 {
+	var inv [prime]uint16
+	for a := uint32(1); a < prime; a++ {
+		// Fermat's little theorem: a^(p-2) = a^-1 (mod p).
+		result, base, exp := uint32(1), a, uint32(prime-2)
+		for exp > 0 {
+			if exp&1 == 1 {
+				result = result * base % prime
+			}
+			base = base * base % prime
+			exp >>= 1
+		}
+		inv[a] = uint16(result)
+	}
+	return inv
+}()
+
+// gf256Exp and gf256Log are the exponent and logarithm tables of GF(2^8)
+// with generator 3. The exponent table is doubled so products never need a
+// modular reduction of the summed logarithms.
+var gf256Exp, gf256Log = func() ([510]byte, [256]byte) {
+	var exp [510]byte
+	var log [256]byte
+	x := byte(1)
+	for i := 0; i < 255; i++ {
+		exp[i] = x
+		exp[i+255] = x
+		log[x] = byte(i)
+		// Multiply by the generator 3: x*2 + x.
+		x2 := x << 1
+		if x&0x80 != 0 {
+			x2 ^= 0x1B
+		}
+		x ^= x2
+	}
+	return exp, log
+}()
+
+// String returns the conventional name of the field.
+func (f Field) String() string {
+	switch f {
+	case GF257:
+		return "GF(257)"
+	case GF256:
+		return "GF(2^8)"
+	}
+	return fmt.Sprintf("Field(%d)", uint8(f))
+}
+
+// Size returns the number of elements of the field.
+func (f Field) Size() int {
+	if f == GF256 {
+		return 256
+	}
+	return prime
+}
+
+// reduce maps a into the field.
+func (f Field) reduce(a Element) Element {
+	if f == GF256 {
+		return a & 0xFF
+	}
+	return a % prime
+}
+
+// Add returns a + b.
+func (f Field) Add(a, b Element) Element {
+	if f == GF256 {
+		return (a ^ b) & 0xFF
+	}
+	return (a%prime + b%prime) % prime
+}
+
+// Sub returns a - b. In GF256 it equals Add.
+func (f Field) Sub(a, b Element) Element {
+	if f == GF256 {
+		return (a ^ b) & 0xFF
+	}
+	return (a%prime + prime - b%prime) % prime
+}
+
+// Mul returns a · b.
+func (f Field) Mul(a, b Element) Element {
+	if f == GF256 {
+		a, b = a&0xFF, b&0xFF
+		if a == 0 || b == 0 {
+			return 0
+		}
+		return Element(gf256Exp[int(gf256Log[a])+int(gf256Log[b])])
+	}
+	return Element(uint32(a%prime) * uint32(b%prime) % prime)
+}
+
+// Inv returns the multiplicative inverse of a, or ErrZeroInverse if a is
+// zero.
+func (f Field) Inv(a Element) (Element, error) {
+	a = f.reduce(a)
+	if a == 0 {
+		return 0, ErrZeroInverse
+	}
+	if f == GF256 {
+		return Element(gf256Exp[255-int(gf256Log[a])]), nil
+	}
+	return gf257Inverse[a], nil
+}
+
+// Div returns a / b, or ErrZeroInverse if b is zero.
+func (f Field) Div(a, b Element) (Element, error) {
+	inv, err := f.Inv(b)
+	if err != nil {
+		return 0, err
+	}
+	return f.Mul(a, inv), nil
+}
+
+// Eval evaluates the polynomial with the given coefficients, lowest degree
+// first, at x. The secret of a sharing polynomial is coeffs[0] = Eval(0).
+func (f Field) Eval(coeffs []Element, x Element) Element {
+	// Horner's method.
+	var acc Element
+	for j := len(coeffs) - 1; j >= 0; j-- {
+		acc = f.Add(f.Mul(acc, x), coeffs[j])
+	}
+	return acc
+}
+
+// LagrangeBasis returns the Lagrange basis polynomials for the distinct
+// x-coordinates xs evaluated at x, so that the polynomial through the
+// points (xs[i], ys[i]) takes the value sum(basis[i] · ys[i]) at x.
+// Reconstructing a secret uses x = 0.
+func (f Field) LagrangeBasis(xs []Element, x Element) ([]Element, error) {
+	basis := make([]Element, len(xs))
+	for i, xi := range xs {
+		num, den := Element(1), Element(1)
+		for j, xj := range xs {
+			if i == j {
+				continue
+			}
+			num = f.Mul(num, f.Sub(xj, x))
+			den = f.Mul(den, f.Sub(xj, xi))
+		}
+		inv, err := f.Inv(den)
+		if err != nil {
+			return nil, ErrDuplicatePoint
+		}
+		basis[i] = f.Mul(num, inv)
+	}
+	return basis, nil
+}
+
+// Interpolate returns the value at x of the lowest-degree polynomial
+// through the points (xs[i], ys[i]).
+func (f Field) Interpolate(xs, ys []Element, x Element) (Element, error) {
+	if len(xs) != len(ys) {
+		return 0, fmt.Errorf("got %d x-coordinates and %d y-coordinates", len(xs), len(ys))
+	}
+	if len(xs) == 0 {
+		return 0, errors.New("no interpolation points")
+	}
+	basis, err := f.LagrangeBasis(xs, x)
+	if err != nil {
+		return 0, err
+	}
+	var acc Element
+	for i, b := range basis {
+		acc = f.Add(acc, f.Mul(b, ys[i]))
+	}
+	return acc, nil
+}
+
+// RandomPolynomial returns a polynomial of the given degree with constant
+// term intercept and the other coefficients drawn uniformly from r, as used
+// to share intercept among degree+1 parties. The leading coefficient may be
+// zero, as in the root package's splits.
+func (f Field) RandomPolynomial(intercept Element, degree int, r io.Reader) ([]Element, error) {
+	if degree < 0 {
+		return nil, errors.New("degree must not be negative")
+	}
+	coeffs := make([]Element, degree+1)
+	coeffs[0] = f.reduce(intercept)
+	for j := 1; j < len(coeffs); j++ {
+		e, err := f.Random(r)
+		if err != nil {
+			return nil, err
+		}
+		coeffs[j] = e
+	}
+	return coeffs, nil
+}
+
+// Random returns an element drawn uniformly from r. In GF257 two bytes are
+// read per attempt and the value that would bias the reduction is
+// rejected.
+func (f Field) Random(r io.Reader) (Element, error) {
+	var buf [2]byte
+	if f == GF256 {
+		if _, err := io.ReadFull(r, buf[:1]); err != nil {
+			return 0, fmt.Errorf("random element generation failed: %w", err)
+		}
+		return Element(buf[0]), nil
+	}
+	for {
+		if _, err := io.ReadFull(r, buf[:]); err != nil {
+			return 0, fmt.Errorf("random element generation failed: %w", err)
+		}
+		v := uint16(buf[0]) | uint16(buf[1])<<8
+		if v != 0xFFFF {
+			return v % prime, nil
+		}
+	}
+}
@@ -0,0 +1,109 @@
+package field
+
+import (
+	"bytes"
+	"crypto/rand"
+	"errors"
+	"testing"
+
+	goshamir "github.com/fawwazid/go-shamir"
+)
+
+// --- Field Tests ---
+
+func TestField_KnownAnswers(t *testing.T) {
+	// FIPS-197 section 4.2.
+	if got := GF256.Mul(0x57, 0x83); got != 0xc1 {
+		t.Errorf("GF256.Mul(0x57, 0x83) = %#x, want 0xc1", got)
+	}
+	if got, _ := GF256.Inv(0x53); got != 0xca {
+		t.Errorf("GF256.Inv(0x53) = %#x, want 0xca", got)
+	}
+	if got := GF257.Mul(200, 100); got != 20000%257 {
+		t.Errorf("GF257.Mul(200, 100) = %d", got)
+	}
+	if got := GF257.Sub(3, 5); got != 255 {
+		t.Errorf("GF257.Sub(3, 5) = %d, want 255", got)
+	}
+}
+
+func TestField_Inverses(t *testing.T) {
+	for _, f := range []Field{GF257, GF256} {
+		for a := 1; a < f.Size(); a++ {
+			inv, err := f.Inv(Element(a))
+			if err != nil {
+				t.Fatalf("%v Inv(%d) failed: %v", f, a, err)
+			}
+			if got := f.Mul(Element(a), inv); got != 1 {
+				t.Fatalf("%v: %d · %d = %d", f, a, inv, got)
+			}
+		}
+		if _, err := f.Inv(0); !errors.Is(err, ErrZeroInverse) {
+			t.Errorf("%v: expected ErrZeroInverse, got %v", f, err)
+		}
+		if _, err := f.Div(1, 0); !errors.Is(err, ErrZeroInverse) {
+			t.Errorf("%v: expected ErrZeroInverse, got %v", f, err)
+		}
+	}
+}
+
+func TestField_EvalInterpolate(t *testing.T) {
+	for _, f := range []Field{GF257, GF256} {
+		coeffs, err := f.RandomPolynomial(42, 2, rand.Reader)
+		if err != nil {
+			t.Fatalf("RandomPolynomial failed: %v", err)
+		}
+		if f.Eval(coeffs, 0) != 42 {
+			t.Fatalf("%v: Eval(0) is not the intercept", f)
+		}
+		xs := []Element{1, 3, 7}
+		ys := make([]Element, len(xs))
+		for i, x := range xs {
+			ys[i] = f.Eval(coeffs, x)
+		}
+		for _, x := range []Element{0, 5, 200} {
+			got, err := f.Interpolate(xs, ys, x)
+			if err != nil {
+				t.Fatalf("Interpolate failed: %v", err)
+			}
+			if want := f.Eval(coeffs, x); got != want {
+				t.Errorf("%v: Interpolate at %d = %d, want %d", f, x, got, want)
+			}
+		}
+		if _, err := f.Interpolate([]Element{1, 1}, []Element{2, 3}, 0); !errors.Is(err, ErrDuplicatePoint) {
+			t.Errorf("%v: expected ErrDuplicatePoint, got %v", f, err)
+		}
+	}
+}
+
+func TestField_MatchesShares(t *testing.T) {
+	secret := []byte("custom scheme")
+	splitter, err := goshamir.NewSplitter(5, 3, goshamir.WithScheme(goshamir.SchemeV2GF256))
+	if err != nil {
+		t.Fatalf("NewSplitter failed: %v", err)
+	}
+	shares, err := splitter.Split(secret)
+	if err != nil {
+		t.Fatalf("Split failed: %v", err)
+	}
+	used := []goshamir.Share{shares[4], shares[0], shares[2]}
+	xs := make([]Element, len(used))
+	for i, s := range used {
+		xs[i] = Element(s.Index)
+	}
+	recovered := make([]byte, len(secret))
+	for pos := range recovered {
+		ys := make([]Element, len(used))
+		for i, s := range used {
+			ys[i] = Element(s.Value[pos])
+		}
+		v, err := GF256.Interpolate(xs, ys, 0)
+		if err != nil {
+			t.Fatalf("Interpolate failed: %v", err)
+		}
+		recovered[pos] = byte(v)
+	}
+	if !bytes.Equal(recovered, secret) {
+		t.Fatalf("recovered %q, want %q", recovered, secret)
+	}
+}
@@ -0,0 +1,100 @@
+package goshamir
+
+// gf256Exp and gf256Log are the exponent and logarithm tables of GF(2^8)
+// with the AES reduction polynomial x^8 + x^4 + x^3 + x + 1 and generator 3.
+// The exponent table is doubled so products never need a modular reduction
+// of the summed logarithms.
+var gf256Exp, gf256Log = func() ([510]byte, [256]byte) {
+	var exp [510]byte
+	var log [256]byte
+	x := byte(1)
+	for i := 0; i < 255; i++ {
+		exp[i] = x
+		exp[i+255] = x
+		log[x] = byte(i)
+		// Multiply by the generator 3: x*2 + x.
+		x2 := x << 1
+		if x&0x80 != 0 {
+			x2 ^= 0x1B
+		}
+		x ^= x2
+	}
+	return exp, log
+}()
+
+func gf256Mul(a, b byte) byte {
+	if a == 0 || b == 0 {
+		return 0
+	}
+	return gf256Exp[int(gf256Log[a])+int(gf256Log[b])]
+}
+
+// gf256Inv returns the multiplicative inverse of a. The caller must ensure a
+// is non-zero.
+func gf256Inv(a byte) byte {
+	return gf256Exp[255-int(gf256Log[a])]
+}
+
+// gf256IndexPowers returns x^0, x^1, ..., x^(count-1) in GF(2^8).
+func gf256IndexPowers(x uint8, count int) []byte {
+	powers := make([]byte, count)
+	p := byte(1)
+	for j := range powers {
+		powers[j] = p
+		p = gf256Mul(p, x)
+	}
+	return powers
+}
+
+// gf256LagrangeBasisAtZero is lagrangeBasisAtZero over GF(2^8).
+func gf256LagrangeBasisAtZero(xs []uint8) []byte {
+	return gf256LagrangeBasisAt(xs, 0)
+}
+
+// gf256LagrangeBasisAt is lagrangeBasisAt over GF(2^8), where subtraction
+// is XOR.
+func gf256LagrangeBasisAt(xs []uint8, x uint8) []byte {
+	basis := make([]byte, len(xs))
+	for i, xi := range xs {
+		num, den := byte(1), byte(1)
+		for j, xj := range xs {
+			if i == j {
+				continue
+			}
+			num = gf256Mul(num, xj^x)
+			den = gf256Mul(den, xj^xi)
+		}
+		basis[i] = gf256Mul(num, gf256Inv(den))
+	}
+	return basis
+}
+
+// combineGF256 reconstructs a secret from shares of SchemeV2GF256.
+func combineGF256(shares []Share) ([]byte, error) {
+	valueLen := len(shares[0].Value)
+	xs := make([]uint8, len(shares))
+	for i, s := range shares {
+		if len(s.Value) != valueLen {
+			return nil, errInconsistentLength(i)
+		}
+		xs[i] = s.Index
+	}
+	return dotGF256(shares, gf256LagrangeBasisAtZero(xs)), nil
+}
+
+// dotGF256 computes sum(basis[i] * shares[i].Value[b]) for every byte b.
+func dotGF256(shares []Share, basis []byte) []byte {
+	secret := make([]byte, len(shares[0].Value))
+	for i, s := range shares {
+		if basis[i] == 0 {
+			continue
+		}
+		logBasis := int(gf256Log[basis[i]])
+		for b, y := range s.Value {
+			if y != 0 {
+				secret[b] ^= gf256Exp[logBasis+int(gf256Log[y])]
+			}
+		}
+	}
+	return secret
+}
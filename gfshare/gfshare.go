@@ -0,0 +1,232 @@
+// Package gfshare reads and writes shares of libgfshare, the library behind
+// Debian's gfsplit and gfcombine tools, for migrating secrets split with
+// them.
+//
+// libgfshare shares bytewise in GF(2^8) with the reduction polynomial
+// x^8 + x^4 + x^3 + x^2 + 1 (0x11D), not the AES polynomial of the goshamir
+// package, so its shares cannot be combined there directly. gfsplit writes
+// each share to a file named after the secret's file with the share's
+// x-coordinate as a three-digit extension, such as "secret.txt.042", holding
+// the raw share bytes, one per secret byte.
+package gfshare
+
+import (
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+// ErrInvalidShare is returned for a share or share file name that is not
+// in libgfshare's format.
+var ErrInvalidShare = errors.New("invalid gfshare share")
+
+// gfExp and gfLog are the exponent and logarithm tables of GF(2^8) with
+// the polynomial 0x11D and generator 2, as in libgfshare. The exponent
+// table is doubled so products never need a modular reduction.
+var gfExp, gfLog = func() ([510]byte, [256]byte) {
+	var exp [510]byte
+	var log [256]byte
+	x := 1
+	for i := range 255 {
+		exp[i] = byte(x)
+		exp[i+255] = byte(x)
+		log[x] = byte(i)
+		x <<= 1
+		if x&0x100 != 0 {
+			x ^= 0x11D
+		}
+	}
+	return exp, log
+}()
+
+func gfMul(a, b byte) byte {
+	if a == 0 || b == 0 {
+		return 0
+	}
+	return gfExp[int(gfLog[a])+int(gfLog[b])]
+}
+
+func gfDiv(a, b byte) byte {
+	if a == 0 {
+		return 0
+	}
+	return gfExp[int(gfLog[a])+255-int(gfLog[b])]
+}
+
+// Share is a libgfshare share.
+type Share struct {
+	// Index is the share number, the x-coordinate, from 1 to 255.
+	Index uint8
+	// Value holds one byte per secret byte.
+	Value []byte
+}
+
+// Config configures Split.
+type Config struct {
+	// Shares is the number of shares to produce, at most 255.
+	Shares int
+	// Threshold is the number of shares needed to reconstruct the secret.
+	Threshold int
+	// Rand is the source of randomness for the share numbers and
+	// polynomial coefficients. Defaults to crypto/rand.Reader.
+	Rand io.Reader
+}
+
+// Split splits secret as gfsplit does, into cfg.Shares shares of which any
+// cfg.Threshold reconstruct it. Like gfsplit, it gives the shares distinct
+// random numbers rather than 1, 2, 3 and so on.
+func Split(secret []byte, cfg Config) ([]Share, error) {
+	if cfg.Threshold < 2 || cfg.Shares < cfg.Threshold || cfg.Shares > 255 {
+		return nil, fmt.Errorf("invalid threshold %d of %d shares", cfg.Threshold, cfg.Shares)
+	}
+	if len(secret) == 0 {
+		return nil, errors.New("secret cannot be empty")
+	}
+	r := cfg.Rand
+	if r == nil {
+		r = rand.Reader
+	}
+
+	indices, err := randomIndices(r, cfg.Shares)
+	if err != nil {
+		return nil, err
+	}
+	// coeffs[j] holds the coefficient of x^j for every secret byte.
+	coeffs := make([][]byte, cfg.Threshold)
+	coeffs[0] = secret
+	for j := 1; j < cfg.Threshold; j++ {
+		coeffs[j] = make([]byte, len(secret))
+		defer clear(coeffs[j])
+		if _, err := io.ReadFull(r, coeffs[j]); err != nil {
+			return nil, fmt.Errorf("random coefficient generation failed: %w", err)
+		}
+	}
+
+	shares := make([]Share, cfg.Shares)
+	for i, x := range indices {
+		value := make([]byte, len(secret))
+		for b := range value {
+			var y byte
+			for j := cfg.Threshold - 1; j >= 0; j-- {
+				y = gfMul(y, x) ^ coeffs[j][b]
+			}
+			value[b] = y
+		}
+		shares[i] = Share{Index: x, Value: value}
+	}
+	return shares, nil
+}
+
+// randomIndices draws n distinct non-zero share numbers.
+func randomIndices(r io.Reader, n int) ([]uint8, error) {
+	var used [256]bool
+	used[0] = true
+	indices := make([]uint8, 0, n)
+	var b [1]byte
+	for len(indices) < n {
+		if _, err := io.ReadFull(r, b[:]); err != nil {
+			return nil, fmt.Errorf("random share number generation failed: %w", err)
+		}
+		if !used[b[0]] {
+			used[b[0]] = true
+			indices = append(indices, b[0])
+		}
+	}
+	return indices, nil
+}
+
+// Combine reconstructs the secret from shares, as gfcombine does. All
+// shares given are used, so they must number at least the threshold the
+// secret was split with.
+func Combine(shares []Share) ([]byte, error) {
+	if len(shares) < 2 {
+		return nil, fmt.Errorf("need at least 2 shares, got %d", len(shares))
+	}
+	size := len(shares[0].Value)
+	var seen [256]bool
+	for _, s := range shares {
+		if s.Index == 0 || seen[s.Index] {
+			return nil, fmt.Errorf("%w: bad or duplicate share number %d", ErrInvalidShare, s.Index)
+		}
+		seen[s.Index] = true
+		if len(s.Value) != size {
+			return nil, fmt.Errorf("%w: shares have different lengths", ErrInvalidShare)
+		}
+	}
+
+	secret := make([]byte, size)
+	for i, si := range shares {
+		// Lagrange basis at 0; subtraction is XOR.
+		num, den := byte(1), byte(1)
+		for j, sj := range shares {
+			if i != j {
+				num = gfMul(num, sj.Index)
+				den = gfMul(den, si.Index^sj.Index)
+			}
+		}
+		basis := gfDiv(num, den)
+		for b, y := range si.Value {
+			secret[b] ^= gfMul(basis, y)
+		}
+	}
+	return secret, nil
+}
+
+// FileName returns the name gfsplit gives the share of the file stem.
+func FileName(stem string, index uint8) string {
+	return fmt.Sprintf("%s.%03d", stem, index)
+}
+
+// ParseFileName returns the share number in a share file name of gfsplit.
+func ParseFileName(name string) (uint8, error) {
+	ext := filepath.Ext(name)
+	n, err := strconv.ParseUint(strings.TrimPrefix(ext, "."), 10, 8)
+	if len(ext) != 4 || err != nil || n == 0 {
+		return 0, fmt.Errorf("%w: file name %q has no share number extension", ErrInvalidShare, name)
+	}
+	return uint8(n), nil
+}
+
+// WriteFiles writes each share to its own file named FileName(stem, index)
+// and returns the paths written. Existing files are never overwritten.
+func WriteFiles(stem string, shares []Share) ([]string, error) {
+	paths := make([]string, len(shares))
+	for i, s := range shares {
+		paths[i] = FileName(stem, s.Index)
+		f, err := os.OpenFile(paths[i], os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0o600)
+		if err != nil {
+			return paths[:i], err
+		}
+		_, err = f.Write(s.Value)
+		if cerr := f.Close(); err == nil {
+			err = cerr
+		}
+		if err != nil {
+			return paths[:i+1], err
+		}
+	}
+	return paths, nil
+}
+
+// ReadFiles reads shares from files written by gfsplit or WriteFiles,
+// taking each share number from the file name's extension.
+func ReadFiles(paths []string) ([]Share, error) {
+	shares := make([]Share, len(paths))
+	for i, p := range paths {
+		index, err := ParseFileName(p)
+		if err != nil {
+			return nil, err
+		}
+		value, err := os.ReadFile(p)
+		if err != nil {
+			return nil, err
+		}
+		shares[i] = Share{Index: index, Value: value}
+	}
+	return shares, nil
+}
@@ -0,0 +1,135 @@
+package gfshare
+
+import (
+	"bytes"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// --- Field Tests ---
+
+func TestField_Polynomial(t *testing.T) {
+	// x^8 reduces to x^4 + x^3 + x^2 + 1 under 0x11D.
+	if got := gfMul(0x80, 0x02); got != 0x1D {
+		t.Fatalf("0x80 · 2 = %#x, want 0x1d", got)
+	}
+	for a := 1; a < 256; a++ {
+		if got := gfMul(byte(a), gfDiv(1, byte(a))); got != 1 {
+			t.Fatalf("%#x · %#x⁻¹ = %#x", a, a, got)
+		}
+	}
+}
+
+// --- Split and Combine Tests ---
+
+func TestSplitCombine(t *testing.T) {
+	secret := []byte("legacy gfsplit secret")
+	shares, err := Split(secret, Config{Shares: 5, Threshold: 3})
+	if err != nil {
+		t.Fatalf("Split failed: %v", err)
+	}
+	seen := map[uint8]bool{}
+	for _, s := range shares {
+		if s.Index == 0 || seen[s.Index] || len(s.Value) != len(secret) {
+			t.Fatalf("bad share %d of %d bytes", s.Index, len(s.Value))
+		}
+		seen[s.Index] = true
+	}
+	for _, subset := range [][]Share{shares[:3], shares[2:], shares} {
+		got, err := Combine(subset)
+		if err != nil {
+			t.Fatalf("Combine failed: %v", err)
+		}
+		if !bytes.Equal(got, secret) {
+			t.Fatalf("Expected %q, got %q", secret, got)
+		}
+	}
+	if got, _ := Combine(shares[:2]); bytes.Equal(got, secret) {
+		t.Fatal("two shares should not reconstruct a 3-of-5 secret")
+	}
+}
+
+func TestSplit_KnownAnswer(t *testing.T) {
+	// No shares captured from gfsplit are available, so this vector is
+	// derived by hand from libgfshare's algorithm: for threshold 2 the share
+	// at x is s + a·x in GF(2^8) under 0x11D, here with s = 0x53 and
+	// a = 0x02 at x = 1 and x = 0x80. Random share number 0 is skipped.
+	random := bytes.NewReader([]byte{0x00, 0x01, 0x80, 0x02})
+	shares, err := Split([]byte{0x53}, Config{Shares: 2, Threshold: 2, Rand: random})
+	if err != nil {
+		t.Fatalf("Split failed: %v", err)
+	}
+	want := []Share{{Index: 0x01, Value: []byte{0x51}}, {Index: 0x80, Value: []byte{0x4e}}}
+	for i, s := range shares {
+		if s.Index != want[i].Index || !bytes.Equal(s.Value, want[i].Value) {
+			t.Fatalf("share %d: got %d-%x, want %d-%x", i, s.Index, s.Value, want[i].Index, want[i].Value)
+		}
+	}
+	got, err := Combine(want)
+	if err != nil {
+		t.Fatalf("Combine failed: %v", err)
+	}
+	if !bytes.Equal(got, []byte{0x53}) {
+		t.Fatalf("got %x, want 53", got)
+	}
+}
+
+func TestCombine_Invalid(t *testing.T) {
+	tests := []struct {
+		name   string
+		shares []Share
+	}{
+		{"too few", []Share{{Index: 1, Value: []byte{1}}}},
+		{"zero index", []Share{{Index: 0, Value: []byte{1}}, {Index: 2, Value: []byte{1}}}},
+		{"duplicate", []Share{{Index: 2, Value: []byte{1}}, {Index: 2, Value: []byte{1}}}},
+		{"lengths", []Share{{Index: 1, Value: []byte{1}}, {Index: 2, Value: []byte{1, 2}}}},
+	}
+	for _, tt := range tests {
+		if _, err := Combine(tt.shares); err == nil {
+			t.Errorf("%s: expected error", tt.name)
+		}
+	}
+	if _, err := Split([]byte("s"), Config{Shares: 256, Threshold: 2}); err == nil {
+		t.Error("expected error for 256 shares")
+	}
+}
+
+// --- Share File Tests ---
+
+func TestWriteReadFiles(t *testing.T) {
+	stem := filepath.Join(t.TempDir(), "key.bin")
+	secret := []byte{0x00, 0xff, 0x10, 0x42}
+	shares, _ := Split(secret, Config{Shares: 4, Threshold: 2})
+
+	paths, err := WriteFiles(stem, shares)
+	if err != nil {
+		t.Fatalf("WriteFiles failed: %v", err)
+	}
+	if want := FileName(stem, shares[0].Index); paths[0] != want || len(filepath.Ext(want)) != 4 {
+		t.Fatalf("got path %q, want %q", paths[0], want)
+	}
+	read, err := ReadFiles(paths[1:3])
+	if err != nil {
+		t.Fatalf("ReadFiles failed: %v", err)
+	}
+	got, err := Combine(read)
+	if err != nil || !bytes.Equal(got, secret) {
+		t.Fatalf("Combine of share files = %x, %v", got, err)
+	}
+	if _, err := WriteFiles(stem, shares[:1]); !errors.Is(err, os.ErrExist) {
+		t.Fatalf("expected existing file error, got %v", err)
+	}
+}
+
+func TestParseFileName(t *testing.T) {
+	if n, err := ParseFileName("dir/secret.txt.042"); err != nil || n != 42 {
+		t.Fatalf("ParseFileName = %d, %v", n, err)
+	}
+	for _, name := range []string{"secret.txt", "secret.000", "secret.256", "secret.42"} {
+		if _, err := ParseFileName(name); !errors.Is(err, ErrInvalidShare) {
+			t.Errorf("ParseFileName(%q): expected ErrInvalidShare, got %v", name, err)
+		}
+	}
+}
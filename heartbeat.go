@@ -0,0 +1,132 @@
+package goshamir
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// heartbeatLabel domain separates heartbeat responses.
+const heartbeatLabel = "goshamir heartbeat v1"
+
+// heartbeatNonceSize is the size in bytes of a heartbeat challenge nonce.
+const heartbeatNonceSize = 16
+
+var (
+	// ErrHeartbeatFailed is returned when a custodian's heartbeat response
+	// does not prove possession of their share.
+	ErrHeartbeatFailed = errors.New("heartbeat response does not match the share")
+	// ErrNoChallenges is returned when every challenge of a Heartbeat has
+	// been used and a new one must be issued from the share.
+	ErrNoChallenges = errors.New("no heartbeat challenges left")
+)
+
+// HeartbeatChallenge is sent to a custodian to prove they still hold the
+// share with the given index.
+type HeartbeatChallenge struct {
+	Index uint8  `json:"index"`
+	Nonce []byte `json:"nonce"`
+}
+
+// HeartbeatEntry is a single-use challenge and a hash of its expected
+// response.
+type HeartbeatEntry struct {
+	Nonce    []byte `json:"nonce"`
+	Expected []byte `json:"expected"`
+}
+
+// Heartbeat lets an operator periodically check that a custodian still
+// holds their share, so lost shares are noticed before an emergency. It is
+// created by the dealer while the share is at hand and holds precomputed
+// single-use challenges; the operator keeps it instead of the share. A
+// response is an HMAC of the challenge keyed by the share value, so it
+// reveals nothing about the share, and the Heartbeat stores only its
+// SHA-256, so it cannot be used to answer challenges.
+//
+// A Heartbeat is JSON-encodable for storage. It is not safe for concurrent
+// use.
+type Heartbeat struct {
+	Index   uint8            `json:"index"`
+	Entries []HeartbeatEntry `json:"entries"`
+	// LastVerified is when the custodian last answered a challenge
+	// correctly.
+	LastVerified time.Time `json:"last_verified,omitzero"`
+}
+
+// NewHeartbeat precomputes count challenges for share.
+func NewHeartbeat(share Share, count int) (*Heartbeat, error) {
+	if share.Index == 0 || len(share.Value) == 0 {
+		return nil, errors.New("heartbeat needs a share")
+	}
+	if count < 1 {
+		return nil, errors.New("count must be at least 1")
+	}
+	h := &Heartbeat{Index: share.Index, Entries: make([]HeartbeatEntry, count)}
+	for i := range h.Entries {
+		nonce := make([]byte, heartbeatNonceSize)
+		if _, err := rand.Read(nonce); err != nil {
+			return nil, err
+		}
+		expected := sha256.Sum256(heartbeatResponse(share, nonce))
+		h.Entries[i] = HeartbeatEntry{Nonce: nonce, Expected: expected[:]}
+	}
+	return h, nil
+}
+
+// Challenge returns the next unused challenge. It stays unused until a
+// response to it is verified.
+func (h *Heartbeat) Challenge() (HeartbeatChallenge, error) {
+	if len(h.Entries) == 0 {
+		return HeartbeatChallenge{}, ErrNoChallenges
+	}
+	return HeartbeatChallenge{Index: h.Index, Nonce: h.Entries[0].Nonce}, nil
+}
+
+// Remaining reports how many challenges are left.
+func (h *Heartbeat) Remaining() int {
+	return len(h.Entries)
+}
+
+// Verify checks a custodian's response to c and, right or wrong, uses up
+// the challenge. On success it records the time in LastVerified.
+func (h *Heartbeat) Verify(c HeartbeatChallenge, response []byte) error {
+	if c.Index != h.Index {
+		return fmt.Errorf("challenge is for share %d, not %d", c.Index, h.Index)
+	}
+	for i, e := range h.Entries {
+		if !hmac.Equal(e.Nonce, c.Nonce) {
+			continue
+		}
+		h.Entries = append(h.Entries[:i:i], h.Entries[i+1:]...)
+		got := sha256.Sum256(response)
+		if subtle.ConstantTimeCompare(got[:], e.Expected) != 1 {
+			return ErrHeartbeatFailed
+		}
+		h.LastVerified = time.Now().UTC()
+		return nil
+	}
+	return errors.New("unknown or already used heartbeat challenge")
+}
+
+// RespondHeartbeat answers a heartbeat challenge on the custodian's side.
+func RespondHeartbeat(share Share, c HeartbeatChallenge) ([]byte, error) {
+	if c.Index != share.Index {
+		return nil, fmt.Errorf("challenge is for share %d, not %d", c.Index, share.Index)
+	}
+	if len(c.Nonce) != heartbeatNonceSize {
+		return nil, errors.New("invalid heartbeat challenge")
+	}
+	return heartbeatResponse(share, c.Nonce), nil
+}
+
+func heartbeatResponse(share Share, nonce []byte) []byte {
+	mac := hmac.New(sha256.New, share.Value)
+	mac.Write([]byte(heartbeatLabel))
+	mac.Write([]byte{share.Index, byte(schemeOf(share))})
+	mac.Write(nonce)
+	return mac.Sum(nil)
+}
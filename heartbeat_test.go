@@ -0,0 +1,70 @@
+package goshamir
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+)
+
+// --- Heartbeat Tests ---
+
+func TestHeartbeat_RoundTrip(t *testing.T) {
+	shares, _ := Split([]byte("heartbeat"), 3, 2)
+	hb, err := NewHeartbeat(shares[1], 3)
+	if err != nil {
+		t.Fatalf("NewHeartbeat failed: %v", err)
+	}
+
+	// The operator stores the heartbeat between checks.
+	data, _ := json.Marshal(hb)
+	var stored Heartbeat
+	if err := json.Unmarshal(data, &stored); err != nil {
+		t.Fatalf("Unmarshal failed: %v", err)
+	}
+
+	for range 3 {
+		c, err := stored.Challenge()
+		if err != nil {
+			t.Fatalf("Challenge failed: %v", err)
+		}
+		resp, err := RespondHeartbeat(shares[1], c)
+		if err != nil {
+			t.Fatalf("RespondHeartbeat failed: %v", err)
+		}
+		if err := stored.Verify(c, resp); err != nil {
+			t.Fatalf("Verify failed: %v", err)
+		}
+	}
+	if stored.LastVerified.IsZero() || stored.Remaining() != 0 {
+		t.Fatalf("unexpected heartbeat state %+v", stored)
+	}
+	if _, err := stored.Challenge(); !errors.Is(err, ErrNoChallenges) {
+		t.Fatalf("expected ErrNoChallenges, got %v", err)
+	}
+}
+
+func TestHeartbeat_WrongShare(t *testing.T) {
+	shares, _ := Split([]byte("heartbeat"), 3, 2)
+	hb, _ := NewHeartbeat(shares[0], 2)
+	c, _ := hb.Challenge()
+
+	// A custodian who lost the share guesses with another one.
+	forged := shares[1]
+	forged.Index = shares[0].Index
+	resp, _ := RespondHeartbeat(forged, c)
+	if err := hb.Verify(c, resp); !errors.Is(err, ErrHeartbeatFailed) {
+		t.Fatalf("expected ErrHeartbeatFailed, got %v", err)
+	}
+	if hb.Remaining() != 1 || !hb.LastVerified.IsZero() {
+		t.Fatalf("failed challenge was not used up: %+v", hb)
+	}
+
+	// Replaying a used challenge is rejected.
+	resp, _ = RespondHeartbeat(shares[0], c)
+	if err := hb.Verify(c, resp); err == nil {
+		t.Fatal("expected used challenge to be rejected")
+	}
+	if _, err := RespondHeartbeat(shares[2], c); err == nil {
+		t.Fatal("expected challenge for another index to be refused")
+	}
+}
@@ -0,0 +1,127 @@
+package goshamir
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"strings"
+)
+
+// ErrHexLineChecksum is returned when a line of a grouped hex share fails
+// its line checksum, indicating a transcription error on that line.
+var ErrHexLineChecksum = errors.New("hex share line checksum mismatch")
+
+// HexGrouping describes how EncodeShareHexGrouped lays out the hex value
+// of a share for manual transcription: GroupSize hex characters per block,
+// GroupsPerLine blocks per line and, with LineChecksum, a short checksum at
+// the end of each line.
+type HexGrouping struct {
+	GroupSize     int
+	GroupsPerLine int
+	LineChecksum  bool
+}
+
+// DefaultHexGrouping writes blocks of four hex characters, eight blocks
+// per line, each line followed by its checksum.
+var DefaultHexGrouping = HexGrouping{GroupSize: 4, GroupsPerLine: 8, LineChecksum: true}
+
+// EncodeShareHexGrouped encodes a share in the hex format of
+// EncodeSharesToHex, laid out for reading aloud and copying by hand. The
+// first line holds everything before the value, such as "v2:3:", and the
+// value follows in lines of space-separated blocks:
+//
+//	v2:3:
+//	a1b2 c3d4 e5f6 0718 293a 4b5c 6d7e 8f90 | 5c1e
+//	0a1b 2c3d | 9f02
+//
+// A line checksum covers the line's bytes and its position, so a mistyped
+// character or a skipped line is reported with its line number. A
+// watermark goes on a last line of its own, as "#hexwatermark".
+// DecodeSharesFromHex accepts the grouped text, with or without line
+// checksums, in place of the single-line format.
+func EncodeShareHexGrouped(s Share, g HexGrouping) (string, error) {
+	if g.GroupSize < 1 || g.GroupsPerLine < 1 {
+		return "", fmt.Errorf("hex grouping needs positive group size and groups per line, got %d and %d", g.GroupSize, g.GroupsPerLine)
+	}
+	if len(s.Value) == 0 {
+		return "", errors.New("share value must not be empty")
+	}
+	var b strings.Builder
+	b.WriteString(encodeShareToHex(Share{Index: s.Index, Scheme: s.Scheme, Parents: s.Parents}))
+	digits := hex.EncodeToString(s.Value)
+	perLine := g.GroupSize * g.GroupsPerLine
+	// Lines hold whole bytes so that their checksums cover whole bytes;
+	// with an odd group size the last block of a line may be short.
+	perLine -= perLine % 2
+	if perLine == 0 {
+		perLine = 2
+	}
+	for line := 1; len(digits) > 0; line++ {
+		chunk := digits[:min(perLine, len(digits))]
+		digits = digits[len(chunk):]
+		b.WriteByte('\n')
+		for i := 0; i < len(chunk); i += g.GroupSize {
+			if i > 0 {
+				b.WriteByte(' ')
+			}
+			b.WriteString(chunk[i:min(i+g.GroupSize, len(chunk))])
+		}
+		if g.LineChecksum {
+			data, _ := hex.DecodeString(chunk)
+			fmt.Fprintf(&b, " | %04x", hexLineChecksum(line, data))
+		}
+	}
+	if len(s.Watermark) > 0 {
+		b.WriteString("\n#" + hex.EncodeToString(s.Watermark))
+	}
+	return b.String(), nil
+}
+
+// hexLineChecksum returns the checksum of one value line: the low 16 bits
+// of the CRC-32C of the line number and the line's bytes.
+func hexLineChecksum(line int, data []byte) uint16 {
+	h := crc32.Update(0, crc32c, []byte{byte(line >> 8), byte(line)})
+	return uint16(crc32.Update(h, crc32c, data))
+}
+
+// decodeGroupedHex decodes the layout of EncodeShareHexGrouped by checking
+// each line's checksum and joining the lines into the single-line format.
+func decodeGroupedHex(encoded string) (Share, error) {
+	lines := strings.Split(strings.ReplaceAll(encoded, "\r", ""), "\n")
+	var flat strings.Builder
+	dataLine, watermarked := 0, false
+	for i, line := range lines {
+		line = strings.TrimSpace(line)
+		switch {
+		case line == "":
+			continue
+		case flat.Len() == 0:
+			if !strings.HasSuffix(line, ":") {
+				return Share{}, ErrInvalidEncodedShare
+			}
+			flat.WriteString(line)
+			continue
+		case watermarked:
+			return Share{}, fmt.Errorf("%w: line %d follows the watermark", ErrInvalidEncodedShare, i+1)
+		case strings.HasPrefix(line, "#"):
+			flat.WriteString(line)
+			watermarked = true
+			continue
+		}
+		dataLine++
+		body, check, hasCheck := strings.Cut(line, "|")
+		digits := strings.Join(strings.Fields(body), "")
+		if hasCheck {
+			data, err := hex.DecodeString(digits)
+			if err != nil {
+				return Share{}, fmt.Errorf("%w on line %d", ErrInvalidEncodedShare, i+1)
+			}
+			if want := strings.ToLower(strings.TrimSpace(check)); want != fmt.Sprintf("%04x", hexLineChecksum(dataLine, data)) {
+				return Share{}, fmt.Errorf("%w on line %d", ErrHexLineChecksum, i+1)
+			}
+		}
+		flat.WriteString(digits)
+	}
+	return decodeShareFromHex(flat.String())
+}
@@ -0,0 +1,100 @@
+package goshamir
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+)
+
+// --- Grouped Hex Tests ---
+
+func TestEncodeShareHexGrouped_RoundTrip(t *testing.T) {
+	s, _ := NewSplitter(5, 3, WithScheme(SchemeV2GF256))
+	shares, err := s.Split([]byte("a secret long enough to span a few lines"))
+	if err != nil {
+		t.Fatalf("Split failed: %v", err)
+	}
+	share := shares[2]
+	share.Watermark = []byte{0xbe, 0xef}
+
+	groupings := []HexGrouping{
+		DefaultHexGrouping,
+		{GroupSize: 5, GroupsPerLine: 3, LineChecksum: true},
+		{GroupSize: 1, GroupsPerLine: 1},
+	}
+	for _, g := range groupings {
+		text, err := EncodeShareHexGrouped(share, g)
+		if err != nil {
+			t.Fatalf("EncodeShareHexGrouped failed: %v", err)
+		}
+		lines := strings.Split(text, "\n")
+		if lines[0] != "v2:3:" || lines[len(lines)-1] != "#beef" {
+			t.Fatalf("unexpected layout:\n%s", text)
+		}
+		decoded, err := DecodeSharesFromHex([]string{text})
+		if err != nil {
+			t.Fatalf("DecodeSharesFromHex failed: %v\n%s", err, text)
+		}
+		got := decoded[0]
+		if got.Index != share.Index || got.Scheme != share.Scheme || !bytes.Equal(got.Value, share.Value) || !bytes.Equal(got.Watermark, share.Watermark) {
+			t.Fatalf("round trip mismatch with %+v", g)
+		}
+	}
+
+	text, _ := EncodeShareHexGrouped(share, DefaultHexGrouping)
+	first := strings.Split(text, "\n")[1]
+	if fields := strings.Fields(first); len(fields) != 10 || fields[8] != "|" || len(fields[0]) != 4 {
+		t.Fatalf("unexpected first line %q", first)
+	}
+}
+
+func TestDecodeGroupedHex_LineChecksum(t *testing.T) {
+	shares, _ := Split(bytes.Repeat([]byte("x"), 40), 3, 2)
+	text, err := EncodeShareHexGrouped(shares[0], DefaultHexGrouping)
+	if err != nil {
+		t.Fatalf("EncodeShareHexGrouped failed: %v", err)
+	}
+	lines := strings.Split(text, "\n")
+
+	typo := append([]string(nil), lines...)
+	digit := "0"
+	if typo[2][0] == '0' {
+		digit = "1"
+	}
+	typo[2] = digit + typo[2][1:]
+	_, err = DecodeSharesFromHex([]string{strings.Join(typo, "\n")})
+	if !errors.Is(err, ErrHexLineChecksum) || !strings.Contains(err.Error(), "line 3") {
+		t.Fatalf("expected checksum error on line 3, got %v", err)
+	}
+
+	skipped := append([]string{lines[0]}, lines[2:]...)
+	if _, err := DecodeSharesFromHex([]string{strings.Join(skipped, "\n")}); !errors.Is(err, ErrHexLineChecksum) {
+		t.Fatalf("expected checksum error for a skipped line, got %v", err)
+	}
+
+	// Without checksums, and with stray blank lines and CRLF endings, the
+	// layout still decodes.
+	plain, _ := EncodeShareHexGrouped(shares[0], HexGrouping{GroupSize: 4, GroupsPerLine: 8})
+	plain = strings.ReplaceAll(plain, "\n", "\r\n\r\n")
+	decoded, err := DecodeSharesFromHex([]string{plain})
+	if err != nil {
+		t.Fatalf("DecodeSharesFromHex failed: %v", err)
+	}
+	if !bytes.Equal(decoded[0].Value, shares[0].Value) {
+		t.Fatal("value mismatch")
+	}
+}
+
+func TestEncodeShareHexGrouped_Invalid(t *testing.T) {
+	shares, _ := Split([]byte("k"), 3, 2)
+	if _, err := EncodeShareHexGrouped(shares[0], HexGrouping{GroupSize: 0, GroupsPerLine: 8}); err == nil {
+		t.Error("expected error for zero group size")
+	}
+	if _, err := EncodeShareHexGrouped(Share{Index: 1}, DefaultHexGrouping); err == nil {
+		t.Error("expected error for empty value")
+	}
+	if _, err := DecodeSharesFromHex([]string{"1:\nabcd\n#00\nabcd"}); err == nil {
+		t.Error("expected error for a line after the watermark")
+	}
+}
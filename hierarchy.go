@@ -0,0 +1,153 @@
+package goshamir
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/hkdf"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"io"
+)
+
+// MasterKeySize is the size in bytes of a MasterShare key.
+const MasterKeySize = 32
+
+// MasterShare is a custodian's long-lived key from which it derives its
+// share of every secret split with DeriveSplit, so it keeps a single
+// artifact however many secrets it helps protect. It must be protected like
+// a share.
+type MasterShare struct {
+	Index uint8
+	Key   []byte
+}
+
+// DerivationRecord is the public data needed to derive shares of one
+// secret from master shares. It holds, for every custodian, the offset
+// between its actual share and the mask derived from its master share.
+// Each offset is masked by a pseudorandom value only that custodian can
+// compute, so the record reveals nothing about the secret and can be
+// stored without protection.
+type DerivationRecord struct {
+	Label     string
+	Threshold int
+	Scheme    Scheme
+	Offsets   []Share
+}
+
+// NewMasterShares generates one master share per custodian, at indices
+// 1..totalShares, from the configured random source.
+func NewMasterShares(totalShares int, opts ...Option) ([]MasterShare, error) {
+	if totalShares < MinThreshold || totalShares > MaxShares {
+		return nil, fmt.Errorf("totalShares must be in [%d, %d]", MinThreshold, MaxShares)
+	}
+	cfg := NewConfig(opts...)
+	masters := make([]MasterShare, totalShares)
+	for i := range masters {
+		key := make([]byte, MasterKeySize)
+		if _, err := io.ReadFull(cfg.Rand, key); err != nil {
+			return nil, fmt.Errorf("master key generation failed: %w", err)
+		}
+		masters[i] = MasterShare{Index: uint8(i + 1), Key: key}
+	}
+	return masters, nil
+}
+
+// DeriveSplit splits secret among the holders of masters and returns the
+// public record from which each derives its share with DeriveShare. The
+// label must be unique per secret: it domain-separates the masks, and
+// reusing one for two secrets reveals their difference.
+func DeriveSplit(secret []byte, label string, masters []MasterShare, threshold int, opts ...Option) (*DerivationRecord, error) {
+	if label == "" {
+		return nil, errors.New("label cannot be empty")
+	}
+	splitter, err := NewSplitter(len(masters), threshold, opts...)
+	if err != nil {
+		return nil, err
+	}
+	for i, m := range masters {
+		if m.Index != uint8(i+1) {
+			return nil, fmt.Errorf("master share %d has index %d, expected %d", i, m.Index, i+1)
+		}
+	}
+	shares, err := splitter.Split(secret)
+	if err != nil {
+		return nil, err
+	}
+	defer splitter.Release(shares)
+
+	scheme := splitter.Scheme()
+	rec := &DerivationRecord{Label: label, Threshold: threshold, Scheme: scheme, Offsets: make([]Share, len(shares))}
+	for i, s := range shares {
+		mask, err := masters[i].mask(label, threshold, scheme, len(secret))
+		if err != nil {
+			return nil, err
+		}
+		offset, err := SubShares(s, mask)
+		clear(mask.Value)
+		if err != nil {
+			return nil, err
+		}
+		rec.Offsets[i] = offset
+	}
+	return rec, nil
+}
+
+// DeriveShare derives the holder's share of the secret described by rec.
+// The result combines with shares derived by other custodians as usual.
+func (m MasterShare) DeriveShare(rec *DerivationRecord) (Share, error) {
+	if rec == nil {
+		return Share{}, errors.New("derivation record cannot be nil")
+	}
+	var offset *Share
+	for i := range rec.Offsets {
+		if rec.Offsets[i].Index == m.Index {
+			offset = &rec.Offsets[i]
+			break
+		}
+	}
+	if offset == nil {
+		return Share{}, fmt.Errorf("record has no offset for index %d", m.Index)
+	}
+	scheme := schemeOf(*offset)
+	if scheme != rec.Scheme {
+		return Share{}, errors.New("record offsets do not match its scheme")
+	}
+	mask, err := m.mask(rec.Label, rec.Threshold, scheme, len(offset.Value)/scheme.bytesPerElement())
+	if err != nil {
+		return Share{}, err
+	}
+	defer clear(mask.Value)
+	return AddShares(mask, *offset)
+}
+
+// mask derives the holder's pseudorandom mask for one secret. HKDF binds
+// the master key to the label, threshold and scheme, and the resulting key
+// drives an AES-256-CTR stream of uniform field elements.
+func (m MasterShare) mask(label string, threshold int, scheme Scheme, size int) (Share, error) {
+	if m.Index == 0 || len(m.Key) != MasterKeySize {
+		return Share{}, errors.New("invalid master share")
+	}
+	if !scheme.Supported() {
+		return Share{}, unsupportedScheme(scheme)
+	}
+	info := fmt.Sprintf("goshamir derived share\x00%s\x00%d\x00%d\x00%d", label, threshold, scheme, m.Index)
+	key, err := hkdf.Key(sha256.New, m.Key, nil, info, 32)
+	if err != nil {
+		return Share{}, errors.New("mask derivation failed")
+	}
+	defer clear(key)
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return Share{}, err
+	}
+	var iv [aes.BlockSize]byte
+	stream := cipher.StreamReader{S: cipher.NewCTR(block, iv[:]), R: zeroReader{}}
+
+	elems := make([]uint16, size)
+	defer clear(elems)
+	if err := randomFieldElements(stream, scheme, elems); err != nil {
+		return Share{}, err
+	}
+	return Share{Index: m.Index, Value: elementsValue(elems, scheme), Scheme: scheme}, nil
+}
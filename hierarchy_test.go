@@ -0,0 +1,76 @@
+package goshamir
+
+import (
+	"bytes"
+	"testing"
+)
+
+// --- Hierarchical Derivation Tests ---
+
+func TestDeriveSplit_ManySecretsOneMasterShare(t *testing.T) {
+	masters, err := NewMasterShares(4)
+	if err != nil {
+		t.Fatalf("NewMasterShares failed: %v", err)
+	}
+
+	secrets := map[string][]byte{
+		"db/primary":   []byte("postgres master password"),
+		"signing/2026": bytes.Repeat([]byte{0xFF}, 40),
+	}
+	for _, scheme := range []Scheme{SchemeV1GF257, SchemeV2GF256} {
+		for label, secret := range secrets {
+			rec, err := DeriveSplit(secret, label, masters, 3, WithScheme(scheme))
+			if err != nil {
+				t.Fatalf("DeriveSplit failed: %v", err)
+			}
+
+			var shares []Share
+			for _, i := range []int{3, 0, 2} {
+				s, err := masters[i].DeriveShare(rec)
+				if err != nil {
+					t.Fatalf("DeriveShare failed: %v", err)
+				}
+				shares = append(shares, s)
+			}
+			recovered, err := Combine(shares, 3)
+			if err != nil {
+				t.Fatalf("Combine failed: %v", err)
+			}
+			if !bytes.Equal(secret, recovered) {
+				t.Errorf("%s %s: recovered %q", scheme, label, recovered)
+			}
+
+			// Derivation is deterministic.
+			again, _ := masters[3].DeriveShare(rec)
+			if !bytes.Equal(again.Value, shares[0].Value) {
+				t.Error("Expected DeriveShare to be deterministic")
+			}
+		}
+	}
+}
+
+func TestDeriveSplit_RecordIsBoundToLabel(t *testing.T) {
+	masters, _ := NewMasterShares(3)
+	secret := []byte("label bound")
+	rec, err := DeriveSplit(secret, "a", masters, 2)
+	if err != nil {
+		t.Fatalf("DeriveSplit failed: %v", err)
+	}
+
+	rec.Label = "b"
+	s1, _ := masters[0].DeriveShare(rec)
+	s2, _ := masters[1].DeriveShare(rec)
+	if recovered, err := Combine([]Share{s1, s2}, 2); err == nil && bytes.Equal(recovered, secret) {
+		t.Error("Expected a different label to derive unrelated shares")
+	}
+
+	if _, err := DeriveSplit(secret, "", masters, 2); err == nil {
+		t.Error("Expected error for empty label")
+	}
+	if _, err := (MasterShare{Index: 9, Key: make([]byte, MasterKeySize)}).DeriveShare(rec); err == nil {
+		t.Error("Expected error for unknown index")
+	}
+	if _, err := DeriveSplit(secret, "a", []MasterShare{masters[1], masters[0]}, 2); err == nil {
+		t.Error("Expected error for out-of-order master shares")
+	}
+}
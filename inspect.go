@@ -0,0 +1,76 @@
+package goshamir
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+)
+
+// ShareInfo describes what can be learned about a share on its own, without
+// a quorum.
+type ShareInfo struct {
+	Scheme Scheme
+	Index  uint8
+	// Length is the size of the share value in bytes.
+	Length int
+	// SecretLength is the size of the secret the share belongs to, or -1
+	// if it cannot be determined.
+	SecretLength int
+	// Fingerprint is a short hash identifying the share, suitable for
+	// comparing shares without revealing their values.
+	Fingerprint string
+	// Problems lists detectable corruption. An empty list does not prove
+	// the share is intact.
+	Problems []string
+}
+
+// InspectShare examines a single share for triage.
+func InspectShare(s Share) ShareInfo {
+	scheme := schemeOf(s)
+	info := ShareInfo{
+		Scheme:       scheme,
+		Index:        s.Index,
+		Length:       len(s.Value),
+		SecretLength: -1,
+		Fingerprint:  shareFingerprint(s),
+	}
+
+	if s.Index == 0 {
+		info.Problems = append(info.Problems, "share index is zero")
+	}
+	if len(s.Value) == 0 {
+		info.Problems = append(info.Problems, "share value is empty")
+	}
+	switch scheme {
+	case SchemeV1GF257:
+		if len(s.Value)%2 != 0 {
+			info.Problems = append(info.Problems, "value length is odd")
+			break
+		}
+		info.SecretLength = len(s.Value) / 2
+		bad := 0
+		for i := range info.SecretLength {
+			if y, _ := decodeFieldElement(s.Value, i); y >= FieldPrime {
+				bad++
+			}
+		}
+		if bad > 0 {
+			info.Problems = append(info.Problems, fmt.Sprintf("%d values out of field range [0, %d]", bad, FieldPrime-1))
+		}
+	case SchemeV2GF256:
+		// Every byte string is a valid GF(2^8) share.
+		info.SecretLength = len(s.Value)
+	default:
+		info.Problems = append(info.Problems, unsupportedScheme(scheme).Error())
+	}
+	return info
+}
+
+// shareFingerprint returns the first 8 bytes of SHA-256 over the scheme,
+// index and value, hex encoded.
+func shareFingerprint(s Share) string {
+	h := sha256.New()
+	h.Write([]byte{byte(schemeOf(s)), s.Index})
+	h.Write(s.Value)
+	return hex.EncodeToString(h.Sum(nil)[:8])
+}
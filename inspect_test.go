@@ -0,0 +1,48 @@
+package goshamir
+
+import (
+	"strings"
+	"testing"
+)
+
+// --- InspectShare Tests ---
+
+func TestInspectShare(t *testing.T) {
+	shares, _ := Split([]byte("secret"), 3, 2)
+	info := InspectShare(shares[1])
+	if info.Scheme != SchemeV1GF257 || info.Index != 2 || info.Length != 12 || info.SecretLength != 6 {
+		t.Errorf("Unexpected info: %+v", info)
+	}
+	if len(info.Problems) != 0 {
+		t.Errorf("Expected no problems, got %q", info.Problems)
+	}
+	if len(info.Fingerprint) != 16 || info.Fingerprint == InspectShare(shares[0]).Fingerprint {
+		t.Errorf("Unexpected fingerprint %q", info.Fingerprint)
+	}
+
+	splitter, _ := NewSplitter(3, 2, WithScheme(SchemeV2GF256))
+	compact, _ := splitter.Split([]byte("secret"))
+	if info := InspectShare(compact[0]); info.SecretLength != 6 || len(info.Problems) != 0 {
+		t.Errorf("Unexpected compact info: %+v", info)
+	}
+}
+
+func TestInspectShare_Corruption(t *testing.T) {
+	tests := []struct {
+		name  string
+		share Share
+		want  string
+	}{
+		{"odd length", Share{Index: 1, Value: []byte{1, 0, 2}}, "odd"},
+		{"out of range", Share{Index: 1, Value: []byte{0xFF, 0xFF, 1, 0}}, "1 values out of field range"},
+		{"zero index", Share{Value: []byte{1, 0}}, "index is zero"},
+		{"empty", Share{Index: 1, Value: []byte{}}, "empty"},
+		{"unknown scheme", Share{Index: 1, Value: []byte{1}, Scheme: 7}, "unsupported scheme v7"},
+	}
+	for _, tt := range tests {
+		info := InspectShare(tt.share)
+		if len(info.Problems) == 0 || !strings.Contains(strings.Join(info.Problems, "; "), tt.want) {
+			t.Errorf("%s: expected problem %q, got %q", tt.name, tt.want, info.Problems)
+		}
+	}
+}
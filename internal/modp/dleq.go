@@ -0,0 +1,71 @@
+package modp
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+	"io"
+	"math/big"
+)
+
+// ErrInvalidProof is returned when a discrete logarithm equality proof
+// does not verify.
+var ErrInvalidProof = errors.New("invalid discrete logarithm equality proof")
+
+// DLEQProof is a non-interactive Chaum-Pedersen proof that log_g1(h1) equals
+// log_g2(h2), made non-interactive with the Fiat-Shamir transform.
+type DLEQProof struct {
+	C *big.Int
+	R *big.Int
+}
+
+// ProveDLEQ proves knowledge of x with h1 = g1^x and h2 = g2^x. The context
+// is bound into the challenge so a proof cannot be replayed elsewhere. A
+// nil reader means crypto/rand.Reader.
+func ProveDLEQ(x, g1, h1, g2, h2 *big.Int, context []byte, r io.Reader) (DLEQProof, error) {
+	w, err := RandomScalar(r)
+	if err != nil {
+		return DLEQProof{}, err
+	}
+	a1, a2 := Exp(g1, w), Exp(g2, w)
+	c := dleqChallenge(context, g1, h1, g2, h2, a1, a2)
+
+	// r = w - c·x mod Q
+	resp := new(big.Int).Mul(c, x)
+	resp.Sub(w, resp)
+	resp.Mod(resp, Q)
+	w.SetInt64(0)
+	return DLEQProof{C: c, R: resp}, nil
+}
+
+// VerifyDLEQ checks a proof produced by ProveDLEQ for the same context.
+func VerifyDLEQ(p DLEQProof, g1, h1, g2, h2 *big.Int, context []byte) error {
+	if p.C == nil || p.R == nil || p.C.Sign() < 0 || p.C.Cmp(Q) >= 0 || p.R.Sign() < 0 || p.R.Cmp(Q) >= 0 {
+		return ErrInvalidProof
+	}
+	for _, e := range []*big.Int{g1, h1, g2, h2} {
+		if !IsElement(e) {
+			return ErrInvalidProof
+		}
+	}
+	// a = g^r·h^c, which equals g^w for an honest proof.
+	a1 := Mul(Exp(g1, p.R), Exp(h1, p.C))
+	a2 := Mul(Exp(g2, p.R), Exp(h2, p.C))
+	if dleqChallenge(context, g1, h1, g2, h2, a1, a2).Cmp(p.C) != 0 {
+		return ErrInvalidProof
+	}
+	return nil
+}
+
+// dleqChallenge hashes the statement and commitments into a scalar.
+func dleqChallenge(context []byte, elems ...*big.Int) *big.Int {
+	h := sha256.New()
+	h.Write([]byte("goshamir dleq"))
+	h.Write(binary.BigEndian.AppendUint64(nil, uint64(len(context))))
+	h.Write(context)
+	for _, e := range elems {
+		h.Write(Encode(e))
+	}
+	c := new(big.Int).SetBytes(h.Sum(nil))
+	return c.Mod(c, Q)
+}
@@ -0,0 +1,167 @@
+// Package modp provides the 2048-bit MODP group of RFC 3526 as a prime-order
+// group, and Shamir secret sharing of its scalars.
+//
+// The modulus P is a safe prime, P = 2Q + 1, and the generator G = 2
+// generates the subgroup of quadratic residues, which has prime order Q.
+package modp
+
+import (
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+)
+
+// pHex is the 2048-bit MODP prime from RFC 3526, section 3.
+const pHex = "FFFFFFFFFFFFFFFFC90FDAA22168C234C4C6628B80DC1CD1" +
+	"29024E088A67CC74020BBEA63B139B22514A08798E3404DD" +
+	"EF9519B3CD3A431B302B0A6DF25F14374FE1356D6D51C245" +
+	"E485B576625E7EC6F44C42E9A637ED6B0BFF5CB6F406B7ED" +
+	"EE386BFB5A899FA5AE9F24117C4B1FE649286651ECE45B3D" +
+	"C2007CB8A163BF0598DA48361C55D39A69163FA8FD24CF5F" +
+	"83655D23DCA3AD961C62F356208552BB9ED529077096966D" +
+	"670C354E4ABC9804F1746C08CA18217C32905E462E36CE3B" +
+	"E39E772C180E86039B2783A2EC07A28FB5C55DF06F4C52C9" +
+	"DE2BCBF6955817183995497CEA956AE515D2261898FA0510" +
+	"15728E5A8AACAA68FFFFFFFFFFFFFFFF"
+
+var (
+	// P is the group modulus.
+	P = mustHex(pHex)
+	// Q is the order of the group generated by G.
+	Q = new(big.Int).Rsh(P, 1)
+	// G is the group generator.
+	G = big.NewInt(2)
+)
+
+// ElementSize is the size in bytes of an encoded group element.
+const ElementSize = 256
+
+var one = big.NewInt(1)
+
+func mustHex(s string) *big.Int {
+	n, ok := new(big.Int).SetString(s, 16)
+	if !ok {
+		panic("modp: invalid constant")
+	}
+	return n
+}
+
+// Exp returns base^e mod P.
+func Exp(base, e *big.Int) *big.Int {
+	return new(big.Int).Exp(base, e, P)
+}
+
+// Mul returns a*b mod P.
+func Mul(a, b *big.Int) *big.Int {
+	z := new(big.Int).Mul(a, b)
+	return z.Mod(z, P)
+}
+
+// IsElement reports whether x is an element of the order-Q subgroup other
+// than the identity.
+func IsElement(x *big.Int) bool {
+	if x == nil || x.Cmp(one) <= 0 || x.Cmp(P) >= 0 {
+		return false
+	}
+	return Exp(x, Q).Cmp(one) == 0
+}
+
+// Encode returns the fixed-size big-endian encoding of a group element.
+func Encode(x *big.Int) []byte {
+	return x.FillBytes(make([]byte, ElementSize))
+}
+
+// Decode parses and validates an encoded group element.
+func Decode(b []byte) (*big.Int, error) {
+	if len(b) != ElementSize {
+		return nil, fmt.Errorf("group element must be %d bytes", ElementSize)
+	}
+	x := new(big.Int).SetBytes(b)
+	if !IsElement(x) {
+		return nil, errors.New("invalid group element")
+	}
+	return x, nil
+}
+
+// RandomScalar returns a uniformly random scalar in [1, Q). A nil reader
+// means crypto/rand.Reader.
+func RandomScalar(r io.Reader) (*big.Int, error) {
+	if r == nil {
+		r = rand.Reader
+	}
+	max := new(big.Int).Sub(Q, one)
+	k, err := rand.Int(r, max)
+	if err != nil {
+		return nil, fmt.Errorf("random scalar generation failed: %w", err)
+	}
+	return k.Add(k, one), nil
+}
+
+// ScalarShare is a Shamir share of a scalar: the value at X of a polynomial
+// over the integers modulo Q.
+type ScalarShare struct {
+	X uint8
+	Y *big.Int
+}
+
+// SplitScalar splits secret into n shares at x = 1..n, any k of which
+// determine it. It also returns the polynomial coefficients, with the secret
+// first, for callers that publish commitments to them.
+func SplitScalar(secret *big.Int, n, k int, r io.Reader) ([]ScalarShare, []*big.Int, error) {
+	if k < 2 || n < k || n > 255 {
+		return nil, nil, errors.New("invalid share parameters")
+	}
+	coeffs := make([]*big.Int, k)
+	coeffs[0] = new(big.Int).Mod(secret, Q)
+	for j := 1; j < k; j++ {
+		c, err := RandomScalar(r)
+		if err != nil {
+			return nil, nil, err
+		}
+		coeffs[j] = c
+	}
+
+	shares := make([]ScalarShare, n)
+	for i := range shares {
+		x := big.NewInt(int64(i + 1))
+		y := new(big.Int)
+		for j := k - 1; j >= 0; j-- {
+			y.Mul(y, x)
+			y.Add(y, coeffs[j])
+			y.Mod(y, Q)
+		}
+		shares[i] = ScalarShare{X: uint8(i + 1), Y: y}
+	}
+	return shares, coeffs, nil
+}
+
+// LagrangeAtZero returns the Lagrange basis values at zero, modulo Q, for
+// the distinct non-zero points xs.
+func LagrangeAtZero(xs []uint8) ([]*big.Int, error) {
+	seen := make(map[uint8]bool, len(xs))
+	for _, x := range xs {
+		if x == 0 || seen[x] {
+			return nil, errors.New("points must be distinct and non-zero")
+		}
+		seen[x] = true
+	}
+
+	basis := make([]*big.Int, len(xs))
+	for i, xi := range xs {
+		num, den := big.NewInt(1), big.NewInt(1)
+		for j, xj := range xs {
+			if i == j {
+				continue
+			}
+			num.Mul(num, big.NewInt(int64(xj)))
+			den.Mul(den, big.NewInt(int64(xj)-int64(xi)))
+		}
+		den.Mod(den, Q)
+		den.ModInverse(den, Q)
+		num.Mul(num, den)
+		basis[i] = num.Mod(num, Q)
+	}
+	return basis, nil
+}
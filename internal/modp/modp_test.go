@@ -0,0 +1,90 @@
+package modp
+
+import (
+	"math/big"
+	"testing"
+)
+
+// --- Group Tests ---
+
+func TestGroup(t *testing.T) {
+	if !P.ProbablyPrime(20) || !Q.ProbablyPrime(20) {
+		t.Fatal("Expected P and Q to be prime")
+	}
+	if !IsElement(G) {
+		t.Error("Expected G to generate the order-Q subgroup")
+	}
+	if IsElement(new(big.Int).Sub(P, one)) {
+		t.Error("Expected P-1 to be rejected")
+	}
+
+	x, _ := RandomScalar(nil)
+	y := Exp(G, x)
+	decoded, err := Decode(Encode(y))
+	if err != nil || decoded.Cmp(y) != 0 {
+		t.Errorf("Decode(Encode(y)) failed: %v", err)
+	}
+	if _, err := Decode(make([]byte, ElementSize)); err == nil {
+		t.Error("Expected error for zero element")
+	}
+}
+
+// --- Scalar Sharing Tests ---
+
+func TestSplitScalar(t *testing.T) {
+	secret, _ := RandomScalar(nil)
+	shares, coeffs, err := SplitScalar(secret, 5, 3, nil)
+	if err != nil {
+		t.Fatalf("SplitScalar failed: %v", err)
+	}
+	if len(coeffs) != 3 || coeffs[0].Cmp(secret) != 0 {
+		t.Error("Expected the secret as the constant coefficient")
+	}
+
+	used := []ScalarShare{shares[4], shares[1], shares[2]}
+	basis, err := LagrangeAtZero([]uint8{5, 2, 3})
+	if err != nil {
+		t.Fatalf("LagrangeAtZero failed: %v", err)
+	}
+	sum := new(big.Int)
+	for i, s := range used {
+		sum.Add(sum, new(big.Int).Mul(s.Y, basis[i]))
+	}
+	if sum.Mod(sum, Q).Cmp(secret) != 0 {
+		t.Error("Interpolation did not recover the secret")
+	}
+
+	if _, err := LagrangeAtZero([]uint8{1, 1}); err == nil {
+		t.Error("Expected error for duplicate points")
+	}
+	if _, _, err := SplitScalar(secret, 2, 3, nil); err == nil {
+		t.Error("Expected error for n < k")
+	}
+}
+
+// --- DLEQ Tests ---
+
+func TestDLEQ(t *testing.T) {
+	x, _ := RandomScalar(nil)
+	h, _ := RandomScalar(nil)
+	g2 := Exp(G, h)
+	h1, h2 := Exp(G, x), Exp(g2, x)
+	context := []byte("round 1")
+
+	p, err := ProveDLEQ(x, G, h1, g2, h2, context, nil)
+	if err != nil {
+		t.Fatalf("ProveDLEQ failed: %v", err)
+	}
+	if err := VerifyDLEQ(p, G, h1, g2, h2, context); err != nil {
+		t.Fatalf("VerifyDLEQ failed: %v", err)
+	}
+	if err := VerifyDLEQ(p, G, h1, g2, h2, []byte("round 2")); err == nil {
+		t.Error("Expected error for different context")
+	}
+	if err := VerifyDLEQ(p, G, h1, g2, Mul(h2, G), context); err == nil {
+		t.Error("Expected error for unequal logarithms")
+	}
+	if err := VerifyDLEQ(DLEQProof{}, G, h1, g2, h2, context); err == nil {
+		t.Error("Expected error for empty proof")
+	}
+}
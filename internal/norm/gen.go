@@ -0,0 +1,210 @@
+//go:build ignore
+
+// Gen writes tables.go from UnicodeData.txt of the Unicode Character
+// Database:
+//
+//	curl -O https://www.unicode.org/Public/14.0.0/ucd/UnicodeData.txt
+//	go run gen.go -version 14.0.0
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"flag"
+	"fmt"
+	"go/format"
+	"log"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+var (
+	ucdPath = flag.String("ucd", "UnicodeData.txt", "path of UnicodeData.txt")
+	version = flag.String("version", "", "Unicode version of UnicodeData.txt")
+	outPath = flag.String("out", "tables.go", "path of the generated tables")
+)
+
+func main() {
+	flag.Parse()
+	if *version == "" {
+		log.Fatal("-version is required")
+	}
+	mappings, ccc := parse(*ucdPath)
+
+	var runes []rune
+	for r := range mappings {
+		runes = append(runes, r)
+	}
+	slices.Sort(runes)
+
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "// Code generated by gen.go from UnicodeData.txt %s; DO NOT EDIT.\n\n", *version)
+	fmt.Fprintf(&buf, "package norm\n\n")
+	fmt.Fprintf(&buf, "// UnicodeVersion is the Unicode version of the tables.\n")
+	fmt.Fprintf(&buf, "const UnicodeVersion = %q\n\n", *version)
+
+	// Runes decomposing to one rune each are stored as runs; the others
+	// map to their full decomposition.
+	var singles []string
+	multis := map[rune][]rune{}
+	for i := 0; i < len(runes); i++ {
+		d := decompose(runes[i], mappings, ccc)
+		if len(d) != 1 {
+			multis[runes[i]] = d
+			continue
+		}
+		lo, to := runes[i], d[0]
+		hi := lo
+		for i+1 < len(runes) && runes[i+1] == hi+1 {
+			next := decompose(runes[i+1], mappings, ccc)
+			if len(next) != 1 || next[0] != to+(hi+1-lo) {
+				break
+			}
+			hi++
+			i++
+		}
+		singles = append(singles, fmt.Sprintf("{%#04x, %#04x, %#04x}", lo, hi, to))
+	}
+	fmt.Fprintf(&buf, "// singleRanges lists runs of runes that decompose to one rune each:\n")
+	fmt.Fprintf(&buf, "// rune lo+i decomposes to rune to+i.\n")
+	fmt.Fprintf(&buf, "var singleRanges = []decompRange{\n")
+	writePacked(&buf, singles)
+	fmt.Fprintf(&buf, "}\n\n")
+
+	var entries []string
+	for _, r := range runes {
+		if d, ok := multis[r]; ok {
+			var s strings.Builder
+			for _, c := range d {
+				if c > 0xffff {
+					fmt.Fprintf(&s, `\U%08x`, c)
+				} else {
+					fmt.Fprintf(&s, `\u%04x`, c)
+				}
+			}
+			entries = append(entries, fmt.Sprintf("%#04x: \"%s\"", r, s.String()))
+		}
+	}
+	fmt.Fprintf(&buf, "// decompositions maps the other runes with a decomposition to it.\n")
+	fmt.Fprintf(&buf, "var decompositions = map[rune]string{\n")
+	writePacked(&buf, entries)
+	fmt.Fprintf(&buf, "}\n\n")
+
+	var classes []string
+	var cccRunes []rune
+	for r := range ccc {
+		cccRunes = append(cccRunes, r)
+	}
+	slices.Sort(cccRunes)
+	for i := 0; i < len(cccRunes); i++ {
+		lo := cccRunes[i]
+		hi := lo
+		for i+1 < len(cccRunes) && cccRunes[i+1] == hi+1 && ccc[cccRunes[i+1]] == ccc[lo] {
+			hi++
+			i++
+		}
+		classes = append(classes, fmt.Sprintf("{%#04x, %#04x, %d}", lo, hi, ccc[lo]))
+	}
+	fmt.Fprintf(&buf, "// combiningClasses lists runs of runes with the same non-zero canonical\n")
+	fmt.Fprintf(&buf, "// combining class.\n")
+	fmt.Fprintf(&buf, "var combiningClasses = []cccRange{\n")
+	writePacked(&buf, classes)
+	fmt.Fprintf(&buf, "}\n")
+
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := os.WriteFile(*outPath, src, 0o644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// parse reads the decomposition mappings, canonical and compatibility, and
+// the non-zero canonical combining classes from UnicodeData.txt.
+func parse(path string) (map[rune][]rune, map[rune]uint8) {
+	f, err := os.Open(path)
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer f.Close()
+	mappings := map[rune][]rune{}
+	ccc := map[rune]uint8{}
+	sc := bufio.NewScanner(f)
+	for sc.Scan() {
+		fields := strings.Split(sc.Text(), ";")
+		if len(fields) < 6 {
+			continue
+		}
+		r := parseRune(fields[0])
+		if class, _ := strconv.Atoi(fields[3]); class != 0 {
+			ccc[r] = uint8(class)
+		}
+		decomp := strings.Fields(fields[5])
+		if len(decomp) > 0 && strings.HasPrefix(decomp[0], "<") {
+			decomp = decomp[1:]
+		}
+		for _, c := range decomp {
+			mappings[r] = append(mappings[r], parseRune(c))
+		}
+	}
+	if err := sc.Err(); err != nil {
+		log.Fatal(err)
+	}
+	return mappings, ccc
+}
+
+func parseRune(s string) rune {
+	r, err := strconv.ParseUint(s, 16, 32)
+	if err != nil {
+		log.Fatal(err)
+	}
+	return rune(r)
+}
+
+// decompose returns the full NFKD form of r.
+func decompose(r rune, mappings map[rune][]rune, ccc map[rune]uint8) []rune {
+	var out []rune
+	var expand func(rune)
+	expand = func(r rune) {
+		if m, ok := mappings[r]; ok {
+			for _, c := range m {
+				expand(c)
+			}
+			return
+		}
+		out = append(out, r)
+	}
+	expand(r)
+	// Canonical ordering: stable sort of each run of non-starters.
+	for i := 0; i < len(out); {
+		if ccc[out[i]] == 0 {
+			i++
+			continue
+		}
+		j := i
+		for j < len(out) && ccc[out[j]] != 0 {
+			j++
+		}
+		slices.SortStableFunc(out[i:j], func(a, b rune) int { return int(ccc[a]) - int(ccc[b]) })
+		i = j
+	}
+	return out
+}
+
+// writePacked writes comma-separated items, several to a line.
+func writePacked(buf *bytes.Buffer, items []string) {
+	line := ""
+	for _, item := range items {
+		if line != "" && len(line)+len(item) > 90 {
+			fmt.Fprintf(buf, "\t%s\n", line)
+			line = ""
+		}
+		line += item + ", "
+	}
+	if line != "" {
+		fmt.Fprintf(buf, "\t%s\n", line)
+	}
+}
@@ -0,0 +1,109 @@
+// Package norm implements Unicode Normalization Form KD (NFKD), which
+// BIP-39 applies to mnemonics and passphrases before deriving a seed.
+//
+// The decomposition and combining class tables in tables.go are generated
+// from the Unicode Character Database by gen.go. Runes assigned after
+// UnicodeVersion have no decomposition in the tables and are left as they
+// are; Unicode's stability policy guarantees that the NFKD form of runes
+// assigned earlier never changes.
+package norm
+
+import (
+	"slices"
+	"sort"
+	"unicode/utf8"
+)
+
+// decompRange is a run of runes decomposing to one rune each.
+type decompRange struct {
+	lo, hi, to rune
+}
+
+// cccRange is a run of runes with the same canonical combining class.
+type cccRange struct {
+	lo, hi rune
+	ccc    uint8
+}
+
+// Hangul syllables decompose algorithmically (Unicode section 3.12).
+const (
+	hangulBase  = 0xac00
+	hangulCount = 11172
+	jamoLBase   = 0x1100
+	jamoVBase   = 0x1161
+	jamoTBase   = 0x11a7
+	jamoTCount  = 28
+	jamoNCount  = 588
+)
+
+// NFKD returns the NFKD form of s. ASCII strings, which NFKD leaves
+// unchanged, are returned without copying. Invalid UTF-8 is replaced with
+// U+FFFD.
+func NFKD(s string) string {
+	ascii := true
+	for i := range len(s) {
+		if s[i] >= utf8.RuneSelf {
+			ascii = false
+			break
+		}
+	}
+	if ascii {
+		return s
+	}
+
+	out := make([]rune, 0, len(s))
+	for _, r := range s {
+		out = appendDecomposition(out, r)
+	}
+	// Canonical ordering: each run of non-starters is sorted, stably, by
+	// combining class.
+	for i := 0; i < len(out); {
+		if combiningClass(out[i]) == 0 {
+			i++
+			continue
+		}
+		j := i
+		for j < len(out) && combiningClass(out[j]) != 0 {
+			j++
+		}
+		slices.SortStableFunc(out[i:j], func(a, b rune) int {
+			return int(combiningClass(a)) - int(combiningClass(b))
+		})
+		i = j
+	}
+	return string(out)
+}
+
+// appendDecomposition appends the full decomposition of r to out.
+func appendDecomposition(out []rune, r rune) []rune {
+	if s := r - hangulBase; s >= 0 && s < hangulCount {
+		out = append(out, jamoLBase+s/jamoNCount, jamoVBase+s%jamoNCount/jamoTCount)
+		if t := s % jamoTCount; t != 0 {
+			out = append(out, jamoTBase+t)
+		}
+		return out
+	}
+	i := sort.Search(len(singleRanges), func(i int) bool { return singleRanges[i].hi >= r })
+	if i < len(singleRanges) && singleRanges[i].lo <= r {
+		return append(out, singleRanges[i].to+r-singleRanges[i].lo)
+	}
+	if d, ok := decompositions[r]; ok {
+		for _, c := range d {
+			out = append(out, c)
+		}
+		return out
+	}
+	return append(out, r)
+}
+
+// combiningClass returns the canonical combining class of r.
+func combiningClass(r rune) uint8 {
+	if r < 0x300 {
+		return 0
+	}
+	i := sort.Search(len(combiningClasses), func(i int) bool { return combiningClasses[i].hi >= r })
+	if i < len(combiningClasses) && combiningClasses[i].lo <= r {
+		return combiningClasses[i].ccc
+	}
+	return 0
+}
@@ -0,0 +1,38 @@
+package norm
+
+import "testing"
+
+// --- NFKD Tests ---
+
+func TestNFKD(t *testing.T) {
+	tests := []struct{ in, want string }{
+		{"plain ascii", "plain ascii"},
+		{"café", "café"},
+		{"ﬁat", "fiat"},
+		{"　", " "},
+		{"㍍", "メートル"},
+		{"ガ", "ガ"},
+		{"ｶﾞ", "ガ"},
+		{"ṩ", "ṩ"},
+		{"한", "한"},
+		{"가", "가"},
+		// Combining marks are reordered by combining class.
+		{"ạ́", "ạ́"},
+		{"\U0001d400", "A"},
+		{"\xff", "�"},
+	}
+	for _, tt := range tests {
+		if got := NFKD(tt.in); got != tt.want {
+			t.Errorf("NFKD(%+q) = %+q, want %+q", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestNFKD_Idempotent(t *testing.T) {
+	for _, s := range []string{"\u00dcn\u00efc\u00f6d\u00e9", "\u334d\u30ac\u30d0", "\ud55c\uad6d\uc5b4"} {
+		once := NFKD(s)
+		if twice := NFKD(once); twice != once {
+			t.Errorf("NFKD(NFKD(%+q)) = %+q, want %+q", s, twice, once)
+		}
+	}
+}
@@ -0,0 +1,1641 @@
+// Code generated by gen.go from UnicodeData.txt 14.0.0; DO NOT EDIT.
+
+package norm
+
+// UnicodeVersion is the Unicode version of the tables.
+const UnicodeVersion = "14.0.0"
+
+// singleRanges lists runs of runes that decompose to one rune each:
+// rune lo+i decomposes to rune to+i.
+var singleRanges = []decompRange{
+	{0x00a0, 0x00a0, 0x0020}, {0x00aa, 0x00aa, 0x0061}, {0x00b2, 0x00b3, 0x0032},
+	{0x00b5, 0x00b5, 0x03bc}, {0x00b9, 0x00b9, 0x0031}, {0x00ba, 0x00ba, 0x006f},
+	{0x017f, 0x017f, 0x0073}, {0x02b0, 0x02b0, 0x0068}, {0x02b1, 0x02b1, 0x0266},
+	{0x02b2, 0x02b2, 0x006a}, {0x02b3, 0x02b3, 0x0072}, {0x02b4, 0x02b4, 0x0279},
+	{0x02b5, 0x02b5, 0x027b}, {0x02b6, 0x02b6, 0x0281}, {0x02b7, 0x02b7, 0x0077},
+	{0x02b8, 0x02b8, 0x0079}, {0x02e0, 0x02e0, 0x0263}, {0x02e1, 0x02e1, 0x006c},
+	{0x02e2, 0x02e2, 0x0073}, {0x02e3, 0x02e3, 0x0078}, {0x02e4, 0x02e4, 0x0295},
+	{0x0340, 0x0341, 0x0300}, {0x0343, 0x0343, 0x0313}, {0x0374, 0x0374, 0x02b9},
+	{0x037e, 0x037e, 0x003b}, {0x0387, 0x0387, 0x00b7}, {0x03d0, 0x03d0, 0x03b2},
+	{0x03d1, 0x03d1, 0x03b8}, {0x03d2, 0x03d2, 0x03a5}, {0x03d5, 0x03d5, 0x03c6},
+	{0x03d6, 0x03d6, 0x03c0}, {0x03f0, 0x03f0, 0x03ba}, {0x03f1, 0x03f2, 0x03c1},
+	{0x03f4, 0x03f4, 0x0398}, {0x03f5, 0x03f5, 0x03b5}, {0x03f9, 0x03f9, 0x03a3},
+	{0x0f0c, 0x0f0c, 0x0f0b}, {0x10fc, 0x10fc, 0x10dc}, {0x1d2c, 0x1d2c, 0x0041},
+	{0x1d2d, 0x1d2d, 0x00c6}, {0x1d2e, 0x1d2e, 0x0042}, {0x1d30, 0x1d31, 0x0044},
+	{0x1d32, 0x1d32, 0x018e}, {0x1d33, 0x1d3a, 0x0047}, {0x1d3c, 0x1d3c, 0x004f},
+	{0x1d3d, 0x1d3d, 0x0222}, {0x1d3e, 0x1d3e, 0x0050}, {0x1d3f, 0x1d3f, 0x0052},
+	{0x1d40, 0x1d41, 0x0054}, {0x1d42, 0x1d42, 0x0057}, {0x1d43, 0x1d43, 0x0061},
+	{0x1d44, 0x1d45, 0x0250}, {0x1d46, 0x1d46, 0x1d02}, {0x1d47, 0x1d47, 0x0062},
+	{0x1d48, 0x1d49, 0x0064}, {0x1d4a, 0x1d4a, 0x0259}, {0x1d4b, 0x1d4c, 0x025b},
+	{0x1d4d, 0x1d4d, 0x0067}, {0x1d4f, 0x1d4f, 0x006b}, {0x1d50, 0x1d50, 0x006d},
+	{0x1d51, 0x1d51, 0x014b}, {0x1d52, 0x1d52, 0x006f}, {0x1d53, 0x1d53, 0x0254},
+	{0x1d54, 0x1d55, 0x1d16}, {0x1d56, 0x1d56, 0x0070}, {0x1d57, 0x1d58, 0x0074},
+	{0x1d59, 0x1d59, 0x1d1d}, {0x1d5a, 0x1d5a, 0x026f}, {0x1d5b, 0x1d5b, 0x0076},
+	{0x1d5c, 0x1d5c, 0x1d25}, {0x1d5d, 0x1d5f, 0x03b2}, {0x1d60, 0x1d61, 0x03c6},
+	{0x1d62, 0x1d62, 0x0069}, {0x1d63, 0x1d63, 0x0072}, {0x1d64, 0x1d65, 0x0075},
+	{0x1d66, 0x1d67, 0x03b2}, {0x1d68, 0x1d68, 0x03c1}, {0x1d69, 0x1d6a, 0x03c6},
+	{0x1d78, 0x1d78, 0x043d}, {0x1d9b, 0x1d9b, 0x0252}, {0x1d9c, 0x1d9c, 0x0063},
+	{0x1d9d, 0x1d9d, 0x0255}, {0x1d9e, 0x1d9e, 0x00f0}, {0x1d9f, 0x1d9f, 0x025c},
+	{0x1da0, 0x1da0, 0x0066}, {0x1da1, 0x1da1, 0x025f}, {0x1da2, 0x1da2, 0x0261},
+	{0x1da3, 0x1da3, 0x0265}, {0x1da4, 0x1da6, 0x0268}, {0x1da7, 0x1da7, 0x1d7b},
+	{0x1da8, 0x1da8, 0x029d}, {0x1da9, 0x1da9, 0x026d}, {0x1daa, 0x1daa, 0x1d85},
+	{0x1dab, 0x1dab, 0x029f}, {0x1dac, 0x1dac, 0x0271}, {0x1dad, 0x1dad, 0x0270},
+	{0x1dae, 0x1db1, 0x0272}, {0x1db2, 0x1db2, 0x0278}, {0x1db3, 0x1db4, 0x0282},
+	{0x1db5, 0x1db5, 0x01ab}, {0x1db6, 0x1db7, 0x0289}, {0x1db8, 0x1db8, 0x1d1c},
+	{0x1db9, 0x1dba, 0x028b}, {0x1dbb, 0x1dbb, 0x007a}, {0x1dbc, 0x1dbe, 0x0290},
+	{0x1dbf, 0x1dbf, 0x03b8}, {0x1fbe, 0x1fbe, 0x03b9}, {0x1fef, 0x1fef, 0x0060},
+	{0x2000, 0x2000, 0x0020}, {0x2001, 0x2001, 0x0020}, {0x2002, 0x2002, 0x0020},
+	{0x2003, 0x2003, 0x0020}, {0x2004, 0x2004, 0x0020}, {0x2005, 0x2005, 0x0020},
+	{0x2006, 0x2006, 0x0020}, {0x2007, 0x2007, 0x0020}, {0x2008, 0x2008, 0x0020},
+	{0x2009, 0x2009, 0x0020}, {0x200a, 0x200a, 0x0020}, {0x2011, 0x2011, 0x2010},
+	{0x2024, 0x2024, 0x002e}, {0x202f, 0x202f, 0x0020}, {0x205f, 0x205f, 0x0020},
+	{0x2070, 0x2070, 0x0030}, {0x2071, 0x2071, 0x0069}, {0x2074, 0x2079, 0x0034},
+	{0x207a, 0x207a, 0x002b}, {0x207b, 0x207b, 0x2212}, {0x207c, 0x207c, 0x003d},
+	{0x207d, 0x207e, 0x0028}, {0x207f, 0x207f, 0x006e}, {0x2080, 0x2089, 0x0030},
+	{0x208a, 0x208a, 0x002b}, {0x208b, 0x208b, 0x2212}, {0x208c, 0x208c, 0x003d},
+	{0x208d, 0x208e, 0x0028}, {0x2090, 0x2090, 0x0061}, {0x2091, 0x2091, 0x0065},
+	{0x2092, 0x2092, 0x006f}, {0x2093, 0x2093, 0x0078}, {0x2094, 0x2094, 0x0259},
+	{0x2095, 0x2095, 0x0068}, {0x2096, 0x2099, 0x006b}, {0x209a, 0x209a, 0x0070},
+	{0x209b, 0x209c, 0x0073}, {0x2102, 0x2102, 0x0043}, {0x2107, 0x2107, 0x0190},
+	{0x210a, 0x210a, 0x0067}, {0x210b, 0x210b, 0x0048}, {0x210c, 0x210c, 0x0048},
+	{0x210d, 0x210d, 0x0048}, {0x210e, 0x210e, 0x0068}, {0x210f, 0x210f, 0x0127},
+	{0x2110, 0x2110, 0x0049}, {0x2111, 0x2111, 0x0049}, {0x2112, 0x2112, 0x004c},
+	{0x2113, 0x2113, 0x006c}, {0x2115, 0x2115, 0x004e}, {0x2119, 0x211b, 0x0050},
+	{0x211c, 0x211c, 0x0052}, {0x211d, 0x211d, 0x0052}, {0x2124, 0x2124, 0x005a},
+	{0x2126, 0x2126, 0x03a9}, {0x2128, 0x2128, 0x005a}, {0x212a, 0x212a, 0x004b},
+	{0x212c, 0x212d, 0x0042}, {0x212f, 0x212f, 0x0065}, {0x2130, 0x2131, 0x0045},
+	{0x2133, 0x2133, 0x004d}, {0x2134, 0x2134, 0x006f}, {0x2135, 0x2138, 0x05d0},
+	{0x2139, 0x2139, 0x0069}, {0x213c, 0x213c, 0x03c0}, {0x213d, 0x213d, 0x03b3},
+	{0x213e, 0x213e, 0x0393}, {0x213f, 0x213f, 0x03a0}, {0x2140, 0x2140, 0x2211},
+	{0x2145, 0x2145, 0x0044}, {0x2146, 0x2147, 0x0064}, {0x2148, 0x2149, 0x0069},
+	{0x2160, 0x2160, 0x0049}, {0x2164, 0x2164, 0x0056}, {0x2169, 0x2169, 0x0058},
+	{0x216c, 0x216c, 0x004c}, {0x216d, 0x216e, 0x0043}, {0x216f, 0x216f, 0x004d},
+	{0x2170, 0x2170, 0x0069}, {0x2174, 0x2174, 0x0076}, {0x2179, 0x2179, 0x0078},
+	{0x217c, 0x217c, 0x006c}, {0x217d, 0x217e, 0x0063}, {0x217f, 0x217f, 0x006d},
+	{0x2329, 0x232a, 0x3008}, {0x2460, 0x2468, 0x0031}, {0x24b6, 0x24cf, 0x0041},
+	{0x24d0, 0x24e9, 0x0061}, {0x24ea, 0x24ea, 0x0030}, {0x2c7c, 0x2c7c, 0x006a},
+	{0x2c7d, 0x2c7d, 0x0056}, {0x2d6f, 0x2d6f, 0x2d61}, {0x2e9f, 0x2e9f, 0x6bcd},
+	{0x2ef3, 0x2ef3, 0x9f9f}, {0x2f00, 0x2f00, 0x4e00}, {0x2f01, 0x2f01, 0x4e28},
+	{0x2f02, 0x2f02, 0x4e36}, {0x2f03, 0x2f03, 0x4e3f}, {0x2f04, 0x2f04, 0x4e59},
+	{0x2f05, 0x2f05, 0x4e85}, {0x2f06, 0x2f06, 0x4e8c}, {0x2f07, 0x2f07, 0x4ea0},
+	{0x2f08, 0x2f08, 0x4eba}, {0x2f09, 0x2f09, 0x513f}, {0x2f0a, 0x2f0a, 0x5165},
+	{0x2f0b, 0x2f0b, 0x516b}, {0x2f0c, 0x2f0c, 0x5182}, {0x2f0d, 0x2f0d, 0x5196},
+	{0x2f0e, 0x2f0e, 0x51ab}, {0x2f0f, 0x2f0f, 0x51e0}, {0x2f10, 0x2f10, 0x51f5},
+	{0x2f11, 0x2f11, 0x5200}, {0x2f12, 0x2f12, 0x529b}, {0x2f13, 0x2f13, 0x52f9},
+	{0x2f14, 0x2f14, 0x5315}, {0x2f15, 0x2f15, 0x531a}, {0x2f16, 0x2f16, 0x5338},
+	{0x2f17, 0x2f17, 0x5341}, {0x2f18, 0x2f18, 0x535c}, {0x2f19, 0x2f19, 0x5369},
+	{0x2f1a, 0x2f1a, 0x5382}, {0x2f1b, 0x2f1b, 0x53b6}, {0x2f1c, 0x2f1c, 0x53c8},
+	{0x2f1d, 0x2f1d, 0x53e3}, {0x2f1e, 0x2f1e, 0x56d7}, {0x2f1f, 0x2f1f, 0x571f},
+	{0x2f20, 0x2f20, 0x58eb}, {0x2f21, 0x2f21, 0x5902}, {0x2f22, 0x2f22, 0x590a},
+	{0x2f23, 0x2f23, 0x5915}, {0x2f24, 0x2f24, 0x5927}, {0x2f25, 0x2f25, 0x5973},
+	{0x2f26, 0x2f26, 0x5b50}, {0x2f27, 0x2f27, 0x5b80}, {0x2f28, 0x2f28, 0x5bf8},
+	{0x2f29, 0x2f29, 0x5c0f}, {0x2f2a, 0x2f2a, 0x5c22}, {0x2f2b, 0x2f2b, 0x5c38},
+	{0x2f2c, 0x2f2c, 0x5c6e}, {0x2f2d, 0x2f2d, 0x5c71}, {0x2f2e, 0x2f2e, 0x5ddb},
+	{0x2f2f, 0x2f2f, 0x5de5}, {0x2f30, 0x2f30, 0x5df1}, {0x2f31, 0x2f31, 0x5dfe},
+	{0x2f32, 0x2f32, 0x5e72}, {0x2f33, 0x2f33, 0x5e7a}, {0x2f34, 0x2f34, 0x5e7f},
+	{0x2f35, 0x2f35, 0x5ef4}, {0x2f36, 0x2f36, 0x5efe}, {0x2f37, 0x2f37, 0x5f0b},
+	{0x2f38, 0x2f38, 0x5f13}, {0x2f39, 0x2f39, 0x5f50}, {0x2f3a, 0x2f3a, 0x5f61},
+	{0x2f3b, 0x2f3b, 0x5f73}, {0x2f3c, 0x2f3c, 0x5fc3}, {0x2f3d, 0x2f3d, 0x6208},
+	{0x2f3e, 0x2f3e, 0x6236}, {0x2f3f, 0x2f3f, 0x624b}, {0x2f40, 0x2f40, 0x652f},
+	{0x2f41, 0x2f41, 0x6534}, {0x2f42, 0x2f42, 0x6587}, {0x2f43, 0x2f43, 0x6597},
+	{0x2f44, 0x2f44, 0x65a4}, {0x2f45, 0x2f45, 0x65b9}, {0x2f46, 0x2f46, 0x65e0},
+	{0x2f47, 0x2f47, 0x65e5}, {0x2f48, 0x2f48, 0x66f0}, {0x2f49, 0x2f49, 0x6708},
+	{0x2f4a, 0x2f4a, 0x6728}, {0x2f4b, 0x2f4b, 0x6b20}, {0x2f4c, 0x2f4c, 0x6b62},
+	{0x2f4d, 0x2f4d, 0x6b79}, {0x2f4e, 0x2f4e, 0x6bb3}, {0x2f4f, 0x2f4f, 0x6bcb},
+	{0x2f50, 0x2f50, 0x6bd4}, {0x2f51, 0x2f51, 0x6bdb}, {0x2f52, 0x2f52, 0x6c0f},
+	{0x2f53, 0x2f53, 0x6c14}, {0x2f54, 0x2f54, 0x6c34}, {0x2f55, 0x2f55, 0x706b},
+	{0x2f56, 0x2f56, 0x722a}, {0x2f57, 0x2f57, 0x7236}, {0x2f58, 0x2f58, 0x723b},
+	{0x2f59, 0x2f59, 0x723f}, {0x2f5a, 0x2f5a, 0x7247}, {0x2f5b, 0x2f5b, 0x7259},
+	{0x2f5c, 0x2f5c, 0x725b}, {0x2f5d, 0x2f5d, 0x72ac}, {0x2f5e, 0x2f5e, 0x7384},
+	{0x2f5f, 0x2f5f, 0x7389}, {0x2f60, 0x2f60, 0x74dc}, {0x2f61, 0x2f61, 0x74e6},
+	{0x2f62, 0x2f62, 0x7518}, {0x2f63, 0x2f63, 0x751f}, {0x2f64, 0x2f64, 0x7528},
+	{0x2f65, 0x2f65, 0x7530}, {0x2f66, 0x2f66, 0x758b}, {0x2f67, 0x2f67, 0x7592},
+	{0x2f68, 0x2f68, 0x7676}, {0x2f69, 0x2f69, 0x767d}, {0x2f6a, 0x2f6a, 0x76ae},
+	{0x2f6b, 0x2f6b, 0x76bf}, {0x2f6c, 0x2f6c, 0x76ee}, {0x2f6d, 0x2f6d, 0x77db},
+	{0x2f6e, 0x2f6e, 0x77e2}, {0x2f6f, 0x2f6f, 0x77f3}, {0x2f70, 0x2f70, 0x793a},
+	{0x2f71, 0x2f71, 0x79b8}, {0x2f72, 0x2f72, 0x79be}, {0x2f73, 0x2f73, 0x7a74},
+	{0x2f74, 0x2f74, 0x7acb}, {0x2f75, 0x2f75, 0x7af9}, {0x2f76, 0x2f76, 0x7c73},
+	{0x2f77, 0x2f77, 0x7cf8}, {0x2f78, 0x2f78, 0x7f36}, {0x2f79, 0x2f79, 0x7f51},
+	{0x2f7a, 0x2f7a, 0x7f8a}, {0x2f7b, 0x2f7b, 0x7fbd}, {0x2f7c, 0x2f7c, 0x8001},
+	{0x2f7d, 0x2f7d, 0x800c}, {0x2f7e, 0x2f7e, 0x8012}, {0x2f7f, 0x2f7f, 0x8033},
+	{0x2f80, 0x2f80, 0x807f}, {0x2f81, 0x2f81, 0x8089}, {0x2f82, 0x2f82, 0x81e3},
+	{0x2f83, 0x2f83, 0x81ea}, {0x2f84, 0x2f84, 0x81f3}, {0x2f85, 0x2f85, 0x81fc},
+	{0x2f86, 0x2f86, 0x820c}, {0x2f87, 0x2f87, 0x821b}, {0x2f88, 0x2f88, 0x821f},
+	{0x2f89, 0x2f89, 0x826e}, {0x2f8a, 0x2f8a, 0x8272}, {0x2f8b, 0x2f8b, 0x8278},
+	{0x2f8c, 0x2f8c, 0x864d}, {0x2f8d, 0x2f8d, 0x866b}, {0x2f8e, 0x2f8e, 0x8840},
+	{0x2f8f, 0x2f8f, 0x884c}, {0x2f90, 0x2f90, 0x8863}, {0x2f91, 0x2f91, 0x897e},
+	{0x2f92, 0x2f92, 0x898b}, {0x2f93, 0x2f93, 0x89d2}, {0x2f94, 0x2f94, 0x8a00},
+	{0x2f95, 0x2f95, 0x8c37}, {0x2f96, 0x2f96, 0x8c46}, {0x2f97, 0x2f97, 0x8c55},
+	{0x2f98, 0x2f98, 0x8c78}, {0x2f99, 0x2f99, 0x8c9d}, {0x2f9a, 0x2f9a, 0x8d64},
+	{0x2f9b, 0x2f9b, 0x8d70}, {0x2f9c, 0x2f9c, 0x8db3}, {0x2f9d, 0x2f9d, 0x8eab},
+	{0x2f9e, 0x2f9e, 0x8eca}, {0x2f9f, 0x2f9f, 0x8f9b}, {0x2fa0, 0x2fa0, 0x8fb0},
+	{0x2fa1, 0x2fa1, 0x8fb5}, {0x2fa2, 0x2fa2, 0x9091}, {0x2fa3, 0x2fa3, 0x9149},
+	{0x2fa4, 0x2fa4, 0x91c6}, {0x2fa5, 0x2fa5, 0x91cc}, {0x2fa6, 0x2fa6, 0x91d1},
+	{0x2fa7, 0x2fa7, 0x9577}, {0x2fa8, 0x2fa8, 0x9580}, {0x2fa9, 0x2fa9, 0x961c},
+	{0x2faa, 0x2faa, 0x96b6}, {0x2fab, 0x2fab, 0x96b9}, {0x2fac, 0x2fac, 0x96e8},
+	{0x2fad, 0x2fad, 0x9751}, {0x2fae, 0x2fae, 0x975e}, {0x2faf, 0x2faf, 0x9762},
+	{0x2fb0, 0x2fb0, 0x9769}, {0x2fb1, 0x2fb1, 0x97cb}, {0x2fb2, 0x2fb2, 0x97ed},
+	{0x2fb3, 0x2fb3, 0x97f3}, {0x2fb4, 0x2fb4, 0x9801}, {0x2fb5, 0x2fb5, 0x98a8},
+	{0x2fb6, 0x2fb6, 0x98db}, {0x2fb7, 0x2fb7, 0x98df}, {0x2fb8, 0x2fb8, 0x9996},
+	{0x2fb9, 0x2fb9, 0x9999}, {0x2fba, 0x2fba, 0x99ac}, {0x2fbb, 0x2fbb, 0x9aa8},
+	{0x2fbc, 0x2fbc, 0x9ad8}, {0x2fbd, 0x2fbd, 0x9adf}, {0x2fbe, 0x2fbe, 0x9b25},
+	{0x2fbf, 0x2fbf, 0x9b2f}, {0x2fc0, 0x2fc0, 0x9b32}, {0x2fc1, 0x2fc1, 0x9b3c},
+	{0x2fc2, 0x2fc2, 0x9b5a}, {0x2fc3, 0x2fc3, 0x9ce5}, {0x2fc4, 0x2fc4, 0x9e75},
+	{0x2fc5, 0x2fc5, 0x9e7f}, {0x2fc6, 0x2fc6, 0x9ea5}, {0x2fc7, 0x2fc7, 0x9ebb},
+	{0x2fc8, 0x2fc8, 0x9ec3}, {0x2fc9, 0x2fc9, 0x9ecd}, {0x2fca, 0x2fca, 0x9ed1},
+	{0x2fcb, 0x2fcb, 0x9ef9}, {0x2fcc, 0x2fcc, 0x9efd}, {0x2fcd, 0x2fcd, 0x9f0e},
+	{0x2fce, 0x2fce, 0x9f13}, {0x2fcf, 0x2fcf, 0x9f20}, {0x2fd0, 0x2fd0, 0x9f3b},
+	{0x2fd1, 0x2fd1, 0x9f4a}, {0x2fd2, 0x2fd2, 0x9f52}, {0x2fd3, 0x2fd3, 0x9f8d},
+	{0x2fd4, 0x2fd4, 0x9f9c}, {0x2fd5, 0x2fd5, 0x9fa0}, {0x3000, 0x3000, 0x0020},
+	{0x3036, 0x3036, 0x3012}, {0x3038, 0x3038, 0x5341}, {0x3039, 0x303a, 0x5344},
+	{0x3131, 0x3132, 0x1100}, {0x3133, 0x3133, 0x11aa}, {0x3134, 0x3134, 0x1102},
+	{0x3135, 0x3136, 0x11ac}, {0x3137, 0x3139, 0x1103}, {0x313a, 0x313f, 0x11b0},
+	{0x3140, 0x3140, 0x111a}, {0x3141, 0x3143, 0x1106}, {0x3144, 0x3144, 0x1121},
+	{0x3145, 0x314e, 0x1109}, {0x314f, 0x3163, 0x1161}, {0x3164, 0x3164, 0x1160},
+	{0x3165, 0x3166, 0x1114}, {0x3167, 0x3168, 0x11c7}, {0x3169, 0x3169, 0x11cc},
+	{0x316a, 0x316a, 0x11ce}, {0x316b, 0x316b, 0x11d3}, {0x316c, 0x316c, 0x11d7},
+	{0x316d, 0x316d, 0x11d9}, {0x316e, 0x316e, 0x111c}, {0x316f, 0x316f, 0x11dd},
+	{0x3170, 0x3170, 0x11df}, {0x3171, 0x3172, 0x111d}, {0x3173, 0x3173, 0x1120},
+	{0x3174, 0x3175, 0x1122}, {0x3176, 0x3176, 0x1127}, {0x3177, 0x3177, 0x1129},
+	{0x3178, 0x317c, 0x112b}, {0x317d, 0x317d, 0x1132}, {0x317e, 0x317e, 0x1136},
+	{0x317f, 0x317f, 0x1140}, {0x3180, 0x3180, 0x1147}, {0x3181, 0x3181, 0x114c},
+	{0x3182, 0x3183, 0x11f1}, {0x3184, 0x3186, 0x1157}, {0x3187, 0x3188, 0x1184},
+	{0x3189, 0x3189, 0x1188}, {0x318a, 0x318b, 0x1191}, {0x318c, 0x318c, 0x1194},
+	{0x318d, 0x318d, 0x119e}, {0x318e, 0x318e, 0x11a1}, {0x3192, 0x3192, 0x4e00},
+	{0x3193, 0x3193, 0x4e8c}, {0x3194, 0x3194, 0x4e09}, {0x3195, 0x3195, 0x56db},
+	{0x3196, 0x3196, 0x4e0a}, {0x3197, 0x3197, 0x4e2d}, {0x3198, 0x3198, 0x4e0b},
+	{0x3199, 0x3199, 0x7532}, {0x319a, 0x319a, 0x4e59}, {0x319b, 0x319b, 0x4e19},
+	{0x319c, 0x319c, 0x4e01}, {0x319d, 0x319d, 0x5929}, {0x319e, 0x319e, 0x5730},
+	{0x319f, 0x319f, 0x4eba}, {0x3244, 0x3244, 0x554f}, {0x3245, 0x3245, 0x5e7c},
+	{0x3246, 0x3246, 0x6587}, {0x3247, 0x3247, 0x7b8f}, {0x3260, 0x3260, 0x1100},
+	{0x3261, 0x3262, 0x1102}, {0x3263, 0x3265, 0x1105}, {0x3266, 0x3266, 0x1109},
+	{0x3267, 0x3268, 0x110b}, {0x3269, 0x326d, 0x110e}, {0x3280, 0x3280, 0x4e00},
+	{0x3281, 0x3281, 0x4e8c}, {0x3282, 0x3282, 0x4e09}, {0x3283, 0x3283, 0x56db},
+	{0x3284, 0x3284, 0x4e94}, {0x3285, 0x3285, 0x516d}, {0x3286, 0x3286, 0x4e03},
+	{0x3287, 0x3287, 0x516b}, {0x3288, 0x3288, 0x4e5d}, {0x3289, 0x3289, 0x5341},
+	{0x328a, 0x328a, 0x6708}, {0x328b, 0x328b, 0x706b}, {0x328c, 0x328c, 0x6c34},
+	{0x328d, 0x328d, 0x6728}, {0x328e, 0x328e, 0x91d1}, {0x328f, 0x328f, 0x571f},
+	{0x3290, 0x3290, 0x65e5}, {0x3291, 0x3291, 0x682a}, {0x3292, 0x3292, 0x6709},
+	{0x3293, 0x3293, 0x793e}, {0x3294, 0x3294, 0x540d}, {0x3295, 0x3295, 0x7279},
+	{0x3296, 0x3296, 0x8ca1}, {0x3297, 0x3297, 0x795d}, {0x3298, 0x3298, 0x52b4},
+	{0x3299, 0x3299, 0x79d8}, {0x329a, 0x329a, 0x7537}, {0x329b, 0x329b, 0x5973},
+	{0x329c, 0x329c, 0x9069}, {0x329d, 0x329d, 0x512a}, {0x329e, 0x329e, 0x5370},
+	{0x329f, 0x329f, 0x6ce8}, {0x32a0, 0x32a0, 0x9805}, {0x32a1, 0x32a1, 0x4f11},
+	{0x32a2, 0x32a2, 0x5199}, {0x32a3, 0x32a3, 0x6b63}, {0x32a4, 0x32a4, 0x4e0a},
+	{0x32a5, 0x32a5, 0x4e2d}, {0x32a6, 0x32a6, 0x4e0b}, {0x32a7, 0x32a7, 0x5de6},
+	{0x32a8, 0x32a8, 0x53f3}, {0x32a9, 0x32a9, 0x533b}, {0x32aa, 0x32aa, 0x5b97},
+	{0x32ab, 0x32ab, 0x5b66}, {0x32ac, 0x32ac, 0x76e3}, {0x32ad, 0x32ad, 0x4f01},
+	{0x32ae, 0x32ae, 0x8cc7}, {0x32af, 0x32af, 0x5354}, {0x32b0, 0x32b0, 0x591c},
+	{0x32d0, 0x32d0, 0x30a2}, {0x32d1, 0x32d1, 0x30a4}, {0x32d2, 0x32d2, 0x30a6},
+	{0x32d3, 0x32d3, 0x30a8}, {0x32d4, 0x32d5, 0x30aa}, {0x32d6, 0x32d6, 0x30ad},
+	{0x32d7, 0x32d7, 0x30af}, {0x32d8, 0x32d8, 0x30b1}, {0x32d9, 0x32d9, 0x30b3},
+	{0x32da, 0x32da, 0x30b5}, {0x32db, 0x32db, 0x30b7}, {0x32dc, 0x32dc, 0x30b9},
+	{0x32dd, 0x32dd, 0x30bb}, {0x32de, 0x32de, 0x30bd}, {0x32df, 0x32df, 0x30bf},
+	{0x32e0, 0x32e0, 0x30c1}, {0x32e1, 0x32e1, 0x30c4}, {0x32e2, 0x32e2, 0x30c6},
+	{0x32e3, 0x32e3, 0x30c8}, {0x32e4, 0x32e9, 0x30ca}, {0x32ea, 0x32ea, 0x30d2},
+	{0x32eb, 0x32eb, 0x30d5}, {0x32ec, 0x32ec, 0x30d8}, {0x32ed, 0x32ed, 0x30db},
+	{0x32ee, 0x32f2, 0x30de}, {0x32f3, 0x32f3, 0x30e4}, {0x32f4, 0x32f4, 0x30e6},
+	{0x32f5, 0x32fa, 0x30e8}, {0x32fb, 0x32fe, 0x30ef}, {0xa69c, 0xa69c, 0x044a},
+	{0xa69d, 0xa69d, 0x044c}, {0xa770, 0xa770, 0xa76f}, {0xa7f2, 0xa7f2, 0x0043},
+	{0xa7f3, 0xa7f3, 0x0046}, {0xa7f4, 0xa7f4, 0x0051}, {0xa7f8, 0xa7f8, 0x0126},
+	{0xa7f9, 0xa7f9, 0x0153}, {0xab5c, 0xab5c, 0xa727}, {0xab5d, 0xab5d, 0xab37},
+	{0xab5e, 0xab5e, 0x026b}, {0xab5f, 0xab5f, 0xab52}, {0xab69, 0xab69, 0x028d},
+	{0xf900, 0xf900, 0x8c48}, {0xf901, 0xf901, 0x66f4}, {0xf902, 0xf902, 0x8eca},
+	{0xf903, 0xf903, 0x8cc8}, {0xf904, 0xf904, 0x6ed1}, {0xf905, 0xf905, 0x4e32},
+	{0xf906, 0xf906, 0x53e5}, {0xf907, 0xf907, 0x9f9c}, {0xf908, 0xf908, 0x9f9c},
+	{0xf909, 0xf909, 0x5951}, {0xf90a, 0xf90a, 0x91d1}, {0xf90b, 0xf90b, 0x5587},
+	{0xf90c, 0xf90c, 0x5948}, {0xf90d, 0xf90d, 0x61f6}, {0xf90e, 0xf90e, 0x7669},
+	{0xf90f, 0xf90f, 0x7f85}, {0xf910, 0xf910, 0x863f}, {0xf911, 0xf911, 0x87ba},
+	{0xf912, 0xf912, 0x88f8}, {0xf913, 0xf913, 0x908f}, {0xf914, 0xf914, 0x6a02},
+	{0xf915, 0xf915, 0x6d1b}, {0xf916, 0xf916, 0x70d9}, {0xf917, 0xf917, 0x73de},
+	{0xf918, 0xf918, 0x843d}, {0xf919, 0xf919, 0x916a}, {0xf91a, 0xf91a, 0x99f1},
+	{0xf91b, 0xf91b, 0x4e82}, {0xf91c, 0xf91c, 0x5375}, {0xf91d, 0xf91d, 0x6b04},
+	{0xf91e, 0xf91e, 0x721b}, {0xf91f, 0xf91f, 0x862d}, {0xf920, 0xf920, 0x9e1e},
+	{0xf921, 0xf921, 0x5d50}, {0xf922, 0xf922, 0x6feb}, {0xf923, 0xf923, 0x85cd},
+	{0xf924, 0xf924, 0x8964}, {0xf925, 0xf925, 0x62c9}, {0xf926, 0xf926, 0x81d8},
+	{0xf927, 0xf927, 0x881f}, {0xf928, 0xf928, 0x5eca}, {0xf929, 0xf929, 0x6717},
+	{0xf92a, 0xf92a, 0x6d6a}, {0xf92b, 0xf92b, 0x72fc}, {0xf92c, 0xf92c, 0x90ce},
+	{0xf92d, 0xf92d, 0x4f86}, {0xf92e, 0xf92e, 0x51b7}, {0xf92f, 0xf92f, 0x52de},
+	{0xf930, 0xf930, 0x64c4}, {0xf931, 0xf931, 0x6ad3}, {0xf932, 0xf932, 0x7210},
+	{0xf933, 0xf933, 0x76e7}, {0xf934, 0xf934, 0x8001}, {0xf935, 0xf935, 0x8606},
+	{0xf936, 0xf936, 0x865c}, {0xf937, 0xf937, 0x8def}, {0xf938, 0xf938, 0x9732},
+	{0xf939, 0xf939, 0x9b6f}, {0xf93a, 0xf93a, 0x9dfa}, {0xf93b, 0xf93b, 0x788c},
+	{0xf93c, 0xf93c, 0x797f}, {0xf93d, 0xf93d, 0x7da0}, {0xf93e, 0xf93e, 0x83c9},
+	{0xf93f, 0xf93f, 0x9304}, {0xf940, 0xf940, 0x9e7f}, {0xf941, 0xf941, 0x8ad6},
+	{0xf942, 0xf942, 0x58df}, {0xf943, 0xf943, 0x5f04}, {0xf944, 0xf944, 0x7c60},
+	{0xf945, 0xf945, 0x807e}, {0xf946, 0xf946, 0x7262}, {0xf947, 0xf947, 0x78ca},
+	{0xf948, 0xf948, 0x8cc2}, {0xf949, 0xf949, 0x96f7}, {0xf94a, 0xf94a, 0x58d8},
+	{0xf94b, 0xf94b, 0x5c62}, {0xf94c, 0xf94c, 0x6a13}, {0xf94d, 0xf94d, 0x6dda},
+	{0xf94e, 0xf94e, 0x6f0f}, {0xf94f, 0xf94f, 0x7d2f}, {0xf950, 0xf950, 0x7e37},
+	{0xf951, 0xf951, 0x964b}, {0xf952, 0xf952, 0x52d2}, {0xf953, 0xf953, 0x808b},
+	{0xf954, 0xf954, 0x51dc}, {0xf955, 0xf955, 0x51cc}, {0xf956, 0xf956, 0x7a1c},
+	{0xf957, 0xf957, 0x7dbe}, {0xf958, 0xf958, 0x83f1}, {0xf959, 0xf959, 0x9675},
+	{0xf95a, 0xf95a, 0x8b80}, {0xf95b, 0xf95b, 0x62cf}, {0xf95c, 0xf95c, 0x6a02},
+	{0xf95d, 0xf95d, 0x8afe}, {0xf95e, 0xf95e, 0x4e39}, {0xf95f, 0xf95f, 0x5be7},
+	{0xf960, 0xf960, 0x6012}, {0xf961, 0xf961, 0x7387}, {0xf962, 0xf962, 0x7570},
+	{0xf963, 0xf963, 0x5317}, {0xf964, 0xf964, 0x78fb}, {0xf965, 0xf965, 0x4fbf},
+	{0xf966, 0xf966, 0x5fa9}, {0xf967, 0xf967, 0x4e0d}, {0xf968, 0xf968, 0x6ccc},
+	{0xf969, 0xf969, 0x6578}, {0xf96a, 0xf96a, 0x7d22}, {0xf96b, 0xf96b, 0x53c3},
+	{0xf96c, 0xf96c, 0x585e}, {0xf96d, 0xf96d, 0x7701}, {0xf96e, 0xf96e, 0x8449},
+	{0xf96f, 0xf96f, 0x8aaa}, {0xf970, 0xf970, 0x6bba}, {0xf971, 0xf971, 0x8fb0},
+	{0xf972, 0xf972, 0x6c88}, {0xf973, 0xf973, 0x62fe}, {0xf974, 0xf974, 0x82e5},
+	{0xf975, 0xf975, 0x63a0}, {0xf976, 0xf976, 0x7565}, {0xf977, 0xf977, 0x4eae},
+	{0xf978, 0xf978, 0x5169}, {0xf979, 0xf979, 0x51c9}, {0xf97a, 0xf97a, 0x6881},
+	{0xf97b, 0xf97b, 0x7ce7}, {0xf97c, 0xf97c, 0x826f}, {0xf97d, 0xf97d, 0x8ad2},
+	{0xf97e, 0xf97e, 0x91cf}, {0xf97f, 0xf97f, 0x52f5}, {0xf980, 0xf980, 0x5442},
+	{0xf981, 0xf981, 0x5973}, {0xf982, 0xf982, 0x5eec}, {0xf983, 0xf983, 0x65c5},
+	{0xf984, 0xf984, 0x6ffe}, {0xf985, 0xf985, 0x792a}, {0xf986, 0xf986, 0x95ad},
+	{0xf987, 0xf987, 0x9a6a}, {0xf988, 0xf988, 0x9e97}, {0xf989, 0xf989, 0x9ece},
+	{0xf98a, 0xf98a, 0x529b}, {0xf98b, 0xf98b, 0x66c6}, {0xf98c, 0xf98c, 0x6b77},
+	{0xf98d, 0xf98d, 0x8f62}, {0xf98e, 0xf98e, 0x5e74}, {0xf98f, 0xf98f, 0x6190},
+	{0xf990, 0xf990, 0x6200}, {0xf991, 0xf991, 0x649a}, {0xf992, 0xf992, 0x6f23},
+	{0xf993, 0xf993, 0x7149}, {0xf994, 0xf994, 0x7489}, {0xf995, 0xf995, 0x79ca},
+	{0xf996, 0xf996, 0x7df4}, {0xf997, 0xf997, 0x806f}, {0xf998, 0xf998, 0x8f26},
+	{0xf999, 0xf999, 0x84ee}, {0xf99a, 0xf99a, 0x9023}, {0xf99b, 0xf99b, 0x934a},
+	{0xf99c, 0xf99c, 0x5217}, {0xf99d, 0xf99d, 0x52a3}, {0xf99e, 0xf99e, 0x54bd},
+	{0xf99f, 0xf99f, 0x70c8}, {0xf9a0, 0xf9a0, 0x88c2}, {0xf9a1, 0xf9a1, 0x8aaa},
+	{0xf9a2, 0xf9a2, 0x5ec9}, {0xf9a3, 0xf9a3, 0x5ff5}, {0xf9a4, 0xf9a4, 0x637b},
+	{0xf9a5, 0xf9a5, 0x6bae}, {0xf9a6, 0xf9a6, 0x7c3e}, {0xf9a7, 0xf9a7, 0x7375},
+	{0xf9a8, 0xf9a8, 0x4ee4}, {0xf9a9, 0xf9a9, 0x56f9}, {0xf9aa, 0xf9aa, 0x5be7},
+	{0xf9ab, 0xf9ab, 0x5dba}, {0xf9ac, 0xf9ac, 0x601c}, {0xf9ad, 0xf9ad, 0x73b2},
+	{0xf9ae, 0xf9ae, 0x7469}, {0xf9af, 0xf9af, 0x7f9a}, {0xf9b0, 0xf9b0, 0x8046},
+	{0xf9b1, 0xf9b1, 0x9234}, {0xf9b2, 0xf9b2, 0x96f6}, {0xf9b3, 0xf9b3, 0x9748},
+	{0xf9b4, 0xf9b4, 0x9818}, {0xf9b5, 0xf9b5, 0x4f8b}, {0xf9b6, 0xf9b6, 0x79ae},
+	{0xf9b7, 0xf9b7, 0x91b4}, {0xf9b8, 0xf9b8, 0x96b8}, {0xf9b9, 0xf9b9, 0x60e1},
+	{0xf9ba, 0xf9ba, 0x4e86}, {0xf9bb, 0xf9bb, 0x50da}, {0xf9bc, 0xf9bc, 0x5bee},
+	{0xf9bd, 0xf9bd, 0x5c3f}, {0xf9be, 0xf9be, 0x6599}, {0xf9bf, 0xf9bf, 0x6a02},
+	{0xf9c0, 0xf9c0, 0x71ce}, {0xf9c1, 0xf9c1, 0x7642}, {0xf9c2, 0xf9c2, 0x84fc},
+	{0xf9c3, 0xf9c3, 0x907c}, {0xf9c4, 0xf9c4, 0x9f8d}, {0xf9c5, 0xf9c5, 0x6688},
+	{0xf9c6, 0xf9c6, 0x962e}, {0xf9c7, 0xf9c7, 0x5289}, {0xf9c8, 0xf9c8, 0x677b},
+	{0xf9c9, 0xf9c9, 0x67f3}, {0xf9ca, 0xf9ca, 0x6d41}, {0xf9cb, 0xf9cb, 0x6e9c},
+	{0xf9cc, 0xf9cc, 0x7409}, {0xf9cd, 0xf9cd, 0x7559}, {0xf9ce, 0xf9ce, 0x786b},
+	{0xf9cf, 0xf9cf, 0x7d10}, {0xf9d0, 0xf9d0, 0x985e}, {0xf9d1, 0xf9d1, 0x516d},
+	{0xf9d2, 0xf9d2, 0x622e}, {0xf9d3, 0xf9d3, 0x9678}, {0xf9d4, 0xf9d4, 0x502b},
+	{0xf9d5, 0xf9d5, 0x5d19}, {0xf9d6, 0xf9d6, 0x6dea}, {0xf9d7, 0xf9d7, 0x8f2a},
+	{0xf9d8, 0xf9d8, 0x5f8b}, {0xf9d9, 0xf9d9, 0x6144}, {0xf9da, 0xf9da, 0x6817},
+	{0xf9db, 0xf9db, 0x7387}, {0xf9dc, 0xf9dc, 0x9686}, {0xf9dd, 0xf9dd, 0x5229},
+	{0xf9de, 0xf9de, 0x540f}, {0xf9df, 0xf9df, 0x5c65}, {0xf9e0, 0xf9e0, 0x6613},
+	{0xf9e1, 0xf9e1, 0x674e}, {0xf9e2, 0xf9e2, 0x68a8}, {0xf9e3, 0xf9e3, 0x6ce5},
+	{0xf9e4, 0xf9e4, 0x7406}, {0xf9e5, 0xf9e5, 0x75e2}, {0xf9e6, 0xf9e6, 0x7f79},
+	{0xf9e7, 0xf9e7, 0x88cf}, {0xf9e8, 0xf9e8, 0x88e1}, {0xf9e9, 0xf9e9, 0x91cc},
+	{0xf9ea, 0xf9ea, 0x96e2}, {0xf9eb, 0xf9eb, 0x533f}, {0xf9ec, 0xf9ec, 0x6eba},
+	{0xf9ed, 0xf9ed, 0x541d}, {0xf9ee, 0xf9ee, 0x71d0}, {0xf9ef, 0xf9ef, 0x7498},
+	{0xf9f0, 0xf9f0, 0x85fa}, {0xf9f1, 0xf9f1, 0x96a3}, {0xf9f2, 0xf9f2, 0x9c57},
+	{0xf9f3, 0xf9f3, 0x9e9f}, {0xf9f4, 0xf9f4, 0x6797}, {0xf9f5, 0xf9f5, 0x6dcb},
+	{0xf9f6, 0xf9f6, 0x81e8}, {0xf9f7, 0xf9f7, 0x7acb}, {0xf9f8, 0xf9f8, 0x7b20},
+	{0xf9f9, 0xf9f9, 0x7c92}, {0xf9fa, 0xf9fa, 0x72c0}, {0xf9fb, 0xf9fb, 0x7099},
+	{0xf9fc, 0xf9fc, 0x8b58}, {0xf9fd, 0xf9fd, 0x4ec0}, {0xf9fe, 0xf9fe, 0x8336},
+	{0xf9ff, 0xf9ff, 0x523a}, {0xfa00, 0xfa00, 0x5207}, {0xfa01, 0xfa01, 0x5ea6},
+	{0xfa02, 0xfa02, 0x62d3}, {0xfa03, 0xfa03, 0x7cd6}, {0xfa04, 0xfa04, 0x5b85},
+	{0xfa05, 0xfa05, 0x6d1e}, {0xfa06, 0xfa06, 0x66b4}, {0xfa07, 0xfa07, 0x8f3b},
+	{0xfa08, 0xfa08, 0x884c}, {0xfa09, 0xfa09, 0x964d}, {0xfa0a, 0xfa0a, 0x898b},
+	{0xfa0b, 0xfa0b, 0x5ed3}, {0xfa0c, 0xfa0c, 0x5140}, {0xfa0d, 0xfa0d, 0x55c0},
+	{0xfa10, 0xfa10, 0x585a}, {0xfa12, 0xfa12, 0x6674}, {0xfa15, 0xfa15, 0x51de},
+	{0xfa16, 0xfa16, 0x732a}, {0xfa17, 0xfa17, 0x76ca}, {0xfa18, 0xfa18, 0x793c},
+	{0xfa19, 0xfa19, 0x795e}, {0xfa1a, 0xfa1a, 0x7965}, {0xfa1b, 0xfa1b, 0x798f},
+	{0xfa1c, 0xfa1c, 0x9756}, {0xfa1d, 0xfa1d, 0x7cbe}, {0xfa1e, 0xfa1e, 0x7fbd},
+	{0xfa20, 0xfa20, 0x8612}, {0xfa22, 0xfa22, 0x8af8}, {0xfa25, 0xfa25, 0x9038},
+	{0xfa26, 0xfa26, 0x90fd}, {0xfa2a, 0xfa2a, 0x98ef}, {0xfa2b, 0xfa2b, 0x98fc},
+	{0xfa2c, 0xfa2c, 0x9928}, {0xfa2d, 0xfa2d, 0x9db4}, {0xfa2e, 0xfa2e, 0x90de},
+	{0xfa2f, 0xfa2f, 0x96b7}, {0xfa30, 0xfa30, 0x4fae}, {0xfa31, 0xfa31, 0x50e7},
+	{0xfa32, 0xfa32, 0x514d}, {0xfa33, 0xfa33, 0x52c9}, {0xfa34, 0xfa34, 0x52e4},
+	{0xfa35, 0xfa35, 0x5351}, {0xfa36, 0xfa36, 0x559d}, {0xfa37, 0xfa37, 0x5606},
+	{0xfa38, 0xfa38, 0x5668}, {0xfa39, 0xfa39, 0x5840}, {0xfa3a, 0xfa3a, 0x58a8},
+	{0xfa3b, 0xfa3b, 0x5c64}, {0xfa3c, 0xfa3c, 0x5c6e}, {0xfa3d, 0xfa3d, 0x6094},
+	{0xfa3e, 0xfa3e, 0x6168}, {0xfa3f, 0xfa3f, 0x618e}, {0xfa40, 0xfa40, 0x61f2},
+	{0xfa41, 0xfa41, 0x654f}, {0xfa42, 0xfa42, 0x65e2}, {0xfa43, 0xfa43, 0x6691},
+	{0xfa44, 0xfa44, 0x6885}, {0xfa45, 0xfa45, 0x6d77}, {0xfa46, 0xfa46, 0x6e1a},
+	{0xfa47, 0xfa47, 0x6f22}, {0xfa48, 0xfa48, 0x716e}, {0xfa49, 0xfa49, 0x722b},
+	{0xfa4a, 0xfa4a, 0x7422}, {0xfa4b, 0xfa4b, 0x7891}, {0xfa4c, 0xfa4c, 0x793e},
+	{0xfa4d, 0xfa4d, 0x7949}, {0xfa4e, 0xfa4e, 0x7948}, {0xfa4f, 0xfa4f, 0x7950},
+	{0xfa50, 0xfa50, 0x7956}, {0xfa51, 0xfa51, 0x795d}, {0xfa52, 0xfa53, 0x798d},
+	{0xfa54, 0xfa54, 0x7a40}, {0xfa55, 0xfa55, 0x7a81}, {0xfa56, 0xfa56, 0x7bc0},
+	{0xfa57, 0xfa57, 0x7df4}, {0xfa58, 0xfa58, 0x7e09}, {0xfa59, 0xfa59, 0x7e41},
+	{0xfa5a, 0xfa5a, 0x7f72}, {0xfa5b, 0xfa5b, 0x8005}, {0xfa5c, 0xfa5c, 0x81ed},
+	{0xfa5d, 0xfa5d, 0x8279}, {0xfa5e, 0xfa5e, 0x8279}, {0xfa5f, 0xfa5f, 0x8457},
+	{0xfa60, 0xfa60, 0x8910}, {0xfa61, 0xfa61, 0x8996}, {0xfa62, 0xfa62, 0x8b01},
+	{0xfa63, 0xfa63, 0x8b39}, {0xfa64, 0xfa64, 0x8cd3}, {0xfa65, 0xfa65, 0x8d08},
+	{0xfa66, 0xfa66, 0x8fb6}, {0xfa67, 0xfa67, 0x9038}, {0xfa68, 0xfa68, 0x96e3},
+	{0xfa69, 0xfa69, 0x97ff}, {0xfa6a, 0xfa6a, 0x983b}, {0xfa6b, 0xfa6b, 0x6075},
+	{0xfa6c, 0xfa6c, 0x242ee}, {0xfa6d, 0xfa6d, 0x8218}, {0xfa70, 0xfa70, 0x4e26},
+	{0xfa71, 0xfa71, 0x51b5}, {0xfa72, 0xfa72, 0x5168}, {0xfa73, 0xfa73, 0x4f80},
+	{0xfa74, 0xfa74, 0x5145}, {0xfa75, 0xfa75, 0x5180}, {0xfa76, 0xfa76, 0x52c7},
+	{0xfa77, 0xfa77, 0x52fa}, {0xfa78, 0xfa78, 0x559d}, {0xfa79, 0xfa79, 0x5555},
+	{0xfa7a, 0xfa7a, 0x5599}, {0xfa7b, 0xfa7b, 0x55e2}, {0xfa7c, 0xfa7c, 0x585a},
+	{0xfa7d, 0xfa7d, 0x58b3}, {0xfa7e, 0xfa7e, 0x5944}, {0xfa7f, 0xfa7f, 0x5954},
+	{0xfa80, 0xfa80, 0x5a62}, {0xfa81, 0xfa81, 0x5b28}, {0xfa82, 0xfa82, 0x5ed2},
+	{0xfa83, 0xfa83, 0x5ed9}, {0xfa84, 0xfa84, 0x5f69}, {0xfa85, 0xfa85, 0x5fad},
+	{0xfa86, 0xfa86, 0x60d8}, {0xfa87, 0xfa87, 0x614e}, {0xfa88, 0xfa88, 0x6108},
+	{0xfa89, 0xfa89, 0x618e}, {0xfa8a, 0xfa8a, 0x6160}, {0xfa8b, 0xfa8b, 0x61f2},
+	{0xfa8c, 0xfa8c, 0x6234}, {0xfa8d, 0xfa8d, 0x63c4}, {0xfa8e, 0xfa8e, 0x641c},
+	{0xfa8f, 0xfa8f, 0x6452}, {0xfa90, 0xfa90, 0x6556}, {0xfa91, 0xfa91, 0x6674},
+	{0xfa92, 0xfa92, 0x6717}, {0xfa93, 0xfa93, 0x671b}, {0xfa94, 0xfa94, 0x6756},
+	{0xfa95, 0xfa95, 0x6b79}, {0xfa96, 0xfa96, 0x6bba}, {0xfa97, 0xfa97, 0x6d41},
+	{0xfa98, 0xfa98, 0x6edb}, {0xfa99, 0xfa99, 0x6ecb}, {0xfa9a, 0xfa9a, 0x6f22},
+	{0xfa9b, 0xfa9b, 0x701e}, {0xfa9c, 0xfa9c, 0x716e}, {0xfa9d, 0xfa9d, 0x77a7},
+	{0xfa9e, 0xfa9e, 0x7235}, {0xfa9f, 0xfa9f, 0x72af}, {0xfaa0, 0xfaa0, 0x732a},
+	{0xfaa1, 0xfaa1, 0x7471}, {0xfaa2, 0xfaa2, 0x7506}, {0xfaa3, 0xfaa3, 0x753b},
+	{0xfaa4, 0xfaa4, 0x761d}, {0xfaa5, 0xfaa5, 0x761f}, {0xfaa6, 0xfaa6, 0x76ca},
+	{0xfaa7, 0xfaa7, 0x76db}, {0xfaa8, 0xfaa8, 0x76f4}, {0xfaa9, 0xfaa9, 0x774a},
+	{0xfaaa, 0xfaaa, 0x7740}, {0xfaab, 0xfaab, 0x78cc}, {0xfaac, 0xfaac, 0x7ab1},
+	{0xfaad, 0xfaad, 0x7bc0}, {0xfaae, 0xfaae, 0x7c7b}, {0xfaaf, 0xfaaf, 0x7d5b},
+	{0xfab0, 0xfab0, 0x7df4}, {0xfab1, 0xfab1, 0x7f3e}, {0xfab2, 0xfab2, 0x8005},
+	{0xfab3, 0xfab3, 0x8352}, {0xfab4, 0xfab4, 0x83ef}, {0xfab5, 0xfab5, 0x8779},
+	{0xfab6, 0xfab6, 0x8941}, {0xfab7, 0xfab7, 0x8986}, {0xfab8, 0xfab8, 0x8996},
+	{0xfab9, 0xfab9, 0x8abf}, {0xfaba, 0xfaba, 0x8af8}, {0xfabb, 0xfabb, 0x8acb},
+	{0xfabc, 0xfabc, 0x8b01}, {0xfabd, 0xfabd, 0x8afe}, {0xfabe, 0xfabe, 0x8aed},
+	{0xfabf, 0xfabf, 0x8b39}, {0xfac0, 0xfac0, 0x8b8a}, {0xfac1, 0xfac1, 0x8d08},
+	{0xfac2, 0xfac2, 0x8f38}, {0xfac3, 0xfac3, 0x9072}, {0xfac4, 0xfac4, 0x9199},
+	{0xfac5, 0xfac5, 0x9276}, {0xfac6, 0xfac6, 0x967c}, {0xfac7, 0xfac7, 0x96e3},
+	{0xfac8, 0xfac8, 0x9756}, {0xfac9, 0xfac9, 0x97db}, {0xfaca, 0xfaca, 0x97ff},
+	{0xfacb, 0xfacb, 0x980b}, {0xfacc, 0xfacc, 0x983b}, {0xfacd, 0xfacd, 0x9b12},
+	{0xface, 0xface, 0x9f9c}, {0xfacf, 0xfacf, 0x2284a}, {0xfad0, 0xfad0, 0x22844},
+	{0xfad1, 0xfad1, 0x233d5}, {0xfad2, 0xfad2, 0x3b9d}, {0xfad3, 0xfad3, 0x4018},
+	{0xfad4, 0xfad4, 0x4039}, {0xfad5, 0xfad5, 0x25249}, {0xfad6, 0xfad6, 0x25cd0},
+	{0xfad7, 0xfad7, 0x27ed3}, {0xfad8, 0xfad8, 0x9f43}, {0xfad9, 0xfad9, 0x9f8e},
+	{0xfb20, 0xfb20, 0x05e2}, {0xfb21, 0xfb21, 0x05d0}, {0xfb22, 0xfb23, 0x05d3},
+	{0xfb24, 0xfb26, 0x05db}, {0xfb27, 0xfb27, 0x05e8}, {0xfb28, 0xfb28, 0x05ea},
+	{0xfb29, 0xfb29, 0x002b}, {0xfb50, 0xfb50, 0x0671}, {0xfb51, 0xfb51, 0x0671},
+	{0xfb52, 0xfb52, 0x067b}, {0xfb53, 0xfb53, 0x067b}, {0xfb54, 0xfb54, 0x067b},
+	{0xfb55, 0xfb55, 0x067b}, {0xfb56, 0xfb56, 0x067e}, {0xfb57, 0xfb57, 0x067e},
+	{0xfb58, 0xfb58, 0x067e}, {0xfb59, 0xfb59, 0x067e}, {0xfb5a, 0xfb5a, 0x0680},
+	{0xfb5b, 0xfb5b, 0x0680}, {0xfb5c, 0xfb5c, 0x0680}, {0xfb5d, 0xfb5d, 0x0680},
+	{0xfb5e, 0xfb5e, 0x067a}, {0xfb5f, 0xfb5f, 0x067a}, {0xfb60, 0xfb60, 0x067a},
+	{0xfb61, 0xfb61, 0x067a}, {0xfb62, 0xfb62, 0x067f}, {0xfb63, 0xfb63, 0x067f},
+	{0xfb64, 0xfb64, 0x067f}, {0xfb65, 0xfb65, 0x067f}, {0xfb66, 0xfb66, 0x0679},
+	{0xfb67, 0xfb67, 0x0679}, {0xfb68, 0xfb68, 0x0679}, {0xfb69, 0xfb69, 0x0679},
+	{0xfb6a, 0xfb6a, 0x06a4}, {0xfb6b, 0xfb6b, 0x06a4}, {0xfb6c, 0xfb6c, 0x06a4},
+	{0xfb6d, 0xfb6d, 0x06a4}, {0xfb6e, 0xfb6e, 0x06a6}, {0xfb6f, 0xfb6f, 0x06a6},
+	{0xfb70, 0xfb70, 0x06a6}, {0xfb71, 0xfb71, 0x06a6}, {0xfb72, 0xfb72, 0x0684},
+	{0xfb73, 0xfb73, 0x0684}, {0xfb74, 0xfb74, 0x0684}, {0xfb75, 0xfb75, 0x0684},
+	{0xfb76, 0xfb76, 0x0683}, {0xfb77, 0xfb77, 0x0683}, {0xfb78, 0xfb78, 0x0683},
+	{0xfb79, 0xfb79, 0x0683}, {0xfb7a, 0xfb7a, 0x0686}, {0xfb7b, 0xfb7b, 0x0686},
+	{0xfb7c, 0xfb7c, 0x0686}, {0xfb7d, 0xfb7e, 0x0686}, {0xfb7f, 0xfb7f, 0x0687},
+	{0xfb80, 0xfb80, 0x0687}, {0xfb81, 0xfb81, 0x0687}, {0xfb82, 0xfb82, 0x068d},
+	{0xfb83, 0xfb83, 0x068d}, {0xfb84, 0xfb84, 0x068c}, {0xfb85, 0xfb85, 0x068c},
+	{0xfb86, 0xfb86, 0x068e}, {0xfb87, 0xfb87, 0x068e}, {0xfb88, 0xfb88, 0x0688},
+	{0xfb89, 0xfb89, 0x0688}, {0xfb8a, 0xfb8a, 0x0698}, {0xfb8b, 0xfb8b, 0x0698},
+	{0xfb8c, 0xfb8c, 0x0691}, {0xfb8d, 0xfb8d, 0x0691}, {0xfb8e, 0xfb8e, 0x06a9},
+	{0xfb8f, 0xfb8f, 0x06a9}, {0xfb90, 0xfb90, 0x06a9}, {0xfb91, 0xfb91, 0x06a9},
+	{0xfb92, 0xfb92, 0x06af}, {0xfb93, 0xfb93, 0x06af}, {0xfb94, 0xfb94, 0x06af},
+	{0xfb95, 0xfb95, 0x06af}, {0xfb96, 0xfb96, 0x06b3}, {0xfb97, 0xfb97, 0x06b3},
+	{0xfb98, 0xfb98, 0x06b3}, {0xfb99, 0xfb99, 0x06b3}, {0xfb9a, 0xfb9a, 0x06b1},
+	{0xfb9b, 0xfb9b, 0x06b1}, {0xfb9c, 0xfb9c, 0x06b1}, {0xfb9d, 0xfb9d, 0x06b1},
+	{0xfb9e, 0xfb9e, 0x06ba}, {0xfb9f, 0xfba0, 0x06ba}, {0xfba1, 0xfba1, 0x06bb},
+	{0xfba2, 0xfba2, 0x06bb}, {0xfba3, 0xfba3, 0x06bb}, {0xfba6, 0xfba6, 0x06c1},
+	{0xfba7, 0xfba7, 0x06c1}, {0xfba8, 0xfba8, 0x06c1}, {0xfba9, 0xfba9, 0x06c1},
+	{0xfbaa, 0xfbaa, 0x06be}, {0xfbab, 0xfbab, 0x06be}, {0xfbac, 0xfbac, 0x06be},
+	{0xfbad, 0xfbad, 0x06be}, {0xfbae, 0xfbae, 0x06d2}, {0xfbaf, 0xfbaf, 0x06d2},
+	{0xfbd3, 0xfbd3, 0x06ad}, {0xfbd4, 0xfbd4, 0x06ad}, {0xfbd5, 0xfbd5, 0x06ad},
+	{0xfbd6, 0xfbd6, 0x06ad}, {0xfbd7, 0xfbd7, 0x06c7}, {0xfbd8, 0xfbd8, 0x06c7},
+	{0xfbd9, 0xfbd9, 0x06c6}, {0xfbda, 0xfbda, 0x06c6}, {0xfbdb, 0xfbdb, 0x06c8},
+	{0xfbdc, 0xfbdc, 0x06c8}, {0xfbde, 0xfbde, 0x06cb}, {0xfbdf, 0xfbdf, 0x06cb},
+	{0xfbe0, 0xfbe0, 0x06c5}, {0xfbe1, 0xfbe1, 0x06c5}, {0xfbe2, 0xfbe2, 0x06c9},
+	{0xfbe3, 0xfbe3, 0x06c9}, {0xfbe4, 0xfbe4, 0x06d0}, {0xfbe5, 0xfbe5, 0x06d0},
+	{0xfbe6, 0xfbe6, 0x06d0}, {0xfbe7, 0xfbe7, 0x06d0}, {0xfbe8, 0xfbe8, 0x0649},
+	{0xfbe9, 0xfbe9, 0x0649}, {0xfbfc, 0xfbfc, 0x06cc}, {0xfbfd, 0xfbfd, 0x06cc},
+	{0xfbfe, 0xfbfe, 0x06cc}, {0xfbff, 0xfbff, 0x06cc}, {0xfe10, 0xfe10, 0x002c},
+	{0xfe11, 0xfe12, 0x3001}, {0xfe13, 0xfe14, 0x003a}, {0xfe15, 0xfe15, 0x0021},
+	{0xfe16, 0xfe16, 0x003f}, {0xfe17, 0xfe18, 0x3016}, {0xfe31, 0xfe31, 0x2014},
+	{0xfe32, 0xfe32, 0x2013}, {0xfe33, 0xfe33, 0x005f}, {0xfe34, 0xfe34, 0x005f},
+	{0xfe35, 0xfe36, 0x0028}, {0xfe37, 0xfe37, 0x007b}, {0xfe38, 0xfe38, 0x007d},
+	{0xfe39, 0xfe3a, 0x3014}, {0xfe3b, 0xfe3c, 0x3010}, {0xfe3d, 0xfe3e, 0x300a},
+	{0xfe3f, 0xfe40, 0x3008}, {0xfe41, 0xfe44, 0x300c}, {0xfe47, 0xfe47, 0x005b},
+	{0xfe48, 0xfe48, 0x005d}, {0xfe4d, 0xfe4d, 0x005f}, {0xfe4e, 0xfe4e, 0x005f},
+	{0xfe4f, 0xfe4f, 0x005f}, {0xfe50, 0xfe50, 0x002c}, {0xfe51, 0xfe51, 0x3001},
+	{0xfe52, 0xfe52, 0x002e}, {0xfe54, 0xfe54, 0x003b}, {0xfe55, 0xfe55, 0x003a},
+	{0xfe56, 0xfe56, 0x003f}, {0xfe57, 0xfe57, 0x0021}, {0xfe58, 0xfe58, 0x2014},
+	{0xfe59, 0xfe5a, 0x0028}, {0xfe5b, 0xfe5b, 0x007b}, {0xfe5c, 0xfe5c, 0x007d},
+	{0xfe5d, 0xfe5e, 0x3014}, {0xfe5f, 0xfe5f, 0x0023}, {0xfe60, 0xfe60, 0x0026},
+	{0xfe61, 0xfe62, 0x002a}, {0xfe63, 0xfe63, 0x002d}, {0xfe64, 0xfe64, 0x003c},
+	{0xfe65, 0xfe65, 0x003e}, {0xfe66, 0xfe66, 0x003d}, {0xfe68, 0xfe68, 0x005c},
+	{0xfe69, 0xfe6a, 0x0024}, {0xfe6b, 0xfe6b, 0x0040}, {0xfe80, 0xfe80, 0x0621},
+	{0xfe8d, 0xfe8d, 0x0627}, {0xfe8e, 0xfe8f, 0x0627}, {0xfe90, 0xfe90, 0x0628},
+	{0xfe91, 0xfe91, 0x0628}, {0xfe92, 0xfe93, 0x0628}, {0xfe94, 0xfe95, 0x0629},
+	{0xfe96, 0xfe96, 0x062a}, {0xfe97, 0xfe97, 0x062a}, {0xfe98, 0xfe99, 0x062a},
+	{0xfe9a, 0xfe9a, 0x062b}, {0xfe9b, 0xfe9b, 0x062b}, {0xfe9c, 0xfe9d, 0x062b},
+	{0xfe9e, 0xfe9e, 0x062c}, {0xfe9f, 0xfe9f, 0x062c}, {0xfea0, 0xfea1, 0x062c},
+	{0xfea2, 0xfea2, 0x062d}, {0xfea3, 0xfea3, 0x062d}, {0xfea4, 0xfea5, 0x062d},
+	{0xfea6, 0xfea6, 0x062e}, {0xfea7, 0xfea7, 0x062e}, {0xfea8, 0xfea9, 0x062e},
+	{0xfeaa, 0xfeab, 0x062f}, {0xfeac, 0xfead, 0x0630}, {0xfeae, 0xfeaf, 0x0631},
+	{0xfeb0, 0xfeb1, 0x0632}, {0xfeb2, 0xfeb2, 0x0633}, {0xfeb3, 0xfeb3, 0x0633},
+	{0xfeb4, 0xfeb5, 0x0633}, {0xfeb6, 0xfeb6, 0x0634}, {0xfeb7, 0xfeb7, 0x0634},
+	{0xfeb8, 0xfeb9, 0x0634}, {0xfeba, 0xfeba, 0x0635}, {0xfebb, 0xfebb, 0x0635},
+	{0xfebc, 0xfebd, 0x0635}, {0xfebe, 0xfebe, 0x0636}, {0xfebf, 0xfebf, 0x0636},
+	{0xfec0, 0xfec1, 0x0636}, {0xfec2, 0xfec2, 0x0637}, {0xfec3, 0xfec3, 0x0637},
+	{0xfec4, 0xfec5, 0x0637}, {0xfec6, 0xfec6, 0x0638}, {0xfec7, 0xfec7, 0x0638},
+	{0xfec8, 0xfec9, 0x0638}, {0xfeca, 0xfeca, 0x0639}, {0xfecb, 0xfecb, 0x0639},
+	{0xfecc, 0xfecd, 0x0639}, {0xfece, 0xfece, 0x063a}, {0xfecf, 0xfecf, 0x063a},
+	{0xfed0, 0xfed0, 0x063a}, {0xfed1, 0xfed1, 0x0641}, {0xfed2, 0xfed2, 0x0641},
+	{0xfed3, 0xfed3, 0x0641}, {0xfed4, 0xfed5, 0x0641}, {0xfed6, 0xfed6, 0x0642},
+	{0xfed7, 0xfed7, 0x0642}, {0xfed8, 0xfed9, 0x0642}, {0xfeda, 0xfeda, 0x0643},
+	{0xfedb, 0xfedb, 0x0643}, {0xfedc, 0xfedd, 0x0643}, {0xfede, 0xfede, 0x0644},
+	{0xfedf, 0xfedf, 0x0644}, {0xfee0, 0xfee1, 0x0644}, {0xfee2, 0xfee2, 0x0645},
+	{0xfee3, 0xfee3, 0x0645}, {0xfee4, 0xfee5, 0x0645}, {0xfee6, 0xfee6, 0x0646},
+	{0xfee7, 0xfee7, 0x0646}, {0xfee8, 0xfee9, 0x0646}, {0xfeea, 0xfeea, 0x0647},
+	{0xfeeb, 0xfeeb, 0x0647}, {0xfeec, 0xfeed, 0x0647}, {0xfeee, 0xfeef, 0x0648},
+	{0xfef0, 0xfef1, 0x0649}, {0xfef2, 0xfef2, 0x064a}, {0xfef3, 0xfef3, 0x064a},
+	{0xfef4, 0xfef4, 0x064a}, {0xff01, 0xff5e, 0x0021}, {0xff5f, 0xff60, 0x2985},
+	{0xff61, 0xff61, 0x3002}, {0xff62, 0xff63, 0x300c}, {0xff64, 0xff64, 0x3001},
+	{0xff65, 0xff65, 0x30fb}, {0xff66, 0xff66, 0x30f2}, {0xff67, 0xff67, 0x30a1},
+	{0xff68, 0xff68, 0x30a3}, {0xff69, 0xff69, 0x30a5}, {0xff6a, 0xff6a, 0x30a7},
+	{0xff6b, 0xff6b, 0x30a9}, {0xff6c, 0xff6c, 0x30e3}, {0xff6d, 0xff6d, 0x30e5},
+	{0xff6e, 0xff6e, 0x30e7}, {0xff6f, 0xff6f, 0x30c3}, {0xff70, 0xff70, 0x30fc},
+	{0xff71, 0xff71, 0x30a2}, {0xff72, 0xff72, 0x30a4}, {0xff73, 0xff73, 0x30a6},
+	{0xff74, 0xff74, 0x30a8}, {0xff75, 0xff76, 0x30aa}, {0xff77, 0xff77, 0x30ad},
+	{0xff78, 0xff78, 0x30af}, {0xff79, 0xff79, 0x30b1}, {0xff7a, 0xff7a, 0x30b3},
+	{0xff7b, 0xff7b, 0x30b5}, {0xff7c, 0xff7c, 0x30b7}, {0xff7d, 0xff7d, 0x30b9},
+	{0xff7e, 0xff7e, 0x30bb}, {0xff7f, 0xff7f, 0x30bd}, {0xff80, 0xff80, 0x30bf},
+	{0xff81, 0xff81, 0x30c1}, {0xff82, 0xff82, 0x30c4}, {0xff83, 0xff83, 0x30c6},
+	{0xff84, 0xff84, 0x30c8}, {0xff85, 0xff8a, 0x30ca}, {0xff8b, 0xff8b, 0x30d2},
+	{0xff8c, 0xff8c, 0x30d5}, {0xff8d, 0xff8d, 0x30d8}, {0xff8e, 0xff8e, 0x30db},
+	{0xff8f, 0xff93, 0x30de}, {0xff94, 0xff94, 0x30e4}, {0xff95, 0xff95, 0x30e6},
+	{0xff96, 0xff9b, 0x30e8}, {0xff9c, 0xff9c, 0x30ef}, {0xff9d, 0xff9d, 0x30f3},
+	{0xff9e, 0xff9f, 0x3099}, {0xffa0, 0xffa0, 0x1160}, {0xffa1, 0xffa2, 0x1100},
+	{0xffa3, 0xffa3, 0x11aa}, {0xffa4, 0xffa4, 0x1102}, {0xffa5, 0xffa6, 0x11ac},
+	{0xffa7, 0xffa9, 0x1103}, {0xffaa, 0xffaf, 0x11b0}, {0xffb0, 0xffb0, 0x111a},
+	{0xffb1, 0xffb3, 0x1106}, {0xffb4, 0xffb4, 0x1121}, {0xffb5, 0xffbe, 0x1109},
+	{0xffc2, 0xffc7, 0x1161}, {0xffca, 0xffcf, 0x1167}, {0xffd2, 0xffd7, 0x116d},
+	{0xffda, 0xffdc, 0x1173}, {0xffe0, 0xffe1, 0x00a2}, {0xffe2, 0xffe2, 0x00ac},
+	{0xffe4, 0xffe4, 0x00a6}, {0xffe5, 0xffe5, 0x00a5}, {0xffe6, 0xffe6, 0x20a9},
+	{0xffe8, 0xffe8, 0x2502}, {0xffe9, 0xffec, 0x2190}, {0xffed, 0xffed, 0x25a0},
+	{0xffee, 0xffee, 0x25cb}, {0x10781, 0x10782, 0x02d0}, {0x10783, 0x10783, 0x00e6},
+	{0x10784, 0x10784, 0x0299}, {0x10785, 0x10785, 0x0253}, {0x10787, 0x10787, 0x02a3},
+	{0x10788, 0x10788, 0xab66}, {0x10789, 0x10789, 0x02a5}, {0x1078a, 0x1078a, 0x02a4},
+	{0x1078b, 0x1078c, 0x0256}, {0x1078d, 0x1078d, 0x1d91}, {0x1078e, 0x1078e, 0x0258},
+	{0x1078f, 0x1078f, 0x025e}, {0x10790, 0x10790, 0x02a9}, {0x10791, 0x10791, 0x0264},
+	{0x10792, 0x10792, 0x0262}, {0x10793, 0x10793, 0x0260}, {0x10794, 0x10794, 0x029b},
+	{0x10795, 0x10795, 0x0127}, {0x10796, 0x10796, 0x029c}, {0x10797, 0x10797, 0x0267},
+	{0x10798, 0x10798, 0x0284}, {0x10799, 0x1079a, 0x02aa}, {0x1079b, 0x1079b, 0x026c},
+	{0x1079c, 0x1079c, 0x1df04}, {0x1079d, 0x1079d, 0xa78e}, {0x1079e, 0x1079e, 0x026e},
+	{0x1079f, 0x1079f, 0x1df05}, {0x107a0, 0x107a0, 0x028e}, {0x107a1, 0x107a1, 0x1df06},
+	{0x107a2, 0x107a2, 0x00f8}, {0x107a3, 0x107a4, 0x0276}, {0x107a5, 0x107a5, 0x0071},
+	{0x107a6, 0x107a6, 0x027a}, {0x107a7, 0x107a7, 0x1df08}, {0x107a8, 0x107a9, 0x027d},
+	{0x107aa, 0x107aa, 0x0280}, {0x107ab, 0x107ab, 0x02a8}, {0x107ac, 0x107ac, 0x02a6},
+	{0x107ad, 0x107ad, 0xab67}, {0x107ae, 0x107ae, 0x02a7}, {0x107af, 0x107af, 0x0288},
+	{0x107b0, 0x107b0, 0x2c71}, {0x107b2, 0x107b2, 0x028f}, {0x107b3, 0x107b4, 0x02a1},
+	{0x107b5, 0x107b5, 0x0298}, {0x107b6, 0x107b8, 0x01c0}, {0x107b9, 0x107b9, 0x1df0a},
+	{0x107ba, 0x107ba, 0x1df1e}, {0x1d400, 0x1d419, 0x0041}, {0x1d41a, 0x1d433, 0x0061},
+	{0x1d434, 0x1d44d, 0x0041}, {0x1d44e, 0x1d454, 0x0061}, {0x1d456, 0x1d467, 0x0069},
+	{0x1d468, 0x1d481, 0x0041}, {0x1d482, 0x1d49b, 0x0061}, {0x1d49c, 0x1d49c, 0x0041},
+	{0x1d49e, 0x1d49f, 0x0043}, {0x1d4a2, 0x1d4a2, 0x0047}, {0x1d4a5, 0x1d4a6, 0x004a},
+	{0x1d4a9, 0x1d4ac, 0x004e}, {0x1d4ae, 0x1d4b5, 0x0053}, {0x1d4b6, 0x1d4b9, 0x0061},
+	{0x1d4bb, 0x1d4bb, 0x0066}, {0x1d4bd, 0x1d4c3, 0x0068}, {0x1d4c5, 0x1d4cf, 0x0070},
+	{0x1d4d0, 0x1d4e9, 0x0041}, {0x1d4ea, 0x1d503, 0x0061}, {0x1d504, 0x1d505, 0x0041},
+	{0x1d507, 0x1d50a, 0x0044}, {0x1d50d, 0x1d514, 0x004a}, {0x1d516, 0x1d51c, 0x0053},
+	{0x1d51e, 0x1d537, 0x0061}, {0x1d538, 0x1d539, 0x0041}, {0x1d53b, 0x1d53e, 0x0044},
+	{0x1d540, 0x1d544, 0x0049}, {0x1d546, 0x1d546, 0x004f}, {0x1d54a, 0x1d550, 0x0053},
+	{0x1d552, 0x1d56b, 0x0061}, {0x1d56c, 0x1d585, 0x0041}, {0x1d586, 0x1d59f, 0x0061},
+	{0x1d5a0, 0x1d5b9, 0x0041}, {0x1d5ba, 0x1d5d3, 0x0061}, {0x1d5d4, 0x1d5ed, 0x0041},
+	{0x1d5ee, 0x1d607, 0x0061}, {0x1d608, 0x1d621, 0x0041}, {0x1d622, 0x1d63b, 0x0061},
+	{0x1d63c, 0x1d655, 0x0041}, {0x1d656, 0x1d66f, 0x0061}, {0x1d670, 0x1d689, 0x0041},
+	{0x1d68a, 0x1d6a3, 0x0061}, {0x1d6a4, 0x1d6a4, 0x0131}, {0x1d6a5, 0x1d6a5, 0x0237},
+	{0x1d6a8, 0x1d6b8, 0x0391}, {0x1d6b9, 0x1d6b9, 0x0398}, {0x1d6ba, 0x1d6c0, 0x03a3},
+	{0x1d6c1, 0x1d6c1, 0x2207}, {0x1d6c2, 0x1d6da, 0x03b1}, {0x1d6db, 0x1d6db, 0x2202},
+	{0x1d6dc, 0x1d6dc, 0x03b5}, {0x1d6dd, 0x1d6dd, 0x03b8}, {0x1d6de, 0x1d6de, 0x03ba},
+	{0x1d6df, 0x1d6df, 0x03c6}, {0x1d6e0, 0x1d6e0, 0x03c1}, {0x1d6e1, 0x1d6e1, 0x03c0},
+	{0x1d6e2, 0x1d6f2, 0x0391}, {0x1d6f3, 0x1d6f3, 0x0398}, {0x1d6f4, 0x1d6fa, 0x03a3},
+	{0x1d6fb, 0x1d6fb, 0x2207}, {0x1d6fc, 0x1d714, 0x03b1}, {0x1d715, 0x1d715, 0x2202},
+	{0x1d716, 0x1d716, 0x03b5}, {0x1d717, 0x1d717, 0x03b8}, {0x1d718, 0x1d718, 0x03ba},
+	{0x1d719, 0x1d719, 0x03c6}, {0x1d71a, 0x1d71a, 0x03c1}, {0x1d71b, 0x1d71b, 0x03c0},
+	{0x1d71c, 0x1d72c, 0x0391}, {0x1d72d, 0x1d72d, 0x0398}, {0x1d72e, 0x1d734, 0x03a3},
+	{0x1d735, 0x1d735, 0x2207}, {0x1d736, 0x1d74e, 0x03b1}, {0x1d74f, 0x1d74f, 0x2202},
+	{0x1d750, 0x1d750, 0x03b5}, {0x1d751, 0x1d751, 0x03b8}, {0x1d752, 0x1d752, 0x03ba},
+	{0x1d753, 0x1d753, 0x03c6}, {0x1d754, 0x1d754, 0x03c1}, {0x1d755, 0x1d755, 0x03c0},
+	{0x1d756, 0x1d766, 0x0391}, {0x1d767, 0x1d767, 0x0398}, {0x1d768, 0x1d76e, 0x03a3},
+	{0x1d76f, 0x1d76f, 0x2207}, {0x1d770, 0x1d788, 0x03b1}, {0x1d789, 0x1d789, 0x2202},
+	{0x1d78a, 0x1d78a, 0x03b5}, {0x1d78b, 0x1d78b, 0x03b8}, {0x1d78c, 0x1d78c, 0x03ba},
+	{0x1d78d, 0x1d78d, 0x03c6}, {0x1d78e, 0x1d78e, 0x03c1}, {0x1d78f, 0x1d78f, 0x03c0},
+	{0x1d790, 0x1d7a0, 0x0391}, {0x1d7a1, 0x1d7a1, 0x0398}, {0x1d7a2, 0x1d7a8, 0x03a3},
+	{0x1d7a9, 0x1d7a9, 0x2207}, {0x1d7aa, 0x1d7c2, 0x03b1}, {0x1d7c3, 0x1d7c3, 0x2202},
+	{0x1d7c4, 0x1d7c4, 0x03b5}, {0x1d7c5, 0x1d7c5, 0x03b8}, {0x1d7c6, 0x1d7c6, 0x03ba},
+	{0x1d7c7, 0x1d7c7, 0x03c6}, {0x1d7c8, 0x1d7c8, 0x03c1}, {0x1d7c9, 0x1d7c9, 0x03c0},
+	{0x1d7ca, 0x1d7cb, 0x03dc}, {0x1d7ce, 0x1d7d7, 0x0030}, {0x1d7d8, 0x1d7e1, 0x0030},
+	{0x1d7e2, 0x1d7eb, 0x0030}, {0x1d7ec, 0x1d7f5, 0x0030}, {0x1d7f6, 0x1d7ff, 0x0030},
+	{0x1ee00, 0x1ee01, 0x0627}, {0x1ee02, 0x1ee02, 0x062c}, {0x1ee03, 0x1ee03, 0x062f},
+	{0x1ee05, 0x1ee05, 0x0648}, {0x1ee06, 0x1ee06, 0x0632}, {0x1ee07, 0x1ee07, 0x062d},
+	{0x1ee08, 0x1ee08, 0x0637}, {0x1ee09, 0x1ee09, 0x064a}, {0x1ee0a, 0x1ee0d, 0x0643},
+	{0x1ee0e, 0x1ee0e, 0x0633}, {0x1ee0f, 0x1ee0f, 0x0639}, {0x1ee10, 0x1ee10, 0x0641},
+	{0x1ee11, 0x1ee11, 0x0635}, {0x1ee12, 0x1ee12, 0x0642}, {0x1ee13, 0x1ee13, 0x0631},
+	{0x1ee14, 0x1ee14, 0x0634}, {0x1ee15, 0x1ee16, 0x062a}, {0x1ee17, 0x1ee17, 0x062e},
+	{0x1ee18, 0x1ee18, 0x0630}, {0x1ee19, 0x1ee19, 0x0636}, {0x1ee1a, 0x1ee1a, 0x0638},
+	{0x1ee1b, 0x1ee1b, 0x063a}, {0x1ee1c, 0x1ee1c, 0x066e}, {0x1ee1d, 0x1ee1d, 0x06ba},
+	{0x1ee1e, 0x1ee1e, 0x06a1}, {0x1ee1f, 0x1ee1f, 0x066f}, {0x1ee21, 0x1ee21, 0x0628},
+	{0x1ee22, 0x1ee22, 0x062c}, {0x1ee24, 0x1ee24, 0x0647}, {0x1ee27, 0x1ee27, 0x062d},
+	{0x1ee29, 0x1ee29, 0x064a}, {0x1ee2a, 0x1ee2d, 0x0643}, {0x1ee2e, 0x1ee2e, 0x0633},
+	{0x1ee2f, 0x1ee2f, 0x0639}, {0x1ee30, 0x1ee30, 0x0641}, {0x1ee31, 0x1ee31, 0x0635},
+	{0x1ee32, 0x1ee32, 0x0642}, {0x1ee34, 0x1ee34, 0x0634}, {0x1ee35, 0x1ee36, 0x062a},
+	{0x1ee37, 0x1ee37, 0x062e}, {0x1ee39, 0x1ee39, 0x0636}, {0x1ee3b, 0x1ee3b, 0x063a},
+	{0x1ee42, 0x1ee42, 0x062c}, {0x1ee47, 0x1ee47, 0x062d}, {0x1ee49, 0x1ee49, 0x064a},
+	{0x1ee4b, 0x1ee4b, 0x0644}, {0x1ee4d, 0x1ee4d, 0x0646}, {0x1ee4e, 0x1ee4e, 0x0633},
+	{0x1ee4f, 0x1ee4f, 0x0639}, {0x1ee51, 0x1ee51, 0x0635}, {0x1ee52, 0x1ee52, 0x0642},
+	{0x1ee54, 0x1ee54, 0x0634}, {0x1ee57, 0x1ee57, 0x062e}, {0x1ee59, 0x1ee59, 0x0636},
+	{0x1ee5b, 0x1ee5b, 0x063a}, {0x1ee5d, 0x1ee5d, 0x06ba}, {0x1ee5f, 0x1ee5f, 0x066f},
+	{0x1ee61, 0x1ee61, 0x0628}, {0x1ee62, 0x1ee62, 0x062c}, {0x1ee64, 0x1ee64, 0x0647},
+	{0x1ee67, 0x1ee67, 0x062d}, {0x1ee68, 0x1ee68, 0x0637}, {0x1ee69, 0x1ee69, 0x064a},
+	{0x1ee6a, 0x1ee6a, 0x0643}, {0x1ee6c, 0x1ee6d, 0x0645}, {0x1ee6e, 0x1ee6e, 0x0633},
+	{0x1ee6f, 0x1ee6f, 0x0639}, {0x1ee70, 0x1ee70, 0x0641}, {0x1ee71, 0x1ee71, 0x0635},
+	{0x1ee72, 0x1ee72, 0x0642}, {0x1ee74, 0x1ee74, 0x0634}, {0x1ee75, 0x1ee76, 0x062a},
+	{0x1ee77, 0x1ee77, 0x062e}, {0x1ee79, 0x1ee79, 0x0636}, {0x1ee7a, 0x1ee7a, 0x0638},
+	{0x1ee7b, 0x1ee7b, 0x063a}, {0x1ee7c, 0x1ee7c, 0x066e}, {0x1ee7e, 0x1ee7e, 0x06a1},
+	{0x1ee80, 0x1ee81, 0x0627}, {0x1ee82, 0x1ee82, 0x062c}, {0x1ee83, 0x1ee83, 0x062f},
+	{0x1ee84, 0x1ee85, 0x0647}, {0x1ee86, 0x1ee86, 0x0632}, {0x1ee87, 0x1ee87, 0x062d},
+	{0x1ee88, 0x1ee88, 0x0637}, {0x1ee89, 0x1ee89, 0x064a}, {0x1ee8b, 0x1ee8d, 0x0644},
+	{0x1ee8e, 0x1ee8e, 0x0633}, {0x1ee8f, 0x1ee8f, 0x0639}, {0x1ee90, 0x1ee90, 0x0641},
+	{0x1ee91, 0x1ee91, 0x0635}, {0x1ee92, 0x1ee92, 0x0642}, {0x1ee93, 0x1ee93, 0x0631},
+	{0x1ee94, 0x1ee94, 0x0634}, {0x1ee95, 0x1ee96, 0x062a}, {0x1ee97, 0x1ee97, 0x062e},
+	{0x1ee98, 0x1ee98, 0x0630}, {0x1ee99, 0x1ee99, 0x0636}, {0x1ee9a, 0x1ee9a, 0x0638},
+	{0x1ee9b, 0x1ee9b, 0x063a}, {0x1eea1, 0x1eea1, 0x0628}, {0x1eea2, 0x1eea2, 0x062c},
+	{0x1eea3, 0x1eea3, 0x062f}, {0x1eea5, 0x1eea5, 0x0648}, {0x1eea6, 0x1eea6, 0x0632},
+	{0x1eea7, 0x1eea7, 0x062d}, {0x1eea8, 0x1eea8, 0x0637}, {0x1eea9, 0x1eea9, 0x064a},
+	{0x1eeab, 0x1eead, 0x0644}, {0x1eeae, 0x1eeae, 0x0633}, {0x1eeaf, 0x1eeaf, 0x0639},
+	{0x1eeb0, 0x1eeb0, 0x0641}, {0x1eeb1, 0x1eeb1, 0x0635}, {0x1eeb2, 0x1eeb2, 0x0642},
+	{0x1eeb3, 0x1eeb3, 0x0631}, {0x1eeb4, 0x1eeb4, 0x0634}, {0x1eeb5, 0x1eeb6, 0x062a},
+	{0x1eeb7, 0x1eeb7, 0x062e}, {0x1eeb8, 0x1eeb8, 0x0630}, {0x1eeb9, 0x1eeb9, 0x0636},
+	{0x1eeba, 0x1eeba, 0x0638}, {0x1eebb, 0x1eebb, 0x063a}, {0x1f12b, 0x1f12b, 0x0043},
+	{0x1f12c, 0x1f12c, 0x0052}, {0x1f130, 0x1f149, 0x0041}, {0x1f202, 0x1f202, 0x30b5},
+	{0x1f210, 0x1f210, 0x624b}, {0x1f211, 0x1f211, 0x5b57}, {0x1f212, 0x1f212, 0x53cc},
+	{0x1f214, 0x1f214, 0x4e8c}, {0x1f215, 0x1f215, 0x591a}, {0x1f216, 0x1f216, 0x89e3},
+	{0x1f217, 0x1f217, 0x5929}, {0x1f218, 0x1f218, 0x4ea4}, {0x1f219, 0x1f219, 0x6620},
+	{0x1f21a, 0x1f21a, 0x7121}, {0x1f21b, 0x1f21b, 0x6599}, {0x1f21c, 0x1f21c, 0x524d},
+	{0x1f21d, 0x1f21d, 0x5f8c}, {0x1f21e, 0x1f21e, 0x518d}, {0x1f21f, 0x1f21f, 0x65b0},
+	{0x1f220, 0x1f220, 0x521d}, {0x1f221, 0x1f221, 0x7d42}, {0x1f222, 0x1f222, 0x751f},
+	{0x1f223, 0x1f223, 0x8ca9}, {0x1f224, 0x1f224, 0x58f0}, {0x1f225, 0x1f225, 0x5439},
+	{0x1f226, 0x1f226, 0x6f14}, {0x1f227, 0x1f227, 0x6295}, {0x1f228, 0x1f228, 0x6355},
+	{0x1f229, 0x1f229, 0x4e00}, {0x1f22a, 0x1f22a, 0x4e09}, {0x1f22b, 0x1f22b, 0x904a},
+	{0x1f22c, 0x1f22c, 0x5de6}, {0x1f22d, 0x1f22d, 0x4e2d}, {0x1f22e, 0x1f22e, 0x53f3},
+	{0x1f22f, 0x1f22f, 0x6307}, {0x1f230, 0x1f230, 0x8d70}, {0x1f231, 0x1f231, 0x6253},
+	{0x1f232, 0x1f232, 0x7981}, {0x1f233, 0x1f233, 0x7a7a}, {0x1f234, 0x1f234, 0x5408},
+	{0x1f235, 0x1f235, 0x6e80}, {0x1f236, 0x1f236, 0x6709}, {0x1f237, 0x1f237, 0x6708},
+	{0x1f238, 0x1f238, 0x7533}, {0x1f239, 0x1f239, 0x5272}, {0x1f23a, 0x1f23a, 0x55b6},
+	{0x1f23b, 0x1f23b, 0x914d}, {0x1f250, 0x1f250, 0x5f97}, {0x1f251, 0x1f251, 0x53ef},
+	{0x1fbf0, 0x1fbf9, 0x0030}, {0x2f800, 0x2f800, 0x4e3d}, {0x2f801, 0x2f801, 0x4e38},
+	{0x2f802, 0x2f802, 0x4e41}, {0x2f803, 0x2f803, 0x20122}, {0x2f804, 0x2f804, 0x4f60},
+	{0x2f805, 0x2f805, 0x4fae}, {0x2f806, 0x2f806, 0x4fbb}, {0x2f807, 0x2f807, 0x5002},
+	{0x2f808, 0x2f808, 0x507a}, {0x2f809, 0x2f809, 0x5099}, {0x2f80a, 0x2f80a, 0x50e7},
+	{0x2f80b, 0x2f80b, 0x50cf}, {0x2f80c, 0x2f80c, 0x349e}, {0x2f80d, 0x2f80d, 0x2063a},
+	{0x2f80e, 0x2f80e, 0x514d}, {0x2f80f, 0x2f80f, 0x5154}, {0x2f810, 0x2f810, 0x5164},
+	{0x2f811, 0x2f811, 0x5177}, {0x2f812, 0x2f812, 0x2051c}, {0x2f813, 0x2f813, 0x34b9},
+	{0x2f814, 0x2f814, 0x5167}, {0x2f815, 0x2f815, 0x518d}, {0x2f816, 0x2f816, 0x2054b},
+	{0x2f817, 0x2f817, 0x5197}, {0x2f818, 0x2f818, 0x51a4}, {0x2f819, 0x2f819, 0x4ecc},
+	{0x2f81a, 0x2f81a, 0x51ac}, {0x2f81b, 0x2f81b, 0x51b5}, {0x2f81c, 0x2f81c, 0x291df},
+	{0x2f81d, 0x2f81d, 0x51f5}, {0x2f81e, 0x2f81e, 0x5203}, {0x2f81f, 0x2f81f, 0x34df},
+	{0x2f820, 0x2f820, 0x523b}, {0x2f821, 0x2f821, 0x5246}, {0x2f822, 0x2f822, 0x5272},
+	{0x2f823, 0x2f823, 0x5277}, {0x2f824, 0x2f824, 0x3515}, {0x2f825, 0x2f825, 0x52c7},
+	{0x2f826, 0x2f826, 0x52c9}, {0x2f827, 0x2f827, 0x52e4}, {0x2f828, 0x2f828, 0x52fa},
+	{0x2f829, 0x2f82a, 0x5305}, {0x2f82b, 0x2f82b, 0x5317}, {0x2f82c, 0x2f82c, 0x5349},
+	{0x2f82d, 0x2f82d, 0x5351}, {0x2f82e, 0x2f82e, 0x535a}, {0x2f82f, 0x2f82f, 0x5373},
+	{0x2f830, 0x2f830, 0x537d}, {0x2f831, 0x2f831, 0x537f}, {0x2f832, 0x2f832, 0x537f},
+	{0x2f833, 0x2f833, 0x537f}, {0x2f834, 0x2f834, 0x20a2c}, {0x2f835, 0x2f835, 0x7070},
+	{0x2f836, 0x2f836, 0x53ca}, {0x2f837, 0x2f837, 0x53df}, {0x2f838, 0x2f838, 0x20b63},
+	{0x2f839, 0x2f839, 0x53eb}, {0x2f83a, 0x2f83a, 0x53f1}, {0x2f83b, 0x2f83b, 0x5406},
+	{0x2f83c, 0x2f83c, 0x549e}, {0x2f83d, 0x2f83d, 0x5438}, {0x2f83e, 0x2f83e, 0x5448},
+	{0x2f83f, 0x2f83f, 0x5468}, {0x2f840, 0x2f840, 0x54a2}, {0x2f841, 0x2f841, 0x54f6},
+	{0x2f842, 0x2f842, 0x5510}, {0x2f843, 0x2f843, 0x5553}, {0x2f844, 0x2f844, 0x5563},
+	{0x2f845, 0x2f845, 0x5584}, {0x2f846, 0x2f846, 0x5584}, {0x2f847, 0x2f847, 0x5599},
+	{0x2f848, 0x2f848, 0x55ab}, {0x2f849, 0x2f849, 0x55b3}, {0x2f84a, 0x2f84a, 0x55c2},
+	{0x2f84b, 0x2f84b, 0x5716}, {0x2f84c, 0x2f84c, 0x5606}, {0x2f84d, 0x2f84d, 0x5717},
+	{0x2f84e, 0x2f84e, 0x5651}, {0x2f84f, 0x2f84f, 0x5674}, {0x2f850, 0x2f850, 0x5207},
+	{0x2f851, 0x2f851, 0x58ee}, {0x2f852, 0x2f852, 0x57ce}, {0x2f853, 0x2f853, 0x57f4},
+	{0x2f854, 0x2f854, 0x580d}, {0x2f855, 0x2f855, 0x578b}, {0x2f856, 0x2f856, 0x5832},
+	{0x2f857, 0x2f857, 0x5831}, {0x2f858, 0x2f858, 0x58ac}, {0x2f859, 0x2f859, 0x214e4},
+	{0x2f85a, 0x2f85a, 0x58f2}, {0x2f85b, 0x2f85b, 0x58f7}, {0x2f85c, 0x2f85c, 0x5906},
+	{0x2f85d, 0x2f85d, 0x591a}, {0x2f85e, 0x2f85e, 0x5922}, {0x2f85f, 0x2f85f, 0x5962},
+	{0x2f860, 0x2f860, 0x216a8}, {0x2f861, 0x2f861, 0x216ea}, {0x2f862, 0x2f862, 0x59ec},
+	{0x2f863, 0x2f863, 0x5a1b}, {0x2f864, 0x2f864, 0x5a27}, {0x2f865, 0x2f865, 0x59d8},
+	{0x2f866, 0x2f866, 0x5a66}, {0x2f867, 0x2f867, 0x36ee}, {0x2f868, 0x2f868, 0x36fc},
+	{0x2f869, 0x2f869, 0x5b08}, {0x2f86a, 0x2f86a, 0x5b3e}, {0x2f86b, 0x2f86b, 0x5b3e},
+	{0x2f86c, 0x2f86c, 0x219c8}, {0x2f86d, 0x2f86d, 0x5bc3}, {0x2f86e, 0x2f86e, 0x5bd8},
+	{0x2f86f, 0x2f86f, 0x5be7}, {0x2f870, 0x2f870, 0x5bf3}, {0x2f871, 0x2f871, 0x21b18},
+	{0x2f872, 0x2f872, 0x5bff}, {0x2f873, 0x2f873, 0x5c06}, {0x2f874, 0x2f874, 0x5f53},
+	{0x2f875, 0x2f875, 0x5c22}, {0x2f876, 0x2f876, 0x3781}, {0x2f877, 0x2f877, 0x5c60},
+	{0x2f878, 0x2f878, 0x5c6e}, {0x2f879, 0x2f879, 0x5cc0}, {0x2f87a, 0x2f87a, 0x5c8d},
+	{0x2f87b, 0x2f87b, 0x21de4}, {0x2f87c, 0x2f87c, 0x5d43}, {0x2f87d, 0x2f87d, 0x21de6},
+	{0x2f87e, 0x2f87e, 0x5d6e}, {0x2f87f, 0x2f87f, 0x5d6b}, {0x2f880, 0x2f880, 0x5d7c},
+	{0x2f881, 0x2f882, 0x5de1}, {0x2f883, 0x2f883, 0x382f}, {0x2f884, 0x2f884, 0x5dfd},
+	{0x2f885, 0x2f885, 0x5e28}, {0x2f886, 0x2f886, 0x5e3d}, {0x2f887, 0x2f887, 0x5e69},
+	{0x2f888, 0x2f888, 0x3862}, {0x2f889, 0x2f889, 0x22183}, {0x2f88a, 0x2f88a, 0x387c},
+	{0x2f88b, 0x2f88b, 0x5eb0}, {0x2f88c, 0x2f88c, 0x5eb3}, {0x2f88d, 0x2f88d, 0x5eb6},
+	{0x2f88e, 0x2f88e, 0x5eca}, {0x2f88f, 0x2f88f, 0x2a392}, {0x2f890, 0x2f890, 0x5efe},
+	{0x2f891, 0x2f891, 0x22331}, {0x2f892, 0x2f892, 0x22331}, {0x2f893, 0x2f893, 0x8201},
+	{0x2f894, 0x2f894, 0x5f22}, {0x2f895, 0x2f895, 0x5f22}, {0x2f896, 0x2f896, 0x38c7},
+	{0x2f897, 0x2f897, 0x232b8}, {0x2f898, 0x2f898, 0x261da}, {0x2f899, 0x2f899, 0x5f62},
+	{0x2f89a, 0x2f89a, 0x5f6b}, {0x2f89b, 0x2f89b, 0x38e3}, {0x2f89c, 0x2f89c, 0x5f9a},
+	{0x2f89d, 0x2f89d, 0x5fcd}, {0x2f89e, 0x2f89e, 0x5fd7}, {0x2f89f, 0x2f89f, 0x5ff9},
+	{0x2f8a0, 0x2f8a0, 0x6081}, {0x2f8a1, 0x2f8a1, 0x393a}, {0x2f8a2, 0x2f8a2, 0x391c},
+	{0x2f8a3, 0x2f8a3, 0x6094}, {0x2f8a4, 0x2f8a4, 0x226d4}, {0x2f8a5, 0x2f8a5, 0x60c7},
+	{0x2f8a6, 0x2f8a6, 0x6148}, {0x2f8a7, 0x2f8a7, 0x614c}, {0x2f8a8, 0x2f8a8, 0x614e},
+	{0x2f8a9, 0x2f8a9, 0x614c}, {0x2f8aa, 0x2f8aa, 0x617a}, {0x2f8ab, 0x2f8ab, 0x618e},
+	{0x2f8ac, 0x2f8ac, 0x61b2}, {0x2f8ad, 0x2f8ad, 0x61a4}, {0x2f8ae, 0x2f8ae, 0x61af},
+	{0x2f8af, 0x2f8af, 0x61de}, {0x2f8b0, 0x2f8b0, 0x61f2}, {0x2f8b1, 0x2f8b1, 0x61f6},
+	{0x2f8b2, 0x2f8b2, 0x6210}, {0x2f8b3, 0x2f8b3, 0x621b}, {0x2f8b4, 0x2f8b4, 0x625d},
+	{0x2f8b5, 0x2f8b5, 0x62b1}, {0x2f8b6, 0x2f8b6, 0x62d4}, {0x2f8b7, 0x2f8b7, 0x6350},
+	{0x2f8b8, 0x2f8b8, 0x22b0c}, {0x2f8b9, 0x2f8b9, 0x633d}, {0x2f8ba, 0x2f8ba, 0x62fc},
+	{0x2f8bb, 0x2f8bb, 0x6368}, {0x2f8bc, 0x2f8bc, 0x6383}, {0x2f8bd, 0x2f8bd, 0x63e4},
+	{0x2f8be, 0x2f8be, 0x22bf1}, {0x2f8bf, 0x2f8bf, 0x6422}, {0x2f8c0, 0x2f8c0, 0x63c5},
+	{0x2f8c1, 0x2f8c1, 0x63a9}, {0x2f8c2, 0x2f8c2, 0x3a2e}, {0x2f8c3, 0x2f8c3, 0x6469},
+	{0x2f8c4, 0x2f8c4, 0x647e}, {0x2f8c5, 0x2f8c5, 0x649d}, {0x2f8c6, 0x2f8c6, 0x6477},
+	{0x2f8c7, 0x2f8c7, 0x3a6c}, {0x2f8c8, 0x2f8c8, 0x654f}, {0x2f8c9, 0x2f8c9, 0x656c},
+	{0x2f8ca, 0x2f8ca, 0x2300a}, {0x2f8cb, 0x2f8cb, 0x65e3}, {0x2f8cc, 0x2f8cc, 0x66f8},
+	{0x2f8cd, 0x2f8cd, 0x6649}, {0x2f8ce, 0x2f8ce, 0x3b19}, {0x2f8cf, 0x2f8cf, 0x6691},
+	{0x2f8d0, 0x2f8d0, 0x3b08}, {0x2f8d1, 0x2f8d1, 0x3ae4}, {0x2f8d2, 0x2f8d2, 0x5192},
+	{0x2f8d3, 0x2f8d3, 0x5195}, {0x2f8d4, 0x2f8d4, 0x6700}, {0x2f8d5, 0x2f8d5, 0x669c},
+	{0x2f8d6, 0x2f8d6, 0x80ad}, {0x2f8d7, 0x2f8d7, 0x43d9}, {0x2f8d8, 0x2f8d8, 0x6717},
+	{0x2f8d9, 0x2f8d9, 0x671b}, {0x2f8da, 0x2f8da, 0x6721}, {0x2f8db, 0x2f8db, 0x675e},
+	{0x2f8dc, 0x2f8dc, 0x6753}, {0x2f8dd, 0x2f8dd, 0x233c3}, {0x2f8de, 0x2f8de, 0x3b49},
+	{0x2f8df, 0x2f8df, 0x67fa}, {0x2f8e0, 0x2f8e0, 0x6785}, {0x2f8e1, 0x2f8e1, 0x6852},
+	{0x2f8e2, 0x2f8e2, 0x6885}, {0x2f8e3, 0x2f8e3, 0x2346d}, {0x2f8e4, 0x2f8e4, 0x688e},
+	{0x2f8e5, 0x2f8e5, 0x681f}, {0x2f8e6, 0x2f8e6, 0x6914}, {0x2f8e7, 0x2f8e7, 0x3b9d},
+	{0x2f8e8, 0x2f8e8, 0x6942}, {0x2f8e9, 0x2f8e9, 0x69a3}, {0x2f8ea, 0x2f8ea, 0x69ea},
+	{0x2f8eb, 0x2f8eb, 0x6aa8}, {0x2f8ec, 0x2f8ec, 0x236a3}, {0x2f8ed, 0x2f8ed, 0x6adb},
+	{0x2f8ee, 0x2f8ee, 0x3c18}, {0x2f8ef, 0x2f8ef, 0x6b21}, {0x2f8f0, 0x2f8f0, 0x238a7},
+	{0x2f8f1, 0x2f8f1, 0x6b54}, {0x2f8f2, 0x2f8f2, 0x3c4e}, {0x2f8f3, 0x2f8f3, 0x6b72},
+	{0x2f8f4, 0x2f8f4, 0x6b9f}, {0x2f8f5, 0x2f8f6, 0x6bba}, {0x2f8f7, 0x2f8f7, 0x23a8d},
+	{0x2f8f8, 0x2f8f8, 0x21d0b}, {0x2f8f9, 0x2f8f9, 0x23afa}, {0x2f8fa, 0x2f8fa, 0x6c4e},
+	{0x2f8fb, 0x2f8fb, 0x23cbc}, {0x2f8fc, 0x2f8fc, 0x6cbf}, {0x2f8fd, 0x2f8fd, 0x6ccd},
+	{0x2f8fe, 0x2f8fe, 0x6c67}, {0x2f8ff, 0x2f8ff, 0x6d16}, {0x2f900, 0x2f900, 0x6d3e},
+	{0x2f901, 0x2f901, 0x6d77}, {0x2f902, 0x2f902, 0x6d41}, {0x2f903, 0x2f903, 0x6d69},
+	{0x2f904, 0x2f904, 0x6d78}, {0x2f905, 0x2f905, 0x6d85}, {0x2f906, 0x2f906, 0x23d1e},
+	{0x2f907, 0x2f907, 0x6d34}, {0x2f908, 0x2f908, 0x6e2f}, {0x2f909, 0x2f909, 0x6e6e},
+	{0x2f90a, 0x2f90a, 0x3d33}, {0x2f90b, 0x2f90b, 0x6ecb}, {0x2f90c, 0x2f90c, 0x6ec7},
+	{0x2f90d, 0x2f90d, 0x23ed1}, {0x2f90e, 0x2f90e, 0x6df9}, {0x2f90f, 0x2f90f, 0x6f6e},
+	{0x2f910, 0x2f910, 0x23f5e}, {0x2f911, 0x2f911, 0x23f8e}, {0x2f912, 0x2f912, 0x6fc6},
+	{0x2f913, 0x2f913, 0x7039}, {0x2f914, 0x2f914, 0x701e}, {0x2f915, 0x2f915, 0x701b},
+	{0x2f916, 0x2f916, 0x3d96}, {0x2f917, 0x2f917, 0x704a}, {0x2f918, 0x2f918, 0x707d},
+	{0x2f919, 0x2f919, 0x7077}, {0x2f91a, 0x2f91a, 0x70ad}, {0x2f91b, 0x2f91b, 0x20525},
+	{0x2f91c, 0x2f91c, 0x7145}, {0x2f91d, 0x2f91d, 0x24263}, {0x2f91e, 0x2f91e, 0x719c},
+	{0x2f91f, 0x2f91f, 0x243ab}, {0x2f920, 0x2f920, 0x7228}, {0x2f921, 0x2f921, 0x7235},
+	{0x2f922, 0x2f922, 0x7250}, {0x2f923, 0x2f923, 0x24608}, {0x2f924, 0x2f924, 0x7280},
+	{0x2f925, 0x2f925, 0x7295}, {0x2f926, 0x2f926, 0x24735}, {0x2f927, 0x2f927, 0x24814},
+	{0x2f928, 0x2f928, 0x737a}, {0x2f929, 0x2f929, 0x738b}, {0x2f92a, 0x2f92a, 0x3eac},
+	{0x2f92b, 0x2f92b, 0x73a5}, {0x2f92c, 0x2f92c, 0x3eb8}, {0x2f92d, 0x2f92d, 0x3eb8},
+	{0x2f92e, 0x2f92e, 0x7447}, {0x2f92f, 0x2f92f, 0x745c}, {0x2f930, 0x2f930, 0x7471},
+	{0x2f931, 0x2f931, 0x7485}, {0x2f932, 0x2f932, 0x74ca}, {0x2f933, 0x2f933, 0x3f1b},
+	{0x2f934, 0x2f934, 0x7524}, {0x2f935, 0x2f935, 0x24c36}, {0x2f936, 0x2f936, 0x753e},
+	{0x2f937, 0x2f937, 0x24c92}, {0x2f938, 0x2f938, 0x7570}, {0x2f939, 0x2f939, 0x2219f},
+	{0x2f93a, 0x2f93a, 0x7610}, {0x2f93b, 0x2f93b, 0x24fa1}, {0x2f93c, 0x2f93c, 0x24fb8},
+	{0x2f93d, 0x2f93d, 0x25044}, {0x2f93e, 0x2f93e, 0x3ffc}, {0x2f93f, 0x2f93f, 0x4008},
+	{0x2f940, 0x2f940, 0x76f4}, {0x2f941, 0x2f941, 0x250f3}, {0x2f942, 0x2f942, 0x250f2},
+	{0x2f943, 0x2f943, 0x25119}, {0x2f944, 0x2f944, 0x25133}, {0x2f945, 0x2f946, 0x771e},
+	{0x2f947, 0x2f947, 0x771f}, {0x2f948, 0x2f948, 0x774a}, {0x2f949, 0x2f949, 0x4039},
+	{0x2f94a, 0x2f94a, 0x778b}, {0x2f94b, 0x2f94b, 0x4046}, {0x2f94c, 0x2f94c, 0x4096},
+	{0x2f94d, 0x2f94d, 0x2541d}, {0x2f94e, 0x2f94e, 0x784e}, {0x2f94f, 0x2f94f, 0x788c},
+	{0x2f950, 0x2f950, 0x78cc}, {0x2f951, 0x2f951, 0x40e3}, {0x2f952, 0x2f952, 0x25626},
+	{0x2f953, 0x2f953, 0x7956}, {0x2f954, 0x2f954, 0x2569a}, {0x2f955, 0x2f955, 0x256c5},
+	{0x2f956, 0x2f956, 0x798f}, {0x2f957, 0x2f957, 0x79eb}, {0x2f958, 0x2f958, 0x412f},
+	{0x2f959, 0x2f959, 0x7a40}, {0x2f95a, 0x2f95a, 0x7a4a}, {0x2f95b, 0x2f95b, 0x7a4f},
+	{0x2f95c, 0x2f95c, 0x2597c}, {0x2f95d, 0x2f95d, 0x25aa7}, {0x2f95e, 0x2f95e, 0x25aa7},
+	{0x2f95f, 0x2f95f, 0x7aee}, {0x2f960, 0x2f960, 0x4202}, {0x2f961, 0x2f961, 0x25bab},
+	{0x2f962, 0x2f962, 0x7bc6}, {0x2f963, 0x2f963, 0x7bc9}, {0x2f964, 0x2f964, 0x4227},
+	{0x2f965, 0x2f965, 0x25c80}, {0x2f966, 0x2f966, 0x7cd2}, {0x2f967, 0x2f967, 0x42a0},
+	{0x2f968, 0x2f968, 0x7ce8}, {0x2f969, 0x2f969, 0x7ce3}, {0x2f96a, 0x2f96a, 0x7d00},
+	{0x2f96b, 0x2f96b, 0x25f86}, {0x2f96c, 0x2f96c, 0x7d63}, {0x2f96d, 0x2f96d, 0x4301},
+	{0x2f96e, 0x2f96e, 0x7dc7}, {0x2f96f, 0x2f96f, 0x7e02}, {0x2f970, 0x2f970, 0x7e45},
+	{0x2f971, 0x2f971, 0x4334}, {0x2f972, 0x2f972, 0x26228}, {0x2f973, 0x2f973, 0x26247},
+	{0x2f974, 0x2f974, 0x4359}, {0x2f975, 0x2f975, 0x262d9}, {0x2f976, 0x2f976, 0x7f7a},
+	{0x2f977, 0x2f977, 0x2633e}, {0x2f978, 0x2f978, 0x7f95}, {0x2f979, 0x2f979, 0x7ffa},
+	{0x2f97a, 0x2f97a, 0x8005}, {0x2f97b, 0x2f97b, 0x264da}, {0x2f97c, 0x2f97c, 0x26523},
+	{0x2f97d, 0x2f97d, 0x8060}, {0x2f97e, 0x2f97e, 0x265a8}, {0x2f97f, 0x2f97f, 0x8070},
+	{0x2f980, 0x2f980, 0x2335f}, {0x2f981, 0x2f981, 0x43d5}, {0x2f982, 0x2f982, 0x80b2},
+	{0x2f983, 0x2f983, 0x8103}, {0x2f984, 0x2f984, 0x440b}, {0x2f985, 0x2f985, 0x813e},
+	{0x2f986, 0x2f986, 0x5ab5}, {0x2f987, 0x2f987, 0x267a7}, {0x2f988, 0x2f988, 0x267b5},
+	{0x2f989, 0x2f989, 0x23393}, {0x2f98a, 0x2f98a, 0x2339c}, {0x2f98b, 0x2f98b, 0x8201},
+	{0x2f98c, 0x2f98c, 0x8204}, {0x2f98d, 0x2f98d, 0x8f9e}, {0x2f98e, 0x2f98e, 0x446b},
+	{0x2f98f, 0x2f98f, 0x8291}, {0x2f990, 0x2f990, 0x828b}, {0x2f991, 0x2f991, 0x829d},
+	{0x2f992, 0x2f992, 0x52b3}, {0x2f993, 0x2f993, 0x82b1}, {0x2f994, 0x2f994, 0x82b3},
+	{0x2f995, 0x2f995, 0x82bd}, {0x2f996, 0x2f996, 0x82e6}, {0x2f997, 0x2f997, 0x26b3c},
+	{0x2f998, 0x2f998, 0x82e5}, {0x2f999, 0x2f999, 0x831d}, {0x2f99a, 0x2f99a, 0x8363},
+	{0x2f99b, 0x2f99b, 0x83ad}, {0x2f99c, 0x2f99c, 0x8323}, {0x2f99d, 0x2f99d, 0x83bd},
+	{0x2f99e, 0x2f99e, 0x83e7}, {0x2f99f, 0x2f99f, 0x8457}, {0x2f9a0, 0x2f9a0, 0x8353},
+	{0x2f9a1, 0x2f9a1, 0x83ca}, {0x2f9a2, 0x2f9a2, 0x83cc}, {0x2f9a3, 0x2f9a3, 0x83dc},
+	{0x2f9a4, 0x2f9a4, 0x26c36}, {0x2f9a5, 0x2f9a5, 0x26d6b}, {0x2f9a6, 0x2f9a6, 0x26cd5},
+	{0x2f9a7, 0x2f9a7, 0x452b}, {0x2f9a8, 0x2f9a8, 0x84f1}, {0x2f9a9, 0x2f9a9, 0x84f3},
+	{0x2f9aa, 0x2f9aa, 0x8516}, {0x2f9ab, 0x2f9ab, 0x273ca}, {0x2f9ac, 0x2f9ac, 0x8564},
+	{0x2f9ad, 0x2f9ad, 0x26f2c}, {0x2f9ae, 0x2f9ae, 0x455d}, {0x2f9af, 0x2f9af, 0x4561},
+	{0x2f9b0, 0x2f9b0, 0x26fb1}, {0x2f9b1, 0x2f9b1, 0x270d2}, {0x2f9b2, 0x2f9b2, 0x456b},
+	{0x2f9b3, 0x2f9b3, 0x8650}, {0x2f9b4, 0x2f9b4, 0x865c}, {0x2f9b5, 0x2f9b5, 0x8667},
+	{0x2f9b6, 0x2f9b6, 0x8669}, {0x2f9b7, 0x2f9b7, 0x86a9}, {0x2f9b8, 0x2f9b8, 0x8688},
+	{0x2f9b9, 0x2f9b9, 0x870e}, {0x2f9ba, 0x2f9ba, 0x86e2}, {0x2f9bb, 0x2f9bb, 0x8779},
+	{0x2f9bc, 0x2f9bc, 0x8728}, {0x2f9bd, 0x2f9bd, 0x876b}, {0x2f9be, 0x2f9be, 0x8786},
+	{0x2f9bf, 0x2f9bf, 0x45d7}, {0x2f9c0, 0x2f9c0, 0x87e1}, {0x2f9c1, 0x2f9c1, 0x8801},
+	{0x2f9c2, 0x2f9c2, 0x45f9}, {0x2f9c3, 0x2f9c3, 0x8860}, {0x2f9c4, 0x2f9c4, 0x8863},
+	{0x2f9c5, 0x2f9c5, 0x27667}, {0x2f9c6, 0x2f9c6, 0x88d7}, {0x2f9c7, 0x2f9c7, 0x88de},
+	{0x2f9c8, 0x2f9c8, 0x4635}, {0x2f9c9, 0x2f9c9, 0x88fa}, {0x2f9ca, 0x2f9ca, 0x34bb},
+	{0x2f9cb, 0x2f9cb, 0x278ae}, {0x2f9cc, 0x2f9cc, 0x27966}, {0x2f9cd, 0x2f9cd, 0x46be},
+	{0x2f9ce, 0x2f9ce, 0x46c7}, {0x2f9cf, 0x2f9cf, 0x8aa0}, {0x2f9d0, 0x2f9d0, 0x8aed},
+	{0x2f9d1, 0x2f9d1, 0x8b8a}, {0x2f9d2, 0x2f9d2, 0x8c55}, {0x2f9d3, 0x2f9d3, 0x27ca8},
+	{0x2f9d4, 0x2f9d4, 0x8cab}, {0x2f9d5, 0x2f9d5, 0x8cc1}, {0x2f9d6, 0x2f9d6, 0x8d1b},
+	{0x2f9d7, 0x2f9d7, 0x8d77}, {0x2f9d8, 0x2f9d8, 0x27f2f}, {0x2f9d9, 0x2f9d9, 0x20804},
+	{0x2f9da, 0x2f9da, 0x8dcb}, {0x2f9db, 0x2f9db, 0x8dbc}, {0x2f9dc, 0x2f9dc, 0x8df0},
+	{0x2f9dd, 0x2f9dd, 0x208de}, {0x2f9de, 0x2f9de, 0x8ed4}, {0x2f9df, 0x2f9df, 0x8f38},
+	{0x2f9e0, 0x2f9e0, 0x285d2}, {0x2f9e1, 0x2f9e1, 0x285ed}, {0x2f9e2, 0x2f9e2, 0x9094},
+	{0x2f9e3, 0x2f9e3, 0x90f1}, {0x2f9e4, 0x2f9e4, 0x9111}, {0x2f9e5, 0x2f9e5, 0x2872e},
+	{0x2f9e6, 0x2f9e6, 0x911b}, {0x2f9e7, 0x2f9e7, 0x9238}, {0x2f9e8, 0x2f9e9, 0x92d7},
+	{0x2f9ea, 0x2f9ea, 0x927c}, {0x2f9eb, 0x2f9eb, 0x93f9}, {0x2f9ec, 0x2f9ec, 0x9415},
+	{0x2f9ed, 0x2f9ed, 0x28bfa}, {0x2f9ee, 0x2f9ee, 0x958b}, {0x2f9ef, 0x2f9ef, 0x4995},
+	{0x2f9f0, 0x2f9f0, 0x95b7}, {0x2f9f1, 0x2f9f1, 0x28d77}, {0x2f9f2, 0x2f9f2, 0x49e6},
+	{0x2f9f3, 0x2f9f3, 0x96c3}, {0x2f9f4, 0x2f9f4, 0x5db2}, {0x2f9f5, 0x2f9f5, 0x9723},
+	{0x2f9f6, 0x2f9f6, 0x29145}, {0x2f9f7, 0x2f9f7, 0x2921a}, {0x2f9f8, 0x2f9f8, 0x4a6e},
+	{0x2f9f9, 0x2f9f9, 0x4a76}, {0x2f9fa, 0x2f9fa, 0x97e0}, {0x2f9fb, 0x2f9fb, 0x2940a},
+	{0x2f9fc, 0x2f9fc, 0x4ab2}, {0x2f9fd, 0x2f9fd, 0x29496}, {0x2f9fe, 0x2f9fe, 0x980b},
+	{0x2f9ff, 0x2f9ff, 0x980b}, {0x2fa00, 0x2fa00, 0x9829}, {0x2fa01, 0x2fa01, 0x295b6},
+	{0x2fa02, 0x2fa02, 0x98e2}, {0x2fa03, 0x2fa03, 0x4b33}, {0x2fa04, 0x2fa04, 0x9929},
+	{0x2fa05, 0x2fa05, 0x99a7}, {0x2fa06, 0x2fa06, 0x99c2}, {0x2fa07, 0x2fa07, 0x99fe},
+	{0x2fa08, 0x2fa08, 0x4bce}, {0x2fa09, 0x2fa09, 0x29b30}, {0x2fa0a, 0x2fa0a, 0x9b12},
+	{0x2fa0b, 0x2fa0b, 0x9c40}, {0x2fa0c, 0x2fa0c, 0x9cfd}, {0x2fa0d, 0x2fa0d, 0x4cce},
+	{0x2fa0e, 0x2fa0e, 0x4ced}, {0x2fa0f, 0x2fa0f, 0x9d67}, {0x2fa10, 0x2fa10, 0x2a0ce},
+	{0x2fa11, 0x2fa11, 0x4cf8}, {0x2fa12, 0x2fa12, 0x2a105}, {0x2fa13, 0x2fa13, 0x2a20e},
+	{0x2fa14, 0x2fa14, 0x2a291}, {0x2fa15, 0x2fa15, 0x9ebb}, {0x2fa16, 0x2fa16, 0x4d56},
+	{0x2fa17, 0x2fa17, 0x9ef9}, {0x2fa18, 0x2fa18, 0x9efe}, {0x2fa19, 0x2fa19, 0x9f05},
+	{0x2fa1a, 0x2fa1a, 0x9f0f}, {0x2fa1b, 0x2fa1b, 0x9f16}, {0x2fa1c, 0x2fa1c, 0x9f3b},
+	{0x2fa1d, 0x2fa1d, 0x2a600},
+}
+
+// decompositions maps the other runes with a decomposition to it.
+var decompositions = map[rune]string{
+	0x00a8: "\u0020\u0308", 0x00af: "\u0020\u0304", 0x00b4: "\u0020\u0301",
+	0x00b8: "\u0020\u0327", 0x00bc: "\u0031\u2044\u0034", 0x00bd: "\u0031\u2044\u0032",
+	0x00be: "\u0033\u2044\u0034", 0x00c0: "\u0041\u0300", 0x00c1: "\u0041\u0301",
+	0x00c2: "\u0041\u0302", 0x00c3: "\u0041\u0303", 0x00c4: "\u0041\u0308",
+	0x00c5: "\u0041\u030a", 0x00c7: "\u0043\u0327", 0x00c8: "\u0045\u0300",
+	0x00c9: "\u0045\u0301", 0x00ca: "\u0045\u0302", 0x00cb: "\u0045\u0308",
+	0x00cc: "\u0049\u0300", 0x00cd: "\u0049\u0301", 0x00ce: "\u0049\u0302",
+	0x00cf: "\u0049\u0308", 0x00d1: "\u004e\u0303", 0x00d2: "\u004f\u0300",
+	0x00d3: "\u004f\u0301", 0x00d4: "\u004f\u0302", 0x00d5: "\u004f\u0303",
+	0x00d6: "\u004f\u0308", 0x00d9: "\u0055\u0300", 0x00da: "\u0055\u0301",
+	0x00db: "\u0055\u0302", 0x00dc: "\u0055\u0308", 0x00dd: "\u0059\u0301",
+	0x00e0: "\u0061\u0300", 0x00e1: "\u0061\u0301", 0x00e2: "\u0061\u0302",
+	0x00e3: "\u0061\u0303", 0x00e4: "\u0061\u0308", 0x00e5: "\u0061\u030a",
+	0x00e7: "\u0063\u0327", 0x00e8: "\u0065\u0300", 0x00e9: "\u0065\u0301",
+	0x00ea: "\u0065\u0302", 0x00eb: "\u0065\u0308", 0x00ec: "\u0069\u0300",
+	0x00ed: "\u0069\u0301", 0x00ee: "\u0069\u0302", 0x00ef: "\u0069\u0308",
+	0x00f1: "\u006e\u0303", 0x00f2: "\u006f\u0300", 0x00f3: "\u006f\u0301",
+	0x00f4: "\u006f\u0302", 0x00f5: "\u006f\u0303", 0x00f6: "\u006f\u0308",
+	0x00f9: "\u0075\u0300", 0x00fa: "\u0075\u0301", 0x00fb: "\u0075\u0302",
+	0x00fc: "\u0075\u0308", 0x00fd: "\u0079\u0301", 0x00ff: "\u0079\u0308",
+	0x0100: "\u0041\u0304", 0x0101: "\u0061\u0304", 0x0102: "\u0041\u0306",
+	0x0103: "\u0061\u0306", 0x0104: "\u0041\u0328", 0x0105: "\u0061\u0328",
+	0x0106: "\u0043\u0301", 0x0107: "\u0063\u0301", 0x0108: "\u0043\u0302",
+	0x0109: "\u0063\u0302", 0x010a: "\u0043\u0307", 0x010b: "\u0063\u0307",
+	0x010c: "\u0043\u030c", 0x010d: "\u0063\u030c", 0x010e: "\u0044\u030c",
+	0x010f: "\u0064\u030c", 0x0112: "\u0045\u0304", 0x0113: "\u0065\u0304",
+	0x0114: "\u0045\u0306", 0x0115: "\u0065\u0306", 0x0116: "\u0045\u0307",
+	0x0117: "\u0065\u0307", 0x0118: "\u0045\u0328", 0x0119: "\u0065\u0328",
+	0x011a: "\u0045\u030c", 0x011b: "\u0065\u030c", 0x011c: "\u0047\u0302",
+	0x011d: "\u0067\u0302", 0x011e: "\u0047\u0306", 0x011f: "\u0067\u0306",
+	0x0120: "\u0047\u0307", 0x0121: "\u0067\u0307", 0x0122: "\u0047\u0327",
+	0x0123: "\u0067\u0327", 0x0124: "\u0048\u0302", 0x0125: "\u0068\u0302",
+	0x0128: "\u0049\u0303", 0x0129: "\u0069\u0303", 0x012a: "\u0049\u0304",
+	0x012b: "\u0069\u0304", 0x012c: "\u0049\u0306", 0x012d: "\u0069\u0306",
+	0x012e: "\u0049\u0328", 0x012f: "\u0069\u0328", 0x0130: "\u0049\u0307",
+	0x0132: "\u0049\u004a", 0x0133: "\u0069\u006a", 0x0134: "\u004a\u0302",
+	0x0135: "\u006a\u0302", 0x0136: "\u004b\u0327", 0x0137: "\u006b\u0327",
+	0x0139: "\u004c\u0301", 0x013a: "\u006c\u0301", 0x013b: "\u004c\u0327",
+	0x013c: "\u006c\u0327", 0x013d: "\u004c\u030c", 0x013e: "\u006c\u030c",
+	0x013f: "\u004c\u00b7", 0x0140: "\u006c\u00b7", 0x0143: "\u004e\u0301",
+	0x0144: "\u006e\u0301", 0x0145: "\u004e\u0327", 0x0146: "\u006e\u0327",
+	0x0147: "\u004e\u030c", 0x0148: "\u006e\u030c", 0x0149: "\u02bc\u006e",
+	0x014c: "\u004f\u0304", 0x014d: "\u006f\u0304", 0x014e: "\u004f\u0306",
+	0x014f: "\u006f\u0306", 0x0150: "\u004f\u030b", 0x0151: "\u006f\u030b",
+	0x0154: "\u0052\u0301", 0x0155: "\u0072\u0301", 0x0156: "\u0052\u0327",
+	0x0157: "\u0072\u0327", 0x0158: "\u0052\u030c", 0x0159: "\u0072\u030c",
+	0x015a: "\u0053\u0301", 0x015b: "\u0073\u0301", 0x015c: "\u0053\u0302",
+	0x015d: "\u0073\u0302", 0x015e: "\u0053\u0327", 0x015f: "\u0073\u0327",
+	0x0160: "\u0053\u030c", 0x0161: "\u0073\u030c", 0x0162: "\u0054\u0327",
+	0x0163: "\u0074\u0327", 0x0164: "\u0054\u030c", 0x0165: "\u0074\u030c",
+	0x0168: "\u0055\u0303", 0x0169: "\u0075\u0303", 0x016a: "\u0055\u0304",
+	0x016b: "\u0075\u0304", 0x016c: "\u0055\u0306", 0x016d: "\u0075\u0306",
+	0x016e: "\u0055\u030a", 0x016f: "\u0075\u030a", 0x0170: "\u0055\u030b",
+	0x0171: "\u0075\u030b", 0x0172: "\u0055\u0328", 0x0173: "\u0075\u0328",
+	0x0174: "\u0057\u0302", 0x0175: "\u0077\u0302", 0x0176: "\u0059\u0302",
+	0x0177: "\u0079\u0302", 0x0178: "\u0059\u0308", 0x0179: "\u005a\u0301",
+	0x017a: "\u007a\u0301", 0x017b: "\u005a\u0307", 0x017c: "\u007a\u0307",
+	0x017d: "\u005a\u030c", 0x017e: "\u007a\u030c", 0x01a0: "\u004f\u031b",
+	0x01a1: "\u006f\u031b", 0x01af: "\u0055\u031b", 0x01b0: "\u0075\u031b",
+	0x01c4: "\u0044\u005a\u030c", 0x01c5: "\u0044\u007a\u030c", 0x01c6: "\u0064\u007a\u030c",
+	0x01c7: "\u004c\u004a", 0x01c8: "\u004c\u006a", 0x01c9: "\u006c\u006a",
+	0x01ca: "\u004e\u004a", 0x01cb: "\u004e\u006a", 0x01cc: "\u006e\u006a",
+	0x01cd: "\u0041\u030c", 0x01ce: "\u0061\u030c", 0x01cf: "\u0049\u030c",
+	0x01d0: "\u0069\u030c", 0x01d1: "\u004f\u030c", 0x01d2: "\u006f\u030c",
+	0x01d3: "\u0055\u030c", 0x01d4: "\u0075\u030c", 0x01d5: "\u0055\u0308\u0304",
+	0x01d6: "\u0075\u0308\u0304", 0x01d7: "\u0055\u0308\u0301", 0x01d8: "\u0075\u0308\u0301",
+	0x01d9: "\u0055\u0308\u030c", 0x01da: "\u0075\u0308\u030c", 0x01db: "\u0055\u0308\u0300",
+	0x01dc: "\u0075\u0308\u0300", 0x01de: "\u0041\u0308\u0304", 0x01df: "\u0061\u0308\u0304",
+	0x01e0: "\u0041\u0307\u0304", 0x01e1: "\u0061\u0307\u0304", 0x01e2: "\u00c6\u0304",
+	0x01e3: "\u00e6\u0304", 0x01e6: "\u0047\u030c", 0x01e7: "\u0067\u030c",
+	0x01e8: "\u004b\u030c", 0x01e9: "\u006b\u030c", 0x01ea: "\u004f\u0328",
+	0x01eb: "\u006f\u0328", 0x01ec: "\u004f\u0328\u0304", 0x01ed: "\u006f\u0328\u0304",
+	0x01ee: "\u01b7\u030c", 0x01ef: "\u0292\u030c", 0x01f0: "\u006a\u030c",
+	0x01f1: "\u0044\u005a", 0x01f2: "\u0044\u007a", 0x01f3: "\u0064\u007a",
+	0x01f4: "\u0047\u0301", 0x01f5: "\u0067\u0301", 0x01f8: "\u004e\u0300",
+	0x01f9: "\u006e\u0300", 0x01fa: "\u0041\u030a\u0301", 0x01fb: "\u0061\u030a\u0301",
+	0x01fc: "\u00c6\u0301", 0x01fd: "\u00e6\u0301", 0x01fe: "\u00d8\u0301",
+	0x01ff: "\u00f8\u0301", 0x0200: "\u0041\u030f", 0x0201: "\u0061\u030f",
+	0x0202: "\u0041\u0311", 0x0203: "\u0061\u0311", 0x0204: "\u0045\u030f",
+	0x0205: "\u0065\u030f", 0x0206: "\u0045\u0311", 0x0207: "\u0065\u0311",
+	0x0208: "\u0049\u030f", 0x0209: "\u0069\u030f", 0x020a: "\u0049\u0311",
+	0x020b: "\u0069\u0311", 0x020c: "\u004f\u030f", 0x020d: "\u006f\u030f",
+	0x020e: "\u004f\u0311", 0x020f: "\u006f\u0311", 0x0210: "\u0052\u030f",
+	0x0211: "\u0072\u030f", 0x0212: "\u0052\u0311", 0x0213: "\u0072\u0311",
+	0x0214: "\u0055\u030f", 0x0215: "\u0075\u030f", 0x0216: "\u0055\u0311",
+	0x0217: "\u0075\u0311", 0x0218: "\u0053\u0326", 0x0219: "\u0073\u0326",
+	0x021a: "\u0054\u0326", 0x021b: "\u0074\u0326", 0x021e: "\u0048\u030c",
+	0x021f: "\u0068\u030c", 0x0226: "\u0041\u0307", 0x0227: "\u0061\u0307",
+	0x0228: "\u0045\u0327", 0x0229: "\u0065\u0327", 0x022a: "\u004f\u0308\u0304",
+	0x022b: "\u006f\u0308\u0304", 0x022c: "\u004f\u0303\u0304", 0x022d: "\u006f\u0303\u0304",
+	0x022e: "\u004f\u0307", 0x022f: "\u006f\u0307", 0x0230: "\u004f\u0307\u0304",
+	0x0231: "\u006f\u0307\u0304", 0x0232: "\u0059\u0304", 0x0233: "\u0079\u0304",
+	0x02d8: "\u0020\u0306", 0x02d9: "\u0020\u0307", 0x02da: "\u0020\u030a",
+	0x02db: "\u0020\u0328", 0x02dc: "\u0020\u0303", 0x02dd: "\u0020\u030b",
+	0x0344: "\u0308\u0301", 0x037a: "\u0020\u0345", 0x0384: "\u0020\u0301",
+	0x0385: "\u0020\u0308\u0301", 0x0386: "\u0391\u0301", 0x0388: "\u0395\u0301",
+	0x0389: "\u0397\u0301", 0x038a: "\u0399\u0301", 0x038c: "\u039f\u0301",
+	0x038e: "\u03a5\u0301", 0x038f: "\u03a9\u0301", 0x0390: "\u03b9\u0308\u0301",
+	0x03aa: "\u0399\u0308", 0x03ab: "\u03a5\u0308", 0x03ac: "\u03b1\u0301",
+	0x03ad: "\u03b5\u0301", 0x03ae: "\u03b7\u0301", 0x03af: "\u03b9\u0301",
+	0x03b0: "\u03c5\u0308\u0301", 0x03ca: "\u03b9\u0308", 0x03cb: "\u03c5\u0308",
+	0x03cc: "\u03bf\u0301", 0x03cd: "\u03c5\u0301", 0x03ce: "\u03c9\u0301",
+	0x03d3: "\u03a5\u0301", 0x03d4: "\u03a5\u0308", 0x0400: "\u0415\u0300",
+	0x0401: "\u0415\u0308", 0x0403: "\u0413\u0301", 0x0407: "\u0406\u0308",
+	0x040c: "\u041a\u0301", 0x040d: "\u0418\u0300", 0x040e: "\u0423\u0306",
+	0x0419: "\u0418\u0306", 0x0439: "\u0438\u0306", 0x0450: "\u0435\u0300",
+	0x0451: "\u0435\u0308", 0x0453: "\u0433\u0301", 0x0457: "\u0456\u0308",
+	0x045c: "\u043a\u0301", 0x045d: "\u0438\u0300", 0x045e: "\u0443\u0306",
+	0x0476: "\u0474\u030f", 0x0477: "\u0475\u030f", 0x04c1: "\u0416\u0306",
+	0x04c2: "\u0436\u0306", 0x04d0: "\u0410\u0306", 0x04d1: "\u0430\u0306",
+	0x04d2: "\u0410\u0308", 0x04d3: "\u0430\u0308", 0x04d6: "\u0415\u0306",
+	0x04d7: "\u0435\u0306", 0x04da: "\u04d8\u0308", 0x04db: "\u04d9\u0308",
+	0x04dc: "\u0416\u0308", 0x04dd: "\u0436\u0308", 0x04de: "\u0417\u0308",
+	0x04df: "\u0437\u0308", 0x04e2: "\u0418\u0304", 0x04e3: "\u0438\u0304",
+	0x04e4: "\u0418\u0308", 0x04e5: "\u0438\u0308", 0x04e6: "\u041e\u0308",
+	0x04e7: "\u043e\u0308", 0x04ea: "\u04e8\u0308", 0x04eb: "\u04e9\u0308",
+	0x04ec: "\u042d\u0308", 0x04ed: "\u044d\u0308", 0x04ee: "\u0423\u0304",
+	0x04ef: "\u0443\u0304", 0x04f0: "\u0423\u0308", 0x04f1: "\u0443\u0308",
+	0x04f2: "\u0423\u030b", 0x04f3: "\u0443\u030b", 0x04f4: "\u0427\u0308",
+	0x04f5: "\u0447\u0308", 0x04f8: "\u042b\u0308", 0x04f9: "\u044b\u0308",
+	0x0587: "\u0565\u0582", 0x0622: "\u0627\u0653", 0x0623: "\u0627\u0654",
+	0x0624: "\u0648\u0654", 0x0625: "\u0627\u0655", 0x0626: "\u064a\u0654",
+	0x0675: "\u0627\u0674", 0x0676: "\u0648\u0674", 0x0677: "\u06c7\u0674",
+	0x0678: "\u064a\u0674", 0x06c0: "\u06d5\u0654", 0x06c2: "\u06c1\u0654",
+	0x06d3: "\u06d2\u0654", 0x0929: "\u0928\u093c", 0x0931: "\u0930\u093c",
+	0x0934: "\u0933\u093c", 0x0958: "\u0915\u093c", 0x0959: "\u0916\u093c",
+	0x095a: "\u0917\u093c", 0x095b: "\u091c\u093c", 0x095c: "\u0921\u093c",
+	0x095d: "\u0922\u093c", 0x095e: "\u092b\u093c", 0x095f: "\u092f\u093c",
+	0x09cb: "\u09c7\u09be", 0x09cc: "\u09c7\u09d7", 0x09dc: "\u09a1\u09bc",
+	0x09dd: "\u09a2\u09bc", 0x09df: "\u09af\u09bc", 0x0a33: "\u0a32\u0a3c",
+	0x0a36: "\u0a38\u0a3c", 0x0a59: "\u0a16\u0a3c", 0x0a5a: "\u0a17\u0a3c",
+	0x0a5b: "\u0a1c\u0a3c", 0x0a5e: "\u0a2b\u0a3c", 0x0b48: "\u0b47\u0b56",
+	0x0b4b: "\u0b47\u0b3e", 0x0b4c: "\u0b47\u0b57", 0x0b5c: "\u0b21\u0b3c",
+	0x0b5d: "\u0b22\u0b3c", 0x0b94: "\u0b92\u0bd7", 0x0bca: "\u0bc6\u0bbe",
+	0x0bcb: "\u0bc7\u0bbe", 0x0bcc: "\u0bc6\u0bd7", 0x0c48: "\u0c46\u0c56",
+	0x0cc0: "\u0cbf\u0cd5", 0x0cc7: "\u0cc6\u0cd5", 0x0cc8: "\u0cc6\u0cd6",
+	0x0cca: "\u0cc6\u0cc2", 0x0ccb: "\u0cc6\u0cc2\u0cd5", 0x0d4a: "\u0d46\u0d3e",
+	0x0d4b: "\u0d47\u0d3e", 0x0d4c: "\u0d46\u0d57", 0x0dda: "\u0dd9\u0dca",
+	0x0ddc: "\u0dd9\u0dcf", 0x0ddd: "\u0dd9\u0dcf\u0dca", 0x0dde: "\u0dd9\u0ddf",
+	0x0e33: "\u0e4d\u0e32", 0x0eb3: "\u0ecd\u0eb2", 0x0edc: "\u0eab\u0e99",
+	0x0edd: "\u0eab\u0ea1", 0x0f43: "\u0f42\u0fb7", 0x0f4d: "\u0f4c\u0fb7",
+	0x0f52: "\u0f51\u0fb7", 0x0f57: "\u0f56\u0fb7", 0x0f5c: "\u0f5b\u0fb7",
+	0x0f69: "\u0f40\u0fb5", 0x0f73: "\u0f71\u0f72", 0x0f75: "\u0f71\u0f74",
+	0x0f76: "\u0fb2\u0f80", 0x0f77: "\u0fb2\u0f71\u0f80", 0x0f78: "\u0fb3\u0f80",
+	0x0f79: "\u0fb3\u0f71\u0f80", 0x0f81: "\u0f71\u0f80", 0x0f93: "\u0f92\u0fb7",
+	0x0f9d: "\u0f9c\u0fb7", 0x0fa2: "\u0fa1\u0fb7", 0x0fa7: "\u0fa6\u0fb7",
+	0x0fac: "\u0fab\u0fb7", 0x0fb9: "\u0f90\u0fb5", 0x1026: "\u1025\u102e",
+	0x1b06: "\u1b05\u1b35", 0x1b08: "\u1b07\u1b35", 0x1b0a: "\u1b09\u1b35",
+	0x1b0c: "\u1b0b\u1b35", 0x1b0e: "\u1b0d\u1b35", 0x1b12: "\u1b11\u1b35",
+	0x1b3b: "\u1b3a\u1b35", 0x1b3d: "\u1b3c\u1b35", 0x1b40: "\u1b3e\u1b35",
+	0x1b41: "\u1b3f\u1b35", 0x1b43: "\u1b42\u1b35", 0x1e00: "\u0041\u0325",
+	0x1e01: "\u0061\u0325", 0x1e02: "\u0042\u0307", 0x1e03: "\u0062\u0307",
+	0x1e04: "\u0042\u0323", 0x1e05: "\u0062\u0323", 0x1e06: "\u0042\u0331",
+	0x1e07: "\u0062\u0331", 0x1e08: "\u0043\u0327\u0301", 0x1e09: "\u0063\u0327\u0301",
+	0x1e0a: "\u0044\u0307", 0x1e0b: "\u0064\u0307", 0x1e0c: "\u0044\u0323",
+	0x1e0d: "\u0064\u0323", 0x1e0e: "\u0044\u0331", 0x1e0f: "\u0064\u0331",
+	0x1e10: "\u0044\u0327", 0x1e11: "\u0064\u0327", 0x1e12: "\u0044\u032d",
+	0x1e13: "\u0064\u032d", 0x1e14: "\u0045\u0304\u0300", 0x1e15: "\u0065\u0304\u0300",
+	0x1e16: "\u0045\u0304\u0301", 0x1e17: "\u0065\u0304\u0301", 0x1e18: "\u0045\u032d",
+	0x1e19: "\u0065\u032d", 0x1e1a: "\u0045\u0330", 0x1e1b: "\u0065\u0330",
+	0x1e1c: "\u0045\u0327\u0306", 0x1e1d: "\u0065\u0327\u0306", 0x1e1e: "\u0046\u0307",
+	0x1e1f: "\u0066\u0307", 0x1e20: "\u0047\u0304", 0x1e21: "\u0067\u0304",
+	0x1e22: "\u0048\u0307", 0x1e23: "\u0068\u0307", 0x1e24: "\u0048\u0323",
+	0x1e25: "\u0068\u0323", 0x1e26: "\u0048\u0308", 0x1e27: "\u0068\u0308",
+	0x1e28: "\u0048\u0327", 0x1e29: "\u0068\u0327", 0x1e2a: "\u0048\u032e",
+	0x1e2b: "\u0068\u032e", 0x1e2c: "\u0049\u0330", 0x1e2d: "\u0069\u0330",
+	0x1e2e: "\u0049\u0308\u0301", 0x1e2f: "\u0069\u0308\u0301", 0x1e30: "\u004b\u0301",
+	0x1e31: "\u006b\u0301", 0x1e32: "\u004b\u0323", 0x1e33: "\u006b\u0323",
+	0x1e34: "\u004b\u0331", 0x1e35: "\u006b\u0331", 0x1e36: "\u004c\u0323",
+	0x1e37: "\u006c\u0323", 0x1e38: "\u004c\u0323\u0304", 0x1e39: "\u006c\u0323\u0304",
+	0x1e3a: "\u004c\u0331", 0x1e3b: "\u006c\u0331", 0x1e3c: "\u004c\u032d",
+	0x1e3d: "\u006c\u032d", 0x1e3e: "\u004d\u0301", 0x1e3f: "\u006d\u0301",
+	0x1e40: "\u004d\u0307", 0x1e41: "\u006d\u0307", 0x1e42: "\u004d\u0323",
+	0x1e43: "\u006d\u0323", 0x1e44: "\u004e\u0307", 0x1e45: "\u006e\u0307",
+	0x1e46: "\u004e\u0323", 0x1e47: "\u006e\u0323", 0x1e48: "\u004e\u0331",
+	0x1e49: "\u006e\u0331", 0x1e4a: "\u004e\u032d", 0x1e4b: "\u006e\u032d",
+	0x1e4c: "\u004f\u0303\u0301", 0x1e4d: "\u006f\u0303\u0301", 0x1e4e: "\u004f\u0303\u0308",
+	0x1e4f: "\u006f\u0303\u0308", 0x1e50: "\u004f\u0304\u0300", 0x1e51: "\u006f\u0304\u0300",
+	0x1e52: "\u004f\u0304\u0301", 0x1e53: "\u006f\u0304\u0301", 0x1e54: "\u0050\u0301",
+	0x1e55: "\u0070\u0301", 0x1e56: "\u0050\u0307", 0x1e57: "\u0070\u0307",
+	0x1e58: "\u0052\u0307", 0x1e59: "\u0072\u0307", 0x1e5a: "\u0052\u0323",
+	0x1e5b: "\u0072\u0323", 0x1e5c: "\u0052\u0323\u0304", 0x1e5d: "\u0072\u0323\u0304",
+	0x1e5e: "\u0052\u0331", 0x1e5f: "\u0072\u0331", 0x1e60: "\u0053\u0307",
+	0x1e61: "\u0073\u0307", 0x1e62: "\u0053\u0323", 0x1e63: "\u0073\u0323",
+	0x1e64: "\u0053\u0301\u0307", 0x1e65: "\u0073\u0301\u0307", 0x1e66: "\u0053\u030c\u0307",
+	0x1e67: "\u0073\u030c\u0307", 0x1e68: "\u0053\u0323\u0307", 0x1e69: "\u0073\u0323\u0307",
+	0x1e6a: "\u0054\u0307", 0x1e6b: "\u0074\u0307", 0x1e6c: "\u0054\u0323",
+	0x1e6d: "\u0074\u0323", 0x1e6e: "\u0054\u0331", 0x1e6f: "\u0074\u0331",
+	0x1e70: "\u0054\u032d", 0x1e71: "\u0074\u032d", 0x1e72: "\u0055\u0324",
+	0x1e73: "\u0075\u0324", 0x1e74: "\u0055\u0330", 0x1e75: "\u0075\u0330",
+	0x1e76: "\u0055\u032d", 0x1e77: "\u0075\u032d", 0x1e78: "\u0055\u0303\u0301",
+	0x1e79: "\u0075\u0303\u0301", 0x1e7a: "\u0055\u0304\u0308", 0x1e7b: "\u0075\u0304\u0308",
+	0x1e7c: "\u0056\u0303", 0x1e7d: "\u0076\u0303", 0x1e7e: "\u0056\u0323",
+	0x1e7f: "\u0076\u0323", 0x1e80: "\u0057\u0300", 0x1e81: "\u0077\u0300",
+	0x1e82: "\u0057\u0301", 0x1e83: "\u0077\u0301", 0x1e84: "\u0057\u0308",
+	0x1e85: "\u0077\u0308", 0x1e86: "\u0057\u0307", 0x1e87: "\u0077\u0307",
+	0x1e88: "\u0057\u0323", 0x1e89: "\u0077\u0323", 0x1e8a: "\u0058\u0307",
+	0x1e8b: "\u0078\u0307", 0x1e8c: "\u0058\u0308", 0x1e8d: "\u0078\u0308",
+	0x1e8e: "\u0059\u0307", 0x1e8f: "\u0079\u0307", 0x1e90: "\u005a\u0302",
+	0x1e91: "\u007a\u0302", 0x1e92: "\u005a\u0323", 0x1e93: "\u007a\u0323",
+	0x1e94: "\u005a\u0331", 0x1e95: "\u007a\u0331", 0x1e96: "\u0068\u0331",
+	0x1e97: "\u0074\u0308", 0x1e98: "\u0077\u030a", 0x1e99: "\u0079\u030a",
+	0x1e9a: "\u0061\u02be", 0x1e9b: "\u0073\u0307", 0x1ea0: "\u0041\u0323",
+	0x1ea1: "\u0061\u0323", 0x1ea2: "\u0041\u0309", 0x1ea3: "\u0061\u0309",
+	0x1ea4: "\u0041\u0302\u0301", 0x1ea5: "\u0061\u0302\u0301", 0x1ea6: "\u0041\u0302\u0300",
+	0x1ea7: "\u0061\u0302\u0300", 0x1ea8: "\u0041\u0302\u0309", 0x1ea9: "\u0061\u0302\u0309",
+	0x1eaa: "\u0041\u0302\u0303", 0x1eab: "\u0061\u0302\u0303", 0x1eac: "\u0041\u0323\u0302",
+	0x1ead: "\u0061\u0323\u0302", 0x1eae: "\u0041\u0306\u0301", 0x1eaf: "\u0061\u0306\u0301",
+	0x1eb0: "\u0041\u0306\u0300", 0x1eb1: "\u0061\u0306\u0300", 0x1eb2: "\u0041\u0306\u0309",
+	0x1eb3: "\u0061\u0306\u0309", 0x1eb4: "\u0041\u0306\u0303", 0x1eb5: "\u0061\u0306\u0303",
+	0x1eb6: "\u0041\u0323\u0306", 0x1eb7: "\u0061\u0323\u0306", 0x1eb8: "\u0045\u0323",
+	0x1eb9: "\u0065\u0323", 0x1eba: "\u0045\u0309", 0x1ebb: "\u0065\u0309",
+	0x1ebc: "\u0045\u0303", 0x1ebd: "\u0065\u0303", 0x1ebe: "\u0045\u0302\u0301",
+	0x1ebf: "\u0065\u0302\u0301", 0x1ec0: "\u0045\u0302\u0300", 0x1ec1: "\u0065\u0302\u0300",
+	0x1ec2: "\u0045\u0302\u0309", 0x1ec3: "\u0065\u0302\u0309", 0x1ec4: "\u0045\u0302\u0303",
+	0x1ec5: "\u0065\u0302\u0303", 0x1ec6: "\u0045\u0323\u0302", 0x1ec7: "\u0065\u0323\u0302",
+	0x1ec8: "\u0049\u0309", 0x1ec9: "\u0069\u0309", 0x1eca: "\u0049\u0323",
+	0x1ecb: "\u0069\u0323", 0x1ecc: "\u004f\u0323", 0x1ecd: "\u006f\u0323",
+	0x1ece: "\u004f\u0309", 0x1ecf: "\u006f\u0309", 0x1ed0: "\u004f\u0302\u0301",
+	0x1ed1: "\u006f\u0302\u0301", 0x1ed2: "\u004f\u0302\u0300", 0x1ed3: "\u006f\u0302\u0300",
+	0x1ed4: "\u004f\u0302\u0309", 0x1ed5: "\u006f\u0302\u0309", 0x1ed6: "\u004f\u0302\u0303",
+	0x1ed7: "\u006f\u0302\u0303", 0x1ed8: "\u004f\u0323\u0302", 0x1ed9: "\u006f\u0323\u0302",
+	0x1eda: "\u004f\u031b\u0301", 0x1edb: "\u006f\u031b\u0301", 0x1edc: "\u004f\u031b\u0300",
+	0x1edd: "\u006f\u031b\u0300", 0x1ede: "\u004f\u031b\u0309", 0x1edf: "\u006f\u031b\u0309",
+	0x1ee0: "\u004f\u031b\u0303", 0x1ee1: "\u006f\u031b\u0303", 0x1ee2: "\u004f\u031b\u0323",
+	0x1ee3: "\u006f\u031b\u0323", 0x1ee4: "\u0055\u0323", 0x1ee5: "\u0075\u0323",
+	0x1ee6: "\u0055\u0309", 0x1ee7: "\u0075\u0309", 0x1ee8: "\u0055\u031b\u0301",
+	0x1ee9: "\u0075\u031b\u0301", 0x1eea: "\u0055\u031b\u0300", 0x1eeb: "\u0075\u031b\u0300",
+	0x1eec: "\u0055\u031b\u0309", 0x1eed: "\u0075\u031b\u0309", 0x1eee: "\u0055\u031b\u0303",
+	0x1eef: "\u0075\u031b\u0303", 0x1ef0: "\u0055\u031b\u0323", 0x1ef1: "\u0075\u031b\u0323",
+	0x1ef2: "\u0059\u0300", 0x1ef3: "\u0079\u0300", 0x1ef4: "\u0059\u0323",
+	0x1ef5: "\u0079\u0323", 0x1ef6: "\u0059\u0309", 0x1ef7: "\u0079\u0309",
+	0x1ef8: "\u0059\u0303", 0x1ef9: "\u0079\u0303", 0x1f00: "\u03b1\u0313",
+	0x1f01: "\u03b1\u0314", 0x1f02: "\u03b1\u0313\u0300", 0x1f03: "\u03b1\u0314\u0300",
+	0x1f04: "\u03b1\u0313\u0301", 0x1f05: "\u03b1\u0314\u0301", 0x1f06: "\u03b1\u0313\u0342",
+	0x1f07: "\u03b1\u0314\u0342", 0x1f08: "\u0391\u0313", 0x1f09: "\u0391\u0314",
+	0x1f0a: "\u0391\u0313\u0300", 0x1f0b: "\u0391\u0314\u0300", 0x1f0c: "\u0391\u0313\u0301",
+	0x1f0d: "\u0391\u0314\u0301", 0x1f0e: "\u0391\u0313\u0342", 0x1f0f: "\u0391\u0314\u0342",
+	0x1f10: "\u03b5\u0313", 0x1f11: "\u03b5\u0314", 0x1f12: "\u03b5\u0313\u0300",
+	0x1f13: "\u03b5\u0314\u0300", 0x1f14: "\u03b5\u0313\u0301", 0x1f15: "\u03b5\u0314\u0301",
+	0x1f18: "\u0395\u0313", 0x1f19: "\u0395\u0314", 0x1f1a: "\u0395\u0313\u0300",
+	0x1f1b: "\u0395\u0314\u0300", 0x1f1c: "\u0395\u0313\u0301", 0x1f1d: "\u0395\u0314\u0301",
+	0x1f20: "\u03b7\u0313", 0x1f21: "\u03b7\u0314", 0x1f22: "\u03b7\u0313\u0300",
+	0x1f23: "\u03b7\u0314\u0300", 0x1f24: "\u03b7\u0313\u0301", 0x1f25: "\u03b7\u0314\u0301",
+	0x1f26: "\u03b7\u0313\u0342", 0x1f27: "\u03b7\u0314\u0342", 0x1f28: "\u0397\u0313",
+	0x1f29: "\u0397\u0314", 0x1f2a: "\u0397\u0313\u0300", 0x1f2b: "\u0397\u0314\u0300",
+	0x1f2c: "\u0397\u0313\u0301", 0x1f2d: "\u0397\u0314\u0301", 0x1f2e: "\u0397\u0313\u0342",
+	0x1f2f: "\u0397\u0314\u0342", 0x1f30: "\u03b9\u0313", 0x1f31: "\u03b9\u0314",
+	0x1f32: "\u03b9\u0313\u0300", 0x1f33: "\u03b9\u0314\u0300", 0x1f34: "\u03b9\u0313\u0301",
+	0x1f35: "\u03b9\u0314\u0301", 0x1f36: "\u03b9\u0313\u0342", 0x1f37: "\u03b9\u0314\u0342",
+	0x1f38: "\u0399\u0313", 0x1f39: "\u0399\u0314", 0x1f3a: "\u0399\u0313\u0300",
+	0x1f3b: "\u0399\u0314\u0300", 0x1f3c: "\u0399\u0313\u0301", 0x1f3d: "\u0399\u0314\u0301",
+	0x1f3e: "\u0399\u0313\u0342", 0x1f3f: "\u0399\u0314\u0342", 0x1f40: "\u03bf\u0313",
+	0x1f41: "\u03bf\u0314", 0x1f42: "\u03bf\u0313\u0300", 0x1f43: "\u03bf\u0314\u0300",
+	0x1f44: "\u03bf\u0313\u0301", 0x1f45: "\u03bf\u0314\u0301", 0x1f48: "\u039f\u0313",
+	0x1f49: "\u039f\u0314", 0x1f4a: "\u039f\u0313\u0300", 0x1f4b: "\u039f\u0314\u0300",
+	0x1f4c: "\u039f\u0313\u0301", 0x1f4d: "\u039f\u0314\u0301", 0x1f50: "\u03c5\u0313",
+	0x1f51: "\u03c5\u0314", 0x1f52: "\u03c5\u0313\u0300", 0x1f53: "\u03c5\u0314\u0300",
+	0x1f54: "\u03c5\u0313\u0301", 0x1f55: "\u03c5\u0314\u0301", 0x1f56: "\u03c5\u0313\u0342",
+	0x1f57: "\u03c5\u0314\u0342", 0x1f59: "\u03a5\u0314", 0x1f5b: "\u03a5\u0314\u0300",
+	0x1f5d: "\u03a5\u0314\u0301", 0x1f5f: "\u03a5\u0314\u0342", 0x1f60: "\u03c9\u0313",
+	0x1f61: "\u03c9\u0314", 0x1f62: "\u03c9\u0313\u0300", 0x1f63: "\u03c9\u0314\u0300",
+	0x1f64: "\u03c9\u0313\u0301", 0x1f65: "\u03c9\u0314\u0301", 0x1f66: "\u03c9\u0313\u0342",
+	0x1f67: "\u03c9\u0314\u0342", 0x1f68: "\u03a9\u0313", 0x1f69: "\u03a9\u0314",
+	0x1f6a: "\u03a9\u0313\u0300", 0x1f6b: "\u03a9\u0314\u0300", 0x1f6c: "\u03a9\u0313\u0301",
+	0x1f6d: "\u03a9\u0314\u0301", 0x1f6e: "\u03a9\u0313\u0342", 0x1f6f: "\u03a9\u0314\u0342",
+	0x1f70: "\u03b1\u0300", 0x1f71: "\u03b1\u0301", 0x1f72: "\u03b5\u0300",
+	0x1f73: "\u03b5\u0301", 0x1f74: "\u03b7\u0300", 0x1f75: "\u03b7\u0301",
+	0x1f76: "\u03b9\u0300", 0x1f77: "\u03b9\u0301", 0x1f78: "\u03bf\u0300",
+	0x1f79: "\u03bf\u0301", 0x1f7a: "\u03c5\u0300", 0x1f7b: "\u03c5\u0301",
+	0x1f7c: "\u03c9\u0300", 0x1f7d: "\u03c9\u0301", 0x1f80: "\u03b1\u0313\u0345",
+	0x1f81: "\u03b1\u0314\u0345", 0x1f82: "\u03b1\u0313\u0300\u0345",
+	0x1f83: "\u03b1\u0314\u0300\u0345", 0x1f84: "\u03b1\u0313\u0301\u0345",
+	0x1f85: "\u03b1\u0314\u0301\u0345", 0x1f86: "\u03b1\u0313\u0342\u0345",
+	0x1f87: "\u03b1\u0314\u0342\u0345", 0x1f88: "\u0391\u0313\u0345",
+	0x1f89: "\u0391\u0314\u0345", 0x1f8a: "\u0391\u0313\u0300\u0345",
+	0x1f8b: "\u0391\u0314\u0300\u0345", 0x1f8c: "\u0391\u0313\u0301\u0345",
+	0x1f8d: "\u0391\u0314\u0301\u0345", 0x1f8e: "\u0391\u0313\u0342\u0345",
+	0x1f8f: "\u0391\u0314\u0342\u0345", 0x1f90: "\u03b7\u0313\u0345",
+	0x1f91: "\u03b7\u0314\u0345", 0x1f92: "\u03b7\u0313\u0300\u0345",
+	0x1f93: "\u03b7\u0314\u0300\u0345", 0x1f94: "\u03b7\u0313\u0301\u0345",
+	0x1f95: "\u03b7\u0314\u0301\u0345", 0x1f96: "\u03b7\u0313\u0342\u0345",
+	0x1f97: "\u03b7\u0314\u0342\u0345", 0x1f98: "\u0397\u0313\u0345",
+	0x1f99: "\u0397\u0314\u0345", 0x1f9a: "\u0397\u0313\u0300\u0345",
+	0x1f9b: "\u0397\u0314\u0300\u0345", 0x1f9c: "\u0397\u0313\u0301\u0345",
+	0x1f9d: "\u0397\u0314\u0301\u0345", 0x1f9e: "\u0397\u0313\u0342\u0345",
+	0x1f9f: "\u0397\u0314\u0342\u0345", 0x1fa0: "\u03c9\u0313\u0345",
+	0x1fa1: "\u03c9\u0314\u0345", 0x1fa2: "\u03c9\u0313\u0300\u0345",
+	0x1fa3: "\u03c9\u0314\u0300\u0345", 0x1fa4: "\u03c9\u0313\u0301\u0345",
+	0x1fa5: "\u03c9\u0314\u0301\u0345", 0x1fa6: "\u03c9\u0313\u0342\u0345",
+	0x1fa7: "\u03c9\u0314\u0342\u0345", 0x1fa8: "\u03a9\u0313\u0345",
+	0x1fa9: "\u03a9\u0314\u0345", 0x1faa: "\u03a9\u0313\u0300\u0345",
+	0x1fab: "\u03a9\u0314\u0300\u0345", 0x1fac: "\u03a9\u0313\u0301\u0345",
+	0x1fad: "\u03a9\u0314\u0301\u0345", 0x1fae: "\u03a9\u0313\u0342\u0345",
+	0x1faf: "\u03a9\u0314\u0342\u0345", 0x1fb0: "\u03b1\u0306", 0x1fb1: "\u03b1\u0304",
+	0x1fb2: "\u03b1\u0300\u0345", 0x1fb3: "\u03b1\u0345", 0x1fb4: "\u03b1\u0301\u0345",
+	0x1fb6: "\u03b1\u0342", 0x1fb7: "\u03b1\u0342\u0345", 0x1fb8: "\u0391\u0306",
+	0x1fb9: "\u0391\u0304", 0x1fba: "\u0391\u0300", 0x1fbb: "\u0391\u0301",
+	0x1fbc: "\u0391\u0345", 0x1fbd: "\u0020\u0313", 0x1fbf: "\u0020\u0313",
+	0x1fc0: "\u0020\u0342", 0x1fc1: "\u0020\u0308\u0342", 0x1fc2: "\u03b7\u0300\u0345",
+	0x1fc3: "\u03b7\u0345", 0x1fc4: "\u03b7\u0301\u0345", 0x1fc6: "\u03b7\u0342",
+	0x1fc7: "\u03b7\u0342\u0345", 0x1fc8: "\u0395\u0300", 0x1fc9: "\u0395\u0301",
+	0x1fca: "\u0397\u0300", 0x1fcb: "\u0397\u0301", 0x1fcc: "\u0397\u0345",
+	0x1fcd: "\u0020\u0313\u0300", 0x1fce: "\u0020\u0313\u0301", 0x1fcf: "\u0020\u0313\u0342",
+	0x1fd0: "\u03b9\u0306", 0x1fd1: "\u03b9\u0304", 0x1fd2: "\u03b9\u0308\u0300",
+	0x1fd3: "\u03b9\u0308\u0301", 0x1fd6: "\u03b9\u0342", 0x1fd7: "\u03b9\u0308\u0342",
+	0x1fd8: "\u0399\u0306", 0x1fd9: "\u0399\u0304", 0x1fda: "\u0399\u0300",
+	0x1fdb: "\u0399\u0301", 0x1fdd: "\u0020\u0314\u0300", 0x1fde: "\u0020\u0314\u0301",
+	0x1fdf: "\u0020\u0314\u0342", 0x1fe0: "\u03c5\u0306", 0x1fe1: "\u03c5\u0304",
+	0x1fe2: "\u03c5\u0308\u0300", 0x1fe3: "\u03c5\u0308\u0301", 0x1fe4: "\u03c1\u0313",
+	0x1fe5: "\u03c1\u0314", 0x1fe6: "\u03c5\u0342", 0x1fe7: "\u03c5\u0308\u0342",
+	0x1fe8: "\u03a5\u0306", 0x1fe9: "\u03a5\u0304", 0x1fea: "\u03a5\u0300",
+	0x1feb: "\u03a5\u0301", 0x1fec: "\u03a1\u0314", 0x1fed: "\u0020\u0308\u0300",
+	0x1fee: "\u0020\u0308\u0301", 0x1ff2: "\u03c9\u0300\u0345", 0x1ff3: "\u03c9\u0345",
+	0x1ff4: "\u03c9\u0301\u0345", 0x1ff6: "\u03c9\u0342", 0x1ff7: "\u03c9\u0342\u0345",
+	0x1ff8: "\u039f\u0300", 0x1ff9: "\u039f\u0301", 0x1ffa: "\u03a9\u0300",
+	0x1ffb: "\u03a9\u0301", 0x1ffc: "\u03a9\u0345", 0x1ffd: "\u0020\u0301",
+	0x1ffe: "\u0020\u0314", 0x2017: "\u0020\u0333", 0x2025: "\u002e\u002e",
+	0x2026: "\u002e\u002e\u002e", 0x2033: "\u2032\u2032", 0x2034: "\u2032\u2032\u2032",
+	0x2036: "\u2035\u2035", 0x2037: "\u2035\u2035\u2035", 0x203c: "\u0021\u0021",
+	0x203e: "\u0020\u0305", 0x2047: "\u003f\u003f", 0x2048: "\u003f\u0021",
+	0x2049: "\u0021\u003f", 0x2057: "\u2032\u2032\u2032\u2032", 0x20a8: "\u0052\u0073",
+	0x2100: "\u0061\u002f\u0063", 0x2101: "\u0061\u002f\u0073", 0x2103: "\u00b0\u0043",
+	0x2105: "\u0063\u002f\u006f", 0x2106: "\u0063\u002f\u0075", 0x2109: "\u00b0\u0046",
+	0x2116: "\u004e\u006f", 0x2120: "\u0053\u004d", 0x2121: "\u0054\u0045\u004c",
+	0x2122: "\u0054\u004d", 0x212b: "\u0041\u030a", 0x213b: "\u0046\u0041\u0058",
+	0x2150: "\u0031\u2044\u0037", 0x2151: "\u0031\u2044\u0039",
+	0x2152: "\u0031\u2044\u0031\u0030", 0x2153: "\u0031\u2044\u0033",
+	0x2154: "\u0032\u2044\u0033", 0x2155: "\u0031\u2044\u0035", 0x2156: "\u0032\u2044\u0035",
+	0x2157: "\u0033\u2044\u0035", 0x2158: "\u0034\u2044\u0035", 0x2159: "\u0031\u2044\u0036",
+	0x215a: "\u0035\u2044\u0036", 0x215b: "\u0031\u2044\u0038", 0x215c: "\u0033\u2044\u0038",
+	0x215d: "\u0035\u2044\u0038", 0x215e: "\u0037\u2044\u0038", 0x215f: "\u0031\u2044",
+	0x2161: "\u0049\u0049", 0x2162: "\u0049\u0049\u0049", 0x2163: "\u0049\u0056",
+	0x2165: "\u0056\u0049", 0x2166: "\u0056\u0049\u0049", 0x2167: "\u0056\u0049\u0049\u0049",
+	0x2168: "\u0049\u0058", 0x216a: "\u0058\u0049", 0x216b: "\u0058\u0049\u0049",
+	0x2171: "\u0069\u0069", 0x2172: "\u0069\u0069\u0069", 0x2173: "\u0069\u0076",
+	0x2175: "\u0076\u0069", 0x2176: "\u0076\u0069\u0069", 0x2177: "\u0076\u0069\u0069\u0069",
+	0x2178: "\u0069\u0078", 0x217a: "\u0078\u0069", 0x217b: "\u0078\u0069\u0069",
+	0x2189: "\u0030\u2044\u0033", 0x219a: "\u2190\u0338", 0x219b: "\u2192\u0338",
+	0x21ae: "\u2194\u0338", 0x21cd: "\u21d0\u0338", 0x21ce: "\u21d4\u0338",
+	0x21cf: "\u21d2\u0338", 0x2204: "\u2203\u0338", 0x2209: "\u2208\u0338",
+	0x220c: "\u220b\u0338", 0x2224: "\u2223\u0338", 0x2226: "\u2225\u0338",
+	0x222c: "\u222b\u222b", 0x222d: "\u222b\u222b\u222b", 0x222f: "\u222e\u222e",
+	0x2230: "\u222e\u222e\u222e", 0x2241: "\u223c\u0338", 0x2244: "\u2243\u0338",
+	0x2247: "\u2245\u0338", 0x2249: "\u2248\u0338", 0x2260: "\u003d\u0338",
+	0x2262: "\u2261\u0338", 0x226d: "\u224d\u0338", 0x226e: "\u003c\u0338",
+	0x226f: "\u003e\u0338", 0x2270: "\u2264\u0338", 0x2271: "\u2265\u0338",
+	0x2274: "\u2272\u0338", 0x2275: "\u2273\u0338", 0x2278: "\u2276\u0338",
+	0x2279: "\u2277\u0338", 0x2280: "\u227a\u0338", 0x2281: "\u227b\u0338",
+	0x2284: "\u2282\u0338", 0x2285: "\u2283\u0338", 0x2288: "\u2286\u0338",
+	0x2289: "\u2287\u0338", 0x22ac: "\u22a2\u0338", 0x22ad: "\u22a8\u0338",
+	0x22ae: "\u22a9\u0338", 0x22af: "\u22ab\u0338", 0x22e0: "\u227c\u0338",
+	0x22e1: "\u227d\u0338", 0x22e2: "\u2291\u0338", 0x22e3: "\u2292\u0338",
+	0x22ea: "\u22b2\u0338", 0x22eb: "\u22b3\u0338", 0x22ec: "\u22b4\u0338",
+	0x22ed: "\u22b5\u0338", 0x2469: "\u0031\u0030", 0x246a: "\u0031\u0031",
+	0x246b: "\u0031\u0032", 0x246c: "\u0031\u0033", 0x246d: "\u0031\u0034",
+	0x246e: "\u0031\u0035", 0x246f: "\u0031\u0036", 0x2470: "\u0031\u0037",
+	0x2471: "\u0031\u0038", 0x2472: "\u0031\u0039", 0x2473: "\u0032\u0030",
+	0x2474: "\u0028\u0031\u0029", 0x2475: "\u0028\u0032\u0029", 0x2476: "\u0028\u0033\u0029",
+	0x2477: "\u0028\u0034\u0029", 0x2478: "\u0028\u0035\u0029", 0x2479: "\u0028\u0036\u0029",
+	0x247a: "\u0028\u0037\u0029", 0x247b: "\u0028\u0038\u0029", 0x247c: "\u0028\u0039\u0029",
+	0x247d: "\u0028\u0031\u0030\u0029", 0x247e: "\u0028\u0031\u0031\u0029",
+	0x247f: "\u0028\u0031\u0032\u0029", 0x2480: "\u0028\u0031\u0033\u0029",
+	0x2481: "\u0028\u0031\u0034\u0029", 0x2482: "\u0028\u0031\u0035\u0029",
+	0x2483: "\u0028\u0031\u0036\u0029", 0x2484: "\u0028\u0031\u0037\u0029",
+	0x2485: "\u0028\u0031\u0038\u0029", 0x2486: "\u0028\u0031\u0039\u0029",
+	0x2487: "\u0028\u0032\u0030\u0029", 0x2488: "\u0031\u002e", 0x2489: "\u0032\u002e",
+	0x248a: "\u0033\u002e", 0x248b: "\u0034\u002e", 0x248c: "\u0035\u002e",
+	0x248d: "\u0036\u002e", 0x248e: "\u0037\u002e", 0x248f: "\u0038\u002e",
+	0x2490: "\u0039\u002e", 0x2491: "\u0031\u0030\u002e", 0x2492: "\u0031\u0031\u002e",
+	0x2493: "\u0031\u0032\u002e", 0x2494: "\u0031\u0033\u002e", 0x2495: "\u0031\u0034\u002e",
+	0x2496: "\u0031\u0035\u002e", 0x2497: "\u0031\u0036\u002e", 0x2498: "\u0031\u0037\u002e",
+	0x2499: "\u0031\u0038\u002e", 0x249a: "\u0031\u0039\u002e", 0x249b: "\u0032\u0030\u002e",
+	0x249c: "\u0028\u0061\u0029", 0x249d: "\u0028\u0062\u0029", 0x249e: "\u0028\u0063\u0029",
+	0x249f: "\u0028\u0064\u0029", 0x24a0: "\u0028\u0065\u0029", 0x24a1: "\u0028\u0066\u0029",
+	0x24a2: "\u0028\u0067\u0029", 0x24a3: "\u0028\u0068\u0029", 0x24a4: "\u0028\u0069\u0029",
+	0x24a5: "\u0028\u006a\u0029", 0x24a6: "\u0028\u006b\u0029", 0x24a7: "\u0028\u006c\u0029",
+	0x24a8: "\u0028\u006d\u0029", 0x24a9: "\u0028\u006e\u0029", 0x24aa: "\u0028\u006f\u0029",
+	0x24ab: "\u0028\u0070\u0029", 0x24ac: "\u0028\u0071\u0029", 0x24ad: "\u0028\u0072\u0029",
+	0x24ae: "\u0028\u0073\u0029", 0x24af: "\u0028\u0074\u0029", 0x24b0: "\u0028\u0075\u0029",
+	0x24b1: "\u0028\u0076\u0029", 0x24b2: "\u0028\u0077\u0029", 0x24b3: "\u0028\u0078\u0029",
+	0x24b4: "\u0028\u0079\u0029", 0x24b5: "\u0028\u007a\u0029",
+	0x2a0c: "\u222b\u222b\u222b\u222b", 0x2a74: "\u003a\u003a\u003d", 0x2a75: "\u003d\u003d",
+	0x2a76: "\u003d\u003d\u003d", 0x2adc: "\u2add\u0338", 0x304c: "\u304b\u3099",
+	0x304e: "\u304d\u3099", 0x3050: "\u304f\u3099", 0x3052: "\u3051\u3099",
+	0x3054: "\u3053\u3099", 0x3056: "\u3055\u3099", 0x3058: "\u3057\u3099",
+	0x305a: "\u3059\u3099", 0x305c: "\u305b\u3099", 0x305e: "\u305d\u3099",
+	0x3060: "\u305f\u3099", 0x3062: "\u3061\u3099", 0x3065: "\u3064\u3099",
+	0x3067: "\u3066\u3099", 0x3069: "\u3068\u3099", 0x3070: "\u306f\u3099",
+	0x3071: "\u306f\u309a", 0x3073: "\u3072\u3099", 0x3074: "\u3072\u309a",
+	0x3076: "\u3075\u3099", 0x3077: "\u3075\u309a", 0x3079: "\u3078\u3099",
+	0x307a: "\u3078\u309a", 0x307c: "\u307b\u3099", 0x307d: "\u307b\u309a",
+	0x3094: "\u3046\u3099", 0x309b: "\u0020\u3099", 0x309c: "\u0020\u309a",
+	0x309e: "\u309d\u3099", 0x309f: "\u3088\u308a", 0x30ac: "\u30ab\u3099",
+	0x30ae: "\u30ad\u3099", 0x30b0: "\u30af\u3099", 0x30b2: "\u30b1\u3099",
+	0x30b4: "\u30b3\u3099", 0x30b6: "\u30b5\u3099", 0x30b8: "\u30b7\u3099",
+	0x30ba: "\u30b9\u3099", 0x30bc: "\u30bb\u3099", 0x30be: "\u30bd\u3099",
+	0x30c0: "\u30bf\u3099", 0x30c2: "\u30c1\u3099", 0x30c5: "\u30c4\u3099",
+	0x30c7: "\u30c6\u3099", 0x30c9: "\u30c8\u3099", 0x30d0: "\u30cf\u3099",
+	0x30d1: "\u30cf\u309a", 0x30d3: "\u30d2\u3099", 0x30d4: "\u30d2\u309a",
+	0x30d6: "\u30d5\u3099", 0x30d7: "\u30d5\u309a", 0x30d9: "\u30d8\u3099",
+	0x30da: "\u30d8\u309a", 0x30dc: "\u30db\u3099", 0x30dd: "\u30db\u309a",
+	0x30f4: "\u30a6\u3099", 0x30f7: "\u30ef\u3099", 0x30f8: "\u30f0\u3099",
+	0x30f9: "\u30f1\u3099", 0x30fa: "\u30f2\u3099", 0x30fe: "\u30fd\u3099",
+	0x30ff: "\u30b3\u30c8", 0x3200: "\u0028\u1100\u0029", 0x3201: "\u0028\u1102\u0029",
+	0x3202: "\u0028\u1103\u0029", 0x3203: "\u0028\u1105\u0029", 0x3204: "\u0028\u1106\u0029",
+	0x3205: "\u0028\u1107\u0029", 0x3206: "\u0028\u1109\u0029", 0x3207: "\u0028\u110b\u0029",
+	0x3208: "\u0028\u110c\u0029", 0x3209: "\u0028\u110e\u0029", 0x320a: "\u0028\u110f\u0029",
+	0x320b: "\u0028\u1110\u0029", 0x320c: "\u0028\u1111\u0029", 0x320d: "\u0028\u1112\u0029",
+	0x320e: "\u0028\u1100\u1161\u0029", 0x320f: "\u0028\u1102\u1161\u0029",
+	0x3210: "\u0028\u1103\u1161\u0029", 0x3211: "\u0028\u1105\u1161\u0029",
+	0x3212: "\u0028\u1106\u1161\u0029", 0x3213: "\u0028\u1107\u1161\u0029",
+	0x3214: "\u0028\u1109\u1161\u0029", 0x3215: "\u0028\u110b\u1161\u0029",
+	0x3216: "\u0028\u110c\u1161\u0029", 0x3217: "\u0028\u110e\u1161\u0029",
+	0x3218: "\u0028\u110f\u1161\u0029", 0x3219: "\u0028\u1110\u1161\u0029",
+	0x321a: "\u0028\u1111\u1161\u0029", 0x321b: "\u0028\u1112\u1161\u0029",
+	0x321c: "\u0028\u110c\u116e\u0029", 0x321d: "\u0028\u110b\u1169\u110c\u1165\u11ab\u0029",
+	0x321e: "\u0028\u110b\u1169\u1112\u116e\u0029", 0x3220: "\u0028\u4e00\u0029",
+	0x3221: "\u0028\u4e8c\u0029", 0x3222: "\u0028\u4e09\u0029", 0x3223: "\u0028\u56db\u0029",
+	0x3224: "\u0028\u4e94\u0029", 0x3225: "\u0028\u516d\u0029", 0x3226: "\u0028\u4e03\u0029",
+	0x3227: "\u0028\u516b\u0029", 0x3228: "\u0028\u4e5d\u0029", 0x3229: "\u0028\u5341\u0029",
+	0x322a: "\u0028\u6708\u0029", 0x322b: "\u0028\u706b\u0029", 0x322c: "\u0028\u6c34\u0029",
+	0x322d: "\u0028\u6728\u0029", 0x322e: "\u0028\u91d1\u0029", 0x322f: "\u0028\u571f\u0029",
+	0x3230: "\u0028\u65e5\u0029", 0x3231: "\u0028\u682a\u0029", 0x3232: "\u0028\u6709\u0029",
+	0x3233: "\u0028\u793e\u0029", 0x3234: "\u0028\u540d\u0029", 0x3235: "\u0028\u7279\u0029",
+	0x3236: "\u0028\u8ca1\u0029", 0x3237: "\u0028\u795d\u0029", 0x3238: "\u0028\u52b4\u0029",
+	0x3239: "\u0028\u4ee3\u0029", 0x323a: "\u0028\u547c\u0029", 0x323b: "\u0028\u5b66\u0029",
+	0x323c: "\u0028\u76e3\u0029", 0x323d: "\u0028\u4f01\u0029", 0x323e: "\u0028\u8cc7\u0029",
+	0x323f: "\u0028\u5354\u0029", 0x3240: "\u0028\u796d\u0029", 0x3241: "\u0028\u4f11\u0029",
+	0x3242: "\u0028\u81ea\u0029", 0x3243: "\u0028\u81f3\u0029", 0x3250: "\u0050\u0054\u0045",
+	0x3251: "\u0032\u0031", 0x3252: "\u0032\u0032", 0x3253: "\u0032\u0033",
+	0x3254: "\u0032\u0034", 0x3255: "\u0032\u0035", 0x3256: "\u0032\u0036",
+	0x3257: "\u0032\u0037", 0x3258: "\u0032\u0038", 0x3259: "\u0032\u0039",
+	0x325a: "\u0033\u0030", 0x325b: "\u0033\u0031", 0x325c: "\u0033\u0032",
+	0x325d: "\u0033\u0033", 0x325e: "\u0033\u0034", 0x325f: "\u0033\u0035",
+	0x326e: "\u1100\u1161", 0x326f: "\u1102\u1161", 0x3270: "\u1103\u1161",
+	0x3271: "\u1105\u1161", 0x3272: "\u1106\u1161", 0x3273: "\u1107\u1161",
+	0x3274: "\u1109\u1161", 0x3275: "\u110b\u1161", 0x3276: "\u110c\u1161",
+	0x3277: "\u110e\u1161", 0x3278: "\u110f\u1161", 0x3279: "\u1110\u1161",
+	0x327a: "\u1111\u1161", 0x327b: "\u1112\u1161", 0x327c: "\u110e\u1161\u11b7\u1100\u1169",
+	0x327d: "\u110c\u116e\u110b\u1174", 0x327e: "\u110b\u116e", 0x32b1: "\u0033\u0036",
+	0x32b2: "\u0033\u0037", 0x32b3: "\u0033\u0038", 0x32b4: "\u0033\u0039",
+	0x32b5: "\u0034\u0030", 0x32b6: "\u0034\u0031", 0x32b7: "\u0034\u0032",
+	0x32b8: "\u0034\u0033", 0x32b9: "\u0034\u0034", 0x32ba: "\u0034\u0035",
+	0x32bb: "\u0034\u0036", 0x32bc: "\u0034\u0037", 0x32bd: "\u0034\u0038",
+	0x32be: "\u0034\u0039", 0x32bf: "\u0035\u0030", 0x32c0: "\u0031\u6708",
+	0x32c1: "\u0032\u6708", 0x32c2: "\u0033\u6708", 0x32c3: "\u0034\u6708",
+	0x32c4: "\u0035\u6708", 0x32c5: "\u0036\u6708", 0x32c6: "\u0037\u6708",
+	0x32c7: "\u0038\u6708", 0x32c8: "\u0039\u6708", 0x32c9: "\u0031\u0030\u6708",
+	0x32ca: "\u0031\u0031\u6708", 0x32cb: "\u0031\u0032\u6708", 0x32cc: "\u0048\u0067",
+	0x32cd: "\u0065\u0072\u0067", 0x32ce: "\u0065\u0056", 0x32cf: "\u004c\u0054\u0044",
+	0x32ff: "\u4ee4\u548c", 0x3300: "\u30a2\u30cf\u309a\u30fc\u30c8",
+	0x3301: "\u30a2\u30eb\u30d5\u30a1", 0x3302: "\u30a2\u30f3\u30d8\u309a\u30a2",
+	0x3303: "\u30a2\u30fc\u30eb", 0x3304: "\u30a4\u30cb\u30f3\u30af\u3099",
+	0x3305: "\u30a4\u30f3\u30c1", 0x3306: "\u30a6\u30a9\u30f3",
+	0x3307: "\u30a8\u30b9\u30af\u30fc\u30c8\u3099", 0x3308: "\u30a8\u30fc\u30ab\u30fc",
+	0x3309: "\u30aa\u30f3\u30b9", 0x330a: "\u30aa\u30fc\u30e0", 0x330b: "\u30ab\u30a4\u30ea",
+	0x330c: "\u30ab\u30e9\u30c3\u30c8", 0x330d: "\u30ab\u30ed\u30ea\u30fc",
+	0x330e: "\u30ab\u3099\u30ed\u30f3", 0x330f: "\u30ab\u3099\u30f3\u30de",
+	0x3310: "\u30ad\u3099\u30ab\u3099", 0x3311: "\u30ad\u3099\u30cb\u30fc",
+	0x3312: "\u30ad\u30e5\u30ea\u30fc", 0x3313: "\u30ad\u3099\u30eb\u30bf\u3099\u30fc",
+	0x3314: "\u30ad\u30ed", 0x3315: "\u30ad\u30ed\u30af\u3099\u30e9\u30e0",
+	0x3316: "\u30ad\u30ed\u30e1\u30fc\u30c8\u30eb", 0x3317: "\u30ad\u30ed\u30ef\u30c3\u30c8",
+	0x3318: "\u30af\u3099\u30e9\u30e0", 0x3319: "\u30af\u3099\u30e9\u30e0\u30c8\u30f3",
+	0x331a: "\u30af\u30eb\u30bb\u3099\u30a4\u30ed", 0x331b: "\u30af\u30ed\u30fc\u30cd",
+	0x331c: "\u30b1\u30fc\u30b9", 0x331d: "\u30b3\u30eb\u30ca",
+	0x331e: "\u30b3\u30fc\u30db\u309a", 0x331f: "\u30b5\u30a4\u30af\u30eb",
+	0x3320: "\u30b5\u30f3\u30c1\u30fc\u30e0", 0x3321: "\u30b7\u30ea\u30f3\u30af\u3099",
+	0x3322: "\u30bb\u30f3\u30c1", 0x3323: "\u30bb\u30f3\u30c8",
+	0x3324: "\u30bf\u3099\u30fc\u30b9", 0x3325: "\u30c6\u3099\u30b7",
+	0x3326: "\u30c8\u3099\u30eb", 0x3327: "\u30c8\u30f3", 0x3328: "\u30ca\u30ce",
+	0x3329: "\u30ce\u30c3\u30c8", 0x332a: "\u30cf\u30a4\u30c4",
+	0x332b: "\u30cf\u309a\u30fc\u30bb\u30f3\u30c8", 0x332c: "\u30cf\u309a\u30fc\u30c4",
+	0x332d: "\u30cf\u3099\u30fc\u30ec\u30eb", 0x332e: "\u30d2\u309a\u30a2\u30b9\u30c8\u30eb",
+	0x332f: "\u30d2\u309a\u30af\u30eb", 0x3330: "\u30d2\u309a\u30b3",
+	0x3331: "\u30d2\u3099\u30eb", 0x3332: "\u30d5\u30a1\u30e9\u30c3\u30c8\u3099",
+	0x3333: "\u30d5\u30a3\u30fc\u30c8", 0x3334: "\u30d5\u3099\u30c3\u30b7\u30a7\u30eb",
+	0x3335: "\u30d5\u30e9\u30f3", 0x3336: "\u30d8\u30af\u30bf\u30fc\u30eb",
+	0x3337: "\u30d8\u309a\u30bd", 0x3338: "\u30d8\u309a\u30cb\u30d2",
+	0x3339: "\u30d8\u30eb\u30c4", 0x333a: "\u30d8\u309a\u30f3\u30b9",
+	0x333b: "\u30d8\u309a\u30fc\u30b7\u3099", 0x333c: "\u30d8\u3099\u30fc\u30bf",
+	0x333d: "\u30db\u309a\u30a4\u30f3\u30c8", 0x333e: "\u30db\u3099\u30eb\u30c8",
+	0x333f: "\u30db\u30f3", 0x3340: "\u30db\u309a\u30f3\u30c8\u3099",
+	0x3341: "\u30db\u30fc\u30eb", 0x3342: "\u30db\u30fc\u30f3",
+	0x3343: "\u30de\u30a4\u30af\u30ed", 0x3344: "\u30de\u30a4\u30eb",
+	0x3345: "\u30de\u30c3\u30cf", 0x3346: "\u30de\u30eb\u30af",
+	0x3347: "\u30de\u30f3\u30b7\u30e7\u30f3", 0x3348: "\u30df\u30af\u30ed\u30f3",
+	0x3349: "\u30df\u30ea", 0x334a: "\u30df\u30ea\u30cf\u3099\u30fc\u30eb",
+	0x334b: "\u30e1\u30ab\u3099", 0x334c: "\u30e1\u30ab\u3099\u30c8\u30f3",
+	0x334d: "\u30e1\u30fc\u30c8\u30eb", 0x334e: "\u30e4\u30fc\u30c8\u3099",
+	0x334f: "\u30e4\u30fc\u30eb", 0x3350: "\u30e6\u30a2\u30f3",
+	0x3351: "\u30ea\u30c3\u30c8\u30eb", 0x3352: "\u30ea\u30e9",
+	0x3353: "\u30eb\u30d2\u309a\u30fc", 0x3354: "\u30eb\u30fc\u30d5\u3099\u30eb",
+	0x3355: "\u30ec\u30e0", 0x3356: "\u30ec\u30f3\u30c8\u30b1\u3099\u30f3",
+	0x3357: "\u30ef\u30c3\u30c8", 0x3358: "\u0030\u70b9", 0x3359: "\u0031\u70b9",
+	0x335a: "\u0032\u70b9", 0x335b: "\u0033\u70b9", 0x335c: "\u0034\u70b9",
+	0x335d: "\u0035\u70b9", 0x335e: "\u0036\u70b9", 0x335f: "\u0037\u70b9",
+	0x3360: "\u0038\u70b9", 0x3361: "\u0039\u70b9", 0x3362: "\u0031\u0030\u70b9",
+	0x3363: "\u0031\u0031\u70b9", 0x3364: "\u0031\u0032\u70b9", 0x3365: "\u0031\u0033\u70b9",
+	0x3366: "\u0031\u0034\u70b9", 0x3367: "\u0031\u0035\u70b9", 0x3368: "\u0031\u0036\u70b9",
+	0x3369: "\u0031\u0037\u70b9", 0x336a: "\u0031\u0038\u70b9", 0x336b: "\u0031\u0039\u70b9",
+	0x336c: "\u0032\u0030\u70b9", 0x336d: "\u0032\u0031\u70b9", 0x336e: "\u0032\u0032\u70b9",
+	0x336f: "\u0032\u0033\u70b9", 0x3370: "\u0032\u0034\u70b9", 0x3371: "\u0068\u0050\u0061",
+	0x3372: "\u0064\u0061", 0x3373: "\u0041\u0055", 0x3374: "\u0062\u0061\u0072",
+	0x3375: "\u006f\u0056", 0x3376: "\u0070\u0063", 0x3377: "\u0064\u006d",
+	0x3378: "\u0064\u006d\u0032", 0x3379: "\u0064\u006d\u0033", 0x337a: "\u0049\u0055",
+	0x337b: "\u5e73\u6210", 0x337c: "\u662d\u548c", 0x337d: "\u5927\u6b63",
+	0x337e: "\u660e\u6cbb", 0x337f: "\u682a\u5f0f\u4f1a\u793e", 0x3380: "\u0070\u0041",
+	0x3381: "\u006e\u0041", 0x3382: "\u03bc\u0041", 0x3383: "\u006d\u0041",
+	0x3384: "\u006b\u0041", 0x3385: "\u004b\u0042", 0x3386: "\u004d\u0042",
+	0x3387: "\u0047\u0042", 0x3388: "\u0063\u0061\u006c", 0x3389: "\u006b\u0063\u0061\u006c",
+	0x338a: "\u0070\u0046", 0x338b: "\u006e\u0046", 0x338c: "\u03bc\u0046",
+	0x338d: "\u03bc\u0067", 0x338e: "\u006d\u0067", 0x338f: "\u006b\u0067",
+	0x3390: "\u0048\u007a", 0x3391: "\u006b\u0048\u007a", 0x3392: "\u004d\u0048\u007a",
+	0x3393: "\u0047\u0048\u007a", 0x3394: "\u0054\u0048\u007a", 0x3395: "\u03bc\u006c",
+	0x3396: "\u006d\u006c", 0x3397: "\u0064\u006c", 0x3398: "\u006b\u006c",
+	0x3399: "\u0066\u006d", 0x339a: "\u006e\u006d", 0x339b: "\u03bc\u006d",
+	0x339c: "\u006d\u006d", 0x339d: "\u0063\u006d", 0x339e: "\u006b\u006d",
+	0x339f: "\u006d\u006d\u0032", 0x33a0: "\u0063\u006d\u0032", 0x33a1: "\u006d\u0032",
+	0x33a2: "\u006b\u006d\u0032", 0x33a3: "\u006d\u006d\u0033", 0x33a4: "\u0063\u006d\u0033",
+	0x33a5: "\u006d\u0033", 0x33a6: "\u006b\u006d\u0033", 0x33a7: "\u006d\u2215\u0073",
+	0x33a8: "\u006d\u2215\u0073\u0032", 0x33a9: "\u0050\u0061", 0x33aa: "\u006b\u0050\u0061",
+	0x33ab: "\u004d\u0050\u0061", 0x33ac: "\u0047\u0050\u0061", 0x33ad: "\u0072\u0061\u0064",
+	0x33ae: "\u0072\u0061\u0064\u2215\u0073", 0x33af: "\u0072\u0061\u0064\u2215\u0073\u0032",
+	0x33b0: "\u0070\u0073", 0x33b1: "\u006e\u0073", 0x33b2: "\u03bc\u0073",
+	0x33b3: "\u006d\u0073", 0x33b4: "\u0070\u0056", 0x33b5: "\u006e\u0056",
+	0x33b6: "\u03bc\u0056", 0x33b7: "\u006d\u0056", 0x33b8: "\u006b\u0056",
+	0x33b9: "\u004d\u0056", 0x33ba: "\u0070\u0057", 0x33bb: "\u006e\u0057",
+	0x33bc: "\u03bc\u0057", 0x33bd: "\u006d\u0057", 0x33be: "\u006b\u0057",
+	0x33bf: "\u004d\u0057", 0x33c0: "\u006b\u03a9", 0x33c1: "\u004d\u03a9",
+	0x33c2: "\u0061\u002e\u006d\u002e", 0x33c3: "\u0042\u0071", 0x33c4: "\u0063\u0063",
+	0x33c5: "\u0063\u0064", 0x33c6: "\u0043\u2215\u006b\u0067", 0x33c7: "\u0043\u006f\u002e",
+	0x33c8: "\u0064\u0042", 0x33c9: "\u0047\u0079", 0x33ca: "\u0068\u0061",
+	0x33cb: "\u0048\u0050", 0x33cc: "\u0069\u006e", 0x33cd: "\u004b\u004b",
+	0x33ce: "\u004b\u004d", 0x33cf: "\u006b\u0074", 0x33d0: "\u006c\u006d",
+	0x33d1: "\u006c\u006e", 0x33d2: "\u006c\u006f\u0067", 0x33d3: "\u006c\u0078",
+	0x33d4: "\u006d\u0062", 0x33d5: "\u006d\u0069\u006c", 0x33d6: "\u006d\u006f\u006c",
+	0x33d7: "\u0050\u0048", 0x33d8: "\u0070\u002e\u006d\u002e", 0x33d9: "\u0050\u0050\u004d",
+	0x33da: "\u0050\u0052", 0x33db: "\u0073\u0072", 0x33dc: "\u0053\u0076",
+	0x33dd: "\u0057\u0062", 0x33de: "\u0056\u2215\u006d", 0x33df: "\u0041\u2215\u006d",
+	0x33e0: "\u0031\u65e5", 0x33e1: "\u0032\u65e5", 0x33e2: "\u0033\u65e5",
+	0x33e3: "\u0034\u65e5", 0x33e4: "\u0035\u65e5", 0x33e5: "\u0036\u65e5",
+	0x33e6: "\u0037\u65e5", 0x33e7: "\u0038\u65e5", 0x33e8: "\u0039\u65e5",
+	0x33e9: "\u0031\u0030\u65e5", 0x33ea: "\u0031\u0031\u65e5", 0x33eb: "\u0031\u0032\u65e5",
+	0x33ec: "\u0031\u0033\u65e5", 0x33ed: "\u0031\u0034\u65e5", 0x33ee: "\u0031\u0035\u65e5",
+	0x33ef: "\u0031\u0036\u65e5", 0x33f0: "\u0031\u0037\u65e5", 0x33f1: "\u0031\u0038\u65e5",
+	0x33f2: "\u0031\u0039\u65e5", 0x33f3: "\u0032\u0030\u65e5", 0x33f4: "\u0032\u0031\u65e5",
+	0x33f5: "\u0032\u0032\u65e5", 0x33f6: "\u0032\u0033\u65e5", 0x33f7: "\u0032\u0034\u65e5",
+	0x33f8: "\u0032\u0035\u65e5", 0x33f9: "\u0032\u0036\u65e5", 0x33fa: "\u0032\u0037\u65e5",
+	0x33fb: "\u0032\u0038\u65e5", 0x33fc: "\u0032\u0039\u65e5", 0x33fd: "\u0033\u0030\u65e5",
+	0x33fe: "\u0033\u0031\u65e5", 0x33ff: "\u0067\u0061\u006c", 0xfb00: "\u0066\u0066",
+	0xfb01: "\u0066\u0069", 0xfb02: "\u0066\u006c", 0xfb03: "\u0066\u0066\u0069",
+	0xfb04: "\u0066\u0066\u006c", 0xfb05: "\u0073\u0074", 0xfb06: "\u0073\u0074",
+	0xfb13: "\u0574\u0576", 0xfb14: "\u0574\u0565", 0xfb15: "\u0574\u056b",
+	0xfb16: "\u057e\u0576", 0xfb17: "\u0574\u056d", 0xfb1d: "\u05d9\u05b4",
+	0xfb1f: "\u05f2\u05b7", 0xfb2a: "\u05e9\u05c1", 0xfb2b: "\u05e9\u05c2",
+	0xfb2c: "\u05e9\u05bc\u05c1", 0xfb2d: "\u05e9\u05bc\u05c2", 0xfb2e: "\u05d0\u05b7",
+	0xfb2f: "\u05d0\u05b8", 0xfb30: "\u05d0\u05bc", 0xfb31: "\u05d1\u05bc",
+	0xfb32: "\u05d2\u05bc", 0xfb33: "\u05d3\u05bc", 0xfb34: "\u05d4\u05bc",
+	0xfb35: "\u05d5\u05bc", 0xfb36: "\u05d6\u05bc", 0xfb38: "\u05d8\u05bc",
+	0xfb39: "\u05d9\u05bc", 0xfb3a: "\u05da\u05bc", 0xfb3b: "\u05db\u05bc",
+	0xfb3c: "\u05dc\u05bc", 0xfb3e: "\u05de\u05bc", 0xfb40: "\u05e0\u05bc",
+	0xfb41: "\u05e1\u05bc", 0xfb43: "\u05e3\u05bc", 0xfb44: "\u05e4\u05bc",
+	0xfb46: "\u05e6\u05bc", 0xfb47: "\u05e7\u05bc", 0xfb48: "\u05e8\u05bc",
+	0xfb49: "\u05e9\u05bc", 0xfb4a: "\u05ea\u05bc", 0xfb4b: "\u05d5\u05b9",
+	0xfb4c: "\u05d1\u05bf", 0xfb4d: "\u05db\u05bf", 0xfb4e: "\u05e4\u05bf",
+	0xfb4f: "\u05d0\u05dc", 0xfba4: "\u06d5\u0654", 0xfba5: "\u06d5\u0654",
+	0xfbb0: "\u06d2\u0654", 0xfbb1: "\u06d2\u0654", 0xfbdd: "\u06c7\u0674",
+	0xfbea: "\u064a\u0654\u0627", 0xfbeb: "\u064a\u0654\u0627", 0xfbec: "\u064a\u0654\u06d5",
+	0xfbed: "\u064a\u0654\u06d5", 0xfbee: "\u064a\u0654\u0648", 0xfbef: "\u064a\u0654\u0648",
+	0xfbf0: "\u064a\u0654\u06c7", 0xfbf1: "\u064a\u0654\u06c7", 0xfbf2: "\u064a\u0654\u06c6",
+	0xfbf3: "\u064a\u0654\u06c6", 0xfbf4: "\u064a\u0654\u06c8", 0xfbf5: "\u064a\u0654\u06c8",
+	0xfbf6: "\u064a\u0654\u06d0", 0xfbf7: "\u064a\u0654\u06d0", 0xfbf8: "\u064a\u0654\u06d0",
+	0xfbf9: "\u064a\u0654\u0649", 0xfbfa: "\u064a\u0654\u0649", 0xfbfb: "\u064a\u0654\u0649",
+	0xfc00: "\u064a\u0654\u062c", 0xfc01: "\u064a\u0654\u062d", 0xfc02: "\u064a\u0654\u0645",
+	0xfc03: "\u064a\u0654\u0649", 0xfc04: "\u064a\u0654\u064a", 0xfc05: "\u0628\u062c",
+	0xfc06: "\u0628\u062d", 0xfc07: "\u0628\u062e", 0xfc08: "\u0628\u0645",
+	0xfc09: "\u0628\u0649", 0xfc0a: "\u0628\u064a", 0xfc0b: "\u062a\u062c",
+	0xfc0c: "\u062a\u062d", 0xfc0d: "\u062a\u062e", 0xfc0e: "\u062a\u0645",
+	0xfc0f: "\u062a\u0649", 0xfc10: "\u062a\u064a", 0xfc11: "\u062b\u062c",
+	0xfc12: "\u062b\u0645", 0xfc13: "\u062b\u0649", 0xfc14: "\u062b\u064a",
+	0xfc15: "\u062c\u062d", 0xfc16: "\u062c\u0645", 0xfc17: "\u062d\u062c",
+	0xfc18: "\u062d\u0645", 0xfc19: "\u062e\u062c", 0xfc1a: "\u062e\u062d",
+	0xfc1b: "\u062e\u0645", 0xfc1c: "\u0633\u062c", 0xfc1d: "\u0633\u062d",
+	0xfc1e: "\u0633\u062e", 0xfc1f: "\u0633\u0645", 0xfc20: "\u0635\u062d",
+	0xfc21: "\u0635\u0645", 0xfc22: "\u0636\u062c", 0xfc23: "\u0636\u062d",
+	0xfc24: "\u0636\u062e", 0xfc25: "\u0636\u0645", 0xfc26: "\u0637\u062d",
+	0xfc27: "\u0637\u0645", 0xfc28: "\u0638\u0645", 0xfc29: "\u0639\u062c",
+	0xfc2a: "\u0639\u0645", 0xfc2b: "\u063a\u062c", 0xfc2c: "\u063a\u0645",
+	0xfc2d: "\u0641\u062c", 0xfc2e: "\u0641\u062d", 0xfc2f: "\u0641\u062e",
+	0xfc30: "\u0641\u0645", 0xfc31: "\u0641\u0649", 0xfc32: "\u0641\u064a",
+	0xfc33: "\u0642\u062d", 0xfc34: "\u0642\u0645", 0xfc35: "\u0642\u0649",
+	0xfc36: "\u0642\u064a", 0xfc37: "\u0643\u0627", 0xfc38: "\u0643\u062c",
+	0xfc39: "\u0643\u062d", 0xfc3a: "\u0643\u062e", 0xfc3b: "\u0643\u0644",
+	0xfc3c: "\u0643\u0645", 0xfc3d: "\u0643\u0649", 0xfc3e: "\u0643\u064a",
+	0xfc3f: "\u0644\u062c", 0xfc40: "\u0644\u062d", 0xfc41: "\u0644\u062e",
+	0xfc42: "\u0644\u0645", 0xfc43: "\u0644\u0649", 0xfc44: "\u0644\u064a",
+	0xfc45: "\u0645\u062c", 0xfc46: "\u0645\u062d", 0xfc47: "\u0645\u062e",
+	0xfc48: "\u0645\u0645", 0xfc49: "\u0645\u0649", 0xfc4a: "\u0645\u064a",
+	0xfc4b: "\u0646\u062c", 0xfc4c: "\u0646\u062d", 0xfc4d: "\u0646\u062e",
+	0xfc4e: "\u0646\u0645", 0xfc4f: "\u0646\u0649", 0xfc50: "\u0646\u064a",
+	0xfc51: "\u0647\u062c", 0xfc52: "\u0647\u0645", 0xfc53: "\u0647\u0649",
+	0xfc54: "\u0647\u064a", 0xfc55: "\u064a\u062c", 0xfc56: "\u064a\u062d",
+	0xfc57: "\u064a\u062e", 0xfc58: "\u064a\u0645", 0xfc59: "\u064a\u0649",
+	0xfc5a: "\u064a\u064a", 0xfc5b: "\u0630\u0670", 0xfc5c: "\u0631\u0670",
+	0xfc5d: "\u0649\u0670", 0xfc5e: "\u0020\u064c\u0651", 0xfc5f: "\u0020\u064d\u0651",
+	0xfc60: "\u0020\u064e\u0651", 0xfc61: "\u0020\u064f\u0651", 0xfc62: "\u0020\u0650\u0651",
+	0xfc63: "\u0020\u0651\u0670", 0xfc64: "\u064a\u0654\u0631", 0xfc65: "\u064a\u0654\u0632",
+	0xfc66: "\u064a\u0654\u0645", 0xfc67: "\u064a\u0654\u0646", 0xfc68: "\u064a\u0654\u0649",
+	0xfc69: "\u064a\u0654\u064a", 0xfc6a: "\u0628\u0631", 0xfc6b: "\u0628\u0632",
+	0xfc6c: "\u0628\u0645", 0xfc6d: "\u0628\u0646", 0xfc6e: "\u0628\u0649",
+	0xfc6f: "\u0628\u064a", 0xfc70: "\u062a\u0631", 0xfc71: "\u062a\u0632",
+	0xfc72: "\u062a\u0645", 0xfc73: "\u062a\u0646", 0xfc74: "\u062a\u0649",
+	0xfc75: "\u062a\u064a", 0xfc76: "\u062b\u0631", 0xfc77: "\u062b\u0632",
+	0xfc78: "\u062b\u0645", 0xfc79: "\u062b\u0646", 0xfc7a: "\u062b\u0649",
+	0xfc7b: "\u062b\u064a", 0xfc7c: "\u0641\u0649", 0xfc7d: "\u0641\u064a",
+	0xfc7e: "\u0642\u0649", 0xfc7f: "\u0642\u064a", 0xfc80: "\u0643\u0627",
+	0xfc81: "\u0643\u0644", 0xfc82: "\u0643\u0645", 0xfc83: "\u0643\u0649",
+	0xfc84: "\u0643\u064a", 0xfc85: "\u0644\u0645", 0xfc86: "\u0644\u0649",
+	0xfc87: "\u0644\u064a", 0xfc88: "\u0645\u0627", 0xfc89: "\u0645\u0645",
+	0xfc8a: "\u0646\u0631", 0xfc8b: "\u0646\u0632", 0xfc8c: "\u0646\u0645",
+	0xfc8d: "\u0646\u0646", 0xfc8e: "\u0646\u0649", 0xfc8f: "\u0646\u064a",
+	0xfc90: "\u0649\u0670", 0xfc91: "\u064a\u0631", 0xfc92: "\u064a\u0632",
+	0xfc93: "\u064a\u0645", 0xfc94: "\u064a\u0646", 0xfc95: "\u064a\u0649",
+	0xfc96: "\u064a\u064a", 0xfc97: "\u064a\u0654\u062c", 0xfc98: "\u064a\u0654\u062d",
+	0xfc99: "\u064a\u0654\u062e", 0xfc9a: "\u064a\u0654\u0645", 0xfc9b: "\u064a\u0654\u0647",
+	0xfc9c: "\u0628\u062c", 0xfc9d: "\u0628\u062d", 0xfc9e: "\u0628\u062e",
+	0xfc9f: "\u0628\u0645", 0xfca0: "\u0628\u0647", 0xfca1: "\u062a\u062c",
+	0xfca2: "\u062a\u062d", 0xfca3: "\u062a\u062e", 0xfca4: "\u062a\u0645",
+	0xfca5: "\u062a\u0647", 0xfca6: "\u062b\u0645", 0xfca7: "\u062c\u062d",
+	0xfca8: "\u062c\u0645", 0xfca9: "\u062d\u062c", 0xfcaa: "\u062d\u0645",
+	0xfcab: "\u062e\u062c", 0xfcac: "\u062e\u0645", 0xfcad: "\u0633\u062c",
+	0xfcae: "\u0633\u062d", 0xfcaf: "\u0633\u062e", 0xfcb0: "\u0633\u0645",
+	0xfcb1: "\u0635\u062d", 0xfcb2: "\u0635\u062e", 0xfcb3: "\u0635\u0645",
+	0xfcb4: "\u0636\u062c", 0xfcb5: "\u0636\u062d", 0xfcb6: "\u0636\u062e",
+	0xfcb7: "\u0636\u0645", 0xfcb8: "\u0637\u062d", 0xfcb9: "\u0638\u0645",
+	0xfcba: "\u0639\u062c", 0xfcbb: "\u0639\u0645", 0xfcbc: "\u063a\u062c",
+	0xfcbd: "\u063a\u0645", 0xfcbe: "\u0641\u062c", 0xfcbf: "\u0641\u062d",
+	0xfcc0: "\u0641\u062e", 0xfcc1: "\u0641\u0645", 0xfcc2: "\u0642\u062d",
+	0xfcc3: "\u0642\u0645", 0xfcc4: "\u0643\u062c", 0xfcc5: "\u0643\u062d",
+	0xfcc6: "\u0643\u062e", 0xfcc7: "\u0643\u0644", 0xfcc8: "\u0643\u0645",
+	0xfcc9: "\u0644\u062c", 0xfcca: "\u0644\u062d", 0xfccb: "\u0644\u062e",
+	0xfccc: "\u0644\u0645", 0xfccd: "\u0644\u0647", 0xfcce: "\u0645\u062c",
+	0xfccf: "\u0645\u062d", 0xfcd0: "\u0645\u062e", 0xfcd1: "\u0645\u0645",
+	0xfcd2: "\u0646\u062c", 0xfcd3: "\u0646\u062d", 0xfcd4: "\u0646\u062e",
+	0xfcd5: "\u0646\u0645", 0xfcd6: "\u0646\u0647", 0xfcd7: "\u0647\u062c",
+	0xfcd8: "\u0647\u0645", 0xfcd9: "\u0647\u0670", 0xfcda: "\u064a\u062c",
+	0xfcdb: "\u064a\u062d", 0xfcdc: "\u064a\u062e", 0xfcdd: "\u064a\u0645",
+	0xfcde: "\u064a\u0647", 0xfcdf: "\u064a\u0654\u0645", 0xfce0: "\u064a\u0654\u0647",
+	0xfce1: "\u0628\u0645", 0xfce2: "\u0628\u0647", 0xfce3: "\u062a\u0645",
+	0xfce4: "\u062a\u0647", 0xfce5: "\u062b\u0645", 0xfce6: "\u062b\u0647",
+	0xfce7: "\u0633\u0645", 0xfce8: "\u0633\u0647", 0xfce9: "\u0634\u0645",
+	0xfcea: "\u0634\u0647", 0xfceb: "\u0643\u0644", 0xfcec: "\u0643\u0645",
+	0xfced: "\u0644\u0645", 0xfcee: "\u0646\u0645", 0xfcef: "\u0646\u0647",
+	0xfcf0: "\u064a\u0645", 0xfcf1: "\u064a\u0647", 0xfcf2: "\u0640\u064e\u0651",
+	0xfcf3: "\u0640\u064f\u0651", 0xfcf4: "\u0640\u0650\u0651", 0xfcf5: "\u0637\u0649",
+	0xfcf6: "\u0637\u064a", 0xfcf7: "\u0639\u0649", 0xfcf8: "\u0639\u064a",
+	0xfcf9: "\u063a\u0649", 0xfcfa: "\u063a\u064a", 0xfcfb: "\u0633\u0649",
+	0xfcfc: "\u0633\u064a", 0xfcfd: "\u0634\u0649", 0xfcfe: "\u0634\u064a",
+	0xfcff: "\u062d\u0649", 0xfd00: "\u062d\u064a", 0xfd01: "\u062c\u0649",
+	0xfd02: "\u062c\u064a", 0xfd03: "\u062e\u0649", 0xfd04: "\u062e\u064a",
+	0xfd05: "\u0635\u0649", 0xfd06: "\u0635\u064a", 0xfd07: "\u0636\u0649",
+	0xfd08: "\u0636\u064a", 0xfd09: "\u0634\u062c", 0xfd0a: "\u0634\u062d",
+	0xfd0b: "\u0634\u062e", 0xfd0c: "\u0634\u0645", 0xfd0d: "\u0634\u0631",
+	0xfd0e: "\u0633\u0631", 0xfd0f: "\u0635\u0631", 0xfd10: "\u0636\u0631",
+	0xfd11: "\u0637\u0649", 0xfd12: "\u0637\u064a", 0xfd13: "\u0639\u0649",
+	0xfd14: "\u0639\u064a", 0xfd15: "\u063a\u0649", 0xfd16: "\u063a\u064a",
+	0xfd17: "\u0633\u0649", 0xfd18: "\u0633\u064a", 0xfd19: "\u0634\u0649",
+	0xfd1a: "\u0634\u064a", 0xfd1b: "\u062d\u0649", 0xfd1c: "\u062d\u064a",
+	0xfd1d: "\u062c\u0649", 0xfd1e: "\u062c\u064a", 0xfd1f: "\u062e\u0649",
+	0xfd20: "\u062e\u064a", 0xfd21: "\u0635\u0649", 0xfd22: "\u0635\u064a",
+	0xfd23: "\u0636\u0649", 0xfd24: "\u0636\u064a", 0xfd25: "\u0634\u062c",
+	0xfd26: "\u0634\u062d", 0xfd27: "\u0634\u062e", 0xfd28: "\u0634\u0645",
+	0xfd29: "\u0634\u0631", 0xfd2a: "\u0633\u0631", 0xfd2b: "\u0635\u0631",
+	0xfd2c: "\u0636\u0631", 0xfd2d: "\u0634\u062c", 0xfd2e: "\u0634\u062d",
+	0xfd2f: "\u0634\u062e", 0xfd30: "\u0634\u0645", 0xfd31: "\u0633\u0647",
+	0xfd32: "\u0634\u0647", 0xfd33: "\u0637\u0645", 0xfd34: "\u0633\u062c",
+	0xfd35: "\u0633\u062d", 0xfd36: "\u0633\u062e", 0xfd37: "\u0634\u062c",
+	0xfd38: "\u0634\u062d", 0xfd39: "\u0634\u062e", 0xfd3a: "\u0637\u0645",
+	0xfd3b: "\u0638\u0645", 0xfd3c: "\u0627\u064b", 0xfd3d: "\u0627\u064b",
+	0xfd50: "\u062a\u062c\u0645", 0xfd51: "\u062a\u062d\u062c", 0xfd52: "\u062a\u062d\u062c",
+	0xfd53: "\u062a\u062d\u0645", 0xfd54: "\u062a\u062e\u0645", 0xfd55: "\u062a\u0645\u062c",
+	0xfd56: "\u062a\u0645\u062d", 0xfd57: "\u062a\u0645\u062e", 0xfd58: "\u062c\u0645\u062d",
+	0xfd59: "\u062c\u0645\u062d", 0xfd5a: "\u062d\u0645\u064a", 0xfd5b: "\u062d\u0645\u0649",
+	0xfd5c: "\u0633\u062d\u062c", 0xfd5d: "\u0633\u062c\u062d", 0xfd5e: "\u0633\u062c\u0649",
+	0xfd5f: "\u0633\u0645\u062d", 0xfd60: "\u0633\u0645\u062d", 0xfd61: "\u0633\u0645\u062c",
+	0xfd62: "\u0633\u0645\u0645", 0xfd63: "\u0633\u0645\u0645", 0xfd64: "\u0635\u062d\u062d",
+	0xfd65: "\u0635\u062d\u062d", 0xfd66: "\u0635\u0645\u0645", 0xfd67: "\u0634\u062d\u0645",
+	0xfd68: "\u0634\u062d\u0645", 0xfd69: "\u0634\u062c\u064a", 0xfd6a: "\u0634\u0645\u062e",
+	0xfd6b: "\u0634\u0645\u062e", 0xfd6c: "\u0634\u0645\u0645", 0xfd6d: "\u0634\u0645\u0645",
+	0xfd6e: "\u0636\u062d\u0649", 0xfd6f: "\u0636\u062e\u0645", 0xfd70: "\u0636\u062e\u0645",
+	0xfd71: "\u0637\u0645\u062d", 0xfd72: "\u0637\u0645\u062d", 0xfd73: "\u0637\u0645\u0645",
+	0xfd74: "\u0637\u0645\u064a", 0xfd75: "\u0639\u062c\u0645", 0xfd76: "\u0639\u0645\u0645",
+	0xfd77: "\u0639\u0645\u0645", 0xfd78: "\u0639\u0645\u0649", 0xfd79: "\u063a\u0645\u0645",
+	0xfd7a: "\u063a\u0645\u064a", 0xfd7b: "\u063a\u0645\u0649", 0xfd7c: "\u0641\u062e\u0645",
+	0xfd7d: "\u0641\u062e\u0645", 0xfd7e: "\u0642\u0645\u062d", 0xfd7f: "\u0642\u0645\u0645",
+	0xfd80: "\u0644\u062d\u0645", 0xfd81: "\u0644\u062d\u064a", 0xfd82: "\u0644\u062d\u0649",
+	0xfd83: "\u0644\u062c\u062c", 0xfd84: "\u0644\u062c\u062c", 0xfd85: "\u0644\u062e\u0645",
+	0xfd86: "\u0644\u062e\u0645", 0xfd87: "\u0644\u0645\u062d", 0xfd88: "\u0644\u0645\u062d",
+	0xfd89: "\u0645\u062d\u062c", 0xfd8a: "\u0645\u062d\u0645", 0xfd8b: "\u0645\u062d\u064a",
+	0xfd8c: "\u0645\u062c\u062d", 0xfd8d: "\u0645\u062c\u0645", 0xfd8e: "\u0645\u062e\u062c",
+	0xfd8f: "\u0645\u062e\u0645", 0xfd92: "\u0645\u062c\u062e", 0xfd93: "\u0647\u0645\u062c",
+	0xfd94: "\u0647\u0645\u0645", 0xfd95: "\u0646\u062d\u0645", 0xfd96: "\u0646\u062d\u0649",
+	0xfd97: "\u0646\u062c\u0645", 0xfd98: "\u0646\u062c\u0645", 0xfd99: "\u0646\u062c\u0649",
+	0xfd9a: "\u0646\u0645\u064a", 0xfd9b: "\u0646\u0645\u0649", 0xfd9c: "\u064a\u0645\u0645",
+	0xfd9d: "\u064a\u0645\u0645", 0xfd9e: "\u0628\u062e\u064a", 0xfd9f: "\u062a\u062c\u064a",
+	0xfda0: "\u062a\u062c\u0649", 0xfda1: "\u062a\u062e\u064a", 0xfda2: "\u062a\u062e\u0649",
+	0xfda3: "\u062a\u0645\u064a", 0xfda4: "\u062a\u0645\u0649", 0xfda5: "\u062c\u0645\u064a",
+	0xfda6: "\u062c\u062d\u0649", 0xfda7: "\u062c\u0645\u0649", 0xfda8: "\u0633\u062e\u0649",
+	0xfda9: "\u0635\u062d\u064a", 0xfdaa: "\u0634\u062d\u064a", 0xfdab: "\u0636\u062d\u064a",
+	0xfdac: "\u0644\u062c\u064a", 0xfdad: "\u0644\u0645\u064a", 0xfdae: "\u064a\u062d\u064a",
+	0xfdaf: "\u064a\u062c\u064a", 0xfdb0: "\u064a\u0645\u064a", 0xfdb1: "\u0645\u0645\u064a",
+	0xfdb2: "\u0642\u0645\u064a", 0xfdb3: "\u0646\u062d\u064a", 0xfdb4: "\u0642\u0645\u062d",
+	0xfdb5: "\u0644\u062d\u0645", 0xfdb6: "\u0639\u0645\u064a", 0xfdb7: "\u0643\u0645\u064a",
+	0xfdb8: "\u0646\u062c\u062d", 0xfdb9: "\u0645\u062e\u064a", 0xfdba: "\u0644\u062c\u0645",
+	0xfdbb: "\u0643\u0645\u0645", 0xfdbc: "\u0644\u062c\u0645", 0xfdbd: "\u0646\u062c\u062d",
+	0xfdbe: "\u062c\u062d\u064a", 0xfdbf: "\u062d\u062c\u064a", 0xfdc0: "\u0645\u062c\u064a",
+	0xfdc1: "\u0641\u0645\u064a", 0xfdc2: "\u0628\u062d\u064a", 0xfdc3: "\u0643\u0645\u0645",
+	0xfdc4: "\u0639\u062c\u0645", 0xfdc5: "\u0635\u0645\u0645", 0xfdc6: "\u0633\u062e\u064a",
+	0xfdc7: "\u0646\u062c\u064a", 0xfdf0: "\u0635\u0644\u06d2", 0xfdf1: "\u0642\u0644\u06d2",
+	0xfdf2: "\u0627\u0644\u0644\u0647", 0xfdf3: "\u0627\u0643\u0628\u0631",
+	0xfdf4: "\u0645\u062d\u0645\u062f", 0xfdf5: "\u0635\u0644\u0639\u0645",
+	0xfdf6: "\u0631\u0633\u0648\u0644", 0xfdf7: "\u0639\u0644\u064a\u0647",
+	0xfdf8: "\u0648\u0633\u0644\u0645", 0xfdf9: "\u0635\u0644\u0649",
+	0xfdfa: "\u0635\u0644\u0649\u0020\u0627\u0644\u0644\u0647\u0020\u0639\u0644\u064a\u0647\u0020\u0648\u0633\u0644\u0645",
+	0xfdfb: "\u062c\u0644\u0020\u062c\u0644\u0627\u0644\u0647",
+	0xfdfc: "\u0631\u06cc\u0627\u0644", 0xfe19: "\u002e\u002e\u002e", 0xfe30: "\u002e\u002e",
+	0xfe49: "\u0020\u0305", 0xfe4a: "\u0020\u0305", 0xfe4b: "\u0020\u0305",
+	0xfe4c: "\u0020\u0305", 0xfe70: "\u0020\u064b", 0xfe71: "\u0640\u064b",
+	0xfe72: "\u0020\u064c", 0xfe74: "\u0020\u064d", 0xfe76: "\u0020\u064e",
+	0xfe77: "\u0640\u064e", 0xfe78: "\u0020\u064f", 0xfe79: "\u0640\u064f",
+	0xfe7a: "\u0020\u0650", 0xfe7b: "\u0640\u0650", 0xfe7c: "\u0020\u0651",
+	0xfe7d: "\u0640\u0651", 0xfe7e: "\u0020\u0652", 0xfe7f: "\u0640\u0652",
+	0xfe81: "\u0627\u0653", 0xfe82: "\u0627\u0653", 0xfe83: "\u0627\u0654",
+	0xfe84: "\u0627\u0654", 0xfe85: "\u0648\u0654", 0xfe86: "\u0648\u0654",
+	0xfe87: "\u0627\u0655", 0xfe88: "\u0627\u0655", 0xfe89: "\u064a\u0654",
+	0xfe8a: "\u064a\u0654", 0xfe8b: "\u064a\u0654", 0xfe8c: "\u064a\u0654",
+	0xfef5: "\u0644\u0627\u0653", 0xfef6: "\u0644\u0627\u0653", 0xfef7: "\u0644\u0627\u0654",
+	0xfef8: "\u0644\u0627\u0654", 0xfef9: "\u0644\u0627\u0655", 0xfefa: "\u0644\u0627\u0655",
+	0xfefb: "\u0644\u0627", 0xfefc: "\u0644\u0627", 0xffe3: "\u0020\u0304",
+	0x1109a: "\U00011099\U000110ba", 0x1109c: "\U0001109b\U000110ba",
+	0x110ab: "\U000110a5\U000110ba", 0x1112e: "\U00011131\U00011127",
+	0x1112f: "\U00011132\U00011127", 0x1134b: "\U00011347\U0001133e",
+	0x1134c: "\U00011347\U00011357", 0x114bb: "\U000114b9\U000114ba",
+	0x114bc: "\U000114b9\U000114b0", 0x114be: "\U000114b9\U000114bd",
+	0x115ba: "\U000115b8\U000115af", 0x115bb: "\U000115b9\U000115af",
+	0x11938: "\U00011935\U00011930", 0x1d15e: "\U0001d157\U0001d165",
+	0x1d15f: "\U0001d158\U0001d165", 0x1d160: "\U0001d158\U0001d165\U0001d16e",
+	0x1d161: "\U0001d158\U0001d165\U0001d16f", 0x1d162: "\U0001d158\U0001d165\U0001d170",
+	0x1d163: "\U0001d158\U0001d165\U0001d171", 0x1d164: "\U0001d158\U0001d165\U0001d172",
+	0x1d1bb: "\U0001d1b9\U0001d165", 0x1d1bc: "\U0001d1ba\U0001d165",
+	0x1d1bd: "\U0001d1b9\U0001d165\U0001d16e", 0x1d1be: "\U0001d1ba\U0001d165\U0001d16e",
+	0x1d1bf: "\U0001d1b9\U0001d165\U0001d16f", 0x1d1c0: "\U0001d1ba\U0001d165\U0001d16f",
+	0x1f100: "\u0030\u002e", 0x1f101: "\u0030\u002c", 0x1f102: "\u0031\u002c",
+	0x1f103: "\u0032\u002c", 0x1f104: "\u0033\u002c", 0x1f105: "\u0034\u002c",
+	0x1f106: "\u0035\u002c", 0x1f107: "\u0036\u002c", 0x1f108: "\u0037\u002c",
+	0x1f109: "\u0038\u002c", 0x1f10a: "\u0039\u002c", 0x1f110: "\u0028\u0041\u0029",
+	0x1f111: "\u0028\u0042\u0029", 0x1f112: "\u0028\u0043\u0029",
+	0x1f113: "\u0028\u0044\u0029", 0x1f114: "\u0028\u0045\u0029",
+	0x1f115: "\u0028\u0046\u0029", 0x1f116: "\u0028\u0047\u0029",
+	0x1f117: "\u0028\u0048\u0029", 0x1f118: "\u0028\u0049\u0029",
+	0x1f119: "\u0028\u004a\u0029", 0x1f11a: "\u0028\u004b\u0029",
+	0x1f11b: "\u0028\u004c\u0029", 0x1f11c: "\u0028\u004d\u0029",
+	0x1f11d: "\u0028\u004e\u0029", 0x1f11e: "\u0028\u004f\u0029",
+	0x1f11f: "\u0028\u0050\u0029", 0x1f120: "\u0028\u0051\u0029",
+	0x1f121: "\u0028\u0052\u0029", 0x1f122: "\u0028\u0053\u0029",
+	0x1f123: "\u0028\u0054\u0029", 0x1f124: "\u0028\u0055\u0029",
+	0x1f125: "\u0028\u0056\u0029", 0x1f126: "\u0028\u0057\u0029",
+	0x1f127: "\u0028\u0058\u0029", 0x1f128: "\u0028\u0059\u0029",
+	0x1f129: "\u0028\u005a\u0029", 0x1f12a: "\u3014\u0053\u3015", 0x1f12d: "\u0043\u0044",
+	0x1f12e: "\u0057\u005a", 0x1f14a: "\u0048\u0056", 0x1f14b: "\u004d\u0056",
+	0x1f14c: "\u0053\u0044", 0x1f14d: "\u0053\u0053", 0x1f14e: "\u0050\u0050\u0056",
+	0x1f14f: "\u0057\u0043", 0x1f16a: "\u004d\u0043", 0x1f16b: "\u004d\u0044",
+	0x1f16c: "\u004d\u0052", 0x1f190: "\u0044\u004a", 0x1f200: "\u307b\u304b",
+	0x1f201: "\u30b3\u30b3", 0x1f213: "\u30c6\u3099", 0x1f240: "\u3014\u672c\u3015",
+	0x1f241: "\u3014\u4e09\u3015", 0x1f242: "\u3014\u4e8c\u3015",
+	0x1f243: "\u3014\u5b89\u3015", 0x1f244: "\u3014\u70b9\u3015",
+	0x1f245: "\u3014\u6253\u3015", 0x1f246: "\u3014\u76d7\u3015",
+	0x1f247: "\u3014\u52dd\u3015", 0x1f248: "\u3014\u6557\u3015",
+}
+
+// combiningClasses lists runs of runes with the same non-zero canonical
+// combining class.
+var combiningClasses = []cccRange{
+	{0x0300, 0x0314, 230}, {0x0315, 0x0315, 232}, {0x0316, 0x0319, 220}, {0x031a, 0x031a, 232},
+	{0x031b, 0x031b, 216}, {0x031c, 0x0320, 220}, {0x0321, 0x0322, 202}, {0x0323, 0x0326, 220},
+	{0x0327, 0x0328, 202}, {0x0329, 0x0333, 220}, {0x0334, 0x0338, 1}, {0x0339, 0x033c, 220},
+	{0x033d, 0x0344, 230}, {0x0345, 0x0345, 240}, {0x0346, 0x0346, 230}, {0x0347, 0x0349, 220},
+	{0x034a, 0x034c, 230}, {0x034d, 0x034e, 220}, {0x0350, 0x0352, 230}, {0x0353, 0x0356, 220},
+	{0x0357, 0x0357, 230}, {0x0358, 0x0358, 232}, {0x0359, 0x035a, 220}, {0x035b, 0x035b, 230},
+	{0x035c, 0x035c, 233}, {0x035d, 0x035e, 234}, {0x035f, 0x035f, 233}, {0x0360, 0x0361, 234},
+	{0x0362, 0x0362, 233}, {0x0363, 0x036f, 230}, {0x0483, 0x0487, 230}, {0x0591, 0x0591, 220},
+	{0x0592, 0x0595, 230}, {0x0596, 0x0596, 220}, {0x0597, 0x0599, 230}, {0x059a, 0x059a, 222},
+	{0x059b, 0x059b, 220}, {0x059c, 0x05a1, 230}, {0x05a2, 0x05a7, 220}, {0x05a8, 0x05a9, 230},
+	{0x05aa, 0x05aa, 220}, {0x05ab, 0x05ac, 230}, {0x05ad, 0x05ad, 222}, {0x05ae, 0x05ae, 228},
+	{0x05af, 0x05af, 230}, {0x05b0, 0x05b0, 10}, {0x05b1, 0x05b1, 11}, {0x05b2, 0x05b2, 12},
+	{0x05b3, 0x05b3, 13}, {0x05b4, 0x05b4, 14}, {0x05b5, 0x05b5, 15}, {0x05b6, 0x05b6, 16},
+	{0x05b7, 0x05b7, 17}, {0x05b8, 0x05b8, 18}, {0x05b9, 0x05ba, 19}, {0x05bb, 0x05bb, 20},
+	{0x05bc, 0x05bc, 21}, {0x05bd, 0x05bd, 22}, {0x05bf, 0x05bf, 23}, {0x05c1, 0x05c1, 24},
+	{0x05c2, 0x05c2, 25}, {0x05c4, 0x05c4, 230}, {0x05c5, 0x05c5, 220}, {0x05c7, 0x05c7, 18},
+	{0x0610, 0x0617, 230}, {0x0618, 0x0618, 30}, {0x0619, 0x0619, 31}, {0x061a, 0x061a, 32},
+	{0x064b, 0x064b, 27}, {0x064c, 0x064c, 28}, {0x064d, 0x064d, 29}, {0x064e, 0x064e, 30},
+	{0x064f, 0x064f, 31}, {0x0650, 0x0650, 32}, {0x0651, 0x0651, 33}, {0x0652, 0x0652, 34},
+	{0x0653, 0x0654, 230}, {0x0655, 0x0656, 220}, {0x0657, 0x065b, 230}, {0x065c, 0x065c, 220},
+	{0x065d, 0x065e, 230}, {0x065f, 0x065f, 220}, {0x0670, 0x0670, 35}, {0x06d6, 0x06dc, 230},
+	{0x06df, 0x06e2, 230}, {0x06e3, 0x06e3, 220}, {0x06e4, 0x06e4, 230}, {0x06e7, 0x06e8, 230},
+	{0x06ea, 0x06ea, 220}, {0x06eb, 0x06ec, 230}, {0x06ed, 0x06ed, 220}, {0x0711, 0x0711, 36},
+	{0x0730, 0x0730, 230}, {0x0731, 0x0731, 220}, {0x0732, 0x0733, 230}, {0x0734, 0x0734, 220},
+	{0x0735, 0x0736, 230}, {0x0737, 0x0739, 220}, {0x073a, 0x073a, 230}, {0x073b, 0x073c, 220},
+	{0x073d, 0x073d, 230}, {0x073e, 0x073e, 220}, {0x073f, 0x0741, 230}, {0x0742, 0x0742, 220},
+	{0x0743, 0x0743, 230}, {0x0744, 0x0744, 220}, {0x0745, 0x0745, 230}, {0x0746, 0x0746, 220},
+	{0x0747, 0x0747, 230}, {0x0748, 0x0748, 220}, {0x0749, 0x074a, 230}, {0x07eb, 0x07f1, 230},
+	{0x07f2, 0x07f2, 220}, {0x07f3, 0x07f3, 230}, {0x07fd, 0x07fd, 220}, {0x0816, 0x0819, 230},
+	{0x081b, 0x0823, 230}, {0x0825, 0x0827, 230}, {0x0829, 0x082d, 230}, {0x0859, 0x085b, 220},
+	{0x0898, 0x0898, 230}, {0x0899, 0x089b, 220}, {0x089c, 0x089f, 230}, {0x08ca, 0x08ce, 230},
+	{0x08cf, 0x08d3, 220}, {0x08d4, 0x08e1, 230}, {0x08e3, 0x08e3, 220}, {0x08e4, 0x08e5, 230},
+	{0x08e6, 0x08e6, 220}, {0x08e7, 0x08e8, 230}, {0x08e9, 0x08e9, 220}, {0x08ea, 0x08ec, 230},
+	{0x08ed, 0x08ef, 220}, {0x08f0, 0x08f0, 27}, {0x08f1, 0x08f1, 28}, {0x08f2, 0x08f2, 29},
+	{0x08f3, 0x08f5, 230}, {0x08f6, 0x08f6, 220}, {0x08f7, 0x08f8, 230}, {0x08f9, 0x08fa, 220},
+	{0x08fb, 0x08ff, 230}, {0x093c, 0x093c, 7}, {0x094d, 0x094d, 9}, {0x0951, 0x0951, 230},
+	{0x0952, 0x0952, 220}, {0x0953, 0x0954, 230}, {0x09bc, 0x09bc, 7}, {0x09cd, 0x09cd, 9},
+	{0x09fe, 0x09fe, 230}, {0x0a3c, 0x0a3c, 7}, {0x0a4d, 0x0a4d, 9}, {0x0abc, 0x0abc, 7},
+	{0x0acd, 0x0acd, 9}, {0x0b3c, 0x0b3c, 7}, {0x0b4d, 0x0b4d, 9}, {0x0bcd, 0x0bcd, 9},
+	{0x0c3c, 0x0c3c, 7}, {0x0c4d, 0x0c4d, 9}, {0x0c55, 0x0c55, 84}, {0x0c56, 0x0c56, 91},
+	{0x0cbc, 0x0cbc, 7}, {0x0ccd, 0x0ccd, 9}, {0x0d3b, 0x0d3c, 9}, {0x0d4d, 0x0d4d, 9},
+	{0x0dca, 0x0dca, 9}, {0x0e38, 0x0e39, 103}, {0x0e3a, 0x0e3a, 9}, {0x0e48, 0x0e4b, 107},
+	{0x0eb8, 0x0eb9, 118}, {0x0eba, 0x0eba, 9}, {0x0ec8, 0x0ecb, 122}, {0x0f18, 0x0f19, 220},
+	{0x0f35, 0x0f35, 220}, {0x0f37, 0x0f37, 220}, {0x0f39, 0x0f39, 216}, {0x0f71, 0x0f71, 129},
+	{0x0f72, 0x0f72, 130}, {0x0f74, 0x0f74, 132}, {0x0f7a, 0x0f7d, 130}, {0x0f80, 0x0f80, 130},
+	{0x0f82, 0x0f83, 230}, {0x0f84, 0x0f84, 9}, {0x0f86, 0x0f87, 230}, {0x0fc6, 0x0fc6, 220},
+	{0x1037, 0x1037, 7}, {0x1039, 0x103a, 9}, {0x108d, 0x108d, 220}, {0x135d, 0x135f, 230},
+	{0x1714, 0x1715, 9}, {0x1734, 0x1734, 9}, {0x17d2, 0x17d2, 9}, {0x17dd, 0x17dd, 230},
+	{0x18a9, 0x18a9, 228}, {0x1939, 0x1939, 222}, {0x193a, 0x193a, 230}, {0x193b, 0x193b, 220},
+	{0x1a17, 0x1a17, 230}, {0x1a18, 0x1a18, 220}, {0x1a60, 0x1a60, 9}, {0x1a75, 0x1a7c, 230},
+	{0x1a7f, 0x1a7f, 220}, {0x1ab0, 0x1ab4, 230}, {0x1ab5, 0x1aba, 220}, {0x1abb, 0x1abc, 230},
+	{0x1abd, 0x1abd, 220}, {0x1abf, 0x1ac0, 220}, {0x1ac1, 0x1ac2, 230}, {0x1ac3, 0x1ac4, 220},
+	{0x1ac5, 0x1ac9, 230}, {0x1aca, 0x1aca, 220}, {0x1acb, 0x1ace, 230}, {0x1b34, 0x1b34, 7},
+	{0x1b44, 0x1b44, 9}, {0x1b6b, 0x1b6b, 230}, {0x1b6c, 0x1b6c, 220}, {0x1b6d, 0x1b73, 230},
+	{0x1baa, 0x1bab, 9}, {0x1be6, 0x1be6, 7}, {0x1bf2, 0x1bf3, 9}, {0x1c37, 0x1c37, 7},
+	{0x1cd0, 0x1cd2, 230}, {0x1cd4, 0x1cd4, 1}, {0x1cd5, 0x1cd9, 220}, {0x1cda, 0x1cdb, 230},
+	{0x1cdc, 0x1cdf, 220}, {0x1ce0, 0x1ce0, 230}, {0x1ce2, 0x1ce8, 1}, {0x1ced, 0x1ced, 220},
+	{0x1cf4, 0x1cf4, 230}, {0x1cf8, 0x1cf9, 230}, {0x1dc0, 0x1dc1, 230}, {0x1dc2, 0x1dc2, 220},
+	{0x1dc3, 0x1dc9, 230}, {0x1dca, 0x1dca, 220}, {0x1dcb, 0x1dcc, 230}, {0x1dcd, 0x1dcd, 234},
+	{0x1dce, 0x1dce, 214}, {0x1dcf, 0x1dcf, 220}, {0x1dd0, 0x1dd0, 202}, {0x1dd1, 0x1df5, 230},
+	{0x1df6, 0x1df6, 232}, {0x1df7, 0x1df8, 228}, {0x1df9, 0x1df9, 220}, {0x1dfa, 0x1dfa, 218},
+	{0x1dfb, 0x1dfb, 230}, {0x1dfc, 0x1dfc, 233}, {0x1dfd, 0x1dfd, 220}, {0x1dfe, 0x1dfe, 230},
+	{0x1dff, 0x1dff, 220}, {0x20d0, 0x20d1, 230}, {0x20d2, 0x20d3, 1}, {0x20d4, 0x20d7, 230},
+	{0x20d8, 0x20da, 1}, {0x20db, 0x20dc, 230}, {0x20e1, 0x20e1, 230}, {0x20e5, 0x20e6, 1},
+	{0x20e7, 0x20e7, 230}, {0x20e8, 0x20e8, 220}, {0x20e9, 0x20e9, 230}, {0x20ea, 0x20eb, 1},
+	{0x20ec, 0x20ef, 220}, {0x20f0, 0x20f0, 230}, {0x2cef, 0x2cf1, 230}, {0x2d7f, 0x2d7f, 9},
+	{0x2de0, 0x2dff, 230}, {0x302a, 0x302a, 218}, {0x302b, 0x302b, 228}, {0x302c, 0x302c, 232},
+	{0x302d, 0x302d, 222}, {0x302e, 0x302f, 224}, {0x3099, 0x309a, 8}, {0xa66f, 0xa66f, 230},
+	{0xa674, 0xa67d, 230}, {0xa69e, 0xa69f, 230}, {0xa6f0, 0xa6f1, 230}, {0xa806, 0xa806, 9},
+	{0xa82c, 0xa82c, 9}, {0xa8c4, 0xa8c4, 9}, {0xa8e0, 0xa8f1, 230}, {0xa92b, 0xa92d, 220},
+	{0xa953, 0xa953, 9}, {0xa9b3, 0xa9b3, 7}, {0xa9c0, 0xa9c0, 9}, {0xaab0, 0xaab0, 230},
+	{0xaab2, 0xaab3, 230}, {0xaab4, 0xaab4, 220}, {0xaab7, 0xaab8, 230}, {0xaabe, 0xaabf, 230},
+	{0xaac1, 0xaac1, 230}, {0xaaf6, 0xaaf6, 9}, {0xabed, 0xabed, 9}, {0xfb1e, 0xfb1e, 26},
+	{0xfe20, 0xfe26, 230}, {0xfe27, 0xfe2d, 220}, {0xfe2e, 0xfe2f, 230},
+	{0x101fd, 0x101fd, 220}, {0x102e0, 0x102e0, 220}, {0x10376, 0x1037a, 230},
+	{0x10a0d, 0x10a0d, 220}, {0x10a0f, 0x10a0f, 230}, {0x10a38, 0x10a38, 230},
+	{0x10a39, 0x10a39, 1}, {0x10a3a, 0x10a3a, 220}, {0x10a3f, 0x10a3f, 9},
+	{0x10ae5, 0x10ae5, 230}, {0x10ae6, 0x10ae6, 220}, {0x10d24, 0x10d27, 230},
+	{0x10eab, 0x10eac, 230}, {0x10f46, 0x10f47, 220}, {0x10f48, 0x10f4a, 230},
+	{0x10f4b, 0x10f4b, 220}, {0x10f4c, 0x10f4c, 230}, {0x10f4d, 0x10f50, 220},
+	{0x10f82, 0x10f82, 230}, {0x10f83, 0x10f83, 220}, {0x10f84, 0x10f84, 230},
+	{0x10f85, 0x10f85, 220}, {0x11046, 0x11046, 9}, {0x11070, 0x11070, 9},
+	{0x1107f, 0x1107f, 9}, {0x110b9, 0x110b9, 9}, {0x110ba, 0x110ba, 7},
+	{0x11100, 0x11102, 230}, {0x11133, 0x11134, 9}, {0x11173, 0x11173, 7},
+	{0x111c0, 0x111c0, 9}, {0x111ca, 0x111ca, 7}, {0x11235, 0x11235, 9}, {0x11236, 0x11236, 7},
+	{0x112e9, 0x112e9, 7}, {0x112ea, 0x112ea, 9}, {0x1133b, 0x1133c, 7}, {0x1134d, 0x1134d, 9},
+	{0x11366, 0x1136c, 230}, {0x11370, 0x11374, 230}, {0x11442, 0x11442, 9},
+	{0x11446, 0x11446, 7}, {0x1145e, 0x1145e, 230}, {0x114c2, 0x114c2, 9},
+	{0x114c3, 0x114c3, 7}, {0x115bf, 0x115bf, 9}, {0x115c0, 0x115c0, 7}, {0x1163f, 0x1163f, 9},
+	{0x116b6, 0x116b6, 9}, {0x116b7, 0x116b7, 7}, {0x1172b, 0x1172b, 9}, {0x11839, 0x11839, 9},
+	{0x1183a, 0x1183a, 7}, {0x1193d, 0x1193e, 9}, {0x11943, 0x11943, 7}, {0x119e0, 0x119e0, 9},
+	{0x11a34, 0x11a34, 9}, {0x11a47, 0x11a47, 9}, {0x11a99, 0x11a99, 9}, {0x11c3f, 0x11c3f, 9},
+	{0x11d42, 0x11d42, 7}, {0x11d44, 0x11d45, 9}, {0x11d97, 0x11d97, 9}, {0x16af0, 0x16af4, 1},
+	{0x16b30, 0x16b36, 230}, {0x16ff0, 0x16ff1, 6}, {0x1bc9e, 0x1bc9e, 1},
+	{0x1d165, 0x1d166, 216}, {0x1d167, 0x1d169, 1}, {0x1d16d, 0x1d16d, 226},
+	{0x1d16e, 0x1d172, 216}, {0x1d17b, 0x1d182, 220}, {0x1d185, 0x1d189, 230},
+	{0x1d18a, 0x1d18b, 220}, {0x1d1aa, 0x1d1ad, 230}, {0x1d242, 0x1d244, 230},
+	{0x1e000, 0x1e006, 230}, {0x1e008, 0x1e018, 230}, {0x1e01b, 0x1e021, 230},
+	{0x1e023, 0x1e024, 230}, {0x1e026, 0x1e02a, 230}, {0x1e130, 0x1e136, 230},
+	{0x1e2ae, 0x1e2ae, 230}, {0x1e2ec, 0x1e2ef, 230}, {0x1e8d0, 0x1e8d6, 220},
+	{0x1e944, 0x1e949, 230}, {0x1e94a, 0x1e94a, 7},
+}
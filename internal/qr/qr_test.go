@@ -0,0 +1,265 @@
+package qr
+
+import (
+	"bytes"
+	"slices"
+	"strings"
+	"testing"
+)
+
+// --- Reed-Solomon Tests ---
+
+func TestReedSolomon(t *testing.T) {
+	// "HELLO WORLD" as version 1-M alphanumeric data, from the worked
+	// example commonly used to illustrate the standard.
+	data := []byte{32, 91, 11, 120, 209, 114, 220, 77, 67, 64, 236, 17, 236, 17, 236, 17}
+	want := []byte{196, 35, 39, 119, 235, 215, 231, 226, 93, 23}
+	if got := reedSolomon(data, 10); !bytes.Equal(got, want) {
+		t.Errorf("Expected %v, got %v", want, got)
+	}
+}
+
+// --- Table Tests ---
+
+// rawCodewords is the number of codewords a version holds, derived from
+// its function patterns.
+func rawCodewords(version int) int {
+	m := newMatrix(version)
+	m.drawFunctionPatterns()
+	free := 0
+	for _, f := range m.function {
+		if !f {
+			free++
+		}
+	}
+	return free / 8
+}
+
+func TestBlockSpecs(t *testing.T) {
+	for v := 1; v <= 40; v++ {
+		total := rawCodewords(v)
+		for level, spec := range blockSpecs[v-1] {
+			got := spec.dataCodewords() + (spec.blocks1+spec.blocks2)*spec.ecc
+			if got != total {
+				t.Errorf("Version %d level %d: blocks hold %d codewords, symbol holds %d", v, level, got, total)
+			}
+			if spec.blocks2 > 0 && spec.data2 != spec.data1+1 {
+				t.Errorf("Version %d level %d: inconsistent group sizes", v, level)
+			}
+		}
+	}
+}
+
+func TestFormatAndVersionInfo(t *testing.T) {
+	cases := map[Level]int{L: 0b111011111000100, M: 0b101010000010010, Q: 0b011010101011111, H: 0b001011010001001}
+	for level, want := range cases {
+		if got := formatInfo(level, 0); got != want {
+			t.Errorf("Level %d mask 0: expected %015b, got %015b", level, want, got)
+		}
+	}
+	if got := versionInfo(7); got != 0x07C94 {
+		t.Errorf("Version 7: expected %#x, got %#x", 0x07C94, got)
+	}
+	if got := alignmentPositions(32); !slices.Equal(got, []int{6, 34, 60, 86, 112, 138}) {
+		t.Errorf("Unexpected alignment positions %v", got)
+	}
+}
+
+// --- Encode Tests ---
+
+// decode reads a code back: format information, unmasking, codeword
+// order, error correction and the byte mode segment.
+func decode(t *testing.T, c *Code) []byte {
+	t.Helper()
+	m := newMatrix(c.Version)
+	m.drawFunctionPatterns()
+
+	var format, format2 int
+	bit := func(x, y int) int {
+		if c.Dark(x, y) {
+			return 1
+		}
+		return 0
+	}
+	for i := range 6 {
+		format |= bit(8, i) << i
+	}
+	format |= bit(8, 7)<<6 | bit(8, 8)<<7 | bit(7, 8)<<8
+	for i := 9; i < 15; i++ {
+		format |= bit(14-i, 8) << i
+	}
+	for i := range 8 {
+		format2 |= bit(c.Size-1-i, 8) << i
+	}
+	for i := 8; i < 15; i++ {
+		format2 |= bit(8, c.Size-15+i) << i
+	}
+	if format != format2 {
+		t.Fatalf("Format copies differ: %015b and %015b", format, format2)
+	}
+	mask := -1
+	for mk := range 8 {
+		if formatInfo(c.Level, mk) == format {
+			mask = mk
+		}
+	}
+	if mask < 0 {
+		t.Fatalf("Format %015b does not match level %d", format, c.Level)
+	}
+
+	var bits bitBuffer
+	for right := c.Size - 1; right >= 1; right -= 2 {
+		if right == 6 {
+			right = 5
+		}
+		for vert := range c.Size {
+			y := vert
+			if (right+1)&2 == 0 {
+				y = c.Size - 1 - vert
+			}
+			for j := range 2 {
+				x := right - j
+				if m.function[y*c.Size+x] {
+					continue
+				}
+				b := c.Dark(x, y) != maskFuncs[mask](x, y)
+				if b {
+					bits.append(1, 1)
+				} else {
+					bits.append(0, 1)
+				}
+			}
+		}
+	}
+
+	spec := blockSpecs[c.Version-1][c.Level]
+	n := spec.blocks1 + spec.blocks2
+	blocks := make([][]byte, n)
+	codewords := bits.bytes
+	for i := range max(spec.data1, spec.data2) {
+		for b := range n {
+			if i < spec.data1 || b >= spec.blocks1 {
+				blocks[b] = append(blocks[b], codewords[0])
+				codewords = codewords[1:]
+			}
+		}
+	}
+	var data []byte
+	for b := range n {
+		ecc := codewords[b : b+n*spec.ecc : b+n*spec.ecc]
+		var got []byte
+		for i := 0; i < len(ecc); i += n {
+			got = append(got, ecc[i])
+		}
+		if !bytes.Equal(got, reedSolomon(blocks[b], spec.ecc)) {
+			t.Fatalf("Block %d fails error correction check", b)
+		}
+		data = append(data, blocks[b]...)
+	}
+
+	if data[0]>>4 != 0b0100 {
+		t.Fatalf("Expected byte mode, got %04b", data[0]>>4)
+	}
+	read := func(pos, count int) int {
+		v := 0
+		for i := range count {
+			v = v<<1 | int(data[(pos+i)/8]>>(7-(pos+i)%8)&1)
+		}
+		return v
+	}
+	countBits := 8
+	if c.Version >= 10 {
+		countBits = 16
+	}
+	length := read(4, countBits)
+	out := make([]byte, length)
+	for i := range out {
+		out[i] = byte(read(4+countBits+8*i, 8))
+	}
+	return out
+}
+
+func TestEncode_RoundTrip(t *testing.T) {
+	cases := []struct {
+		data    string
+		level   Level
+		version int
+	}{
+		{"2:9f3c", M, 1},
+		{strings.Repeat("a", 14), M, 1},
+		{strings.Repeat("a", 15), M, 2},
+		{"v2:3:" + strings.Repeat("0123456789abcdef", 8), M, 8},
+		{strings.Repeat("x", 300), Q, 0},
+		{strings.Repeat("y", 1000), L, 0},
+		{strings.Repeat("z", 2331), M, 40},
+	}
+	for _, tc := range cases {
+		c, err := Encode([]byte(tc.data), tc.level)
+		if err != nil {
+			t.Fatalf("Encode failed: %v", err)
+		}
+		if tc.version != 0 && c.Version != tc.version {
+			t.Errorf("%d bytes: expected version %d, got %d", len(tc.data), tc.version, c.Version)
+		}
+		if c.Size != 17+4*c.Version {
+			t.Errorf("Unexpected size %d for version %d", c.Size, c.Version)
+		}
+		if got := decode(t, c); string(got) != tc.data {
+			t.Errorf("Round trip of %d bytes at version %d failed", len(tc.data), c.Version)
+		}
+	}
+}
+
+func TestEncode_FinderPatterns(t *testing.T) {
+	c, _ := Encode([]byte("finder"), H)
+	pattern := []string{"1111111", "1000001", "1011101", "1011101", "1011101", "1000001", "1111111"}
+	for _, corner := range [][2]int{{0, 0}, {c.Size - 7, 0}, {0, c.Size - 7}} {
+		for y, row := range pattern {
+			for x, ch := range row {
+				if c.Dark(corner[0]+x, corner[1]+y) != (ch == '1') {
+					t.Fatalf("Finder pattern at %v is wrong", corner)
+				}
+			}
+		}
+	}
+	if !c.Dark(8, c.Size-8) {
+		t.Error("Dark module missing")
+	}
+}
+
+func TestEncode_TooLong(t *testing.T) {
+	if _, err := Encode(make([]byte, 2332), M); err != ErrTooLong {
+		t.Errorf("Expected ErrTooLong, got %v", err)
+	}
+	if _, err := Encode([]byte("x"), Level(7)); err == nil {
+		t.Error("Expected error for invalid level")
+	}
+}
+
+// --- Render Tests ---
+
+func TestHalfBlocks(t *testing.T) {
+	c, _ := Encode([]byte("render"), M)
+	out := c.HalfBlocks(4, false)
+	lines := strings.Split(strings.TrimSuffix(out, "\n"), "\n")
+	if len(lines) != (c.Size+8+1)/2 {
+		t.Fatalf("Expected %d lines, got %d", (c.Size+8+1)/2, len(lines))
+	}
+	for _, l := range lines {
+		if n := len([]rune(l)); n != c.Size+8 {
+			t.Fatalf("Expected %d columns, got %d", c.Size+8, n)
+		}
+	}
+	// The quiet zone is light, drawn as full blocks on dark terminals.
+	if strings.Trim(lines[0], "█") != "" {
+		t.Errorf("Expected a solid quiet zone, got %q", lines[0])
+	}
+	// Line 2 holds the first two rows of the symbol, which start with the
+	// dark top-left finder pattern.
+	if got := []rune(lines[2])[4]; got != ' ' {
+		t.Errorf("Expected dark finder corner left blank, got %q", got)
+	}
+	if inv := c.HalfBlocks(0, true); []rune(inv)[0] != '█' {
+		t.Errorf("Expected inverted finder corner drawn as a block, got %q", []rune(inv)[0])
+	}
+}
@@ -0,0 +1,60 @@
+package qr
+
+// gfExp and gfLog are the exponent and logarithm tables of GF(2^8) with
+// the QR code polynomial x^8 + x^4 + x^3 + x^2 + 1 and generator 2.
+var gfExp, gfLog = func() ([510]byte, [256]byte) {
+	var exp [510]byte
+	var log [256]byte
+	x := 1
+	for i := range 255 {
+		exp[i] = byte(x)
+		exp[i+255] = byte(x)
+		log[x] = byte(i)
+		x <<= 1
+		if x&0x100 != 0 {
+			x ^= 0x11D
+		}
+	}
+	return exp, log
+}()
+
+func gfMul(a, b byte) byte {
+	if a == 0 || b == 0 {
+		return 0
+	}
+	return gfExp[int(gfLog[a])+int(gfLog[b])]
+}
+
+// rsGenerator returns the coefficients, highest degree first and without
+// the leading 1, of the generator polynomial (x - a^0)...(x - a^(n-1)).
+func rsGenerator(n int) []byte {
+	g := make([]byte, n)
+	g[n-1] = 1
+	root := byte(1)
+	for range n {
+		for j := range n {
+			g[j] = gfMul(g[j], root)
+			if j+1 < n {
+				g[j] ^= g[j+1]
+			}
+		}
+		root = gfMul(root, 2)
+	}
+	return g
+}
+
+// reedSolomon returns the n error correction codewords of data: the
+// remainder of data * x^n divided by the generator polynomial.
+func reedSolomon(data []byte, n int) []byte {
+	g := rsGenerator(n)
+	rem := make([]byte, n)
+	for _, b := range data {
+		factor := b ^ rem[0]
+		copy(rem, rem[1:])
+		rem[n-1] = 0
+		for i := range n {
+			rem[i] ^= gfMul(g[i], factor)
+		}
+	}
+	return rem
+}
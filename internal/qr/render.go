@@ -0,0 +1,34 @@
+package qr
+
+import "strings"
+
+// HalfBlocks renders the code with UTF-8 half block characters, two module
+// rows per line of text, surrounded by a quiet zone of the given width in
+// modules (the standard asks for 4).
+//
+// By default light modules are drawn as blocks, which suits terminals with
+// light text on a dark background; set invert for dark text on a light
+// background.
+func (c *Code) HalfBlocks(quiet int, invert bool) string {
+	// Indexed by whether the top and bottom modules are drawn.
+	glyphs := [2][2]string{{" ", "▄"}, {"▀", "█"}}
+	drawn := func(x, y int) int {
+		if c.Dark(x, y) == invert {
+			return 1
+		}
+		return 0
+	}
+	var b strings.Builder
+	for y := -quiet; y < c.Size+quiet; y += 2 {
+		for x := -quiet; x < c.Size+quiet; x++ {
+			// The last line has no bottom row when the row count is odd.
+			bottom := 0
+			if y+1 < c.Size+quiet {
+				bottom = drawn(x, y+1)
+			}
+			b.WriteString(glyphs[drawn(x, y)][bottom])
+		}
+		b.WriteByte('\n')
+	}
+	return b.String()
+}
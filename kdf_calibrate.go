@@ -0,0 +1,61 @@
+package goshamir
+
+import (
+	"errors"
+	"time"
+)
+
+// calibrateStartMemory is the first Argon2id memory cost, in KiB, that
+// CalibrateKDF measures; smaller costs are too fast to time reliably.
+const calibrateStartMemory = 8 << 10
+
+// CalibrateKDF measures Argon2id on this machine and returns parameters for
+// which deriving a key takes about target. Time and Threads start at those
+// of DefaultKDFParams; Memory is doubled until the target is met or the
+// memory bound of 1 GiB is reached, after which Time is raised, up to 64,
+// to make up the remaining time. Parameters weaker than DefaultKDFParams
+// are never returned.
+//
+// Calibrate on hardware comparable to where shares will be unlocked: an
+// attacker's hardware is typically much faster.
+func CalibrateKDF(target time.Duration) (KDFParams, error) {
+	if target <= 0 {
+		return KDFParams{}, errors.New("target duration must be positive")
+	}
+
+	params := DefaultKDFParams
+	params.Memory = calibrateStartMemory
+	for {
+		elapsed, err := timeKDF(params)
+		if err != nil {
+			return KDFParams{}, err
+		}
+		if elapsed >= target {
+			break
+		}
+		next := params
+		next.Memory *= 2
+		if next.validate() != nil {
+			// Memory bound reached: scale time with passes instead.
+			passes := int64(params.Time) * int64(target) / int64(max(elapsed, 1))
+			params.Time = uint32(min(passes+1, maxKDFPasses))
+			break
+		}
+		params = next
+	}
+
+	if params.Memory < DefaultKDFParams.Memory {
+		params = DefaultKDFParams
+	}
+	return params, nil
+}
+
+func timeKDF(p KDFParams) (time.Duration, error) {
+	start := time.Now()
+	key, err := p.deriveKey([]byte("calibrate"), make([]byte, pinSaltSize))
+	if err != nil {
+		return 0, err
+	}
+	clear(key)
+	return time.Since(start), nil
+}
@@ -0,0 +1,91 @@
+package goshamir
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+	"text/template"
+)
+
+// DefaultLabelTemplate names shares by set, zero-padded index and total so
+// that the labels of a set sort in index order.
+const DefaultLabelTemplate = `share-{{.SetID}}-{{printf "%03d" .Index}}-of-{{.Total}}`
+
+// ShareLabel is the data available to label templates.
+type ShareLabel struct {
+	SetID     string
+	Index     uint8
+	Total     int
+	Threshold int
+	Scheme    Scheme
+}
+
+// LabelTemplate renders self-describing labels for shares, for example
+// "backup-{{.SetID}}-{{.Index}}-of-{{.Total}}". Labels are used as file
+// names, so they must be non-empty and may not contain path separators.
+// A LabelTemplate is safe for concurrent use.
+type LabelTemplate struct {
+	tmpl *template.Template
+}
+
+// ParseLabelTemplate parses a text/template label template. Fields of
+// ShareLabel are available, and printf can be used for padding.
+func ParseLabelTemplate(text string) (*LabelTemplate, error) {
+	tmpl, err := template.New("label").Option("missingkey=error").Parse(text)
+	if err != nil {
+		return nil, fmt.Errorf("invalid label template: %w", err)
+	}
+	return &LabelTemplate{tmpl: tmpl}, nil
+}
+
+// Execute renders the label for one share.
+func (t *LabelTemplate) Execute(label ShareLabel) (string, error) {
+	var b strings.Builder
+	if err := t.tmpl.Execute(&b, label); err != nil {
+		return "", fmt.Errorf("label template failed: %w", err)
+	}
+	s := b.String()
+	if s == "" || s == "." || s == ".." {
+		return "", fmt.Errorf("label %q is not a valid name", s)
+	}
+	if strings.ContainsAny(s, "/\\\x00\n") {
+		return "", fmt.Errorf("label %q contains a path separator or control character", s)
+	}
+	return s, nil
+}
+
+// Labels renders a label for each share of a set with the given ID and
+// threshold. Total is the number of shares. The labels must be distinct.
+func (t *LabelTemplate) Labels(setID string, threshold int, shares []Share) ([]string, error) {
+	labels := make([]string, len(shares))
+	seen := make(map[string]bool, len(shares))
+	for i, s := range shares {
+		label, err := t.Execute(ShareLabel{
+			SetID:     setID,
+			Index:     s.Index,
+			Total:     len(shares),
+			Threshold: threshold,
+			Scheme:    schemeOf(s),
+		})
+		if err != nil {
+			return nil, err
+		}
+		if seen[label] {
+			return nil, fmt.Errorf("label %q is not unique", label)
+		}
+		seen[label] = true
+		labels[i] = label
+	}
+	return labels, nil
+}
+
+// NewSetID returns a random identifier for a share set.
+func NewSetID() (string, error) {
+	id := make([]byte, 4)
+	if _, err := rand.Read(id); err != nil {
+		return "", errors.New("set id generation failed")
+	}
+	return hex.EncodeToString(id), nil
+}
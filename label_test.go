@@ -0,0 +1,60 @@
+package goshamir
+
+import (
+	"sort"
+	"testing"
+)
+
+// --- LabelTemplate Tests ---
+
+func TestLabelTemplate(t *testing.T) {
+	shares, _ := Split([]byte("secret"), 12, 3)
+	tmpl, err := ParseLabelTemplate(DefaultLabelTemplate)
+	if err != nil {
+		t.Fatalf("ParseLabelTemplate failed: %v", err)
+	}
+
+	labels, err := tmpl.Labels("abcd1234", 3, shares)
+	if err != nil {
+		t.Fatalf("Labels failed: %v", err)
+	}
+	if labels[1] != "share-abcd1234-002-of-12" {
+		t.Errorf("Unexpected label %q", labels[1])
+	}
+	if !sort.StringsAreSorted(labels) {
+		t.Errorf("Expected labels to sort in index order: %q", labels)
+	}
+
+	tmpl, _ = ParseLabelTemplate("{{.Scheme}}-{{.Index}}-{{.Threshold}}")
+	if label, _ := tmpl.Execute(ShareLabel{Index: 4, Threshold: 2, Scheme: SchemeV2GF256}); label != "v2-4-2" {
+		t.Errorf("Unexpected label %q", label)
+	}
+}
+
+func TestLabelTemplate_Errors(t *testing.T) {
+	if _, err := ParseLabelTemplate("{{.Index"); err == nil {
+		t.Error("Expected error for malformed template")
+	}
+
+	shares, _ := Split([]byte("secret"), 3, 2)
+	for _, text := range []string{"", "..", "a/{{.Index}}", "{{.Missing}}", "fixed"} {
+		tmpl, err := ParseLabelTemplate(text)
+		if err != nil {
+			t.Fatalf("ParseLabelTemplate(%q) failed: %v", text, err)
+		}
+		if _, err := tmpl.Labels("id", 2, shares); err == nil {
+			t.Errorf("Expected error for template %q", text)
+		}
+	}
+}
+
+func TestNewSetID(t *testing.T) {
+	a, err := NewSetID()
+	if err != nil {
+		t.Fatalf("NewSetID failed: %v", err)
+	}
+	b, _ := NewSetID()
+	if len(a) != 8 || a == b {
+		t.Errorf("Unexpected set IDs %q and %q", a, b)
+	}
+}
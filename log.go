@@ -0,0 +1,27 @@
+package goshamir
+
+import "log/slog"
+
+// discardLogger is the default Config.Logger.
+var discardLogger = slog.New(slog.DiscardHandler)
+
+// WithLogger sets the logger receiving operational events: validated
+// parameters, the number of shares generated, combine attempts and failed
+// verifications. Events never include secrets, share values or keys. A nil
+// logger keeps the default, which discards all events.
+func WithLogger(l *slog.Logger) Option {
+	return func(c *Config) {
+		if l != nil {
+			c.Logger = l
+		}
+	}
+}
+
+// logger returns the configured logger, tolerating a Config built without
+// NewConfig.
+func (c *Config) logger() *slog.Logger {
+	if c.Logger == nil {
+		return discardLogger
+	}
+	return c.Logger
+}
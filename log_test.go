@@ -0,0 +1,100 @@
+package goshamir
+
+import (
+	"bytes"
+	"encoding/hex"
+	"io"
+	"log/slog"
+	"strings"
+	"testing"
+)
+
+// --- Logging Tests ---
+
+func newTestLogger() (*slog.Logger, *bytes.Buffer) {
+	var buf bytes.Buffer
+	return slog.New(slog.NewTextHandler(&buf, &slog.HandlerOptions{Level: slog.LevelDebug})), &buf
+}
+
+func TestWithLogger_SplitEvents(t *testing.T) {
+	logger, buf := newTestLogger()
+	secret := []byte("do-not-log-this-secret")
+
+	splitter, err := NewSplitter(5, 3, WithLogger(logger))
+	if err != nil {
+		t.Fatalf("NewSplitter failed: %v", err)
+	}
+	shares, err := splitter.Split(secret)
+	if err != nil {
+		t.Fatalf("Split failed: %v", err)
+	}
+
+	out := buf.String()
+	for _, want := range []string{
+		`msg="shamir: split parameters validated" total_shares=5 threshold=3 scheme=v1`,
+		`msg="shamir: shares generated" shares=5 threshold=3 scheme=v1`,
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("Expected log to contain %q, got:\n%s", want, out)
+		}
+	}
+	if strings.Contains(out, string(secret)) {
+		t.Error("Log contains the secret")
+	}
+	for _, s := range shares {
+		if strings.Contains(out, hex.EncodeToString(s.Value)) {
+			t.Error("Log contains a share value")
+		}
+	}
+}
+
+func TestWithLogger_InvalidParameters(t *testing.T) {
+	logger, buf := newTestLogger()
+	if _, err := NewSplitter(2, 3, WithLogger(logger)); err == nil {
+		t.Fatal("Expected error for threshold above total shares")
+	}
+	if !strings.Contains(buf.String(), `level=WARN msg="shamir: invalid split parameters"`) {
+		t.Errorf("Expected invalid parameter warning, got:\n%s", buf.String())
+	}
+}
+
+func TestWithLogger_StreamEvents(t *testing.T) {
+	logger, buf := newTestLogger()
+	opts := []Option{WithLogger(logger), WithChunkMAC([]byte("key"))}
+	secret := bytes.Repeat([]byte{7}, streamChunkSize*2+5)
+
+	bufs := []*bytes.Buffer{new(bytes.Buffer), new(bytes.Buffer), new(bytes.Buffer)}
+	if err := SplitStream([]io.Writer{bufs[0], bufs[1], bufs[2]}, bytes.NewReader(secret), 2, opts...); err != nil {
+		t.Fatalf("SplitStream failed: %v", err)
+	}
+	if got := strings.Count(buf.String(), "shamir: share streams generated"); got != 1 {
+		t.Errorf("Expected one stream split event, got %d:\n%s", got, buf.String())
+	}
+	if strings.Contains(buf.String(), "shamir: shares generated") {
+		t.Error("Expected per-chunk splits not to be logged")
+	}
+
+	buf.Reset()
+	tampered := bytes.Clone(bufs[1].Bytes())
+	tampered[len(tampered)-40] ^= 1
+	err := CombineStream(io.Discard, []io.Reader{bufs[0], bytes.NewReader(tampered)}, 2, opts...)
+	if err == nil {
+		t.Fatal("Expected error for tampered share stream")
+	}
+	out := buf.String()
+	if !strings.Contains(out, `msg="shamir: combining share streams" shares=2 scheme=v1`) {
+		t.Errorf("Expected combine attempt to be logged, got:\n%s", out)
+	}
+	if !strings.Contains(out, `level=WARN msg="shamir: share stream verification failed" chunk=`) {
+		t.Errorf("Expected verification failure to be logged, got:\n%s", out)
+	}
+}
+
+func TestWithLogger_NilKeepsDefault(t *testing.T) {
+	cfg := NewConfig(WithLogger(nil))
+	if cfg.Logger == nil {
+		t.Fatal("Expected default logger")
+	}
+	var zero Config
+	zero.logger().Info("discarded")
+}
@@ -0,0 +1,145 @@
+package goshamir
+
+import (
+	"errors"
+	"fmt"
+)
+
+const (
+	// matrixBlockRows is the number of rows MulVec accumulates at once. The
+	// accumulator block stays in L1 cache while each column streams past.
+	matrixBlockRows = 512
+
+	// maxLazyTerms is the number of products of reduced elements that can be
+	// summed in a uint32 before it must be reduced (256*256*65535 < 2^32).
+	maxLazyTerms = 65535
+)
+
+// FieldMatrix is a dense matrix of GF(257) elements stored column-major:
+// element (r, c) is Data[c*Rows+r]. Every element must already be reduced
+// modulo FieldPrime.
+//
+// Column-major order matches the shape of a reconstruction, where each
+// column is one share's value, so the layout can be handed directly to
+// vectorized or GPU kernels.
+type FieldMatrix struct {
+	Rows int
+	Cols int
+	Data []uint16
+}
+
+// At returns element (r, c).
+func (m *FieldMatrix) At(r, c int) uint16 {
+	return m.Data[c*m.Rows+r]
+}
+
+// MulVec computes dst = m·v over GF(257). dst must have length m.Rows and v
+// length m.Cols. Rows are processed in blocks so that the partial sums stay
+// cache-resident, and reductions are deferred until they could overflow.
+func (m *FieldMatrix) MulVec(dst, v []uint16) error {
+	if len(m.Data) != m.Rows*m.Cols {
+		return errors.New("matrix data length does not match dimensions")
+	}
+	if len(v) != m.Cols {
+		return fmt.Errorf("vector length %d does not match %d columns", len(v), m.Cols)
+	}
+	if len(dst) != m.Rows {
+		return fmt.Errorf("destination length %d does not match %d rows", len(dst), m.Rows)
+	}
+
+	var acc [matrixBlockRows]uint32
+	for rb := 0; rb < m.Rows; rb += matrixBlockRows {
+		re := min(rb+matrixBlockRows, m.Rows)
+		block := acc[:re-rb]
+		clear(block)
+
+		for c, w := range v {
+			col := m.Data[c*m.Rows+rb : c*m.Rows+re]
+			weight := uint32(w)
+			for r, y := range col {
+				block[r] += uint32(y) * weight
+			}
+			if (c+1)%maxLazyTerms == 0 {
+				for r := range block {
+					block[r] %= FieldPrime
+				}
+			}
+		}
+
+		for r, a := range block {
+			dst[rb+r] = uint16(a % FieldPrime)
+		}
+	}
+	return nil
+}
+
+// CombineSystem expresses reconstruction from the first threshold shares as
+// the matrix-vector product secret = Y·λ over GF(257). Y has one row per
+// secret byte and one column per share, and λ holds the Lagrange basis
+// values for the shares' indices. Callers with their own accelerated
+// kernels can evaluate the product themselves; CombineMatrix does so in
+// pure Go. Only SchemeV1GF257 shares are supported.
+func CombineSystem(shares []Share, threshold int) (_ *FieldMatrix, _ []uint16, err error) {
+	defer recoverInternal(&err)
+
+	if err := validateCombineParams(shares, threshold); err != nil {
+		return nil, nil, err
+	}
+	usedShares := shares[:threshold]
+	scheme, err := sharesScheme(usedShares)
+	if err != nil {
+		return nil, nil, err
+	}
+	if scheme != SchemeV1GF257 {
+		return nil, nil, fmt.Errorf("%w %s for matrix reconstruction", ErrUnsupportedScheme, scheme)
+	}
+	if err := validateShareIndices(usedShares); err != nil {
+		return nil, nil, err
+	}
+	if len(usedShares[0].Value)%2 != 0 {
+		return nil, nil, errors.New("share value length must be even")
+	}
+
+	rows := len(usedShares[0].Value) / 2
+	m := &FieldMatrix{
+		Rows: rows,
+		Cols: threshold,
+		Data: make([]uint16, rows*threshold),
+	}
+	xs := make([]uint8, threshold)
+	for c, s := range usedShares {
+		xs[c] = s.Index
+		col := m.Data[c*rows : (c+1)*rows]
+		for r := range col {
+			y, _ := decodeFieldElement(s.Value, r)
+			if y >= FieldPrime {
+				return nil, nil, fmt.Errorf("share %d: decoded value %d out of field range [0, %d]", c, y, FieldPrime-1)
+			}
+			col[r] = uint16(y)
+		}
+	}
+	return m, lagrangeBasisAtZero(xs), nil
+}
+
+// CombineMatrix reconstructs the secret like Combine, but evaluates the
+// interpolation as a blocked matrix-vector product. It is intended for very
+// large secrets, where it is considerably faster than Combine.
+func CombineMatrix(shares []Share, threshold int) (_ []byte, err error) {
+	defer recoverInternal(&err)
+
+	m, basis, err := CombineSystem(shares, threshold)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]uint16, m.Rows)
+	if err := m.MulVec(result, basis); err != nil {
+		return nil, err
+	}
+
+	secret := make([]byte, m.Rows)
+	for i, v := range result {
+		secret[i] = byte(v % 256)
+	}
+	return secret, nil
+}
@@ -0,0 +1,96 @@
+package goshamir
+
+import (
+	"bytes"
+	"crypto/rand"
+	"testing"
+)
+
+// --- Matrix Tests ---
+
+func TestCombineMatrix_MatchesCombine(t *testing.T) {
+	secret := make([]byte, 3000)
+	if _, err := rand.Read(secret); err != nil {
+		t.Fatalf("Failed to generate random secret: %v", err)
+	}
+
+	shares, err := Split(secret, 7, 4)
+	if err != nil {
+		t.Fatalf("Split failed: %v", err)
+	}
+
+	subset := []Share{shares[6], shares[2], shares[0], shares[5]}
+	recovered, err := CombineMatrix(subset, 4)
+	if err != nil {
+		t.Fatalf("CombineMatrix failed: %v", err)
+	}
+	if !bytes.Equal(secret, recovered) {
+		t.Error("Recovered secret does not match original")
+	}
+}
+
+func TestCombineMatrix_InvalidShares(t *testing.T) {
+	if _, err := CombineMatrix(nil, 2); err == nil {
+		t.Error("Expected error for nil shares")
+	}
+
+	shares := []Share{
+		{Index: 1, Value: []byte{0xFF, 0xFF}},
+		{Index: 2, Value: []byte{1, 0}},
+	}
+	if _, err := CombineMatrix(shares, 2); err == nil {
+		t.Error("Expected error for out-of-field value")
+	}
+}
+
+func TestCombineSystem_Shape(t *testing.T) {
+	shares, err := Split([]byte("matrix"), 5, 3)
+	if err != nil {
+		t.Fatalf("Split failed: %v", err)
+	}
+
+	m, basis, err := CombineSystem(shares, 3)
+	if err != nil {
+		t.Fatalf("CombineSystem failed: %v", err)
+	}
+	if m.Rows != 6 || m.Cols != 3 || len(basis) != 3 {
+		t.Fatalf("Unexpected shape: %dx%d with %d basis values", m.Rows, m.Cols, len(basis))
+	}
+
+	y, _ := decodeFieldElement(shares[1].Value, 4)
+	if got := m.At(4, 1); int64(got) != y {
+		t.Errorf("Expected element %d, got %d", y, got)
+	}
+}
+
+func TestFieldMatrix_MulVecDimensions(t *testing.T) {
+	m := &FieldMatrix{Rows: 2, Cols: 2, Data: []uint16{1, 2, 3, 4}}
+	if err := m.MulVec(make([]uint16, 2), []uint16{1}); err == nil {
+		t.Error("Expected error for short vector")
+	}
+	if err := m.MulVec(make([]uint16, 1), []uint16{1, 1}); err == nil {
+		t.Error("Expected error for short destination")
+	}
+
+	dst := make([]uint16, 2)
+	if err := m.MulVec(dst, []uint16{1, 256}); err != nil {
+		t.Fatalf("MulVec failed: %v", err)
+	}
+	// Column-major: row 0 is (1, 3), row 1 is (2, 4); 256 = -1 mod 257.
+	if dst[0] != gfSub(1, 3) || dst[1] != gfSub(2, 4) {
+		t.Errorf("Unexpected product %v", dst)
+	}
+}
+
+func BenchmarkCombineMatrix(b *testing.B) {
+	secret := make([]byte, 1<<16)
+	if _, err := rand.Read(secret); err != nil {
+		b.Fatal(err)
+	}
+	shares, _ := Split(secret, 5, 3)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		CombineMatrix(shares[:3], 3)
+	}
+}
@@ -0,0 +1,217 @@
+package goshamir
+
+import (
+	"bytes"
+	"crypto/rand"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"io"
+	"math/bits"
+	"slices"
+)
+
+// ShareBlindingSize is the size of the random blinding NewShareMerkleTree
+// draws for each share commitment.
+const ShareBlindingSize = 32
+
+// ErrInclusionProof is returned by VerifyInclusion when a proof does not
+// show membership of a share in the committed set.
+var ErrInclusionProof = errors.New("invalid inclusion proof")
+
+// ShareMerkleTree is a Merkle tree over the commitments of a share set,
+// ordered by share index. Its root can be published, for example in an
+// audit log or on a blockchain, and each custodian can later prove that
+// their share belongs to the set without revealing it.
+//
+// Each commitment is blinded with a random value drawn when the tree is
+// built and handed to the custodian in their InclusionProof, so published
+// commitments cannot be matched against guessed share values.
+//
+// Hashing follows RFC 6962: leaves are SHA-256(0x00 || commitment) and
+// interior nodes SHA-256(0x01 || left || right).
+type ShareMerkleTree struct {
+	indices     []uint8
+	blindings   [][]byte
+	commitments [][]byte
+	leaves      [][]byte
+}
+
+// InclusionProof shows that the leaf at LeafIndex is part of a tree of
+// TreeSize leaves. Blinding is the share's commitment blinding; keep it
+// with the share, since the share cannot be verified without it.
+type InclusionProof struct {
+	LeafIndex int
+	TreeSize  int
+	Hashes    [][]byte
+	Blinding  []byte
+}
+
+// ShareCommitment returns a 32-byte commitment to a share's scheme, index
+// and value, blinded with the given random value. Without a blinding the
+// commitment is a plain hash: the value of a share of a short secret has
+// only a few bytes, so anyone can find it by trying every candidate.
+// Commitments that are published must use a blinding that is kept with
+// the share, as NewShareMerkleTree does.
+func ShareCommitment(s Share, blinding []byte) []byte {
+	h := sha256.New()
+	h.Write([]byte("goshamir share commitment"))
+	h.Write([]byte{byte(schemeOf(s)), s.Index})
+	h.Write(appendField(nil, blinding))
+	h.Write(s.Value)
+	return h.Sum(nil)
+}
+
+// NewShareMerkleTree builds the Merkle tree of a share set, drawing a
+// fresh blinding for each share's commitment. Two trees of the same shares
+// therefore have different roots; the tree that was published must be
+// kept until every custodian has their proof.
+func NewShareMerkleTree(shares []Share) (*ShareMerkleTree, error) {
+	return newShareMerkleTree(shares, rand.Reader)
+}
+
+// newShareMerkleTree builds the Merkle tree of a share set with blindings
+// read from r, or with unblinded commitments if r is nil.
+func newShareMerkleTree(shares []Share, r io.Reader) (*ShareMerkleTree, error) {
+	if len(shares) == 0 {
+		return nil, errors.New("shares cannot be empty")
+	}
+	sorted := slices.Clone(shares)
+	slices.SortFunc(sorted, func(a, b Share) int { return int(a.Index) - int(b.Index) })
+
+	t := &ShareMerkleTree{
+		indices:     make([]uint8, len(sorted)),
+		blindings:   make([][]byte, len(sorted)),
+		commitments: make([][]byte, len(sorted)),
+		leaves:      make([][]byte, len(sorted)),
+	}
+	for i, s := range sorted {
+		if i > 0 && s.Index == sorted[i-1].Index {
+			return nil, errors.New("duplicate share index found")
+		}
+		if r != nil {
+			t.blindings[i] = make([]byte, ShareBlindingSize)
+			if _, err := io.ReadFull(r, t.blindings[i]); err != nil {
+				return nil, fmt.Errorf("generating commitment blinding: %w", err)
+			}
+		}
+		t.indices[i] = s.Index
+		t.commitments[i] = ShareCommitment(s, t.blindings[i])
+		t.leaves[i] = merkleLeafHash(t.commitments[i])
+	}
+	return t, nil
+}
+
+// Root returns the tree's root hash.
+func (t *ShareMerkleTree) Root() []byte {
+	return merkleRoot(t.leaves)
+}
+
+// Indices returns the share indices in the tree, in ascending order.
+func (t *ShareMerkleTree) Indices() []uint8 {
+	return slices.Clone(t.indices)
+}
+
+// Commitment returns the blinded commitment of the share with the given
+// index, which may be published alongside the root.
+func (t *ShareMerkleTree) Commitment(index uint8) ([]byte, error) {
+	pos := slices.Index(t.indices, index)
+	if pos < 0 {
+		return nil, fmt.Errorf("no share with index %d", index)
+	}
+	return slices.Clone(t.commitments[pos]), nil
+}
+
+// Proof returns the inclusion proof for the share with the given index,
+// including its blinding.
+func (t *ShareMerkleTree) Proof(index uint8) (*InclusionProof, error) {
+	pos := slices.Index(t.indices, index)
+	if pos < 0 {
+		return nil, fmt.Errorf("no share with index %d", index)
+	}
+	return &InclusionProof{
+		LeafIndex: pos,
+		TreeSize:  len(t.leaves),
+		Hashes:    merklePath(pos, t.leaves),
+		Blinding:  slices.Clone(t.blindings[pos]),
+	}, nil
+}
+
+// VerifyShareInclusion checks that a share is included in the tree with
+// the given root, using the blinding carried by its proof.
+func VerifyShareInclusion(root []byte, s Share, proof *InclusionProof) error {
+	if proof == nil {
+		return ErrInclusionProof
+	}
+	return VerifyInclusion(root, ShareCommitment(s, proof.Blinding), proof)
+}
+
+// VerifyInclusion checks that commitment, as returned by ShareCommitment,
+// is included in the tree with the given root.
+func VerifyInclusion(root, commitment []byte, proof *InclusionProof) error {
+	if proof == nil || proof.LeafIndex < 0 || proof.LeafIndex >= proof.TreeSize {
+		return ErrInclusionProof
+	}
+	// RFC 9162, section 2.1.3.2.
+	fn, sn := proof.LeafIndex, proof.TreeSize-1
+	r := merkleLeafHash(commitment)
+	for _, p := range proof.Hashes {
+		if sn == 0 {
+			return ErrInclusionProof
+		}
+		if fn&1 == 1 || fn == sn {
+			r = merkleNodeHash(p, r)
+			for fn&1 == 0 && fn != 0 {
+				fn >>= 1
+				sn >>= 1
+			}
+		} else {
+			r = merkleNodeHash(r, p)
+		}
+		fn >>= 1
+		sn >>= 1
+	}
+	if sn != 0 || !bytes.Equal(r, root) {
+		return ErrInclusionProof
+	}
+	return nil
+}
+
+func merkleLeafHash(data []byte) []byte {
+	h := sha256.New()
+	h.Write([]byte{0})
+	h.Write(data)
+	return h.Sum(nil)
+}
+
+func merkleNodeHash(left, right []byte) []byte {
+	h := sha256.New()
+	h.Write([]byte{1})
+	h.Write(left)
+	h.Write(right)
+	return h.Sum(nil)
+}
+
+// merkleSplit returns the largest power of two smaller than n.
+func merkleSplit(n int) int {
+	return 1 << (bits.Len(uint(n-1)) - 1)
+}
+
+func merkleRoot(leaves [][]byte) []byte {
+	if len(leaves) == 1 {
+		return leaves[0]
+	}
+	k := merkleSplit(len(leaves))
+	return merkleNodeHash(merkleRoot(leaves[:k]), merkleRoot(leaves[k:]))
+}
+
+func merklePath(m int, leaves [][]byte) [][]byte {
+	if len(leaves) == 1 {
+		return nil
+	}
+	k := merkleSplit(len(leaves))
+	if m < k {
+		return append(merklePath(m, leaves[:k]), merkleRoot(leaves[k:]))
+	}
+	return append(merklePath(m-k, leaves[k:]), merkleRoot(leaves[:k]))
+}
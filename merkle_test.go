@@ -0,0 +1,87 @@
+package goshamir
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+)
+
+// --- Merkle Tree Tests ---
+
+func TestShareMerkleTree(t *testing.T) {
+	for _, n := range []int{1, 2, 3, 5, 8, 13} {
+		shares := make([]Share, n)
+		for i := range shares {
+			shares[i] = Share{Index: uint8(n - i), Value: []byte{byte(i), 1}}
+		}
+		tree, err := NewShareMerkleTree(shares)
+		if err != nil {
+			t.Fatalf("NewShareMerkleTree failed: %v", err)
+		}
+		root := tree.Root()
+
+		for _, s := range shares {
+			proof, err := tree.Proof(s.Index)
+			if err != nil {
+				t.Fatalf("Proof failed: %v", err)
+			}
+			if len(proof.Blinding) != ShareBlindingSize {
+				t.Fatalf("n=%d: Expected a %d-byte blinding, got %d", n, ShareBlindingSize, len(proof.Blinding))
+			}
+			if err := VerifyShareInclusion(root, s, proof); err != nil {
+				t.Errorf("n=%d: VerifyShareInclusion failed for share %d: %v", n, s.Index, err)
+			}
+			commitment, _ := tree.Commitment(s.Index)
+			if err := VerifyInclusion(root, commitment, proof); err != nil {
+				t.Errorf("n=%d: VerifyInclusion failed for share %d: %v", n, s.Index, err)
+			}
+			other := Share{Index: s.Index, Value: []byte{0xFF, 0xFF}}
+			if err := VerifyShareInclusion(root, other, proof); !errors.Is(err, ErrInclusionProof) {
+				t.Errorf("n=%d: Expected ErrInclusionProof for foreign share, got %v", n, err)
+			}
+		}
+	}
+}
+
+func TestShareMerkleTree_Root(t *testing.T) {
+	shares, _ := Split([]byte("secret"), 3, 2)
+	tree, _ := NewShareMerkleTree(shares)
+	again, _ := NewShareMerkleTree(shares)
+	if bytes.Equal(tree.Root(), again.Root()) {
+		t.Error("Expected fresh blindings to give a different root")
+	}
+	plain, _ := newShareMerkleTree(shares, nil)
+	reordered, _ := newShareMerkleTree([]Share{shares[2], shares[0], shares[1]}, nil)
+	if !bytes.Equal(plain.Root(), reordered.Root()) {
+		t.Error("Expected the root to be independent of share order")
+	}
+
+	// Three leaves: root = H(1 || H(1 || l0 || l1) || l2).
+	l := make([][]byte, 3)
+	for i, s := range shares {
+		proof, _ := tree.Proof(s.Index)
+		l[i] = merkleLeafHash(ShareCommitment(s, proof.Blinding))
+	}
+	want := merkleNodeHash(merkleNodeHash(l[0], l[1]), l[2])
+	if !bytes.Equal(tree.Root(), want) {
+		t.Error("Unexpected root for three leaves")
+	}
+
+	if _, err := tree.Proof(9); err == nil {
+		t.Error("Expected error for unknown index")
+	}
+	if _, err := NewShareMerkleTree([]Share{shares[0], shares[0]}); err == nil {
+		t.Error("Expected error for duplicate indices")
+	}
+	proof, _ := tree.Proof(1)
+	proof.LeafIndex = 1
+	if err := VerifyShareInclusion(tree.Root(), shares[0], proof); err == nil {
+		t.Error("Expected error for wrong leaf position")
+	}
+	if err := VerifyShareInclusion(tree.Root(), shares[0], nil); err == nil {
+		t.Error("Expected error for nil proof")
+	}
+	if _, err := tree.Commitment(9); err == nil {
+		t.Error("Expected error for unknown index")
+	}
+}
@@ -0,0 +1,32 @@
+package goshamir
+
+import "fmt"
+
+// MigrateShares re-splits a secret held as legacy SchemeV1GF257 shares into
+// totalShares SchemeV2GF256 shares with the same threshold. quorum is the
+// threshold of the legacy split; the reconstructed secret only lives in
+// memory for the duration of the call and is wiped before returning.
+//
+// The legacy shares remain valid after migration. Operators should destroy
+// them once the new shares have been distributed.
+func MigrateShares(old []Share, quorum, totalShares int, opts ...Option) ([]Share, error) {
+	if err := validateCombineParams(old, quorum); err != nil {
+		return nil, err
+	}
+	if scheme := schemeOf(old[0]); scheme != SchemeV1GF257 {
+		return nil, fmt.Errorf("shares use scheme %s, expected %s", scheme, SchemeV1GF257)
+	}
+
+	opts = append([]Option{WithScheme(SchemeV2GF256)}, opts...)
+	splitter, err := NewSplitter(totalShares, quorum, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	secret, err := Combine(old, quorum)
+	if err != nil {
+		return nil, err
+	}
+	defer clear(secret)
+	return splitter.Split(secret)
+}
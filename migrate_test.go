@@ -0,0 +1,52 @@
+package goshamir
+
+import (
+	"bytes"
+	"testing"
+)
+
+// --- MigrateShares Tests ---
+
+func TestMigrateShares(t *testing.T) {
+	secret := []byte("legacy secret")
+	old, err := Split(secret, 5, 3)
+	if err != nil {
+		t.Fatalf("Split failed: %v", err)
+	}
+
+	shares, err := MigrateShares(old[1:4], 3, 4)
+	if err != nil {
+		t.Fatalf("MigrateShares failed: %v", err)
+	}
+	if len(shares) != 4 {
+		t.Fatalf("Expected 4 shares, got %d", len(shares))
+	}
+	for i, s := range shares {
+		if s.Scheme != SchemeV2GF256 || len(s.Value) != len(secret) {
+			t.Errorf("Share %d: expected compact share, got scheme %s with %d bytes", i, s.Scheme, len(s.Value))
+		}
+	}
+
+	recovered, err := Combine(shares[1:], 3)
+	if err != nil {
+		t.Fatalf("Combine failed: %v", err)
+	}
+	if !bytes.Equal(secret, recovered) {
+		t.Error("Recovered secret does not match original")
+	}
+}
+
+func TestMigrateShares_Errors(t *testing.T) {
+	old, _ := Split([]byte("secret"), 3, 2)
+	if _, err := MigrateShares(old[:1], 2, 3); err == nil {
+		t.Error("Expected error for too few shares")
+	}
+	if _, err := MigrateShares(old, 2, 1); err == nil {
+		t.Error("Expected error for total shares below threshold")
+	}
+
+	compact, _ := MigrateShares(old, 2, 3)
+	if _, err := MigrateShares(compact, 2, 3); err == nil {
+		t.Error("Expected error for shares that are already compact")
+	}
+}
@@ -0,0 +1,146 @@
+package goshamir
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+)
+
+// ShareParent identifies the share a sub-share was split from by SplitShare.
+type ShareParent struct {
+	// Index is the index of the parent share.
+	Index uint8
+	// Threshold is the number of sub-shares needed to rebuild the parent.
+	Threshold uint8
+}
+
+// SplitShare splits a share into totalShares sub-shares, threshold of which
+// rebuild it, so a custodian can delegate their share to a quorum of their
+// own. Sub-shares record the nesting in Parents, which is kept by the hex
+// and binary encodings, and can be split again.
+//
+// The share's scheme and value are the secret of the sub-split; the
+// sub-shares use the scheme selected with opts. A watermark on the share is
+// not carried over.
+func SplitShare(share Share, totalShares, threshold int, opts ...Option) (_ []Share, err error) {
+	defer recoverInternal(&err)
+
+	if share.Index == 0 {
+		return nil, errors.New("share index must be non-zero")
+	}
+	if len(share.Value) == 0 {
+		return nil, errors.New("share value cannot be empty")
+	}
+	splitter, err := NewSplitter(totalShares, threshold, opts...)
+	if err != nil {
+		return nil, err
+	}
+	secret := append([]byte{byte(schemeOf(share))}, share.Value...)
+	defer clear(secret)
+	subs, err := splitter.Split(secret)
+	if err != nil {
+		return nil, err
+	}
+	parents := append(slices.Clone(share.Parents), ShareParent{Index: share.Index, Threshold: uint8(threshold)})
+	for i := range subs {
+		subs[i].Parents = parents
+	}
+	return subs, nil
+}
+
+// CombineShare rebuilds the share that sub-shares were split from. All
+// sub-shares must have the same parent.
+func CombineShare(subs []Share) (_ Share, err error) {
+	defer recoverInternal(&err)
+
+	if len(subs) == 0 {
+		return Share{}, errors.New("no sub-shares provided")
+	}
+	parents := subs[0].Parents
+	if len(parents) == 0 {
+		return Share{}, errors.New("share is not a sub-share")
+	}
+	for i, s := range subs[1:] {
+		if !slices.Equal(s.Parents, parents) {
+			return Share{}, fmt.Errorf("sub-share at position %d belongs to a different parent", i+1)
+		}
+	}
+	parent := parents[len(parents)-1]
+	secret, err := Combine(subs, int(parent.Threshold))
+	if err != nil {
+		return Share{}, fmt.Errorf("rebuilding share %d: %w", parent.Index, err)
+	}
+	if len(secret) < 2 || secret[0] == 0 {
+		clear(secret)
+		return Share{}, fmt.Errorf("rebuilding share %d: invalid sub-share contents", parent.Index)
+	}
+	share := Share{Index: parent.Index, Scheme: Scheme(secret[0]), Value: secret[1:]}
+	if len(parents) > 1 {
+		share.Parents = slices.Clone(parents[:len(parents)-1])
+	}
+	return share, nil
+}
+
+// CombineNested reconstructs the secret from any mix of shares and
+// sub-shares, at any depth of nesting. Sub-shares are grouped by parent and
+// each group with enough sub-shares is rebuilt into its parent share,
+// innermost first; groups short of their quorum are ignored. The resulting
+// top-level shares are then combined with threshold.
+func CombineNested(shares []Share, threshold int) (_ []byte, err error) {
+	defer recoverInternal(&err)
+
+	pending := slices.Clone(shares)
+	for {
+		depth := 0
+		for _, s := range pending {
+			depth = max(depth, len(s.Parents))
+		}
+		if depth == 0 {
+			break
+		}
+		var next []Share
+		groups := make(map[string][]Share)
+		var order []string
+		for _, s := range pending {
+			if len(s.Parents) < depth {
+				next = append(next, s)
+				continue
+			}
+			key := fmt.Sprint(s.Parents)
+			if _, ok := groups[key]; !ok {
+				order = append(order, key)
+			}
+			groups[key] = append(groups[key], s)
+		}
+		for _, key := range order {
+			group := groups[key]
+			parent := group[0].Parents[depth-1]
+			if distinctIndices(group) < int(parent.Threshold) {
+				continue
+			}
+			share, err := CombineShare(dedupeShares(group))
+			if err != nil {
+				return nil, err
+			}
+			next = append(next, share)
+		}
+		pending = next
+	}
+	return Combine(dedupeShares(pending), threshold)
+}
+
+// dedupeShares drops shares whose index appeared earlier with the same
+// value, so a share presented both directly and through its sub-shares is
+// counted once. Conflicting shares with the same index are kept for
+// Combine to report.
+func dedupeShares(shares []Share) []Share {
+	var out []Share
+	for _, s := range shares {
+		if !slices.ContainsFunc(out, func(o Share) bool {
+			return o.Index == s.Index && schemeOf(o) == schemeOf(s) && slices.Equal(o.Value, s.Value)
+		}) {
+			out = append(out, s)
+		}
+	}
+	return out
+}
@@ -0,0 +1,107 @@
+package goshamir
+
+import (
+	"bytes"
+	"errors"
+	"slices"
+	"testing"
+)
+
+// --- SplitShare Tests ---
+
+func TestSplitShare_RoundTrip(t *testing.T) {
+	shares, _ := Split([]byte("delegated"), 3, 2)
+	subs, err := SplitShare(shares[2], 3, 2, WithScheme(SchemeV2GF256))
+	if err != nil {
+		t.Fatalf("SplitShare failed: %v", err)
+	}
+	want := []ShareParent{{Index: 3, Threshold: 2}}
+	for _, s := range subs {
+		if !slices.Equal(s.Parents, want) || s.Scheme != SchemeV2GF256 {
+			t.Fatalf("Unexpected sub-share %+v", s)
+		}
+	}
+
+	rebuilt, err := CombineShare(subs[1:])
+	if err != nil {
+		t.Fatalf("CombineShare failed: %v", err)
+	}
+	if rebuilt.Index != 3 || rebuilt.Scheme != SchemeV1GF257 || !bytes.Equal(rebuilt.Value, shares[2].Value) || rebuilt.Parents != nil {
+		t.Errorf("Unexpected rebuilt share %+v", rebuilt)
+	}
+	if _, err := CombineShare(subs[:1]); err == nil {
+		t.Error("Expected error for too few sub-shares")
+	}
+	if _, err := CombineShare(shares[:2]); err == nil {
+		t.Error("Expected error for top-level shares")
+	}
+}
+
+func TestCombineNested(t *testing.T) {
+	shares, _ := Split([]byte("nested quorum"), 3, 2)
+	subs, _ := SplitShare(shares[0], 3, 2)
+	subsubs, _ := SplitShare(subs[2], 2, 2)
+	if got := subsubs[0].Parents; !slices.Equal(got, []ShareParent{{1, 2}, {3, 2}}) {
+		t.Fatalf("Unexpected parents %v", got)
+	}
+
+	tests := []struct {
+		name   string
+		shares []Share
+	}{
+		{"sub-shares and a share", []Share{subs[0], shares[1], subs[1]}},
+		{"two levels", []Share{subsubs[1], subs[0], shares[2], subsubs[0]}},
+		{"share also present directly", []Share{shares[0], subs[0], subs[1], shares[1]}},
+		{"incomplete group ignored", []Share{subsubs[0], shares[1], shares[2]}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			secret, err := CombineNested(tt.shares, 2)
+			if err != nil || string(secret) != "nested quorum" {
+				t.Fatalf("CombineNested failed: %q, %v", secret, err)
+			}
+		})
+	}
+
+	_, err := CombineNested([]Share{subs[0], shares[1]}, 2)
+	var ce *CombineError
+	if !errors.As(err, &ce) || ce.Problem != ProblemInsufficientShares {
+		t.Errorf("Expected insufficient shares, got %v", err)
+	}
+	_, err = Combine([]Share{subs[0], shares[1]}, 2)
+	if !errors.As(err, &ce) || ce.Problem != ProblemMixedNesting {
+		t.Errorf("Expected Combine to reject mixed nesting, got %v", err)
+	}
+}
+
+func TestSplitShare_Encoding(t *testing.T) {
+	shares, _ := Split([]byte("encode me"), 3, 2)
+	subs, _ := SplitShare(shares[1], 4, 3)
+	subsubs, _ := SplitShare(subs[3], 2, 2, WithScheme(SchemeV2GF256))
+
+	encoded, _ := EncodeSharesToHex(subsubs)
+	if want := "v2:2.3/4.2/1:"; encoded[0][:len(want)] != want {
+		t.Errorf("Expected prefix %q, got %q", want, encoded[0])
+	}
+	decoded, err := DecodeSharesFromHex(encoded)
+	if err != nil || !slices.Equal(decoded[1].Parents, subsubs[1].Parents) {
+		t.Fatalf("DecodeSharesFromHex failed: %v", err)
+	}
+	for _, bad := range []string{"0.2/1:00", "3.1/1:00", "3/1:00", "x.2/1:00"} {
+		if _, err := DecodeSharesFromHex([]string{bad}); err == nil {
+			t.Errorf("Expected error for %q", bad)
+		}
+	}
+
+	data, err := subsubs[0].MarshalBinary()
+	if err != nil || data[2] != envelopeVersion2 {
+		t.Fatalf("MarshalBinary failed: %v", err)
+	}
+	var s Share
+	if err := s.UnmarshalBinary(data); err != nil || !slices.Equal(s.Parents, subsubs[0].Parents) {
+		t.Fatalf("UnmarshalBinary failed: %v", err)
+	}
+	if data, _ := shares[0].MarshalBinary(); data[2] != envelopeVersion {
+		t.Error("Expected version 1 envelopes for ordinary shares")
+	}
+}
@@ -0,0 +1,162 @@
+package goshamir
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"slices"
+)
+
+// ShareOrder is the canonical order NormalizeShares puts shares in.
+type ShareOrder int
+
+const (
+	// OrderByIndex sorts shares by ascending index. It is the default.
+	OrderByIndex ShareOrder = iota
+	// OrderByCommitment sorts shares by their unblinded ShareCommitment,
+	// so that the position of a share in a persisted set does not reveal
+	// its index.
+	OrderByCommitment
+)
+
+// String returns the order's name, such as "index".
+func (o ShareOrder) String() string {
+	switch o {
+	case OrderByIndex:
+		return "index"
+	case OrderByCommitment:
+		return "commitment"
+	}
+	return fmt.Sprintf("ShareOrder(%d)", int(o))
+}
+
+// NormalizeOption configures NormalizeShares.
+type NormalizeOption func(*normalizeConfig)
+
+type normalizeConfig struct {
+	order ShareOrder
+}
+
+// WithShareOrder sets the canonical order of NormalizeShares.
+func WithShareOrder(o ShareOrder) NormalizeOption {
+	return func(c *normalizeConfig) {
+		c.order = o
+	}
+}
+
+// ShareSet is a canonical share set produced by NormalizeShares: duplicate
+// free, of one scheme and nesting level, with equally long values, in a
+// deterministic order.
+type ShareSet struct {
+	Order  ShareOrder
+	Scheme Scheme
+	Shares []Share
+}
+
+// Hash returns a SHA-256 digest of the set's shares in their canonical
+// order, covering each share's binary envelope. Two normalizations of the
+// same shares hash alike however the input was ordered or duplicated.
+func (s *ShareSet) Hash() ([]byte, error) {
+	h := sha256.New()
+	h.Write([]byte("goshamir share set"))
+	h.Write([]byte{byte(s.Order)})
+	for _, sh := range s.Shares {
+		env, err := sh.MarshalBinary()
+		if err != nil {
+			return nil, err
+		}
+		h.Write(env)
+	}
+	return h.Sum(nil), nil
+}
+
+// NormalizeReport describes the changes NormalizeShares made.
+type NormalizeReport struct {
+	// Input is the number of shares given.
+	Input int
+	// Duplicates lists the indices of exact copies that were dropped, once
+	// per dropped copy.
+	Duplicates []uint8
+	// Reordered reports whether the input was not already in canonical
+	// order, ignoring dropped copies.
+	Reordered bool
+}
+
+// NormalizeShares returns shares as a canonical ShareSet, so that
+// persisting or hashing a share set is deterministic. Exact copies of a
+// share are dropped; a share whose value differs from an earlier one with
+// the same index is rejected, as are zero indices, mixed schemes or
+// nesting, and values of different lengths. Validation errors are
+// *CombineError values pointing at the offending input share. Share values
+// are copied, so the set does not alias the input.
+func NormalizeShares(shares []Share, opts ...NormalizeOption) (*ShareSet, NormalizeReport, error) {
+	var cfg normalizeConfig
+	for _, opt := range opts {
+		if opt != nil {
+			opt(&cfg)
+		}
+	}
+	report := NormalizeReport{Input: len(shares)}
+	if len(shares) == 0 {
+		return nil, report, errors.New("shares cannot be empty")
+	}
+	if cfg.order != OrderByIndex && cfg.order != OrderByCommitment {
+		return nil, report, fmt.Errorf("unknown share order %v", cfg.order)
+	}
+
+	var first [MaxShares + 1]int
+	unique := make([]Share, 0, len(shares))
+	positions := make([]int, 0, len(shares))
+	for i, s := range shares {
+		if s.Index == 0 {
+			return nil, report, newCombineError(ProblemZeroIndex, shares, i)
+		}
+		if f := first[s.Index]; f != 0 {
+			if prev := shares[f-1]; schemeOf(prev) == schemeOf(s) && bytes.Equal(prev.Value, s.Value) && slices.Equal(prev.Parents, s.Parents) {
+				report.Duplicates = append(report.Duplicates, s.Index)
+				continue
+			}
+			e := newCombineError(ProblemDuplicateIndex, shares, i)
+			e.Other = f - 1
+			return nil, report, e
+		}
+		first[s.Index] = i + 1
+		unique = append(unique, s)
+		positions = append(positions, i)
+	}
+	scheme, err := sharesScheme(unique)
+	if err == nil {
+		err = checkNesting(unique)
+	}
+	if err == nil {
+		err = checkLengths(unique)
+	}
+	if err != nil {
+		// Point at the input share rather than its deduplicated position.
+		var ce *CombineError
+		if errors.As(err, &ce) && ce.Position >= 0 {
+			ce.Position = positions[ce.Position]
+		}
+		return nil, report, err
+	}
+
+	set := &ShareSet{Order: cfg.order, Scheme: scheme, Shares: make([]Share, len(unique))}
+	for i, s := range unique {
+		set.Shares[i] = Share{
+			Index:     s.Index,
+			Value:     bytes.Clone(s.Value),
+			Scheme:    scheme,
+			Watermark: bytes.Clone(s.Watermark),
+			Parents:   slices.Clone(s.Parents),
+			Peppered:  s.Peppered,
+		}
+	}
+	cmp := func(a, b Share) int { return int(a.Index) - int(b.Index) }
+	if cfg.order == OrderByCommitment {
+		cmp = func(a, b Share) int { return bytes.Compare(ShareCommitment(a, nil), ShareCommitment(b, nil)) }
+	}
+	report.Reordered = !slices.IsSortedFunc(unique, cmp)
+	slices.SortFunc(set.Shares, cmp)
+	return set, report, nil
+}
@@ -0,0 +1,97 @@
+package goshamir
+
+import (
+	"bytes"
+	"errors"
+	"slices"
+	"testing"
+)
+
+// --- NormalizeShares Tests ---
+
+func TestNormalizeShares(t *testing.T) {
+	shares, _ := Split([]byte("normalize me"), 5, 3)
+	messy := []Share{shares[3], shares[0], shares[3], shares[2], shares[0]}
+
+	set, report, err := NormalizeShares(messy)
+	if err != nil {
+		t.Fatalf("NormalizeShares failed: %v", err)
+	}
+	var indices []uint8
+	for _, s := range set.Shares {
+		indices = append(indices, s.Index)
+	}
+	if !slices.Equal(indices, []uint8{1, 3, 4}) {
+		t.Fatalf("got indices %v, want [1 3 4]", indices)
+	}
+	if report.Input != 5 || !slices.Equal(report.Duplicates, []uint8{4, 1}) || !report.Reordered {
+		t.Fatalf("unexpected report %+v", report)
+	}
+	if set.Scheme != SchemeV1GF257 {
+		t.Fatalf("got scheme %v", set.Scheme)
+	}
+
+	set.Shares[0].Value[0] ^= 1
+	if shares[0].Value[0] == set.Shares[0].Value[0] {
+		t.Fatal("normalized set aliases the input")
+	}
+	set.Shares[0].Value[0] ^= 1
+	if got, err := Combine(set.Shares, 3); err != nil || string(got) != "normalize me" {
+		t.Fatalf("Combine of normalized set = %q, %v", got, err)
+	}
+
+	_, report, _ = NormalizeShares(shares[:3])
+	if report.Reordered || len(report.Duplicates) != 0 {
+		t.Fatalf("unexpected report for canonical input %+v", report)
+	}
+}
+
+func TestShareSet_HashIsDeterministic(t *testing.T) {
+	shares, _ := Split([]byte("hash me"), 4, 2)
+	a, _, _ := NormalizeShares([]Share{shares[2], shares[1], shares[0]})
+	b, _, _ := NormalizeShares([]Share{shares[0], shares[1], shares[2], shares[1]})
+	ha, _ := a.Hash()
+	hb, _ := b.Hash()
+	if !bytes.Equal(ha, hb) {
+		t.Fatal("equivalent share sets hash differently")
+	}
+	c, _, _ := NormalizeShares(shares[:3], WithShareOrder(OrderByCommitment))
+	if hc, _ := c.Hash(); bytes.Equal(ha, hc) {
+		t.Fatal("expected different hashes for different orders")
+	}
+	for i := 1; i < len(c.Shares); i++ {
+		if bytes.Compare(ShareCommitment(c.Shares[i-1], nil), ShareCommitment(c.Shares[i], nil)) > 0 {
+			t.Fatal("shares not sorted by commitment")
+		}
+	}
+}
+
+func TestNormalizeShares_Rejects(t *testing.T) {
+	shares, _ := Split([]byte("secret"), 3, 2)
+	conflicting := Share{Index: shares[1].Index, Value: bytes.Clone(shares[1].Value)}
+	conflicting.Value[0] ^= 1
+	other, _ := Split([]byte("longer secret"), 3, 2)
+
+	tests := []struct {
+		name     string
+		shares   []Share
+		problem  CombineProblem
+		position int
+	}{
+		{"conflicting duplicate", []Share{shares[0], shares[1], conflicting}, ProblemDuplicateIndex, 2},
+		{"zero index", []Share{shares[0], {Index: 0, Value: []byte{1, 2}}}, ProblemZeroIndex, 1},
+		{"length", []Share{shares[0], shares[0], other[1]}, ProblemLengthMismatch, 2},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, _, err := NormalizeShares(tt.shares)
+			var ce *CombineError
+			if !errors.As(err, &ce) || ce.Problem != tt.problem || ce.Position != tt.position {
+				t.Fatalf("unexpected error %v", err)
+			}
+		})
+	}
+	if _, _, err := NormalizeShares(nil); err == nil {
+		t.Error("expected error for no shares")
+	}
+}
@@ -0,0 +1,288 @@
+package goshamir
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+)
+
+// BundleManifestVersion is the manifest format written by
+// WriteOfflineBundle.
+const BundleManifestVersion = 1
+
+// Names of the fixed files in an offline bundle.
+const (
+	BundleManifestFile = "manifest.json"
+	BundleChecksumFile = "SHA256SUMS"
+	BundleVerifyScript = "verify.sh"
+)
+
+// ErrBundleVerification is returned by VerifyBundleManifest when a bundle
+// is incomplete, altered or inconsistent.
+var ErrBundleVerification = errors.New("offline bundle verification failed")
+
+// verifyScript checks a bundle with coreutils alone, for air-gapped
+// machines without the shamir tool.
+const verifyScript = `#!/bin/sh
+# Verifies this go-shamir offline bundle. Compare the manifest.json hash
+# printed last with the manifest digest announced by the dealer.
+set -eu
+cd "$(dirname "$0")"
+sha256sum -c SHA256SUMS
+sha256sum manifest.json
+`
+
+// BundleManifest describes the contents of an offline bundle.
+type BundleManifest struct {
+	Version     int    `json:"version"`
+	SetID       string `json:"set_id"`
+	Threshold   int    `json:"threshold"`
+	TotalShares int    `json:"total_shares"`
+	Scheme      Scheme `json:"scheme"`
+	// MerkleRoot is the hex root of the Merkle tree of the set's unblinded
+	// commitments. It checks the bundle's shares and differs from the
+	// blinded root of a published ShareMerkleTree.
+	MerkleRoot string       `json:"merkle_root"`
+	Files      []BundleFile `json:"files"`
+}
+
+// BundleFile describes one share file in an offline bundle.
+type BundleFile struct {
+	Name  string `json:"name"`
+	Index uint8  `json:"index"`
+	Size  int64  `json:"size"`
+	// SHA256 is the hex SHA-256 of the file's contents.
+	SHA256 string `json:"sha256"`
+	// Commitment is the hex unblinded ShareCommitment of the share. Like
+	// the share itself, it should not leave the bundle.
+	Commitment string `json:"commitment"`
+}
+
+// Digest returns the hex SHA-256 of the bundle's manifest file. Announce
+// it to the receiving side through a channel other than the bundle itself,
+// such as reading it aloud, so that the bundle as a whole can be trusted.
+func (m *BundleManifest) Digest() (string, error) {
+	data, err := m.marshal()
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:]), nil
+}
+
+func (m *BundleManifest) marshal() ([]byte, error) {
+	data, err := json.MarshalIndent(m, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	return append(data, '\n'), nil
+}
+
+// WriteOfflineBundle writes a self-contained bundle of shares into dir for
+// transfer by removable media to an air-gapped machine. The bundle holds
+// one hex share file per share, a manifest with the hash and commitment of
+// each, a SHA256SUMS file and a verify.sh script that checks the bundle
+// with coreutils alone. dir is created if needed and must not already
+// hold a bundle.
+//
+// Bundles are deterministic: the same shares always produce byte-identical
+// files, so bundles written on two machines can be compared by digest.
+func WriteOfflineBundle(dir, setID string, threshold int, shares []Share) (*BundleManifest, error) {
+	if len(shares) == 0 {
+		return nil, errors.New("no shares to bundle")
+	}
+	if threshold < 2 || threshold > len(shares) {
+		return nil, fmt.Errorf("invalid threshold %d for %d shares", threshold, len(shares))
+	}
+	if setID == "" || strings.ContainsAny(setID, "/\\") {
+		return nil, fmt.Errorf("invalid set ID %q", setID)
+	}
+	sorted := slices.Clone(shares)
+	slices.SortFunc(sorted, func(a, b Share) int { return int(a.Index) - int(b.Index) })
+	for i, s := range sorted {
+		if s.Index == 0 || (i > 0 && sorted[i-1].Index == s.Index) {
+			return nil, fmt.Errorf("invalid or duplicate share index %d", s.Index)
+		}
+		if schemeOf(s) != schemeOf(sorted[0]) {
+			return nil, errors.New("shares use different schemes")
+		}
+	}
+	tree, err := newShareMerkleTree(sorted, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	m := &BundleManifest{
+		Version:     BundleManifestVersion,
+		SetID:       setID,
+		Threshold:   threshold,
+		TotalShares: len(sorted),
+		Scheme:      schemeOf(sorted[0]),
+		MerkleRoot:  hex.EncodeToString(tree.Root()),
+	}
+	names := make([]string, len(sorted))
+	for i, s := range sorted {
+		data := []byte(encodeShareToHex(s) + "\n")
+		sum := sha256.Sum256(data)
+		names[i] = fmt.Sprintf("share-%03d.txt", s.Index)
+		m.Files = append(m.Files, BundleFile{
+			Name:       names[i],
+			Index:      s.Index,
+			Size:       int64(len(data)),
+			SHA256:     hex.EncodeToString(sum[:]),
+			Commitment: hex.EncodeToString(ShareCommitment(s, nil)),
+		})
+	}
+	manifest, err := m.marshal()
+	if err != nil {
+		return nil, err
+	}
+	sums := bundleChecksums(m, manifest, []byte(verifyScript))
+
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return nil, err
+	}
+	if _, err := os.Lstat(filepath.Join(dir, BundleManifestFile)); !errors.Is(err, fs.ErrNotExist) {
+		return nil, fmt.Errorf("%s already holds a bundle", dir)
+	}
+	if _, err := WriteShareFiles(dir, names, sorted); err != nil {
+		return nil, err
+	}
+	// The manifest is written last, so a bundle without one is known to be
+	// incomplete.
+	for _, f := range []struct {
+		name string
+		data []byte
+		perm os.FileMode
+	}{
+		{BundleVerifyScript, []byte(verifyScript), 0o500},
+		{BundleChecksumFile, sums, 0o400},
+		{BundleManifestFile, manifest, 0o400},
+	} {
+		if err := writeBundleFile(dir, f.name, f.data, f.perm); err != nil {
+			return nil, err
+		}
+	}
+	if err := syncDir(dir); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// bundleChecksums returns the contents of a bundle's SHA256SUMS file, in
+// the format checked by sha256sum -c.
+func bundleChecksums(m *BundleManifest, manifest, script []byte) []byte {
+	var b bytes.Buffer
+	for _, f := range m.Files {
+		fmt.Fprintf(&b, "%s  %s\n", f.SHA256, f.Name)
+	}
+	fmt.Fprintf(&b, "%x  %s\n", sha256.Sum256(manifest), BundleManifestFile)
+	fmt.Fprintf(&b, "%x  %s\n", sha256.Sum256(script), BundleVerifyScript)
+	return b.Bytes()
+}
+
+func writeBundleFile(dir, name string, data []byte, perm os.FileMode) error {
+	tmp, err := writeTempFile(dir, name, data)
+	if err != nil {
+		if tmp != "" {
+			os.Remove(tmp)
+		}
+		return err
+	}
+	if err := os.Chmod(tmp, perm); err != nil {
+		os.Remove(tmp)
+		return err
+	}
+	if err := renameFile(tmp, filepath.Join(dir, name)); err != nil {
+		os.Remove(tmp)
+		return err
+	}
+	return nil
+}
+
+// VerifyBundleManifest checks an offline bundle written by
+// WriteOfflineBundle: that every share file is present with the size and
+// hash recorded in the manifest, that the shares match their commitments
+// and Merkle root, and that SHA256SUMS and verify.sh are unaltered. Files
+// not listed in the manifest are ignored.
+//
+// A bundle can only vouch for itself; compare the manifest's Digest with
+// the one announced by the dealer before trusting it.
+func VerifyBundleManifest(dir string) (*BundleManifest, error) {
+	manifest, err := os.ReadFile(filepath.Join(dir, BundleManifestFile))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrBundleVerification, err)
+	}
+	var m BundleManifest
+	dec := json.NewDecoder(bytes.NewReader(manifest))
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(&m); err != nil {
+		return nil, fmt.Errorf("%w: invalid manifest: %w", ErrBundleVerification, err)
+	}
+	if m.Version != BundleManifestVersion {
+		return nil, fmt.Errorf("%w: unsupported manifest version %d", ErrBundleVerification, m.Version)
+	}
+	// Manifests are written canonically; anything else was edited.
+	if canonical, err := m.marshal(); err != nil || !bytes.Equal(canonical, manifest) {
+		return nil, fmt.Errorf("%w: manifest is not in canonical form", ErrBundleVerification)
+	}
+	if len(m.Files) != m.TotalShares || m.Threshold < 2 || m.Threshold > m.TotalShares {
+		return nil, fmt.Errorf("%w: manifest lists %d files for %d shares with threshold %d", ErrBundleVerification, len(m.Files), m.TotalShares, m.Threshold)
+	}
+
+	shares := make([]Share, 0, len(m.Files))
+	defer func() {
+		for _, s := range shares {
+			clear(s.Value)
+		}
+	}()
+	for _, f := range m.Files {
+		if f.Name == "" || filepath.Base(f.Name) != f.Name {
+			return nil, fmt.Errorf("%w: invalid file name %q", ErrBundleVerification, f.Name)
+		}
+		data, err := os.ReadFile(filepath.Join(dir, f.Name))
+		if err != nil {
+			return nil, fmt.Errorf("%w: %w", ErrBundleVerification, err)
+		}
+		sum := sha256.Sum256(data)
+		if int64(len(data)) != f.Size || hex.EncodeToString(sum[:]) != f.SHA256 {
+			clear(data)
+			return nil, fmt.Errorf("%w: %s does not match the manifest", ErrBundleVerification, f.Name)
+		}
+		decoded, err := DecodeSharesFromHex([]string{strings.TrimSpace(string(data))})
+		clear(data)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s: %w", ErrBundleVerification, f.Name, err)
+		}
+		s := decoded[0]
+		shares = append(shares, s)
+		if s.Index != f.Index || schemeOf(s) != m.Scheme || hex.EncodeToString(ShareCommitment(s, nil)) != f.Commitment {
+			return nil, fmt.Errorf("%w: %s does not match its commitment", ErrBundleVerification, f.Name)
+		}
+	}
+	tree, err := newShareMerkleTree(shares, nil)
+	if err != nil || hex.EncodeToString(tree.Root()) != m.MerkleRoot {
+		return nil, fmt.Errorf("%w: shares do not match the Merkle root", ErrBundleVerification)
+	}
+
+	script, err := os.ReadFile(filepath.Join(dir, BundleVerifyScript))
+	if err != nil || string(script) != verifyScript {
+		return nil, fmt.Errorf("%w: %s is missing or altered", ErrBundleVerification, BundleVerifyScript)
+	}
+	sums, err := os.ReadFile(filepath.Join(dir, BundleChecksumFile))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrBundleVerification, err)
+	}
+	if !bytes.Equal(sums, bundleChecksums(&m, manifest, script)) {
+		return nil, fmt.Errorf("%w: %s does not match the manifest", ErrBundleVerification, BundleChecksumFile)
+	}
+	return &m, nil
+}
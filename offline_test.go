@@ -0,0 +1,145 @@
+package goshamir
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// --- Offline Bundle Tests ---
+
+func TestWriteOfflineBundle(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "usb")
+	shares, _ := Split([]byte("air gapped"), 3, 2)
+
+	m, err := WriteOfflineBundle(dir, "a1b2c3d4", 2, []Share{shares[2], shares[0], shares[1]})
+	if err != nil {
+		t.Fatalf("WriteOfflineBundle failed: %v", err)
+	}
+	if len(m.Files) != 3 || m.Files[0].Name != "share-001.txt" || m.Scheme != SchemeV1GF257 {
+		t.Errorf("Unexpected manifest %+v", m)
+	}
+	tree, _ := newShareMerkleTree(shares, nil)
+	if m.MerkleRoot != hex.EncodeToString(tree.Root()) {
+		t.Error("Manifest Merkle root does not match the share set")
+	}
+
+	verified, err := VerifyBundleManifest(dir)
+	if err != nil {
+		t.Fatalf("VerifyBundleManifest failed: %v", err)
+	}
+	digest, _ := verified.Digest()
+	manifest, _ := os.ReadFile(filepath.Join(dir, BundleManifestFile))
+	if sum := sha256.Sum256(manifest); digest != hex.EncodeToString(sum[:]) {
+		t.Error("Digest does not match the manifest file")
+	}
+
+	if _, err := WriteOfflineBundle(dir, "a1b2c3d4", 2, shares); err == nil {
+		t.Error("Expected error writing over an existing bundle")
+	}
+}
+
+func TestWriteOfflineBundle_Deterministic(t *testing.T) {
+	shares, _ := Split([]byte("same bytes"), 4, 3)
+	a, b := t.TempDir(), t.TempDir()
+	if _, err := WriteOfflineBundle(a, "set", 3, shares); err != nil {
+		t.Fatalf("WriteOfflineBundle failed: %v", err)
+	}
+	if _, err := WriteOfflineBundle(b, "set", 3, shares); err != nil {
+		t.Fatalf("WriteOfflineBundle failed: %v", err)
+	}
+	entries, _ := os.ReadDir(a)
+	if len(entries) != 7 {
+		t.Errorf("Expected 7 files, got %d", len(entries))
+	}
+	for _, e := range entries {
+		x, _ := os.ReadFile(filepath.Join(a, e.Name()))
+		y, _ := os.ReadFile(filepath.Join(b, e.Name()))
+		if !bytes.Equal(x, y) {
+			t.Errorf("%s differs between bundles", e.Name())
+		}
+	}
+}
+
+func TestWriteOfflineBundle_Errors(t *testing.T) {
+	shares, _ := Split([]byte("secret"), 3, 2)
+	dup := []Share{shares[0], shares[1], shares[1]}
+	cases := map[string]func() error{
+		"no shares":       func() error { _, err := WriteOfflineBundle(t.TempDir(), "s", 2, nil); return err },
+		"threshold":       func() error { _, err := WriteOfflineBundle(t.TempDir(), "s", 4, shares); return err },
+		"set ID":          func() error { _, err := WriteOfflineBundle(t.TempDir(), "../s", 2, shares); return err },
+		"duplicate index": func() error { _, err := WriteOfflineBundle(t.TempDir(), "s", 2, dup); return err },
+	}
+	for name, fn := range cases {
+		if fn() == nil {
+			t.Errorf("%s: expected error", name)
+		}
+	}
+}
+
+func TestVerifyBundleManifest_Tampering(t *testing.T) {
+	shares, _ := Split([]byte("tamper evident"), 3, 2)
+	other, _ := Split([]byte("tamper evident"), 3, 2)
+	otherHex, _ := EncodeSharesToHex(other)
+
+	cases := map[string]func(dir string){
+		"missing share": func(dir string) { os.Remove(filepath.Join(dir, "share-002.txt")) },
+		"replaced share": func(dir string) {
+			p := filepath.Join(dir, "share-002.txt")
+			os.Chmod(p, 0o600)
+			os.WriteFile(p, []byte(otherHex[1]+"\n"), 0o600)
+		},
+		"edited manifest": func(dir string) {
+			p := filepath.Join(dir, BundleManifestFile)
+			data, _ := os.ReadFile(p)
+			os.Chmod(p, 0o600)
+			os.WriteFile(p, bytes.Replace(data, []byte(`"threshold": 2`), []byte(`"threshold":  2`), 1), 0o600)
+		},
+		"altered script": func(dir string) {
+			p := filepath.Join(dir, BundleVerifyScript)
+			os.Chmod(p, 0o700)
+			os.WriteFile(p, []byte("#!/bin/sh\nexit 0\n"), 0o700)
+		},
+		"altered checksums": func(dir string) {
+			p := filepath.Join(dir, BundleChecksumFile)
+			os.Chmod(p, 0o600)
+			os.WriteFile(p, []byte{}, 0o600)
+		},
+	}
+	for name, tamper := range cases {
+		dir := t.TempDir()
+		if _, err := WriteOfflineBundle(dir, "set", 2, shares); err != nil {
+			t.Fatalf("WriteOfflineBundle failed: %v", err)
+		}
+		tamper(dir)
+		if _, err := VerifyBundleManifest(dir); !errors.Is(err, ErrBundleVerification) {
+			t.Errorf("%s: expected ErrBundleVerification, got %v", name, err)
+		}
+	}
+}
+
+func TestOfflineBundle_VerifyScript(t *testing.T) {
+	if _, err := exec.LookPath("sha256sum"); err != nil {
+		t.Skip("sha256sum not available")
+	}
+	dir := t.TempDir()
+	shares, _ := Split([]byte("coreutils"), 3, 2)
+	m, err := WriteOfflineBundle(dir, "set", 2, shares)
+	if err != nil {
+		t.Fatalf("WriteOfflineBundle failed: %v", err)
+	}
+	out, err := exec.Command("sh", filepath.Join(dir, BundleVerifyScript)).CombinedOutput()
+	if err != nil {
+		t.Fatalf("verify.sh failed: %v\n%s", err, out)
+	}
+	digest, _ := m.Digest()
+	if !strings.Contains(string(out), digest) {
+		t.Errorf("verify.sh did not print the manifest digest:\n%s", out)
+	}
+}
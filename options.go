@@ -0,0 +1,100 @@
+package goshamir
+
+import (
+	"crypto/rand"
+	"io"
+	"log/slog"
+)
+
+// Config holds the tunable settings shared by the reusable Splitter and the
+// other option-based APIs. The zero value is not used directly; call
+// NewConfig or pass Options to a constructor instead.
+type Config struct {
+	// Rand is the source of randomness for polynomial coefficients.
+	// Defaults to crypto/rand.Reader.
+	Rand io.Reader
+
+	// Scheme selects the field and share layout. Defaults to
+	// SchemeV1GF257.
+	Scheme Scheme
+
+	// PoolBuffers enables recycling of share values and temporary buffers
+	// through an internal sync.Pool. It reduces GC pressure for services
+	// performing many operations per second; share values should then be
+	// handed back with Splitter.Release once they are no longer needed.
+	PoolBuffers bool
+
+	// Progress, if set, is called as streaming operations advance.
+	Progress ProgressFunc
+
+	// ChunkMACKey, if set, makes streaming splits frame share streams as
+	// authenticated chunks. See WithChunkMAC.
+	ChunkMACKey []byte
+
+	// KDFParams, if non-zero, overrides DefaultKDFParams for ProtectShare.
+	KDFParams KDFParams
+
+	// Blinding masks the share values and Lagrange basis during
+	// reconstruction. See WithBlinding.
+	Blinding bool
+
+	// Deterministic records that Rand was replaced by
+	// WithDeterministicCoefficients. Such configurations are for tests only.
+	Deterministic bool
+
+	// SplitSalt mixes a fresh salt into the coefficients of every split.
+	// See WithSplitSalt.
+	SplitSalt bool
+
+	// FixedSalt replaces the fresh salt when replaying a split. See
+	// WithFixedSplitSalt.
+	FixedSalt []byte
+
+	// ZeroizeOnCleanup registers a cleanup wiping each share value once
+	// the share is unreachable. See WithZeroizeOnCleanup.
+	ZeroizeOnCleanup bool
+
+	// Pepper, if set, encrypts share values under a key derived from it.
+	// See WithPepper.
+	Pepper []byte
+
+	// Logger receives non-sensitive operational events. Defaults to a
+	// logger that discards everything. See WithLogger.
+	Logger *slog.Logger
+}
+
+// Option configures a Config.
+type Option func(*Config)
+
+// NewConfig returns a Config populated with defaults and the given options.
+func NewConfig(opts ...Option) Config {
+	cfg := Config{
+		Rand:   rand.Reader,
+		Scheme: SchemeV1GF257,
+		Logger: discardLogger,
+	}
+	for _, opt := range opts {
+		if opt != nil {
+			opt(&cfg)
+		}
+	}
+	return cfg
+}
+
+// WithRandom sets the source of randomness used for polynomial coefficients.
+// The reader must be cryptographically secure; a nil reader keeps the
+// default.
+func WithRandom(r io.Reader) Option {
+	return func(c *Config) {
+		if r != nil {
+			c.Rand = r
+		}
+	}
+}
+
+// WithBufferPool enables or disables buffer pooling. See Config.PoolBuffers.
+func WithBufferPool(enabled bool) Option {
+	return func(c *Config) {
+		c.PoolBuffers = enabled
+	}
+}
@@ -0,0 +1,167 @@
+package goshamir
+
+import (
+	"encoding/base32"
+	"errors"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// OTPKey is a TOTP or HOTP key as described by an otpauth:// URI.
+type OTPKey struct {
+	// Type is "totp" or "hotp".
+	Type    string
+	Issuer  string
+	Account string
+	// Secret is the decoded shared seed.
+	Secret []byte
+	// Algorithm is "SHA1", "SHA256" or "SHA512".
+	Algorithm string
+	Digits    int
+	// Period is the TOTP time step in seconds.
+	Period int
+	// Counter is the HOTP moving factor.
+	Counter uint64
+}
+
+var otpBase32 = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+// ParseOTPAuthURI parses an otpauth:// URI as used by authenticator apps.
+func ParseOTPAuthURI(uri string) (*OTPKey, error) {
+	u, err := url.Parse(uri)
+	if err != nil {
+		return nil, fmt.Errorf("invalid otpauth URI: %w", err)
+	}
+	if u.Scheme != "otpauth" {
+		return nil, errors.New("not an otpauth URI")
+	}
+	key := &OTPKey{Type: strings.ToLower(u.Host), Algorithm: "SHA1", Digits: 6}
+	if key.Type != "totp" && key.Type != "hotp" {
+		return nil, fmt.Errorf("unsupported OTP type %q", u.Host)
+	}
+
+	label := strings.TrimPrefix(u.Path, "/")
+	if issuer, account, ok := strings.Cut(label, ":"); ok {
+		key.Issuer, key.Account = issuer, strings.TrimSpace(account)
+	} else {
+		key.Account = label
+	}
+
+	q := u.Query()
+	secret := strings.ToUpper(strings.TrimRight(q.Get("secret"), "="))
+	if secret == "" {
+		return nil, errors.New("otpauth URI has no secret")
+	}
+	if key.Secret, err = otpBase32.DecodeString(secret); err != nil {
+		return nil, errors.New("otpauth secret is not valid base32")
+	}
+	if issuer := q.Get("issuer"); issuer != "" {
+		key.Issuer = issuer
+	}
+	if alg := q.Get("algorithm"); alg != "" {
+		key.Algorithm = strings.ToUpper(alg)
+	}
+	if d := q.Get("digits"); d != "" {
+		if key.Digits, err = strconv.Atoi(d); err != nil {
+			return nil, errors.New("invalid otpauth digits")
+		}
+	}
+	if key.Type == "totp" {
+		key.Period = 30
+		if p := q.Get("period"); p != "" {
+			if key.Period, err = strconv.Atoi(p); err != nil {
+				return nil, errors.New("invalid otpauth period")
+			}
+		}
+	} else {
+		c := q.Get("counter")
+		if c == "" {
+			return nil, errors.New("hotp URI has no counter")
+		}
+		if key.Counter, err = strconv.ParseUint(c, 10, 64); err != nil {
+			return nil, errors.New("invalid otpauth counter")
+		}
+	}
+	if err := key.validate(); err != nil {
+		return nil, err
+	}
+	return key, nil
+}
+
+func (k *OTPKey) validate() error {
+	if k.Type != "totp" && k.Type != "hotp" {
+		return fmt.Errorf("unsupported OTP type %q", k.Type)
+	}
+	if len(k.Secret) == 0 {
+		return errors.New("OTP secret cannot be empty")
+	}
+	switch k.Algorithm {
+	case "SHA1", "SHA256", "SHA512":
+	default:
+		return fmt.Errorf("unsupported OTP algorithm %q", k.Algorithm)
+	}
+	if k.Digits < 6 || k.Digits > 10 {
+		return fmt.Errorf("unsupported OTP digits %d", k.Digits)
+	}
+	if k.Type == "totp" && k.Period < 1 {
+		return errors.New("OTP period must be positive")
+	}
+	return nil
+}
+
+// URI returns the canonical otpauth:// URI of the key.
+func (k *OTPKey) URI() string {
+	label := k.Account
+	if k.Issuer != "" {
+		label = k.Issuer + ":" + k.Account
+	}
+	q := url.Values{}
+	q.Set("secret", otpBase32.EncodeToString(k.Secret))
+	if k.Issuer != "" {
+		q.Set("issuer", k.Issuer)
+	}
+	q.Set("algorithm", k.Algorithm)
+	q.Set("digits", strconv.Itoa(k.Digits))
+	if k.Type == "totp" {
+		q.Set("period", strconv.Itoa(k.Period))
+	} else {
+		q.Set("counter", strconv.FormatUint(k.Counter, 10))
+	}
+	u := url.URL{Scheme: "otpauth", Host: k.Type, Path: "/" + label, RawQuery: q.Encode()}
+	return u.String()
+}
+
+// SplitOTPAuthURI validates a TOTP or HOTP otpauth:// URI and splits it into
+// shares, so that 2FA seeds can be escrowed under threshold control. The
+// issuer, account and parameters are split together with the seed, so the
+// shares alone are enough to restore a working URI.
+func SplitOTPAuthURI(uri string, totalShares, threshold int, opts ...Option) ([]Share, error) {
+	key, err := ParseOTPAuthURI(uri)
+	if err != nil {
+		return nil, err
+	}
+	splitter, err := NewSplitter(totalShares, threshold, opts...)
+	if err != nil {
+		return nil, err
+	}
+	canonical := []byte(key.URI())
+	defer clear(canonical)
+	return splitter.Split(canonical)
+}
+
+// CombineOTPAuthURI reconstructs the otpauth:// URI from shares created by
+// SplitOTPAuthURI and returns it with the parsed key.
+func CombineOTPAuthURI(shares []Share, threshold int) (string, *OTPKey, error) {
+	data, err := Combine(shares, threshold)
+	if err != nil {
+		return "", nil, err
+	}
+	defer clear(data)
+	key, err := ParseOTPAuthURI(string(data))
+	if err != nil {
+		return "", nil, fmt.Errorf("reconstructed data is not an otpauth URI: %w", err)
+	}
+	return key.URI(), key, nil
+}
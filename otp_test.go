@@ -0,0 +1,82 @@
+package goshamir
+
+import (
+	"bytes"
+	"testing"
+)
+
+// --- OTP Tests ---
+
+func TestParseOTPAuthURI(t *testing.T) {
+	key, err := ParseOTPAuthURI("otpauth://totp/ACME%20Co:john@example.com?secret=JBSWY3DPEHPK3PXP&issuer=ACME%20Co&digits=8")
+	if err != nil {
+		t.Fatalf("ParseOTPAuthURI failed: %v", err)
+	}
+	if key.Type != "totp" || key.Issuer != "ACME Co" || key.Account != "john@example.com" {
+		t.Errorf("Unexpected key %+v", key)
+	}
+	if !bytes.Equal(key.Secret, []byte("Hello!\xde\xad\xbe\xef")) || key.Algorithm != "SHA1" || key.Digits != 8 || key.Period != 30 {
+		t.Errorf("Unexpected key parameters %+v", key)
+	}
+
+	again, err := ParseOTPAuthURI(key.URI())
+	if err != nil {
+		t.Fatalf("Parsing canonical URI failed: %v", err)
+	}
+	if again.URI() != key.URI() {
+		t.Errorf("Canonical URI is not stable: %s vs %s", again.URI(), key.URI())
+	}
+
+	hotp, err := ParseOTPAuthURI("otpauth://hotp/alice?secret=jbswy3dpehpk3pxp&counter=7")
+	if err != nil {
+		t.Fatalf("ParseOTPAuthURI failed for hotp: %v", err)
+	}
+	if hotp.Counter != 7 || hotp.Account != "alice" {
+		t.Errorf("Unexpected hotp key %+v", hotp)
+	}
+}
+
+func TestParseOTPAuthURI_Errors(t *testing.T) {
+	for _, uri := range []string{
+		"https://totp/x?secret=JBSWY3DP",
+		"otpauth://motp/x?secret=JBSWY3DP",
+		"otpauth://totp/x",
+		"otpauth://totp/x?secret=!!!",
+		"otpauth://totp/x?secret=JBSWY3DP&algorithm=MD5",
+		"otpauth://totp/x?secret=JBSWY3DP&digits=4",
+		"otpauth://totp/x?secret=JBSWY3DP&period=0",
+		"otpauth://hotp/x?secret=JBSWY3DP",
+	} {
+		if _, err := ParseOTPAuthURI(uri); err == nil {
+			t.Errorf("Expected error for %q", uri)
+		}
+	}
+}
+
+func TestSplitOTPAuthURI(t *testing.T) {
+	uri := "otpauth://totp/Example:alice@example.com?secret=JBSWY3DPEHPK3PXP&issuer=Example"
+	shares, err := SplitOTPAuthURI(uri, 5, 3, WithScheme(SchemeV2GF256))
+	if err != nil {
+		t.Fatalf("SplitOTPAuthURI failed: %v", err)
+	}
+
+	restored, key, err := CombineOTPAuthURI(shares[2:], 3)
+	if err != nil {
+		t.Fatalf("CombineOTPAuthURI failed: %v", err)
+	}
+	if key.Account != "alice@example.com" || key.Issuer != "Example" {
+		t.Errorf("Unexpected key %+v", key)
+	}
+	if parsed, _ := ParseOTPAuthURI(restored); !bytes.Equal(parsed.Secret, key.Secret) {
+		t.Error("Restored URI does not carry the seed")
+	}
+
+	if _, err := SplitOTPAuthURI("otpauth://totp/x", 3, 2); err == nil {
+		t.Error("Expected error for URI without secret")
+	}
+
+	plain, _ := Split([]byte("not a uri"), 2, 2)
+	if _, _, err := CombineOTPAuthURI(plain, 2); err == nil {
+		t.Error("Expected error for shares of other data")
+	}
+}
@@ -0,0 +1,23 @@
+package goshamir
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ErrInternal is returned when an operation hits an internal invariant
+// violation instead of panicking. It indicates a bug in this package, not
+// in the caller's input, and should be reported.
+var ErrInternal = errors.New("internal error")
+
+// recoverInternal converts a panic in the calling function into an error
+// wrapping ErrInternal. It must be deferred directly by every exported
+// function that consumes untrusted shares, so malformed input can never
+// crash the caller:
+//
+//	defer recoverInternal(&err)
+func recoverInternal(err *error) {
+	if r := recover(); r != nil {
+		*err = fmt.Errorf("%w: %v", ErrInternal, r)
+	}
+}
@@ -0,0 +1,144 @@
+package goshamir
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+)
+
+// --- Panic Safety Tests ---
+
+// sharesFromFuzz deterministically builds adversarial shares from fuzz
+// input: each share takes an index, a scheme and a value length from data.
+func sharesFromFuzz(data []byte, count int) []Share {
+	shares := make([]Share, count)
+	for i := range shares {
+		if len(data) < 3 {
+			break
+		}
+		index, scheme, n := data[0], Scheme(data[1]%4), min(int(data[2])%9, len(data)-3)
+		shares[i] = Share{Index: index, Scheme: scheme, Value: data[3 : 3+n]}
+		data = data[3+n:]
+	}
+	return shares
+}
+
+// requireNoInternalError fails the test if err reports a recovered panic.
+// Recovery keeps callers safe, but every ErrInternal is still a bug.
+func requireNoInternalError(t *testing.T, op string, err error) {
+	t.Helper()
+	if errors.Is(err, ErrInternal) {
+		t.Fatalf("%s panicked: %v", op, err)
+	}
+}
+
+func TestRecoverInternal(t *testing.T) {
+	f := func() (err error) {
+		defer recoverInternal(&err)
+		var s []byte
+		_ = s[3]
+		return nil
+	}
+	if err := f(); !errors.Is(err, ErrInternal) {
+		t.Fatalf("Expected ErrInternal, got %v", err)
+	}
+}
+
+func TestNeverPanics_AdversarialShares(t *testing.T) {
+	cases := map[string][]Share{
+		"zero index":       {{Index: 0, Value: []byte{1, 0}}, {Index: 1, Value: []byte{1, 0}}},
+		"odd length":       {{Index: 1, Value: []byte{1}}, {Index: 2, Value: []byte{1}}},
+		"out of field":     {{Index: 1, Value: []byte{0xff, 0xff}}, {Index: 2, Value: []byte{0, 0}}},
+		"duplicate index":  {{Index: 7, Value: []byte{1, 0}}, {Index: 7, Value: []byte{2, 0}}},
+		"nil values":       {{Index: 1}, {Index: 2}},
+		"unknown scheme":   {{Index: 1, Value: []byte{1}, Scheme: 9}, {Index: 2, Value: []byte{1}, Scheme: 9}},
+		"mixed schemes":    {{Index: 1, Value: []byte{1, 0}}, {Index: 2, Value: []byte{1}, Scheme: SchemeV2GF256}},
+		"mismatched sizes": {{Index: 1, Value: []byte{1, 0, 0, 0}}, {Index: 2, Value: []byte{1, 0}}},
+	}
+	for name, shares := range cases {
+		t.Run(name, func(t *testing.T) {
+			_, err := Combine(shares, 2)
+			if err == nil {
+				t.Error("Combine: expected error")
+			}
+			requireNoInternalError(t, "Combine", err)
+			_, err = CombineMatrix(shares, 2)
+			if err == nil {
+				t.Error("CombineMatrix: expected error")
+			}
+			requireNoInternalError(t, "CombineMatrix", err)
+			if r, err := NewReconstructor([]uint8{1, 2}); err == nil {
+				_, err = r.Combine(shares)
+				requireNoInternalError(t, "Reconstructor.Combine", err)
+			}
+			_ = InspectShare(shares[0])
+		})
+	}
+}
+
+func FuzzCombine_NeverPanics(f *testing.F) {
+	f.Add([]byte{1, 0, 2, 1, 0, 2, 0, 2, 1, 0}, uint8(2))
+	f.Add([]byte{0, 1, 1, 7, 0, 7, 1, 1, 3}, uint8(3))
+	f.Add([]byte{255, 1, 8, 1, 2, 3, 4, 5, 6, 7, 8, 254, 1, 8}, uint8(2))
+	f.Fuzz(func(t *testing.T, data []byte, threshold uint8) {
+		shares := sharesFromFuzz(data, 4)
+		_, err := Combine(shares, int(threshold))
+		requireNoInternalError(t, "Combine", err)
+		_, err = CombineMatrix(shares, int(threshold))
+		requireNoInternalError(t, "CombineMatrix", err)
+		_, err = CombineMnemonicSeed(shares, int(threshold))
+		requireNoInternalError(t, "CombineMnemonicSeed", err)
+		_, _, err = CombineOTPAuthURI(shares, int(threshold))
+		requireNoInternalError(t, "CombineOTPAuthURI", err)
+		_, err = EvaluateAt(shares, threshold)
+		requireNoInternalError(t, "EvaluateAt", err)
+		for _, s := range shares {
+			_ = InspectShare(s)
+		}
+
+		indices := make([]uint8, 0, len(shares))
+		for _, s := range shares[:min(int(threshold), len(shares))] {
+			indices = append(indices, s.Index)
+		}
+		if r, err := NewReconstructor(indices); err == nil {
+			_, err = r.Combine(shares)
+			requireNoInternalError(t, "Reconstructor.Combine", err)
+		}
+	})
+}
+
+func FuzzDecodeSharesFromHex_NeverPanics(f *testing.F) {
+	f.Add("1:0a00")
+	f.Add("v2:3:ff#00")
+	f.Add("v:1:")
+	f.Add("#")
+	f.Fuzz(func(t *testing.T, encoded string) {
+		shares, err := DecodeSharesFromHex(strings.Split(encoded, ","))
+		requireNoInternalError(t, "DecodeSharesFromHex", err)
+		if err != nil {
+			return
+		}
+		if _, err := EncodeSharesToHex(shares); err != nil {
+			t.Fatalf("Encode of decoded shares failed: %v", err)
+		}
+	})
+}
+
+func FuzzCombineStream_NeverPanics(f *testing.F) {
+	f.Add([]byte{1, 5, 0, 9, 0}, []byte{2, 5, 0, 9, 0}, false)
+	f.Add([]byte{0, 2, 1, 5}, []byte{0, 2, 2, 5}, true)
+	f.Add([]byte{0, 0, 0}, []byte{}, true)
+	f.Fuzz(func(t *testing.T, a, b []byte, chunked bool) {
+		var opts []Option
+		if chunked {
+			opts = append(opts, WithChunkMAC([]byte("key")))
+		}
+		srcs := []io.Reader{bytes.NewReader(a), bytes.NewReader(b)}
+		requireNoInternalError(t, "CombineStream", CombineStream(io.Discard, srcs, 2, opts...))
+		srcs = []io.Reader{bytes.NewReader(a), bytes.NewReader(b)}
+		_, err := io.Copy(io.Discard, CombineReader(srcs, 2, opts...))
+		requireNoInternalError(t, "CombineReader", err)
+	})
+}
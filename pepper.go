@@ -0,0 +1,120 @@
+package goshamir
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/hkdf"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"io"
+)
+
+// Peppered value layout: nonce | AES-256-GCM ciphertext of the value, with
+// the scheme and index as associated data.
+const (
+	pepperNonceSize = 12
+	pepperOverhead  = pepperNonceSize + 16
+)
+
+// ErrWrongPepper is returned when a peppered share cannot be opened,
+// because the pepper is wrong, the share is not peppered or its value was
+// modified.
+var ErrWrongPepper = errors.New("share pepper does not match")
+
+// WithPepper encrypts the share values produced by Splitter.Split under a
+// key derived from pepper, an application-wide secret kept apart from the
+// share store, such as in a KMS or the application's configuration. A
+// leaked share store is then useless without the pepper. Reconstructors
+// built with the same option reverse it transparently; for other APIs,
+// UnpepperShares restores plain shares first. The shares are marked
+// Peppered, so Combine and the functions built on it reject them with
+// ProblemPeppered rather than returning a wrong secret.
+//
+// Each value grows by 28 bytes: a random nonce and an authentication tag,
+// so a wrong pepper is detected rather than yielding a wrong secret. Index,
+// scheme and watermark stay readable. A nil or empty pepper disables the
+// option. The streaming APIs, such as SplitStream and CombineReader, do not
+// support a pepper and return an error when it is set.
+func WithPepper(pepper []byte) Option {
+	return func(c *Config) {
+		c.Pepper = pepper
+	}
+}
+
+// PepperShares returns copies of shares with their values encrypted under
+// pepper, as WithPepper does, reading nonces from random.
+func PepperShares(shares []Share, pepper []byte, random io.Reader) ([]Share, error) {
+	aead, err := pepperAEAD(pepper)
+	if err != nil {
+		return nil, err
+	}
+	out := make([]Share, len(shares))
+	for i, s := range shares {
+		if out[i], err = pepperShare(aead, s, random); err != nil {
+			return nil, err
+		}
+	}
+	return out, nil
+}
+
+// UnpepperShares returns copies of peppered shares with their plain
+// values, ready for Combine and the other functions taking shares.
+func UnpepperShares(shares []Share, pepper []byte) ([]Share, error) {
+	aead, err := pepperAEAD(pepper)
+	if err != nil {
+		return nil, err
+	}
+	out := make([]Share, len(shares))
+	for i, s := range shares {
+		if out[i], err = unpepperShare(aead, s); err != nil {
+			return nil, fmt.Errorf("share %d: %w", s.Index, err)
+		}
+	}
+	return out, nil
+}
+
+func pepperShare(aead cipher.AEAD, s Share, random io.Reader) (Share, error) {
+	value := make([]byte, pepperNonceSize, pepperOverhead+len(s.Value))
+	if _, err := io.ReadFull(random, value); err != nil {
+		return Share{}, fmt.Errorf("pepper nonce generation failed: %w", err)
+	}
+	s.Value = aead.Seal(value, value, s.Value, pepperAD(s))
+	s.Peppered = true
+	return s, nil
+}
+
+func unpepperShare(aead cipher.AEAD, s Share) (Share, error) {
+	if len(s.Value) < pepperOverhead {
+		return Share{}, ErrWrongPepper
+	}
+	value, err := aead.Open(nil, s.Value[:pepperNonceSize], s.Value[pepperNonceSize:], pepperAD(s))
+	if err != nil {
+		return Share{}, ErrWrongPepper
+	}
+	s.Value = value
+	s.Peppered = false
+	return s, nil
+}
+
+// pepperAD binds a peppered value to its share's scheme and index, so it
+// cannot be moved to another share.
+func pepperAD(s Share) []byte {
+	return []byte{byte(schemeOf(s)), s.Index}
+}
+
+func pepperAEAD(pepper []byte) (cipher.AEAD, error) {
+	if len(pepper) == 0 {
+		return nil, errors.New("pepper cannot be empty")
+	}
+	key, err := hkdf.Key(sha256.New, pepper, nil, "goshamir share pepper", 32)
+	if err != nil {
+		return nil, err
+	}
+	defer clear(key)
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
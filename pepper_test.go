@@ -0,0 +1,173 @@
+package goshamir
+
+import (
+	"bytes"
+	"crypto/rand"
+	"errors"
+	"io"
+	"testing"
+)
+
+// --- Pepper Tests ---
+
+func TestWithPepper(t *testing.T) {
+	secret := []byte("database root password")
+	pepper := []byte("application pepper")
+	for _, scheme := range []Scheme{SchemeV1GF257, SchemeV2GF256} {
+		s, err := NewSplitter(5, 3, WithScheme(scheme), WithPepper(pepper))
+		if err != nil {
+			t.Fatalf("NewSplitter failed: %v", err)
+		}
+		shares, err := s.Split(secret)
+		if err != nil {
+			t.Fatalf("Split failed: %v", err)
+		}
+		if want := len(secret)*scheme.bytesPerElement() + pepperOverhead; len(shares[0].Value) != want {
+			t.Fatalf("got value of %d bytes, want %d", len(shares[0].Value), want)
+		}
+
+		r, err := NewReconstructor([]uint8{2, 4, 5}, WithPepper(pepper))
+		if err != nil {
+			t.Fatalf("NewReconstructor failed: %v", err)
+		}
+		got, err := r.Combine(shares)
+		if err != nil {
+			t.Fatalf("Combine failed: %v", err)
+		}
+		if !bytes.Equal(got, secret) {
+			t.Fatalf("Expected %q, got %q", secret, got)
+		}
+
+		plain, err := UnpepperShares(shares[:3], pepper)
+		if err != nil {
+			t.Fatalf("UnpepperShares failed: %v", err)
+		}
+		if got, err := Combine(plain, 3); err != nil || !bytes.Equal(got, secret) {
+			t.Fatalf("Combine of unpeppered shares = %q, %v", got, err)
+		}
+	}
+}
+
+func TestWithPepper_WrongPepper(t *testing.T) {
+	s, _ := NewSplitter(3, 2, WithScheme(SchemeV2GF256), WithPepper([]byte("right")))
+	shares, _ := s.Split([]byte("secret"))
+
+	r, _ := NewReconstructor([]uint8{1, 2}, WithPepper([]byte("wrong")))
+	if _, err := r.Combine(shares); !errors.Is(err, ErrWrongPepper) {
+		t.Fatalf("expected ErrWrongPepper, got %v", err)
+	}
+	moved := shares[0]
+	moved.Index = 3
+	if _, err := UnpepperShares([]Share{moved}, []byte("right")); !errors.Is(err, ErrWrongPepper) {
+		t.Fatalf("expected ErrWrongPepper for a value moved to another index, got %v", err)
+	}
+	plain, _ := Split([]byte("secret"), 3, 2)
+	if _, err := UnpepperShares(plain, []byte("right")); !errors.Is(err, ErrWrongPepper) {
+		t.Fatalf("expected ErrWrongPepper for unpeppered shares, got %v", err)
+	}
+}
+
+func TestPepperShares(t *testing.T) {
+	shares, _ := Split([]byte("secret"), 3, 2)
+	a, err := PepperShares(shares, []byte("pepper"), rand.Reader)
+	if err != nil {
+		t.Fatalf("PepperShares failed: %v", err)
+	}
+	b, _ := PepperShares(shares, []byte("pepper"), rand.Reader)
+	if bytes.Equal(a[0].Value, b[0].Value) {
+		t.Fatal("peppering the same share twice gave the same value")
+	}
+	if _, err := PepperShares(shares, nil, rand.Reader); err == nil {
+		t.Fatal("expected error for empty pepper")
+	}
+}
+
+func TestWithPepper_PlainCombineRejected(t *testing.T) {
+	for _, scheme := range []Scheme{SchemeV1GF257, SchemeV2GF256} {
+		s, _ := NewSplitter(3, 2, WithScheme(scheme), WithPepper([]byte("pepper")))
+		shares, err := s.Split([]byte("secret"))
+		if err != nil {
+			t.Fatalf("Split failed: %v", err)
+		}
+		if !shares[0].Peppered {
+			t.Fatal("Expected peppered shares to be marked")
+		}
+		var ce *CombineError
+		if _, err := Combine(shares, 2); !errors.As(err, &ce) || ce.Problem != ProblemPeppered {
+			t.Fatalf("expected ProblemPeppered, got %v", err)
+		}
+
+		// The mark survives the binary envelope, and is cleared with the
+		// pepper.
+		data, _ := shares[1].MarshalBinary()
+		var decoded Share
+		if err := decoded.UnmarshalBinary(data); err != nil {
+			t.Fatalf("UnmarshalBinary failed: %v", err)
+		}
+		if !decoded.Peppered {
+			t.Fatal("Envelope lost the pepper mark")
+		}
+		if _, err := Combine([]Share{shares[0], decoded}, 2); !errors.As(err, &ce) || ce.Problem != ProblemPeppered {
+			t.Fatalf("expected ProblemPeppered after an envelope round trip, got %v", err)
+		}
+		plain, _ := UnpepperShares([]Share{shares[0], decoded}, []byte("pepper"))
+		if plain[0].Peppered || plain[1].Peppered {
+			t.Fatal("UnpepperShares left the pepper mark")
+		}
+		if got, err := Combine(plain, 2); err != nil || string(got) != "secret" {
+			t.Fatalf("Combine of unpeppered shares = %q, %v", got, err)
+		}
+		if data, _ := plain[0].MarshalBinary(); data[2] != envelopeVersion {
+			t.Errorf("Expected a version 1 envelope for a plain share, got version %d", data[2])
+		}
+	}
+}
+
+func TestWithPepper_LargeSecret(t *testing.T) {
+	// Larger than a stream chunk, so a split into many blocks is covered.
+	secret := make([]byte, 3*streamChunkSize/2)
+	rand.Read(secret)
+	s, _ := NewSplitter(3, 2, WithPepper([]byte("pepper")))
+	shares, err := s.Split(secret)
+	if err != nil {
+		t.Fatalf("Split failed: %v", err)
+	}
+	r, _ := NewReconstructor([]uint8{1, 3}, WithPepper([]byte("pepper")))
+	got, err := r.Combine([]Share{shares[0], shares[2]})
+	if err != nil {
+		t.Fatalf("Combine failed: %v", err)
+	}
+	if !bytes.Equal(got, secret) {
+		t.Fatal("Combine returned a different secret")
+	}
+}
+
+func TestWithPepper_StreamsRejected(t *testing.T) {
+	secret := make([]byte, 3*streamChunkSize/2)
+	pepper := WithPepper([]byte("pepper"))
+
+	dst := []io.Writer{new(bytes.Buffer), new(bytes.Buffer), new(bytes.Buffer)}
+	if err := SplitStream(dst, bytes.NewReader(secret), 2, pepper); !errors.Is(err, errStreamPepper) {
+		t.Fatalf("SplitStream: expected errStreamPepper, got %v", err)
+	}
+	if _, err := NewStreamSplitter(3, 2, pepper); !errors.Is(err, errStreamPepper) {
+		t.Fatalf("NewStreamSplitter: expected errStreamPepper, got %v", err)
+	}
+	cp := StreamCheckpoint{Offsets: make([]int64, 3)}
+	if _, err := ResumeStreamSplitter(cp, 3, 2, pepper); !errors.Is(err, errStreamPepper) {
+		t.Fatalf("ResumeStreamSplitter: expected errStreamPepper, got %v", err)
+	}
+
+	// Streams split without a pepper cannot be combined with one either.
+	bufs := []*bytes.Buffer{new(bytes.Buffer), new(bytes.Buffer), new(bytes.Buffer)}
+	if err := SplitStream([]io.Writer{bufs[0], bufs[1], bufs[2]}, bytes.NewReader(secret), 2); err != nil {
+		t.Fatalf("SplitStream failed: %v", err)
+	}
+	srcs := []io.Reader{bufs[0], bufs[1]}
+	if err := CombineStream(io.Discard, srcs, 2, pepper); !errors.Is(err, errStreamPepper) {
+		t.Fatalf("CombineStream: expected errStreamPepper, got %v", err)
+	}
+	if _, err := io.ReadAll(CombineReader(srcs, 2, pepper)); !errors.Is(err, errStreamPepper) {
+		t.Fatalf("CombineReader: expected errStreamPepper, got %v", err)
+	}
+}
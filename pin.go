@@ -0,0 +1,240 @@
+package goshamir
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+)
+
+const (
+	pinVersion     = 1
+	pinKDFScrypt   = 1
+	pinKDFArgon2id = 2
+	pinSaltSize    = 16
+	pinNonceSize   = 12
+	pinHeaderSize  = 3 + 4 + 4 + pinSaltSize + pinNonceSize
+
+	// maxKDFMemory bounds the memory an untrusted envelope can make
+	// UnprotectShare allocate.
+	maxKDFMemory = 1 << 30
+	// maxKDFParallelism bounds the scrypt parallelism or Argon2id lanes of
+	// an untrusted envelope. Scrypt parallelism scales both the time it
+	// takes to open and the PBKDF2 output scrypt allocates.
+	maxKDFParallelism = 64
+	// maxKDFPasses bounds the Argon2id passes of an untrusted envelope.
+	maxKDFPasses = 64
+)
+
+// ErrWrongPIN is returned by UnprotectShare when the PIN is wrong or the
+// protected share has been modified.
+var ErrWrongPIN = errors.New("wrong PIN or corrupted protected share")
+
+// KDF identifies the function that derives the key protecting a share
+// from its PIN.
+type KDF uint8
+
+const (
+	// KDFScrypt is scrypt (RFC 7914), tuned by LogN, R and P. It is the
+	// zero value so that parameters written before Argon2id was supported
+	// keep their meaning.
+	KDFScrypt KDF = iota
+	// KDFArgon2id is Argon2id (RFC 9106), tuned by Time, Memory and
+	// Threads.
+	KDFArgon2id
+)
+
+// KDFParams are the cost parameters used to derive the key that protects a
+// share from its PIN. Only the fields of the selected KDF are used.
+//
+// For scrypt, memory use is 128 * R * (2^LogN + P) bytes and time grows
+// linearly with 2^LogN * R * P. P is at most 64.
+//
+// For Argon2id, memory use is Memory KiB and time grows linearly with
+// Memory * Time. Time and Threads are at most 64, and Memory is at least
+// 8 KiB per thread.
+type KDFParams struct {
+	KDF KDF
+
+	LogN uint8
+	R    uint32
+	P    uint32
+
+	Time    uint32
+	Memory  uint32
+	Threads uint8
+}
+
+// DefaultKDFParams are the parameters recommended for interactive use: the
+// second recommended Argon2id option of RFC 9106, with 3 passes over
+// 64 MiB in 4 lanes.
+var DefaultKDFParams = KDFParams{KDF: KDFArgon2id, Time: 3, Memory: 64 << 10, Threads: 4}
+
+func (p KDFParams) validate() error {
+	switch p.KDF {
+	case KDFScrypt:
+		if p.LogN < 1 || p.LogN > 30 || p.R < 1 || p.P < 1 || p.P > maxKDFParallelism {
+			return fmt.Errorf("invalid KDF parameters %+v", p)
+		}
+		if 128*uint64(p.R)*(1<<p.LogN+uint64(p.P)) > maxKDFMemory {
+			return fmt.Errorf("KDF parameters %+v need more than %d bytes of memory", p, maxKDFMemory)
+		}
+	case KDFArgon2id:
+		if p.Time < 1 || p.Time > maxKDFPasses || p.Threads < 1 || p.Threads > maxKDFParallelism || p.Memory < 8*uint32(p.Threads) {
+			return fmt.Errorf("invalid KDF parameters %+v", p)
+		}
+		if 1024*uint64(p.Memory) > maxKDFMemory {
+			return fmt.Errorf("KDF parameters %+v need more than %d bytes of memory", p, maxKDFMemory)
+		}
+	default:
+		return fmt.Errorf("unsupported KDF %d", p.KDF)
+	}
+	return nil
+}
+
+// deriveKey derives a 32-byte key from pin and salt.
+func (p KDFParams) deriveKey(pin, salt []byte) ([]byte, error) {
+	if p.KDF == KDFArgon2id {
+		return argon2idKey(pin, salt, nil, nil, p.Time, p.Memory, p.Threads, 32)
+	}
+	return scryptKey(pin, salt, int(p.LogN), int(p.R), int(p.P), 32)
+}
+
+// ProtectShare encrypts a share under a custodian-chosen PIN or passphrase,
+// so that physical theft of the medium holding it does not immediately
+// yield the share. The key is derived with Argon2id using DefaultKDFParams
+// (or the KDF set by WithKDFParams) and a random salt, and the share is sealed
+// with AES-256-GCM. The KDF parameters are stored in the result, so they can
+// be raised over time without breaking older protected shares.
+//
+// A short PIN only slows down an attacker who holds the protected share;
+// it cannot stop an exhaustive search.
+func ProtectShare(s Share, pin []byte, opts ...Option) ([]byte, error) {
+	if s.Index == 0 {
+		return nil, errors.New("share index must be non-zero")
+	}
+	if len(pin) == 0 {
+		return nil, errors.New("PIN cannot be empty")
+	}
+	cfg := NewConfig(opts...)
+	params := cfg.KDFParams
+	if params == (KDFParams{}) {
+		params = DefaultKDFParams
+	}
+	if err := params.validate(); err != nil {
+		return nil, err
+	}
+
+	out := make([]byte, pinHeaderSize, pinHeaderSize+2+len(s.Value)+16)
+	out[0] = pinVersion
+	if params.KDF == KDFArgon2id {
+		out[1], out[2] = pinKDFArgon2id, params.Threads
+		binary.BigEndian.PutUint32(out[3:], params.Time)
+		binary.BigEndian.PutUint32(out[7:], params.Memory)
+	} else {
+		out[1], out[2] = pinKDFScrypt, params.LogN
+		binary.BigEndian.PutUint32(out[3:], params.R)
+		binary.BigEndian.PutUint32(out[7:], params.P)
+	}
+	if _, err := io.ReadFull(cfg.Rand, out[11:pinHeaderSize]); err != nil {
+		return nil, fmt.Errorf("salt generation failed: %w", err)
+	}
+
+	aead, err := pinAEAD(pin, out[:pinHeaderSize])
+	if err != nil {
+		return nil, err
+	}
+	plaintext := make([]byte, 0, 2+len(s.Value))
+	plaintext = append(plaintext, byte(schemeOf(s)), s.Index)
+	plaintext = append(plaintext, s.Value...)
+	defer clear(plaintext)
+
+	nonce := out[pinHeaderSize-pinNonceSize : pinHeaderSize]
+	return aead.Seal(out, nonce, plaintext, out[:pinHeaderSize]), nil
+}
+
+// WithKDFParams sets the KDF and parameters used by ProtectShare. Use
+// CalibrateKDF to pick parameters for a target unlock time.
+func WithKDFParams(p KDFParams) Option {
+	return func(c *Config) {
+		c.KDFParams = p
+	}
+}
+
+// ProtectedShareParams returns the KDF parameters stored in a protected
+// share, without needing the PIN.
+func ProtectedShareParams(data []byte) (KDFParams, error) {
+	if len(data) < pinHeaderSize || data[0] != pinVersion || (data[1] != pinKDFScrypt && data[1] != pinKDFArgon2id) {
+		return KDFParams{}, errors.New("not a protected share")
+	}
+	return kdfParamsFromHeader(data), nil
+}
+
+// UnprotectShare decrypts a share protected by ProtectShare.
+func UnprotectShare(data, pin []byte) (_ Share, err error) {
+	defer recoverInternal(&err)
+
+	if len(data) < pinHeaderSize+2+16 {
+		return Share{}, errors.New("protected share too short")
+	}
+	if data[0] != pinVersion {
+		return Share{}, fmt.Errorf("unsupported protected share version %d", data[0])
+	}
+	if data[1] != pinKDFScrypt && data[1] != pinKDFArgon2id {
+		return Share{}, fmt.Errorf("unsupported KDF %d", data[1])
+	}
+
+	aead, err := pinAEAD(pin, data[:pinHeaderSize])
+	if err != nil {
+		return Share{}, err
+	}
+	nonce := data[pinHeaderSize-pinNonceSize : pinHeaderSize]
+	plaintext, err := aead.Open(nil, nonce, data[pinHeaderSize:], data[:pinHeaderSize])
+	if err != nil {
+		return Share{}, ErrWrongPIN
+	}
+	return Share{
+		Index:  plaintext[1],
+		Value:  plaintext[2:],
+		Scheme: Scheme(plaintext[0]),
+	}, nil
+}
+
+// pinAEAD derives the AES-256-GCM cipher for a protected share from the PIN
+// and the parameters and salt in header.
+func pinAEAD(pin, header []byte) (cipher.AEAD, error) {
+	params := kdfParamsFromHeader(header)
+	if err := params.validate(); err != nil {
+		return nil, err
+	}
+	salt := header[11 : 11+pinSaltSize]
+	key, err := params.deriveKey(pin, salt)
+	if err != nil {
+		return nil, err
+	}
+	defer clear(key)
+
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+func kdfParamsFromHeader(header []byte) KDFParams {
+	if header[1] == pinKDFArgon2id {
+		return KDFParams{
+			KDF:     KDFArgon2id,
+			Threads: header[2],
+			Time:    binary.BigEndian.Uint32(header[3:]),
+			Memory:  binary.BigEndian.Uint32(header[7:]),
+		}
+	}
+	return KDFParams{
+		LogN: header[2],
+		R:    binary.BigEndian.Uint32(header[3:]),
+		P:    binary.BigEndian.Uint32(header[7:]),
+	}
+}
@@ -0,0 +1,173 @@
+package goshamir
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"testing"
+	"time"
+)
+
+// --- ProtectShare Tests ---
+
+func TestProtectShare_RoundTrip(t *testing.T) {
+	splitter, _ := NewSplitter(3, 2, WithScheme(SchemeV2GF256))
+	shares, _ := splitter.Split([]byte("secret"))
+
+	protected, err := ProtectShare(shares[1], []byte("1234"))
+	if err != nil {
+		t.Fatalf("ProtectShare failed: %v", err)
+	}
+	if bytes.Contains(protected, shares[1].Value) {
+		t.Error("Protected share contains the plaintext value")
+	}
+
+	share, err := UnprotectShare(protected, []byte("1234"))
+	if err != nil {
+		t.Fatalf("UnprotectShare failed: %v", err)
+	}
+	if share.Index != 2 || share.Scheme != SchemeV2GF256 || !bytes.Equal(share.Value, shares[1].Value) {
+		t.Errorf("Unexpected share %+v", share)
+	}
+
+	again, _ := ProtectShare(shares[1], []byte("1234"))
+	if bytes.Equal(protected, again) {
+		t.Error("Expected a fresh salt and nonce per protection")
+	}
+}
+
+func TestUnprotectShare_Errors(t *testing.T) {
+	shares, _ := Split([]byte("secret"), 2, 2)
+	protected, _ := ProtectShare(shares[0], []byte("1234"))
+
+	if _, err := UnprotectShare(protected, []byte("4321")); !errors.Is(err, ErrWrongPIN) {
+		t.Errorf("Expected ErrWrongPIN, got %v", err)
+	}
+
+	tampered := bytes.Clone(protected)
+	tampered[len(tampered)-1] ^= 1
+	if _, err := UnprotectShare(tampered, []byte("1234")); !errors.Is(err, ErrWrongPIN) {
+		t.Errorf("Expected ErrWrongPIN for tampered data, got %v", err)
+	}
+
+	// Raising the cost parameters beyond their bounds is rejected before
+	// any work is done.
+	for _, field := range []struct {
+		offset int
+		value  uint32
+	}{{3, maxKDFPasses + 1}, {7, maxKDFMemory/1024 + 1}} {
+		tampered = bytes.Clone(protected)
+		binary.BigEndian.PutUint32(tampered[field.offset:], field.value)
+		if _, err := UnprotectShare(tampered, []byte("1234")); err == nil || errors.Is(err, ErrWrongPIN) {
+			t.Errorf("Expected parameter error, got %v", err)
+		}
+	}
+	tampered = bytes.Clone(protected)
+	tampered[1], tampered[2] = pinKDFScrypt, 30
+	if _, err := UnprotectShare(tampered, []byte("1234")); err == nil || errors.Is(err, ErrWrongPIN) {
+		t.Errorf("Expected parameter error, got %v", err)
+	}
+
+	// So is a scrypt parallelism that would make scrypt allocate and hash
+	// gigabytes despite a tiny N.
+	tampered = bytes.Clone(protected)
+	tampered[1], tampered[2] = pinKDFScrypt, 1
+	binary.BigEndian.PutUint32(tampered[3:], 1)
+	binary.BigEndian.PutUint32(tampered[7:], 1<<24)
+	start := time.Now()
+	if _, err := UnprotectShare(tampered, []byte("1234")); err == nil || errors.Is(err, ErrWrongPIN) {
+		t.Errorf("Expected parameter error for a huge P, got %v", err)
+	}
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Errorf("Rejecting a huge P took %v", elapsed)
+	}
+
+	if _, err := UnprotectShare(protected[:20], []byte("1234")); err == nil {
+		t.Error("Expected error for truncated data")
+	}
+	if _, err := ProtectShare(shares[0], nil); err == nil {
+		t.Error("Expected error for empty PIN")
+	}
+	if _, err := ProtectShare(shares[0], []byte("1"), WithRandom(failingReader{})); err == nil {
+		t.Error("Expected error for failing random source")
+	}
+}
+
+func TestProtectShare_KDFParams(t *testing.T) {
+	shares, _ := Split([]byte("secret"), 2, 2)
+	for _, params := range []KDFParams{
+		{LogN: 12, R: 4, P: 2},
+		{KDF: KDFArgon2id, Time: 2, Memory: 1 << 10, Threads: 2},
+	} {
+		protected, err := ProtectShare(shares[0], []byte("pin"), WithKDFParams(params))
+		if err != nil {
+			t.Fatalf("ProtectShare failed: %v", err)
+		}
+		stored, err := ProtectedShareParams(protected)
+		if err != nil {
+			t.Fatalf("ProtectedShareParams failed: %v", err)
+		}
+		if stored != params {
+			t.Errorf("Expected stored parameters %+v, got %+v", params, stored)
+		}
+		share, err := UnprotectShare(protected, []byte("pin"))
+		if err != nil {
+			t.Fatalf("UnprotectShare failed: %v", err)
+		}
+		if !bytes.Equal(share.Value, shares[0].Value) {
+			t.Errorf("Unexpected share %+v", share)
+		}
+		if _, err := UnprotectShare(protected, []byte("nip")); !errors.Is(err, ErrWrongPIN) {
+			t.Errorf("Expected ErrWrongPIN, got %v", err)
+		}
+	}
+
+	stored, _ := ProtectedShareParams(mustProtect(t, shares[0]))
+	if stored != DefaultKDFParams {
+		t.Errorf("Expected the default Argon2id parameters, got %+v", stored)
+	}
+	for _, params := range []KDFParams{
+		{KDF: KDFArgon2id, Time: 0, Memory: 1 << 10, Threads: 1},
+		{KDF: KDFArgon2id, Time: 1, Memory: 1 << 21, Threads: 1},
+		{KDF: KDFArgon2id, Time: 1, Memory: 16, Threads: 4},
+		{KDF: 7, LogN: 4, R: 1, P: 1},
+	} {
+		if _, err := ProtectShare(shares[0], []byte("pin"), WithKDFParams(params)); err == nil {
+			t.Errorf("Expected error for parameters %+v", params)
+		}
+	}
+
+	if _, err := ProtectShare(shares[0], []byte("pin"), WithKDFParams(KDFParams{LogN: 24, R: 8, P: 1})); err == nil {
+		t.Error("Expected error for parameters above the memory bound")
+	}
+	if _, err := ProtectShare(shares[0], []byte("pin"), WithKDFParams(KDFParams{LogN: 4, R: 1, P: maxKDFParallelism + 1})); err == nil {
+		t.Error("Expected error for parallelism above the bound")
+	}
+	if _, err := ProtectedShareParams([]byte("short")); err == nil {
+		t.Error("Expected error for invalid data")
+	}
+}
+
+func mustProtect(t *testing.T, s Share) []byte {
+	t.Helper()
+	protected, err := ProtectShare(s, []byte("pin"))
+	if err != nil {
+		t.Fatalf("ProtectShare failed: %v", err)
+	}
+	return protected
+}
+
+// --- CalibrateKDF Tests ---
+
+func TestCalibrateKDF(t *testing.T) {
+	params, err := CalibrateKDF(time.Millisecond)
+	if err != nil {
+		t.Fatalf("CalibrateKDF failed: %v", err)
+	}
+	if params != DefaultKDFParams {
+		t.Errorf("Expected a tiny target to yield the defaults, got %+v", params)
+	}
+	if _, err := CalibrateKDF(0); err == nil {
+		t.Error("Expected error for zero target")
+	}
+}
@@ -0,0 +1,170 @@
+package goshamir
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"math/big"
+	"strings"
+)
+
+// Plate payload layout: a 0x01 sentinel, scheme, index, value and the
+// CRC-32C of the scheme, index and value, read as one big-endian number and
+// written in the alphabet's base. The sentinel keeps leading zero bytes.
+// The digits are grouped plateGroupSize at a time, each group followed by a
+// check character.
+const (
+	plateSentinel  = 0x01
+	plateGroupSize = 4
+)
+
+// ErrPlateChecksum is returned by DecodeSharePlate when the text fails a
+// check character or the overall checksum, usually because of a typo.
+var ErrPlateChecksum = errors.New("share plate checksum mismatch")
+
+// PlateAlphabet is the character set of EncodeSharePlate.
+type PlateAlphabet int
+
+const (
+	// AlphabetDigits uses 0–9, for entry on numeric keypads.
+	AlphabetDigits PlateAlphabet = iota + 1
+	// AlphabetLetters uses A–Z, for stamping or embossing on metal plates
+	// with letter punches.
+	AlphabetLetters
+)
+
+// String returns the alphabet's name, such as "digits".
+func (a PlateAlphabet) String() string {
+	switch a {
+	case AlphabetDigits:
+		return "digits"
+	case AlphabetLetters:
+		return "letters"
+	}
+	return fmt.Sprintf("PlateAlphabet(%d)", int(a))
+}
+
+// chars returns the alphabet's characters and the weights of the group
+// check character. The weights are coprime with the base, so a check
+// character catches any single substitution in its group, and all swaps of
+// adjacent characters except those of characters half the base apart.
+func (a PlateAlphabet) chars() (string, [plateGroupSize]int, error) {
+	switch a {
+	case AlphabetDigits:
+		return "0123456789", [plateGroupSize]int{1, 3, 7, 9}, nil
+	case AlphabetLetters:
+		return "ABCDEFGHIJKLMNOPQRSTUVWXYZ", [plateGroupSize]int{1, 3, 5, 7}, nil
+	}
+	return "", [plateGroupSize]int{}, fmt.Errorf("unknown plate alphabet %v", a)
+}
+
+// EncodeSharePlate encodes a share with only the characters of alphabet,
+// for shares that must be typed on a numeric keypad or punched into metal.
+// The text is split into groups of five characters separated by dashes:
+// four data characters and a check character that pinpoints a mistyped
+// group. A CRC-32C over the whole share catches anything the check
+// characters miss. Watermarks and sub-share parents are not supported.
+func EncodeSharePlate(s Share, alphabet PlateAlphabet) (string, error) {
+	chars, weights, err := alphabet.chars()
+	if err != nil {
+		return "", err
+	}
+	if s.Index == 0 || len(s.Value) == 0 {
+		return "", ErrInvalidEncodedShare
+	}
+	if len(s.Watermark) > 0 || len(s.Parents) > 0 {
+		return "", errors.New("plate encoding does not support watermarks or sub-shares")
+	}
+
+	payload := append([]byte{byte(schemeOf(s)), s.Index}, s.Value...)
+	payload = binary.BigEndian.AppendUint32(payload, crc32.Checksum(payload, crc32c))
+	n := new(big.Int).SetBytes(append([]byte{plateSentinel}, payload...))
+
+	base := big.NewInt(int64(len(chars)))
+	var digits []int
+	mod := new(big.Int)
+	for n.Sign() > 0 {
+		n.DivMod(n, base, mod)
+		digits = append(digits, int(mod.Int64()))
+	}
+	for len(digits)%plateGroupSize != 0 {
+		digits = append(digits, 0)
+	}
+
+	var b strings.Builder
+	for g := len(digits) - 1; g >= 0; g -= plateGroupSize {
+		if b.Len() > 0 {
+			b.WriteByte('-')
+		}
+		group := make([]int, plateGroupSize)
+		for i := range group {
+			group[i] = digits[g-i]
+			b.WriteByte(chars[group[i]])
+		}
+		b.WriteByte(chars[plateCheck(group, weights, len(chars))])
+	}
+	return b.String(), nil
+}
+
+// DecodeSharePlate decodes a share encoded by EncodeSharePlate. Dashes and
+// whitespace are ignored, and letters may be in either case. A typo yields
+// an error wrapping ErrPlateChecksum naming the group it is in.
+func DecodeSharePlate(text string, alphabet PlateAlphabet) (Share, error) {
+	chars, weights, err := alphabet.chars()
+	if err != nil {
+		return Share{}, err
+	}
+	clean := strings.Map(func(r rune) rune {
+		if r == '-' || r == ' ' || r == '\t' || r == '\n' || r == '\r' {
+			return -1
+		}
+		return r
+	}, strings.ToUpper(text))
+	if len(clean) == 0 || len(clean)%(plateGroupSize+1) != 0 {
+		return Share{}, fmt.Errorf("%w: plate must be whole groups of %d characters", ErrInvalidEncodedShare, plateGroupSize+1)
+	}
+
+	base := big.NewInt(int64(len(chars)))
+	n := new(big.Int)
+	for g := 0; g < len(clean); g += plateGroupSize + 1 {
+		group := make([]int, plateGroupSize+1)
+		for i := range group {
+			v := strings.IndexByte(chars, clean[g+i])
+			if v < 0 {
+				return Share{}, fmt.Errorf("%w: character %q is not in the %v alphabet", ErrInvalidEncodedShare, clean[g+i], alphabet)
+			}
+			group[i] = v
+		}
+		if plateCheck(group[:plateGroupSize], weights, len(chars)) != group[plateGroupSize] {
+			return Share{}, fmt.Errorf("%w: group %d", ErrPlateChecksum, g/(plateGroupSize+1)+1)
+		}
+		for _, v := range group[:plateGroupSize] {
+			n.Mul(n, base)
+			n.Add(n, big.NewInt(int64(v)))
+		}
+	}
+
+	raw := n.Bytes()
+	if len(raw) < 1+2+1+4 || raw[0] != plateSentinel {
+		return Share{}, ErrPlateChecksum
+	}
+	payload, sum := raw[1:len(raw)-4], raw[len(raw)-4:]
+	if crc32.Checksum(payload, crc32c) != binary.BigEndian.Uint32(sum) {
+		return Share{}, ErrPlateChecksum
+	}
+	if payload[0] == 0 || payload[1] == 0 {
+		return Share{}, ErrInvalidEncodedShare
+	}
+	return Share{Index: payload[1], Value: payload[2:], Scheme: Scheme(payload[0])}, nil
+}
+
+// plateCheck returns the check character of a group: the weighted sum of
+// its characters modulo the base, negated.
+func plateCheck(group []int, weights [plateGroupSize]int, base int) int {
+	sum := 0
+	for i, v := range group {
+		sum += v * weights[i]
+	}
+	return (base - sum%base) % base
+}
@@ -0,0 +1,99 @@
+package goshamir
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// PlateLayout describes the grid of a steel backup plate: how many rows of
+// how many character slots one plate holds, and which character set its
+// tiles or punches provide.
+type PlateLayout struct {
+	Rows     int
+	Columns  int
+	Alphabet PlateAlphabet
+}
+
+// DefaultPlateLayout matches the common steel plates of 24 rows of letter
+// tiles, using five columns per row: four data characters and a check
+// character.
+var DefaultPlateLayout = PlateLayout{Rows: 24, Columns: 5, Alphabet: AlphabetLetters}
+
+// PlateCell is one character slot of a plate. Plates, rows and columns are
+// numbered from 1, as they are stamped on the plates.
+type PlateCell struct {
+	Plate  int
+	Row    int
+	Column int
+	Char   byte
+}
+
+// String returns the cell as "plate/row/column:char", such as "1/3/5:Q".
+func (c PlateCell) String() string {
+	return fmt.Sprintf("%d/%d/%d:%c", c.Plate, c.Row, c.Column, c.Char)
+}
+
+func (l PlateLayout) validate() error {
+	if _, _, err := l.Alphabet.chars(); err != nil {
+		return err
+	}
+	if l.Rows < 1 || l.Columns < plateGroupSize+1 || l.Columns%(plateGroupSize+1) != 0 {
+		return fmt.Errorf("plate layout needs at least one row and a multiple of %d columns, got %dx%d", plateGroupSize+1, l.Rows, l.Columns)
+	}
+	return nil
+}
+
+// LayoutSharePlate lays a share out on steel backup plates: it encodes the
+// share with EncodeSharePlate and places the characters row by row, so each
+// group of five, with its check character, stays on one row. The cells are
+// returned in stamping order, continuing on further plates when one is
+// full. Slots after the last cell stay blank.
+func LayoutSharePlate(s Share, layout PlateLayout) ([]PlateCell, error) {
+	if err := layout.validate(); err != nil {
+		return nil, err
+	}
+	text, err := EncodeSharePlate(s, layout.Alphabet)
+	if err != nil {
+		return nil, err
+	}
+	text = strings.ReplaceAll(text, "-", "")
+	perPlate := layout.Rows * layout.Columns
+	cells := make([]PlateCell, len(text))
+	for i := range cells {
+		slot := i % perPlate
+		cells[i] = PlateCell{
+			Plate:  i/perPlate + 1,
+			Row:    slot/layout.Columns + 1,
+			Column: slot%layout.Columns + 1,
+			Char:   text[i],
+		}
+	}
+	return cells, nil
+}
+
+// ReadSharePlate decodes a share from the cells of LayoutSharePlate, as
+// read back from the plates, in any order. Every cell up to the last one
+// must be present exactly once; a misread character yields an error
+// wrapping ErrPlateChecksum.
+func ReadSharePlate(cells []PlateCell, layout PlateLayout) (Share, error) {
+	if err := layout.validate(); err != nil {
+		return Share{}, err
+	}
+	sorted := slices.Clone(cells)
+	slices.SortFunc(sorted, func(a, b PlateCell) int {
+		return cmp.Or(cmp.Compare(a.Plate, b.Plate), cmp.Compare(a.Row, b.Row), cmp.Compare(a.Column, b.Column))
+	})
+	perPlate := layout.Rows * layout.Columns
+	text := make([]byte, len(sorted))
+	for i, c := range sorted {
+		slot := i % perPlate
+		want := PlateCell{Plate: i/perPlate + 1, Row: slot/layout.Columns + 1, Column: slot%layout.Columns + 1, Char: c.Char}
+		if c != want {
+			return Share{}, fmt.Errorf("%w: expected plate %d row %d column %d, got %v", ErrInvalidEncodedShare, want.Plate, want.Row, want.Column, c)
+		}
+		text[i] = c.Char
+	}
+	return DecodeSharePlate(string(text), layout.Alphabet)
+}
@@ -0,0 +1,57 @@
+package goshamir
+
+import (
+	"bytes"
+	"errors"
+	"math/rand/v2"
+	"testing"
+)
+
+// --- Plate Layout Tests ---
+
+func TestLayoutSharePlate(t *testing.T) {
+	s, _ := NewSplitter(3, 2, WithScheme(SchemeV2GF256))
+	shares, _ := s.Split(bytes.Repeat([]byte("seed"), 8))
+	layout := PlateLayout{Rows: 4, Columns: 10, Alphabet: AlphabetLetters}
+
+	cells, err := LayoutSharePlate(shares[1], layout)
+	if err != nil {
+		t.Fatalf("LayoutSharePlate failed: %v", err)
+	}
+	if last := cells[len(cells)-1]; last.Plate < 2 {
+		t.Fatalf("expected the share to span several plates, last cell %v", last)
+	}
+	for _, c := range cells {
+		if c.Row < 1 || c.Row > layout.Rows || c.Column < 1 || c.Column > layout.Columns || c.Char < 'A' || c.Char > 'Z' {
+			t.Fatalf("cell out of layout: %v", c)
+		}
+	}
+
+	shuffled := append([]PlateCell(nil), cells...)
+	rand.Shuffle(len(shuffled), func(i, j int) { shuffled[i], shuffled[j] = shuffled[j], shuffled[i] })
+	got, err := ReadSharePlate(shuffled, layout)
+	if err != nil {
+		t.Fatalf("ReadSharePlate failed: %v", err)
+	}
+	if got.Index != shares[1].Index || !bytes.Equal(got.Value, shares[1].Value) {
+		t.Fatalf("round trip mismatch: got share %d %x", got.Index, got.Value)
+	}
+}
+
+func TestReadSharePlate_Errors(t *testing.T) {
+	shares, _ := Split([]byte("steel"), 3, 2)
+	cells, _ := LayoutSharePlate(shares[0], DefaultPlateLayout)
+
+	misread := append([]PlateCell(nil), cells...)
+	misread[6].Char = 'A' + (misread[6].Char-'A'+1)%26
+	if _, err := ReadSharePlate(misread, DefaultPlateLayout); !errors.Is(err, ErrPlateChecksum) {
+		t.Errorf("expected ErrPlateChecksum for a misread cell, got %v", err)
+	}
+	missing := append(append([]PlateCell(nil), cells[:3]...), cells[4:]...)
+	if _, err := ReadSharePlate(missing, DefaultPlateLayout); !errors.Is(err, ErrInvalidEncodedShare) {
+		t.Errorf("expected ErrInvalidEncodedShare for a missing cell, got %v", err)
+	}
+	if _, err := LayoutSharePlate(shares[0], PlateLayout{Rows: 24, Columns: 4, Alphabet: AlphabetLetters}); err == nil {
+		t.Error("expected error for a layout splitting check groups")
+	}
+}
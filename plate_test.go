@@ -0,0 +1,89 @@
+package goshamir
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+)
+
+// --- Plate Encoding Tests ---
+
+func TestEncodeSharePlate(t *testing.T) {
+	s, _ := NewSplitter(3, 2, WithScheme(SchemeV2GF256))
+	v2, _ := s.Split([]byte{0x00, 0x00, 0xff, 0x10})
+	v1, _ := Split([]byte("seed"), 3, 2)
+
+	for _, alphabet := range []PlateAlphabet{AlphabetDigits, AlphabetLetters} {
+		for _, share := range []Share{v2[0], v1[2]} {
+			text, err := EncodeSharePlate(share, alphabet)
+			if err != nil {
+				t.Fatalf("EncodeSharePlate failed: %v", err)
+			}
+			for _, group := range strings.Split(text, "-") {
+				if len(group) != 5 {
+					t.Fatalf("bad group %q in %q", group, text)
+				}
+			}
+			if alphabet == AlphabetDigits && strings.Trim(text, "0123456789-") != "" {
+				t.Fatalf("non-digit in %q", text)
+			}
+			if alphabet == AlphabetLetters && strings.Trim(text, "ABCDEFGHIJKLMNOPQRSTUVWXYZ-") != "" {
+				t.Fatalf("non-letter in %q", text)
+			}
+
+			entered := strings.ReplaceAll(strings.ToLower(text), "-", " ")
+			got, err := DecodeSharePlate(entered, alphabet)
+			if err != nil {
+				t.Fatalf("DecodeSharePlate failed: %v", err)
+			}
+			if got.Index != share.Index || schemeOf(got) != schemeOf(share) || !bytes.Equal(got.Value, share.Value) {
+				t.Fatalf("round trip mismatch: %+v vs %+v", got, share)
+			}
+		}
+	}
+}
+
+func TestDecodeSharePlate_Typos(t *testing.T) {
+	shares, _ := Split([]byte("keypad"), 3, 2)
+	text, _ := EncodeSharePlate(shares[0], AlphabetDigits)
+
+	// Change one digit of the second group.
+	b := []byte(text)
+	b[7] = '0' + (b[7]-'0'+1)%10
+	_, err := DecodeSharePlate(string(b), AlphabetDigits)
+	if !errors.Is(err, ErrPlateChecksum) || !strings.Contains(err.Error(), "group 2") {
+		t.Fatalf("expected checksum error in group 2, got %v", err)
+	}
+
+	// Swap two adjacent digits of the first group, when they differ.
+	b = []byte(text)
+	for i := 0; i < 3; i++ {
+		if b[i] != b[i+1] && b[i]-b[i+1] != 5 && b[i+1]-b[i] != 5 {
+			b[i], b[i+1] = b[i+1], b[i]
+			if _, err := DecodeSharePlate(string(b), AlphabetDigits); !errors.Is(err, ErrPlateChecksum) {
+				t.Fatalf("expected checksum error for transposition, got %v", err)
+			}
+			break
+		}
+	}
+
+	if _, err := DecodeSharePlate(text[:len(text)-6], AlphabetDigits); err == nil {
+		t.Fatal("expected error for a missing group")
+	}
+	if _, err := DecodeSharePlate(text, AlphabetLetters); err == nil {
+		t.Fatal("expected error for the wrong alphabet")
+	}
+}
+
+func TestEncodeSharePlate_Unsupported(t *testing.T) {
+	shares, _ := Split([]byte("secret"), 3, 2)
+	marked := shares[0]
+	marked.Watermark = []byte{1}
+	if _, err := EncodeSharePlate(marked, AlphabetDigits); err == nil {
+		t.Error("expected error for watermarked share")
+	}
+	if _, err := EncodeSharePlate(shares[0], PlateAlphabet(9)); err == nil {
+		t.Error("expected error for unknown alphabet")
+	}
+}
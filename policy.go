@@ -0,0 +1,140 @@
+package goshamir
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// ErrPolicyExpired is returned by Policy.Validate for a policy whose Expiry
+// has passed.
+var ErrPolicyExpired = errors.New("policy has expired")
+
+// PolicyGroup is a set of custodians that share a failure domain, such as a
+// team, an office or a cloud account. Shares held by one group can be lost
+// or compromised together.
+type PolicyGroup struct {
+	Name   string
+	Shares int
+}
+
+// Policy describes a custody scheme: how many shares are created, how many
+// are needed to recover the secret and how the shares are spread across
+// groups. A Policy can be validated and planned before any secret exists.
+type Policy struct {
+	TotalShares int
+	Threshold   int
+	// Scheme is the share scheme. The zero value means SchemeV1GF257.
+	Scheme Scheme
+	// Groups optionally partitions the shares. If set, the group share
+	// counts must add up to TotalShares.
+	Groups []PolicyGroup
+	// Expiry is the time after which the policy should no longer be used
+	// for new splits. The zero value means no expiry.
+	Expiry time.Time
+	Labels map[string]string
+}
+
+// PolicyPlan is the dry-run report produced by Policy.Plan.
+type PolicyPlan struct {
+	// ShareSize is the size in bytes of each share value.
+	ShareSize int
+	// EncodedSize is the length of each share as encoded by
+	// EncodeSharesToHex, assuming the longest index.
+	EncodedSize int
+	// Custodians is the number of shares per group, or a single entry
+	// named "" holding all shares if the policy has no groups.
+	Custodians map[string]int
+	// Warnings lists weaknesses of the policy, such as single points of
+	// failure. An empty list does not imply the policy is sound.
+	Warnings []string
+}
+
+// Validate checks that the policy describes a split this library can
+// perform.
+func (p *Policy) Validate() error {
+	if err := validateShareCounts(p.TotalShares, p.Threshold); err != nil {
+		return err
+	}
+	if scheme := p.scheme(); !scheme.Supported() {
+		return unsupportedScheme(scheme)
+	}
+	if !p.Expiry.IsZero() && time.Now().After(p.Expiry) {
+		return ErrPolicyExpired
+	}
+
+	if len(p.Groups) > 0 {
+		seen := make(map[string]bool, len(p.Groups))
+		sum := 0
+		for i, g := range p.Groups {
+			if g.Name == "" {
+				return fmt.Errorf("group %d has no name", i)
+			}
+			if seen[g.Name] {
+				return fmt.Errorf("duplicate group name %q", g.Name)
+			}
+			seen[g.Name] = true
+			if g.Shares < 1 {
+				return fmt.Errorf("group %q must hold at least one share", g.Name)
+			}
+			sum += g.Shares
+		}
+		if sum != p.TotalShares {
+			return fmt.Errorf("groups hold %d shares, expected %d", sum, p.TotalShares)
+		}
+	}
+
+	for k := range p.Labels {
+		if k == "" {
+			return errors.New("label key cannot be empty")
+		}
+	}
+	return nil
+}
+
+// Plan validates the policy and reports what splitting a secret of
+// secretSize bytes under it would produce.
+func (p *Policy) Plan(secretSize int) (*PolicyPlan, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+	if secretSize < 1 {
+		return nil, errors.New("secret size must be positive")
+	}
+
+	scheme := p.scheme()
+	plan := &PolicyPlan{
+		ShareSize:  secretSize * scheme.bytesPerElement(),
+		Custodians: make(map[string]int),
+	}
+	plan.EncodedSize = len(fmt.Sprint(p.TotalShares)) + 1 + hex.EncodedLen(plan.ShareSize)
+	if scheme != SchemeV1GF257 {
+		plan.EncodedSize += len(scheme.String()) + 1
+	}
+
+	if p.Threshold == p.TotalShares {
+		plan.Warnings = append(plan.Warnings, "threshold equals total shares: losing any share makes the secret unrecoverable")
+	}
+	if len(p.Groups) == 0 {
+		plan.Custodians[""] = p.TotalShares
+		return plan, nil
+	}
+	for _, g := range p.Groups {
+		plan.Custodians[g.Name] = g.Shares
+		if g.Shares >= p.Threshold {
+			plan.Warnings = append(plan.Warnings, fmt.Sprintf("group %q holds %d shares and can recover the secret alone", g.Name, g.Shares))
+		}
+		if p.TotalShares-g.Shares < p.Threshold {
+			plan.Warnings = append(plan.Warnings, fmt.Sprintf("losing group %q leaves %d shares, fewer than the threshold", g.Name, p.TotalShares-g.Shares))
+		}
+	}
+	return plan, nil
+}
+
+func (p *Policy) scheme() Scheme {
+	if p.Scheme == 0 {
+		return SchemeV1GF257
+	}
+	return p.Scheme
+}
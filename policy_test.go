@@ -0,0 +1,94 @@
+package goshamir
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+// --- Policy Tests ---
+
+func TestPolicy_Validate(t *testing.T) {
+	valid := Policy{
+		TotalShares: 5,
+		Threshold:   3,
+		Groups:      []PolicyGroup{{Name: "ops", Shares: 2}, {Name: "legal", Shares: 2}, {Name: "ceo", Shares: 1}},
+		Expiry:      time.Now().Add(time.Hour),
+		Labels:      map[string]string{"env": "prod"},
+	}
+	if err := valid.Validate(); err != nil {
+		t.Fatalf("Validate failed: %v", err)
+	}
+
+	tests := []struct {
+		name   string
+		mutate func(p *Policy)
+	}{
+		{"threshold too low", func(p *Policy) { p.Threshold = 1 }},
+		{"threshold above total", func(p *Policy) { p.Threshold = 6 }},
+		{"unsupported scheme", func(p *Policy) { p.Scheme = 9 }},
+		{"expired", func(p *Policy) { p.Expiry = time.Now().Add(-time.Hour) }},
+		{"unnamed group", func(p *Policy) { p.Groups = []PolicyGroup{{Shares: 5}} }},
+		{"duplicate group", func(p *Policy) { p.Groups = []PolicyGroup{{"a", 3}, {"a", 2}} }},
+		{"empty group", func(p *Policy) { p.Groups = []PolicyGroup{{"a", 5}, {"b", 0}} }},
+		{"group sum mismatch", func(p *Policy) { p.Groups = []PolicyGroup{{"a", 4}} }},
+		{"empty label key", func(p *Policy) { p.Labels = map[string]string{"": "x"} }},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := valid
+			tt.mutate(&p)
+			if err := p.Validate(); err == nil {
+				t.Error("Expected validation error")
+			}
+		})
+	}
+
+	expired := valid
+	expired.Expiry = time.Now().Add(-time.Minute)
+	if err := expired.Validate(); !errors.Is(err, ErrPolicyExpired) {
+		t.Errorf("Expected ErrPolicyExpired, got %v", err)
+	}
+}
+
+func TestPolicy_Plan(t *testing.T) {
+	p := Policy{
+		TotalShares: 5,
+		Threshold:   3,
+		Groups:      []PolicyGroup{{Name: "ops", Shares: 3}, {Name: "legal", Shares: 2}},
+	}
+	plan, err := p.Plan(32)
+	if err != nil {
+		t.Fatalf("Plan failed: %v", err)
+	}
+	if plan.ShareSize != 64 {
+		t.Errorf("Expected share size 64, got %d", plan.ShareSize)
+	}
+	if plan.EncodedSize != len("5:")+128 {
+		t.Errorf("Expected encoded size %d, got %d", len("5:")+128, plan.EncodedSize)
+	}
+	if plan.Custodians["ops"] != 3 || plan.Custodians["legal"] != 2 {
+		t.Errorf("Unexpected custodian counts: %v", plan.Custodians)
+	}
+	// ops alone can recover, and losing ops leaves only 2 shares.
+	if len(plan.Warnings) != 2 || !strings.Contains(plan.Warnings[0], `"ops"`) {
+		t.Errorf("Unexpected warnings: %q", plan.Warnings)
+	}
+
+	compact := Policy{TotalShares: 3, Threshold: 3, Scheme: SchemeV2GF256}
+	plan, err = compact.Plan(32)
+	if err != nil {
+		t.Fatalf("Plan failed: %v", err)
+	}
+	if plan.ShareSize != 32 || plan.EncodedSize != len("v2:3:")+64 {
+		t.Errorf("Unexpected sizes: %d, %d", plan.ShareSize, plan.EncodedSize)
+	}
+	if plan.Custodians[""] != 3 || len(plan.Warnings) != 1 {
+		t.Errorf("Unexpected plan: %+v", plan)
+	}
+
+	if _, err := compact.Plan(0); err == nil {
+		t.Error("Expected error for empty secret")
+	}
+}
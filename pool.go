@@ -0,0 +1,69 @@
+package goshamir
+
+import (
+	"math/bits"
+	"sync"
+)
+
+// bufferPool recycles byte and field element buffers in power-of-two size
+// classes. Buffers are always cleared before they are returned to the pool,
+// so recycled memory never carries share material between callers.
+type bufferPool struct {
+	bytes [33]sync.Pool
+	elems [33]sync.Pool
+}
+
+// sharedBuffers is the process-wide pool used when Config.PoolBuffers is set.
+var sharedBuffers bufferPool
+
+func sizeClass(n int) int {
+	if n <= 1 {
+		return 0
+	}
+	return bits.Len(uint(n - 1))
+}
+
+func (p *bufferPool) getBytes(n int) []byte {
+	c := sizeClass(n)
+	if c >= len(p.bytes) {
+		return make([]byte, n)
+	}
+	if v, ok := p.bytes[c].Get().(*[]byte); ok {
+		return (*v)[:n]
+	}
+	return make([]byte, n, 1<<c)
+}
+
+// putBytes clears b and recycles it if its capacity is a size class.
+// Buffers of other capacities, such as peppered share values, are cleared
+// and dropped.
+func (p *bufferPool) putBytes(b []byte) {
+	b = b[:cap(b)]
+	clear(b)
+	c := sizeClass(len(b))
+	if len(b) == 0 || len(b) != 1<<c || c >= len(p.bytes) {
+		return
+	}
+	p.bytes[c].Put(&b)
+}
+
+func (p *bufferPool) getElems(n int) []uint16 {
+	c := sizeClass(n)
+	if c >= len(p.elems) {
+		return make([]uint16, n)
+	}
+	if v, ok := p.elems[c].Get().(*[]uint16); ok {
+		return (*v)[:n]
+	}
+	return make([]uint16, n, 1<<c)
+}
+
+func (p *bufferPool) putElems(e []uint16) {
+	e = e[:cap(e)]
+	clear(e)
+	c := sizeClass(len(e))
+	if len(e) == 0 || len(e) != 1<<c || c >= len(p.elems) {
+		return
+	}
+	p.elems[c].Put(&e)
+}
@@ -0,0 +1,304 @@
+// Package publish publishes commitments to share sets in DNS TXT records
+// and /.well-known JSON documents, for semi-public custody arrangements in
+// which anyone should be able to check that a share belongs to a set, and
+// verifies shares against the published commitments.
+//
+// Only commitments are published: the Merkle root of a
+// goshamir.ShareMerkleTree and, in JSON documents, each share's blinded
+// commitment from the tree. Share values and blindings are never
+// published; each custodian receives their share's blinding in its
+// goshamir.InclusionProof and needs it to verify the share.
+//
+// A TXT record is published at TXTName(domain, setID) and reads
+//
+//	v=goshamir1; set=db-root; k=3; n=5; root=<hex Merkle root>
+//
+// and the JSON document is served at WellKnownURL(domain, setID).
+package publish
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	goshamir "github.com/fawwazid/go-shamir"
+)
+
+// txtVersion is the version tag of TXT records.
+const txtVersion = "goshamir1"
+
+// maxDocumentSize bounds the JSON documents FetchWellKnown reads.
+const maxDocumentSize = 1 << 20
+
+// ErrCommitmentMismatch is returned when a share does not match the
+// published commitment.
+var ErrCommitmentMismatch = errors.New("share does not match the published commitment")
+
+// ErrNoRecord is returned when no valid record for the share set is
+// published.
+var ErrNoRecord = errors.New("no share set record published")
+
+// Record is the published commitment to a share set.
+type Record struct {
+	SetID      string
+	Threshold  int
+	Total      int
+	MerkleRoot []byte
+	// Commitments maps share indices to their blinded ShareCommitment. It
+	// is published in JSON documents only; TXT records carry just the root.
+	Commitments map[uint8][]byte
+}
+
+// NewRecord commits to a complete share set through the Merkle tree of its
+// shares. Hand each custodian the tree's proof for their share, which
+// carries the blinding they need to verify it.
+func NewRecord(setID string, tree *goshamir.ShareMerkleTree, threshold int) (Record, error) {
+	if setID == "" {
+		return Record{}, errors.New("set ID cannot be empty")
+	}
+	if tree == nil {
+		return Record{}, errors.New("share tree cannot be nil")
+	}
+	indices := tree.Indices()
+	if threshold < goshamir.MinThreshold || threshold > len(indices) || len(indices) > goshamir.MaxShares {
+		return Record{}, errors.New("invalid threshold for share set")
+	}
+	r := Record{
+		SetID:       setID,
+		Threshold:   threshold,
+		Total:       len(indices),
+		MerkleRoot:  tree.Root(),
+		Commitments: make(map[uint8][]byte, len(indices)),
+	}
+	for _, index := range indices {
+		c, err := tree.Commitment(index)
+		if err != nil {
+			return Record{}, err
+		}
+		r.Commitments[index] = c
+	}
+	return r, nil
+}
+
+// VerifyShare checks that a custodian's share belongs to the published
+// set, using the inclusion proof issued with it: the share, blinded as in
+// the proof, must match its published commitment, if any, and the proof
+// must lead to the Merkle root.
+func (r Record) VerifyShare(s goshamir.Share, proof *goshamir.InclusionProof) error {
+	if proof == nil {
+		return fmt.Errorf("%w: inclusion proof required", ErrCommitmentMismatch)
+	}
+	commitment := goshamir.ShareCommitment(s, proof.Blinding)
+	if want, ok := r.Commitments[s.Index]; ok {
+		if !bytes.Equal(want, commitment) {
+			return ErrCommitmentMismatch
+		}
+	}
+	if proof.TreeSize != r.Total {
+		return fmt.Errorf("%w: inclusion proof required", ErrCommitmentMismatch)
+	}
+	if err := goshamir.VerifyInclusion(r.MerkleRoot, commitment, proof); err != nil {
+		return fmt.Errorf("%w: %v", ErrCommitmentMismatch, err)
+	}
+	return nil
+}
+
+// setLabel maps a set ID to a DNS label: the first 16 bytes of its SHA-256,
+// hex encoded, since set IDs may hold characters DNS names cannot.
+func setLabel(setID string) string {
+	h := sha256.Sum256([]byte(setID))
+	return hex.EncodeToString(h[:16])
+}
+
+// TXTName returns the DNS name the TXT record of a share set is published
+// under: "<label>._goshamir.<domain>", where the label is derived from the
+// set ID.
+func TXTName(domain, setID string) string {
+	return setLabel(setID) + "._goshamir." + strings.TrimSuffix(domain, ".")
+}
+
+// TXT returns the TXT record text of the share set.
+func (r Record) TXT() string {
+	return fmt.Sprintf("v=%s; set=%s; k=%d; n=%d; root=%x", txtVersion, url.QueryEscape(r.SetID), r.Threshold, r.Total, r.MerkleRoot)
+}
+
+// ParseTXT parses the text of a TXT record produced by Record.TXT.
+func ParseTXT(txt string) (Record, error) {
+	fields := make(map[string]string)
+	for part := range strings.SplitSeq(txt, ";") {
+		key, value, ok := strings.Cut(strings.TrimSpace(part), "=")
+		if !ok {
+			return Record{}, fmt.Errorf("malformed TXT field %q", part)
+		}
+		fields[key] = value
+	}
+	if fields["v"] != txtVersion {
+		return Record{}, fmt.Errorf("unsupported TXT record version %q", fields["v"])
+	}
+	var r Record
+	var err error
+	if r.SetID, err = url.QueryUnescape(fields["set"]); err != nil || r.SetID == "" {
+		return Record{}, errors.New("TXT record has no valid set")
+	}
+	if r.Threshold, err = strconv.Atoi(fields["k"]); err != nil {
+		return Record{}, errors.New("TXT record has no valid threshold")
+	}
+	if r.Total, err = strconv.Atoi(fields["n"]); err != nil || r.Total < r.Threshold {
+		return Record{}, errors.New("TXT record has no valid total")
+	}
+	if r.MerkleRoot, err = hex.DecodeString(fields["root"]); err != nil || len(r.MerkleRoot) != sha256.Size {
+		return Record{}, errors.New("TXT record has no valid root")
+	}
+	return r, nil
+}
+
+// Resolver looks up TXT records. *net.Resolver implements it.
+type Resolver interface {
+	LookupTXT(ctx context.Context, name string) ([]string, error)
+}
+
+// LookupTXT looks up the TXT record of a share set published under domain.
+// Records of other versions or sets at the same name are skipped.
+func LookupTXT(ctx context.Context, resolver Resolver, domain, setID string) (Record, error) {
+	txts, err := resolver.LookupTXT(ctx, TXTName(domain, setID))
+	if err != nil {
+		return Record{}, fmt.Errorf("%w: %v", ErrNoRecord, err)
+	}
+	for _, txt := range txts {
+		if r, err := ParseTXT(txt); err == nil && r.SetID == setID {
+			return r, nil
+		}
+	}
+	return Record{}, ErrNoRecord
+}
+
+// VerifyDNS looks up the TXT record of a share set and checks a share
+// against it with the share's inclusion proof.
+func VerifyDNS(ctx context.Context, resolver Resolver, domain, setID string, s goshamir.Share, proof *goshamir.InclusionProof) error {
+	r, err := LookupTXT(ctx, resolver, domain, setID)
+	if err != nil {
+		return err
+	}
+	return r.VerifyShare(s, proof)
+}
+
+// wellKnownDocument is the JSON form of a Record.
+type wellKnownDocument struct {
+	Version     string                `json:"version"`
+	SetID       string                `json:"set_id"`
+	Threshold   int                   `json:"threshold"`
+	Total       int                   `json:"total"`
+	MerkleRoot  string                `json:"merkle_root"`
+	Commitments []wellKnownCommitment `json:"commitments,omitempty"`
+}
+
+type wellKnownCommitment struct {
+	Index      uint8  `json:"index"`
+	Commitment string `json:"commitment"`
+}
+
+// WellKnownURL returns the URL the JSON document of a share set is served
+// at: "https://<domain>/.well-known/goshamir/<set ID>.json".
+func WellKnownURL(domain, setID string) string {
+	return "https://" + domain + "/.well-known/goshamir/" + url.PathEscape(setID) + ".json"
+}
+
+// MarshalJSON returns the JSON document of the share set, with roots and
+// commitments hex encoded and commitments sorted by index.
+func (r Record) MarshalJSON() ([]byte, error) {
+	doc := wellKnownDocument{
+		Version:    txtVersion,
+		SetID:      r.SetID,
+		Threshold:  r.Threshold,
+		Total:      r.Total,
+		MerkleRoot: hex.EncodeToString(r.MerkleRoot),
+	}
+	for i := range goshamir.MaxShares + 1 {
+		if c, ok := r.Commitments[uint8(i)]; ok {
+			doc.Commitments = append(doc.Commitments, wellKnownCommitment{Index: uint8(i), Commitment: hex.EncodeToString(c)})
+		}
+	}
+	return json.Marshal(doc)
+}
+
+// UnmarshalJSON parses a JSON document produced by MarshalJSON.
+func (r *Record) UnmarshalJSON(data []byte) error {
+	var doc wellKnownDocument
+	if err := json.Unmarshal(data, &doc); err != nil {
+		return err
+	}
+	if doc.Version != txtVersion {
+		return fmt.Errorf("unsupported document version %q", doc.Version)
+	}
+	root, err := hex.DecodeString(doc.MerkleRoot)
+	if err != nil || len(root) != sha256.Size {
+		return errors.New("document has no valid merkle_root")
+	}
+	if doc.SetID == "" || doc.Threshold < goshamir.MinThreshold || doc.Total < doc.Threshold {
+		return errors.New("document has invalid set parameters")
+	}
+	out := Record{SetID: doc.SetID, Threshold: doc.Threshold, Total: doc.Total, MerkleRoot: root}
+	if len(doc.Commitments) > 0 {
+		out.Commitments = make(map[uint8][]byte, len(doc.Commitments))
+		for _, c := range doc.Commitments {
+			b, err := hex.DecodeString(c.Commitment)
+			if err != nil || len(b) != sha256.Size {
+				return fmt.Errorf("document has an invalid commitment for share %d", c.Index)
+			}
+			out.Commitments[c.Index] = b
+		}
+	}
+	*r = out
+	return nil
+}
+
+// FetchWellKnown fetches the JSON document of a share set from docURL, as
+// returned by WellKnownURL. A nil client uses http.DefaultClient.
+func FetchWellKnown(ctx context.Context, client *http.Client, docURL, setID string) (Record, error) {
+	if client == nil {
+		client = http.DefaultClient
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, docURL, nil)
+	if err != nil {
+		return Record{}, err
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return Record{}, fmt.Errorf("%w: %v", ErrNoRecord, err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return Record{}, fmt.Errorf("%w: %s", ErrNoRecord, resp.Status)
+	}
+	data, err := io.ReadAll(io.LimitReader(resp.Body, maxDocumentSize))
+	if err != nil {
+		return Record{}, err
+	}
+	var r Record
+	if err := json.Unmarshal(data, &r); err != nil {
+		return Record{}, fmt.Errorf("%w: %v", ErrNoRecord, err)
+	}
+	if r.SetID != setID {
+		return Record{}, fmt.Errorf("%w: document is for set %q", ErrNoRecord, r.SetID)
+	}
+	return r, nil
+}
+
+// VerifyWellKnown fetches the JSON document of a share set and checks a
+// share against its commitments with the share's inclusion proof.
+func VerifyWellKnown(ctx context.Context, client *http.Client, docURL, setID string, s goshamir.Share, proof *goshamir.InclusionProof) error {
+	r, err := FetchWellKnown(ctx, client, docURL, setID)
+	if err != nil {
+		return err
+	}
+	return r.VerifyShare(s, proof)
+}
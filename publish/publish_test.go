@@ -0,0 +1,149 @@
+package publish
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	goshamir "github.com/fawwazid/go-shamir"
+)
+
+// fakeResolver serves TXT records from a map.
+type fakeResolver map[string][]string
+
+func (f fakeResolver) LookupTXT(_ context.Context, name string) ([]string, error) {
+	if txts, ok := f[name]; ok {
+		return txts, nil
+	}
+	return nil, errors.New("no such host")
+}
+
+func testRecord(t *testing.T) (Record, []goshamir.Share, *goshamir.ShareMerkleTree) {
+	t.Helper()
+	shares, _ := goshamir.Split([]byte("published"), 5, 3)
+	tree, _ := goshamir.NewShareMerkleTree(shares)
+	r, err := NewRecord("db root", tree, 3)
+	if err != nil {
+		t.Fatalf("NewRecord failed: %v", err)
+	}
+	return r, shares, tree
+}
+
+// --- DNS TXT Tests ---
+
+func TestTXT_RoundTrip(t *testing.T) {
+	r, shares, tree := testRecord(t)
+	txt := r.TXT()
+	if !strings.HasPrefix(txt, "v=goshamir1; set=db+root; k=3; n=5; root=") || len(txt) > 255 {
+		t.Fatalf("unexpected TXT record %q", txt)
+	}
+	for _, s := range shares {
+		if strings.Contains(txt, string(s.Value)) {
+			t.Fatal("TXT record contains a share value")
+		}
+	}
+
+	resolver := fakeResolver{TXTName("example.com.", "db root"): {"v=spf1 -all", r.TXT()}}
+	proof, _ := tree.Proof(shares[2].Index)
+	if err := VerifyDNS(context.Background(), resolver, "example.com", "db root", shares[2], proof); err != nil {
+		t.Fatalf("VerifyDNS failed: %v", err)
+	}
+
+	other, _ := goshamir.Split([]byte("published"), 5, 3)
+	if err := VerifyDNS(context.Background(), resolver, "example.com", "db root", other[2], proof); !errors.Is(err, ErrCommitmentMismatch) {
+		t.Fatalf("expected ErrCommitmentMismatch, got %v", err)
+	}
+	if err := VerifyDNS(context.Background(), resolver, "example.com", "db root", shares[2], nil); !errors.Is(err, ErrCommitmentMismatch) {
+		t.Fatalf("expected an error without a proof, got %v", err)
+	}
+	if _, err := LookupTXT(context.Background(), resolver, "example.com", "other set"); !errors.Is(err, ErrNoRecord) {
+		t.Fatalf("expected ErrNoRecord, got %v", err)
+	}
+}
+
+func TestParseTXT_Invalid(t *testing.T) {
+	for _, txt := range []string{
+		"v=spf1 -all",
+		"v=goshamir1; set=a; k=3; n=2; root=00",
+		"v=goshamir1; set=a; k=3; n=5; root=zz",
+		"v=goshamir1; set=; k=3; n=5; root=" + strings.Repeat("00", 32),
+	} {
+		if _, err := ParseTXT(txt); err == nil {
+			t.Errorf("ParseTXT(%q): expected error", txt)
+		}
+	}
+}
+
+// --- Well-Known Document Tests ---
+
+func TestWellKnown(t *testing.T) {
+	r, shares, tree := testRecord(t)
+	doc, err := json.Marshal(r)
+	if err != nil {
+		t.Fatalf("Marshal failed: %v", err)
+	}
+	srv := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if req.URL.Path != "/.well-known/goshamir/db%20root.json" && req.URL.Path != "/.well-known/goshamir/db root.json" {
+			http.NotFound(w, req)
+			return
+		}
+		w.Write(doc)
+	}))
+	defer srv.Close()
+
+	host := strings.TrimPrefix(srv.URL, "https://")
+	url := WellKnownURL(host, "db root")
+	ctx := context.Background()
+	proof, _ := tree.Proof(shares[4].Index)
+	if err := VerifyWellKnown(ctx, srv.Client(), url, "db root", shares[4], proof); err != nil {
+		t.Fatalf("VerifyWellKnown failed: %v", err)
+	}
+	tampered := shares[4]
+	tampered.Value = append([]byte(nil), tampered.Value...)
+	tampered.Value[0] ^= 1
+	if err := VerifyWellKnown(ctx, srv.Client(), url, "db root", tampered, proof); !errors.Is(err, ErrCommitmentMismatch) {
+		t.Fatalf("expected ErrCommitmentMismatch, got %v", err)
+	}
+	if _, err := FetchWellKnown(ctx, srv.Client(), url, "another set"); !errors.Is(err, ErrNoRecord) {
+		t.Fatalf("expected ErrNoRecord for another set, got %v", err)
+	}
+	if _, err := FetchWellKnown(ctx, srv.Client(), WellKnownURL(host, "missing"), "missing"); !errors.Is(err, ErrNoRecord) {
+		t.Fatalf("expected ErrNoRecord for a missing document, got %v", err)
+	}
+}
+
+func TestRecord_HidesShortSecrets(t *testing.T) {
+	// A one-byte secret has v1 shares of two bytes, so a published
+	// commitment could be matched by trying all 65536 values.
+	shares, _ := goshamir.Split([]byte{0x2a}, 3, 2)
+	tree, _ := goshamir.NewShareMerkleTree(shares)
+	r, err := NewRecord("short", tree, 2)
+	if err != nil {
+		t.Fatalf("NewRecord failed: %v", err)
+	}
+	doc, _ := json.Marshal(r)
+	var published Record
+	if err := json.Unmarshal(doc, &published); err != nil {
+		t.Fatalf("Unmarshal failed: %v", err)
+	}
+
+	for index, want := range published.Commitments {
+		for v := range 1 << 16 {
+			guess := goshamir.Share{Index: index, Value: []byte{byte(v >> 8), byte(v)}}
+			if bytes.Equal(goshamir.ShareCommitment(guess, nil), want) {
+				t.Fatalf("share %d recovered from its published commitment", index)
+			}
+		}
+	}
+
+	// The custodian's blinding still verifies the real share.
+	proof, _ := tree.Proof(shares[0].Index)
+	if err := published.VerifyShare(shares[0], proof); err != nil {
+		t.Fatalf("VerifyShare failed: %v", err)
+	}
+}
@@ -0,0 +1,162 @@
+package goshamir
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/ed25519"
+	"crypto/rand"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// receiptMagic starts the signed form of a Receipt and domain separates its
+// signatures.
+const receiptMagic = "goshamir share receipt v1"
+
+var (
+	// ErrBadReceipt is returned when a receipt was not signed by the
+	// custodian's key or does not match the share they were given.
+	ErrBadReceipt = errors.New("share receipt is invalid")
+	// ErrMissingReceipt is returned by VerifyReceipts for custodians who
+	// have not acknowledged their share.
+	ErrMissingReceipt = errors.New("share receipt is missing")
+)
+
+// Receipt is a custodian's signed acknowledgment that they received a
+// share, closing the loop on distribution audits. It commits to the share
+// through its unblinded ShareCommitment, which can be matched against
+// guessed share values: receipts for shares of short secrets should be
+// kept by the dealer rather than published.
+type Receipt struct {
+	SetID     string
+	Custodian string
+	Index     uint8
+	// Commitment is the unblinded ShareCommitment of the share received.
+	Commitment []byte
+	ReceivedAt time.Time
+	Signature  []byte
+}
+
+// NewReceipt acknowledges receipt of share, signing it with the
+// custodian's Ed25519 key. The signer may be an ed25519.PrivateKey or any
+// crypto.Signer holding an Ed25519 key, such as a hardware token.
+func NewReceipt(setID, custodian string, share Share, signer crypto.Signer) (Receipt, error) {
+	if _, ok := signer.Public().(ed25519.PublicKey); !ok {
+		return Receipt{}, errors.New("signer must hold an Ed25519 key")
+	}
+	if share.Index == 0 || len(share.Value) == 0 {
+		return Receipt{}, errors.New("receipt needs a share")
+	}
+	r := Receipt{
+		SetID:      setID,
+		Custodian:  custodian,
+		Index:      share.Index,
+		Commitment: ShareCommitment(share, nil),
+		ReceivedAt: time.Now().UTC().Truncate(time.Second),
+	}
+	sig, err := signer.Sign(rand.Reader, r.signedMessage(), crypto.Hash(0))
+	if err != nil {
+		return Receipt{}, fmt.Errorf("signing receipt failed: %w", err)
+	}
+	r.Signature = sig
+	return r, nil
+}
+
+// Verify checks that the receipt was signed by key.
+func (r Receipt) Verify(key ed25519.PublicKey) error {
+	if len(key) != ed25519.PublicKeySize {
+		return errors.New("invalid custodian public key")
+	}
+	if !ed25519.Verify(key, r.signedMessage(), r.Signature) {
+		return ErrBadReceipt
+	}
+	return nil
+}
+
+// VerifyReceipts confirms that every custodian of a distribution
+// acknowledged the share they were given: each bundle needs a receipt for
+// the set, signed by the custodian, for the same index and commitment.
+// Keys maps custodian names to their receipt keys; custodians missing from
+// it, or a nil map, use Custodian.PublicKey. The error joins one error per
+// custodian, wrapping ErrMissingReceipt or ErrBadReceipt.
+func VerifyReceipts(setID string, bundles []CustodianBundle, receipts []Receipt, keys map[string]ed25519.PublicKey) error {
+	byName := make(map[string][]Receipt, len(receipts))
+	for _, r := range receipts {
+		if r.SetID == setID {
+			byName[r.Custodian] = append(byName[r.Custodian], r)
+		}
+	}
+	var errs []error
+	for _, b := range bundles {
+		name := b.Custodian.Name
+		key, ok := keys[name]
+		if !ok {
+			key = b.Custodian.PublicKey
+		}
+		candidates := byName[name]
+		if len(candidates) == 0 {
+			errs = append(errs, fmt.Errorf("%w: custodian %q", ErrMissingReceipt, name))
+			continue
+		}
+		commitment := ShareCommitment(b.Share, nil)
+		var lastErr error
+		for _, r := range candidates {
+			switch {
+			case r.Index != b.Share.Index || !bytes.Equal(r.Commitment, commitment):
+				lastErr = fmt.Errorf("%w: custodian %q acknowledged a different share", ErrBadReceipt, name)
+			default:
+				if lastErr = r.Verify(key); lastErr != nil {
+					lastErr = fmt.Errorf("custodian %q: %w", name, lastErr)
+				}
+			}
+			if lastErr == nil {
+				break
+			}
+		}
+		if lastErr != nil {
+			errs = append(errs, lastErr)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// MarshalBinary encodes the receipt, including its signature.
+func (r Receipt) MarshalBinary() ([]byte, error) {
+	return appendField(r.signedMessage(), r.Signature), nil
+}
+
+// UnmarshalBinary decodes a receipt produced by MarshalBinary. It does not
+// verify the signature.
+func (r *Receipt) UnmarshalBinary(data []byte) error {
+	fr := fieldReader{data: data}
+	if string(fr.next()) != receiptMagic {
+		return errors.New("invalid receipt")
+	}
+	var out Receipt
+	out.SetID = string(fr.next())
+	out.Custodian = string(fr.next())
+	index := fr.next()
+	out.Commitment = fr.next()
+	received := fr.next()
+	out.Signature = fr.next()
+	if fr.err != nil || len(fr.data) != 0 || len(index) != 1 || len(received) != 8 {
+		return errors.New("invalid receipt")
+	}
+	out.Index = index[0]
+	out.ReceivedAt = time.Unix(int64(binary.BigEndian.Uint64(received)), 0).UTC()
+	*r = out
+	return nil
+}
+
+// signedMessage returns the canonical encoding of everything the signature
+// covers.
+func (r *Receipt) signedMessage() []byte {
+	buf := appendField(nil, []byte(receiptMagic))
+	buf = appendField(buf, []byte(r.SetID))
+	buf = appendField(buf, []byte(r.Custodian))
+	buf = appendField(buf, []byte{r.Index})
+	buf = appendField(buf, r.Commitment)
+	return appendField(buf, binary.BigEndian.AppendUint64(nil, uint64(r.ReceivedAt.Unix())))
+}
@@ -0,0 +1,101 @@
+package goshamir
+
+import (
+	"crypto/ed25519"
+	"errors"
+	"strings"
+	"testing"
+)
+
+// --- Receipt Tests ---
+
+func newReceiptFixture(t *testing.T) ([]CustodianBundle, []ed25519.PrivateKey) {
+	t.Helper()
+	shares, _ := Split([]byte("distributed"), 3, 2)
+	var custodians []Custodian
+	var keys []ed25519.PrivateKey
+	for _, name := range []string{"alice", "bob", "carol"} {
+		pub, priv, err := ed25519.GenerateKey(nil)
+		if err != nil {
+			t.Fatalf("GenerateKey failed: %v", err)
+		}
+		custodians = append(custodians, Custodian{Name: name, PublicKey: pub})
+		keys = append(keys, priv)
+	}
+	bundles, err := AssignShares(shares, custodians)
+	if err != nil {
+		t.Fatalf("AssignShares failed: %v", err)
+	}
+	return bundles, keys
+}
+
+func TestVerifyReceipts_AllAcknowledged(t *testing.T) {
+	bundles, keys := newReceiptFixture(t)
+	var receipts []Receipt
+	for i, b := range bundles {
+		r, err := NewReceipt("set-1", b.Custodian.Name, b.Share, keys[i])
+		if err != nil {
+			t.Fatalf("NewReceipt failed: %v", err)
+		}
+		data, _ := r.MarshalBinary()
+		var decoded Receipt
+		if err := decoded.UnmarshalBinary(data); err != nil {
+			t.Fatalf("UnmarshalBinary failed: %v", err)
+		}
+		receipts = append(receipts, decoded)
+	}
+	if err := VerifyReceipts("set-1", bundles, receipts, nil); err != nil {
+		t.Fatalf("VerifyReceipts failed: %v", err)
+	}
+}
+
+func TestVerifyReceipts_MissingAndForged(t *testing.T) {
+	bundles, keys := newReceiptFixture(t)
+	alice, _ := NewReceipt("set-1", "alice", bundles[0].Share, keys[0])
+	// Bob's receipt signed with Carol's key.
+	bob, _ := NewReceipt("set-1", "bob", bundles[1].Share, keys[2])
+
+	err := VerifyReceipts("set-1", bundles, []Receipt{alice, bob}, nil)
+	if !errors.Is(err, ErrMissingReceipt) || !errors.Is(err, ErrBadReceipt) {
+		t.Fatalf("expected missing and bad receipts, got %v", err)
+	}
+	if !strings.Contains(err.Error(), `"carol"`) || !strings.Contains(err.Error(), `"bob"`) || strings.Contains(err.Error(), `"alice"`) {
+		t.Errorf("error does not name the right custodians: %v", err)
+	}
+}
+
+func TestVerifyReceipts_WrongShareOrSet(t *testing.T) {
+	bundles, keys := newReceiptFixture(t)
+	var receipts []Receipt
+	for i, b := range bundles {
+		share := b.Share
+		if i == 0 {
+			share = bundles[1].Share
+		}
+		setID := "set-1"
+		if i == 2 {
+			setID = "other"
+		}
+		r, _ := NewReceipt(setID, b.Custodian.Name, share, keys[i])
+		receipts = append(receipts, r)
+	}
+	err := VerifyReceipts("set-1", bundles, receipts, nil)
+	if !errors.Is(err, ErrBadReceipt) || !errors.Is(err, ErrMissingReceipt) {
+		t.Fatalf("expected bad and missing receipts, got %v", err)
+	}
+}
+
+func TestVerifyReceipts_ExplicitKeys(t *testing.T) {
+	bundles, keys := newReceiptFixture(t)
+	var receipts []Receipt
+	receiptKeys := make(map[string]ed25519.PublicKey)
+	for i := range bundles {
+		receiptKeys[bundles[i].Custodian.Name] = keys[i].Public().(ed25519.PublicKey)
+		bundles[i].Custodian.PublicKey = []byte("age1 encryption key")
+		r, _ := NewReceipt("set-1", bundles[i].Custodian.Name, bundles[i].Share, keys[i])
+		receipts = append(receipts, r)
+	}
+	if err := VerifyReceipts("set-1", bundles, receipts, receiptKeys); err != nil {
+		t.Fatalf("VerifyReceipts failed: %v", err)
+	}
+}
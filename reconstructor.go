@@ -0,0 +1,151 @@
+package goshamir
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Reconstructor recombines secrets from a fixed set of share indices. The
+// Lagrange basis for those indices is computed once for every supported
+// scheme, so each Combine call is a dot product per secret byte. This suits
+// automated deployments where the same custodians (for example nodes 1, 3
+// and 4 of a 3-of-5 split) always take part in recovery.
+//
+// A Reconstructor is immutable after construction and safe for concurrent
+// use by multiple goroutines.
+type Reconstructor struct {
+	indices  []uint8
+	basis    []uint16
+	basis256 []byte
+	// position maps a share index to its slot in indices, or -1.
+	position [MaxShares + 1]int16
+	config   Config
+}
+
+// NewReconstructor returns a Reconstructor for shares carrying exactly the
+// given indices. The number of indices is the threshold of the split.
+// WithBlinding, WithRandom and WithPepper are the options that apply.
+func NewReconstructor(indices []uint8, opts ...Option) (*Reconstructor, error) {
+	return newReconstructor(indices, NewConfig(opts...))
+}
+
+func newReconstructor(indices []uint8, cfg Config) (*Reconstructor, error) {
+	if len(indices) < MinThreshold {
+		return nil, fmt.Errorf("threshold must be at least %d", MinThreshold)
+	}
+	if len(indices) > MaxShares {
+		return nil, fmt.Errorf("threshold must be <= %d", MaxShares)
+	}
+
+	r := &Reconstructor{
+		indices: append([]uint8(nil), indices...),
+		config:  cfg,
+	}
+	for i := range r.position {
+		r.position[i] = -1
+	}
+	for i, x := range r.indices {
+		if x == 0 {
+			return nil, errors.New("share index must be non-zero")
+		}
+		if r.position[x] >= 0 {
+			return nil, errors.New("duplicate share index found")
+		}
+		r.position[x] = int16(i)
+	}
+	r.basis = lagrangeBasisAtZero(r.indices)
+	r.basis256 = gf256LagrangeBasisAtZero(r.indices)
+	return r, nil
+}
+
+// Threshold returns the number of shares the Reconstructor combines.
+func (r *Reconstructor) Threshold() int {
+	return len(r.indices)
+}
+
+// Indices returns a copy of the share indices the Reconstructor expects.
+func (r *Reconstructor) Indices() []uint8 {
+	return append([]uint8(nil), r.indices...)
+}
+
+// Combine reconstructs the secret from shares. Every expected index must be
+// present; shares with other indices are ignored, and the order of shares
+// does not matter. The field is chosen from the shares' Scheme.
+func (r *Reconstructor) Combine(shares []Share) (_ []byte, err error) {
+	defer recoverInternal(&err)
+
+	if shares == nil {
+		return nil, errors.New("shares cannot be nil")
+	}
+
+	ordered := make([]Share, len(r.indices))
+	found := 0
+	for _, s := range shares {
+		pos := r.position[s.Index]
+		if pos < 0 {
+			continue
+		}
+		if ordered[pos].Index != 0 {
+			return nil, errors.New("duplicate share index found")
+		}
+		ordered[pos] = s
+		found++
+	}
+	if found < len(ordered) {
+		for i, s := range ordered {
+			if s.Index == 0 {
+				return nil, fmt.Errorf("missing share with index %d", r.indices[i])
+			}
+		}
+	}
+
+	if len(r.config.Pepper) > 0 {
+		if ordered, err = UnpepperShares(ordered, r.config.Pepper); err != nil {
+			return nil, err
+		}
+		defer func() {
+			for _, s := range ordered {
+				clear(s.Value)
+			}
+		}()
+	}
+
+	valueLen := len(ordered[0].Value)
+	for i, s := range ordered {
+		if len(s.Value) != valueLen {
+			return nil, fmt.Errorf("share with index %d has inconsistent length", r.indices[i])
+		}
+	}
+	if valueLen == 0 {
+		return nil, errors.New("share value cannot be empty")
+	}
+
+	scheme, err := sharesScheme(ordered)
+	if err != nil {
+		return nil, err
+	}
+	if r.config.Blinding {
+		return r.combineBlinded(ordered, scheme)
+	}
+	if scheme == SchemeV2GF256 {
+		return dotGF256(ordered, r.basis256), nil
+	}
+	if valueLen%2 != 0 {
+		return nil, errors.New("share value length must be even")
+	}
+
+	secret := make([]byte, valueLen/2)
+	for bytePos := range secret {
+		// Each product is below 257^2 and there are at most 255 of them.
+		var acc uint32
+		for i, s := range ordered {
+			y, _ := decodeFieldElement(s.Value, bytePos)
+			if y >= FieldPrime {
+				return nil, fmt.Errorf("share with index %d: decoded value %d out of field range [0, %d]", r.indices[i], y, FieldPrime-1)
+			}
+			acc += uint32(y) * uint32(r.basis[i])
+		}
+		secret[bytePos] = byte(acc % FieldPrime % 256)
+	}
+	return secret, nil
+}
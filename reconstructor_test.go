@@ -0,0 +1,92 @@
+package goshamir
+
+import (
+	"bytes"
+	"testing"
+)
+
+// --- Reconstructor Tests ---
+
+func TestReconstructor_FixedIndices(t *testing.T) {
+	secret := []byte("fixed custodian set")
+	shares, err := Split(secret, 5, 3)
+	if err != nil {
+		t.Fatalf("Split failed: %v", err)
+	}
+
+	r, err := NewReconstructor([]uint8{2, 4, 5})
+	if err != nil {
+		t.Fatalf("NewReconstructor failed: %v", err)
+	}
+
+	// Order does not matter and unrelated shares are ignored.
+	recovered, err := r.Combine([]Share{shares[4], shares[0], shares[3], shares[1]})
+	if err != nil {
+		t.Fatalf("Combine failed: %v", err)
+	}
+	if !bytes.Equal(secret, recovered) {
+		t.Errorf("Expected %q, got %q", secret, recovered)
+	}
+}
+
+func TestReconstructor_MissingShare(t *testing.T) {
+	shares, err := Split([]byte("test"), 5, 3)
+	if err != nil {
+		t.Fatalf("Split failed: %v", err)
+	}
+
+	r, err := NewReconstructor([]uint8{1, 2, 3})
+	if err != nil {
+		t.Fatalf("NewReconstructor failed: %v", err)
+	}
+	if _, err := r.Combine([]Share{shares[0], shares[1], shares[4]}); err == nil {
+		t.Error("Expected error for missing share")
+	}
+}
+
+func TestReconstructor_InvalidIndices(t *testing.T) {
+	invalid := [][]uint8{
+		nil,
+		{1},
+		{0, 1, 2},
+		{1, 2, 2},
+	}
+	for _, indices := range invalid {
+		if _, err := NewReconstructor(indices); err == nil {
+			t.Errorf("Expected error for indices %v", indices)
+		}
+	}
+}
+
+func TestReconstructor_InvalidShares(t *testing.T) {
+	r, err := NewReconstructor([]uint8{1, 2})
+	if err != nil {
+		t.Fatalf("NewReconstructor failed: %v", err)
+	}
+
+	cases := map[string][]Share{
+		"duplicate":    {{Index: 1, Value: []byte{1, 0}}, {Index: 1, Value: []byte{1, 0}}, {Index: 2, Value: []byte{1, 0}}},
+		"inconsistent": {{Index: 1, Value: []byte{1, 0}}, {Index: 2, Value: []byte{1, 0, 2, 0}}},
+		"odd length":   {{Index: 1, Value: []byte{1, 0, 2}}, {Index: 2, Value: []byte{1, 0, 2}}},
+		"out of field": {{Index: 1, Value: []byte{0xFF, 0xFF}}, {Index: 2, Value: []byte{1, 0}}},
+	}
+	for name, shares := range cases {
+		if _, err := r.Combine(shares); err == nil {
+			t.Errorf("%s: expected error", name)
+		}
+	}
+}
+
+func BenchmarkReconstructorCombine(b *testing.B) {
+	secret := make([]byte, 32)
+	shares, _ := Split(secret, 5, 3)
+	r, err := NewReconstructor([]uint8{1, 2, 3})
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		r.Combine(shares[:3])
+	}
+}
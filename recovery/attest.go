@@ -0,0 +1,102 @@
+package recovery
+
+import (
+	"context"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+)
+
+// Attestation formats understood by common verifiers.
+const (
+	// FormatTPMQuote is a TPM 2.0 quote with its signature and event log.
+	FormatTPMQuote = "tpm2-quote"
+	// FormatAppAttest is an Apple DCAppAttest attestation or assertion.
+	FormatAppAttest = "apple-app-attest"
+)
+
+// attestationContext domain separates attestation challenges.
+const attestationContext = "goshamir recovery attestation v1"
+
+// ErrAttestation is returned when a submission's attestation evidence is
+// missing or does not verify.
+var ErrAttestation = errors.New("device attestation failed")
+
+// Attestation is hardware evidence that a share was submitted from a
+// genuine custodian device. The evidence must commit to the submission's
+// AttestationChallenge, for example as the TPM quote's qualifying data or
+// the App Attest client data hash, so it cannot be replayed for another
+// submission.
+type Attestation struct {
+	Format   string `json:"format"`
+	Evidence []byte `json:"evidence"`
+}
+
+// AttestationVerifier checks attestation evidence, returning a stable
+// identifier of the attested device, such as the TPM's endorsement key
+// hash or the App Attest key ID.
+type AttestationVerifier interface {
+	VerifyAttestation(ctx context.Context, a Attestation, challenge []byte) (deviceID string, err error)
+}
+
+// AttestationVerifierFunc adapts a function to an AttestationVerifier.
+type AttestationVerifierFunc func(ctx context.Context, a Attestation, challenge []byte) (string, error)
+
+// VerifyAttestation calls f.
+func (f AttestationVerifierFunc) VerifyAttestation(ctx context.Context, a Attestation, challenge []byte) (string, error) {
+	return f(ctx, a, challenge)
+}
+
+// FormatVerifiers dispatches attestations to a verifier by format, so
+// custodians may use different kinds of hardware.
+type FormatVerifiers map[string]AttestationVerifier
+
+// VerifyAttestation implements AttestationVerifier.
+func (fv FormatVerifiers) VerifyAttestation(ctx context.Context, a Attestation, challenge []byte) (string, error) {
+	v, ok := fv[a.Format]
+	if !ok {
+		return "", fmt.Errorf("unsupported attestation format %q", a.Format)
+	}
+	return v.VerifyAttestation(ctx, a, challenge)
+}
+
+// WithAttestation requires every submission to carry attestation evidence
+// accepted by v, and every share of a ceremony to come from a different
+// device, so reconstruction needs a threshold of genuine custodian
+// hardware.
+func WithAttestation(v AttestationVerifier) Option {
+	return func(s *Server) {
+		s.verifier = v
+	}
+}
+
+// AttestationChallenge returns the value the attestation of a submission
+// to the given ceremony must commit to. It binds the ceremony ID and the
+// submitted share or transit envelope.
+func (sub Submission) AttestationChallenge(ceremonyID string) []byte {
+	h := sha256.New()
+	h.Write([]byte(attestationContext + "\x00" + ceremonyID + "\x00"))
+	if sub.Transit != nil {
+		h.Write(sub.Transit.EphemeralKey)
+		h.Write(sub.Transit.Ciphertext)
+	} else {
+		h.Write([]byte(sub.Share))
+	}
+	return h.Sum(nil)
+}
+
+// verifyAttestation checks a submission's attestation, returning the
+// device ID.
+func (s *Server) verifyAttestation(ctx context.Context, id string, sub Submission) (string, error) {
+	if sub.Attestation == nil {
+		return "", fmt.Errorf("%w: no attestation evidence", ErrAttestation)
+	}
+	device, err := s.verifier.VerifyAttestation(ctx, *sub.Attestation, sub.AttestationChallenge(id))
+	if err != nil {
+		return "", fmt.Errorf("%w: %v", ErrAttestation, err)
+	}
+	if device == "" {
+		return "", fmt.Errorf("%w: verifier returned no device identity", ErrAttestation)
+	}
+	return device, nil
+}
@@ -0,0 +1,95 @@
+package recovery
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"errors"
+	"strings"
+	"testing"
+
+	goshamir "github.com/fawwazid/go-shamir"
+)
+
+// --- Attestation Tests ---
+
+// fakeTPM stands in for a hardware verifier: evidence is the device name
+// followed by an HMAC of the challenge under a key only genuine devices
+// hold.
+var fakeTPMKey = []byte("genuine hardware")
+
+func fakeTPMEvidence(device string, challenge []byte) []byte {
+	mac := hmac.New(sha256.New, fakeTPMKey)
+	mac.Write([]byte(device))
+	mac.Write(challenge)
+	return append([]byte(device+":"), mac.Sum(nil)...)
+}
+
+var fakeTPMVerifier = AttestationVerifierFunc(func(_ context.Context, a Attestation, challenge []byte) (string, error) {
+	device, _, ok := bytes.Cut(a.Evidence, []byte(":"))
+	if !ok || !hmac.Equal(a.Evidence, fakeTPMEvidence(string(device), challenge)) {
+		return "", errors.New("quote does not verify")
+	}
+	return string(device), nil
+})
+
+func attestingClient(url, device string) *Client {
+	return &Client{URL: url, Attest: func(_ context.Context, challenge []byte) (*Attestation, error) {
+		return &Attestation{Format: FormatTPMQuote, Evidence: fakeTPMEvidence(device, challenge)}, nil
+	}}
+}
+
+func TestServer_RequiresAttestation(t *testing.T) {
+	ctx := context.Background()
+	srv, ts, recovered := newTestServer(t, WithAttestation(FormatVerifiers{FormatTPMQuote: fakeTPMVerifier}))
+	srv.StartCeremony(ctx, "c", 2)
+	shares, _ := goshamir.Split([]byte("attested"), 3, 2)
+
+	if _, err := (&Client{URL: ts.URL}).Submit(ctx, "c", shares[0]); err == nil || !strings.Contains(err.Error(), "no attestation") {
+		t.Errorf("Expected missing attestation error, got %v", err)
+	}
+
+	forged := &Client{URL: ts.URL, Attest: func(context.Context, []byte) (*Attestation, error) {
+		return &Attestation{Format: FormatTPMQuote, Evidence: fakeTPMEvidence("laptop-1", []byte("old challenge"))}, nil
+	}}
+	if _, err := forged.Submit(ctx, "c", shares[0]); err == nil {
+		t.Error("Expected replayed evidence to be rejected")
+	}
+
+	appAttest := &Client{URL: ts.URL, Attest: func(context.Context, []byte) (*Attestation, error) {
+		return &Attestation{Format: FormatAppAttest, Evidence: []byte("blob")}, nil
+	}}
+	if _, err := appAttest.Submit(ctx, "c", shares[0]); err == nil || !strings.Contains(err.Error(), "unsupported attestation format") {
+		t.Errorf("Expected unsupported format error, got %v", err)
+	}
+
+	if _, err := attestingClient(ts.URL, "laptop-1").Submit(ctx, "c", shares[0]); err != nil {
+		t.Fatalf("Submit failed: %v", err)
+	}
+	if _, err := attestingClient(ts.URL, "laptop-1").Submit(ctx, "c", shares[1]); err == nil || !strings.Contains(err.Error(), "device already submitted") {
+		t.Errorf("Expected second share from the same device to be rejected, got %v", err)
+	}
+	if status, _ := srv.Status("c"); len(status.Received) != 1 {
+		t.Errorf("Expected one accepted share, got %v", status.Received)
+	}
+
+	status, err := attestingClient(ts.URL, "laptop-2").Submit(ctx, "c", shares[1])
+	if err != nil {
+		t.Fatalf("Submit failed: %v", err)
+	}
+	if status.State != StateComplete || string(*recovered) != "attested" {
+		t.Errorf("Expected completed ceremony, got %+v", status)
+	}
+}
+
+func TestAttestationChallenge_BindsSubmission(t *testing.T) {
+	a := Submission{Transit: &TransitEnvelope{EphemeralKey: []byte{1}, Ciphertext: []byte{2}}}
+	b := Submission{Transit: &TransitEnvelope{EphemeralKey: []byte{1}, Ciphertext: []byte{3}}}
+	if bytes.Equal(a.AttestationChallenge("c"), b.AttestationChallenge("c")) {
+		t.Error("Challenge does not depend on the ciphertext")
+	}
+	if bytes.Equal(a.AttestationChallenge("c"), a.AttestationChallenge("d")) {
+		t.Error("Challenge does not depend on the ceremony")
+	}
+}
@@ -0,0 +1,136 @@
+package recovery
+
+import (
+	"context"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// ErrUnauthenticated is returned when a request carries no acceptable
+// credentials.
+var ErrUnauthenticated = errors.New("authentication required")
+
+// Identity is an authenticated custodian.
+type Identity struct {
+	// Subject identifies the custodian, such as an OIDC subject or a
+	// certificate's common name.
+	Subject string
+	// Method names how the custodian authenticated: "bearer" or "mtls".
+	Method string
+}
+
+// Authenticator identifies the custodian making a request, returning an
+// error if the request is not from an authorized custodian.
+type Authenticator interface {
+	Authenticate(r *http.Request) (Identity, error)
+}
+
+// AuthenticatorFunc adapts a function to an Authenticator.
+type AuthenticatorFunc func(r *http.Request) (Identity, error)
+
+// Authenticate calls f.
+func (f AuthenticatorFunc) Authenticate(r *http.Request) (Identity, error) {
+	return f(r)
+}
+
+// BearerAuthenticator authenticates requests by an "Authorization: Bearer"
+// token, typically an OIDC ID or access token. verify checks the token's
+// signature, issuer, audience and expiry, for example with an OIDC
+// library's verifier, and returns the custodian's subject if authorized.
+func BearerAuthenticator(verify func(ctx context.Context, token string) (subject string, err error)) Authenticator {
+	return AuthenticatorFunc(func(r *http.Request) (Identity, error) {
+		scheme, token, ok := strings.Cut(r.Header.Get("Authorization"), " ")
+		if !ok || !strings.EqualFold(scheme, "Bearer") || token == "" {
+			return Identity{}, ErrUnauthenticated
+		}
+		subject, err := verify(r.Context(), token)
+		if err != nil {
+			return Identity{}, fmt.Errorf("%w: %v", ErrUnauthenticated, err)
+		}
+		return Identity{Subject: subject, Method: "bearer"}, nil
+	})
+}
+
+// ClientCertAuthenticator authenticates requests by their TLS client
+// certificate. The server's tls.Config must verify client certificates
+// (ClientAuth set to VerifyClientCertIfGiven or RequireAndVerifyClientCert
+// with the custodian CA in ClientCAs); authorize then maps the verified
+// leaf certificate to a custodian subject, or rejects it.
+func ClientCertAuthenticator(authorize func(cert *x509.Certificate) (subject string, err error)) Authenticator {
+	return AuthenticatorFunc(func(r *http.Request) (Identity, error) {
+		if r.TLS == nil || len(r.TLS.VerifiedChains) == 0 || len(r.TLS.VerifiedChains[0]) == 0 {
+			return Identity{}, ErrUnauthenticated
+		}
+		subject, err := authorize(r.TLS.VerifiedChains[0][0])
+		if err != nil {
+			return Identity{}, fmt.Errorf("%w: %v", ErrUnauthenticated, err)
+		}
+		return Identity{Subject: subject, Method: "mtls"}, nil
+	})
+}
+
+// AnyAuthenticator accepts a request if any of auths does, trying them in
+// order, so custodians may use either a token or a certificate.
+func AnyAuthenticator(auths ...Authenticator) Authenticator {
+	return AuthenticatorFunc(func(r *http.Request) (Identity, error) {
+		err := ErrUnauthenticated
+		for _, a := range auths {
+			id, aerr := a.Authenticate(r)
+			if aerr == nil {
+				return id, nil
+			}
+			// Prefer an error explaining why credentials were rejected
+			// over one saying that none were given.
+			if aerr != ErrUnauthenticated {
+				err = aerr
+			}
+		}
+		return Identity{}, err
+	})
+}
+
+// WithAuthenticator requires custodians to authenticate with a before
+// reading a ceremony's status or submitting a share, and lets each
+// authenticated custodian submit only one share per ceremony. The transit
+// key and metrics endpoints remain unauthenticated.
+func WithAuthenticator(a Authenticator) Option {
+	return func(s *Server) {
+		s.auth = a
+	}
+}
+
+type identityKey struct{}
+
+// IdentityFromContext returns the authenticated custodian of a request
+// handled by a Server with an Authenticator.
+func IdentityFromContext(ctx context.Context) (Identity, bool) {
+	id, ok := ctx.Value(identityKey{}).(Identity)
+	return id, ok
+}
+
+// authenticated wraps h to require authentication if the server has an
+// Authenticator.
+func (s *Server) authenticated(h http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if s.auth == nil {
+			h(w, r)
+			return
+		}
+		id, err := s.auth.Authenticate(r)
+		if err == nil && id.Subject == "" {
+			err = fmt.Errorf("%w: no subject", ErrUnauthenticated)
+		}
+		if err != nil {
+			if r.Method == http.MethodPost {
+				s.metrics.invalidSubmission(reasonUnauthenticated)
+			}
+			w.Header().Set("WWW-Authenticate", "Bearer")
+			writeError(w, http.StatusUnauthorized, ErrUnauthenticated)
+			return
+		}
+		h(w, r.WithContext(context.WithValue(r.Context(), identityKey{}, id)))
+	}
+}
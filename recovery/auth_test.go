@@ -0,0 +1,160 @@
+package recovery
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"errors"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	goshamir "github.com/fawwazid/go-shamir"
+	"github.com/fawwazid/go-shamir/store"
+)
+
+// --- Authentication Tests ---
+
+// tokenSubjects stands in for an OIDC verifier.
+var tokenSubjects = map[string]string{"token-alice": "alice", "token-bob": "bob"}
+
+func verifyTestToken(_ context.Context, token string) (string, error) {
+	if sub, ok := tokenSubjects[token]; ok {
+		return sub, nil
+	}
+	return "", errors.New("invalid token")
+}
+
+func tokenClient(url, token string) *Client {
+	return &Client{URL: url, Token: func(context.Context) (string, error) { return token, nil }}
+}
+
+func TestServer_BearerAuthentication(t *testing.T) {
+	ctx := context.Background()
+	srv, ts, recovered := newTestServer(t, WithAuthenticator(BearerAuthenticator(verifyTestToken)))
+	srv.StartCeremony(ctx, "c", 2)
+	shares, _ := goshamir.Split([]byte("authorized"), 3, 2)
+
+	if _, err := (&Client{URL: ts.URL}).Submit(ctx, "c", shares[0]); err == nil || !strings.Contains(err.Error(), "401") {
+		t.Errorf("Expected 401 without a token, got %v", err)
+	}
+	if _, err := tokenClient(ts.URL, "forged").Submit(ctx, "c", shares[0]); err == nil {
+		t.Error("Expected error for an invalid token")
+	}
+	if _, err := tokenClient(ts.URL, "token-alice").Status(ctx, "c"); err != nil {
+		t.Errorf("Status failed: %v", err)
+	}
+
+	if _, err := tokenClient(ts.URL, "token-alice").Submit(ctx, "c", shares[0]); err != nil {
+		t.Fatalf("Submit failed: %v", err)
+	}
+	if _, err := tokenClient(ts.URL, "token-alice").Submit(ctx, "c", shares[1]); err == nil || !strings.Contains(err.Error(), "already submitted") {
+		t.Errorf("Expected second share from the same custodian to be rejected, got %v", err)
+	}
+	status, err := tokenClient(ts.URL, "token-bob").Submit(ctx, "c", shares[2])
+	if err != nil {
+		t.Fatalf("Submit failed: %v", err)
+	}
+	if status.State != StateComplete || string(*recovered) != "authorized" {
+		t.Errorf("Expected completed ceremony, got %+v", status)
+	}
+
+	if body := scrape(t, ts.URL); !strings.Contains(body, `{reason="unauthenticated"} 2`) {
+		t.Error("Expected unauthenticated submissions to be counted")
+	}
+}
+
+func TestServer_ClientCertAuthentication(t *testing.T) {
+	ctx := context.Background()
+	caKey, caCert := newTestCA(t)
+	staging, _ := store.NewSessionStore(store.NewMemoryKV(), time.Minute)
+	var recoveredBy []string
+	auth := ClientCertAuthenticator(func(cert *x509.Certificate) (string, error) {
+		if cert.Subject.OrganizationalUnit[0] != "custodians" {
+			return "", errors.New("not a custodian")
+		}
+		return cert.Subject.CommonName, nil
+	})
+	srv, _ := NewServer(staging, func(ctx context.Context, _ string, _ []byte) error {
+		id, _ := IdentityFromContext(ctx)
+		recoveredBy = append(recoveredBy, id.Subject)
+		return nil
+	}, WithAuthenticator(AnyAuthenticator(BearerAuthenticator(verifyTestToken), auth)))
+	srv.StartCeremony(ctx, "c", 2)
+
+	ts := httptest.NewUnstartedServer(srv)
+	pool := x509.NewCertPool()
+	pool.AddCert(caCert)
+	ts.TLS = &tls.Config{ClientAuth: tls.VerifyClientCertIfGiven, ClientCAs: pool}
+	ts.StartTLS()
+	t.Cleanup(ts.Close)
+
+	mtlsClient := func(cn, ou string) *Client {
+		transport := ts.Client().Transport.(*http.Transport).Clone()
+		transport.TLSClientConfig.Certificates = []tls.Certificate{newTestClientCert(t, caKey, caCert, cn, ou)}
+		return &Client{URL: ts.URL, Client: &http.Client{Transport: transport}}
+	}
+	shares, _ := goshamir.Split([]byte("mtls"), 3, 2)
+
+	if _, err := mtlsClient("mallory", "contractors").Submit(ctx, "c", shares[0]); err == nil {
+		t.Error("Expected unauthorized certificate to be rejected")
+	}
+	if _, err := (&Client{URL: ts.URL, Client: ts.Client()}).Submit(ctx, "c", shares[0]); err == nil {
+		t.Error("Expected request without credentials to be rejected")
+	}
+	if _, err := mtlsClient("carol", "custodians").Submit(ctx, "c", shares[0]); err != nil {
+		t.Fatalf("Submit with certificate failed: %v", err)
+	}
+	bearer := tokenClient(ts.URL, "token-bob")
+	bearer.Client = ts.Client()
+	if _, err := bearer.Submit(ctx, "c", shares[1]); err != nil {
+		t.Fatalf("Submit with token failed: %v", err)
+	}
+	if len(recoveredBy) != 1 || recoveredBy[0] != "bob" {
+		t.Errorf("Expected identity of the final submitter in the callback, got %v", recoveredBy)
+	}
+}
+
+func newTestCA(t *testing.T) (ed25519.PrivateKey, *x509.Certificate) {
+	t.Helper()
+	pub, key, _ := ed25519.GenerateKey(rand.Reader)
+	tmpl := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "custodian CA"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageCertSign,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, pub, key)
+	if err != nil {
+		t.Fatalf("CreateCertificate failed: %v", err)
+	}
+	cert, _ := x509.ParseCertificate(der)
+	return key, cert
+}
+
+func newTestClientCert(t *testing.T, caKey ed25519.PrivateKey, ca *x509.Certificate, cn, ou string) tls.Certificate {
+	t.Helper()
+	pub, key, _ := ed25519.GenerateKey(rand.Reader)
+	tmpl := &x509.Certificate{
+		SerialNumber: big.NewInt(2),
+		Subject:      pkix.Name{CommonName: cn, OrganizationalUnit: []string{ou}},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, ca, pub, caKey)
+	if err != nil {
+		t.Fatalf("CreateCertificate failed: %v", err)
+	}
+	return tls.Certificate{Certificate: [][]byte{der}, PrivateKey: key}
+}
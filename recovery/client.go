@@ -0,0 +1,132 @@
+package recovery
+
+import (
+	"bytes"
+	"context"
+	"crypto/ecdh"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+
+	goshamir "github.com/fawwazid/go-shamir"
+)
+
+// maxResponseSize bounds the size of a server response read by Client.
+const maxResponseSize = 1 << 20
+
+// Client submits shares to a recovery Server on behalf of a custodian.
+type Client struct {
+	// URL is the base URL of the server, such as "https://unseal.example".
+	URL string
+	// Client is the HTTP client to use. Defaults to http.DefaultClient.
+	// For mTLS authentication, configure its transport with the
+	// custodian's client certificate.
+	Client *http.Client
+	// Fingerprint, if set, is the expected KeyFingerprint of the server's
+	// transit key, as announced by the ceremony operator. Submissions fail
+	// if the server presents a different key.
+	Fingerprint string
+	// Attest, if set, produces the device's attestation evidence for a
+	// submission's AttestationChallenge, for servers that require it.
+	Attest func(ctx context.Context, challenge []byte) (*Attestation, error)
+	// Token, if set, returns a bearer token, such as an OIDC ID token,
+	// sent with every request.
+	Token func(ctx context.Context) (string, error)
+}
+
+// TransitKey fetches the server's transit key, checking it against
+// c.Fingerprint if set.
+func (c *Client) TransitKey(ctx context.Context) (*ecdh.PublicKey, error) {
+	var tk TransitKey
+	if err := c.do(ctx, http.MethodGet, "/v1/transit-key", nil, &tk); err != nil {
+		return nil, err
+	}
+	key, err := ecdh.X25519().NewPublicKey(tk.PublicKey)
+	if err != nil {
+		return nil, fmt.Errorf("invalid transit key: %w", err)
+	}
+	if c.Fingerprint != "" && KeyFingerprint(key) != c.Fingerprint {
+		return nil, fmt.Errorf("transit key fingerprint %s does not match expected %s", KeyFingerprint(key), c.Fingerprint)
+	}
+	return key, nil
+}
+
+// Submit encrypts a share to the server's transit key and submits it to
+// the ceremony, returning the ceremony's status afterwards.
+func (c *Client) Submit(ctx context.Context, ceremonyID string, s goshamir.Share) (Status, error) {
+	key, err := c.TransitKey(ctx)
+	if err != nil {
+		return Status{}, err
+	}
+	env, err := EncryptShare(key, ceremonyID, s)
+	if err != nil {
+		return Status{}, err
+	}
+	sub := Submission{Transit: env}
+	if c.Attest != nil {
+		if sub.Attestation, err = c.Attest(ctx, sub.AttestationChallenge(ceremonyID)); err != nil {
+			return Status{}, fmt.Errorf("attestation failed: %w", err)
+		}
+	}
+	var status Status
+	err = c.do(ctx, http.MethodPost, "/v1/ceremonies/"+url.PathEscape(ceremonyID)+"/shares", sub, &status)
+	return status, err
+}
+
+// Status returns the progress of a ceremony.
+func (c *Client) Status(ctx context.Context, ceremonyID string) (Status, error) {
+	var status Status
+	err := c.do(ctx, http.MethodGet, "/v1/ceremonies/"+url.PathEscape(ceremonyID), nil, &status)
+	return status, err
+}
+
+func (c *Client) do(ctx context.Context, method, path string, body, out any) error {
+	var reqBody io.Reader
+	if body != nil {
+		data, err := json.Marshal(body)
+		if err != nil {
+			return err
+		}
+		reqBody = bytes.NewReader(data)
+	}
+	req, err := http.NewRequestWithContext(ctx, method, c.URL+path, reqBody)
+	if err != nil {
+		return err
+	}
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	if c.Token != nil {
+		token, err := c.Token(ctx)
+		if err != nil {
+			return fmt.Errorf("obtaining bearer token failed: %w", err)
+		}
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+	client := c.Client
+	if client == nil {
+		client = http.DefaultClient
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return fmt.Errorf("recovery request failed: %w", err)
+	}
+	defer resp.Body.Close()
+	data, err := io.ReadAll(io.LimitReader(resp.Body, maxResponseSize))
+	if err != nil {
+		return fmt.Errorf("reading recovery response failed: %w", err)
+	}
+
+	if resp.StatusCode >= 300 {
+		var e struct {
+			Error string `json:"error"`
+		}
+		if json.Unmarshal(data, &e) == nil && e.Error != "" {
+			return fmt.Errorf("recovery request failed: %s: %s", resp.Status, e.Error)
+		}
+		return fmt.Errorf("recovery request failed: %s", resp.Status)
+	}
+	return json.Unmarshal(data, out)
+}
@@ -0,0 +1,154 @@
+package recovery
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"slices"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// Reasons a submission is rejected, used as the "reason" label of
+// goshamir_recovery_invalid_submissions_total.
+const (
+	reasonMalformed       = "malformed"
+	reasonDecryption      = "decryption"
+	reasonAttestation     = "attestation"
+	reasonUnauthenticated = "unauthenticated"
+	reasonRateLimited     = "rate_limited"
+	reasonUnknownCeremony = "unknown_ceremony"
+	reasonClosedCeremony  = "closed_ceremony"
+	reasonDuplicate       = "duplicate"
+)
+
+// ceremonyDurationBuckets are the upper bounds, in seconds, of the ceremony
+// duration histogram: one minute to one day.
+var ceremonyDurationBuckets = []float64{60, 300, 900, 1800, 3600, 4 * 3600, 24 * 3600}
+
+// metrics holds the server's Prometheus metrics.
+type metrics struct {
+	mu             sync.Mutex
+	sharesReceived uint64
+	invalid        map[string]uint64
+	combines       map[string]uint64
+	durationCounts []uint64 // per bucket, non-cumulative, with +Inf last
+	durationSum    float64
+	notifyFailures uint64
+	saveFailures   uint64
+}
+
+func newMetrics() *metrics {
+	return &metrics{
+		invalid:        make(map[string]uint64),
+		combines:       make(map[string]uint64),
+		durationCounts: make([]uint64, len(ceremonyDurationBuckets)+1),
+	}
+}
+
+func (m *metrics) shareReceived() {
+	m.mu.Lock()
+	m.sharesReceived++
+	m.mu.Unlock()
+}
+
+func (m *metrics) invalidSubmission(reason string) {
+	m.mu.Lock()
+	m.invalid[reason]++
+	m.mu.Unlock()
+}
+
+func (m *metrics) notificationFailed() {
+	m.mu.Lock()
+	m.notifyFailures++
+	m.mu.Unlock()
+}
+
+func (m *metrics) stateSaveFailed() {
+	m.mu.Lock()
+	m.saveFailures++
+	m.mu.Unlock()
+}
+
+// ceremonyFinished records a reconstruction attempt and the ceremony's
+// duration.
+func (m *metrics) ceremonyFinished(success bool, d time.Duration) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	result := "failure"
+	if success {
+		result = "success"
+	}
+	m.combines[result]++
+	secs := d.Seconds()
+	i, _ := slices.BinarySearch(ceremonyDurationBuckets, secs)
+	m.durationCounts[i]++
+	m.durationSum += secs
+}
+
+// writeTo writes the metrics in the Prometheus text exposition format.
+func (m *metrics) writeTo(w io.Writer, open int) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	fmt.Fprintln(w, "# HELP goshamir_recovery_shares_received_total Shares accepted for recovery ceremonies.")
+	fmt.Fprintln(w, "# TYPE goshamir_recovery_shares_received_total counter")
+	fmt.Fprintf(w, "goshamir_recovery_shares_received_total %d\n", m.sharesReceived)
+
+	fmt.Fprintln(w, "# HELP goshamir_recovery_invalid_submissions_total Share submissions rejected, by reason.")
+	fmt.Fprintln(w, "# TYPE goshamir_recovery_invalid_submissions_total counter")
+	for _, reason := range []string{reasonMalformed, reasonDecryption, reasonAttestation, reasonUnauthenticated, reasonRateLimited, reasonUnknownCeremony, reasonClosedCeremony, reasonDuplicate} {
+		fmt.Fprintf(w, "goshamir_recovery_invalid_submissions_total{reason=%q} %d\n", reason, m.invalid[reason])
+	}
+
+	fmt.Fprintln(w, "# HELP goshamir_recovery_combines_total Reconstruction attempts, by result.")
+	fmt.Fprintln(w, "# TYPE goshamir_recovery_combines_total counter")
+	for _, result := range []string{"success", "failure"} {
+		fmt.Fprintf(w, "goshamir_recovery_combines_total{result=%q} %d\n", result, m.combines[result])
+	}
+
+	fmt.Fprintln(w, "# HELP goshamir_recovery_ceremony_duration_seconds Time from the start of a ceremony to its reconstruction.")
+	fmt.Fprintln(w, "# TYPE goshamir_recovery_ceremony_duration_seconds histogram")
+	var cumulative uint64
+	for i, le := range ceremonyDurationBuckets {
+		cumulative += m.durationCounts[i]
+		fmt.Fprintf(w, "goshamir_recovery_ceremony_duration_seconds_bucket{le=%q} %d\n", strconv.FormatFloat(le, 'g', -1, 64), cumulative)
+	}
+	cumulative += m.durationCounts[len(ceremonyDurationBuckets)]
+	fmt.Fprintf(w, "goshamir_recovery_ceremony_duration_seconds_bucket{le=\"+Inf\"} %d\n", cumulative)
+	fmt.Fprintf(w, "goshamir_recovery_ceremony_duration_seconds_sum %s\n", strconv.FormatFloat(m.durationSum, 'g', -1, 64))
+	fmt.Fprintf(w, "goshamir_recovery_ceremony_duration_seconds_count %d\n", cumulative)
+
+	fmt.Fprintln(w, "# HELP goshamir_recovery_notifications_failed_total Ceremony event notifications that could not be delivered.")
+	fmt.Fprintln(w, "# TYPE goshamir_recovery_notifications_failed_total counter")
+	fmt.Fprintf(w, "goshamir_recovery_notifications_failed_total %d\n", m.notifyFailures)
+
+	fmt.Fprintln(w, "# HELP goshamir_recovery_state_saves_failed_total Ceremony state snapshots that could not be saved.")
+	fmt.Fprintln(w, "# TYPE goshamir_recovery_state_saves_failed_total counter")
+	fmt.Fprintf(w, "goshamir_recovery_state_saves_failed_total %d\n", m.saveFailures)
+
+	fmt.Fprintln(w, "# HELP goshamir_recovery_open_ceremonies Ceremonies currently accepting shares.")
+	fmt.Fprintln(w, "# TYPE goshamir_recovery_open_ceremonies gauge")
+	fmt.Fprintf(w, "goshamir_recovery_open_ceremonies %d\n", open)
+}
+
+// MetricsHandler returns a handler serving the server's metrics in the
+// Prometheus text format. The server also serves it at /metrics; use this
+// to expose metrics on a separate, internal listener instead.
+func (s *Server) MetricsHandler() http.Handler {
+	return http.HandlerFunc(s.handleMetrics)
+}
+
+func (s *Server) handleMetrics(w http.ResponseWriter, _ *http.Request) {
+	s.mu.Lock()
+	open := 0
+	for _, c := range s.ceremonies {
+		if c.state == StateOpen {
+			open++
+		}
+	}
+	s.mu.Unlock()
+	w.Header().Set("Content-Type", "text/plain; version=0.0.4; charset=utf-8")
+	s.metrics.writeTo(w, open)
+}
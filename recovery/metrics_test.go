@@ -0,0 +1,75 @@
+package recovery
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	goshamir "github.com/fawwazid/go-shamir"
+)
+
+// --- Metrics Tests ---
+
+func scrape(t *testing.T, url string) string {
+	t.Helper()
+	resp, err := http.Get(url + "/metrics")
+	if err != nil {
+		t.Fatalf("GET /metrics failed: %v", err)
+	}
+	defer resp.Body.Close()
+	if ct := resp.Header.Get("Content-Type"); !strings.HasPrefix(ct, "text/plain") {
+		t.Errorf("Unexpected content type %q", ct)
+	}
+	body, _ := io.ReadAll(resp.Body)
+	return string(body)
+}
+
+func TestServer_Metrics(t *testing.T) {
+	ctx := context.Background()
+	srv, ts, _ := newTestServer(t)
+	now := time.Unix(1_700_000_000, 0)
+	srv.now = func() time.Time { return now }
+	shares, _ := goshamir.Split([]byte("observed"), 3, 2)
+	client := &Client{URL: ts.URL}
+
+	srv.StartCeremony(ctx, "c", 2)
+	srv.StartCeremony(ctx, "idle", 2)
+	client.Submit(ctx, "c", shares[0])
+	client.Submit(ctx, "c", shares[0])
+	client.Submit(ctx, "missing", shares[0])
+	postJSON(t, ts.URL+"/v1/ceremonies/c/shares", "not a submission")
+	env, _ := EncryptShare(srv.TransitKey(), "elsewhere", shares[1])
+	postJSON(t, ts.URL+"/v1/ceremonies/c/shares", Submission{Transit: env})
+
+	now = now.Add(10 * time.Minute)
+	if _, err := client.Submit(ctx, "c", shares[2]); err != nil {
+		t.Fatalf("Submit failed: %v", err)
+	}
+
+	body := scrape(t, ts.URL)
+	for _, want := range []string{
+		"goshamir_recovery_shares_received_total 2\n",
+		`goshamir_recovery_invalid_submissions_total{reason="duplicate"} 1`,
+		`goshamir_recovery_invalid_submissions_total{reason="unknown_ceremony"} 1`,
+		`goshamir_recovery_invalid_submissions_total{reason="malformed"} 1`,
+		`goshamir_recovery_invalid_submissions_total{reason="decryption"} 1`,
+		`goshamir_recovery_combines_total{result="success"} 1`,
+		`goshamir_recovery_combines_total{result="failure"} 0`,
+		`goshamir_recovery_ceremony_duration_seconds_bucket{le="300"} 0`,
+		`goshamir_recovery_ceremony_duration_seconds_bucket{le="900"} 1`,
+		`goshamir_recovery_ceremony_duration_seconds_bucket{le="+Inf"} 1`,
+		"goshamir_recovery_ceremony_duration_seconds_sum 600\n",
+		"goshamir_recovery_open_ceremonies 1\n",
+		"# TYPE goshamir_recovery_ceremony_duration_seconds histogram",
+	} {
+		if !strings.Contains(body, want) {
+			t.Errorf("Metrics missing %q", want)
+		}
+	}
+	if strings.Contains(body, "observed") {
+		t.Error("Metrics leak secret material")
+	}
+}
@@ -0,0 +1,265 @@
+package recovery
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// EventType identifies a ceremony event.
+type EventType string
+
+const (
+	// EventCeremonyStarted is sent when StartCeremony opens a ceremony.
+	EventCeremonyStarted EventType = "ceremony_started"
+	// EventShareReceived is sent when a share is accepted.
+	EventShareReceived EventType = "share_received"
+	// EventShareOutstanding is sent by Remind for each custodian who has
+	// not yet submitted a share.
+	EventShareOutstanding EventType = "share_outstanding"
+	// EventCeremonyComplete is sent when the secret was reconstructed and
+	// accepted by the RecoverFunc.
+	EventCeremonyComplete EventType = "ceremony_complete"
+	// EventCeremonyFailed is sent when reconstruction or the RecoverFunc
+	// failed.
+	EventCeremonyFailed EventType = "ceremony_failed"
+)
+
+// Event describes something that happened in a ceremony. Events never
+// carry share material.
+type Event struct {
+	Type       EventType `json:"type"`
+	CeremonyID string    `json:"ceremony_id"`
+	Threshold  int       `json:"threshold"`
+	Received   int       `json:"received"`
+	// Custodian is the authenticated subject the event concerns, if any:
+	// the submitter for EventShareReceived and the reminded custodian for
+	// EventShareOutstanding.
+	Custodian string    `json:"custodian,omitempty"`
+	Time      time.Time `json:"time"`
+}
+
+// Text returns a one-line, human-readable description of the event.
+func (e Event) Text() string {
+	switch e.Type {
+	case EventCeremonyStarted:
+		return fmt.Sprintf("Recovery ceremony %q started: %d shares are needed.", e.CeremonyID, e.Threshold)
+	case EventShareReceived:
+		if e.Custodian != "" {
+			return fmt.Sprintf("Recovery ceremony %q received a share from %s (%d of %d).", e.CeremonyID, e.Custodian, e.Received, e.Threshold)
+		}
+		return fmt.Sprintf("Recovery ceremony %q received a share (%d of %d).", e.CeremonyID, e.Received, e.Threshold)
+	case EventShareOutstanding:
+		return fmt.Sprintf("%s: your share is still needed for recovery ceremony %q (%d of %d received).", e.Custodian, e.CeremonyID, e.Received, e.Threshold)
+	case EventCeremonyComplete:
+		return fmt.Sprintf("Recovery ceremony %q is complete.", e.CeremonyID)
+	case EventCeremonyFailed:
+		return fmt.Sprintf("Recovery ceremony %q failed after %d shares.", e.CeremonyID, e.Received)
+	}
+	return fmt.Sprintf("Recovery ceremony %q: %s.", e.CeremonyID, e.Type)
+}
+
+// Notifier delivers ceremony events to custodians and operators.
+type Notifier interface {
+	Notify(ctx context.Context, e Event) error
+}
+
+// NotifierFunc adapts a function to the Notifier interface.
+type NotifierFunc func(ctx context.Context, e Event) error
+
+// Notify calls f(ctx, e).
+func (f NotifierFunc) Notify(ctx context.Context, e Event) error {
+	return f(ctx, e)
+}
+
+// MultiNotifier delivers each event to every notifier, returning the
+// joined errors of those that failed.
+func MultiNotifier(ns ...Notifier) Notifier {
+	return NotifierFunc(func(ctx context.Context, e Event) error {
+		var errs []error
+		for _, n := range ns {
+			if err := n.Notify(ctx, e); err != nil {
+				errs = append(errs, err)
+			}
+		}
+		return errors.Join(errs...)
+	})
+}
+
+// WithNotifier sends ceremony events to n. Notifications are sent
+// synchronously once the triggering operation has finished, so a slow
+// notifier delays the response to the submitting custodian but never
+// holds up other ceremonies. Failed notifications do not fail the
+// operation; they are counted in goshamir_recovery_notifications_failed_total.
+func WithNotifier(n Notifier) Option {
+	return func(s *Server) {
+		s.notifier = n
+	}
+}
+
+// notify sends an event if a notifier is configured.
+func (s *Server) notify(ctx context.Context, e Event) {
+	if s.notifier == nil {
+		return
+	}
+	e.Time = s.now()
+	if err := s.notifier.Notify(context.WithoutCancel(ctx), e); err != nil {
+		s.metrics.notificationFailed()
+	}
+}
+
+// Remind sends EventShareOutstanding for each of the given custodians, by
+// authenticated subject, who has not yet submitted a share to the open
+// ceremony, and returns them. Call it periodically, or use RunReminders.
+// Reminders need an Authenticator to tell custodians apart.
+func (s *Server) Remind(ctx context.Context, id string, custodians []string) ([]string, error) {
+	s.mu.Lock()
+	c, ok := s.ceremonies[id]
+	if !ok {
+		s.mu.Unlock()
+		return nil, ErrUnknownCeremony
+	}
+	if c.state != StateOpen {
+		s.mu.Unlock()
+		return nil, fmt.Errorf("ceremony %q is %s", id, c.state)
+	}
+	var outstanding []string
+	for _, subject := range custodians {
+		if !c.custodians[subject] {
+			outstanding = append(outstanding, subject)
+		}
+	}
+	threshold, received := c.threshold, len(c.received)
+	s.mu.Unlock()
+
+	for _, subject := range outstanding {
+		s.notify(ctx, Event{Type: EventShareOutstanding, CeremonyID: id, Threshold: threshold, Received: received, Custodian: subject})
+	}
+	return outstanding, nil
+}
+
+// RunReminders calls Remind every interval until the ceremony closes or
+// ctx is done. It returns nil once the ceremony has closed.
+func (s *Server) RunReminders(ctx context.Context, id string, custodians []string, interval time.Duration) error {
+	if interval <= 0 {
+		return errors.New("reminder interval must be positive")
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+		if _, err := s.Remind(ctx, id, custodians); err != nil {
+			if status, serr := s.Status(id); serr == nil && status.State != StateOpen {
+				return nil
+			}
+			return err
+		}
+	}
+}
+
+// WebhookNotifier posts each event as JSON to a URL. It suits chat
+// services with incoming webhooks and bridges to Signal and other
+// messengers; the event's Text is included as "text".
+type WebhookNotifier struct {
+	URL string
+	// Client is the HTTP client to use. Defaults to http.DefaultClient.
+	Client *http.Client
+	// Secret, if set, signs each request body with HMAC-SHA256, sent as
+	// "sha256=<hex>" in the X-Goshamir-Signature header.
+	Secret []byte
+}
+
+// Notify implements Notifier.
+func (n *WebhookNotifier) Notify(ctx context.Context, e Event) error {
+	body, err := json.Marshal(struct {
+		Event
+		Text string `json:"text"`
+	}{e, e.Text()})
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, n.URL, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if len(n.Secret) > 0 {
+		mac := hmac.New(sha256.New, n.Secret)
+		mac.Write(body)
+		req.Header.Set("X-Goshamir-Signature", "sha256="+hex.EncodeToString(mac.Sum(nil)))
+	}
+	return sendNotification(n.Client, req, "webhook")
+}
+
+// MatrixNotifier posts each event's Text as a message to a Matrix room
+// through the client-server API.
+type MatrixNotifier struct {
+	// Homeserver is the base URL of the homeserver, such as
+	// "https://matrix.example.org".
+	Homeserver string
+	// RoomID is the room to post to, such as "!abc123:example.org".
+	RoomID      string
+	AccessToken string
+	// Client is the HTTP client to use. Defaults to http.DefaultClient.
+	Client *http.Client
+	// Mention, if set, maps a custodian's subject to their Matrix user ID,
+	// so reminders mention and notify them directly.
+	Mention func(subject string) string
+}
+
+// Notify implements Notifier.
+func (n *MatrixNotifier) Notify(ctx context.Context, e Event) error {
+	msg := map[string]any{"msgtype": "m.text", "body": e.Text()}
+	if e.Custodian != "" && n.Mention != nil {
+		if user := n.Mention(e.Custodian); user != "" {
+			msg["body"] = strings.Replace(e.Text(), e.Custodian, user, 1)
+			msg["m.mentions"] = map[string]any{"user_ids": []string{user}}
+		}
+	}
+	body, err := json.Marshal(msg)
+	if err != nil {
+		return err
+	}
+	var txn [12]byte
+	rand.Read(txn[:])
+	endpoint := strings.TrimSuffix(n.Homeserver, "/") + "/_matrix/client/v3/rooms/" +
+		url.PathEscape(n.RoomID) + "/send/m.room.message/" + hex.EncodeToString(txn[:])
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, endpoint, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", "Bearer "+n.AccessToken)
+	return sendNotification(n.Client, req, "matrix")
+}
+
+func sendNotification(client *http.Client, req *http.Request, kind string) error {
+	if client == nil {
+		client = http.DefaultClient
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return fmt.Errorf("%s notification failed: %w", kind, err)
+	}
+	defer resp.Body.Close()
+	io.Copy(io.Discard, io.LimitReader(resp.Body, maxResponseSize))
+	if resp.StatusCode >= 300 {
+		return fmt.Errorf("%s notification failed: %s", kind, resp.Status)
+	}
+	return nil
+}
@@ -0,0 +1,196 @@
+package recovery
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	goshamir "github.com/fawwazid/go-shamir"
+)
+
+// --- Notifier Tests ---
+
+type eventRecorder struct {
+	mu     sync.Mutex
+	events []Event
+}
+
+func (r *eventRecorder) Notify(_ context.Context, e Event) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.events = append(r.events, e)
+	return nil
+}
+
+func (r *eventRecorder) types() []EventType {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var types []EventType
+	for _, e := range r.events {
+		types = append(types, e.Type)
+	}
+	return types
+}
+
+func TestServer_Notifications(t *testing.T) {
+	ctx := context.Background()
+	rec := &eventRecorder{}
+	srv, ts, _ := newTestServer(t, WithNotifier(rec), WithAuthenticator(BearerAuthenticator(verifyTestToken)), WithPlaintextSubmissions())
+	shares, _ := goshamir.Split([]byte("notify"), 3, 2)
+
+	if err := srv.StartCeremony(ctx, "c", 2); err != nil {
+		t.Fatalf("StartCeremony failed: %v", err)
+	}
+	if _, err := tokenClient(ts.URL, "token-alice").Submit(ctx, "c", shares[0]); err != nil {
+		t.Fatalf("Submit failed: %v", err)
+	}
+
+	outstanding, err := srv.Remind(ctx, "c", []string{"alice", "bob", "carol"})
+	if err != nil {
+		t.Fatalf("Remind failed: %v", err)
+	}
+	if !slices.Equal(outstanding, []string{"bob", "carol"}) {
+		t.Errorf("Expected bob and carol outstanding, got %v", outstanding)
+	}
+
+	if _, err := tokenClient(ts.URL, "token-bob").Submit(ctx, "c", shares[2]); err != nil {
+		t.Fatalf("Submit failed: %v", err)
+	}
+	want := []EventType{EventCeremonyStarted, EventShareReceived, EventShareOutstanding, EventShareOutstanding, EventShareReceived, EventCeremonyComplete}
+	if got := rec.types(); !slices.Equal(got, want) {
+		t.Fatalf("Expected events %v, got %v", want, got)
+	}
+	if e := rec.events[1]; e.Custodian != "alice" || e.Received != 1 || e.Threshold != 2 || e.Time.IsZero() {
+		t.Errorf("Unexpected share event %+v", e)
+	}
+	if e := rec.events[3]; e.Custodian != "carol" || !strings.Contains(e.Text(), "still needed") {
+		t.Errorf("Unexpected reminder %+v: %s", e, e.Text())
+	}
+	if _, err := srv.Remind(ctx, "c", []string{"carol"}); err == nil {
+		t.Error("Expected error reminding for a closed ceremony")
+	}
+}
+
+func TestServer_NotificationFailures(t *testing.T) {
+	ctx := context.Background()
+	failing := NotifierFunc(func(context.Context, Event) error { return errors.New("unreachable") })
+	srv, ts, _ := newTestServer(t, WithNotifier(failing))
+	if err := srv.StartCeremony(ctx, "c", 2); err != nil {
+		t.Fatalf("StartCeremony failed: %v", err)
+	}
+	if !strings.Contains(scrape(t, ts.URL), "goshamir_recovery_notifications_failed_total 1\n") {
+		t.Error("Expected failed notification to be counted")
+	}
+}
+
+func TestServer_RunReminders(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	rec := &eventRecorder{}
+	srv, _, _ := newTestServer(t, WithNotifier(rec))
+	srv.StartCeremony(ctx, "c", 2)
+
+	done := make(chan error, 1)
+	go func() { done <- srv.RunReminders(ctx, "c", []string{"alice"}, time.Millisecond) }()
+	for slices.Index(rec.types(), EventShareOutstanding) < 0 {
+		time.Sleep(time.Millisecond)
+	}
+	srv.mu.Lock()
+	srv.ceremonies["c"].state = StateFailed
+	srv.mu.Unlock()
+	if err := <-done; err != nil {
+		t.Errorf("Expected RunReminders to stop cleanly, got %v", err)
+	}
+}
+
+func TestWebhookNotifier(t *testing.T) {
+	var body []byte
+	var signature string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ = io.ReadAll(r.Body)
+		signature = r.Header.Get("X-Goshamir-Signature")
+	}))
+	defer ts.Close()
+
+	n := &WebhookNotifier{URL: ts.URL, Secret: []byte("hook secret")}
+	e := Event{Type: EventCeremonyStarted, CeremonyID: "c", Threshold: 3}
+	if err := n.Notify(context.Background(), e); err != nil {
+		t.Fatalf("Notify failed: %v", err)
+	}
+	var got struct {
+		Type EventType `json:"type"`
+		Text string    `json:"text"`
+	}
+	if err := json.Unmarshal(body, &got); err != nil || got.Type != EventCeremonyStarted || got.Text != e.Text() {
+		t.Errorf("Unexpected webhook body %s", body)
+	}
+	mac := hmac.New(sha256.New, []byte("hook secret"))
+	mac.Write(body)
+	if signature != "sha256="+hex.EncodeToString(mac.Sum(nil)) {
+		t.Errorf("Invalid signature %q", signature)
+	}
+
+	ts.Config.Handler = http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	})
+	if err := n.Notify(context.Background(), e); err == nil {
+		t.Error("Expected error for failing webhook")
+	}
+}
+
+func TestMatrixNotifier(t *testing.T) {
+	var path, auth string
+	var msg map[string]any
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPut {
+			t.Errorf("Unexpected method %s", r.Method)
+		}
+		path, auth = r.URL.EscapedPath(), r.Header.Get("Authorization")
+		json.NewDecoder(r.Body).Decode(&msg)
+		io.WriteString(w, `{"event_id":"$1"}`)
+	}))
+	defer ts.Close()
+
+	n := &MatrixNotifier{
+		Homeserver:  ts.URL + "/",
+		RoomID:      "!room:example.org",
+		AccessToken: "syt_token",
+		Mention:     func(subject string) string { return "@" + subject + ":example.org" },
+	}
+	e := Event{Type: EventShareOutstanding, CeremonyID: "c", Threshold: 2, Custodian: "bob"}
+	if err := n.Notify(context.Background(), e); err != nil {
+		t.Fatalf("Notify failed: %v", err)
+	}
+	if !strings.HasPrefix(path, "/_matrix/client/v3/rooms/%21room:example.org/send/m.room.message/") {
+		t.Errorf("Unexpected path %s", path)
+	}
+	if auth != "Bearer syt_token" {
+		t.Errorf("Unexpected authorization %q", auth)
+	}
+	if body, _ := msg["body"].(string); msg["msgtype"] != "m.text" || !strings.HasPrefix(body, "@bob:example.org: your share") {
+		t.Errorf("Unexpected message %v", msg)
+	}
+	if msg["m.mentions"] == nil {
+		t.Error("Expected the custodian to be mentioned")
+	}
+}
+
+func TestMultiNotifier(t *testing.T) {
+	a, b := &eventRecorder{}, &eventRecorder{}
+	failing := NotifierFunc(func(context.Context, Event) error { return errors.New("down") })
+	err := MultiNotifier(a, failing, b).Notify(context.Background(), Event{Type: EventCeremonyComplete})
+	if err == nil || len(a.events) != 1 || len(b.events) != 1 {
+		t.Errorf("Expected delivery to all notifiers and an error, got %v", err)
+	}
+}
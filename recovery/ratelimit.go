@@ -0,0 +1,172 @@
+package recovery
+
+import (
+	"net"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// maxRateLimitSources bounds the number of sources tracked before idle
+// entries are swept.
+const maxRateLimitSources = 10000
+
+// defaultFailureDecay is the FailureDecay used when it is zero.
+const defaultFailureDecay = time.Hour
+
+// RateLimit configures throttling of share submissions, to slow online
+// guessing and abuse of the reconstruction endpoint. A zero field disables
+// the corresponding limit.
+type RateLimit struct {
+	// Attempts is the number of submissions a source may make per Window.
+	Attempts int
+	Window   time.Duration
+	// MaxFailures is the number of consecutive rejected submissions after
+	// which a source is locked out for Lockout. An accepted submission
+	// resets the count.
+	MaxFailures int
+	Lockout     time.Duration
+	// FailureDecay is how long a source's failures are remembered after
+	// its last one, so that a source cannot reset its count by pausing
+	// between attempts. Defaults to an hour.
+	FailureDecay time.Duration
+	// Source identifies the caller. Defaults to the remote IP address;
+	// behind a proxy, derive it from a trusted forwarding header instead.
+	Source func(r *http.Request) string
+}
+
+// WithRateLimit throttles share submissions per source. Throttled
+// requests are rejected with 429 Too Many Requests and a Retry-After
+// header.
+func WithRateLimit(rl RateLimit) Option {
+	return func(s *Server) {
+		if rl.Source == nil {
+			rl.Source = remoteIP
+		}
+		if rl.FailureDecay == 0 {
+			rl.FailureDecay = defaultFailureDecay
+		}
+		s.limiter = &limiter{config: rl, sources: make(map[string]*sourceState)}
+	}
+}
+
+func remoteIP(r *http.Request) string {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr
+	}
+	return host
+}
+
+type limiter struct {
+	config RateLimit
+
+	mu      sync.Mutex
+	sources map[string]*sourceState
+}
+
+type sourceState struct {
+	windowStart time.Time
+	attempts    int
+	failures    int
+	lastFailure time.Time
+	lockedUntil time.Time
+}
+
+// allow records an attempt by source, returning how long to wait if it is
+// throttled.
+func (l *limiter) allow(source string, now time.Time) (time.Duration, bool) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	st, ok := l.sources[source]
+	if !ok {
+		if len(l.sources) >= maxRateLimitSources {
+			l.sweep(now)
+		}
+		st = &sourceState{windowStart: now}
+		l.sources[source] = st
+	}
+
+	if now.Before(st.lockedUntil) {
+		return st.lockedUntil.Sub(now), false
+	}
+	if now.Sub(st.windowStart) >= l.config.Window {
+		st.windowStart, st.attempts = now, 0
+	}
+	if l.config.Attempts > 0 {
+		if st.attempts >= l.config.Attempts {
+			return st.windowStart.Add(l.config.Window).Sub(now), false
+		}
+		st.attempts++
+	}
+	return 0, true
+}
+
+// result records whether an allowed attempt by source succeeded, locking
+// the source out after too many consecutive failures.
+func (l *limiter) result(source string, success bool, now time.Time) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	st, ok := l.sources[source]
+	if !ok {
+		return
+	}
+	if success {
+		st.failures = 0
+		return
+	}
+	st.failures++
+	st.lastFailure = now
+	if l.config.MaxFailures > 0 && st.failures >= l.config.MaxFailures {
+		st.lockedUntil = now.Add(l.config.Lockout)
+		st.failures = 0
+	}
+}
+
+// sweep forgets sources whose window and lockout have passed and whose
+// failures, if any, are older than FailureDecay, so that sources which fail
+// and go away cannot fill the map. l.mu must be held.
+func (l *limiter) sweep(now time.Time) {
+	for source, st := range l.sources {
+		if now.Sub(st.windowStart) < l.config.Window || now.Before(st.lockedUntil) {
+			continue
+		}
+		if st.failures > 0 && now.Sub(st.lastFailure) < l.config.FailureDecay {
+			continue
+		}
+		delete(l.sources, source)
+	}
+}
+
+// statusRecorder captures the status code written by a handler.
+type statusRecorder struct {
+	http.ResponseWriter
+	code int
+}
+
+func (r *statusRecorder) WriteHeader(code int) {
+	r.code = code
+	r.ResponseWriter.WriteHeader(code)
+}
+
+// rateLimited wraps h with the server's rate limit, if any. Responses with
+// a 4xx status count as failed attempts.
+func (s *Server) rateLimited(h http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if s.limiter == nil {
+			h(w, r)
+			return
+		}
+		source := s.limiter.config.Source(r)
+		if wait, ok := s.limiter.allow(source, s.now()); !ok {
+			s.metrics.invalidSubmission(reasonRateLimited)
+			w.Header().Set("Retry-After", strconv.Itoa(int((wait+time.Second-1)/time.Second)))
+			writeError(w, http.StatusTooManyRequests, errTooManyAttempts)
+			return
+		}
+		rec := &statusRecorder{ResponseWriter: w, code: http.StatusOK}
+		h(rec, r)
+		s.limiter.result(source, rec.code < 400 || rec.code >= 500, s.now())
+	}
+}
@@ -0,0 +1,150 @@
+package recovery
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	goshamir "github.com/fawwazid/go-shamir"
+)
+
+// --- Rate Limit Tests ---
+
+func TestServer_RateLimit(t *testing.T) {
+	ctx := context.Background()
+	srv, ts, _ := newTestServer(t, WithRateLimit(RateLimit{Attempts: 3, Window: time.Minute}))
+	now := time.Unix(1_700_000_000, 0)
+	srv.now = func() time.Time { return now }
+	srv.StartCeremony(ctx, "c", 3)
+	shares, _ := goshamir.Split([]byte("throttled"), 5, 3)
+
+	for i := range 3 {
+		if resp := postJSON(t, ts.URL+"/v1/ceremonies/c/shares", "garbage"); resp.StatusCode != http.StatusBadRequest {
+			t.Fatalf("Attempt %d: expected 400, got %s", i, resp.Status)
+		}
+	}
+	resp := postJSON(t, ts.URL+"/v1/ceremonies/c/shares", "garbage")
+	if resp.StatusCode != http.StatusTooManyRequests {
+		t.Fatalf("Expected 429, got %s", resp.Status)
+	}
+	if resp.Header.Get("Retry-After") != "60" {
+		t.Errorf("Expected Retry-After 60, got %q", resp.Header.Get("Retry-After"))
+	}
+	if _, err := (&Client{URL: ts.URL}).Submit(ctx, "c", shares[0]); err == nil || !strings.Contains(err.Error(), "429") {
+		t.Errorf("Expected valid submission to be throttled too, got %v", err)
+	}
+
+	now = now.Add(time.Minute)
+	if _, err := (&Client{URL: ts.URL}).Submit(ctx, "c", shares[0]); err != nil {
+		t.Errorf("Submit after the window failed: %v", err)
+	}
+	if body := scrape(t, ts.URL); !strings.Contains(body, `{reason="rate_limited"} 2`) {
+		t.Error("Expected throttled submissions to be counted")
+	}
+}
+
+func TestServer_Lockout(t *testing.T) {
+	ctx := context.Background()
+	srv, ts, _ := newTestServer(t,
+		WithAuthenticator(BearerAuthenticator(verifyTestToken)),
+		WithRateLimit(RateLimit{MaxFailures: 3, Lockout: 10 * time.Minute}))
+	now := time.Unix(1_700_000_000, 0)
+	srv.now = func() time.Time { return now }
+	srv.StartCeremony(ctx, "c", 2)
+	shares, _ := goshamir.Split([]byte("locked"), 3, 2)
+
+	// Guessing tokens counts as failures.
+	for _, token := range []string{"guess-1", "guess-2", "guess-3"} {
+		tokenClient(ts.URL, token).Submit(ctx, "c", shares[0])
+	}
+	if _, err := tokenClient(ts.URL, "token-alice").Submit(ctx, "c", shares[0]); err == nil || !strings.Contains(err.Error(), "429") {
+		t.Fatalf("Expected source to be locked out, got %v", err)
+	}
+
+	now = now.Add(10 * time.Minute)
+	if _, err := tokenClient(ts.URL, "token-alice").Submit(ctx, "c", shares[0]); err != nil {
+		t.Fatalf("Submit after lockout failed: %v", err)
+	}
+	// An accepted submission resets the failure count.
+	tokenClient(ts.URL, "guess-4").Submit(ctx, "c", shares[1])
+	tokenClient(ts.URL, "guess-5").Submit(ctx, "c", shares[1])
+	if _, err := tokenClient(ts.URL, "token-bob").Submit(ctx, "c", shares[1]); err != nil {
+		t.Errorf("Expected submission before reaching MaxFailures to succeed, got %v", err)
+	}
+}
+
+func TestLimiter_SourcesAndSweep(t *testing.T) {
+	l := &limiter{config: RateLimit{Attempts: 1, Window: time.Minute}, sources: make(map[string]*sourceState)}
+	now := time.Unix(0, 0)
+	if _, ok := l.allow("a", now); !ok {
+		t.Fatal("Expected first attempt to be allowed")
+	}
+	if _, ok := l.allow("b", now); !ok {
+		t.Error("Expected sources to be limited independently")
+	}
+	if wait, ok := l.allow("a", now.Add(20*time.Second)); ok || wait != 40*time.Second {
+		t.Errorf("Expected 40s wait, got %v, %v", wait, ok)
+	}
+	l.sweep(now.Add(time.Minute))
+	if len(l.sources) != 0 {
+		t.Errorf("Expected idle sources to be swept, %d remain", len(l.sources))
+	}
+}
+
+func TestLimiter_SweepsFailedSources(t *testing.T) {
+	l := &limiter{config: RateLimit{Attempts: 5, Window: time.Minute, MaxFailures: 3, Lockout: time.Hour, FailureDecay: 2 * time.Hour}, sources: make(map[string]*sourceState)}
+	now := time.Unix(0, 0)
+	l.allow("failing", now)
+	l.result("failing", false, now)
+	for range 3 {
+		l.allow("locked", now)
+		l.result("locked", false, now)
+	}
+
+	l.sweep(now.Add(time.Minute))
+	if _, ok := l.sources["failing"]; !ok {
+		t.Error("Expected a source with recent failures to be kept")
+	}
+	if _, ok := l.sources["locked"]; !ok {
+		t.Error("Expected a locked out source to be kept")
+	}
+	l.sweep(now.Add(time.Hour))
+	if _, ok := l.sources["locked"]; ok {
+		t.Error("Expected a source to be swept after its lockout")
+	}
+	l.sweep(now.Add(2 * time.Hour))
+	if len(l.sources) != 0 {
+		t.Errorf("Expected failures to be forgotten after FailureDecay, %d sources remain", len(l.sources))
+	}
+}
+
+func TestLimiter_FailuresSurviveSweep(t *testing.T) {
+	l := &limiter{config: RateLimit{Attempts: 5, Window: time.Minute, MaxFailures: 3, Lockout: time.Hour, FailureDecay: time.Hour}, sources: make(map[string]*sourceState)}
+	now := time.Unix(0, 0)
+	// The source waits out each window, so a sweep runs between its
+	// failures, yet the third failure still locks it out.
+	for i := range 3 {
+		at := now.Add(time.Duration(i) * 2 * time.Minute)
+		l.sweep(at)
+		if _, ok := l.allow("guesser", at); !ok {
+			t.Fatalf("Expected attempt %d to be allowed", i+1)
+		}
+		l.result("guesser", false, at)
+	}
+	if _, ok := l.allow("guesser", now.Add(5*time.Minute)); ok {
+		t.Error("Expected the source to be locked out")
+	}
+}
+
+func TestLimiter_WindowResetsWithoutAttemptLimit(t *testing.T) {
+	l := &limiter{config: RateLimit{Window: time.Minute, MaxFailures: 3, Lockout: time.Hour}, sources: make(map[string]*sourceState)}
+	now := time.Unix(0, 0)
+	l.allow("a", now)
+	l.allow("a", now.Add(90*time.Second))
+	l.sweep(now.Add(2 * time.Minute))
+	if _, ok := l.sources["a"]; !ok {
+		t.Error("Expected a source active in the current window to be kept")
+	}
+}
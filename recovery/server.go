@@ -0,0 +1,402 @@
+// Package recovery coordinates network recovery ceremonies. A Server runs
+// in the process that needs a secret: an operator starts a ceremony,
+// custodians submit their shares over HTTP, and once the threshold is met
+// the server combines them and hands the secret to a callback. The secret
+// itself is never returned over the network.
+//
+// Shares are encrypted end to end from each custodian to the server
+// process with ephemeral X25519 keys (see TransitEnvelope), so they stay
+// confidential even where TLS is terminated in front of the server.
+package recovery
+
+import (
+	"context"
+	"crypto/ecdh"
+	"crypto/rand"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+	"sync"
+	"time"
+
+	goshamir "github.com/fawwazid/go-shamir"
+	"github.com/fawwazid/go-shamir/store"
+)
+
+// maxSubmissionSize bounds the size of a share submission request body.
+const maxSubmissionSize = 1 << 20
+
+// ErrUnknownCeremony is returned for operations on a ceremony that was
+// never started.
+var ErrUnknownCeremony = errors.New("unknown recovery ceremony")
+
+var errTooManyAttempts = errors.New("too many submission attempts")
+
+// RecoverFunc receives the secret reconstructed by a ceremony. The secret
+// is wiped when the function returns, so it must copy anything it keeps.
+// Returning an error marks the ceremony as failed. With an Authenticator,
+// ctx carries the Identity of the custodian whose share completed the
+// quorum.
+type RecoverFunc func(ctx context.Context, ceremonyID string, secret []byte) error
+
+// State is the state of a recovery ceremony.
+type State string
+
+const (
+	// StateOpen means the ceremony is accepting shares.
+	StateOpen State = "open"
+	// StateComplete means the secret was reconstructed and delivered.
+	StateComplete State = "complete"
+	// StateFailed means reconstruction or the RecoverFunc failed.
+	StateFailed State = "failed"
+)
+
+// Status describes a ceremony's progress, as served to custodians.
+type Status struct {
+	ID        string  `json:"id"`
+	Threshold int     `json:"threshold"`
+	Received  []uint8 `json:"received"`
+	State     State   `json:"state"`
+}
+
+// Submission is the body of a share submission. Exactly one of Transit and
+// Share must be set; plaintext shares are only accepted by servers created
+// with WithPlaintextSubmissions.
+type Submission struct {
+	Transit *TransitEnvelope `json:"transit,omitempty"`
+	// Share is a share in the hex format of goshamir.EncodeSharesToHex.
+	Share string `json:"share,omitempty"`
+	// Attestation is required by servers created with WithAttestation.
+	Attestation *Attestation `json:"attestation,omitempty"`
+}
+
+// TransitKey is the body served at /v1/transit-key.
+type TransitKey struct {
+	PublicKey   []byte `json:"public_key"`
+	Fingerprint string `json:"fingerprint"`
+}
+
+// Server is an http.Handler running recovery ceremonies. It serves:
+//
+//	GET  /v1/transit-key             the server's X25519 transit key
+//	GET  /v1/ceremonies/{id}         the ceremony's Status
+//	POST /v1/ceremonies/{id}/shares  submit a share (a Submission)
+//	GET  /metrics                    Prometheus metrics
+//
+// With WithNotifier, ceremony events are sent to custodians and operators.
+// Submissions are processed one at a time, so the RecoverFunc runs exactly
+// once per ceremony.
+type Server struct {
+	store      store.ShareStore
+	onRecover  RecoverFunc
+	transitKey *ecdh.PrivateKey
+	plaintext  bool
+	verifier   AttestationVerifier
+	auth       Authenticator
+	limiter    *limiter
+	notifier   Notifier
+	state      StateStore
+	stateKey   []byte
+	mux        *http.ServeMux
+	metrics    *metrics
+	// now is time.Now, replaceable in tests.
+	now func() time.Time
+
+	mu         sync.Mutex
+	ceremonies map[string]*ceremony
+}
+
+type ceremony struct {
+	threshold int
+	started   time.Time
+	received  []uint8
+	state     State
+	// devices and custodians hold the attested devices and authenticated
+	// custodians that have submitted a share.
+	devices    map[string]bool
+	custodians map[string]bool
+}
+
+// Option configures a Server.
+type Option func(*Server)
+
+// WithPlaintextSubmissions lets custodians submit hex-encoded shares
+// without transit encryption, relying on TLS alone. It is meant for
+// deployments where the server terminates TLS itself.
+func WithPlaintextSubmissions() Option {
+	return func(s *Server) {
+		s.plaintext = true
+	}
+}
+
+// NewServer returns a Server staging submitted shares in st and passing
+// reconstructed secrets to onRecover. A transit key pair is generated for
+// the lifetime of the server; custodians should check its fingerprint
+// against the one the operator announces.
+func NewServer(st store.ShareStore, onRecover RecoverFunc, opts ...Option) (*Server, error) {
+	if st == nil {
+		return nil, errors.New("share store cannot be nil")
+	}
+	if onRecover == nil {
+		return nil, errors.New("recover function cannot be nil")
+	}
+	key, err := ecdh.X25519().GenerateKey(rand.Reader)
+	if err != nil {
+		return nil, fmt.Errorf("transit key generation failed: %w", err)
+	}
+	s := &Server{
+		store:      st,
+		onRecover:  onRecover,
+		transitKey: key,
+		metrics:    newMetrics(),
+		now:        time.Now,
+		ceremonies: make(map[string]*ceremony),
+	}
+	for _, opt := range opts {
+		if opt != nil {
+			opt(s)
+		}
+	}
+	if s.state != nil && len(s.stateKey) != StateKeySize {
+		return nil, fmt.Errorf("state key must be %d bytes", StateKeySize)
+	}
+
+	s.mux = http.NewServeMux()
+	s.mux.HandleFunc("GET /v1/transit-key", s.handleTransitKey)
+	s.mux.HandleFunc("GET /v1/ceremonies/{id}", s.authenticated(s.handleStatus))
+	s.mux.HandleFunc("POST /v1/ceremonies/{id}/shares", s.rateLimited(s.authenticated(s.handleSubmit)))
+	s.mux.HandleFunc("GET /metrics", s.handleMetrics)
+	return s, nil
+}
+
+// TransitKey returns the server's transit public key.
+func (s *Server) TransitKey() *ecdh.PublicKey {
+	return s.transitKey.PublicKey()
+}
+
+// StartCeremony opens a ceremony that reconstructs once threshold shares
+// have been submitted. Restarting a finished ceremony discards its state.
+func (s *Server) StartCeremony(ctx context.Context, id string, threshold int) error {
+	if id == "" {
+		return errors.New("ceremony ID cannot be empty")
+	}
+	if threshold < 2 || threshold > 255 {
+		return fmt.Errorf("invalid threshold %d", threshold)
+	}
+	s.mu.Lock()
+	if c, ok := s.ceremonies[id]; ok && c.state == StateOpen {
+		s.mu.Unlock()
+		return fmt.Errorf("ceremony %q is already open", id)
+	}
+	if err := s.store.Delete(ctx, id); err != nil {
+		s.mu.Unlock()
+		return err
+	}
+	c := &ceremony{threshold: threshold, started: s.now(), state: StateOpen, devices: make(map[string]bool), custodians: make(map[string]bool)}
+	if err := s.persist(ctx, id, c); err != nil {
+		s.mu.Unlock()
+		return err
+	}
+	s.ceremonies[id] = c
+	s.mu.Unlock()
+
+	s.notify(ctx, Event{Type: EventCeremonyStarted, CeremonyID: id, Threshold: threshold})
+	return nil
+}
+
+// Status returns the progress of a ceremony.
+func (s *Server) Status(id string) (Status, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	c, ok := s.ceremonies[id]
+	if !ok {
+		return Status{}, ErrUnknownCeremony
+	}
+	return c.status(id), nil
+}
+
+func (c *ceremony) status(id string) Status {
+	return Status{ID: id, Threshold: c.threshold, Received: slices.Clone(c.received), State: c.state}
+}
+
+// ServeHTTP implements http.Handler.
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mux.ServeHTTP(w, r)
+}
+
+func (s *Server) handleTransitKey(w http.ResponseWriter, _ *http.Request) {
+	pub := s.TransitKey()
+	writeJSON(w, http.StatusOK, TransitKey{PublicKey: pub.Bytes(), Fingerprint: KeyFingerprint(pub)})
+}
+
+func (s *Server) handleStatus(w http.ResponseWriter, r *http.Request) {
+	status, err := s.Status(r.PathValue("id"))
+	if err != nil {
+		writeError(w, http.StatusNotFound, err)
+		return
+	}
+	writeJSON(w, http.StatusOK, status)
+}
+
+func (s *Server) handleSubmit(w http.ResponseWriter, r *http.Request) {
+	id := r.PathValue("id")
+	var sub Submission
+	if err := json.NewDecoder(http.MaxBytesReader(w, r.Body, maxSubmissionSize)).Decode(&sub); err != nil {
+		s.metrics.invalidSubmission(reasonMalformed)
+		writeError(w, http.StatusBadRequest, errors.New("malformed submission"))
+		return
+	}
+	var device string
+	if s.verifier != nil {
+		var err error
+		if device, err = s.verifyAttestation(r.Context(), id, sub); err != nil {
+			s.metrics.invalidSubmission(reasonAttestation)
+			writeError(w, http.StatusForbidden, err)
+			return
+		}
+	}
+	share, err := s.decodeSubmission(id, sub)
+	if err != nil {
+		reason := reasonMalformed
+		if errors.Is(err, ErrTransitDecryption) {
+			reason = reasonDecryption
+		}
+		s.metrics.invalidSubmission(reason)
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	status, code, err := s.accept(r.Context(), id, share, device)
+	if code != http.StatusAccepted && code != http.StatusOK && code != http.StatusUnprocessableEntity {
+		writeError(w, code, err)
+		return
+	}
+	custodian, _ := IdentityFromContext(r.Context())
+	s.notify(r.Context(), Event{Type: EventShareReceived, CeremonyID: id, Threshold: status.Threshold, Received: len(status.Received), Custodian: custodian.Subject})
+	switch status.State {
+	case StateComplete:
+		s.notify(r.Context(), Event{Type: EventCeremonyComplete, CeremonyID: id, Threshold: status.Threshold, Received: len(status.Received)})
+	case StateFailed:
+		s.notify(r.Context(), Event{Type: EventCeremonyFailed, CeremonyID: id, Threshold: status.Threshold, Received: len(status.Received)})
+	}
+	if err != nil {
+		writeError(w, code, err)
+		return
+	}
+	writeJSON(w, code, status)
+}
+
+func (s *Server) decodeSubmission(id string, sub Submission) (goshamir.Share, error) {
+	switch {
+	case sub.Transit != nil && sub.Share == "":
+		return decryptShare(s.transitKey, id, sub.Transit)
+	case sub.Share != "" && sub.Transit == nil:
+		if !s.plaintext {
+			return goshamir.Share{}, errors.New("shares must be submitted with transit encryption")
+		}
+		shares, err := goshamir.DecodeSharesFromHex([]string{sub.Share})
+		if err != nil {
+			return goshamir.Share{}, err
+		}
+		return shares[0], nil
+	default:
+		return goshamir.Share{}, errors.New("submission must contain exactly one share")
+	}
+}
+
+// accept stages a share submitted from device, which is empty unless
+// attestation is required, and once the threshold is reached reconstructs
+// the secret. It returns the resulting status and HTTP status code. If ctx
+// carries an authenticated Identity, each custodian may submit only once.
+func (s *Server) accept(ctx context.Context, id string, share goshamir.Share, device string) (Status, int, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	c, ok := s.ceremonies[id]
+	if !ok {
+		s.metrics.invalidSubmission(reasonUnknownCeremony)
+		return Status{}, http.StatusNotFound, ErrUnknownCeremony
+	}
+	if c.state != StateOpen {
+		s.metrics.invalidSubmission(reasonClosedCeremony)
+		return Status{}, http.StatusConflict, fmt.Errorf("ceremony %q is %s", id, c.state)
+	}
+	if device != "" && c.devices[device] {
+		s.metrics.invalidSubmission(reasonAttestation)
+		return Status{}, http.StatusForbidden, fmt.Errorf("%w: device already submitted a share", ErrAttestation)
+	}
+	custodian, authenticated := IdentityFromContext(ctx)
+	if authenticated && c.custodians[custodian.Subject] {
+		s.metrics.invalidSubmission(reasonDuplicate)
+		return Status{}, http.StatusConflict, fmt.Errorf("custodian %q already submitted a share", custodian.Subject)
+	}
+	if err := s.store.Put(ctx, id, share); errors.Is(err, store.ErrExists) {
+		s.metrics.invalidSubmission(reasonDuplicate)
+		return Status{}, http.StatusConflict, fmt.Errorf("share %d was already submitted", share.Index)
+	} else if err != nil {
+		return Status{}, http.StatusInternalServerError, err
+	}
+	s.metrics.shareReceived()
+	c.received = append(c.received, share.Index)
+	slices.Sort(c.received)
+	if device != "" {
+		c.devices[device] = true
+	}
+	if authenticated {
+		c.custodians[custodian.Subject] = true
+	}
+
+	if len(c.received) < c.threshold {
+		s.save(ctx, id, c)
+		return c.status(id), http.StatusAccepted, nil
+	}
+	err := s.reconstruct(ctx, id, c)
+	s.metrics.ceremonyFinished(err == nil, s.now().Sub(c.started))
+	if err != nil {
+		c.state = StateFailed
+		s.save(ctx, id, c)
+		return c.status(id), http.StatusUnprocessableEntity, err
+	}
+	c.state = StateComplete
+	s.save(ctx, id, c)
+	return c.status(id), http.StatusOK, nil
+}
+
+// save persists the ceremony's snapshot, counting failures rather than
+// failing the submission. s.mu must be held.
+func (s *Server) save(ctx context.Context, id string, c *ceremony) {
+	if err := s.persist(context.WithoutCancel(ctx), id, c); err != nil {
+		s.metrics.stateSaveFailed()
+	}
+}
+
+// reconstruct combines the staged shares, hands the secret to the
+// RecoverFunc and removes the shares from the store.
+func (s *Server) reconstruct(ctx context.Context, id string, c *ceremony) error {
+	defer s.store.Delete(context.WithoutCancel(ctx), id)
+
+	shares, err := s.store.List(ctx, id)
+	if err != nil {
+		return err
+	}
+	secret, err := goshamir.Combine(shares, c.threshold)
+	if err != nil {
+		return fmt.Errorf("reconstruction failed: %w", err)
+	}
+	defer clear(secret)
+	if err := s.onRecover(ctx, id, secret); err != nil {
+		return fmt.Errorf("recovered secret was rejected: %w", err)
+	}
+	return nil
+}
+
+func writeJSON(w http.ResponseWriter, code int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	json.NewEncoder(w).Encode(v)
+}
+
+func writeError(w http.ResponseWriter, code int, err error) {
+	writeJSON(w, code, map[string]string{"error": err.Error()})
+}
@@ -0,0 +1,188 @@
+package recovery
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	goshamir "github.com/fawwazid/go-shamir"
+	"github.com/fawwazid/go-shamir/store"
+)
+
+// --- Server Tests ---
+
+// newTestServer returns a running server and a pointer to the last secret
+// it recovered.
+func newTestServer(t *testing.T, opts ...Option) (*Server, *httptest.Server, *[]byte) {
+	t.Helper()
+	staging, _ := store.NewSessionStore(store.NewMemoryKV(), time.Minute)
+	var recovered []byte
+	srv, err := NewServer(staging, func(_ context.Context, _ string, secret []byte) error {
+		recovered = bytes.Clone(secret)
+		return nil
+	}, opts...)
+	if err != nil {
+		t.Fatalf("NewServer failed: %v", err)
+	}
+	ts := httptest.NewServer(srv)
+	t.Cleanup(ts.Close)
+	return srv, ts, &recovered
+}
+
+func TestServer_Ceremony(t *testing.T) {
+	ctx := context.Background()
+	srv, ts, recovered := newTestServer(t)
+	shares, _ := goshamir.Split([]byte("unseal key"), 5, 3)
+	if err := srv.StartCeremony(ctx, "unseal", 3); err != nil {
+		t.Fatalf("StartCeremony failed: %v", err)
+	}
+
+	client := &Client{URL: ts.URL, Fingerprint: KeyFingerprint(srv.TransitKey())}
+	for i, idx := range []int{4, 1} {
+		status, err := client.Submit(ctx, "unseal", shares[idx])
+		if err != nil {
+			t.Fatalf("Submit failed: %v", err)
+		}
+		if status.State != StateOpen || len(status.Received) != i+1 {
+			t.Errorf("Unexpected status %+v", status)
+		}
+	}
+	if _, err := client.Submit(ctx, "unseal", shares[1]); err == nil || !strings.Contains(err.Error(), "already submitted") {
+		t.Errorf("Expected duplicate submission error, got %v", err)
+	}
+	if *recovered != nil {
+		t.Fatal("Secret recovered before the threshold was met")
+	}
+
+	status, err := client.Submit(ctx, "unseal", shares[0])
+	if err != nil {
+		t.Fatalf("Submit failed: %v", err)
+	}
+	if status.State != StateComplete {
+		t.Errorf("Expected complete ceremony, got %+v", status)
+	}
+	if string(*recovered) != "unseal key" {
+		t.Errorf("Expected %q, got %q", "unseal key", *recovered)
+	}
+	if _, err := client.Submit(ctx, "unseal", shares[2]); err == nil {
+		t.Error("Expected error submitting to a completed ceremony")
+	}
+
+	status, err = client.Status(ctx, "unseal")
+	if err != nil {
+		t.Fatalf("Status failed: %v", err)
+	}
+	if status.Threshold != 3 || status.State != StateComplete {
+		t.Errorf("Unexpected status %+v", status)
+	}
+}
+
+func TestServer_RequiresTransitEncryption(t *testing.T) {
+	ctx := context.Background()
+	srv, ts, _ := newTestServer(t)
+	srv.StartCeremony(ctx, "c", 2)
+	shares, _ := goshamir.Split([]byte("x"), 3, 2)
+	encoded, _ := goshamir.EncodeSharesToHex(shares)
+
+	resp := postJSON(t, ts.URL+"/v1/ceremonies/c/shares", Submission{Share: encoded[0]})
+	if resp.StatusCode != http.StatusBadRequest {
+		t.Errorf("Expected 400 for plaintext share, got %s", resp.Status)
+	}
+
+	env, _ := EncryptShare(srv.TransitKey(), "another-ceremony", shares[0])
+	resp = postJSON(t, ts.URL+"/v1/ceremonies/c/shares", Submission{Transit: env})
+	if resp.StatusCode != http.StatusBadRequest {
+		t.Errorf("Expected 400 for envelope sealed to another ceremony, got %s", resp.Status)
+	}
+
+	env, _ = EncryptShare(srv.TransitKey(), "missing", shares[0])
+	resp = postJSON(t, ts.URL+"/v1/ceremonies/missing/shares", Submission{Transit: env})
+	if resp.StatusCode != http.StatusNotFound {
+		t.Errorf("Expected rejection for unknown ceremony, got %s", resp.Status)
+	}
+}
+
+func TestServer_PlaintextSubmissions(t *testing.T) {
+	ctx := context.Background()
+	srv, ts, recovered := newTestServer(t, WithPlaintextSubmissions())
+	srv.StartCeremony(ctx, "c", 2)
+	shares, _ := goshamir.Split([]byte("plain"), 3, 2)
+	encoded, _ := goshamir.EncodeSharesToHex(shares)
+
+	postJSON(t, ts.URL+"/v1/ceremonies/c/shares", Submission{Share: encoded[0]})
+	resp := postJSON(t, ts.URL+"/v1/ceremonies/c/shares", Submission{Share: encoded[2]})
+	if resp.StatusCode != http.StatusOK {
+		t.Errorf("Expected 200, got %s", resp.Status)
+	}
+	if string(*recovered) != "plain" {
+		t.Errorf("Expected %q, got %q", "plain", *recovered)
+	}
+}
+
+func TestServer_RecoverFuncFailure(t *testing.T) {
+	ctx := context.Background()
+	staging := store.NewMemoryKV()
+	st, _ := store.NewSessionStore(staging, time.Minute)
+	srv, _ := NewServer(st, func(context.Context, string, []byte) error {
+		return errors.New("wrong unseal key")
+	})
+	srv.StartCeremony(ctx, "c", 2)
+	shares, _ := goshamir.Split([]byte("x"), 3, 2)
+
+	for _, s := range shares[:2] {
+		if _, _, err := srv.accept(ctx, "c", s, ""); err != nil && !strings.Contains(err.Error(), "wrong unseal key") {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+	}
+	status, _ := srv.Status("c")
+	if status.State != StateFailed {
+		t.Errorf("Expected failed ceremony, got %s", status.State)
+	}
+	if keys, _ := staging.Keys(ctx, ""); len(keys) != 0 {
+		t.Errorf("Expected staged shares to be removed, got %v", keys)
+	}
+	if err := srv.StartCeremony(ctx, "c", 2); err != nil {
+		t.Errorf("Restarting a failed ceremony failed: %v", err)
+	}
+}
+
+func TestClient_FingerprintMismatch(t *testing.T) {
+	srv, ts, _ := newTestServer(t)
+	srv.StartCeremony(context.Background(), "c", 2)
+	shares, _ := goshamir.Split([]byte("x"), 3, 2)
+	client := &Client{URL: ts.URL, Fingerprint: strings.Repeat("00", 16)}
+	if _, err := client.Submit(context.Background(), "c", shares[0]); err == nil || !strings.Contains(err.Error(), "fingerprint") {
+		t.Errorf("Expected fingerprint mismatch, got %v", err)
+	}
+}
+
+func TestNewServer_InvalidParams(t *testing.T) {
+	st, _ := store.NewSessionStore(store.NewMemoryKV(), time.Minute)
+	if _, err := NewServer(nil, func(context.Context, string, []byte) error { return nil }); err == nil {
+		t.Error("Expected error for nil store")
+	}
+	if _, err := NewServer(st, nil); err == nil {
+		t.Error("Expected error for nil recover function")
+	}
+	srv, _ := NewServer(st, func(context.Context, string, []byte) error { return nil })
+	if err := srv.StartCeremony(context.Background(), "c", 1); err == nil {
+		t.Error("Expected error for threshold 1")
+	}
+}
+
+func postJSON(t *testing.T, url string, body any) *http.Response {
+	t.Helper()
+	data, _ := json.Marshal(body)
+	resp, err := http.Post(url, "application/json", bytes.NewReader(data))
+	if err != nil {
+		t.Fatalf("POST failed: %v", err)
+	}
+	resp.Body.Close()
+	return resp
+}
@@ -0,0 +1,280 @@
+package recovery
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+
+	goshamir "github.com/fawwazid/go-shamir"
+	"github.com/fawwazid/go-shamir/store"
+)
+
+// stateVersion is the version of the ceremony snapshot format.
+const stateVersion = 1
+
+// stateLabel starts the associated data of encrypted snapshots.
+const stateLabel = "goshamir recovery state v1"
+
+// StateKeySize is the size in bytes of the key snapshots are encrypted
+// with.
+const StateKeySize = 32
+
+// StateStore persists encrypted ceremony snapshots, so that ceremonies
+// survive a restart of the server. Snapshots are opaque to the store.
+type StateStore interface {
+	// SaveState stores the snapshot of a ceremony, replacing any previous
+	// one.
+	SaveState(ctx context.Context, id string, data []byte) error
+	// LoadStates returns every stored snapshot by ceremony ID.
+	LoadStates(ctx context.Context) (map[string][]byte, error)
+}
+
+// WithStateStore snapshots each ceremony to st whenever it changes: when it
+// starts, when a share is accepted and when it completes or fails. A
+// snapshot holds the ceremony's threshold, state, timestamps, the indices
+// received and the attested devices and authenticated custodians that
+// submitted them, and, while the ceremony is open, the staged shares. It
+// is encrypted with AES-256-GCM under key, which must be StateKeySize bytes
+// and kept outside the state store, for example in a KMS.
+//
+// After a restart, call Server.Restore before serving requests. A snapshot
+// that cannot be saved when a share is accepted does not fail the
+// submission; it is counted in goshamir_recovery_state_saves_failed_total.
+func WithStateStore(st StateStore, key []byte) Option {
+	key = slices.Clone(key)
+	return func(s *Server) {
+		s.state = st
+		s.stateKey = key
+	}
+}
+
+// ceremonySnapshot is the plaintext of a snapshot.
+type ceremonySnapshot struct {
+	Version    int       `json:"version"`
+	ID         string    `json:"id"`
+	Threshold  int       `json:"threshold"`
+	State      State     `json:"state"`
+	Started    time.Time `json:"started"`
+	Updated    time.Time `json:"updated"`
+	Received   []uint8   `json:"received"`
+	Devices    []string  `json:"devices,omitempty"`
+	Custodians []string  `json:"custodians,omitempty"`
+	// Shares holds the envelopes of the staged shares while the ceremony
+	// is open.
+	Shares [][]byte `json:"shares,omitempty"`
+}
+
+// persist saves the ceremony's snapshot. s.mu must be held.
+func (s *Server) persist(ctx context.Context, id string, c *ceremony) error {
+	if s.state == nil {
+		return nil
+	}
+	snap := ceremonySnapshot{
+		Version:    stateVersion,
+		ID:         id,
+		Threshold:  c.threshold,
+		State:      c.state,
+		Started:    c.started,
+		Updated:    s.now(),
+		Received:   c.received,
+		Devices:    sortedKeys(c.devices),
+		Custodians: sortedKeys(c.custodians),
+	}
+	defer func() {
+		for _, e := range snap.Shares {
+			clear(e)
+		}
+	}()
+	if c.state == StateOpen && len(c.received) > 0 {
+		shares, err := s.store.List(ctx, id)
+		if err != nil && !errors.Is(err, store.ErrNotFound) {
+			return err
+		}
+		for _, share := range shares {
+			envelope, err := share.MarshalBinary()
+			clear(share.Value)
+			if err != nil {
+				return err
+			}
+			snap.Shares = append(snap.Shares, envelope)
+		}
+	}
+	plaintext, err := json.Marshal(snap)
+	if err != nil {
+		return err
+	}
+	defer clear(plaintext)
+	aead, err := s.stateAEAD()
+	if err != nil {
+		return err
+	}
+	nonce := make([]byte, aead.NonceSize(), aead.NonceSize()+len(plaintext)+aead.Overhead())
+	if _, err := rand.Read(nonce); err != nil {
+		return err
+	}
+	data := aead.Seal(nonce, nonce, plaintext, []byte(stateLabel+id))
+	if err := s.state.SaveState(ctx, id, data); err != nil {
+		return fmt.Errorf("saving state of ceremony %q: %w", id, err)
+	}
+	return nil
+}
+
+// Restore loads the ceremonies saved to the state store and stages the
+// shares of open ceremonies again, so they continue where they left off.
+// Ceremonies already known to the server are kept. Call it before serving
+// requests. Custodians must fetch the transit key again, as it changes
+// with every server instance.
+func (s *Server) Restore(ctx context.Context) error {
+	if s.state == nil {
+		return errors.New("no state store configured")
+	}
+	states, err := s.state.LoadStates(ctx)
+	if err != nil {
+		return err
+	}
+	aead, err := s.stateAEAD()
+	if err != nil {
+		return err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for id, data := range states {
+		if _, ok := s.ceremonies[id]; ok {
+			continue
+		}
+		if len(data) < aead.NonceSize() {
+			return fmt.Errorf("state of ceremony %q is corrupt", id)
+		}
+		plaintext, err := aead.Open(nil, data[:aead.NonceSize()], data[aead.NonceSize():], []byte(stateLabel+id))
+		if err != nil {
+			return fmt.Errorf("state of ceremony %q cannot be decrypted", id)
+		}
+		var snap ceremonySnapshot
+		err = json.Unmarshal(plaintext, &snap)
+		clear(plaintext)
+		if err != nil || snap.ID != id {
+			return fmt.Errorf("state of ceremony %q is corrupt", id)
+		}
+		if snap.Version != stateVersion {
+			return fmt.Errorf("state of ceremony %q has unsupported version %d", id, snap.Version)
+		}
+		c := &ceremony{
+			threshold:  snap.Threshold,
+			started:    snap.Started,
+			received:   snap.Received,
+			state:      snap.State,
+			devices:    make(map[string]bool),
+			custodians: make(map[string]bool),
+		}
+		for _, d := range snap.Devices {
+			c.devices[d] = true
+		}
+		for _, subject := range snap.Custodians {
+			c.custodians[subject] = true
+		}
+		for _, envelope := range snap.Shares {
+			var share goshamir.Share
+			err := share.UnmarshalBinary(envelope)
+			clear(envelope)
+			if err != nil {
+				return fmt.Errorf("state of ceremony %q: %w", id, err)
+			}
+			err = s.store.Put(ctx, id, share)
+			clear(share.Value)
+			if err != nil && !errors.Is(err, store.ErrExists) {
+				return fmt.Errorf("restaging share %d of ceremony %q: %w", share.Index, id, err)
+			}
+		}
+		s.ceremonies[id] = c
+	}
+	return nil
+}
+
+func (s *Server) stateAEAD() (cipher.AEAD, error) {
+	if len(s.stateKey) != StateKeySize {
+		return nil, fmt.Errorf("state key must be %d bytes", StateKeySize)
+	}
+	block, err := aes.NewCipher(s.stateKey)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+func sortedKeys(m map[string]bool) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	slices.Sort(keys)
+	return keys
+}
+
+// DirStateStore is a StateStore keeping each snapshot in a file of a
+// directory. Files are replaced atomically, so a crash never leaves a
+// partial snapshot.
+type DirStateStore struct {
+	Dir string
+}
+
+// SaveState implements StateStore.
+func (d DirStateStore) SaveState(_ context.Context, id string, data []byte) error {
+	if err := os.MkdirAll(d.Dir, 0o700); err != nil {
+		return err
+	}
+	tmp, err := os.CreateTemp(d.Dir, ".state-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Sync(); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), filepath.Join(d.Dir, hex.EncodeToString([]byte(id))+".state"))
+}
+
+// LoadStates implements StateStore.
+func (d DirStateStore) LoadStates(_ context.Context) (map[string][]byte, error) {
+	entries, err := os.ReadDir(d.Dir)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	states := make(map[string][]byte)
+	for _, e := range entries {
+		name, ok := strings.CutSuffix(e.Name(), ".state")
+		if !ok || e.IsDir() {
+			continue
+		}
+		id, err := hex.DecodeString(name)
+		if err != nil {
+			continue
+		}
+		data, err := os.ReadFile(filepath.Join(d.Dir, e.Name()))
+		if err != nil {
+			return nil, err
+		}
+		states[string(id)] = data
+	}
+	return states, nil
+}
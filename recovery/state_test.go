@@ -0,0 +1,101 @@
+package recovery
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	goshamir "github.com/fawwazid/go-shamir"
+	"github.com/fawwazid/go-shamir/store"
+)
+
+// --- State Tests ---
+
+func TestServer_RestoreAfterRestart(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	key := bytes.Repeat([]byte{0x42}, StateKeySize)
+	secret := []byte("multi-day ceremony")
+	shares, _ := goshamir.Split(secret, 5, 3)
+
+	srv, ts, _ := newTestServer(t, WithStateStore(DirStateStore{Dir: dir}, key))
+	if err := srv.StartCeremony(ctx, "vault", 3); err != nil {
+		t.Fatalf("StartCeremony failed: %v", err)
+	}
+	client := &Client{URL: ts.URL, Fingerprint: KeyFingerprint(srv.TransitKey())}
+	for _, s := range shares[:2] {
+		if _, err := client.Submit(ctx, "vault", s); err != nil {
+			t.Fatalf("Submit failed: %v", err)
+		}
+	}
+
+	files, _ := filepath.Glob(filepath.Join(dir, "*.state"))
+	if len(files) != 1 {
+		t.Fatalf("expected one state file, got %v", files)
+	}
+	data, _ := os.ReadFile(files[0])
+	encoded, _ := goshamir.EncodeSharesToHex(shares[:1])
+	value := strings.SplitN(encoded[0], ":", 2)[1]
+	if bytes.Contains(data, shares[0].Value) || bytes.Contains(data, []byte(value)) || bytes.Contains(data, []byte("vault")) {
+		t.Fatal("state file is not encrypted")
+	}
+
+	// A new server process with empty staging storage.
+	restarted, ts2, recovered := newTestServer(t, WithStateStore(DirStateStore{Dir: dir}, key))
+	if err := restarted.Restore(ctx); err != nil {
+		t.Fatalf("Restore failed: %v", err)
+	}
+	status, err := restarted.Status("vault")
+	if err != nil {
+		t.Fatalf("Status failed: %v", err)
+	}
+	if status.State != StateOpen || len(status.Received) != 2 {
+		t.Fatalf("unexpected restored status %+v", status)
+	}
+
+	client = &Client{URL: ts2.URL, Fingerprint: KeyFingerprint(restarted.TransitKey())}
+	if _, err := client.Submit(ctx, "vault", shares[1]); err == nil {
+		t.Fatal("expected duplicate submission to be rejected after restore")
+	}
+	status, err = client.Submit(ctx, "vault", shares[4])
+	if err != nil {
+		t.Fatalf("Submit failed: %v", err)
+	}
+	if status.State != StateComplete || !bytes.Equal(*recovered, secret) {
+		t.Fatalf("ceremony did not complete after restore: %+v, %q", status, *recovered)
+	}
+
+	// The final snapshot records completion without shares.
+	again, _, _ := newTestServer(t, WithStateStore(DirStateStore{Dir: dir}, key))
+	if err := again.Restore(ctx); err != nil {
+		t.Fatalf("Restore failed: %v", err)
+	}
+	if status, _ := again.Status("vault"); status.State != StateComplete {
+		t.Fatalf("expected complete ceremony, got %+v", status)
+	}
+}
+
+func TestServer_RestoreWrongKey(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	srv, _, _ := newTestServer(t, WithStateStore(DirStateStore{Dir: dir}, bytes.Repeat([]byte{1}, StateKeySize)))
+	if err := srv.StartCeremony(ctx, "c", 2); err != nil {
+		t.Fatalf("StartCeremony failed: %v", err)
+	}
+	other, _, _ := newTestServer(t, WithStateStore(DirStateStore{Dir: dir}, bytes.Repeat([]byte{2}, StateKeySize)))
+	if err := other.Restore(ctx); err == nil {
+		t.Fatal("expected error restoring with the wrong key")
+	}
+}
+
+func TestServer_StateKeySize(t *testing.T) {
+	staging, _ := store.NewSessionStore(store.NewMemoryKV(), time.Minute)
+	noop := func(context.Context, string, []byte) error { return nil }
+	if _, err := NewServer(staging, noop, WithStateStore(DirStateStore{Dir: t.TempDir()}, []byte("short"))); err == nil {
+		t.Fatal("expected error for short state key")
+	}
+}
@@ -0,0 +1,112 @@
+package recovery
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/ecdh"
+	"crypto/hkdf"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+
+	goshamir "github.com/fawwazid/go-shamir"
+)
+
+// transitInfo domain separates the keys of transit envelopes.
+const transitInfo = "goshamir recovery transit v1"
+
+// ErrTransitDecryption is returned when a transit envelope cannot be
+// opened, for example because it was sealed to another server's key or for
+// another ceremony.
+var ErrTransitDecryption = errors.New("transit envelope decryption failed")
+
+// TransitEnvelope is a share encrypted end to end to the process running a
+// Server. A fresh X25519 key pair is generated for every envelope; the
+// shared secret with the server's transit key is expanded with HKDF-SHA256
+// into an AES-256-GCM key bound to the ceremony ID, so TLS terminators and
+// load balancers in front of the server only ever see ciphertext.
+type TransitEnvelope struct {
+	EphemeralKey []byte `json:"ephemeral_key"`
+	Ciphertext   []byte `json:"ciphertext"`
+}
+
+// EncryptShare seals a share to a server's transit key for the given
+// ceremony.
+func EncryptShare(serverKey *ecdh.PublicKey, ceremonyID string, s goshamir.Share) (*TransitEnvelope, error) {
+	if serverKey == nil || serverKey.Curve() != ecdh.X25519() {
+		return nil, errors.New("transit key must be an X25519 public key")
+	}
+	ephemeral, err := ecdh.X25519().GenerateKey(rand.Reader)
+	if err != nil {
+		return nil, err
+	}
+	shared, err := ephemeral.ECDH(serverKey)
+	if err != nil {
+		return nil, err
+	}
+	aead, err := transitAEAD(shared, ceremonyID, ephemeral.PublicKey(), serverKey)
+	if err != nil {
+		return nil, err
+	}
+
+	plaintext, err := s.MarshalBinary()
+	if err != nil {
+		return nil, err
+	}
+	defer clear(plaintext)
+	return &TransitEnvelope{
+		EphemeralKey: ephemeral.PublicKey().Bytes(),
+		Ciphertext:   aead.Seal(nil, nil, plaintext, nil),
+	}, nil
+}
+
+// decryptShare opens a transit envelope with the server's private key.
+func decryptShare(key *ecdh.PrivateKey, ceremonyID string, env *TransitEnvelope) (goshamir.Share, error) {
+	ephemeral, err := ecdh.X25519().NewPublicKey(env.EphemeralKey)
+	if err != nil {
+		return goshamir.Share{}, ErrTransitDecryption
+	}
+	shared, err := key.ECDH(ephemeral)
+	if err != nil {
+		return goshamir.Share{}, ErrTransitDecryption
+	}
+	aead, err := transitAEAD(shared, ceremonyID, ephemeral, key.PublicKey())
+	if err != nil {
+		return goshamir.Share{}, err
+	}
+	plaintext, err := aead.Open(nil, nil, env.Ciphertext, nil)
+	if err != nil {
+		return goshamir.Share{}, ErrTransitDecryption
+	}
+	defer clear(plaintext)
+
+	var s goshamir.Share
+	if err := s.UnmarshalBinary(plaintext); err != nil {
+		return goshamir.Share{}, err
+	}
+	return s, nil
+}
+
+func transitAEAD(shared []byte, ceremonyID string, ephemeral, server *ecdh.PublicKey) (cipher.AEAD, error) {
+	defer clear(shared)
+	info := transitInfo + "\x00" + ceremonyID + "\x00" + string(ephemeral.Bytes()) + string(server.Bytes())
+	key, err := hkdf.Key(sha256.New, shared, nil, info, 32)
+	if err != nil {
+		return nil, err
+	}
+	defer clear(key)
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCMWithRandomNonce(block)
+}
+
+// KeyFingerprint returns a short hex fingerprint of a transit key, for
+// custodians to compare against the one announced by the ceremony operator
+// before submitting their share.
+func KeyFingerprint(key *ecdh.PublicKey) string {
+	sum := sha256.Sum256(key.Bytes())
+	return hex.EncodeToString(sum[:16])
+}
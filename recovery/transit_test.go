@@ -0,0 +1,59 @@
+package recovery
+
+import (
+	"bytes"
+	"crypto/ecdh"
+	"crypto/rand"
+	"errors"
+	"testing"
+
+	goshamir "github.com/fawwazid/go-shamir"
+)
+
+// --- Transit Encryption Tests ---
+
+func TestTransit_RoundTrip(t *testing.T) {
+	key, _ := ecdh.X25519().GenerateKey(rand.Reader)
+	shares, _ := goshamir.Split([]byte("in transit"), 3, 2)
+
+	env, err := EncryptShare(key.PublicKey(), "ceremony", shares[1])
+	if err != nil {
+		t.Fatalf("EncryptShare failed: %v", err)
+	}
+	if bytes.Contains(env.Ciphertext, shares[1].Value) {
+		t.Error("Ciphertext contains the share value")
+	}
+	got, err := decryptShare(key, "ceremony", env)
+	if err != nil {
+		t.Fatalf("decryptShare failed: %v", err)
+	}
+	if got.Index != 2 || !bytes.Equal(got.Value, shares[1].Value) {
+		t.Error("Decrypted share does not match")
+	}
+
+	other, _ := ecdh.X25519().GenerateKey(rand.Reader)
+	if _, err := decryptShare(other, "ceremony", env); !errors.Is(err, ErrTransitDecryption) {
+		t.Errorf("Expected ErrTransitDecryption for wrong key, got %v", err)
+	}
+	if _, err := decryptShare(key, "other-ceremony", env); !errors.Is(err, ErrTransitDecryption) {
+		t.Errorf("Expected ErrTransitDecryption for wrong ceremony, got %v", err)
+	}
+	env.Ciphertext[0] ^= 1
+	if _, err := decryptShare(key, "ceremony", env); !errors.Is(err, ErrTransitDecryption) {
+		t.Errorf("Expected ErrTransitDecryption for tampered ciphertext, got %v", err)
+	}
+	if _, err := decryptShare(key, "ceremony", &TransitEnvelope{EphemeralKey: []byte{1}}); !errors.Is(err, ErrTransitDecryption) {
+		t.Errorf("Expected ErrTransitDecryption for invalid ephemeral key, got %v", err)
+	}
+}
+
+func TestTransit_RejectsWrongCurve(t *testing.T) {
+	p256, _ := ecdh.P256().GenerateKey(rand.Reader)
+	shares, _ := goshamir.Split([]byte("x"), 3, 2)
+	if _, err := EncryptShare(p256.PublicKey(), "c", shares[0]); err == nil {
+		t.Error("Expected error for non-X25519 key")
+	}
+	if _, err := EncryptShare(nil, "c", shares[0]); err == nil {
+		t.Error("Expected error for nil key")
+	}
+}
@@ -0,0 +1,155 @@
+package goshamir
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+)
+
+// ErrRolePolicy is returned by CombineBundles when the custodians taking
+// part in a reconstruction do not satisfy the role policy.
+var ErrRolePolicy = errors.New("role policy not satisfied")
+
+// RoleEvaluator decides whether a set of custodians may reconstruct a
+// secret together, based on their Roles. It returns an error wrapping
+// ErrRolePolicy to refuse.
+type RoleEvaluator interface {
+	EvaluateRoles(custodians []Custodian) error
+}
+
+// RoleRequirement requires at least Min of the custodians taking part in a
+// reconstruction to hold Role.
+type RoleRequirement struct {
+	Role string
+	Min  int
+}
+
+// RolePolicy is a RoleEvaluator enforcing separation of duties, such as
+// "at least one officer and one auditor".
+type RolePolicy struct {
+	Require []RoleRequirement
+	// Distinct makes each custodian count towards at most one requirement,
+	// so a custodian holding both "officer" and "auditor" cannot satisfy
+	// both alone.
+	Distinct bool
+}
+
+// Validate checks that the policy is well formed.
+func (p *RolePolicy) Validate() error {
+	seen := make(map[string]bool, len(p.Require))
+	for i, r := range p.Require {
+		if r.Role == "" {
+			return fmt.Errorf("requirement %d has no role", i)
+		}
+		if seen[r.Role] {
+			return fmt.Errorf("duplicate requirement for role %q", r.Role)
+		}
+		seen[r.Role] = true
+		if r.Min < 1 || r.Min > MaxShares {
+			return fmt.Errorf("role %q: minimum must be between 1 and %d", r.Role, MaxShares)
+		}
+	}
+	return nil
+}
+
+// EvaluateRoles reports whether custodians cover every requirement. With
+// Distinct set, it searches for an assignment of custodians to
+// requirements in which nobody is counted twice.
+func (p *RolePolicy) EvaluateRoles(custodians []Custodian) error {
+	if err := p.Validate(); err != nil {
+		return err
+	}
+	for _, r := range p.Require {
+		have := 0
+		for _, c := range custodians {
+			if slices.Contains(c.Roles, r.Role) {
+				have++
+			}
+		}
+		if have < r.Min {
+			more := r.Min - have
+			return fmt.Errorf("%w: need %d more %s held by role %q (have %d of %d)",
+				ErrRolePolicy, more, plural(more, "share"), r.Role, have, r.Min)
+		}
+	}
+	if p.Distinct {
+		if covered, needed := p.matchRoles(custodians); covered < needed {
+			return fmt.Errorf("%w: roles need %d distinct custodians, only %d can be assigned",
+				ErrRolePolicy, needed, covered)
+		}
+	}
+	return nil
+}
+
+// matchRoles computes a maximum matching between custodians and the role
+// slots of the requirements, where a requirement with Min n has n slots,
+// using augmenting paths. It returns the number of slots filled and the
+// total number of slots.
+func (p *RolePolicy) matchRoles(custodians []Custodian) (int, int) {
+	var slots []string
+	for _, r := range p.Require {
+		for range r.Min {
+			slots = append(slots, r.Role)
+		}
+	}
+	holder := make([]int, len(slots))
+	for i := range holder {
+		holder[i] = -1
+	}
+	var augment func(c int, visited []bool) bool
+	augment = func(c int, visited []bool) bool {
+		for s, role := range slots {
+			if visited[s] || !slices.Contains(custodians[c].Roles, role) {
+				continue
+			}
+			visited[s] = true
+			if holder[s] < 0 || augment(holder[s], visited) {
+				holder[s] = c
+				return true
+			}
+		}
+		return false
+	}
+	covered := 0
+	for c := range custodians {
+		if augment(c, make([]bool, len(slots))) {
+			covered++
+		}
+	}
+	return covered, len(slots)
+}
+
+// CombineBundles reconstructs the secret from the shares of custodian
+// bundles, after checking that the custodians whose shares are used, the
+// first threshold bundles, satisfy the role evaluator. A nil evaluator
+// skips the check.
+//
+// Roles are taken from the bundles as given, so they must come from the
+// dealer's records, such as the custodian registry, never from the party
+// submitting the share. Shares should be checked against their commitments,
+// so that a custodian cannot present another custodian's index.
+func CombineBundles(bundles []CustodianBundle, threshold int, eval RoleEvaluator) ([]byte, error) {
+	shares := make([]Share, len(bundles))
+	var custodians []Custodian
+	names := make(map[string]bool, len(bundles))
+	for i, b := range bundles {
+		shares[i] = b.Share
+		if i >= threshold {
+			continue
+		}
+		if b.Custodian.Name != "" && names[b.Custodian.Name] {
+			return nil, fmt.Errorf("custodian %q appears twice", b.Custodian.Name)
+		}
+		names[b.Custodian.Name] = true
+		custodians = append(custodians, b.Custodian)
+	}
+	if err := validateCombineParams(shares, threshold); err != nil {
+		return nil, err
+	}
+	if eval != nil {
+		if err := eval.EvaluateRoles(custodians); err != nil {
+			return nil, err
+		}
+	}
+	return Combine(shares, threshold)
+}
@@ -0,0 +1,85 @@
+package goshamir
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func roleBundles(t *testing.T, roles ...[]string) []CustodianBundle {
+	t.Helper()
+	shares, _ := Split([]byte("separation of duties"), len(roles), 2)
+	custodians := make([]Custodian, len(roles))
+	for i, r := range roles {
+		custodians[i] = Custodian{Name: string(rune('a' + i)), Roles: r}
+	}
+	bundles, err := AssignShares(shares, custodians)
+	if err != nil {
+		t.Fatalf("AssignShares failed: %v", err)
+	}
+	return bundles
+}
+
+// --- RolePolicy Tests ---
+
+func TestCombineBundles_RolePolicy(t *testing.T) {
+	bundles := roleBundles(t, []string{"engineer"}, []string{"officer"}, []string{"engineer"}, []string{"auditor"})
+	policy := &RolePolicy{Require: []RoleRequirement{{Role: "officer", Min: 1}}}
+
+	secret, err := CombineBundles([]CustodianBundle{bundles[0], bundles[1]}, 2, policy)
+	if err != nil || string(secret) != "separation of duties" {
+		t.Fatalf("CombineBundles failed: %q, %v", secret, err)
+	}
+	_, err = CombineBundles([]CustodianBundle{bundles[0], bundles[2]}, 2, policy)
+	if !errors.Is(err, ErrRolePolicy) || !strings.Contains(err.Error(), `need 1 more share held by role "officer"`) {
+		t.Errorf("Expected role policy error, got %v", err)
+	}
+	// Only the first threshold bundles take part.
+	if _, err := CombineBundles([]CustodianBundle{bundles[0], bundles[2], bundles[1]}, 2, policy); !errors.Is(err, ErrRolePolicy) {
+		t.Errorf("Expected role policy error for an unused officer, got %v", err)
+	}
+	if _, err := CombineBundles([]CustodianBundle{bundles[0], bundles[2]}, 2, nil); err != nil {
+		t.Errorf("CombineBundles without a policy failed: %v", err)
+	}
+	if _, err := CombineBundles([]CustodianBundle{bundles[1], bundles[1]}, 2, nil); err == nil {
+		t.Error("Expected error for a repeated custodian")
+	}
+	if _, err := CombineBundles(bundles[:1], 2, policy); err == nil {
+		t.Error("Expected error for too few bundles")
+	}
+}
+
+func TestRolePolicy_Distinct(t *testing.T) {
+	both := Custodian{Name: "alice", Roles: []string{"officer", "auditor"}}
+	officer := Custodian{Name: "bob", Roles: []string{"officer"}}
+	auditor := Custodian{Name: "carol", Roles: []string{"auditor"}}
+	engineer := Custodian{Name: "dave", Roles: []string{"engineer"}}
+	policy := &RolePolicy{Require: []RoleRequirement{{"officer", 1}, {"auditor", 1}}}
+
+	if err := policy.EvaluateRoles([]Custodian{both, engineer}); err != nil {
+		t.Errorf("Expected overlapping roles to pass without Distinct: %v", err)
+	}
+	policy.Distinct = true
+	if err := policy.EvaluateRoles([]Custodian{both, engineer}); !errors.Is(err, ErrRolePolicy) {
+		t.Errorf("Expected one custodian not to cover two roles, got %v", err)
+	}
+	// Alice must be counted as the auditor for Bob to cover the officer.
+	if err := policy.EvaluateRoles([]Custodian{both, officer}); err != nil {
+		t.Errorf("Expected a distinct assignment to be found: %v", err)
+	}
+	if err := policy.EvaluateRoles([]Custodian{officer, auditor}); err != nil {
+		t.Errorf("EvaluateRoles failed: %v", err)
+	}
+}
+
+func TestRolePolicy_Validate(t *testing.T) {
+	for _, p := range []RolePolicy{
+		{Require: []RoleRequirement{{"", 1}}},
+		{Require: []RoleRequirement{{"officer", 0}}},
+		{Require: []RoleRequirement{{"officer", 1}, {"officer", 2}}},
+	} {
+		if err := p.Validate(); err == nil {
+			t.Errorf("Expected error for %+v", p)
+		}
+	}
+}
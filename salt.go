@@ -0,0 +1,120 @@
+package goshamir
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/hkdf"
+	"crypto/rand"
+	"crypto/sha256"
+	"fmt"
+	"io"
+)
+
+// SplitSaltSize is the size in bytes of a split salt.
+const SplitSaltSize = 32
+
+// splitSaltLabel domain-separates the salted coefficient key.
+const splitSaltLabel = "go-shamir split salt v1"
+
+// SplitMetadata describes one split, for storing alongside its shares.
+// It holds nothing secret.
+type SplitMetadata struct {
+	TotalShares int    `json:"total_shares"`
+	Threshold   int    `json:"threshold"`
+	Scheme      Scheme `json:"scheme"`
+	// Salt is the per-split salt, or nil if the split was not salted. See
+	// WithSplitSalt.
+	Salt []byte `json:"salt,omitempty"`
+}
+
+// WithSplitSalt mixes a fresh 32-byte salt into the coefficients of every
+// split, so that two splits of the same secret never produce relatable
+// shares, even if the configured random source repeats itself, as it may
+// after a virtual machine snapshot is restored or when a caller supplies a
+// faulty reader with WithRandom. The salt is always read from the
+// operating system's generator, crypto/rand, independently of WithRandom,
+// and Splitter.SplitWithMetadata records it. Streaming splits are not
+// salted.
+//
+// Coefficients are then drawn from AES-256-CTR keyed with HKDF-SHA256 of
+// 32 bytes of the configured random source, salted with the salt.
+func WithSplitSalt() Option {
+	return func(c *Config) {
+		c.SplitSalt = true
+	}
+}
+
+// WithFixedSplitSalt replays a split with a recorded salt, for audits:
+// together with the random source the split was made with, such as
+// WithDeterministicCoefficients, it reproduces the split's shares exactly.
+// It implies WithSplitSalt. Reusing a salt in production defeats its
+// purpose.
+func WithFixedSplitSalt(salt []byte) Option {
+	salt = append([]byte(nil), salt...)
+	return func(c *Config) {
+		c.SplitSalt = true
+		c.FixedSalt = salt
+	}
+}
+
+// SplitWithMetadata splits secret like Split and also returns the split's
+// metadata, including its salt when WithSplitSalt is in effect.
+func (s *Splitter) SplitWithMetadata(secret []byte) ([]Share, SplitMetadata, error) {
+	meta := SplitMetadata{TotalShares: s.totalShares, Threshold: s.threshold, Scheme: s.config.Scheme}
+	random := s.config.Rand
+	if s.config.SplitSalt {
+		salt, err := s.splitSalt()
+		if err != nil {
+			return nil, SplitMetadata{}, err
+		}
+		if random, err = saltedReader(random, salt); err != nil {
+			return nil, SplitMetadata{}, err
+		}
+		meta.Salt = salt
+	}
+	shares, err := s.split(secret, random)
+	if err != nil {
+		s.config.logger().Warn("shamir: split failed", "error", err)
+		return nil, SplitMetadata{}, err
+	}
+	attrs := []any{"shares", len(shares), "threshold", s.threshold, "scheme", s.config.Scheme.String()}
+	if meta.Salt != nil {
+		attrs = append(attrs, "salted", true)
+	}
+	s.config.logger().Info("shamir: shares generated", attrs...)
+	return shares, meta, nil
+}
+
+func (s *Splitter) splitSalt() ([]byte, error) {
+	if s.config.FixedSalt != nil {
+		if len(s.config.FixedSalt) != SplitSaltSize {
+			return nil, fmt.Errorf("split salt must be %d bytes", SplitSaltSize)
+		}
+		return append([]byte(nil), s.config.FixedSalt...), nil
+	}
+	salt := make([]byte, SplitSaltSize)
+	if _, err := rand.Read(salt); err != nil {
+		return nil, err
+	}
+	return salt, nil
+}
+
+// saltedReader returns the coefficient stream of a salted split.
+func saltedReader(random io.Reader, salt []byte) (io.Reader, error) {
+	ikm := make([]byte, 32)
+	defer clear(ikm)
+	if _, err := io.ReadFull(random, ikm); err != nil {
+		return nil, err
+	}
+	key, err := hkdf.Key(sha256.New, ikm, salt, splitSaltLabel, 32)
+	if err != nil {
+		return nil, err
+	}
+	defer clear(key)
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	var iv [aes.BlockSize]byte
+	return cipher.StreamReader{S: cipher.NewCTR(block, iv[:]), R: zeroReader{}}, nil
+}
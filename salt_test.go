@@ -0,0 +1,88 @@
+package goshamir
+
+import (
+	"bytes"
+	"testing"
+)
+
+// --- WithSplitSalt Tests ---
+
+func TestWithSplitSalt_RepeatingRandomStillUnrelated(t *testing.T) {
+	secret := []byte("same secret twice")
+	seed := []byte("stuck rng")
+
+	split := func() ([]Share, SplitMetadata) {
+		splitter, err := NewSplitter(3, 2, WithDeterministicCoefficients(seed), WithSplitSalt())
+		if err != nil {
+			t.Fatalf("NewSplitter failed: %v", err)
+		}
+		shares, meta, err := splitter.SplitWithMetadata(secret)
+		if err != nil {
+			t.Fatalf("SplitWithMetadata failed: %v", err)
+		}
+		return shares, meta
+	}
+	a, metaA := split()
+	b, metaB := split()
+
+	if len(metaA.Salt) != SplitSaltSize || bytes.Equal(metaA.Salt, metaB.Salt) {
+		t.Fatal("expected distinct 32-byte salts")
+	}
+	if bytes.Equal(a[0].Value, b[0].Value) {
+		t.Fatal("salted splits with a repeating random source produced the same shares")
+	}
+	for _, shares := range [][]Share{a, b} {
+		got, err := Combine(shares[1:], 2)
+		if err != nil {
+			t.Fatalf("Combine failed: %v", err)
+		}
+		if !bytes.Equal(got, secret) {
+			t.Fatalf("Combine = %q, want %q", got, secret)
+		}
+	}
+	if metaA.TotalShares != 3 || metaA.Threshold != 2 || metaA.Scheme != SchemeV1GF257 {
+		t.Fatalf("unexpected metadata %+v", metaA)
+	}
+}
+
+func TestWithFixedSplitSalt_ReproducesSplit(t *testing.T) {
+	secret := []byte("audited")
+	opts := []Option{WithScheme(SchemeV2GF256), WithDeterministicCoefficients([]byte("audit seed")), WithSplitSalt()}
+	splitter, _ := NewSplitter(4, 3, opts...)
+	original, meta, err := splitter.SplitWithMetadata(secret)
+	if err != nil {
+		t.Fatalf("SplitWithMetadata failed: %v", err)
+	}
+
+	replay, _ := NewSplitter(4, 3, append(opts, WithFixedSplitSalt(meta.Salt))...)
+	reproduced, replayMeta, err := replay.SplitWithMetadata(secret)
+	if err != nil {
+		t.Fatalf("SplitWithMetadata failed: %v", err)
+	}
+	if !bytes.Equal(replayMeta.Salt, meta.Salt) {
+		t.Fatal("replay recorded a different salt")
+	}
+	for i := range original {
+		if !bytes.Equal(original[i].Value, reproduced[i].Value) {
+			t.Fatalf("share %d differs on replay", original[i].Index)
+		}
+	}
+}
+
+func TestWithSplitSalt_Unsalted(t *testing.T) {
+	splitter, _ := NewSplitter(3, 2)
+	_, meta, err := splitter.SplitWithMetadata([]byte("x"))
+	if err != nil {
+		t.Fatalf("SplitWithMetadata failed: %v", err)
+	}
+	if meta.Salt != nil {
+		t.Fatal("unsalted split recorded a salt")
+	}
+}
+
+func TestWithFixedSplitSalt_InvalidLength(t *testing.T) {
+	splitter, _ := NewSplitter(3, 2, WithFixedSplitSalt([]byte("short")))
+	if _, err := splitter.Split([]byte("x")); err == nil {
+		t.Fatal("expected error for short salt")
+	}
+}
@@ -0,0 +1,90 @@
+package goshamir
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Scheme identifies the field and value layout a share was created with, so
+// shares from different library versions are never misinterpreted.
+type Scheme uint8
+
+const (
+	// SchemeV1GF257 is the original scheme: arithmetic in GF(257) with every
+	// secret byte stored as two little-endian bytes. It is produced by
+	// Split and is the default for Splitter.
+	SchemeV1GF257 Scheme = 1
+	// SchemeV2GF256 is the compact scheme: arithmetic in GF(2^8) with the
+	// AES polynomial, storing one byte per secret byte.
+	SchemeV2GF256 Scheme = 2
+)
+
+// ErrUnsupportedScheme is returned for shares whose scheme this version of
+// the library does not implement, for example shares created by a newer
+// release.
+var ErrUnsupportedScheme = errors.New("unsupported scheme")
+
+// String returns the scheme's short version name, such as "v2".
+func (s Scheme) String() string {
+	return fmt.Sprintf("v%d", uint8(s))
+}
+
+// Supported reports whether this version of the library can split and
+// combine shares of the scheme.
+func (s Scheme) Supported() bool {
+	switch s {
+	case SchemeV1GF257, SchemeV2GF256:
+		return true
+	}
+	return false
+}
+
+// bytesPerElement returns the number of value bytes stored per secret byte.
+func (s Scheme) bytesPerElement() int {
+	if s == SchemeV2GF256 {
+		return 1
+	}
+	return 2
+}
+
+// WithScheme selects the scheme used by a Splitter and the streaming APIs.
+// The default is SchemeV1GF257.
+func WithScheme(s Scheme) Option {
+	return func(c *Config) {
+		c.Scheme = s
+	}
+}
+
+// schemeOf returns the scheme of a share. Shares created before schemes were
+// introduced carry the zero value and are SchemeV1GF257.
+func schemeOf(s Share) Scheme {
+	if s.Scheme == 0 {
+		return SchemeV1GF257
+	}
+	return s.Scheme
+}
+
+// sharesScheme returns the common scheme of shares, failing if the shares
+// mix schemes or use one this version cannot handle.
+func sharesScheme(shares []Share) (Scheme, error) {
+	scheme := schemeOf(shares[0])
+	for i, s := range shares {
+		if schemeOf(s) != scheme {
+			e := newCombineError(ProblemMixedSchemes, shares, i)
+			e.Expected = scheme
+			return 0, e
+		}
+	}
+	if !scheme.Supported() {
+		return 0, newCombineError(ProblemUnsupportedScheme, shares, 0)
+	}
+	return scheme, nil
+}
+
+func unsupportedScheme(s Scheme) error {
+	return fmt.Errorf("%w %s", ErrUnsupportedScheme, s)
+}
+
+func errInconsistentLength(i int) error {
+	return fmt.Errorf("share %d has inconsistent length", i)
+}
@@ -0,0 +1,174 @@
+package goshamir
+
+import (
+	"bytes"
+	"crypto/rand"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+)
+
+// --- Scheme Tests ---
+
+func TestGF256_Inverses(t *testing.T) {
+	for a := 1; a < 256; a++ {
+		if got := gf256Mul(byte(a), gf256Inv(byte(a))); got != 1 {
+			t.Fatalf("%d * inverse = %d, expected 1", a, got)
+		}
+	}
+	// 0x53 * 0xCA = 1 is the worked example from FIPS-197.
+	if gf256Mul(0x53, 0xCA) != 1 {
+		t.Error("Unexpected product for FIPS-197 example")
+	}
+}
+
+func TestSchemeV2_RoundTrip(t *testing.T) {
+	splitter, err := NewSplitter(5, 3, WithScheme(SchemeV2GF256))
+	if err != nil {
+		t.Fatalf("NewSplitter failed: %v", err)
+	}
+
+	secret := make([]byte, 600)
+	if _, err := rand.Read(secret); err != nil {
+		t.Fatalf("Failed to generate random secret: %v", err)
+	}
+	shares, err := splitter.Split(secret)
+	if err != nil {
+		t.Fatalf("Split failed: %v", err)
+	}
+	for i, s := range shares {
+		if s.Scheme != SchemeV2GF256 {
+			t.Errorf("Share %d: expected scheme v2, got %s", i, s.Scheme)
+		}
+		if len(s.Value) != len(secret) {
+			t.Errorf("Share %d: expected compact value length %d, got %d", i, len(secret), len(s.Value))
+		}
+	}
+
+	recovered, err := Combine([]Share{shares[3], shares[0], shares[4]}, 3)
+	if err != nil {
+		t.Fatalf("Combine failed: %v", err)
+	}
+	if !bytes.Equal(secret, recovered) {
+		t.Error("Combine: recovered secret does not match original")
+	}
+
+	r, err := NewReconstructor([]uint8{1, 4, 5})
+	if err != nil {
+		t.Fatalf("NewReconstructor failed: %v", err)
+	}
+	recovered, err = r.Combine(shares)
+	if err != nil {
+		t.Fatalf("Reconstructor.Combine failed: %v", err)
+	}
+	if !bytes.Equal(secret, recovered) {
+		t.Error("Reconstructor: recovered secret does not match original")
+	}
+}
+
+func TestCombine_UnsupportedScheme(t *testing.T) {
+	decoded, err := DecodeSharesFromHex([]string{"v4:1:0102", "v4:2:0304"})
+	if err != nil {
+		t.Fatalf("Decode failed: %v", err)
+	}
+	if decoded[0].Scheme != 4 {
+		t.Fatalf("Expected scheme v4, got %s", decoded[0].Scheme)
+	}
+
+	_, err = Combine(decoded, 2)
+	if !errors.Is(err, ErrUnsupportedScheme) {
+		t.Fatalf("Expected ErrUnsupportedScheme, got %v", err)
+	}
+	if !strings.Contains(err.Error(), "unsupported scheme v4") {
+		t.Errorf("Expected error to name the scheme, got %q", err)
+	}
+
+	if _, err := NewSplitter(3, 2, WithScheme(4)); !errors.Is(err, ErrUnsupportedScheme) {
+		t.Errorf("Expected ErrUnsupportedScheme from NewSplitter, got %v", err)
+	}
+}
+
+func TestCombine_MixedSchemes(t *testing.T) {
+	v1, _ := Split([]byte("ab"), 3, 2)
+	splitter, _ := NewSplitter(3, 2, WithScheme(SchemeV2GF256))
+	v2, _ := splitter.Split([]byte("abcd"))
+
+	if _, err := Combine([]Share{v1[0], v2[1]}, 2); err == nil {
+		t.Error("Expected error for mixed schemes")
+	}
+}
+
+func TestEncodeDecode_SchemePrefix(t *testing.T) {
+	splitter, _ := NewSplitter(3, 2, WithScheme(SchemeV2GF256))
+	shares, _ := splitter.Split([]byte("compact"))
+
+	encoded, err := EncodeSharesToHex(shares)
+	if err != nil {
+		t.Fatalf("Encode failed: %v", err)
+	}
+	if !strings.HasPrefix(encoded[0], "v2:1:") {
+		t.Errorf("Expected v2 prefix, got %q", encoded[0])
+	}
+
+	decoded, err := DecodeSharesFromHex(encoded)
+	if err != nil {
+		t.Fatalf("Decode failed: %v", err)
+	}
+	recovered, err := Combine(decoded[1:], 2)
+	if err != nil {
+		t.Fatalf("Combine failed: %v", err)
+	}
+	if string(recovered) != "compact" {
+		t.Errorf("Expected %q, got %q", "compact", recovered)
+	}
+
+	legacy, _ := Split([]byte("legacy"), 3, 2)
+	encoded, _ = EncodeSharesToHex(legacy)
+	if strings.HasPrefix(encoded[0], "v") {
+		t.Errorf("Expected legacy encoding without prefix, got %q", encoded[0])
+	}
+
+	for _, input := range []string{"v:1:ab", "v0:1:ab", "v256:1:ab", "vx:1:ab", "v2"} {
+		if _, err := DecodeSharesFromHex([]string{input}); err == nil {
+			t.Errorf("Expected error for input %q", input)
+		}
+	}
+}
+
+func TestSchemeV2_Streams(t *testing.T) {
+	secret := make([]byte, streamChunkSize+99)
+	if _, err := rand.Read(secret); err != nil {
+		t.Fatalf("Failed to generate random secret: %v", err)
+	}
+
+	bufs := []*bytes.Buffer{new(bytes.Buffer), new(bytes.Buffer), new(bytes.Buffer)}
+	opts := []Option{WithScheme(SchemeV2GF256), WithChunkMAC([]byte("k"))}
+	if err := SplitStream([]io.Writer{bufs[0], bufs[1], bufs[2]}, bytes.NewReader(secret), 2, opts...); err != nil {
+		t.Fatalf("SplitStream failed: %v", err)
+	}
+	if bufs[0].Bytes()[0] != 0 || bufs[0].Bytes()[1] != byte(SchemeV2GF256) {
+		t.Errorf("Expected explicit scheme header, got % x", bufs[0].Bytes()[:3])
+	}
+
+	var total int64
+	opts = append(opts, WithProgress(func(_, n int64) { total = n }))
+	out, err := io.ReadAll(CombineReader([]io.Reader{bufs[2], bufs[1]}, 2, opts...))
+	if err != nil {
+		t.Fatalf("CombineReader failed: %v", err)
+	}
+	if !bytes.Equal(secret, out) {
+		t.Error("Recovered secret does not match original")
+	}
+	if total != int64(len(secret)) {
+		t.Errorf("Expected total %d, got %d", len(secret), total)
+	}
+}
+
+func TestCombineMatrix_RejectsCompactScheme(t *testing.T) {
+	splitter, _ := NewSplitter(3, 2, WithScheme(SchemeV2GF256))
+	shares, _ := splitter.Split([]byte("compact"))
+	if _, err := CombineMatrix(shares, 2); !errors.Is(err, ErrUnsupportedScheme) {
+		t.Errorf("Expected ErrUnsupportedScheme, got %v", err)
+	}
+}
@@ -0,0 +1,36 @@
+package goshamir
+
+import (
+	"encoding/hex"
+	"testing"
+)
+
+// --- scrypt Tests ---
+
+func TestScryptKey_RFC7914(t *testing.T) {
+	tests := []struct {
+		password, salt string
+		logN, r, p     int
+		want           string
+	}{
+		{"", "", 4, 1, 1, "77d6576238657b203b19ca42c18a0497f16b4844e3074ae8dfdffa3fede21442fcd0069ded0948f8326a753a0fc81f17e8d3e0fb2e0d3628cf35e20c38d18906"},
+		{"password", "NaCl", 10, 8, 16, "fdbabe1c9d3472007856e7190d01e9fe7c6ad7cbc8237830e77376634b3731622eaf30d92e22a3886ff109279d9830dac727afb94a83ee6d8360cbdfa2cc0640"},
+	}
+	for _, tt := range tests {
+		key, err := scryptKey([]byte(tt.password), []byte(tt.salt), tt.logN, tt.r, tt.p, 64)
+		if err != nil {
+			t.Fatalf("scryptKey failed: %v", err)
+		}
+		if got := hex.EncodeToString(key); got != tt.want {
+			t.Errorf("scrypt(%q, %q) = %s, expected %s", tt.password, tt.salt, got, tt.want)
+		}
+	}
+}
+
+func TestScryptKey_InvalidParams(t *testing.T) {
+	for _, p := range [][3]int{{0, 1, 1}, {31, 1, 1}, {10, 0, 1}, {10, 1, 0}, {20, 1 << 12, 1}} {
+		if _, err := scryptKey([]byte("pw"), []byte("salt"), p[0], p[1], p[2], 32); err == nil {
+			t.Errorf("Expected error for parameters %v", p)
+		}
+	}
+}
@@ -0,0 +1,179 @@
+package goshamir
+
+import (
+	"crypto"
+	"crypto/ed25519"
+	"crypto/rand"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// sealedBundleMagic starts the signed form of a SealedBundle and domain
+// separates its signatures.
+const sealedBundleMagic = "goshamir sealed bundle v1"
+
+// ErrBadSeal is returned by VerifyBundle when a sealed bundle was not signed
+// by the given dealer key or has been modified.
+var ErrBadSeal = errors.New("sealed bundle signature is invalid")
+
+// SealedBundle is a custodian bundle signed by the dealer, so that the
+// custodian can verify the authenticity of their share long after the
+// ceremony.
+type SealedBundle struct {
+	SetID     string
+	Custodian Custodian
+	Share     Share
+	IssuedAt  time.Time
+	// TimestampToken optionally holds an RFC 3161 timestamp over the share
+	// set's Merkle root, proving when the set was created. See
+	// TimestampAuthority.
+	TimestampToken []byte
+	Signature      []byte
+}
+
+// SealBundle signs each bundle of a share set with the dealer's Ed25519 key.
+// The signer may be an ed25519.PrivateKey or any crypto.Signer holding an
+// Ed25519 key, such as one backed by an HSM. To embed a timestamp token,
+// set TimestampToken on the results and call Seal again.
+func SealBundle(setID string, bundles []CustodianBundle, signer crypto.Signer) ([]SealedBundle, error) {
+	issued := time.Now().UTC().Truncate(time.Second)
+	sealed := make([]SealedBundle, len(bundles))
+	for i, b := range bundles {
+		sealed[i] = SealedBundle{SetID: setID, Custodian: b.Custodian, Share: b.Share, IssuedAt: issued}
+		if err := sealed[i].Seal(signer); err != nil {
+			return nil, err
+		}
+	}
+	return sealed, nil
+}
+
+// Seal signs the bundle's current contents with the dealer's Ed25519 key,
+// replacing any previous signature.
+func (sb *SealedBundle) Seal(signer crypto.Signer) error {
+	if _, ok := signer.Public().(ed25519.PublicKey); !ok {
+		return errors.New("signer must hold an Ed25519 key")
+	}
+	msg, err := sb.signedMessage()
+	if err != nil {
+		return err
+	}
+	sig, err := signer.Sign(rand.Reader, msg, crypto.Hash(0))
+	if err != nil {
+		return fmt.Errorf("signing bundle for %q failed: %w", sb.Custodian.Name, err)
+	}
+	sb.Signature = sig
+	return nil
+}
+
+// VerifyBundle checks that sb was sealed by the dealer's key and has not
+// been modified since.
+func VerifyBundle(sb SealedBundle, dealer ed25519.PublicKey) error {
+	if len(dealer) != ed25519.PublicKeySize {
+		return errors.New("invalid dealer public key")
+	}
+	msg, err := sb.signedMessage()
+	if err != nil {
+		return err
+	}
+	if !ed25519.Verify(dealer, msg, sb.Signature) {
+		return ErrBadSeal
+	}
+	return nil
+}
+
+// MarshalBinary encodes the sealed bundle, including its signature.
+func (sb SealedBundle) MarshalBinary() ([]byte, error) {
+	msg, err := sb.signedMessage()
+	if err != nil {
+		return nil, err
+	}
+	return appendField(msg, sb.Signature), nil
+}
+
+// UnmarshalBinary decodes a sealed bundle produced by MarshalBinary. It does
+// not verify the signature; call VerifyBundle for that.
+func (sb *SealedBundle) UnmarshalBinary(data []byte) error {
+	r := fieldReader{data: data}
+	if string(r.next()) != sealedBundleMagic {
+		return errors.New("invalid sealed bundle")
+	}
+	var out SealedBundle
+	out.SetID = string(r.next())
+	out.Custodian.Name = string(r.next())
+	out.Custodian.Contact = string(r.next())
+	out.Custodian.PublicKey = r.next()
+	meta := r.next()
+	out.Share.Value = r.next()
+	out.Share.Watermark = r.next()
+	issued := r.next()
+	out.TimestampToken = r.next()
+	out.Signature = r.next()
+	if r.err != nil || len(r.data) != 0 || len(meta) != 3 || len(issued) != 8 {
+		return errors.New("invalid sealed bundle")
+	}
+	out.Custodian.Index, out.Share.Index, out.Share.Scheme = meta[0], meta[1], Scheme(meta[2])
+	if len(out.Custodian.PublicKey) == 0 {
+		out.Custodian.PublicKey = nil
+	}
+	if len(out.Share.Watermark) == 0 {
+		out.Share.Watermark = nil
+	}
+	if len(out.TimestampToken) == 0 {
+		out.TimestampToken = nil
+	}
+	out.IssuedAt = time.Unix(int64(binary.BigEndian.Uint64(issued)), 0).UTC()
+	*sb = out
+	return nil
+}
+
+// signedMessage returns the canonical encoding of everything the signature
+// covers.
+func (sb *SealedBundle) signedMessage() ([]byte, error) {
+	if sb.Share.Index == 0 || len(sb.Share.Value) == 0 {
+		return nil, errors.New("bundle has no share")
+	}
+	buf := appendField(nil, []byte(sealedBundleMagic))
+	buf = appendField(buf, []byte(sb.SetID))
+	buf = appendField(buf, []byte(sb.Custodian.Name))
+	buf = appendField(buf, []byte(sb.Custodian.Contact))
+	buf = appendField(buf, sb.Custodian.PublicKey)
+	buf = appendField(buf, []byte{sb.Custodian.Index, sb.Share.Index, byte(schemeOf(sb.Share))})
+	buf = appendField(buf, sb.Share.Value)
+	buf = appendField(buf, sb.Share.Watermark)
+	buf = appendField(buf, binary.BigEndian.AppendUint64(nil, uint64(sb.IssuedAt.Unix())))
+	buf = appendField(buf, sb.TimestampToken)
+	return buf, nil
+}
+
+// appendField appends b to buf prefixed with its 4-byte big-endian length.
+func appendField(buf, b []byte) []byte {
+	buf = binary.BigEndian.AppendUint32(buf, uint32(len(b)))
+	return append(buf, b...)
+}
+
+// fieldReader reads fields written by appendField. After the first error,
+// next returns nil and err is set.
+type fieldReader struct {
+	data []byte
+	err  error
+}
+
+func (r *fieldReader) next() []byte {
+	if r.err != nil {
+		return nil
+	}
+	if len(r.data) < 4 {
+		r.err = errors.New("truncated field")
+		return nil
+	}
+	n := binary.BigEndian.Uint32(r.data)
+	if uint64(n) > uint64(len(r.data)-4) {
+		r.err = errors.New("truncated field")
+		return nil
+	}
+	field := append([]byte(nil), r.data[4:4+n]...)
+	r.data = r.data[4+n:]
+	return field
+}
@@ -0,0 +1,77 @@
+package goshamir
+
+import (
+	"bytes"
+	"crypto/ed25519"
+	"errors"
+	"testing"
+)
+
+// --- SealBundle Tests ---
+
+func TestSealBundle(t *testing.T) {
+	pub, priv, _ := ed25519.GenerateKey(nil)
+	shares, _ := Split([]byte("secret"), 2, 2)
+	bundles, _ := AssignShares(shares, []Custodian{
+		{Name: "alice", Contact: "alice@example.com", PublicKey: []byte{1, 2, 3}},
+		{Name: "bob"},
+	})
+
+	sealed, err := SealBundle("set-1", bundles, priv)
+	if err != nil {
+		t.Fatalf("SealBundle failed: %v", err)
+	}
+	for _, sb := range sealed {
+		if err := VerifyBundle(sb, pub); err != nil {
+			t.Errorf("VerifyBundle failed for %s: %v", sb.Custodian.Name, err)
+		}
+	}
+
+	// A custodian stores the binary form and verifies it years later.
+	data, err := sealed[0].MarshalBinary()
+	if err != nil {
+		t.Fatalf("MarshalBinary failed: %v", err)
+	}
+	var restored SealedBundle
+	if err := restored.UnmarshalBinary(data); err != nil {
+		t.Fatalf("UnmarshalBinary failed: %v", err)
+	}
+	if err := VerifyBundle(restored, pub); err != nil {
+		t.Errorf("VerifyBundle failed after round trip: %v", err)
+	}
+	if restored.SetID != "set-1" || restored.Custodian.Contact != "alice@example.com" ||
+		!bytes.Equal(restored.Share.Value, shares[0].Value) || !restored.IssuedAt.Equal(sealed[0].IssuedAt) {
+		t.Errorf("Unexpected restored bundle %+v", restored)
+	}
+}
+
+func TestVerifyBundle_Tampered(t *testing.T) {
+	pub, priv, _ := ed25519.GenerateKey(nil)
+	otherPub, _, _ := ed25519.GenerateKey(nil)
+	shares, _ := Split([]byte("secret"), 2, 2)
+	bundles, _ := AssignShares(shares, []Custodian{{Name: "alice"}, {Name: "bob"}})
+	sealed, _ := SealBundle("set-1", bundles, priv)
+
+	if err := VerifyBundle(sealed[0], otherPub); !errors.Is(err, ErrBadSeal) {
+		t.Errorf("Expected ErrBadSeal for wrong dealer key, got %v", err)
+	}
+
+	tampered := sealed[0]
+	tampered.Share.Value = append([]byte(nil), tampered.Share.Value...)
+	tampered.Share.Value[0] ^= 1
+	if err := VerifyBundle(tampered, pub); !errors.Is(err, ErrBadSeal) {
+		t.Errorf("Expected ErrBadSeal for modified share, got %v", err)
+	}
+
+	swapped := sealed[0]
+	swapped.Custodian = sealed[1].Custodian
+	if err := VerifyBundle(swapped, pub); !errors.Is(err, ErrBadSeal) {
+		t.Errorf("Expected ErrBadSeal for reassigned bundle, got %v", err)
+	}
+
+	data, _ := sealed[0].MarshalBinary()
+	var sb SealedBundle
+	if err := sb.UnmarshalBinary(data[:len(data)-1]); err == nil {
+		t.Error("Expected error for truncated bundle")
+	}
+}
@@ -0,0 +1,160 @@
+package goshamir
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"slices"
+)
+
+// errSealedShareMarshal is returned when a SealedShare is passed to an
+// encoder that would copy its value.
+var errSealedShareMarshal = errors.New("sealed share cannot be marshaled; use WriteTo")
+
+// sealedShareIDKey keys SealedShare.ID. It is drawn once per process, so
+// IDs cannot be matched against guessed share values outside it.
+var sealedShareIDKey = func() []byte {
+	key := make([]byte, 32)
+	rand.Read(key)
+	return key
+}()
+
+// SealedShare is a read-only view of a share whose value can only leave it
+// through WriteTo or an explicit conversion with Share. Printing it or
+// logging it with log/slog reveals only its index, scheme and ID, and
+// encoding it as JSON or text fails, so a SealedShare can be passed through
+// code that might log, cache or serialize it without leaking the value.
+//
+// Copies of a SealedShare share their value, so Wipe affects all of them.
+// The zero value holds no share.
+type SealedShare struct {
+	share Share
+}
+
+// NewSealedShare returns a SealedShare holding a copy of s. The caller
+// should wipe s once it is no longer needed.
+func NewSealedShare(s Share) SealedShare {
+	return SealedShare{share: Share{
+		Index:     s.Index,
+		Value:     slices.Clone(s.Value),
+		Scheme:    schemeOf(s),
+		Watermark: slices.Clone(s.Watermark),
+		Parents:   slices.Clone(s.Parents),
+		Peppered:  s.Peppered,
+	}}
+}
+
+// ReadSealedShare reads a share envelope written by WriteTo, consuming r.
+func ReadSealedShare(r io.Reader) (SealedShare, error) {
+	data, err := io.ReadAll(io.LimitReader(r, maxEnvelopeField+1))
+	if err != nil {
+		return SealedShare{}, err
+	}
+	defer clear(data)
+	var s Share
+	if err := s.UnmarshalBinary(data); err != nil {
+		return SealedShare{}, err
+	}
+	return NewSealedShare(s), nil
+}
+
+// Share returns a copy of the share, including its value. The caller owns
+// the copy and should wipe it after use.
+func (s SealedShare) Share() Share {
+	return NewSealedShare(s.share).share
+}
+
+// Index returns the share index.
+func (s SealedShare) Index() uint8 {
+	return s.share.Index
+}
+
+// Scheme returns the share's scheme.
+func (s SealedShare) Scheme() Scheme {
+	return s.share.Scheme
+}
+
+// Len returns the size of the share value in bytes.
+func (s SealedShare) Len() int {
+	return len(s.share.Value)
+}
+
+// Fingerprint returns the short hash identifying the share, as reported by
+// InspectShare. It is an unkeyed hash of the value, so for short secrets it
+// lets anyone holding it confirm guesses of the value: log ID instead.
+func (s SealedShare) Fingerprint() string {
+	return shareFingerprint(s.share)
+}
+
+// ID returns a short identifier of the share for logs, keyed with a random
+// key drawn once per process. Copies of a share have the same ID within a
+// process, but IDs do not reveal anything about the value and differ
+// between processes.
+func (s SealedShare) ID() string {
+	mac := hmac.New(sha256.New, sealedShareIDKey)
+	mac.Write([]byte{byte(s.share.Scheme), s.share.Index})
+	mac.Write(s.share.Value)
+	return hex.EncodeToString(mac.Sum(nil)[:8])
+}
+
+// WriteTo writes the share's binary envelope (see Share.MarshalBinary) to
+// w. It implements io.WriterTo.
+func (s SealedShare) WriteTo(w io.Writer) (int64, error) {
+	envelope, err := s.share.MarshalBinary()
+	if err != nil {
+		return 0, err
+	}
+	defer clear(envelope)
+	n, err := w.Write(envelope)
+	return int64(n), err
+}
+
+// Wipe zeroes the share value. The SealedShare, and every copy of it, is
+// unusable afterwards.
+func (s SealedShare) Wipe() {
+	clear(s.share.Value)
+}
+
+// String returns a description without the value, such as
+// "share 3 (v1, id 1a2b3c4d5e6f7081)".
+func (s SealedShare) String() string {
+	if s.share.Index == 0 {
+		return "share (empty)"
+	}
+	return fmt.Sprintf("share %d (%s, id %s)", s.share.Index, s.share.Scheme, s.ID())
+}
+
+// GoString returns the same as String, so %#v does not print the value.
+func (s SealedShare) GoString() string {
+	return s.String()
+}
+
+// Format prints String for every verb.
+func (s SealedShare) Format(f fmt.State, _ rune) {
+	io.WriteString(f, s.String())
+}
+
+// LogValue implements slog.LogValuer, logging the index, scheme and ID.
+func (s SealedShare) LogValue() slog.Value {
+	return slog.GroupValue(
+		slog.Int("index", int(s.share.Index)),
+		slog.String("scheme", s.share.Scheme.String()),
+		slog.String("id", s.ID()),
+	)
+}
+
+// MarshalJSON always fails, so a SealedShare is never copied into JSON.
+func (s SealedShare) MarshalJSON() ([]byte, error) {
+	return nil, errSealedShareMarshal
+}
+
+// MarshalText always fails, so a SealedShare is never copied into text
+// encodings.
+func (s SealedShare) MarshalText() ([]byte, error) {
+	return nil, errSealedShareMarshal
+}
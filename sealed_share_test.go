@@ -0,0 +1,108 @@
+package goshamir
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"strings"
+	"testing"
+)
+
+// --- SealedShare Tests ---
+
+func TestSealedShare_RoundTrip(t *testing.T) {
+	shares, _ := Split([]byte("sealed"), 3, 2)
+	sealed := make([]SealedShare, len(shares))
+	for i, s := range shares {
+		sealed[i] = NewSealedShare(s)
+	}
+	clear(shares[0].Value)
+
+	var buf bytes.Buffer
+	if _, err := sealed[0].WriteTo(&buf); err != nil {
+		t.Fatalf("WriteTo failed: %v", err)
+	}
+	read, err := ReadSealedShare(&buf)
+	if err != nil {
+		t.Fatalf("ReadSealedShare failed: %v", err)
+	}
+	if read.Fingerprint() != sealed[0].Fingerprint() || read.Index() != 1 || read.Len() != sealed[0].Len() {
+		t.Fatalf("read %v, want %v", read, sealed[0])
+	}
+
+	secret, err := Combine([]Share{read.Share(), sealed[2].Share()}, 2)
+	if err != nil {
+		t.Fatalf("Combine failed: %v", err)
+	}
+	if string(secret) != "sealed" {
+		t.Fatalf("Combine = %q", secret)
+	}
+}
+
+func TestSealedShare_DoesNotLeakValue(t *testing.T) {
+	share := Share{Index: 4, Value: []byte("TOPSECRETVALUE"), Scheme: SchemeV2GF256}
+	sealed := NewSealedShare(share)
+
+	for _, verb := range []string{"%v", "%+v", "%#v", "%s", "%q", "%x"} {
+		if out := fmt.Sprintf(verb, sealed); strings.Contains(out, "TOPSECRET") || strings.Contains(out, "544f50") {
+			t.Errorf("%s leaked the value: %s", verb, out)
+		}
+	}
+	if out := fmt.Sprintf("%v", struct{ S SealedShare }{sealed}); strings.Contains(out, "TOPSECRET") {
+		t.Errorf("nested formatting leaked the value: %s", out)
+	}
+
+	var logs bytes.Buffer
+	slog.New(slog.NewTextHandler(&logs, nil)).Info("got share", "share", sealed)
+	if strings.Contains(logs.String(), "TOPSECRET") || !strings.Contains(logs.String(), "share.index=4") {
+		t.Errorf("unexpected log output: %s", logs.String())
+	}
+
+	if _, err := json.Marshal(map[string]any{"share": sealed}); err == nil {
+		t.Error("expected json.Marshal to fail")
+	}
+	if _, err := sealed.MarshalText(); err == nil {
+		t.Error("expected MarshalText to fail")
+	}
+}
+
+func TestSealedShare_LogsKeyedID(t *testing.T) {
+	// A one-byte v2 share could be recovered from an unkeyed hash of it by
+	// trying all 256 values, so neither String nor LogValue may print one.
+	sealed := NewSealedShare(Share{Index: 2, Value: []byte{0x42}, Scheme: SchemeV2GF256})
+	var logs bytes.Buffer
+	slog.New(slog.NewTextHandler(&logs, nil)).Info("got share", "share", sealed)
+	for _, out := range []string{sealed.String(), logs.String()} {
+		if strings.Contains(out, sealed.Fingerprint()) {
+			t.Errorf("output contains the unkeyed fingerprint: %s", out)
+		}
+		if !strings.Contains(out, sealed.ID()) {
+			t.Errorf("output lacks the share ID: %s", out)
+		}
+	}
+
+	if again := NewSealedShare(sealed.Share()); again.ID() != sealed.ID() {
+		t.Error("copies of a share have different IDs")
+	}
+	other := NewSealedShare(Share{Index: 2, Value: []byte{0x43}, Scheme: SchemeV2GF256})
+	if other.ID() == sealed.ID() {
+		t.Error("different shares have the same ID")
+	}
+}
+
+func TestSealedShare_Immutable(t *testing.T) {
+	share := Share{Index: 1, Value: []byte{1, 2, 3}, Scheme: SchemeV2GF256}
+	sealed := NewSealedShare(share)
+	share.Value[0] = 9
+	out := sealed.Share()
+	out.Value[1] = 9
+	if got := sealed.Share().Value; !bytes.Equal(got, []byte{1, 2, 3}) {
+		t.Fatalf("sealed value changed to %v", got)
+	}
+
+	sealed.Wipe()
+	if got := sealed.Share().Value; !bytes.Equal(got, []byte{0, 0, 0}) {
+		t.Fatalf("Wipe left %v", got)
+	}
+}
@@ -0,0 +1,259 @@
+// Package secretcache caches secrets reconstructed from shares, so that
+// services needing a secret repeatedly do not have to run a recovery
+// ceremony each time, without holding the plaintext indefinitely.
+//
+// Cached secrets are encrypted with AES-256-GCM under a key generated when
+// the Cache is created, which exists only in the process's memory and is
+// erased by Close. Each entry expires after a TTL and after a maximum
+// number of uses, whichever comes first; an expired entry is erased and the
+// next Get or GetOrCombine reports it missing.
+//
+//	cache, err := secretcache.New(secretcache.Config{TTL: 10 * time.Minute, MaxUses: 100})
+//	defer cache.Close()
+//
+//	key, err := cache.GetOrCombine("payments", func() ([]byte, error) {
+//	    return goshamir.Combine(collectShares(), 3)
+//	})
+//	defer clear(key)
+package secretcache
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"io"
+	"sync"
+	"time"
+)
+
+// ErrNotCached is returned for a name with no live entry: it was never
+// cached, has expired, has been used up or was deleted.
+var ErrNotCached = errors.New("secret is not cached")
+
+// ErrClosed is returned by a Cache after Close.
+var ErrClosed = errors.New("secret cache is closed")
+
+// Config configures a Cache.
+type Config struct {
+	// TTL is how long an entry lives after it is stored. It must be
+	// positive.
+	TTL time.Duration
+	// MaxUses is the number of times an entry can be read before it is
+	// erased. Zero means no limit.
+	MaxUses int
+	// Rand is the source of randomness for the process key and nonces.
+	// Defaults to crypto/rand.Reader.
+	Rand io.Reader
+	// Now returns the current time. Defaults to time.Now.
+	Now func() time.Time
+}
+
+// entry is one cached secret, sealed under the cache's key.
+type entry struct {
+	sealed    []byte
+	expires   time.Time
+	remaining int
+}
+
+// Cache is an in-memory cache of encrypted secrets. A Cache is safe for
+// concurrent use.
+type Cache struct {
+	cfg     Config
+	mu      sync.Mutex
+	key     []byte
+	aead    cipher.AEAD
+	entries map[string]*entry
+	// pending serializes GetOrCombine calls per name, so that concurrent
+	// callers share one ceremony.
+	pending map[string]*pendingCombine
+}
+
+// pendingCombine is the lock of the GetOrCombine calls for one name and
+// the number of calls holding or waiting for it.
+type pendingCombine struct {
+	mu      sync.Mutex
+	waiters int
+}
+
+// New returns an empty Cache with a fresh process key.
+func New(cfg Config) (*Cache, error) {
+	if cfg.TTL <= 0 {
+		return nil, errors.New("cache TTL must be positive")
+	}
+	if cfg.MaxUses < 0 {
+		return nil, errors.New("max uses cannot be negative")
+	}
+	if cfg.Rand == nil {
+		cfg.Rand = rand.Reader
+	}
+	if cfg.Now == nil {
+		cfg.Now = time.Now
+	}
+	key := make([]byte, 32)
+	if _, err := io.ReadFull(cfg.Rand, key); err != nil {
+		return nil, fmt.Errorf("generating process key: %w", err)
+	}
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, err
+	}
+	return &Cache{cfg: cfg, key: key, aead: aead, entries: make(map[string]*entry), pending: make(map[string]*pendingCombine)}, nil
+}
+
+// Put encrypts a copy of secret and caches it under name, replacing any
+// previous entry, for the configured TTL and number of uses. The caller
+// may clear secret afterwards.
+func (c *Cache) Put(name string, secret []byte) error {
+	if len(secret) == 0 {
+		return errors.New("secret cannot be empty")
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.aead == nil {
+		return ErrClosed
+	}
+	nonce := make([]byte, c.aead.NonceSize(), c.aead.NonceSize()+len(secret)+c.aead.Overhead())
+	if _, err := io.ReadFull(c.cfg.Rand, nonce); err != nil {
+		return fmt.Errorf("generating nonce: %w", err)
+	}
+	now := c.cfg.Now()
+	c.sweep(now)
+	c.erase(name)
+	c.entries[name] = &entry{
+		// The name is authenticated so entries cannot be swapped.
+		sealed:    c.aead.Seal(nonce, nonce, secret, []byte(name)),
+		expires:   now.Add(c.cfg.TTL),
+		remaining: c.cfg.MaxUses,
+	}
+	return nil
+}
+
+// Get decrypts and returns the secret cached under name, counting one use.
+// The caller owns the returned slice and should clear it when done.
+func (c *Cache) Get(name string) ([]byte, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.aead == nil {
+		return nil, ErrClosed
+	}
+	now := c.cfg.Now()
+	e, ok := c.entries[name]
+	if !ok || !now.Before(e.expires) {
+		c.erase(name)
+		return nil, fmt.Errorf("%w: %q", ErrNotCached, name)
+	}
+	n := c.aead.NonceSize()
+	secret, err := c.aead.Open(nil, e.sealed[:n], e.sealed[n:], []byte(name))
+	if err != nil {
+		c.erase(name)
+		return nil, fmt.Errorf("decrypting cached secret: %w", err)
+	}
+	if e.remaining > 0 {
+		if e.remaining--; e.remaining == 0 {
+			c.erase(name)
+		}
+	}
+	return secret, nil
+}
+
+// GetOrCombine returns the secret cached under name or, if there is none,
+// runs combine, typically a recovery ceremony, caches its result and
+// returns it. Concurrent calls for the same name wait for a single run of
+// combine. A fresh result counts as the entry's first use.
+func (c *Cache) GetOrCombine(name string, combine func() ([]byte, error)) ([]byte, error) {
+	c.mu.Lock()
+	if c.aead == nil {
+		c.mu.Unlock()
+		return nil, ErrClosed
+	}
+	p, ok := c.pending[name]
+	if !ok {
+		p = new(pendingCombine)
+		c.pending[name] = p
+	}
+	p.waiters++
+	c.mu.Unlock()
+
+	p.mu.Lock()
+	defer func() {
+		p.mu.Unlock()
+		c.mu.Lock()
+		if p.waiters--; p.waiters == 0 {
+			delete(c.pending, name)
+		}
+		c.mu.Unlock()
+	}()
+	if secret, err := c.Get(name); !errors.Is(err, ErrNotCached) {
+		return secret, err
+	}
+	secret, err := combine()
+	if err != nil {
+		return nil, err
+	}
+	if err := c.Put(name, secret); err != nil {
+		clear(secret)
+		return nil, err
+	}
+	if c.cfg.MaxUses > 0 {
+		c.mu.Lock()
+		if e, ok := c.entries[name]; ok {
+			if e.remaining--; e.remaining == 0 {
+				c.erase(name)
+			}
+		}
+		c.mu.Unlock()
+	}
+	return secret, nil
+}
+
+// Delete erases the entry cached under name, if any.
+func (c *Cache) Delete(name string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.erase(name)
+}
+
+// Len returns the number of live entries.
+func (c *Cache) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.aead != nil {
+		c.sweep(c.cfg.Now())
+	}
+	return len(c.entries)
+}
+
+// Close erases every entry and the process key and drops the cipher.
+// Afterwards the cache returns ErrClosed.
+func (c *Cache) Close() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for name := range c.entries {
+		c.erase(name)
+	}
+	clear(c.key)
+	c.aead = nil
+}
+
+// sweep erases the entries that expired by now. The caller holds c.mu.
+func (c *Cache) sweep(now time.Time) {
+	for name, e := range c.entries {
+		if !now.Before(e.expires) {
+			c.erase(name)
+		}
+	}
+}
+
+// erase clears and removes an entry. The caller holds c.mu.
+func (c *Cache) erase(name string) {
+	if e, ok := c.entries[name]; ok {
+		clear(e.sealed)
+		delete(c.entries, name)
+	}
+}
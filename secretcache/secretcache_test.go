@@ -0,0 +1,157 @@
+package secretcache
+
+import (
+	"bytes"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+type fakeClock struct {
+	mu  sync.Mutex
+	now time.Time
+}
+
+func (c *fakeClock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.now
+}
+
+func (c *fakeClock) Advance(d time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.now = c.now.Add(d)
+}
+
+func newTestCache(t *testing.T, maxUses int) (*Cache, *fakeClock) {
+	t.Helper()
+	clock := &fakeClock{now: time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)}
+	c, err := New(Config{TTL: time.Minute, MaxUses: maxUses, Now: clock.Now})
+	if err != nil {
+		t.Fatalf("New failed: %v", err)
+	}
+	return c, clock
+}
+
+// --- Cache Tests ---
+
+func TestCache_EncryptsEntries(t *testing.T) {
+	c, _ := newTestCache(t, 0)
+	secret := []byte("combined secret value")
+	if err := c.Put("db", secret); err != nil {
+		t.Fatalf("Put failed: %v", err)
+	}
+	if bytes.Contains(c.entries["db"].sealed, secret) {
+		t.Fatal("cache holds the plaintext")
+	}
+	clear(secret)
+	got, err := c.Get("db")
+	if err != nil {
+		t.Fatalf("Get failed: %v", err)
+	}
+	if string(got) != "combined secret value" {
+		t.Fatalf("got %q", got)
+	}
+
+	// Entries are bound to their names.
+	c.entries["other"] = c.entries["db"]
+	if _, err := c.Get("other"); err == nil || errors.Is(err, ErrNotCached) {
+		t.Fatalf("expected a decryption error for a swapped entry, got %v", err)
+	}
+}
+
+func TestCache_TTL(t *testing.T) {
+	c, clock := newTestCache(t, 0)
+	c.Put("db", []byte("secret"))
+	clock.Advance(59 * time.Second)
+	if _, err := c.Get("db"); err != nil {
+		t.Fatalf("Get failed before expiry: %v", err)
+	}
+	clock.Advance(time.Second)
+	if _, err := c.Get("db"); !errors.Is(err, ErrNotCached) {
+		t.Fatalf("expected ErrNotCached after expiry, got %v", err)
+	}
+	if c.Len() != 0 {
+		t.Fatal("expired entry was not erased")
+	}
+}
+
+func TestCache_MaxUses(t *testing.T) {
+	c, _ := newTestCache(t, 2)
+	c.Put("db", []byte("secret"))
+	for i := range 2 {
+		if _, err := c.Get("db"); err != nil {
+			t.Fatalf("Get %d failed: %v", i, err)
+		}
+	}
+	if _, err := c.Get("db"); !errors.Is(err, ErrNotCached) {
+		t.Fatalf("expected ErrNotCached after max uses, got %v", err)
+	}
+}
+
+func TestCache_GetOrCombine(t *testing.T) {
+	c, clock := newTestCache(t, 3)
+	var ceremonies atomic.Int32
+	combine := func() ([]byte, error) {
+		ceremonies.Add(1)
+		time.Sleep(10 * time.Millisecond)
+		return []byte("secret"), nil
+	}
+
+	var wg sync.WaitGroup
+	for range 3 {
+		wg.Go(func() {
+			got, err := c.GetOrCombine("db", combine)
+			if err != nil || string(got) != "secret" {
+				t.Errorf("GetOrCombine returned %q, %v", got, err)
+			}
+		})
+	}
+	wg.Wait()
+	if n := ceremonies.Load(); n != 1 {
+		t.Fatalf("ran %d ceremonies, want 1", n)
+	}
+	// The three calls used up the entry, so the next runs a ceremony.
+	c.GetOrCombine("db", combine)
+	clock.Advance(time.Hour)
+	c.GetOrCombine("db", combine)
+	if n := ceremonies.Load(); n != 3 {
+		t.Fatalf("ran %d ceremonies, want 3", n)
+	}
+	if len(c.pending) != 0 {
+		t.Fatal("pending combine locks were not released")
+	}
+
+	failed := errors.New("quorum not reached")
+	if _, err := c.GetOrCombine("other", func() ([]byte, error) { return nil, failed }); !errors.Is(err, failed) {
+		t.Fatalf("expected the ceremony error, got %v", err)
+	}
+}
+
+func TestCache_Close(t *testing.T) {
+	c, _ := newTestCache(t, 0)
+	c.Put("db", []byte("secret"))
+	key := c.key
+	c.Close()
+	if !bytes.Equal(key, make([]byte, 32)) || c.Len() != 0 {
+		t.Fatal("Close did not erase the key and entries")
+	}
+	if _, err := c.Get("db"); !errors.Is(err, ErrClosed) {
+		t.Fatalf("expected ErrClosed, got %v", err)
+	}
+	if err := c.Put("db", []byte("secret")); !errors.Is(err, ErrClosed) {
+		t.Fatalf("expected ErrClosed, got %v", err)
+	}
+}
+
+func TestNew_Invalid(t *testing.T) {
+	if _, err := New(Config{}); err == nil {
+		t.Error("expected error for zero TTL")
+	}
+	if _, err := New(Config{TTL: time.Minute, MaxUses: -1}); err == nil {
+		t.Error("expected error for negative max uses")
+	}
+}
@@ -0,0 +1,183 @@
+package goshamir
+
+import (
+	"cmp"
+	"crypto/rand"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"math/big"
+	"slices"
+)
+
+// maxVoteQuorums bounds the number of quorums SelectVote combines.
+const maxVoteQuorums = 10000
+
+// ErrNoMajority is returned by SelectVote when no secret is reconstructed
+// by more quorums than any other.
+var ErrNoMajority = errors.New("no secret reconstructed by more quorums than any other")
+
+// Selection chooses which threshold shares CombineSelect combines when
+// more are supplied. Combine always uses the first threshold, silently
+// ignoring the rest.
+type Selection int
+
+const (
+	// SelectFirst combines the first threshold shares, like Combine.
+	SelectFirst Selection = iota
+	// SelectLowestIndices combines the threshold shares with the lowest
+	// indices, so the result does not depend on the order shares arrived
+	// in.
+	SelectLowestIndices
+	// SelectRandom combines threshold shares chosen uniformly at random
+	// with the configured source of randomness.
+	SelectRandom
+	// SelectVote combines every quorum of threshold shares and returns the
+	// secret reconstructed by the most quorums, outvoting a minority of
+	// corrupted shares, since quorums including a corrupted share rarely
+	// agree with each other. It fails with ErrNoMajority on a tie. The number
+	// of quorums grows quickly with the number of extra shares and is
+	// capped at 10000.
+	SelectVote
+)
+
+// String returns a short name for the selection, such as "vote".
+func (s Selection) String() string {
+	switch s {
+	case SelectFirst:
+		return "first"
+	case SelectLowestIndices:
+		return "lowest-indices"
+	case SelectRandom:
+		return "random"
+	case SelectVote:
+		return "vote"
+	}
+	return fmt.Sprintf("Selection(%d)", int(s))
+}
+
+// CombineSelect reconstructs the secret from threshold of shares, chosen
+// with sel. WithRandom is the option that applies, for SelectRandom.
+func CombineSelect(shares []Share, threshold int, sel Selection, opts ...Option) (_ []byte, err error) {
+	defer recoverInternal(&err)
+
+	if err := checkThreshold(threshold); err != nil {
+		return nil, err
+	}
+	if len(shares) < threshold {
+		return nil, fmt.Errorf("need at least %d shares, got %d", threshold, len(shares))
+	}
+	switch sel {
+	case SelectFirst:
+		return Combine(shares, threshold)
+	case SelectLowestIndices:
+		sorted := slices.Clone(shares)
+		slices.SortStableFunc(sorted, func(a, b Share) int { return cmp.Compare(a.Index, b.Index) })
+		return Combine(sorted, threshold)
+	case SelectRandom:
+		shuffled := slices.Clone(shares)
+		cfg := NewConfig(opts...)
+		// A partial Fisher-Yates shuffle picks the first threshold.
+		for i := range threshold {
+			j, err := rand.Int(cfg.Rand, big.NewInt(int64(len(shuffled)-i)))
+			if err != nil {
+				return nil, fmt.Errorf("random share selection failed: %w", err)
+			}
+			k := i + int(j.Int64())
+			shuffled[i], shuffled[k] = shuffled[k], shuffled[i]
+		}
+		return Combine(shuffled, threshold)
+	case SelectVote:
+		return combineVote(shares, threshold)
+	}
+	return nil, fmt.Errorf("unknown selection %v", sel)
+}
+
+// combineVote implements SelectVote.
+func combineVote(shares []Share, threshold int) ([]byte, error) {
+	if n := binomial(len(shares), threshold); n < 0 || n > maxVoteQuorums {
+		return nil, fmt.Errorf("voting over %d shares with threshold %d needs more than %d quorums", len(shares), threshold, maxVoteQuorums)
+	}
+	type tally struct {
+		secret []byte
+		votes  int
+	}
+	tallies := make(map[[sha256.Size]byte]*tally)
+	defer func() {
+		for _, t := range tallies {
+			clear(t.secret)
+		}
+	}()
+	var lastErr error
+	forEachQuorum(len(shares), threshold, func(idx []int) {
+		quorum := make([]Share, len(idx))
+		for i, j := range idx {
+			quorum[i] = shares[j]
+		}
+		secret, err := Combine(quorum, threshold)
+		if err != nil {
+			lastErr = err
+			return
+		}
+		key := sha256.Sum256(secret)
+		if t, ok := tallies[key]; ok {
+			t.votes++
+			clear(secret)
+			return
+		}
+		tallies[key] = &tally{secret: secret, votes: 1}
+	})
+	if len(tallies) == 0 && lastErr != nil {
+		return nil, lastErr
+	}
+	var best *tally
+	tie := false
+	for _, t := range tallies {
+		switch {
+		case best == nil || t.votes > best.votes:
+			best, tie = t, false
+		case t.votes == best.votes:
+			tie = true
+		}
+	}
+	if best == nil || tie {
+		return nil, ErrNoMajority
+	}
+	return slices.Clone(best.secret), nil
+}
+
+// forEachQuorum calls fn with the indices of every k-subset of n items, in
+// lexicographic order. fn must not retain the slice.
+func forEachQuorum(n, k int, fn func([]int)) {
+	idx := make([]int, k)
+	for i := range idx {
+		idx[i] = i
+	}
+	for {
+		fn(idx)
+		i := k - 1
+		for i >= 0 && idx[i] == n-k+i {
+			i--
+		}
+		if i < 0 {
+			return
+		}
+		idx[i]++
+		for j := i + 1; j < k; j++ {
+			idx[j] = idx[j-1] + 1
+		}
+	}
+}
+
+// binomial returns n choose k, or -1 if it exceeds maxVoteQuorums.
+func binomial(n, k int) int {
+	k = min(k, n-k)
+	result := 1
+	for i := 1; i <= k; i++ {
+		result = result * (n - k + i) / i
+		if result > maxVoteQuorums {
+			return -1
+		}
+	}
+	return result
+}
@@ -0,0 +1,70 @@
+package goshamir
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+)
+
+// --- Selection Tests ---
+
+func TestCombineSelect_Strategies(t *testing.T) {
+	secret := []byte("choose wisely")
+	s, _ := NewSplitter(5, 3, WithScheme(SchemeV2GF256))
+	shares, _ := s.Split(secret)
+	// Share 5 arrived first but is corrupted.
+	shares[4].Value[0] ^= 0xFF
+	ordered := []Share{shares[4], shares[2], shares[0], shares[1], shares[3]}
+
+	got, err := CombineSelect(ordered, 3, SelectFirst)
+	if err != nil {
+		t.Fatalf("CombineSelect failed: %v", err)
+	}
+	if bytes.Equal(got, secret) {
+		t.Fatal("expected SelectFirst to use the corrupted share")
+	}
+
+	for _, sel := range []Selection{SelectLowestIndices, SelectVote} {
+		got, err := CombineSelect(ordered, 3, sel)
+		if err != nil {
+			t.Fatalf("CombineSelect(%v) failed: %v", sel, err)
+		}
+		if !bytes.Equal(got, secret) {
+			t.Errorf("CombineSelect(%v) = %q, want %q", sel, got, secret)
+		}
+	}
+}
+
+func TestCombineSelect_Random(t *testing.T) {
+	secret := []byte("random quorum")
+	shares, _ := Split(secret, 6, 2)
+	for range 20 {
+		got, err := CombineSelect(shares, 2, SelectRandom)
+		if err != nil {
+			t.Fatalf("CombineSelect failed: %v", err)
+		}
+		if !bytes.Equal(got, secret) {
+			t.Fatalf("Expected %q, got %q", secret, got)
+		}
+	}
+	if _, err := CombineSelect(shares, 2, SelectRandom, WithRandom(bytes.NewReader(nil))); err == nil {
+		t.Fatal("expected error when randomness runs out")
+	}
+}
+
+func TestCombineSelect_VoteNoMajority(t *testing.T) {
+	// GF(2^8) values stay valid when tampered with, so every quorum votes.
+	s, _ := NewSplitter(4, 2, WithScheme(SchemeV2GF256))
+	shares, _ := s.Split([]byte("tampered"))
+	shares[0].Value[0] ^= 1
+	shares[1].Value[0] ^= 4
+	// Only the quorum {3, 4} is honest: 1 of 6.
+	if _, err := CombineSelect(shares, 2, SelectVote); !errors.Is(err, ErrNoMajority) {
+		t.Fatalf("expected ErrNoMajority, got %v", err)
+	}
+
+	many, _ := Split([]byte("x"), 40, 10)
+	if _, err := CombineSelect(many, 10, SelectVote); err == nil {
+		t.Fatal("expected error for too many quorums")
+	}
+}
@@ -0,0 +1,159 @@
+package goshamir
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"slices"
+)
+
+// ErrSelfTest is returned by SelfTest when a known-answer test fails.
+var ErrSelfTest = errors.New("self-test failed")
+
+// selfTestVectors are shares of "hello" split 2-of-3 with
+// WithDeterministicCoefficients([]byte("golden")), the same vectors the
+// deterministic mode guarantees across releases.
+var selfTestVectors = map[Scheme][]string{
+	SchemeV1GF257: {"1:e4009c00fb003e009f00", "2:5f00d30089001000cf00", "3:db0009001700e300ff00"},
+	SchemeV2GF256: {"v2:1:70f8c51ee9", "v2:2:5844258878", "v2:3:40d98cfafe"},
+}
+
+// SelfTest runs known-answer tests of the field arithmetic of every scheme,
+// of splitting and combining, and of the hex and binary share encodings,
+// and returns an error wrapping ErrSelfTest naming the first failure. It
+// takes well under a millisecond, so deployments whose operational policy
+// requires verifying cryptographic code at startup can call it before
+// handling any secret and refuse to start if it fails.
+func SelfTest() (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("%w: panic: %v", ErrSelfTest, r)
+		}
+	}()
+	for _, t := range []struct {
+		name string
+		run  func() error
+	}{
+		{"GF(257) arithmetic", selfTestGF257},
+		{"GF(2^8) arithmetic", selfTestGF256},
+		{"split", selfTestSplit},
+		{"combine", selfTestCombine},
+		{"encoding", selfTestEncoding},
+	} {
+		if err := t.run(); err != nil {
+			return fmt.Errorf("%w: %s: %v", ErrSelfTest, t.name, err)
+		}
+	}
+	return nil
+}
+
+func selfTestGF257() error {
+	for _, kat := range []struct {
+		op   string
+		got  uint16
+		want uint16
+	}{
+		{"200+100", gfAdd(200, 100), 43},
+		{"5-10", gfSub(5, 10), 252},
+		{"3*86", gfMul(3, 86), 1},
+		{"256*256", gfMul(256, 256), 1},
+		{"1/3", gfInv(3), 86},
+		{"1/256", gfInv(256), 256},
+	} {
+		if kat.got != kat.want {
+			return fmt.Errorf("%s = %d, want %d", kat.op, kat.got, kat.want)
+		}
+	}
+	return nil
+}
+
+func selfTestGF256() error {
+	for _, kat := range []struct {
+		op        string
+		got, want byte
+	}{
+		// Products from FIPS 197, which uses the same field.
+		{"57*83", gf256Mul(0x57, 0x83), 0xc1},
+		{"53*ca", gf256Mul(0x53, 0xca), 0x01},
+		{"1/53", gf256Inv(0x53), 0xca},
+		{"0*ff", gf256Mul(0, 0xff), 0},
+	} {
+		if kat.got != kat.want {
+			return fmt.Errorf("%s = %#02x, want %#02x", kat.op, kat.got, kat.want)
+		}
+	}
+	return nil
+}
+
+func selfTestSplit() error {
+	for _, scheme := range []Scheme{SchemeV1GF257, SchemeV2GF256} {
+		splitter, err := NewSplitter(3, 2, WithScheme(scheme), WithDeterministicCoefficients([]byte("golden")))
+		if err != nil {
+			return err
+		}
+		shares, err := splitter.Split([]byte("hello"))
+		if err != nil {
+			return err
+		}
+		got, err := EncodeSharesToHex(shares)
+		if err != nil {
+			return err
+		}
+		if !slices.Equal(got, selfTestVectors[scheme]) {
+			return fmt.Errorf("scheme %s produced unexpected shares", scheme)
+		}
+	}
+	return nil
+}
+
+func selfTestCombine() error {
+	for _, scheme := range []Scheme{SchemeV1GF257, SchemeV2GF256} {
+		shares, err := DecodeSharesFromHex(selfTestVectors[scheme])
+		if err != nil {
+			return err
+		}
+		for _, pair := range [][]Share{shares[:2], shares[1:], {shares[2], shares[0]}} {
+			secret, err := Combine(pair, 2)
+			if err != nil {
+				return err
+			}
+			if string(secret) != "hello" {
+				return fmt.Errorf("scheme %s combined to %q", scheme, secret)
+			}
+		}
+	}
+	return nil
+}
+
+func selfTestEncoding() error {
+	share := Share{Index: 7, Scheme: SchemeV2GF256, Value: []byte{0x00, 0x7f, 0xff},
+		Parents: []ShareParent{{Index: 2, Threshold: 3}}}
+	encoded, err := EncodeSharesToHex([]Share{share})
+	if err != nil {
+		return err
+	}
+	decoded, err := DecodeSharesFromHex(encoded)
+	if err != nil {
+		return err
+	}
+	if !sameShare(decoded[0], share) {
+		return errors.New("hex round trip changed the share")
+	}
+	envelope, err := share.MarshalBinary()
+	if err != nil {
+		return err
+	}
+	var unmarshaled Share
+	if err := unmarshaled.UnmarshalBinary(envelope); err != nil {
+		return err
+	}
+	if !sameShare(unmarshaled, share) {
+		return errors.New("binary round trip changed the share")
+	}
+	return nil
+}
+
+func sameShare(a, b Share) bool {
+	return a.Index == b.Index && schemeOf(a) == schemeOf(b) &&
+		bytes.Equal(a.Value, b.Value) && slices.Equal(a.Parents, b.Parents)
+}
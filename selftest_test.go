@@ -0,0 +1,37 @@
+package goshamir
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+// --- SelfTest Tests ---
+
+func TestSelfTest_Passes(t *testing.T) {
+	if err := SelfTest(); err != nil {
+		t.Fatalf("SelfTest failed: %v", err)
+	}
+}
+
+func TestSelfTest_DetectsWrongVector(t *testing.T) {
+	saved := selfTestVectors[SchemeV2GF256]
+	defer func() { selfTestVectors[SchemeV2GF256] = saved }()
+	selfTestVectors[SchemeV2GF256] = []string{"v2:1:70f8c51ee9", "v2:2:5844258878", "v2:3:40d98cfaff"}
+
+	err := SelfTest()
+	if !errors.Is(err, ErrSelfTest) {
+		t.Fatalf("expected ErrSelfTest, got %v", err)
+	}
+	if !strings.Contains(err.Error(), "split") {
+		t.Errorf("error does not name the failing test: %v", err)
+	}
+}
+
+func BenchmarkSelfTest(b *testing.B) {
+	for b.Loop() {
+		if err := SelfTest(); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"errors"
 	"fmt"
 	"math/big"
+	"slices"
 )
 
 const (
@@ -22,9 +23,31 @@ const (
 type Share struct {
 	Index uint8
 	Value []byte
+	// Scheme identifies how Value is encoded. The zero value denotes
+	// SchemeV1GF257, the layout of shares created before schemes existed.
+	Scheme Scheme
+	// Watermark optionally identifies the custodian the share was issued
+	// to. It is not needed for reconstruction. See WatermarkBundles.
+	Watermark []byte
+	// Parents records, outermost first, the shares this share was split
+	// from by SplitShare. It is empty for ordinary shares.
+	Parents []ShareParent
+	// Peppered records that Value is encrypted under a pepper, as
+	// WithPepper and PepperShares leave it. Combine rejects peppered
+	// shares; UnpepperShares, or a Reconstructor built with WithPepper,
+	// removes the pepper first. Of the share encodings, only the binary
+	// envelope records it.
+	Peppered bool
+
+	// cleanup wipes Value once the share is unreachable. See
+	// WithZeroizeOnCleanup.
+	cleanup *valueCleanup
 }
 
 // Split divides a secret into n shares requiring k shares to reconstruct.
+// Its behavior is frozen for compatibility: it always produces
+// SchemeV1GF257 shares. New code should prefer package
+// github.com/fawwazid/go-shamir/v2.
 func Split(secret []byte, totalShares, threshold int) ([]Share, error) {
 	if err := validateSplitParams(secret, totalShares, threshold); err != nil {
 		return nil, err
@@ -35,8 +58,9 @@ func Split(secret []byte, totalShares, threshold int) ([]Share, error) {
 	shares := make([]Share, totalShares)
 	for i := range shares {
 		shares[i] = Share{
-			Index: uint8(i + 1),
-			Value: make([]byte, 0, len(secret)*2),
+			Index:  uint8(i + 1),
+			Value:  make([]byte, 0, len(secret)*2),
+			Scheme: SchemeV1GF257,
 		}
 	}
 
@@ -58,19 +82,42 @@ func Split(secret []byte, totalShares, threshold int) ([]Share, error) {
 }
 
 // Combine reconstructs the secret from shares using Lagrange interpolation.
-func Combine(shares []Share, threshold int) ([]byte, error) {
+// The field is chosen from the shares' Scheme; shares of a scheme this
+// version does not support yield an error wrapping ErrUnsupportedScheme.
+func Combine(shares []Share, threshold int) (_ []byte, err error) {
+	defer recoverInternal(&err)
+
 	if err := validateCombineParams(shares, threshold); err != nil {
 		return nil, err
 	}
 
-	prime := big.NewInt(FieldPrime)
 	usedShares := shares[:threshold]
-
+	scheme, err := sharesScheme(usedShares)
+	if err != nil {
+		return nil, err
+	}
 	if err := validateShareIndices(usedShares); err != nil {
 		return nil, err
 	}
+	if err := checkValues(usedShares, scheme); err != nil {
+		return nil, err
+	}
 
-	valueLen := len(shares[0].Value)
+	if scheme == SchemeV2GF256 {
+		return combineGF256(usedShares)
+	}
+	return combineGF257(usedShares)
+}
+
+// combineGF257 reconstructs a secret from shares of SchemeV1GF257.
+func combineGF257(usedShares []Share) ([]byte, error) {
+	if len(usedShares[0].Value)%2 != 0 {
+		return nil, errors.New("share value length must be even")
+	}
+
+	prime := big.NewInt(FieldPrime)
+
+	valueLen := len(usedShares[0].Value)
 	secretLen := valueLen / 2
 	secret := make([]byte, secretLen)
 
@@ -190,6 +237,12 @@ func validateSplitParams(secret []byte, totalShares, threshold int) error {
 	if len(secret) == 0 {
 		return errors.New("secret must not be empty")
 	}
+	return validateShareCounts(totalShares, threshold)
+}
+
+// validateShareCounts validates the share count and threshold pair shared by
+// Split and NewSplitter.
+func validateShareCounts(totalShares, threshold int) error {
 	if threshold < MinThreshold {
 		return fmt.Errorf("threshold must be at least %d", MinThreshold)
 	}
@@ -207,38 +260,60 @@ func validateSplitParams(secret []byte, totalShares, threshold int) error {
 
 // validateCombineParams validates parameters for Combine.
 func validateCombineParams(shares []Share, threshold int) error {
-	if shares == nil {
-		return errors.New("shares cannot be nil")
-	}
 	if len(shares) == 0 {
-		return errors.New("no shares provided")
-	}
-	if threshold < MinThreshold {
-		return fmt.Errorf("threshold must be at least %d", MinThreshold)
+		return &CombineError{Problem: ProblemInsufficientShares, Position: -1, Other: -1, Need: threshold}
 	}
-	if threshold > MaxShares {
-		return fmt.Errorf("threshold must be <= %d", MaxShares)
+	if err := checkThreshold(threshold); err != nil {
+		return err
 	}
-	if len(shares) < threshold {
-		return errors.New("insufficient shares: need at least threshold shares")
+	if err := checkQuorum(shares, threshold); err != nil {
+		return err
 	}
 
 	// Only validate the first threshold shares since those are the ones that will be used
-	usedShares := shares
-	if len(shares) > threshold {
-		usedShares = shares[:threshold]
+	if err := checkNesting(shares[:threshold]); err != nil {
+		return err
 	}
+	if err := checkPeppered(shares[:threshold]); err != nil {
+		return err
+	}
+	return checkLengths(shares[:threshold])
+}
 
-	expectedLen := len(usedShares[0].Value)
-	if expectedLen == 0 {
-		return errors.New("share value cannot be empty")
+// checkThreshold checks that threshold is within the supported range.
+func checkThreshold(threshold int) error {
+	if threshold < MinThreshold || threshold > MaxShares {
+		return &CombineError{Problem: ProblemInvalidThreshold, Position: -1, Other: -1, Need: threshold}
 	}
-	if expectedLen%2 != 0 {
-		return errors.New("share value length must be even")
+	return nil
+}
+
+// checkQuorum checks that shares holds at least threshold distinct valid
+// indices. If it does not, the error points at the first duplicate or zero
+// index, if any.
+func checkQuorum(shares []Share, threshold int) error {
+	have := distinctIndices(shares)
+	if len(shares) >= threshold && have >= threshold {
+		return nil
+	}
+	e := &CombineError{Problem: ProblemInsufficientShares, Position: -1, Other: -1, Need: threshold, Have: have}
+	var cause *CombineError
+	if errors.As(validateShareIndices(shares), &cause) {
+		e.Position, e.Other, e.Index = cause.Position, cause.Other, cause.Index
+	}
+	return e
+}
+
+// checkLengths checks that share values are non-empty and equally long.
+func checkLengths(shares []Share) error {
+	if len(shares[0].Value) == 0 {
+		return newCombineError(ProblemEmptyShare, shares, 0)
 	}
-	for i, s := range usedShares {
-		if len(s.Value) != expectedLen {
-			return fmt.Errorf("share %d has inconsistent length", i)
+	for i, s := range shares {
+		if len(s.Value) != len(shares[0].Value) {
+			e := newCombineError(ProblemLengthMismatch, shares, i)
+			e.ExpectedLength = len(shares[0].Value)
+			return e
 		}
 	}
 	return nil
@@ -246,15 +321,60 @@ func validateCombineParams(shares []Share, threshold int) error {
 
 // validateShareIndices checks that share indices are non-zero and unique.
 func validateShareIndices(shares []Share) error {
-	indices := make(map[uint8]bool, len(shares))
-	for _, s := range shares {
+	var first [MaxShares + 1]int
+	for i, s := range shares {
 		if s.Index == 0 {
-			return errors.New("share index must be non-zero")
+			return newCombineError(ProblemZeroIndex, shares, i)
+		}
+		if first[s.Index] != 0 {
+			e := newCombineError(ProblemDuplicateIndex, shares, i)
+			e.Other = first[s.Index] - 1
+			return e
+		}
+		first[s.Index] = i + 1
+	}
+	return nil
+}
+
+// checkValues checks that share values are valid field elements of the
+// scheme, as combineGF257 and lagrangeInterpolate do.
+func checkValues(shares []Share, scheme Scheme) error {
+	if scheme != SchemeV1GF257 {
+		return nil
+	}
+	if len(shares[0].Value)%2 != 0 {
+		return newCombineError(ProblemCorruptValue, shares, 0)
+	}
+	for pos := range len(shares[0].Value) / 2 {
+		for i, s := range shares {
+			if y, _ := decodeFieldElement(s.Value, pos); y >= FieldPrime {
+				e := newCombineError(ProblemCorruptValue, shares, i)
+				e.Value = int(y)
+				return e
+			}
+		}
+	}
+	return nil
+}
+
+// checkNesting checks that shares are all sub-shares of the same parent, or
+// all top-level shares.
+func checkNesting(shares []Share) error {
+	for i, s := range shares {
+		if !slices.Equal(s.Parents, shares[0].Parents) {
+			return newCombineError(ProblemMixedNesting, shares, i)
 		}
-		if indices[s.Index] {
-			return errors.New("duplicate share index found")
+	}
+	return nil
+}
+
+// checkPeppered rejects peppered shares, whose values would otherwise
+// combine into a wrong secret.
+func checkPeppered(shares []Share) error {
+	for i, s := range shares {
+		if s.Peppered {
+			return newCombineError(ProblemPeppered, shares, i)
 		}
-		indices[s.Index] = true
 	}
 	return nil
 }
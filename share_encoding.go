@@ -19,6 +19,11 @@ var ErrNilShares = errors.New("shares cannot be nil")
 var ErrNilEncoded = errors.New("encoded data cannot be nil")
 
 // EncodeSharesToHex converts shares to hex string format "index:hexvalue".
+// Shares of schemes other than SchemeV1GF257 carry the scheme as a prefix,
+// as in "v2:index:hexvalue", so they cannot be mistaken for legacy shares.
+// A watermark is appended as "#hexwatermark". The index of a sub-share is
+// preceded by its parents as "index.threshold/", outermost first, as in
+// "3.2/1:hexvalue" for sub-share 1 of share 3 with a threshold of 2.
 func EncodeSharesToHex(shares []Share) ([]string, error) {
 	if shares == nil {
 		return nil, ErrNilShares
@@ -33,8 +38,11 @@ func EncodeSharesToHex(shares []Share) ([]string, error) {
 	return result, nil
 }
 
-// DecodeSharesFromHex converts hex-encoded strings back to shares.
-func DecodeSharesFromHex(encoded []string) ([]Share, error) {
+// DecodeSharesFromHex converts hex-encoded strings back to shares. It also
+// accepts the multi-line layout of EncodeShareHexGrouped.
+func DecodeSharesFromHex(encoded []string) (_ []Share, err error) {
+	defer recoverInternal(&err)
+
 	if encoded == nil {
 		return nil, ErrNilEncoded
 	}
@@ -45,21 +53,64 @@ func DecodeSharesFromHex(encoded []string) ([]Share, error) {
 	for i, v := range encoded {
 		share, err := decodeShareFromHex(v)
 		if err != nil {
+			reportHexNearMiss(v)
 			return nil, fmt.Errorf("invalid share at index %d: %w", i, err)
 		}
+		if schemeOf(share) == SchemeV1GF257 {
+			ReportTelemetry(TelemetryEvent{Kind: TelemetryDeprecatedFormat, Op: "DecodeSharesFromHex", Format: "hex", Scheme: SchemeV1GF257, Reason: "legacy GF(257) share"})
+		}
 		shares[i] = share
 	}
 	return shares, nil
 }
 
 func encodeShareToHex(s Share) string {
-	return strconv.FormatUint(uint64(s.Index), 10) + ":" + hex.EncodeToString(s.Value)
+	var path string
+	for _, p := range s.Parents {
+		path += strconv.Itoa(int(p.Index)) + "." + strconv.Itoa(int(p.Threshold)) + "/"
+	}
+	encoded := path + strconv.FormatUint(uint64(s.Index), 10) + ":" + hex.EncodeToString(s.Value)
+	if len(s.Watermark) > 0 {
+		encoded += "#" + hex.EncodeToString(s.Watermark)
+	}
+	if scheme := schemeOf(s); scheme != SchemeV1GF257 {
+		return scheme.String() + ":" + encoded
+	}
+	return encoded
 }
 
 func decodeShareFromHex(encoded string) (Share, error) {
 	if encoded == "" {
 		return Share{}, ErrInvalidEncodedShare
 	}
+	if strings.Contains(encoded, "\n") {
+		return decodeGroupedHex(encoded)
+	}
+
+	var watermark []byte
+	if body, mark, ok := strings.Cut(encoded, "#"); ok {
+		var err error
+		if watermark, err = hex.DecodeString(mark); err != nil || len(watermark) == 0 {
+			return Share{}, ErrInvalidEncodedShare
+		}
+		encoded = body
+	}
+
+	scheme := SchemeV1GF257
+	if strings.HasPrefix(encoded, "v") {
+		prefix, rest, ok := strings.Cut(encoded[1:], ":")
+		if !ok {
+			return Share{}, ErrInvalidEncodedShare
+		}
+		// Unknown schemes decode successfully so that Combine can report
+		// them as unsupported rather than as malformed input.
+		version, err := strconv.ParseUint(prefix, 10, 8)
+		if err != nil || version == 0 {
+			return Share{}, ErrInvalidEncodedShare
+		}
+		scheme, encoded = Scheme(version), rest
+	}
+
 	parts := strings.SplitN(encoded, ":", 2)
 	if len(parts) != 2 {
 		return Share{}, ErrInvalidEncodedShare
@@ -68,6 +119,22 @@ func decodeShareFromHex(encoded string) (Share, error) {
 		return Share{}, ErrInvalidEncodedShare
 	}
 
+	var parents []ShareParent
+	for {
+		parent, rest, ok := strings.Cut(parts[0], "/")
+		if !ok {
+			break
+		}
+		idx, thr, ok := strings.Cut(parent, ".")
+		pi, err1 := strconv.ParseUint(idx, 10, 8)
+		pt, err2 := strconv.ParseUint(thr, 10, 8)
+		if !ok || err1 != nil || err2 != nil || pi == 0 || pt < MinThreshold {
+			return Share{}, ErrInvalidEncodedShare
+		}
+		parents = append(parents, ShareParent{Index: uint8(pi), Threshold: uint8(pt)})
+		parts[0] = rest
+	}
+
 	index, err := strconv.ParseUint(parts[0], 10, 8)
 	if err != nil {
 		return Share{}, ErrInvalidEncodedShare
@@ -84,5 +151,5 @@ func decodeShareFromHex(encoded string) (Share, error) {
 		return Share{}, ErrInvalidEncodedShare
 	}
 
-	return Share{Index: uint8(index), Value: value}, nil
+	return Share{Index: uint8(index), Value: value, Scheme: scheme, Watermark: watermark, Parents: parents}, nil
 }
@@ -0,0 +1,115 @@
+package goshamir
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+)
+
+// renameFile is os.Rename, replaceable in tests to simulate failures.
+var renameFile = os.Rename
+
+// linkFile is os.Link, replaceable in tests to simulate failures and races.
+var linkFile = os.Link
+
+// WriteShareFiles writes each share, hex encoded, to a file in dir named by
+// the corresponding entry of names, and returns the paths written. Names are
+// typically produced by LabelTemplate.Labels.
+//
+// The write is all-or-nothing: every share is first written to a temporary
+// file and synced, then the files are hard-linked into place and the
+// directory is synced. On any failure, files already written are removed,
+// so a crash or error never leaves a partial set of shares behind
+// unnoticed. Existing files are never overwritten, even if they appear
+// while the shares are being written: linking fails rather than replace
+// them. The directory must be on a file system that supports hard links.
+func WriteShareFiles(dir string, names []string, shares []Share) ([]string, error) {
+	if len(names) != len(shares) {
+		return nil, fmt.Errorf("got %d names for %d shares", len(names), len(shares))
+	}
+
+	paths := make([]string, len(shares))
+	for i, name := range names {
+		if name == "" || filepath.Base(name) != name {
+			return nil, fmt.Errorf("invalid share file name %q", name)
+		}
+		paths[i] = filepath.Join(dir, name)
+		if _, err := os.Lstat(paths[i]); err == nil {
+			return nil, fmt.Errorf("share file %s already exists", paths[i])
+		} else if !errors.Is(err, fs.ErrNotExist) {
+			return nil, err
+		}
+	}
+
+	temps := make([]string, 0, len(shares))
+	linked := 0
+	success := false
+	defer func() {
+		for _, t := range temps {
+			os.Remove(t)
+		}
+		if success {
+			return
+		}
+		for _, p := range paths[:linked] {
+			os.Remove(p)
+		}
+	}()
+
+	for i, s := range shares {
+		tmp, err := writeTempFile(dir, names[i], []byte(encodeShareToHex(s)+"\n"))
+		if tmp != "" {
+			temps = append(temps, tmp)
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	for i, tmp := range temps {
+		if err := linkFile(tmp, paths[i]); err != nil {
+			if errors.Is(err, fs.ErrExist) {
+				return nil, fmt.Errorf("share file %s already exists", paths[i])
+			}
+			return nil, err
+		}
+		linked++
+	}
+	if err := syncDir(dir); err != nil {
+		return nil, err
+	}
+	success = true
+	return paths, nil
+}
+
+// writeTempFile writes data to a new temporary file in dir and syncs it. It
+// returns the file's path even on failure if the file was created.
+func writeTempFile(dir, name string, data []byte) (string, error) {
+	f, err := os.CreateTemp(dir, "."+name+".*.tmp")
+	if err != nil {
+		return "", err
+	}
+	if _, err := f.Write(data); err != nil {
+		f.Close()
+		return f.Name(), err
+	}
+	if err := f.Sync(); err != nil {
+		f.Close()
+		return f.Name(), err
+	}
+	return f.Name(), f.Close()
+}
+
+func syncDir(dir string) error {
+	d, err := os.Open(dir)
+	if err != nil {
+		return err
+	}
+	defer d.Close()
+	// Some platforms cannot sync directories; the renames are still atomic.
+	if err := d.Sync(); err != nil && !errors.Is(err, os.ErrInvalid) && !errors.Is(err, errors.ErrUnsupported) {
+		return err
+	}
+	return nil
+}
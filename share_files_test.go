@@ -0,0 +1,104 @@
+package goshamir
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// --- WriteShareFiles Tests ---
+
+func TestWriteShareFiles(t *testing.T) {
+	dir := t.TempDir()
+	shares, _ := Split([]byte("secret"), 3, 2)
+
+	paths, err := WriteShareFiles(dir, []string{"a", "b", "c"}, shares)
+	if err != nil {
+		t.Fatalf("WriteShareFiles failed: %v", err)
+	}
+
+	var encoded []string
+	for _, p := range paths {
+		data, err := os.ReadFile(p)
+		if err != nil {
+			t.Fatalf("ReadFile failed: %v", err)
+		}
+		encoded = append(encoded, strings.TrimSpace(string(data)))
+	}
+	decoded, _ := DecodeSharesFromHex(encoded)
+	if secret, err := Combine(decoded[1:], 2); err != nil || string(secret) != "secret" {
+		t.Errorf("Combine failed: %v", err)
+	}
+
+	entries, _ := os.ReadDir(dir)
+	if len(entries) != 3 {
+		t.Errorf("Expected 3 files, got %d", len(entries))
+	}
+}
+
+func TestWriteShareFiles_Rollback(t *testing.T) {
+	shares, _ := Split([]byte("secret"), 3, 2)
+
+	dir := t.TempDir()
+	os.WriteFile(filepath.Join(dir, "b"), []byte("old"), 0o600)
+	if _, err := WriteShareFiles(dir, []string{"a", "b", "c"}, shares); err == nil {
+		t.Error("Expected error for existing file")
+	}
+	if entries, _ := os.ReadDir(dir); len(entries) != 1 {
+		t.Errorf("Expected only the existing file, got %d entries", len(entries))
+	}
+
+	dir = t.TempDir()
+	errLink := errors.New("link failed")
+	calls := 0
+	linkFile = func(oldpath, newpath string) error {
+		if calls++; calls == 3 {
+			return errLink
+		}
+		return os.Link(oldpath, newpath)
+	}
+	defer func() { linkFile = os.Link }()
+
+	if _, err := WriteShareFiles(dir, []string{"a", "b", "c"}, shares); !errors.Is(err, errLink) {
+		t.Fatalf("Expected link error, got %v", err)
+	}
+	if entries, _ := os.ReadDir(dir); len(entries) != 0 {
+		t.Errorf("Expected partial write to be rolled back, found %d entries", len(entries))
+	}
+}
+
+func TestWriteShareFiles_FileAppearsDuringWrite(t *testing.T) {
+	shares, _ := Split([]byte("secret"), 3, 2)
+	dir := t.TempDir()
+	// Another process creates "b" after the existence checks, just before
+	// the share is linked into place.
+	linkFile = func(oldpath, newpath string) error {
+		if filepath.Base(newpath) == "b" {
+			os.WriteFile(newpath, []byte("theirs"), 0o600)
+		}
+		return os.Link(oldpath, newpath)
+	}
+	defer func() { linkFile = os.Link }()
+
+	if _, err := WriteShareFiles(dir, []string{"a", "b", "c"}, shares); err == nil {
+		t.Fatal("Expected error for a file created during the write")
+	}
+	if data, _ := os.ReadFile(filepath.Join(dir, "b")); string(data) != "theirs" {
+		t.Errorf("The other file was replaced with %q", data)
+	}
+	if entries, _ := os.ReadDir(dir); len(entries) != 1 {
+		t.Errorf("Expected only the other file, got %d entries", len(entries))
+	}
+}
+
+func TestWriteShareFiles_InvalidNames(t *testing.T) {
+	shares, _ := Split([]byte("secret"), 2, 2)
+	dir := t.TempDir()
+	for _, names := range [][]string{{"a"}, {"a", ""}, {"a", "../b"}, {"a", "sub/b"}, {"a", "a"}} {
+		if _, err := WriteShareFiles(dir, names, shares); err == nil {
+			t.Errorf("Expected error for names %q", names)
+		}
+	}
+}
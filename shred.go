@@ -0,0 +1,62 @@
+package goshamir
+
+import (
+	"crypto/rand"
+	"fmt"
+	"os"
+)
+
+// shredBlockSize is the size of the buffer used to overwrite files.
+const shredBlockSize = 64 * 1024
+
+// ShredOriginal overwrites the regular file at path with random data, syncs
+// it, truncates it and removes it. It is meant for destroying a secret file
+// once it has been split and the shares are safely stored.
+//
+// Overwriting is best effort. On SSDs and flash media, copy-on-write or
+// log-structured filesystems (such as btrfs, ZFS or APFS), journaled data
+// modes, snapshots and backups, earlier copies of the data may survive.
+// Full-disk encryption is the only reliable protection for data at rest.
+func ShredOriginal(path string) error {
+	info, err := os.Lstat(path)
+	if err != nil {
+		return err
+	}
+	if !info.Mode().IsRegular() {
+		return fmt.Errorf("%s is not a regular file", path)
+	}
+
+	f, err := os.OpenFile(path, os.O_WRONLY, 0)
+	if err != nil {
+		return err
+	}
+	if err := overwriteFile(f, info.Size()); err != nil {
+		f.Close()
+		return fmt.Errorf("shredding %s failed: %w", path, err)
+	}
+	if err := f.Close(); err != nil {
+		return err
+	}
+	return os.Remove(path)
+}
+
+func overwriteFile(f *os.File, size int64) error {
+	buf := make([]byte, shredBlockSize)
+	for done := int64(0); done < size; {
+		n := int(min(size-done, shredBlockSize))
+		if _, err := rand.Read(buf[:n]); err != nil {
+			return err
+		}
+		if _, err := f.WriteAt(buf[:n], done); err != nil {
+			return err
+		}
+		done += int64(n)
+	}
+	if err := f.Sync(); err != nil {
+		return err
+	}
+	if err := f.Truncate(0); err != nil {
+		return err
+	}
+	return f.Sync()
+}
@@ -0,0 +1,49 @@
+package goshamir
+
+import (
+	"errors"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// --- ShredOriginal Tests ---
+
+func TestShredOriginal(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "secret")
+	if err := os.WriteFile(path, make([]byte, shredBlockSize+10), 0o600); err != nil {
+		t.Fatalf("WriteFile failed: %v", err)
+	}
+
+	if err := ShredOriginal(path); err != nil {
+		t.Fatalf("ShredOriginal failed: %v", err)
+	}
+	if _, err := os.Stat(path); !errors.Is(err, fs.ErrNotExist) {
+		t.Errorf("Expected file to be removed, got %v", err)
+	}
+}
+
+func TestShredOriginal_Errors(t *testing.T) {
+	dir := t.TempDir()
+	if err := ShredOriginal(filepath.Join(dir, "missing")); err == nil {
+		t.Error("Expected error for missing file")
+	}
+	if err := ShredOriginal(dir); err == nil {
+		t.Error("Expected error for directory")
+	}
+
+	target := filepath.Join(dir, "target")
+	link := filepath.Join(dir, "link")
+	os.WriteFile(target, []byte("x"), 0o600)
+	if err := os.Symlink(target, link); err != nil {
+		t.Skipf("Symlinks not supported: %v", err)
+	}
+	if err := ShredOriginal(link); err == nil {
+		t.Error("Expected error for symlink")
+	}
+	if _, err := os.Stat(target); err != nil {
+		t.Errorf("Symlink target was touched: %v", err)
+	}
+}
@@ -0,0 +1,312 @@
+// Package sigstore signs share-set manifests with Sigstore and verifies
+// them later, so custody artifacts carry provenance anyone can check
+// against the public Sigstore infrastructure.
+//
+// A Manifest records the fingerprints of a share set, the blinded
+// commitment of each share and the root of their goshamir.ShareMerkleTree,
+// along with
+// the custody policy and when the set was created. It never holds share
+// values. Signing is keyless: cosign obtains a short-lived certificate for
+// the signer's OIDC identity, such as a CI workload or a person's email
+// account, and records the signature in the Rekor transparency log. The
+// resulting Sigstore bundle is verified offline against the expected
+// identity.
+//
+// Signing and verification run the cosign command, version 2 or later,
+// which must be installed:
+//
+//	signer := sigstore.New()
+//	att, err := signer.Sign(ctx, manifest)
+//	// store att.Manifest and att.Bundle with the shares
+//
+//	m, err := signer.Verify(ctx, att, sigstore.Identity{
+//	    Subject: "dealer@example.com",
+//	    Issuer:  "https://accounts.google.com",
+//	})
+package sigstore
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"time"
+
+	goshamir "github.com/fawwazid/go-shamir"
+)
+
+// ManifestVersion is the manifest format produced by NewManifest.
+const ManifestVersion = 1
+
+// ErrVerification is returned when a manifest's signature, signer identity
+// or contents fail verification.
+var ErrVerification = errors.New("manifest verification failed")
+
+// Manifest describes a share set for attestation.
+type Manifest struct {
+	Version     int             `json:"version"`
+	SetID       string          `json:"set_id"`
+	Threshold   int             `json:"threshold"`
+	TotalShares int             `json:"total_shares"`
+	Scheme      goshamir.Scheme `json:"scheme"`
+	// MerkleRoot is the hex root of the set's ShareMerkleTree.
+	MerkleRoot   string        `json:"merkle_root"`
+	Fingerprints []Fingerprint `json:"fingerprints"`
+	// Policy is the custody policy the set was created under, if any.
+	Policy    *goshamir.Policy `json:"policy,omitempty"`
+	CreatedAt time.Time        `json:"created_at"`
+}
+
+// Fingerprint identifies one share of a set without revealing it.
+type Fingerprint struct {
+	Index uint8 `json:"index"`
+	// Commitment is the hex blinded ShareCommitment of the share.
+	Commitment string `json:"commitment"`
+}
+
+// NewManifest describes a complete share set through the Merkle tree of
+// its shares, with fingerprints sorted by index. Each custodian needs the
+// tree's proof for their share, which carries its blinding, to check it
+// against the manifest. Set the Policy field before signing to attest to
+// it too.
+func NewManifest(setID string, threshold int, scheme goshamir.Scheme, tree *goshamir.ShareMerkleTree) (*Manifest, error) {
+	if setID == "" {
+		return nil, errors.New("set ID cannot be empty")
+	}
+	if tree == nil {
+		return nil, errors.New("share tree cannot be nil")
+	}
+	indices := tree.Indices()
+	if threshold < goshamir.MinThreshold || threshold > len(indices) {
+		return nil, fmt.Errorf("invalid threshold %d for %d shares", threshold, len(indices))
+	}
+	if scheme == 0 {
+		scheme = goshamir.SchemeV1GF257
+	}
+	m := &Manifest{
+		Version:     ManifestVersion,
+		SetID:       setID,
+		Threshold:   threshold,
+		TotalShares: len(indices),
+		Scheme:      scheme,
+		MerkleRoot:  hex.EncodeToString(tree.Root()),
+		CreatedAt:   time.Now().UTC().Truncate(time.Second),
+	}
+	for _, index := range indices {
+		c, err := tree.Commitment(index)
+		if err != nil {
+			return nil, err
+		}
+		m.Fingerprints = append(m.Fingerprints, Fingerprint{Index: index, Commitment: hex.EncodeToString(c)})
+	}
+	return m, nil
+}
+
+// Marshal returns the canonical JSON form of the manifest, the bytes that
+// are signed.
+func (m *Manifest) Marshal() ([]byte, error) {
+	data, err := json.MarshalIndent(m, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	return append(data, '\n'), nil
+}
+
+// VerifyShare checks that a share belongs to the manifest's set, using the
+// blinding carried by the share's inclusion proof.
+func (m *Manifest) VerifyShare(s goshamir.Share, proof *goshamir.InclusionProof) error {
+	if proof == nil {
+		return fmt.Errorf("%w: share %d has no inclusion proof", ErrVerification, s.Index)
+	}
+	want := hex.EncodeToString(goshamir.ShareCommitment(s, proof.Blinding))
+	for _, f := range m.Fingerprints {
+		if f.Index == s.Index {
+			if f.Commitment != want {
+				return fmt.Errorf("%w: share %d does not match its fingerprint", ErrVerification, s.Index)
+			}
+			return nil
+		}
+	}
+	return fmt.Errorf("%w: share %d is not in set %q", ErrVerification, s.Index, m.SetID)
+}
+
+// Attestation is a signed manifest: the exact manifest bytes and the
+// Sigstore bundle holding their signature, signing certificate and
+// transparency log entry. Both are needed to verify.
+type Attestation struct {
+	Manifest []byte
+	Bundle   []byte
+}
+
+// Identity is the signer identity a manifest must have been signed by: the
+// subject of the signing certificate, such as an email address or a CI
+// workflow URI, and the OIDC issuer that vouched for it. Either may be a
+// regular expression instead, in SubjectRegexp or IssuerRegexp.
+type Identity struct {
+	Subject       string
+	SubjectRegexp string
+	Issuer        string
+	IssuerRegexp  string
+}
+
+func (id Identity) args() ([]string, error) {
+	var args []string
+	switch {
+	case id.Subject != "":
+		args = append(args, "--certificate-identity", id.Subject)
+	case id.SubjectRegexp != "":
+		args = append(args, "--certificate-identity-regexp", id.SubjectRegexp)
+	default:
+		return nil, errors.New("identity needs a subject")
+	}
+	switch {
+	case id.Issuer != "":
+		args = append(args, "--certificate-oidc-issuer", id.Issuer)
+	case id.IssuerRegexp != "":
+		args = append(args, "--certificate-oidc-issuer-regexp", id.IssuerRegexp)
+	default:
+		return nil, errors.New("identity needs an OIDC issuer")
+	}
+	return args, nil
+}
+
+// Signer signs and verifies manifests by running cosign.
+type Signer struct {
+	command       string
+	identityToken string
+	extraArgs     []string
+	// run executes the cosign command in dir, replaceable in tests.
+	run func(ctx context.Context, dir string, args ...string) error
+}
+
+// Option configures a Signer.
+type Option func(*Signer)
+
+// WithCommand sets the cosign command to run, such as a full path. The
+// default is "cosign".
+func WithCommand(name string) Option {
+	return func(s *Signer) {
+		s.command = name
+	}
+}
+
+// WithIdentityToken signs with an OIDC identity token obtained elsewhere,
+// for example from a CI provider, instead of letting cosign find ambient
+// credentials or open a browser. The token is passed to cosign in the
+// SIGSTORE_ID_TOKEN environment variable, not on its command line.
+func WithIdentityToken(token string) Option {
+	return func(s *Signer) {
+		s.identityToken = token
+	}
+}
+
+// WithArgs passes further arguments to every cosign invocation, such as
+// "--rekor-url" for a private Sigstore deployment.
+func WithArgs(args ...string) Option {
+	return func(s *Signer) {
+		s.extraArgs = append(s.extraArgs, args...)
+	}
+}
+
+// New returns a Signer.
+func New(opts ...Option) *Signer {
+	s := &Signer{command: "cosign"}
+	s.run = s.exec
+	for _, opt := range opts {
+		if opt != nil {
+			opt(s)
+		}
+	}
+	return s
+}
+
+// Sign signs the manifest keylessly with cosign sign-blob and returns the
+// attestation. The signature is published in the Rekor transparency log.
+func (s *Signer) Sign(ctx context.Context, m *Manifest) (*Attestation, error) {
+	manifest, err := m.Marshal()
+	if err != nil {
+		return nil, err
+	}
+	dir, err := os.MkdirTemp("", "goshamir-sigstore-")
+	if err != nil {
+		return nil, err
+	}
+	defer os.RemoveAll(dir)
+	if err := os.WriteFile(filepath.Join(dir, "manifest.json"), manifest, 0o600); err != nil {
+		return nil, err
+	}
+	args := append([]string{"sign-blob", "--yes", "--bundle", "manifest.sigstore.json"}, s.extraArgs...)
+	args = append(args, "manifest.json")
+	if err := s.run(ctx, dir, args...); err != nil {
+		return nil, err
+	}
+	bundle, err := os.ReadFile(filepath.Join(dir, "manifest.sigstore.json"))
+	if err != nil {
+		return nil, fmt.Errorf("reading cosign bundle: %w", err)
+	}
+	return &Attestation{Manifest: manifest, Bundle: bundle}, nil
+}
+
+// Verify checks with cosign verify-blob that the attestation's manifest
+// was signed by the given identity and logged in Rekor, and returns the
+// manifest.
+func (s *Signer) Verify(ctx context.Context, a *Attestation, id Identity) (*Manifest, error) {
+	idArgs, err := id.args()
+	if err != nil {
+		return nil, err
+	}
+	var m Manifest
+	dec := json.NewDecoder(bytes.NewReader(a.Manifest))
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(&m); err != nil {
+		return nil, fmt.Errorf("%w: invalid manifest: %w", ErrVerification, err)
+	}
+	if m.Version != ManifestVersion {
+		return nil, fmt.Errorf("%w: unsupported manifest version %d", ErrVerification, m.Version)
+	}
+	if len(m.Fingerprints) != m.TotalShares {
+		return nil, fmt.Errorf("%w: manifest lists %d fingerprints for %d shares", ErrVerification, len(m.Fingerprints), m.TotalShares)
+	}
+
+	dir, err := os.MkdirTemp("", "goshamir-sigstore-")
+	if err != nil {
+		return nil, err
+	}
+	defer os.RemoveAll(dir)
+	if err := os.WriteFile(filepath.Join(dir, "manifest.json"), a.Manifest, 0o600); err != nil {
+		return nil, err
+	}
+	if err := os.WriteFile(filepath.Join(dir, "manifest.sigstore.json"), a.Bundle, 0o600); err != nil {
+		return nil, err
+	}
+	args := append([]string{"verify-blob", "--bundle", "manifest.sigstore.json"}, idArgs...)
+	args = append(append(args, s.extraArgs...), "manifest.json")
+	if err := s.run(ctx, dir, args...); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrVerification, err)
+	}
+	return &m, nil
+}
+
+func (s *Signer) exec(ctx context.Context, dir string, args ...string) error {
+	cmd := exec.CommandContext(ctx, s.command, args...)
+	cmd.Dir = dir
+	if s.identityToken != "" {
+		cmd.Env = append(os.Environ(), "SIGSTORE_ID_TOKEN="+s.identityToken)
+	}
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		msg := strings.TrimSpace(stderr.String())
+		if msg == "" {
+			return fmt.Errorf("%s %s failed: %w", s.command, args[0], err)
+		}
+		return fmt.Errorf("%s %s failed: %s", s.command, args[0], msg)
+	}
+	return nil
+}
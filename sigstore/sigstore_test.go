@@ -0,0 +1,187 @@
+package sigstore
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	goshamir "github.com/fawwazid/go-shamir"
+)
+
+// fakeCosign emulates cosign sign-blob and verify-blob: the bundle records
+// the manifest's hash and the signer's identity.
+type fakeCosign struct {
+	t        *testing.T
+	subject  string
+	issuer   string
+	lastArgs []string
+}
+
+type fakeBundle struct {
+	Digest  string `json:"digest"`
+	Subject string `json:"subject"`
+	Issuer  string `json:"issuer"`
+}
+
+func (f *fakeCosign) run(_ context.Context, dir string, args ...string) error {
+	f.lastArgs = args
+	blob, err := os.ReadFile(filepath.Join(dir, args[len(args)-1]))
+	if err != nil {
+		return err
+	}
+	sum := sha256.Sum256(blob)
+	bundlePath := filepath.Join(dir, args[slices.Index(args, "--bundle")+1])
+	switch args[0] {
+	case "sign-blob":
+		data, _ := json.Marshal(fakeBundle{Digest: hex.EncodeToString(sum[:]), Subject: f.subject, Issuer: f.issuer})
+		return os.WriteFile(bundlePath, data, 0o600)
+	case "verify-blob":
+		data, err := os.ReadFile(bundlePath)
+		if err != nil {
+			return err
+		}
+		var b fakeBundle
+		if err := json.Unmarshal(data, &b); err != nil {
+			return err
+		}
+		if b.Digest != hex.EncodeToString(sum[:]) {
+			return errors.New("invalid signature when validating ASN.1 encoded signature")
+		}
+		if args[slices.Index(args, "--certificate-identity")+1] != b.Subject || args[slices.Index(args, "--certificate-oidc-issuer")+1] != b.Issuer {
+			return errors.New("none of the expected identities matched")
+		}
+		return nil
+	}
+	f.t.Fatalf("Unexpected cosign command %v", args)
+	return nil
+}
+
+func newTestSigner(t *testing.T) (*Signer, *fakeCosign) {
+	t.Helper()
+	fake := &fakeCosign{t: t, subject: "dealer@example.com", issuer: "https://accounts.example.com"}
+	s := New()
+	s.run = fake.run
+	return s, fake
+}
+
+func testManifest(t *testing.T) (*Manifest, []goshamir.Share, *goshamir.ShareMerkleTree) {
+	t.Helper()
+	shares, _ := goshamir.Split([]byte("attested secret"), 5, 3)
+	tree, _ := goshamir.NewShareMerkleTree(shares)
+	m, err := NewManifest("payments", 3, shares[0].Scheme, tree)
+	if err != nil {
+		t.Fatalf("NewManifest failed: %v", err)
+	}
+	m.Policy = &goshamir.Policy{TotalShares: 5, Threshold: 3, Expiry: time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)}
+	return m, shares, tree
+}
+
+// --- Manifest Tests ---
+
+func TestNewManifest(t *testing.T) {
+	m, shares, tree := testManifest(t)
+	if m.TotalShares != 5 || len(m.Fingerprints) != 5 || m.Scheme != goshamir.SchemeV1GF257 || m.CreatedAt.IsZero() {
+		t.Fatalf("unexpected manifest %+v", m)
+	}
+	data, _ := m.Marshal()
+	for _, s := range shares {
+		proof, _ := tree.Proof(s.Index)
+		if err := m.VerifyShare(s, proof); err != nil {
+			t.Fatalf("VerifyShare failed: %v", err)
+		}
+		if strings.Contains(string(data), hex.EncodeToString(s.Value)) {
+			t.Fatal("manifest contains a share value")
+		}
+	}
+	other, _ := goshamir.Split([]byte("another secret!"), 5, 3)
+	proof, _ := tree.Proof(other[0].Index)
+	if err := m.VerifyShare(other[0], proof); !errors.Is(err, ErrVerification) {
+		t.Fatalf("expected ErrVerification, got %v", err)
+	}
+	if err := m.VerifyShare(shares[0], nil); !errors.Is(err, ErrVerification) {
+		t.Fatalf("expected ErrVerification without a proof, got %v", err)
+	}
+	if _, err := NewManifest("", 3, 0, tree); err == nil {
+		t.Error("expected error for empty set ID")
+	}
+	if _, err := NewManifest("x", 6, 0, tree); err == nil {
+		t.Error("expected error for threshold above share count")
+	}
+}
+
+// --- Sign and Verify Tests ---
+
+func TestSignVerify(t *testing.T) {
+	s, fake := newTestSigner(t)
+	m, shares, tree := testManifest(t)
+	ctx := context.Background()
+	att, err := s.Sign(ctx, m)
+	if err != nil {
+		t.Fatalf("Sign failed: %v", err)
+	}
+	if !slices.Contains(fake.lastArgs, "--yes") {
+		t.Fatalf("sign-blob should not prompt, ran %v", fake.lastArgs)
+	}
+
+	id := Identity{Subject: "dealer@example.com", Issuer: "https://accounts.example.com"}
+	got, err := s.Verify(ctx, att, id)
+	if err != nil {
+		t.Fatalf("Verify failed: %v", err)
+	}
+	if got.SetID != "payments" || got.Policy == nil || got.Policy.Threshold != 3 || !got.CreatedAt.Equal(m.CreatedAt) {
+		t.Fatalf("unexpected manifest %+v", got)
+	}
+	proof, _ := tree.Proof(shares[2].Index)
+	if err := got.VerifyShare(shares[2], proof); err != nil {
+		t.Fatalf("VerifyShare failed: %v", err)
+	}
+
+	if _, err := s.Verify(ctx, att, Identity{Subject: "mallory@example.com", Issuer: id.Issuer}); !errors.Is(err, ErrVerification) {
+		t.Fatalf("expected ErrVerification for another identity, got %v", err)
+	}
+	tampered := *att
+	tampered.Manifest = []byte(string(att.Manifest[:len(att.Manifest)-2]) + " }\n")
+	if _, err := s.Verify(ctx, &tampered, id); !errors.Is(err, ErrVerification) {
+		t.Fatalf("expected ErrVerification for an altered manifest, got %v", err)
+	}
+	if _, err := s.Verify(ctx, att, Identity{Subject: "dealer@example.com"}); err == nil {
+		t.Fatal("expected error for an identity without issuer")
+	}
+}
+
+func TestSigner_RunsCosign(t *testing.T) {
+	dir := t.TempDir()
+	script := filepath.Join(dir, "cosign")
+	// The stand-in records its arguments and token and writes a bundle.
+	body := "#!/bin/sh\necho \"$@\" > " + filepath.Join(dir, "args") + "\necho \"$SIGSTORE_ID_TOKEN\" > " + filepath.Join(dir, "token") + "\necho '{}' > \"$4\"\n"
+	if err := os.WriteFile(script, []byte(body), 0o700); err != nil {
+		t.Fatal(err)
+	}
+	s := New(WithCommand(script), WithIdentityToken("ci-token"), WithArgs("--rekor-url", "https://rekor.example.com"))
+	m, _, _ := testManifest(t)
+	att, err := s.Sign(context.Background(), m)
+	if err != nil {
+		t.Fatalf("Sign failed: %v", err)
+	}
+	if string(att.Bundle) != "{}\n" {
+		t.Fatalf("unexpected bundle %q", att.Bundle)
+	}
+	args, _ := os.ReadFile(filepath.Join(dir, "args"))
+	token, _ := os.ReadFile(filepath.Join(dir, "token"))
+	if string(args) != "sign-blob --yes --bundle manifest.sigstore.json --rekor-url https://rekor.example.com manifest.json\n" || string(token) != "ci-token\n" {
+		t.Fatalf("unexpected invocation %q with token %q", args, token)
+	}
+
+	failing := New(WithCommand("false"))
+	if _, err := failing.Sign(context.Background(), m); err == nil {
+		t.Fatal("expected error from a failing cosign")
+	}
+}
@@ -0,0 +1,268 @@
+package goshamir
+
+import (
+	"fmt"
+	"io"
+)
+
+// splitBlockSize is the number of secret bytes processed per batch of random
+// coefficients. It bounds the scratch space a single Split call needs.
+const splitBlockSize = 256
+
+// Splitter splits secrets into a fixed number of shares with a fixed
+// threshold. The share indices and their powers in the scheme's field are
+// computed once at construction, so repeated Split calls only pay for
+// randomness and polynomial evaluation.
+//
+// A Splitter is immutable after construction and safe for concurrent use by
+// multiple goroutines.
+type Splitter struct {
+	totalShares int
+	threshold   int
+	config      Config
+
+	// powers[i][j] is (i+1)^j in GF(257), used by SchemeV1GF257.
+	powers [][]uint16
+	// powers256[i][j] is (i+1)^j in GF(2^8), used by SchemeV2GF256.
+	powers256 [][]byte
+}
+
+// NewSplitter returns a Splitter producing totalShares shares of which
+// threshold are required to reconstruct the secret.
+func NewSplitter(totalShares, threshold int, opts ...Option) (*Splitter, error) {
+	cfg := NewConfig(opts...)
+	if err := validateShareCounts(totalShares, threshold); err != nil {
+		cfg.logger().Warn("shamir: invalid split parameters",
+			"total_shares", totalShares, "threshold", threshold, "error", err)
+		return nil, err
+	}
+	if err := validateChunkMACKey(cfg); err != nil {
+		cfg.logger().Warn("shamir: invalid split parameters", "error", err)
+		return nil, err
+	}
+
+	s := &Splitter{
+		totalShares: totalShares,
+		threshold:   threshold,
+		config:      cfg,
+	}
+	switch s.config.Scheme {
+	case SchemeV1GF257:
+		s.powers = make([][]uint16, totalShares)
+		for i := range s.powers {
+			s.powers[i] = indexPowers(uint8(i+1), threshold)
+		}
+	case SchemeV2GF256:
+		s.powers256 = make([][]byte, totalShares)
+		for i := range s.powers256 {
+			s.powers256[i] = gf256IndexPowers(uint8(i+1), threshold)
+		}
+	default:
+		err := unsupportedScheme(s.config.Scheme)
+		cfg.logger().Warn("shamir: invalid split parameters", "scheme", s.config.Scheme.String(), "error", err)
+		return nil, err
+	}
+	if cfg.Deterministic {
+		cfg.logger().Warn("shamir: deterministic coefficients in use; shares are not secure")
+	}
+	cfg.logger().Debug("shamir: split parameters validated",
+		"total_shares", totalShares, "threshold", threshold, "scheme", s.config.Scheme.String())
+	return s, nil
+}
+
+// TotalShares returns the number of shares produced by each Split call.
+func (s *Splitter) TotalShares() int {
+	return s.totalShares
+}
+
+// Threshold returns the number of shares required to reconstruct a secret.
+func (s *Splitter) Threshold() int {
+	return s.threshold
+}
+
+// Scheme returns the scheme of the shares the Splitter produces.
+func (s *Splitter) Scheme() Scheme {
+	return s.config.Scheme
+}
+
+// Split divides secret into shares using the Splitter's parameters. The
+// output is compatible with Combine.
+func (s *Splitter) Split(secret []byte) ([]Share, error) {
+	shares, _, err := s.SplitWithMetadata(secret)
+	return shares, err
+}
+
+// split is Split with an explicit source of coefficient randomness.
+func (s *Splitter) split(secret []byte, random io.Reader) ([]Share, error) {
+	if err := validateSplitParams(secret, s.totalShares, s.threshold); err != nil {
+		return nil, err
+	}
+
+	scheme := s.config.Scheme
+	valueLen := len(secret) * scheme.bytesPerElement()
+	shares := make([]Share, s.totalShares)
+	if s.config.PoolBuffers {
+		for i := range shares {
+			shares[i] = Share{
+				Index:  uint8(i + 1),
+				Value:  sharedBuffers.getBytes(valueLen),
+				Scheme: scheme,
+			}
+		}
+	} else {
+		arena := make([]byte, s.totalShares*valueLen)
+		for i := range shares {
+			shares[i] = Share{
+				Index:  uint8(i + 1),
+				Value:  arena[i*valueLen : (i+1)*valueLen : (i+1)*valueLen],
+				Scheme: scheme,
+			}
+		}
+	}
+
+	var err error
+	if scheme == SchemeV2GF256 {
+		err = s.splitGF256(shares, secret, random)
+	} else {
+		err = s.splitGF257(shares, secret, random)
+	}
+	if err != nil {
+		s.Release(shares)
+		return nil, err
+	}
+	if len(s.config.Pepper) > 0 {
+		peppered, err := PepperShares(shares, s.config.Pepper, s.config.Rand)
+		s.Release(shares)
+		if err != nil {
+			return nil, err
+		}
+		shares = peppered
+	}
+	if s.config.ZeroizeOnCleanup {
+		for i := range shares {
+			attachCleanup(&shares[i])
+		}
+	}
+	return shares, nil
+}
+
+func (s *Splitter) splitGF257(shares []Share, secret []byte, random io.Reader) error {
+	degree := s.threshold - 1
+	block := min(len(secret), splitBlockSize)
+	coeffs := s.getElems(block * degree)
+	scratch := s.getBytes(2 * block * degree)
+	defer s.putElems(coeffs)
+	defer s.putBytes(scratch)
+
+	for start := 0; start < len(secret); start += block {
+		end := min(start+block, len(secret))
+		batch := coeffs[:(end-start)*degree]
+		if err := readFieldElements(random, batch, scratch); err != nil {
+			return err
+		}
+		s.evaluateBlock(shares, secret[start:end], start, batch)
+	}
+	return nil
+}
+
+func (s *Splitter) splitGF256(shares []Share, secret []byte, random io.Reader) error {
+	degree := s.threshold - 1
+	block := min(len(secret), splitBlockSize)
+	coeffs := s.getBytes(block * degree)
+	defer s.putBytes(coeffs)
+
+	for start := 0; start < len(secret); start += block {
+		end := min(start+block, len(secret))
+		batch := coeffs[:(end-start)*degree]
+		if _, err := io.ReadFull(random, batch); err != nil {
+			return fmt.Errorf("random coefficient generation failed: %w", err)
+		}
+		s.evaluateBlockGF256(shares, secret[start:end], start, batch)
+	}
+	return nil
+}
+
+// Release zeroes the values of shares previously returned by Split and, when
+// buffer pooling is enabled, recycles them for future calls. The shares must
+// not be used afterwards.
+func (s *Splitter) Release(shares []Share) {
+	for i := range shares {
+		stopCleanup(&shares[i])
+		s.putBytes(shares[i].Value)
+		shares[i].Value = nil
+	}
+}
+
+// getBytes and getElems return scratch buffers, recycled through the shared
+// pool when pooling is enabled.
+func (s *Splitter) getBytes(n int) []byte {
+	if s.config.PoolBuffers {
+		return sharedBuffers.getBytes(n)
+	}
+	return make([]byte, n)
+}
+
+func (s *Splitter) getElems(n int) []uint16 {
+	if s.config.PoolBuffers {
+		return sharedBuffers.getElems(n)
+	}
+	return make([]uint16, n)
+}
+
+// putBytes and putElems wipe buffers, which may hold coefficients that
+// determine every share of a secret, and recycle them if pooling is enabled.
+func (s *Splitter) putBytes(b []byte) {
+	if s.config.PoolBuffers {
+		sharedBuffers.putBytes(b)
+		return
+	}
+	clear(b)
+}
+
+func (s *Splitter) putElems(e []uint16) {
+	if s.config.PoolBuffers {
+		sharedBuffers.putElems(e)
+		return
+	}
+	clear(e)
+}
+
+// evaluateBlock evaluates the polynomials for secret bytes starting at
+// logical position offset and writes the results into every share.
+// coeffs holds threshold-1 random coefficients per secret byte.
+func (s *Splitter) evaluateBlock(shares []Share, secret []byte, offset int, coeffs []uint16) {
+	degree := s.threshold - 1
+	for b, secretByte := range secret {
+		c := coeffs[b*degree : (b+1)*degree]
+		pos := (offset + b) * 2
+		for i := range shares {
+			powers := s.powers[i]
+			// Each term is below 257^2 and there are at most 255 of them,
+			// so the sum fits comfortably in a uint32 before reduction.
+			acc := uint32(secretByte)
+			for j, cj := range c {
+				acc += uint32(cj) * uint32(powers[j+1])
+			}
+			y := acc % FieldPrime
+			shares[i].Value[pos] = byte(y & 0xFF)
+			shares[i].Value[pos+1] = byte(y >> 8)
+		}
+	}
+}
+
+// evaluateBlockGF256 is evaluateBlock for SchemeV2GF256.
+func (s *Splitter) evaluateBlockGF256(shares []Share, secret []byte, offset int, coeffs []byte) {
+	degree := s.threshold - 1
+	for b, secretByte := range secret {
+		c := coeffs[b*degree : (b+1)*degree]
+		pos := offset + b
+		for i := range shares {
+			powers := s.powers256[i]
+			y := secretByte
+			for j, cj := range c {
+				y ^= gf256Mul(cj, powers[j+1])
+			}
+			shares[i].Value[pos] = y
+		}
+	}
+}
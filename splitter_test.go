@@ -0,0 +1,204 @@
+package goshamir
+
+import (
+	"bytes"
+	"crypto/rand"
+	"errors"
+	"sync"
+	"testing"
+)
+
+// --- Splitter Tests ---
+
+func TestSplitter_RoundTrip(t *testing.T) {
+	splitter, err := NewSplitter(5, 3)
+	if err != nil {
+		t.Fatalf("NewSplitter failed: %v", err)
+	}
+
+	secret := make([]byte, 1000)
+	if _, err := rand.Read(secret); err != nil {
+		t.Fatalf("Failed to generate random secret: %v", err)
+	}
+
+	shares, err := splitter.Split(secret)
+	if err != nil {
+		t.Fatalf("Split failed: %v", err)
+	}
+	if len(shares) != 5 {
+		t.Fatalf("Expected 5 shares, got %d", len(shares))
+	}
+	for i, share := range shares {
+		if share.Index != uint8(i+1) {
+			t.Errorf("Share %d: expected index %d, got %d", i, i+1, share.Index)
+		}
+		if len(share.Value) != len(secret)*2 {
+			t.Errorf("Share %d: expected value length %d, got %d", i, len(secret)*2, len(share.Value))
+		}
+	}
+
+	recovered, err := Combine([]Share{shares[4], shares[1], shares[2]}, 3)
+	if err != nil {
+		t.Fatalf("Combine failed: %v", err)
+	}
+	if !bytes.Equal(secret, recovered) {
+		t.Error("Recovered secret does not match original")
+	}
+}
+
+func TestSplitter_InvalidParams(t *testing.T) {
+	if _, err := NewSplitter(2, 3); err == nil {
+		t.Error("Expected error when totalShares < threshold")
+	}
+	if _, err := NewSplitter(5, 1); err == nil {
+		t.Error("Expected error for threshold < MinThreshold")
+	}
+
+	splitter, err := NewSplitter(3, 2)
+	if err != nil {
+		t.Fatalf("NewSplitter failed: %v", err)
+	}
+	if _, err := splitter.Split(nil); err == nil {
+		t.Error("Expected error for nil secret")
+	}
+}
+
+func TestSplitter_RandomFailure(t *testing.T) {
+	splitter, err := NewSplitter(3, 2, WithRandom(failingReader{}))
+	if err != nil {
+		t.Fatalf("NewSplitter failed: %v", err)
+	}
+	if _, err := splitter.Split([]byte("secret")); !errors.Is(err, errFailingReader) {
+		t.Errorf("Expected random source error, got %v", err)
+	}
+}
+
+func TestSplitter_ConcurrentUse(t *testing.T) {
+	splitter, err := NewSplitter(4, 2)
+	if err != nil {
+		t.Fatalf("NewSplitter failed: %v", err)
+	}
+
+	var wg sync.WaitGroup
+	for g := 0; g < 8; g++ {
+		wg.Add(1)
+		go func(g int) {
+			defer wg.Done()
+			secret := []byte{byte(g), 1, 2, 3}
+			shares, err := splitter.Split(secret)
+			if err != nil {
+				t.Errorf("Split failed: %v", err)
+				return
+			}
+			recovered, err := Combine(shares[2:], 2)
+			if err != nil {
+				t.Errorf("Combine failed: %v", err)
+				return
+			}
+			if !bytes.Equal(secret, recovered) {
+				t.Errorf("Goroutine %d: expected %v, got %v", g, secret, recovered)
+			}
+		}(g)
+	}
+	wg.Wait()
+}
+
+func TestSplitter_BufferPool(t *testing.T) {
+	splitter, err := NewSplitter(5, 3, WithBufferPool(true))
+	if err != nil {
+		t.Fatalf("NewSplitter failed: %v", err)
+	}
+
+	for round := 0; round < 3; round++ {
+		secret := []byte("pooled secret material")
+		shares, err := splitter.Split(secret)
+		if err != nil {
+			t.Fatalf("Round %d: Split failed: %v", round, err)
+		}
+		recovered, err := Combine(shares[1:4], 3)
+		if err != nil {
+			t.Fatalf("Round %d: Combine failed: %v", round, err)
+		}
+		if !bytes.Equal(secret, recovered) {
+			t.Errorf("Round %d: expected %q, got %q", round, secret, recovered)
+		}
+
+		values := shares[0].Value
+		splitter.Release(shares)
+		if shares[0].Value != nil {
+			t.Error("Expected released share value to be cleared")
+		}
+		for _, b := range values[:cap(values)] {
+			if b != 0 {
+				t.Fatal("Expected released buffer to be zeroed")
+			}
+		}
+	}
+}
+
+func TestSplitter_BufferPoolReleaseOddSize(t *testing.T) {
+	splitter, _ := NewSplitter(3, 2, WithBufferPool(true), WithPepper([]byte("pepper")))
+	// Peppered values are 28 bytes longer, so their capacity is not a
+	// size class of the pool.
+	shares, err := splitter.Split([]byte("secret"))
+	if err != nil {
+		t.Fatalf("Split failed: %v", err)
+	}
+	values := []byte{1, 2, 3, 4, 5}
+	shares = append(shares, Share{Index: 4, Value: values})
+	kept := make([][]byte, len(shares))
+	for i, s := range shares {
+		kept[i] = s.Value
+	}
+
+	splitter.Release(shares)
+	for i, v := range kept {
+		for _, b := range v[:cap(v)] {
+			if b != 0 {
+				t.Fatalf("Expected released share %d to be zeroed", i)
+			}
+		}
+	}
+}
+
+var errFailingReader = errors.New("random source unavailable")
+
+type failingReader struct{}
+
+func (failingReader) Read([]byte) (int, error) {
+	return 0, errFailingReader
+}
+
+func BenchmarkSplitterSplit(b *testing.B) {
+	secret := make([]byte, 32)
+	if _, err := rand.Read(secret); err != nil {
+		b.Fatal(err)
+	}
+	splitter, err := NewSplitter(5, 3)
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		splitter.Split(secret)
+	}
+}
+
+func BenchmarkSplitterSplitPooled(b *testing.B) {
+	secret := make([]byte, 32)
+	if _, err := rand.Read(secret); err != nil {
+		b.Fatal(err)
+	}
+	splitter, err := NewSplitter(5, 3, WithBufferPool(true))
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		shares, _ := splitter.Split(secret)
+		splitter.Release(shares)
+	}
+}
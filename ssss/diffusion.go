@@ -0,0 +1,91 @@
+package ssss
+
+import (
+	"encoding/binary"
+	"math/big"
+)
+
+// diffuse applies the diffusion layer of ssss to x, an element of
+// GF(2^degree), or removes it when decode is set. ssss lays the
+// element out as 16-bit big-endian words, least significant word first,
+// and runs XTEA with an all-zero key over overlapping 8-byte windows,
+// advancing two bytes at a time for 40 passes' worth of bytes. When the
+// degree is an odd number of bytes, the top word holds a single byte,
+// which is moved next to the others for the duration.
+func diffuse(x *big.Int, degree int, decode bool) *big.Int {
+	n := degree / 8
+	words := (degree + 8) / 16
+	v := make([]byte, 2*words)
+	be := x.FillBytes(make([]byte, 2*words))
+	for w := range words {
+		copy(v[2*w:2*w+2], be[len(be)-2*w-2:len(be)-2*w])
+	}
+	if degree%16 == 8 {
+		v[n-1] = v[n]
+	}
+
+	if decode {
+		for i := 40*n - 2; i >= 0; i -= 2 {
+			diffuseSlice(v, i, n, decipher)
+		}
+	} else {
+		for i := 0; i < 40*n; i += 2 {
+			diffuseSlice(v, i, n, encipher)
+		}
+	}
+
+	if degree%16 == 8 {
+		v[n] = v[n-1]
+		v[n-1] = 0
+	}
+	for w := range words {
+		copy(be[len(be)-2*w-2:len(be)-2*w], v[2*w:2*w+2])
+	}
+	return new(big.Int).SetBytes(be)
+}
+
+// diffuseSlice runs block over the 8 bytes of data starting at idx,
+// wrapping around at n.
+func diffuseSlice(data []byte, idx, n int, block func(v *[2]uint32)) {
+	var buf [8]byte
+	for i := range buf {
+		buf[i] = data[(idx+i)%n]
+	}
+	v := [2]uint32{binary.BigEndian.Uint32(buf[:4]), binary.BigEndian.Uint32(buf[4:])}
+	block(&v)
+	binary.BigEndian.PutUint32(buf[:4], v[0])
+	binary.BigEndian.PutUint32(buf[4:], v[1])
+	for i := range buf {
+		data[(idx+i)%n] = buf[i]
+	}
+}
+
+const (
+	xteaDelta = 0x9E3779B9
+	// xteaSum is the key schedule sum after 32 rounds, 32·delta mod 2^32.
+	xteaSum = 0xC6EF3720
+)
+
+// encipher is 32 rounds of XTEA with an all-zero key.
+func encipher(v *[2]uint32) {
+	v0, v1 := v[0], v[1]
+	var sum uint32
+	for range 32 {
+		v0 += (v1<<4 ^ v1>>5 + v1) ^ sum
+		sum += xteaDelta
+		v1 += (v0<<4 ^ v0>>5 + v0) ^ sum
+	}
+	v[0], v[1] = v0, v1
+}
+
+// decipher inverts encipher.
+func decipher(v *[2]uint32) {
+	v0, v1 := v[0], v[1]
+	sum := uint32(xteaSum)
+	for range 32 {
+		v1 -= (v0<<4 ^ v0>>5 + v0) ^ sum
+		sum -= xteaDelta
+		v0 -= (v1<<4 ^ v1>>5 + v1) ^ sum
+	}
+	v[0], v[1] = v0, v1
+}
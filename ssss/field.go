@@ -0,0 +1,205 @@
+package ssss
+
+import (
+	"math/big"
+	"sync"
+)
+
+// field is GF(2^degree), with elements held as polynomials over GF(2) in
+// the bits of a big.Int, reduced modulo poly.
+type field struct {
+	degree int
+	// poly is x^degree + x^k[0] + x^k[1] + x^k[2] + 1.
+	poly *big.Int
+	k    [3]int
+}
+
+var (
+	fieldsMu sync.Mutex
+	fields   = make(map[int]*field)
+)
+
+// fieldOf returns GF(2^degree) with the modulus ssss uses: the irreducible
+// pentanomial x^degree + x^a + x^b + x^c + 1 with a as small as possible,
+// then b, then c. These are the pentanomials of the table compiled into
+// ssss, computed here rather than copied.
+func fieldOf(degree int) *field {
+	fieldsMu.Lock()
+	defer fieldsMu.Unlock()
+	if f, ok := fields[degree]; ok {
+		return f
+	}
+	for a := 3; a < degree; a++ {
+		for b := 2; b < a; b++ {
+			for c := 1; c < b; c++ {
+				poly := pentanomial(degree, a, b, c)
+				if irreducible(poly, degree) {
+					f := &field{degree: degree, poly: poly, k: [3]int{a, b, c}}
+					fields[degree] = f
+					return f
+				}
+			}
+		}
+	}
+	panic("ssss: no irreducible pentanomial")
+}
+
+func pentanomial(degree, a, b, c int) *big.Int {
+	p := new(big.Int)
+	for _, e := range []int{degree, a, b, c, 0} {
+		p.SetBit(p, e, 1)
+	}
+	return p
+}
+
+// reduce reduces v modulo the field polynomial in place, using
+// x^degree = x^a + x^b + x^c + 1.
+func (f *field) reduce(v *big.Int) *big.Int {
+	mask := new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), uint(f.degree)), big.NewInt(1))
+	hi := new(big.Int)
+	for v.BitLen() > f.degree {
+		hi.Rsh(v, uint(f.degree))
+		v.And(v, mask)
+		for _, k := range []int{0, f.k[0], f.k[1], f.k[2]} {
+			v.Xor(v, new(big.Int).Lsh(hi, uint(k)))
+		}
+	}
+	return v
+}
+
+// add returns x + y.
+func (f *field) add(x, y *big.Int) *big.Int {
+	return new(big.Int).Xor(x, y)
+}
+
+// mul returns x · y.
+func (f *field) mul(x, y *big.Int) *big.Int {
+	z := new(big.Int)
+	shifted := new(big.Int)
+	for i := range y.BitLen() {
+		if y.Bit(i) == 1 {
+			z.Xor(z, shifted.Lsh(x, uint(i)))
+		}
+	}
+	return f.reduce(z)
+}
+
+// spread maps a byte to the 16-bit value with its bits interleaved with
+// zeros, which is its square over GF(2).
+var spread = func() (t [256]uint16) {
+	for b := range t {
+		for i := range 8 {
+			t[b] |= uint16(b>>i&1) << (2 * i)
+		}
+	}
+	return t
+}()
+
+// sqr returns x², which over GF(2) interleaves the bits of x with zeros.
+func (f *field) sqr(x *big.Int) *big.Int {
+	in := x.Bytes()
+	out := make([]byte, 2*len(in))
+	for i, b := range in {
+		s := spread[b]
+		out[2*i], out[2*i+1] = byte(s>>8), byte(s)
+	}
+	return f.reduce(new(big.Int).SetBytes(out))
+}
+
+// inv returns the multiplicative inverse of a non-zero x, by the extended
+// Euclidean algorithm over GF(2)[x].
+func (f *field) inv(x *big.Int) *big.Int {
+	r0, r1 := new(big.Int).Set(f.poly), new(big.Int).Set(x)
+	s0, s1 := new(big.Int), big.NewInt(1)
+	for r1.Sign() != 0 {
+		q, r := polyDivMod(r0, r1)
+		r0, r1 = r1, r
+		s0, s1 = s1, new(big.Int).Xor(s0, polyMul(q, s1))
+	}
+	return f.reduce(s0)
+}
+
+// polyMul multiplies polynomials over GF(2) without reduction.
+func polyMul(x, y *big.Int) *big.Int {
+	z := new(big.Int)
+	shifted := new(big.Int)
+	for i := range y.BitLen() {
+		if y.Bit(i) == 1 {
+			z.Xor(z, shifted.Lsh(x, uint(i)))
+		}
+	}
+	return z
+}
+
+// polyDivMod divides polynomials over GF(2).
+func polyDivMod(a, b *big.Int) (q, r *big.Int) {
+	q, r = new(big.Int), new(big.Int).Set(a)
+	db := b.BitLen()
+	for r.BitLen() >= db {
+		shift := r.BitLen() - db
+		q.SetBit(q, shift, 1)
+		r.Xor(r, new(big.Int).Lsh(b, uint(shift)))
+	}
+	return q, r
+}
+
+// polyGCD returns the greatest common divisor of polynomials over GF(2).
+func polyGCD(a, b *big.Int) *big.Int {
+	a, b = new(big.Int).Set(a), new(big.Int).Set(b)
+	for b.Sign() != 0 {
+		_, r := polyDivMod(a, b)
+		a, b = b, r
+	}
+	return a
+}
+
+// irreducible reports whether poly, of the given degree, is irreducible,
+// by Rabin's test: x^(2^degree) = x modulo poly, and x^(2^(degree/p)) - x
+// is coprime to poly for every prime p dividing degree.
+func irreducible(poly *big.Int, degree int) bool {
+	f := &field{degree: degree, poly: poly}
+	// reduce needs the exponents of the low terms.
+	var low []int
+	for i := poly.BitLen() - 2; i > 0; i-- {
+		if poly.Bit(i) == 1 {
+			low = append(low, i)
+		}
+	}
+	copy(f.k[:], low)
+
+	x := big.NewInt(2)
+	frobenius := func(times int) *big.Int {
+		v := new(big.Int).Set(x)
+		for range times {
+			v = f.sqr(v)
+		}
+		return v
+	}
+	if frobenius(degree).Cmp(x) != 0 {
+		return false
+	}
+	for _, p := range primeFactors(degree) {
+		h := frobenius(degree / p)
+		h.Xor(h, x)
+		if polyGCD(poly, h).Cmp(big.NewInt(1)) != 0 {
+			return false
+		}
+	}
+	return true
+}
+
+func primeFactors(n int) []int {
+	var ps []int
+	for p := 2; p*p <= n; p++ {
+		if n%p == 0 {
+			ps = append(ps, p)
+			for n%p == 0 {
+				n /= p
+			}
+		}
+	}
+	if n > 1 {
+		ps = append(ps, n)
+	}
+	return ps
+}
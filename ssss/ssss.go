@@ -0,0 +1,256 @@
+// Package ssss reads and writes shares of ssss(1), the classic Shamir
+// secret sharing command-line tool of ssss-split and ssss-combine, so that
+// shares made with it can be recovered with this library and new shares
+// handed to people who only have the tool.
+//
+// ssss works in GF(2^m), where m is eight times the secret length in bytes,
+// from 8 to 1024, and for m of 64 or more it first passes the secret
+// through a diffusion layer built on XTEA with a zero key. A share is a
+// line of the form
+//
+//	[token-]index-hexvalue
+//
+// where the index is zero-padded to the width of the share count and the
+// value to m/4 hex digits. Its arithmetic is not that of the goshamir
+// package, so shares of the two cannot be mixed.
+package ssss
+
+import (
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+// Limits of the field degree, in bits.
+const (
+	MinDegree = 8
+	MaxDegree = 1024
+)
+
+// diffusionMinDegree is the smallest degree ssss applies diffusion at.
+const diffusionMinDegree = 64
+
+// ErrInvalidShare is returned for a line that is not an ssss share.
+var ErrInvalidShare = errors.New("invalid ssss share")
+
+// Share is a share of ssss(1).
+type Share struct {
+	// Token is the optional label ssss-split -w prefixes to every share.
+	Token string
+	// Index is the share number, the x-coordinate, starting at 1.
+	Index int
+	// Value is the y-coordinate, big-endian, Degree/8 bytes long.
+	Value []byte
+}
+
+// Degree returns the degree of the field the share belongs to.
+func (s Share) Degree() int {
+	return 8 * len(s.Value)
+}
+
+// String returns the share in ssss form, without padding the index.
+func (s Share) String() string {
+	return s.format(0)
+}
+
+func (s Share) format(width int) string {
+	var b strings.Builder
+	if s.Token != "" {
+		b.WriteString(s.Token)
+		b.WriteByte('-')
+	}
+	fmt.Fprintf(&b, "%0*d-%x", width, s.Index, s.Value)
+	return b.String()
+}
+
+// FormatShares returns the shares as ssss-split prints them, with indices
+// zero-padded to a common width.
+func FormatShares(shares []Share) []string {
+	width := 0
+	for _, s := range shares {
+		width = max(width, len(strconv.Itoa(s.Index)))
+	}
+	lines := make([]string, len(shares))
+	for i, s := range shares {
+		lines[i] = s.format(width)
+	}
+	return lines
+}
+
+// ParseShare parses a share line as printed by ssss-split. Surrounding
+// whitespace is ignored.
+func ParseShare(line string) (Share, error) {
+	line = strings.TrimSpace(line)
+	token, rest, hasToken := strings.Cut(line, "-")
+	if !hasToken {
+		return Share{}, fmt.Errorf("%w: missing separator", ErrInvalidShare)
+	}
+	index, value, ok := strings.Cut(rest, "-")
+	if !ok {
+		token, index, value = "", token, rest
+	}
+	n, err := strconv.Atoi(index)
+	if err != nil || n < 1 {
+		return Share{}, fmt.Errorf("%w: bad index %q", ErrInvalidShare, index)
+	}
+	if len(value)%2 != 0 || 4*len(value) < MinDegree || 4*len(value) > MaxDegree {
+		return Share{}, fmt.Errorf("%w: value of %d hex digits", ErrInvalidShare, len(value))
+	}
+	v, ok := new(big.Int).SetString(value, 16)
+	if !ok {
+		return Share{}, fmt.Errorf("%w: value is not hex", ErrInvalidShare)
+	}
+	return Share{Token: token, Index: n, Value: v.FillBytes(make([]byte, len(value)/2))}, nil
+}
+
+// Config configures Split and Combine. Combine only uses Threshold and
+// NoDiffusion.
+type Config struct {
+	// Shares is the number of shares Split produces.
+	Shares int
+	// Threshold is the number of shares needed to reconstruct the secret.
+	Threshold int
+	// Token is an optional label prefixed to every share, as with
+	// ssss-split -w. It must not contain '-' or whitespace.
+	Token string
+	// Degree is the field degree in bits, as with ssss-split -s. It must
+	// be a multiple of 8 from MinDegree to MaxDegree. Defaults to eight
+	// times the secret length.
+	Degree int
+	// NoDiffusion disables the diffusion layer, as with the -D flag of
+	// both tools. Shares must be combined with the same setting.
+	NoDiffusion bool
+	// Rand is the source of randomness for polynomial coefficients.
+	// Defaults to crypto/rand.Reader.
+	Rand io.Reader
+}
+
+// Split splits secret the way ssss-split does, into cfg.Shares shares of
+// which any cfg.Threshold reconstruct it. A secret shorter than the field
+// is padded with leading zero bytes.
+func Split(secret []byte, cfg Config) ([]Share, error) {
+	if cfg.Threshold < 2 || cfg.Shares < cfg.Threshold {
+		return nil, fmt.Errorf("invalid threshold %d of %d shares", cfg.Threshold, cfg.Shares)
+	}
+	if strings.ContainsAny(cfg.Token, "- \t\r\n") {
+		return nil, errors.New("token must not contain '-' or whitespace")
+	}
+	degree := cfg.Degree
+	if degree == 0 {
+		degree = 8 * len(secret)
+	}
+	if degree%8 != 0 || degree < MinDegree || degree > MaxDegree {
+		return nil, fmt.Errorf("invalid field degree %d", degree)
+	}
+	if 8*len(secret) > degree {
+		return nil, fmt.Errorf("secret of %d bytes does not fit a field of degree %d", len(secret), degree)
+	}
+	if cfg.Shares >= 1<<min(degree, 30) {
+		return nil, fmt.Errorf("too many shares for a field of degree %d", degree)
+	}
+	r := cfg.Rand
+	if r == nil {
+		r = rand.Reader
+	}
+
+	f := fieldOf(degree)
+	coeffs := make([]*big.Int, cfg.Threshold)
+	coeffs[0] = new(big.Int).SetBytes(secret)
+	if !cfg.NoDiffusion && degree >= diffusionMinDegree {
+		coeffs[0] = diffuse(coeffs[0], degree, false)
+	}
+	buf := make([]byte, degree/8)
+	defer clear(buf)
+	for i := 1; i < cfg.Threshold; i++ {
+		if _, err := io.ReadFull(r, buf); err != nil {
+			return nil, fmt.Errorf("random coefficient generation failed: %w", err)
+		}
+		coeffs[i] = new(big.Int).SetBytes(buf)
+	}
+
+	shares := make([]Share, cfg.Shares)
+	for i := range shares {
+		x := big.NewInt(int64(i + 1))
+		shares[i] = Share{
+			Token: cfg.Token,
+			Index: i + 1,
+			Value: horner(f, x, coeffs).FillBytes(make([]byte, degree/8)),
+		}
+	}
+	return shares, nil
+}
+
+// horner evaluates the polynomial ssss shares with at x. Its leading
+// coefficient is an implicit 1, so it has degree len(coeffs):
+// x^t + coeffs[t-1]·x^(t-1) + … + coeffs[0].
+func horner(f *field, x *big.Int, coeffs []*big.Int) *big.Int {
+	y := new(big.Int).Set(x)
+	for i := len(coeffs) - 1; i > 0; i-- {
+		y = f.mul(f.add(y, coeffs[i]), x)
+	}
+	return f.add(y, coeffs[0])
+}
+
+// Combine reconstructs the secret from shares, as ssss-combine does. The
+// first cfg.Threshold shares are used, and the threshold must be the one
+// the shares were split with. The secret is returned at the full field
+// width, Degree/8 bytes.
+func Combine(shares []Share, cfg Config) ([]byte, error) {
+	t := cfg.Threshold
+	if t < 2 {
+		return nil, fmt.Errorf("invalid threshold %d", t)
+	}
+	if len(shares) < t {
+		return nil, fmt.Errorf("need %d shares, got %d", t, len(shares))
+	}
+	shares = shares[:t]
+	degree := shares[0].Degree()
+	if degree%8 != 0 || degree < MinDegree || degree > MaxDegree {
+		return nil, fmt.Errorf("%w: field degree %d", ErrInvalidShare, degree)
+	}
+	seen := make(map[int]bool, t)
+	for _, s := range shares {
+		if s.Degree() != degree {
+			return nil, fmt.Errorf("%w: shares have different lengths", ErrInvalidShare)
+		}
+		if s.Index < 1 || seen[s.Index] {
+			return nil, fmt.Errorf("%w: bad or duplicate index %d", ErrInvalidShare, s.Index)
+		}
+		seen[s.Index] = true
+	}
+
+	f := fieldOf(degree)
+	xs := make([]*big.Int, t)
+	ys := make([]*big.Int, t)
+	for i, s := range shares {
+		xs[i] = big.NewInt(int64(s.Index))
+		// Remove the implicit leading term x^t.
+		xt := big.NewInt(1)
+		for range t {
+			xt = f.mul(xt, xs[i])
+		}
+		ys[i] = f.add(new(big.Int).SetBytes(s.Value), xt)
+	}
+
+	// Lagrange interpolation at 0; subtraction is addition in GF(2^m).
+	secret := new(big.Int)
+	for i := range t {
+		num, den := big.NewInt(1), big.NewInt(1)
+		for j := range t {
+			if i == j {
+				continue
+			}
+			num = f.mul(num, xs[j])
+			den = f.mul(den, f.add(xs[i], xs[j]))
+		}
+		secret = f.add(secret, f.mul(ys[i], f.mul(num, f.inv(den))))
+	}
+	if !cfg.NoDiffusion && degree >= diffusionMinDegree {
+		secret = diffuse(secret, degree, true)
+	}
+	return secret.FillBytes(make([]byte, degree/8)), nil
+}
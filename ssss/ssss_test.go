@@ -0,0 +1,205 @@
+package ssss
+
+import (
+	"bytes"
+	"errors"
+	"math/big"
+	"slices"
+	"strings"
+	"testing"
+)
+
+// --- Field Tests ---
+
+func TestFieldOf_MatchesSSSSTable(t *testing.T) {
+	// Entries of the irreducible polynomial table compiled into ssss.
+	tests := []struct {
+		degree int
+		k      [3]int
+	}{
+		{8, [3]int{4, 3, 1}},
+		{16, [3]int{5, 3, 1}},
+		{24, [3]int{4, 3, 1}},
+		{32, [3]int{7, 3, 2}},
+		{40, [3]int{5, 4, 3}},
+		{64, [3]int{4, 3, 1}},
+		{72, [3]int{10, 9, 3}},
+		{96, [3]int{10, 9, 6}},
+		{128, [3]int{7, 2, 1}},
+	}
+	for _, tt := range tests {
+		if got := fieldOf(tt.degree).k; got != tt.k {
+			t.Errorf("degree %d: got x^%v, want x^%v", tt.degree, got, tt.k)
+		}
+	}
+}
+
+func TestField_Inverse(t *testing.T) {
+	f := fieldOf(64)
+	for _, v := range []int64{1, 2, 3, 0x1234567, 1 << 62} {
+		x := big.NewInt(v)
+		if got := f.mul(x, f.inv(x)); got.Cmp(big.NewInt(1)) != 0 {
+			t.Errorf("%d · %d⁻¹ = %v", v, v, got)
+		}
+	}
+}
+
+func TestField_FIPS197Product(t *testing.T) {
+	// ssss's degree-8 polynomial x^8 + x^4 + x^3 + x + 1 is the one of AES,
+	// so FIPS 197's worked example, {57}·{83} = {c1}, applies.
+	f := fieldOf(8)
+	if got := f.mul(big.NewInt(0x57), big.NewInt(0x83)); got.Cmp(big.NewInt(0xc1)) != 0 {
+		t.Fatalf("{57}·{83} = %x, want c1", got)
+	}
+}
+
+// --- Diffusion Tests ---
+
+func TestEncipher_KnownAnswer(t *testing.T) {
+	// The published XTEA test vector for an all-zero key and block.
+	v := [2]uint32{0, 0}
+	encipher(&v)
+	if v != [2]uint32{0xdee9d4d8, 0xf7131ed9} {
+		t.Fatalf("got %08x%08x, want dee9d4d8f7131ed9", v[0], v[1])
+	}
+	decipher(&v)
+	if v != [2]uint32{0, 0} {
+		t.Fatalf("decipher did not invert encipher: %08x%08x", v[0], v[1])
+	}
+}
+
+func TestDiffuse_Inverse(t *testing.T) {
+	for _, degree := range []int{64, 72, 128, 136, 1024} {
+		x := new(big.Int).SetBytes(bytes.Repeat([]byte{0xa5, 0x3c, 0x01}, degree/8)[:degree/8])
+		enc := diffuse(x, degree, false)
+		if enc.Cmp(x) == 0 || enc.BitLen() > degree {
+			t.Fatalf("degree %d: bad diffused value %x", degree, enc)
+		}
+		if got := diffuse(enc, degree, true); got.Cmp(x) != 0 {
+			t.Fatalf("degree %d: got %x, want %x", degree, got, x)
+		}
+	}
+}
+
+// --- Split and Combine Tests ---
+
+func TestSplitCombine(t *testing.T) {
+	tests := []struct {
+		name   string
+		secret []byte
+		cfg    Config
+	}{
+		{"short secret", []byte("abc"), Config{Shares: 3, Threshold: 2}},
+		{"diffusion", []byte("correct horse battery"), Config{Shares: 5, Threshold: 3}},
+		{"no diffusion", []byte("correct horse battery"), Config{Shares: 5, Threshold: 3, NoDiffusion: true}},
+		{"padded", []byte("pad"), Config{Shares: 12, Threshold: 4, Degree: 128, Token: "vault"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			shares, err := Split(tt.secret, tt.cfg)
+			if err != nil {
+				t.Fatalf("Split failed: %v", err)
+			}
+			lines := FormatShares(shares)
+			var parsed []Share
+			for _, line := range lines[len(lines)-tt.cfg.Threshold:] {
+				s, err := ParseShare(line)
+				if err != nil {
+					t.Fatalf("ParseShare failed: %v", err)
+				}
+				parsed = append(parsed, s)
+			}
+			got, err := Combine(parsed, tt.cfg)
+			if err != nil {
+				t.Fatalf("Combine failed: %v", err)
+			}
+			want := make([]byte, len(got)-len(tt.secret), len(got))
+			want = append(want, tt.secret...)
+			if !bytes.Equal(got, want) {
+				t.Fatalf("Expected %x, got %x", want, got)
+			}
+		})
+	}
+}
+
+func TestSplit_KnownAnswer(t *testing.T) {
+	// No shares captured from ssss-split are available, so this vector is
+	// derived by hand from ssss's algorithm: for threshold 2 the share at x
+	// is (x + a)·x + s in GF(2^8), with the implicit leading term, here
+	// with s = 0x57 and a = 0x83. Degree 8 has no diffusion.
+	shares, err := Split([]byte{0x57}, Config{Shares: 3, Threshold: 2, Rand: bytes.NewReader([]byte{0x83})})
+	if err != nil {
+		t.Fatalf("Split failed: %v", err)
+	}
+	want := []string{"1-d5", "2-4e", "3-cc"}
+	if got := FormatShares(shares); !slices.Equal(got, want) {
+		t.Fatalf("got %q, want %q", got, want)
+	}
+
+	var parsed []Share
+	for _, line := range want[1:] {
+		s, _ := ParseShare(line)
+		parsed = append(parsed, s)
+	}
+	got, err := Combine(parsed, Config{Threshold: 2})
+	if err != nil {
+		t.Fatalf("Combine failed: %v", err)
+	}
+	if !bytes.Equal(got, []byte{0x57}) {
+		t.Fatalf("got %x, want 57", got)
+	}
+}
+
+func TestFormatShares(t *testing.T) {
+	shares, err := Split([]byte("ab"), Config{Shares: 10, Threshold: 2, Token: "x"})
+	if err != nil {
+		t.Fatalf("Split failed: %v", err)
+	}
+	lines := FormatShares(shares)
+	if !strings.HasPrefix(lines[0], "x-01-") || !strings.HasPrefix(lines[9], "x-10-") || len(lines[0]) != len("x-01-")+4 {
+		t.Fatalf("unexpected share lines %q", lines)
+	}
+	if s := shares[0].String(); !strings.HasPrefix(s, "x-1-") {
+		t.Fatalf("unexpected String %q", s)
+	}
+}
+
+func TestCombine_WrongThresholdOrDiffusion(t *testing.T) {
+	secret := []byte("threshold matters")
+	shares, _ := Split(secret, Config{Shares: 4, Threshold: 3})
+	got, err := Combine(shares, Config{Threshold: 4})
+	if err != nil || bytes.Equal(got, secret) {
+		t.Fatalf("expected a wrong secret with the wrong threshold, got %q, %v", got, err)
+	}
+	got, _ = Combine(shares, Config{Threshold: 3, NoDiffusion: true})
+	if bytes.Equal(got, secret) {
+		t.Fatal("expected a wrong secret without diffusion")
+	}
+}
+
+func TestParseShare_Invalid(t *testing.T) {
+	for _, line := range []string{"", "abc", "0-ab", "x-1-abc", "1-zz", "1-" + strings.Repeat("ab", 129)} {
+		if _, err := ParseShare(line); !errors.Is(err, ErrInvalidShare) {
+			t.Errorf("ParseShare(%q): expected ErrInvalidShare, got %v", line, err)
+		}
+	}
+}
+
+func TestSplit_Invalid(t *testing.T) {
+	tests := []struct {
+		name   string
+		secret []byte
+		cfg    Config
+	}{
+		{"threshold", []byte("s"), Config{Shares: 3, Threshold: 1}},
+		{"token", []byte("s"), Config{Shares: 3, Threshold: 2, Token: "a-b"}},
+		{"degree", []byte("s"), Config{Shares: 3, Threshold: 2, Degree: 12}},
+		{"too long", make([]byte, 129), Config{Shares: 3, Threshold: 2}},
+		{"too many shares", []byte("s"), Config{Shares: 256, Threshold: 2}},
+	}
+	for _, tt := range tests {
+		if _, err := Split(tt.secret, tt.cfg); err == nil {
+			t.Errorf("%s: expected error", tt.name)
+		}
+	}
+}
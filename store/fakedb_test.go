@@ -0,0 +1,203 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"io"
+	"regexp"
+	"slices"
+	"strings"
+	"sync"
+)
+
+// fakeDriver is a minimal in-memory database/sql driver that understands
+// exactly the statements SQLStore issues, so the store can be tested
+// without a real database.
+type fakeDriver struct {
+	mu  sync.Mutex
+	dbs map[string]*fakeDB
+}
+
+type fakeDB struct {
+	mu         sync.Mutex
+	tables     map[string]bool
+	migrations map[int64]bool
+	rows       map[fakeKey]fakeRow
+	queries    []string
+}
+
+type fakeKey struct {
+	setID string
+	index int64
+}
+
+type fakeRow struct {
+	envelope []byte
+	version  int64
+}
+
+var fakeSQL = &fakeDriver{dbs: make(map[string]*fakeDB)}
+
+func init() {
+	sql.Register("goshamir-fake", fakeSQL)
+}
+
+// openFakeDB returns a fresh database and its backing state.
+func openFakeDB(name string) (*sql.DB, *fakeDB) {
+	fakeSQL.mu.Lock()
+	state := &fakeDB{tables: map[string]bool{}, migrations: map[int64]bool{}, rows: map[fakeKey]fakeRow{}}
+	fakeSQL.dbs[name] = state
+	fakeSQL.mu.Unlock()
+	db, _ := sql.Open("goshamir-fake", name)
+	return db, state
+}
+
+func (d *fakeDriver) Open(name string) (driver.Conn, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	state, ok := d.dbs[name]
+	if !ok {
+		return nil, fmt.Errorf("unknown fake database %q", name)
+	}
+	return &fakeConn{db: state}, nil
+}
+
+type fakeConn struct{ db *fakeDB }
+
+func (c *fakeConn) Prepare(query string) (driver.Stmt, error) {
+	return nil, errors.New("prepare not supported")
+}
+func (c *fakeConn) Close() error              { return nil }
+func (c *fakeConn) Begin() (driver.Tx, error) { return fakeTx{}, nil }
+
+type fakeTx struct{}
+
+func (fakeTx) Commit() error   { return nil }
+func (fakeTx) Rollback() error { return nil }
+
+var (
+	placeholderPattern = regexp.MustCompile(`\$\d+`)
+	createPattern      = regexp.MustCompile(`^CREATE TABLE (IF NOT EXISTS )?(\w+) `)
+	tablePattern       = regexp.MustCompile(`(?:FROM|INTO|UPDATE) (\w+)`)
+)
+
+func (c *fakeConn) ExecContext(_ context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	db := c.db
+	db.mu.Lock()
+	defer db.mu.Unlock()
+	db.queries = append(db.queries, query)
+	query = placeholderPattern.ReplaceAllString(query, "?")
+
+	if m := createPattern.FindStringSubmatch(query); m != nil {
+		if db.tables[m[2]] && m[1] == "" {
+			return nil, fmt.Errorf("table %s already exists", m[2])
+		}
+		db.tables[m[2]] = true
+		return driver.RowsAffected(0), nil
+	}
+	if err := db.checkTable(query); err != nil {
+		return nil, err
+	}
+
+	switch {
+	case strings.HasPrefix(query, "INSERT INTO") && strings.Contains(query, "_migrations"):
+		db.migrations[args[0].Value.(int64)] = true
+	case strings.HasPrefix(query, "INSERT INTO"):
+		key := fakeKey{args[0].Value.(string), args[1].Value.(int64)}
+		if _, ok := db.rows[key]; ok {
+			return nil, errors.New("unique constraint violated")
+		}
+		db.rows[key] = fakeRow{envelope: args[2].Value.([]byte), version: 1}
+	case strings.HasPrefix(query, "UPDATE"):
+		key := fakeKey{args[1].Value.(string), args[2].Value.(int64)}
+		row, ok := db.rows[key]
+		if !ok || row.version != args[3].Value.(int64) {
+			return driver.RowsAffected(0), nil
+		}
+		db.rows[key] = fakeRow{envelope: args[0].Value.([]byte), version: row.version + 1}
+	case strings.HasPrefix(query, "DELETE FROM"):
+		n := 0
+		for key := range db.rows {
+			if key.setID == args[0].Value.(string) {
+				delete(db.rows, key)
+				n++
+			}
+		}
+		return driver.RowsAffected(n), nil
+	default:
+		return nil, fmt.Errorf("unexpected statement %q", query)
+	}
+	return driver.RowsAffected(1), nil
+}
+
+func (c *fakeConn) QueryContext(_ context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	db := c.db
+	db.mu.Lock()
+	defer db.mu.Unlock()
+	db.queries = append(db.queries, query)
+	query = placeholderPattern.ReplaceAllString(query, "?")
+	if err := db.checkTable(query); err != nil {
+		return nil, err
+	}
+
+	rows := &fakeRows{}
+	switch {
+	case strings.HasPrefix(query, "SELECT COUNT(*)"):
+		n := int64(0)
+		if db.migrations[args[0].Value.(int64)] {
+			n = 1
+		}
+		rows.values = [][]driver.Value{{n}}
+	case strings.HasPrefix(query, "SELECT version"), strings.HasPrefix(query, "SELECT envelope, version"):
+		row, ok := db.rows[fakeKey{args[0].Value.(string), args[1].Value.(int64)}]
+		if ok && strings.HasPrefix(query, "SELECT version") {
+			rows.values = [][]driver.Value{{row.version}}
+		} else if ok {
+			rows.values = [][]driver.Value{{slices.Clone(row.envelope), row.version}}
+		}
+	case strings.HasPrefix(query, "SELECT share_index, envelope"):
+		for key, row := range db.rows {
+			if key.setID == args[0].Value.(string) {
+				rows.values = append(rows.values, []driver.Value{key.index, slices.Clone(row.envelope)})
+			}
+		}
+		slices.SortFunc(rows.values, func(a, b []driver.Value) int {
+			return int(a[0].(int64) - b[0].(int64))
+		})
+	default:
+		return nil, fmt.Errorf("unexpected query %q", query)
+	}
+	return rows, nil
+}
+
+func (db *fakeDB) checkTable(query string) error {
+	if m := tablePattern.FindStringSubmatch(query); m != nil && !db.tables[m[1]] {
+		return fmt.Errorf("no such table: %s", m[1])
+	}
+	return nil
+}
+
+type fakeRows struct {
+	values [][]driver.Value
+}
+
+func (r *fakeRows) Columns() []string {
+	if len(r.values) == 0 {
+		return []string{"a", "b"}
+	}
+	return make([]string, len(r.values[0]))
+}
+
+func (r *fakeRows) Close() error { return nil }
+
+func (r *fakeRows) Next(dest []driver.Value) error {
+	if len(r.values) == 0 {
+		return io.EOF
+	}
+	copy(dest, r.values[0])
+	r.values = r.values[1:]
+	return nil
+}
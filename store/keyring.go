@@ -0,0 +1,225 @@
+package store
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+
+	goshamir "github.com/fawwazid/go-shamir"
+)
+
+// DefaultKeyringService is the service name KeyringStore files its items
+// under unless WithKeyringService is given.
+const DefaultKeyringService = "goshamir"
+
+// Keyring is an OS secret store holding small secrets by service and
+// account name. SystemKeyring returns the platform's implementation.
+type Keyring interface {
+	// Set stores secret, replacing any existing item.
+	Set(service, account string, secret []byte) error
+	// Get returns a secret, or ErrNotFound.
+	Get(service, account string) ([]byte, error)
+	// Delete removes a secret. Missing items are ignored.
+	Delete(service, account string) error
+}
+
+// KeyringStore is a ShareStore backed by the OS keychain, for desktop
+// applications keeping a custodian's share without managing files: the
+// macOS Keychain, the Windows Credential Manager (protected with DPAPI) or
+// the Secret Service on Linux (GNOME Keyring, KWallet).
+//
+// Each share is an item with account "<set ID>/<index>" holding the base64
+// share envelope. Keychains cannot be listed portably, so the indices of
+// each set are recorded in an additional "<set ID>/index" item.
+type KeyringStore struct {
+	keyring Keyring
+	service string
+	// mu serializes updates to the index items.
+	mu sync.Mutex
+}
+
+// KeyringOption configures a KeyringStore.
+type KeyringOption func(*KeyringStore)
+
+// WithKeyring uses k instead of the system keyring.
+func WithKeyring(k Keyring) KeyringOption {
+	return func(s *KeyringStore) {
+		s.keyring = k
+	}
+}
+
+// WithKeyringService sets the service name of the store's items, shown by
+// keychain managers.
+func WithKeyringService(service string) KeyringOption {
+	return func(s *KeyringStore) {
+		s.service = service
+	}
+}
+
+// NewKeyringStore returns a KeyringStore using the system keyring unless
+// WithKeyring is given.
+func NewKeyringStore(opts ...KeyringOption) (*KeyringStore, error) {
+	s := &KeyringStore{service: DefaultKeyringService}
+	for _, opt := range opts {
+		if opt != nil {
+			opt(s)
+		}
+	}
+	if s.service == "" {
+		return nil, errors.New("keyring service cannot be empty")
+	}
+	if s.keyring == nil {
+		k, err := SystemKeyring()
+		if err != nil {
+			return nil, err
+		}
+		s.keyring = k
+	}
+	return s, nil
+}
+
+// Put stores a new share.
+func (s *KeyringStore) Put(_ context.Context, setID string, share goshamir.Share) error {
+	if err := validateKeyringKey(setID, share.Index); err != nil {
+		return err
+	}
+	envelope, err := share.MarshalBinary()
+	if err != nil {
+		return err
+	}
+	defer clear(envelope)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	indices, err := s.indices(setID)
+	if err != nil && !errors.Is(err, ErrNotFound) {
+		return err
+	}
+	if slices.Contains(indices, share.Index) {
+		return ErrExists
+	}
+	secret := []byte(base64.StdEncoding.EncodeToString(envelope))
+	defer clear(secret)
+	if err := s.keyring.Set(s.service, shareAccount(setID, share.Index), secret); err != nil {
+		return err
+	}
+	indices = append(indices, share.Index)
+	slices.Sort(indices)
+	return s.setIndices(setID, indices)
+}
+
+// Get returns the share of the set with the given index.
+func (s *KeyringStore) Get(_ context.Context, setID string, index uint8) (goshamir.Share, error) {
+	if err := validateKeyringKey(setID, index); err != nil {
+		return goshamir.Share{}, err
+	}
+	secret, err := s.keyring.Get(s.service, shareAccount(setID, index))
+	if err != nil {
+		return goshamir.Share{}, err
+	}
+	defer clear(secret)
+	envelope, err := base64.StdEncoding.DecodeString(strings.TrimSpace(string(secret)))
+	if err != nil {
+		return goshamir.Share{}, fmt.Errorf("share %s/%d: invalid keyring item", setID, index)
+	}
+	defer clear(envelope)
+	var share goshamir.Share
+	if err := share.UnmarshalBinary(envelope); err != nil {
+		return goshamir.Share{}, fmt.Errorf("share %s/%d: %w", setID, index, err)
+	}
+	if share.Index != index {
+		return goshamir.Share{}, fmt.Errorf("share %s/%d holds index %d", setID, index, share.Index)
+	}
+	return share, nil
+}
+
+// List returns every share of the set, ordered by index.
+func (s *KeyringStore) List(ctx context.Context, setID string) ([]goshamir.Share, error) {
+	if err := validateKeyringKey(setID, 1); err != nil {
+		return nil, err
+	}
+	s.mu.Lock()
+	indices, err := s.indices(setID)
+	s.mu.Unlock()
+	if err != nil {
+		return nil, err
+	}
+	shares := make([]goshamir.Share, 0, len(indices))
+	for _, index := range indices {
+		share, err := s.Get(ctx, setID, index)
+		if err != nil {
+			return nil, err
+		}
+		shares = append(shares, share)
+	}
+	return shares, nil
+}
+
+// Delete removes every share of the set.
+func (s *KeyringStore) Delete(_ context.Context, setID string) error {
+	if err := validateKeyringKey(setID, 1); err != nil {
+		return err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	indices, err := s.indices(setID)
+	if errors.Is(err, ErrNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	for _, index := range indices {
+		if err := s.keyring.Delete(s.service, shareAccount(setID, index)); err != nil {
+			return err
+		}
+	}
+	return s.keyring.Delete(s.service, setID+"/index")
+}
+
+// indices reads the set's index item. The caller must hold s.mu.
+func (s *KeyringStore) indices(setID string) ([]uint8, error) {
+	data, err := s.keyring.Get(s.service, setID+"/index")
+	if err != nil {
+		return nil, err
+	}
+	var indices []uint8
+	for f := range strings.SplitSeq(strings.TrimSpace(string(data)), ",") {
+		index, err := strconv.ParseUint(f, 10, 8)
+		if err != nil || index == 0 {
+			return nil, fmt.Errorf("set %s: corrupt keyring index", setID)
+		}
+		indices = append(indices, uint8(index))
+	}
+	if len(indices) == 0 {
+		return nil, ErrNotFound
+	}
+	return indices, nil
+}
+
+func (s *KeyringStore) setIndices(setID string, indices []uint8) error {
+	fields := make([]string, len(indices))
+	for i, index := range indices {
+		fields[i] = strconv.Itoa(int(index))
+	}
+	return s.keyring.Set(s.service, setID+"/index", []byte(strings.Join(fields, ",")))
+}
+
+func shareAccount(setID string, index uint8) string {
+	return setID + "/" + strconv.Itoa(int(index))
+}
+
+func validateKeyringKey(setID string, index uint8) error {
+	if err := validateKey(setID, index); err != nil {
+		return err
+	}
+	if strings.Contains(setID, "/") {
+		return fmt.Errorf("set ID %q cannot contain a slash", setID)
+	}
+	return nil
+}
@@ -0,0 +1,60 @@
+package store
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"os/exec"
+	"strconv"
+	"strings"
+)
+
+// errSecItemNotFound is the exit status of security(1) for missing items.
+const errSecItemNotFound = 44
+
+// SystemKeyring returns the macOS login keychain, accessed through
+// security(1).
+func SystemKeyring() (Keyring, error) {
+	if _, err := exec.LookPath("security"); err != nil {
+		return nil, fmt.Errorf("macOS keychain unavailable: %w", err)
+	}
+	return keychain{}, nil
+}
+
+type keychain struct{}
+
+func (keychain) Set(service, account string, secret []byte) error {
+	// Commands are passed on standard input in interactive mode, so that
+	// the secret does not appear in the process list.
+	cmd := exec.Command("security", "-i")
+	cmd.Stdin = strings.NewReader("add-generic-password -U -s " + strconv.Quote(service) +
+		" -a " + strconv.Quote(account) + " -w " + strconv.Quote(string(secret)) + "\n")
+	if out, err := cmd.CombinedOutput(); err != nil || len(bytes.TrimSpace(out)) > 0 {
+		return fmt.Errorf("storing keychain item failed: %s", bytes.TrimSpace(out))
+	}
+	return nil
+}
+
+func (keychain) Get(service, account string) ([]byte, error) {
+	out, err := exec.Command("security", "find-generic-password", "-s", service, "-a", account, "-w").Output()
+	var exitErr *exec.ExitError
+	if errors.As(err, &exitErr) && exitErr.ExitCode() == errSecItemNotFound {
+		return nil, ErrNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("reading keychain item failed: %w", err)
+	}
+	return bytes.TrimSuffix(out, []byte("\n")), nil
+}
+
+func (keychain) Delete(service, account string) error {
+	err := exec.Command("security", "delete-generic-password", "-s", service, "-a", account).Run()
+	var exitErr *exec.ExitError
+	if errors.As(err, &exitErr) && exitErr.ExitCode() == errSecItemNotFound {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("deleting keychain item failed: %w", err)
+	}
+	return nil
+}
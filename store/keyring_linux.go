@@ -0,0 +1,66 @@
+package store
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"os/exec"
+	"strings"
+)
+
+// secretTool is the Secret Service command-line client, from libsecret.
+var secretTool = "secret-tool"
+
+// SystemKeyring returns the Secret Service keyring (GNOME Keyring,
+// KWallet), accessed through secret-tool from libsecret.
+func SystemKeyring() (Keyring, error) {
+	if _, err := exec.LookPath(secretTool); err != nil {
+		return nil, fmt.Errorf("secret service keyring unavailable: %w", err)
+	}
+	return secretServiceKeyring{}, nil
+}
+
+type secretServiceKeyring struct{}
+
+func (secretServiceKeyring) Set(service, account string, secret []byte) error {
+	_, err := runSecretTool(secret, "store", "--label="+service+" "+account, "service", service, "account", account)
+	return err
+}
+
+func (secretServiceKeyring) Get(service, account string) ([]byte, error) {
+	out, err := runSecretTool(nil, "lookup", "service", service, "account", account)
+	if errors.Is(err, errSecretNotFound) || (err == nil && len(out) == 0) {
+		return nil, ErrNotFound
+	}
+	return out, err
+}
+
+func (secretServiceKeyring) Delete(service, account string) error {
+	_, err := runSecretTool(nil, "clear", "service", service, "account", account)
+	if errors.Is(err, errSecretNotFound) {
+		return nil
+	}
+	return err
+}
+
+// errSecretNotFound is returned by runSecretTool when secret-tool exits
+// with status 1 without an error message, as lookup does for missing items.
+var errSecretNotFound = errors.New("secret not found")
+
+func runSecretTool(stdin []byte, args ...string) ([]byte, error) {
+	cmd := exec.Command(secretTool, args...)
+	cmd.Stdin = bytes.NewReader(stdin)
+	out, err := cmd.Output()
+	var exitErr *exec.ExitError
+	if errors.As(err, &exitErr) {
+		msg := strings.TrimSpace(string(exitErr.Stderr))
+		if msg == "" && exitErr.ExitCode() == 1 {
+			return nil, errSecretNotFound
+		}
+		return nil, fmt.Errorf("secret-tool %s failed: %s", args[0], msg)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("secret-tool %s failed: %w", args[0], err)
+	}
+	return out, nil
+}
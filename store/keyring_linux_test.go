@@ -0,0 +1,68 @@
+package store
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	goshamir "github.com/fawwazid/go-shamir"
+)
+
+// fakeSecretTool is a shell script emulating secret-tool, keeping each
+// item in a file named after its attributes.
+const fakeSecretTool = `#!/bin/sh
+dir="$(dirname "$0")/items"
+mkdir -p "$dir"
+cmd=$1; shift
+[ "$cmd" = store ] && shift
+file="$dir/$(echo "$@" | tr ' /' '__')"
+case $cmd in
+store) cat > "$file" ;;
+lookup) [ -f "$file" ] || exit 1; cat "$file" ;;
+clear) [ -f "$file" ] || exit 1; rm "$file" ;;
+esac
+`
+
+func TestSystemKeyring_SecretService(t *testing.T) {
+	dir := t.TempDir()
+	tool := filepath.Join(dir, "secret-tool")
+	if err := os.WriteFile(tool, []byte(fakeSecretTool), 0o700); err != nil {
+		t.Fatalf("WriteFile failed: %v", err)
+	}
+	defer func(old string) { secretTool = old }(secretTool)
+	secretTool = tool
+
+	s, err := NewKeyringStore()
+	if err != nil {
+		t.Fatalf("NewKeyringStore failed: %v", err)
+	}
+	ctx := context.Background()
+	shares, _ := goshamir.Split([]byte("secret service"), 3, 2)
+	for _, share := range shares {
+		if err := s.Put(ctx, "desktop", share); err != nil {
+			t.Fatalf("Put failed: %v", err)
+		}
+	}
+	listed, err := s.List(ctx, "desktop")
+	if err != nil || len(listed) != 3 {
+		t.Fatalf("List failed: %v", err)
+	}
+	secret, err := goshamir.Combine(listed[1:], 2)
+	if err != nil || string(secret) != "secret service" {
+		t.Fatalf("Combine failed: %q, %v", secret, err)
+	}
+	if _, err := s.Get(ctx, "desktop", 7); !errors.Is(err, ErrNotFound) {
+		t.Errorf("Expected ErrNotFound, got %v", err)
+	}
+	if err := s.Delete(ctx, "desktop"); err != nil {
+		t.Fatalf("Delete failed: %v", err)
+	}
+	if err := s.Delete(ctx, "desktop"); err != nil {
+		t.Errorf("Deleting a missing set failed: %v", err)
+	}
+	if items, _ := os.ReadDir(filepath.Join(dir, "items")); len(items) != 0 {
+		t.Errorf("Expected no items after Delete, got %d", len(items))
+	}
+}
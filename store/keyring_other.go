@@ -0,0 +1,13 @@
+//go:build !linux && !darwin && !windows
+
+package store
+
+import (
+	"errors"
+	"fmt"
+)
+
+// SystemKeyring is not supported on this platform; use WithKeyring.
+func SystemKeyring() (Keyring, error) {
+	return nil, fmt.Errorf("system keyring: %w", errors.ErrUnsupported)
+}
@@ -0,0 +1,141 @@
+package store
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"strings"
+	"sync"
+	"testing"
+
+	goshamir "github.com/fawwazid/go-shamir"
+)
+
+// memoryKeyring is an in-memory Keyring.
+type memoryKeyring struct {
+	mu    sync.Mutex
+	items map[string][]byte
+}
+
+func newMemoryKeyring() *memoryKeyring {
+	return &memoryKeyring{items: map[string][]byte{}}
+}
+
+func (k *memoryKeyring) Set(service, account string, secret []byte) error {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+	k.items[service+":"+account] = bytes.Clone(secret)
+	return nil
+}
+
+func (k *memoryKeyring) Get(service, account string) ([]byte, error) {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+	secret, ok := k.items[service+":"+account]
+	if !ok {
+		return nil, ErrNotFound
+	}
+	return bytes.Clone(secret), nil
+}
+
+func (k *memoryKeyring) Delete(service, account string) error {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+	delete(k.items, service+":"+account)
+	return nil
+}
+
+// --- KeyringStore Tests ---
+
+func TestKeyringStore_RoundTrip(t *testing.T) {
+	ctx := context.Background()
+	k := newMemoryKeyring()
+	s, err := NewKeyringStore(WithKeyring(k), WithKeyringService("myapp"))
+	if err != nil {
+		t.Fatalf("NewKeyringStore failed: %v", err)
+	}
+	shares, _ := goshamir.Split([]byte("keychain"), 4, 3)
+
+	// Put out of order; List returns shares ordered by index.
+	for _, i := range []int{2, 0, 3, 1} {
+		if err := s.Put(ctx, "db", shares[i]); err != nil {
+			t.Fatalf("Put failed: %v", err)
+		}
+	}
+	if err := s.Put(ctx, "db", shares[1]); !errors.Is(err, ErrExists) {
+		t.Errorf("Expected ErrExists, got %v", err)
+	}
+	item, ok := k.items["myapp:db/2"]
+	if !ok {
+		t.Fatal("Expected item for account db/2 under service myapp")
+	}
+	if bytes.Contains(item, shares[1].Value) {
+		t.Error("Item holds the raw share value instead of its envelope")
+	}
+	if got := string(k.items["myapp:db/index"]); got != "1,2,3,4" {
+		t.Errorf("Expected index 1,2,3,4, got %q", got)
+	}
+
+	got, err := s.Get(ctx, "db", 3)
+	if err != nil || !bytes.Equal(got.Value, shares[2].Value) {
+		t.Fatalf("Get failed: %v", err)
+	}
+	if _, err := s.Get(ctx, "db", 9); !errors.Is(err, ErrNotFound) {
+		t.Errorf("Expected ErrNotFound, got %v", err)
+	}
+	listed, err := s.List(ctx, "db")
+	if err != nil || len(listed) != 4 {
+		t.Fatalf("List failed: %v", err)
+	}
+	for i, share := range listed {
+		if share.Index != uint8(i+1) {
+			t.Errorf("Expected index %d at position %d, got %d", i+1, i, share.Index)
+		}
+	}
+	secret, err := goshamir.Combine(listed[:3], 3)
+	if err != nil || string(secret) != "keychain" {
+		t.Fatalf("Combine failed: %q, %v", secret, err)
+	}
+
+	if err := s.Delete(ctx, "db"); err != nil {
+		t.Fatalf("Delete failed: %v", err)
+	}
+	if len(k.items) != 0 {
+		t.Errorf("Expected no items after Delete, got %d", len(k.items))
+	}
+	if _, err := s.List(ctx, "db"); !errors.Is(err, ErrNotFound) {
+		t.Errorf("Expected ErrNotFound after Delete, got %v", err)
+	}
+	if err := s.Delete(ctx, "db"); err != nil {
+		t.Errorf("Deleting a missing set failed: %v", err)
+	}
+}
+
+func TestKeyringStore_Errors(t *testing.T) {
+	ctx := context.Background()
+	k := newMemoryKeyring()
+	s, _ := NewKeyringStore(WithKeyring(k))
+	shares, _ := goshamir.Split([]byte("secret"), 2, 2)
+
+	if _, err := NewKeyringStore(WithKeyring(k), WithKeyringService("")); err == nil {
+		t.Error("Expected error for an empty service")
+	}
+	for _, id := range []string{"", "a/b"} {
+		if err := s.Put(ctx, id, shares[0]); err == nil {
+			t.Errorf("Expected error for set ID %q", id)
+		}
+	}
+
+	s.Put(ctx, "set", shares[0])
+	k.items[DefaultKeyringService+":set/1"] = []byte("not base64!")
+	if _, err := s.Get(ctx, "set", 1); err == nil {
+		t.Error("Expected error for a corrupt item")
+	}
+	k.items[DefaultKeyringService+":set/index"] = []byte("1,x")
+	if _, err := s.List(ctx, "set"); err == nil || !strings.Contains(err.Error(), "corrupt keyring index") {
+		t.Errorf("Expected corrupt index error, got %v", err)
+	}
+	if _, err := s.List(ctx, "missing"); !errors.Is(err, ErrNotFound) {
+		t.Errorf("Expected ErrNotFound, got %v", err)
+	}
+}
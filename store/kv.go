@@ -0,0 +1,261 @@
+package store
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	goshamir "github.com/fawwazid/go-shamir"
+)
+
+// KV is the subset of a key-value store with expiring keys that
+// SessionStore needs. It is small enough to adapt Redis (SET NX PX, SCAN,
+// DEL) or etcd (a transaction on a leased key, prefix Get, Delete) in a
+// few lines, without this package depending on either client.
+type KV interface {
+	// SetNX stores value under key with the given time to live, unless the
+	// key already exists. It reports whether the value was stored.
+	SetNX(ctx context.Context, key string, value []byte, ttl time.Duration) (bool, error)
+	// Get returns the value of key, or ok == false if it does not exist or
+	// has expired.
+	Get(ctx context.Context, key string) (value []byte, ok bool, err error)
+	// Keys returns the live keys starting with prefix, in any order.
+	Keys(ctx context.Context, prefix string) ([]string, error)
+	// Delete removes keys. Missing keys are ignored.
+	Delete(ctx context.Context, keys ...string) error
+}
+
+// DefaultKeyPrefix is the prefix SessionStore puts in front of its keys
+// unless WithKeyPrefix is given.
+const DefaultKeyPrefix = "goshamir/"
+
+// SessionStore is a ShareStore for staging the shares submitted during a
+// recovery ceremony. Every share expires a fixed time after it is put, so
+// nothing is persisted beyond the session even if the ceremony is
+// abandoned.
+type SessionStore struct {
+	kv     KV
+	ttl    time.Duration
+	prefix string
+}
+
+// SessionOption configures a SessionStore.
+type SessionOption func(*SessionStore)
+
+// WithKeyPrefix sets the prefix of the store's keys, to namespace them in a
+// shared key-value store.
+func WithKeyPrefix(prefix string) SessionOption {
+	return func(s *SessionStore) {
+		s.prefix = prefix
+	}
+}
+
+// NewSessionStore returns a SessionStore keeping each share in kv for ttl.
+func NewSessionStore(kv KV, ttl time.Duration, opts ...SessionOption) (*SessionStore, error) {
+	if kv == nil {
+		return nil, errors.New("key-value store cannot be nil")
+	}
+	if ttl <= 0 {
+		return nil, errors.New("ttl must be positive")
+	}
+	s := &SessionStore{kv: kv, ttl: ttl, prefix: DefaultKeyPrefix}
+	for _, opt := range opts {
+		if opt != nil {
+			opt(s)
+		}
+	}
+	return s, nil
+}
+
+// Put stages a share until the store's TTL elapses.
+func (s *SessionStore) Put(ctx context.Context, setID string, share goshamir.Share) error {
+	if err := validateSessionKey(setID, share.Index); err != nil {
+		return err
+	}
+	envelope, err := share.MarshalBinary()
+	if err != nil {
+		return err
+	}
+	stored, err := s.kv.SetNX(ctx, s.key(setID, share.Index), envelope, s.ttl)
+	if err != nil {
+		return err
+	}
+	if !stored {
+		return ErrExists
+	}
+	return nil
+}
+
+// Get returns a staged share.
+func (s *SessionStore) Get(ctx context.Context, setID string, index uint8) (goshamir.Share, error) {
+	if err := validateSessionKey(setID, index); err != nil {
+		return goshamir.Share{}, err
+	}
+	envelope, ok, err := s.kv.Get(ctx, s.key(setID, index))
+	if err != nil {
+		return goshamir.Share{}, err
+	}
+	if !ok {
+		return goshamir.Share{}, ErrNotFound
+	}
+	return decodeStagedShare(setID, index, envelope)
+}
+
+// List returns the staged shares of the set that have not yet expired,
+// ordered by index.
+func (s *SessionStore) List(ctx context.Context, setID string) ([]goshamir.Share, error) {
+	if err := validateSessionKey(setID, 1); err != nil {
+		return nil, err
+	}
+	keys, err := s.kv.Keys(ctx, s.setPrefix(setID))
+	if err != nil {
+		return nil, err
+	}
+
+	var shares []goshamir.Share
+	for _, key := range keys {
+		index, err := strconv.ParseUint(strings.TrimPrefix(key, s.setPrefix(setID)), 10, 8)
+		if err != nil || index == 0 {
+			return nil, fmt.Errorf("unexpected key %q in share set", key)
+		}
+		envelope, ok, err := s.kv.Get(ctx, key)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			continue // expired since Keys
+		}
+		share, err := decodeStagedShare(setID, uint8(index), envelope)
+		if err != nil {
+			return nil, err
+		}
+		shares = append(shares, share)
+	}
+	if len(shares) == 0 {
+		return nil, ErrNotFound
+	}
+	slices.SortFunc(shares, func(a, b goshamir.Share) int { return int(a.Index) - int(b.Index) })
+	return shares, nil
+}
+
+// Delete removes every staged share of the set, typically once the
+// ceremony has completed.
+func (s *SessionStore) Delete(ctx context.Context, setID string) error {
+	if err := validateSessionKey(setID, 1); err != nil {
+		return err
+	}
+	keys, err := s.kv.Keys(ctx, s.setPrefix(setID))
+	if err != nil || len(keys) == 0 {
+		return err
+	}
+	return s.kv.Delete(ctx, keys...)
+}
+
+func (s *SessionStore) setPrefix(setID string) string {
+	return s.prefix + setID + "/"
+}
+
+func (s *SessionStore) key(setID string, index uint8) string {
+	return s.setPrefix(setID) + strconv.Itoa(int(index))
+}
+
+// validateSessionKey is validateKey for key-value stores, where a "/" in the
+// set ID would let one set's prefix match another's keys.
+func validateSessionKey(setID string, index uint8) error {
+	if strings.Contains(setID, "/") {
+		return fmt.Errorf("set ID %q cannot contain '/'", setID)
+	}
+	return validateKey(setID, index)
+}
+
+func decodeStagedShare(setID string, index uint8, envelope []byte) (goshamir.Share, error) {
+	var share goshamir.Share
+	if err := share.UnmarshalBinary(envelope); err != nil {
+		return goshamir.Share{}, fmt.Errorf("share %s/%d: %w", setID, index, err)
+	}
+	if share.Index != index {
+		return goshamir.Share{}, fmt.Errorf("share %s/%d holds index %d", setID, index, share.Index)
+	}
+	return share, nil
+}
+
+// MemoryKV is an in-process KV, for tests and single-process deployments.
+// Expired keys are dropped lazily when accessed.
+type MemoryKV struct {
+	mu      sync.Mutex
+	entries map[string]memoryEntry
+	// now is time.Now, replaceable in tests.
+	now func() time.Time
+}
+
+type memoryEntry struct {
+	value   []byte
+	expires time.Time
+}
+
+// NewMemoryKV returns an empty MemoryKV.
+func NewMemoryKV() *MemoryKV {
+	return &MemoryKV{entries: make(map[string]memoryEntry), now: time.Now}
+}
+
+// SetNX implements KV.
+func (m *MemoryKV) SetNX(_ context.Context, key string, value []byte, ttl time.Duration) (bool, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if _, ok := m.live(key); ok {
+		return false, nil
+	}
+	m.entries[key] = memoryEntry{value: slices.Clone(value), expires: m.now().Add(ttl)}
+	return true, nil
+}
+
+// Get implements KV.
+func (m *MemoryKV) Get(_ context.Context, key string) ([]byte, bool, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	e, ok := m.live(key)
+	return slices.Clone(e.value), ok, nil
+}
+
+// Keys implements KV.
+func (m *MemoryKV) Keys(_ context.Context, prefix string) ([]string, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	var keys []string
+	for key := range m.entries {
+		if _, ok := m.live(key); ok && strings.HasPrefix(key, prefix) {
+			keys = append(keys, key)
+		}
+	}
+	return keys, nil
+}
+
+// Delete implements KV, wiping the removed values.
+func (m *MemoryKV) Delete(_ context.Context, keys ...string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for _, key := range keys {
+		if e, ok := m.entries[key]; ok {
+			clear(e.value)
+			delete(m.entries, key)
+		}
+	}
+	return nil
+}
+
+// live returns the entry for key, removing it if it has expired. m.mu must
+// be held.
+func (m *MemoryKV) live(key string) (memoryEntry, bool) {
+	e, ok := m.entries[key]
+	if ok && !m.now().Before(e.expires) {
+		clear(e.value)
+		delete(m.entries, key)
+		return memoryEntry{}, false
+	}
+	return e, ok
+}
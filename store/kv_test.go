@@ -0,0 +1,117 @@
+package store
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	goshamir "github.com/fawwazid/go-shamir"
+)
+
+// --- SessionStore Tests ---
+
+func TestSessionStore_RoundTrip(t *testing.T) {
+	ctx := context.Background()
+	s, err := NewSessionStore(NewMemoryKV(), time.Minute)
+	if err != nil {
+		t.Fatalf("NewSessionStore failed: %v", err)
+	}
+	shares, _ := goshamir.Split([]byte("ceremony"), 5, 3)
+
+	for _, i := range []int{4, 0, 2} {
+		if err := s.Put(ctx, "ceremony-1", shares[i]); err != nil {
+			t.Fatalf("Put failed: %v", err)
+		}
+	}
+	if err := s.Put(ctx, "ceremony-1", shares[0]); !errors.Is(err, ErrExists) {
+		t.Errorf("Expected ErrExists, got %v", err)
+	}
+
+	staged, err := s.List(ctx, "ceremony-1")
+	if err != nil {
+		t.Fatalf("List failed: %v", err)
+	}
+	if len(staged) != 3 || staged[0].Index != 1 || staged[2].Index != 5 {
+		t.Fatalf("Unexpected listing %+v", staged)
+	}
+	recovered, err := goshamir.Combine(staged, 3)
+	if err != nil {
+		t.Fatalf("Combine failed: %v", err)
+	}
+	if string(recovered) != "ceremony" {
+		t.Errorf("Expected %q, got %q", "ceremony", recovered)
+	}
+
+	if _, err := s.Get(ctx, "ceremony-1", 2); !errors.Is(err, ErrNotFound) {
+		t.Errorf("Expected ErrNotFound, got %v", err)
+	}
+	if err := s.Delete(ctx, "ceremony-1"); err != nil {
+		t.Fatalf("Delete failed: %v", err)
+	}
+	if _, err := s.Get(ctx, "ceremony-1", 1); !errors.Is(err, ErrNotFound) {
+		t.Errorf("Expected ErrNotFound after Delete, got %v", err)
+	}
+}
+
+func TestSessionStore_Expiry(t *testing.T) {
+	ctx := context.Background()
+	kv := NewMemoryKV()
+	now := time.Unix(1_700_000_000, 0)
+	kv.now = func() time.Time { return now }
+	s, _ := NewSessionStore(kv, time.Minute, WithKeyPrefix("recovery:"))
+	shares, _ := goshamir.Split([]byte("short lived"), 3, 2)
+
+	s.Put(ctx, "set", shares[0])
+	now = now.Add(30 * time.Second)
+	s.Put(ctx, "set", shares[1])
+	if keys, _ := kv.Keys(ctx, "recovery:set/"); len(keys) != 2 {
+		t.Errorf("Expected 2 prefixed keys, got %v", keys)
+	}
+
+	now = now.Add(45 * time.Second)
+	staged, err := s.List(ctx, "set")
+	if err != nil {
+		t.Fatalf("List failed: %v", err)
+	}
+	if len(staged) != 1 || staged[0].Index != 2 {
+		t.Errorf("Expected only share 2 to remain, got %+v", staged)
+	}
+	// An expired share may be submitted again.
+	if err := s.Put(ctx, "set", shares[0]); err != nil {
+		t.Errorf("Put after expiry failed: %v", err)
+	}
+
+	now = now.Add(time.Hour)
+	if _, err := s.List(ctx, "set"); !errors.Is(err, ErrNotFound) {
+		t.Errorf("Expected ErrNotFound once every share expired, got %v", err)
+	}
+	if len(kv.entries) != 0 {
+		t.Errorf("Expected expired entries to be dropped, %d remain", len(kv.entries))
+	}
+}
+
+func TestSessionStore_InvalidInput(t *testing.T) {
+	ctx := context.Background()
+	if _, err := NewSessionStore(nil, time.Minute); err == nil {
+		t.Error("Expected error for nil KV")
+	}
+	if _, err := NewSessionStore(NewMemoryKV(), 0); err == nil {
+		t.Error("Expected error for zero TTL")
+	}
+
+	kv := NewMemoryKV()
+	s, _ := NewSessionStore(kv, time.Minute)
+	shares, _ := goshamir.Split([]byte("x"), 3, 2)
+	if err := s.Put(ctx, "a/b", shares[0]); err == nil {
+		t.Error("Expected error for set ID containing '/'")
+	}
+	if err := s.Put(ctx, "", shares[0]); err == nil {
+		t.Error("Expected error for empty set ID")
+	}
+
+	kv.SetNX(ctx, DefaultKeyPrefix+"set/2", []byte("garbage"), time.Minute)
+	if _, err := s.Get(ctx, "set", 2); !errors.Is(err, goshamir.ErrInvalidEnvelope) {
+		t.Errorf("Expected ErrInvalidEnvelope, got %v", err)
+	}
+}
@@ -0,0 +1,302 @@
+package store
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"os/exec"
+	"path"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+
+	goshamir "github.com/fawwazid/go-shamir"
+)
+
+// DefaultPassPrefix is the folder PassStore keeps its entries in unless
+// WithPassPrefix is given.
+const DefaultPassPrefix = "goshamir"
+
+// PassStore is a ShareStore backed by pass(1), the standard Unix password
+// manager, or a compatible tool such as gopass. Each share is a separate
+// GPG-encrypted entry, <prefix>/<set ID>/<index>/share, holding the
+// base64 share envelope, so each share can be encrypted to a different
+// custodian's key with WithRecipients and kept in the team's existing
+// password-store repository.
+//
+// Entries are written and read through the pass command, so GPG, the agent
+// and git integration behave as they do for any other entry; the store's
+// directory is only read to list entries.
+type PassStore struct {
+	command    string
+	dir        string
+	prefix     string
+	recipients func(setID string, index uint8) []string
+	// run executes the pass command, replaceable in tests.
+	run func(ctx context.Context, stdin []byte, args ...string) ([]byte, error)
+}
+
+// PassOption configures a PassStore.
+type PassOption func(*PassStore)
+
+// WithPassCommand sets the pass-compatible command to run, such as
+// "gopass". Defaults to "pass".
+func WithPassCommand(name string) PassOption {
+	return func(s *PassStore) {
+		s.command = name
+	}
+}
+
+// WithPassDir sets the password-store directory. It defaults to
+// $PASSWORD_STORE_DIR, or ~/.password-store, and is passed to the command
+// as PASSWORD_STORE_DIR.
+func WithPassDir(dir string) PassOption {
+	return func(s *PassStore) {
+		s.dir = dir
+	}
+}
+
+// WithPassPrefix sets the folder holding the store's entries.
+func WithPassPrefix(prefix string) PassOption {
+	return func(s *PassStore) {
+		s.prefix = strings.Trim(prefix, "/")
+	}
+}
+
+// WithRecipients encrypts each share to the GPG key IDs returned by fn,
+// typically the key of the custodian holding it, by running
+// "pass init --path" on the share's folder before inserting it. Without
+// it, entries use the recipients of the enclosing folder.
+func WithRecipients(fn func(setID string, index uint8) []string) PassOption {
+	return func(s *PassStore) {
+		s.recipients = fn
+	}
+}
+
+// NewPassStore returns a PassStore.
+func NewPassStore(opts ...PassOption) (*PassStore, error) {
+	s := &PassStore{command: "pass", prefix: DefaultPassPrefix, dir: os.Getenv("PASSWORD_STORE_DIR")}
+	s.run = s.exec
+	for _, opt := range opts {
+		if opt != nil {
+			opt(s)
+		}
+	}
+	if s.dir == "" {
+		home, err := os.UserHomeDir()
+		if err != nil {
+			return nil, fmt.Errorf("locating password store: %w", err)
+		}
+		s.dir = filepath.Join(home, ".password-store")
+	}
+	if s.prefix == "" || slices.Contains(strings.Split(s.prefix, "/"), "..") {
+		return nil, fmt.Errorf("invalid pass prefix %q", s.prefix)
+	}
+	return s, nil
+}
+
+// Put encrypts and inserts a share as a new entry.
+func (s *PassStore) Put(ctx context.Context, setID string, share goshamir.Share) error {
+	if err := validatePassKey(setID, share.Index); err != nil {
+		return err
+	}
+	if _, err := os.Stat(s.entryFile(setID, share.Index)); err == nil {
+		return ErrExists
+	} else if !errors.Is(err, fs.ErrNotExist) {
+		return err
+	}
+	envelope, err := share.MarshalBinary()
+	if err != nil {
+		return err
+	}
+	defer clear(envelope)
+
+	if s.recipients != nil {
+		ids := s.recipients(setID, share.Index)
+		if len(ids) == 0 {
+			return fmt.Errorf("no recipients for share %s/%d", setID, share.Index)
+		}
+		args := append([]string{"init", "--path=" + s.shareDir(setID, share.Index)}, ids...)
+		if _, err := s.run(ctx, nil, args...); err != nil {
+			return err
+		}
+	}
+	content := []byte(base64.StdEncoding.EncodeToString(envelope) + "\n")
+	defer clear(content)
+	_, err = s.run(ctx, content, "insert", "--multiline", "--force", s.entry(setID, share.Index))
+	return err
+}
+
+// Get decrypts the share of the set with the given index.
+func (s *PassStore) Get(ctx context.Context, setID string, index uint8) (goshamir.Share, error) {
+	if err := validatePassKey(setID, index); err != nil {
+		return goshamir.Share{}, err
+	}
+	if _, err := os.Stat(s.entryFile(setID, index)); errors.Is(err, fs.ErrNotExist) {
+		return goshamir.Share{}, ErrNotFound
+	}
+	out, err := s.run(ctx, nil, "show", s.entry(setID, index))
+	if err != nil {
+		return goshamir.Share{}, err
+	}
+	defer clear(out)
+	first, _, _ := bytes.Cut(out, []byte("\n"))
+	envelope := make([]byte, base64.StdEncoding.DecodedLen(len(first)))
+	defer clear(envelope)
+	n, err := base64.StdEncoding.Decode(envelope, bytes.TrimSpace(first))
+	if err != nil {
+		return goshamir.Share{}, fmt.Errorf("share %s/%d: invalid entry", setID, index)
+	}
+	var share goshamir.Share
+	if err := share.UnmarshalBinary(envelope[:n]); err != nil {
+		return goshamir.Share{}, fmt.Errorf("share %s/%d: %w", setID, index, err)
+	}
+	if share.Index != index {
+		return goshamir.Share{}, fmt.Errorf("share %s/%d holds index %d", setID, index, share.Index)
+	}
+	return share, nil
+}
+
+// List decrypts every share of the set, ordered by index. Each entry is a
+// separate decryption, which may prompt for a passphrase or a hardware
+// token; use Combine to decrypt only as many as needed.
+func (s *PassStore) List(ctx context.Context, setID string) ([]goshamir.Share, error) {
+	indices, err := s.indices(setID)
+	if err != nil {
+		return nil, err
+	}
+	shares := make([]goshamir.Share, 0, len(indices))
+	for _, index := range indices {
+		share, err := s.Get(ctx, setID, index)
+		if err != nil {
+			return nil, err
+		}
+		shares = append(shares, share)
+	}
+	return shares, nil
+}
+
+// Combine decrypts shares of the set, in index order, until threshold of
+// them have been decrypted, and reconstructs the secret. Entries that fail
+// to decrypt, for example because they are encrypted to another
+// custodian's key, are skipped.
+func (s *PassStore) Combine(ctx context.Context, setID string, threshold int) ([]byte, error) {
+	indices, err := s.indices(setID)
+	if err != nil {
+		return nil, err
+	}
+	var shares []goshamir.Share
+	defer func() {
+		for _, share := range shares {
+			clear(share.Value)
+		}
+	}()
+	var errs []error
+	for _, index := range indices {
+		if len(shares) == threshold {
+			break
+		}
+		share, err := s.Get(ctx, setID, index)
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil, ctx.Err()
+			}
+			errs = append(errs, err)
+			continue
+		}
+		shares = append(shares, share)
+	}
+	if len(shares) < threshold {
+		return nil, fmt.Errorf("decrypted %d of %d needed shares of %s: %w", len(shares), threshold, setID, errors.Join(errs...))
+	}
+	return goshamir.Combine(shares, threshold)
+}
+
+// Delete removes every entry of the set.
+func (s *PassStore) Delete(ctx context.Context, setID string) error {
+	if err := validatePassKey(setID, 1); err != nil {
+		return err
+	}
+	if _, err := os.Stat(filepath.Join(s.dir, filepath.FromSlash(s.setDir(setID)))); errors.Is(err, fs.ErrNotExist) {
+		return nil
+	}
+	_, err := s.run(ctx, nil, "rm", "--recursive", "--force", s.setDir(setID))
+	return err
+}
+
+// indices returns the share indices of the set's entries, in order.
+func (s *PassStore) indices(setID string) ([]uint8, error) {
+	if err := validatePassKey(setID, 1); err != nil {
+		return nil, err
+	}
+	entries, err := os.ReadDir(filepath.Join(s.dir, filepath.FromSlash(s.setDir(setID))))
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, ErrNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	var indices []uint8
+	for _, e := range entries {
+		index, err := strconv.ParseUint(e.Name(), 10, 8)
+		if err != nil || index == 0 || !e.IsDir() {
+			continue
+		}
+		if _, err := os.Stat(s.entryFile(setID, uint8(index))); err == nil {
+			indices = append(indices, uint8(index))
+		}
+	}
+	if len(indices) == 0 {
+		return nil, ErrNotFound
+	}
+	slices.Sort(indices)
+	return indices, nil
+}
+
+func (s *PassStore) setDir(setID string) string {
+	return path.Join(s.prefix, setID)
+}
+
+func (s *PassStore) shareDir(setID string, index uint8) string {
+	return path.Join(s.prefix, setID, strconv.Itoa(int(index)))
+}
+
+func (s *PassStore) entry(setID string, index uint8) string {
+	return path.Join(s.shareDir(setID, index), "share")
+}
+
+func (s *PassStore) entryFile(setID string, index uint8) string {
+	return filepath.Join(s.dir, filepath.FromSlash(s.entry(setID, index))+".gpg")
+}
+
+func (s *PassStore) exec(ctx context.Context, stdin []byte, args ...string) ([]byte, error) {
+	cmd := exec.CommandContext(ctx, s.command, args...)
+	cmd.Env = append(os.Environ(), "PASSWORD_STORE_DIR="+s.dir)
+	cmd.Stdin = bytes.NewReader(stdin)
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+	out, err := cmd.Output()
+	if err != nil {
+		msg := strings.TrimSpace(stderr.String())
+		if msg == "" {
+			return nil, fmt.Errorf("%s %s failed: %w", s.command, args[0], err)
+		}
+		return nil, fmt.Errorf("%s %s failed: %s", s.command, args[0], msg)
+	}
+	return out, nil
+}
+
+func validatePassKey(setID string, index uint8) error {
+	if err := validateKey(setID, index); err != nil {
+		return err
+	}
+	if strings.ContainsAny(setID, `/\`) || strings.HasPrefix(setID, ".") {
+		return fmt.Errorf("invalid set ID %q for a password store", setID)
+	}
+	return nil
+}
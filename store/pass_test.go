@@ -0,0 +1,167 @@
+package store
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+
+	goshamir "github.com/fawwazid/go-shamir"
+)
+
+// fakePass emulates the pass commands PassStore runs on a store directory.
+// Entries are stored in the clear, and entries whose folder's .gpg-id
+// names a key in locked cannot be shown.
+type fakePass struct {
+	t      *testing.T
+	dir    string
+	locked map[string]bool
+	calls  []string
+}
+
+func (p *fakePass) run(_ context.Context, stdin []byte, args ...string) ([]byte, error) {
+	p.calls = append(p.calls, args[0])
+	switch args[0] {
+	case "init":
+		dir := filepath.Join(p.dir, strings.TrimPrefix(args[1], "--path="))
+		os.MkdirAll(dir, 0o700)
+		return nil, os.WriteFile(filepath.Join(dir, ".gpg-id"), []byte(strings.Join(args[2:], "\n")+"\n"), 0o600)
+	case "insert":
+		file := filepath.Join(p.dir, args[len(args)-1]+".gpg")
+		os.MkdirAll(filepath.Dir(file), 0o700)
+		return nil, os.WriteFile(file, stdin, 0o600)
+	case "show":
+		file := filepath.Join(p.dir, args[1]+".gpg")
+		if ids, err := os.ReadFile(filepath.Join(filepath.Dir(file), ".gpg-id")); err == nil && p.locked[strings.TrimSpace(string(ids))] {
+			return nil, errors.New("gpg: decryption failed: No secret key")
+		}
+		return os.ReadFile(file)
+	case "rm":
+		return nil, os.RemoveAll(filepath.Join(p.dir, args[len(args)-1]))
+	}
+	p.t.Fatalf("Unexpected pass command %v", args)
+	return nil, nil
+}
+
+func newTestPassStore(t *testing.T, opts ...PassOption) (*PassStore, *fakePass) {
+	t.Helper()
+	fake := &fakePass{t: t, dir: t.TempDir(), locked: map[string]bool{}}
+	s, err := NewPassStore(append([]PassOption{WithPassDir(fake.dir)}, opts...)...)
+	if err != nil {
+		t.Fatalf("NewPassStore failed: %v", err)
+	}
+	s.run = fake.run
+	return s, fake
+}
+
+// --- PassStore Tests ---
+
+func TestPassStore_RoundTrip(t *testing.T) {
+	ctx := context.Background()
+	s, fake := newTestPassStore(t, WithPassPrefix("team/shamir/"))
+	shares, _ := goshamir.Split([]byte("password-store"), 4, 3)
+
+	for _, share := range shares {
+		if err := s.Put(ctx, "db", share); err != nil {
+			t.Fatalf("Put failed: %v", err)
+		}
+	}
+	if err := s.Put(ctx, "db", shares[1]); !errors.Is(err, ErrExists) {
+		t.Errorf("Expected ErrExists, got %v", err)
+	}
+	if _, err := os.Stat(filepath.Join(fake.dir, "team/shamir/db/2/share.gpg")); err != nil {
+		t.Errorf("Expected entry at team/shamir/db/2/share: %v", err)
+	}
+	data, _ := os.ReadFile(filepath.Join(fake.dir, "team/shamir/db/2/share.gpg"))
+	if bytes.Contains(data, shares[1].Value) {
+		t.Error("Entry holds the raw share value instead of its envelope")
+	}
+
+	got, err := s.Get(ctx, "db", 3)
+	if err != nil || !bytes.Equal(got.Value, shares[2].Value) {
+		t.Fatalf("Get failed: %v", err)
+	}
+	if _, err := s.Get(ctx, "db", 9); !errors.Is(err, ErrNotFound) {
+		t.Errorf("Expected ErrNotFound, got %v", err)
+	}
+	listed, err := s.List(ctx, "db")
+	if err != nil || len(listed) != 4 || listed[3].Index != 4 {
+		t.Fatalf("List failed: %v", err)
+	}
+
+	fake.calls = nil
+	secret, err := s.Combine(ctx, "db", 3)
+	if err != nil || string(secret) != "password-store" {
+		t.Fatalf("Combine failed: %q, %v", secret, err)
+	}
+	if !slices.Equal(fake.calls, []string{"show", "show", "show"}) {
+		t.Errorf("Expected exactly three decryptions, got %v", fake.calls)
+	}
+
+	if err := s.Delete(ctx, "db"); err != nil {
+		t.Fatalf("Delete failed: %v", err)
+	}
+	if _, err := s.List(ctx, "db"); !errors.Is(err, ErrNotFound) {
+		t.Errorf("Expected ErrNotFound after Delete, got %v", err)
+	}
+	if err := s.Delete(ctx, "db"); err != nil {
+		t.Errorf("Deleting a missing set failed: %v", err)
+	}
+}
+
+func TestPassStore_Recipients(t *testing.T) {
+	ctx := context.Background()
+	keys := map[uint8]string{1: "alice@example.com", 2: "bob@example.com", 3: "carol@example.com"}
+	s, fake := newTestPassStore(t, WithRecipients(func(_ string, index uint8) []string {
+		return []string{keys[index]}
+	}))
+	shares, _ := goshamir.Split([]byte("per custodian"), 3, 2)
+	for _, share := range shares {
+		if err := s.Put(ctx, "vault", share); err != nil {
+			t.Fatalf("Put failed: %v", err)
+		}
+	}
+	ids, _ := os.ReadFile(filepath.Join(fake.dir, "goshamir/vault/2/.gpg-id"))
+	if strings.TrimSpace(string(ids)) != "bob@example.com" {
+		t.Errorf("Expected share 2 encrypted to bob, got %q", ids)
+	}
+
+	// Without Alice's key, Combine skips her share and uses the others.
+	fake.locked["alice@example.com"] = true
+	secret, err := s.Combine(ctx, "vault", 2)
+	if err != nil || string(secret) != "per custodian" {
+		t.Fatalf("Combine failed: %q, %v", secret, err)
+	}
+	fake.locked["bob@example.com"] = true
+	if _, err := s.Combine(ctx, "vault", 2); err == nil || !strings.Contains(err.Error(), "decrypted 1 of 2") {
+		t.Errorf("Expected insufficient shares error, got %v", err)
+	}
+}
+
+func TestPassStore_Errors(t *testing.T) {
+	ctx := context.Background()
+	s, fake := newTestPassStore(t)
+	shares, _ := goshamir.Split([]byte("secret"), 2, 2)
+
+	for _, id := range []string{"", "a/b", "..", ".hidden"} {
+		if err := s.Put(ctx, id, shares[0]); err == nil {
+			t.Errorf("Expected error for set ID %q", id)
+		}
+	}
+	if _, err := NewPassStore(WithPassDir(fake.dir), WithPassPrefix("../escape")); err == nil {
+		t.Error("Expected error for a prefix leaving the store")
+	}
+
+	s.Put(ctx, "set", shares[0])
+	os.WriteFile(filepath.Join(fake.dir, "goshamir/set/1/share.gpg"), []byte("not base64!\n"), 0o600)
+	if _, err := s.Get(ctx, "set", 1); err == nil {
+		t.Error("Expected error for a corrupt entry")
+	}
+	if _, err := s.List(ctx, "missing"); !errors.Is(err, ErrNotFound) {
+		t.Errorf("Expected ErrNotFound, got %v", err)
+	}
+}
@@ -0,0 +1,360 @@
+package store
+
+import (
+	"bytes"
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"database/sql"
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+
+	goshamir "github.com/fawwazid/go-shamir"
+)
+
+// Dialect selects the SQL flavour generated by SQLStore.
+type Dialect int
+
+const (
+	// SQLite uses "?" placeholders and BLOB columns. It also suits MySQL
+	// and MariaDB.
+	SQLite Dialect = iota
+	// Postgres uses "$n" placeholders and BYTEA columns.
+	Postgres
+)
+
+// DefaultTable is the table SQLStore uses unless WithTable is given.
+const DefaultTable = "goshamir_shares"
+
+// encryptedRowMagic starts a row encrypted with WithEncryptionKey. Plain
+// share envelopes start with "SH", so the two cannot be confused.
+var encryptedRowMagic = []byte{'E', 'H', 1}
+
+var tableNamePattern = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]{0,62}$`)
+
+// SQLStore is a ShareStore backed by database/sql. Each share is a row
+// keyed by set ID and index, holding the share envelope and a version
+// number used for optimistic locking.
+//
+// Call Migrate before first use, or apply Migrations with your own
+// migration tool.
+type SQLStore struct {
+	db      *sql.DB
+	table   string
+	dialect Dialect
+	aead    cipher.AEAD
+}
+
+// SQLOption configures an SQLStore.
+type SQLOption func(*SQLStore) error
+
+// WithDialect sets the SQL dialect. Defaults to SQLite.
+func WithDialect(d Dialect) SQLOption {
+	return func(s *SQLStore) error {
+		if d != SQLite && d != Postgres {
+			return fmt.Errorf("unknown SQL dialect %d", d)
+		}
+		s.dialect = d
+		return nil
+	}
+}
+
+// WithTable sets the name of the share table. Migrate also creates a
+// table of the same name with a "_migrations" suffix.
+func WithTable(name string) SQLOption {
+	return func(s *SQLStore) error {
+		if !tableNamePattern.MatchString(name) {
+			return fmt.Errorf("invalid table name %q", name)
+		}
+		s.table = name
+		return nil
+	}
+}
+
+// WithEncryptionKey encrypts every row with AES-GCM under key, which must
+// be 16, 24 or 32 bytes long. The set ID, index and table name are bound
+// to the ciphertext, so rows cannot be swapped between sets. Rows written
+// without a key cannot be read by a store that has one, and vice versa.
+func WithEncryptionKey(key []byte) SQLOption {
+	return func(s *SQLStore) error {
+		block, err := aes.NewCipher(key)
+		if err != nil {
+			return fmt.Errorf("invalid encryption key: %w", err)
+		}
+		aead, err := cipher.NewGCMWithRandomNonce(block)
+		if err != nil {
+			return err
+		}
+		s.aead = aead
+		return nil
+	}
+}
+
+// NewSQLStore returns an SQLStore using db.
+func NewSQLStore(db *sql.DB, opts ...SQLOption) (*SQLStore, error) {
+	if db == nil {
+		return nil, errors.New("database cannot be nil")
+	}
+	s := &SQLStore{db: db, table: DefaultTable}
+	for _, opt := range opts {
+		if err := opt(s); err != nil {
+			return nil, err
+		}
+	}
+	return s, nil
+}
+
+// Migrations returns the schema migrations for the store's table and
+// dialect, in order. Migrate applies them automatically; they are exposed
+// for applications that manage their schema with external tooling.
+func (s *SQLStore) Migrations() []string {
+	blob := "BLOB"
+	if s.dialect == Postgres {
+		blob = "BYTEA"
+	}
+	return []string{
+		"CREATE TABLE " + s.table + " (" +
+			"set_id VARCHAR(255) NOT NULL, " +
+			"share_index INTEGER NOT NULL, " +
+			"envelope " + blob + " NOT NULL, " +
+			"version BIGINT NOT NULL, " +
+			"PRIMARY KEY (set_id, share_index))",
+	}
+}
+
+// Migrate brings the schema up to date, recording applied migrations in a
+// companion table. Each migration runs in its own transaction, so it is
+// safe to call Migrate on every start-up.
+func (s *SQLStore) Migrate(ctx context.Context) error {
+	migrations := s.table + "_migrations"
+	if _, err := s.db.ExecContext(ctx,
+		"CREATE TABLE IF NOT EXISTS "+migrations+" (version INTEGER PRIMARY KEY)"); err != nil {
+		return fmt.Errorf("creating migrations table: %w", err)
+	}
+
+	for i, stmt := range s.Migrations() {
+		version := i + 1
+		err := s.inTx(ctx, func(tx *sql.Tx) error {
+			var applied int
+			err := tx.QueryRowContext(ctx,
+				"SELECT COUNT(*) FROM "+migrations+" WHERE version = "+s.ph(1), version).Scan(&applied)
+			if err != nil || applied > 0 {
+				return err
+			}
+			if _, err := tx.ExecContext(ctx, stmt); err != nil {
+				return err
+			}
+			_, err = tx.ExecContext(ctx, "INSERT INTO "+migrations+" (version) VALUES ("+s.ph(1)+")", version)
+			return err
+		})
+		if err != nil {
+			return fmt.Errorf("applying migration %d: %w", version, err)
+		}
+	}
+	return nil
+}
+
+// Put stores a new share with version 1.
+func (s *SQLStore) Put(ctx context.Context, setID string, share goshamir.Share) error {
+	if err := validateKey(setID, share.Index); err != nil {
+		return err
+	}
+	blob, err := s.seal(setID, share)
+	if err != nil {
+		return err
+	}
+	return s.inTx(ctx, func(tx *sql.Tx) error {
+		var version int64
+		err := tx.QueryRowContext(ctx,
+			"SELECT version FROM "+s.table+" WHERE set_id = "+s.ph(1)+" AND share_index = "+s.ph(2),
+			setID, int64(share.Index)).Scan(&version)
+		switch {
+		case err == nil:
+			return ErrExists
+		case !errors.Is(err, sql.ErrNoRows):
+			return err
+		}
+		_, err = tx.ExecContext(ctx,
+			"INSERT INTO "+s.table+" (set_id, share_index, envelope, version) VALUES ("+
+				s.ph(1)+", "+s.ph(2)+", "+s.ph(3)+", 1)",
+			setID, int64(share.Index), blob)
+		return err
+	})
+}
+
+// Get returns the share of the set with the given index.
+func (s *SQLStore) Get(ctx context.Context, setID string, index uint8) (goshamir.Share, error) {
+	share, _, err := s.GetVersion(ctx, setID, index)
+	return share, err
+}
+
+// GetVersion returns a share together with its current version, for use
+// with Update.
+func (s *SQLStore) GetVersion(ctx context.Context, setID string, index uint8) (goshamir.Share, int64, error) {
+	if err := validateKey(setID, index); err != nil {
+		return goshamir.Share{}, 0, err
+	}
+	var blob []byte
+	var version int64
+	err := s.db.QueryRowContext(ctx,
+		"SELECT envelope, version FROM "+s.table+" WHERE set_id = "+s.ph(1)+" AND share_index = "+s.ph(2),
+		setID, int64(index)).Scan(&blob, &version)
+	if errors.Is(err, sql.ErrNoRows) {
+		return goshamir.Share{}, 0, ErrNotFound
+	}
+	if err != nil {
+		return goshamir.Share{}, 0, err
+	}
+	share, err := s.open(setID, index, blob)
+	if err != nil {
+		return goshamir.Share{}, 0, err
+	}
+	return share, version, nil
+}
+
+// Update replaces a share, provided its stored version still equals
+// version, and returns the new version. It fails with ErrVersionConflict
+// if the share was modified since it was read, and with ErrNotFound if it
+// does not exist.
+func (s *SQLStore) Update(ctx context.Context, setID string, share goshamir.Share, version int64) (int64, error) {
+	if err := validateKey(setID, share.Index); err != nil {
+		return 0, err
+	}
+	blob, err := s.seal(setID, share)
+	if err != nil {
+		return 0, err
+	}
+	res, err := s.db.ExecContext(ctx,
+		"UPDATE "+s.table+" SET envelope = "+s.ph(1)+", version = version + 1"+
+			" WHERE set_id = "+s.ph(2)+" AND share_index = "+s.ph(3)+" AND version = "+s.ph(4),
+		blob, setID, int64(share.Index), version)
+	if err != nil {
+		return 0, err
+	}
+	n, err := res.RowsAffected()
+	if err != nil {
+		return 0, err
+	}
+	if n == 0 {
+		if _, _, err := s.GetVersion(ctx, setID, share.Index); err != nil {
+			return 0, err
+		}
+		return 0, ErrVersionConflict
+	}
+	return version + 1, nil
+}
+
+// List returns every share of the set, ordered by index. It returns
+// ErrNotFound if the set holds no shares.
+func (s *SQLStore) List(ctx context.Context, setID string) ([]goshamir.Share, error) {
+	if setID == "" {
+		return nil, errors.New("set ID cannot be empty")
+	}
+	rows, err := s.db.QueryContext(ctx,
+		"SELECT share_index, envelope FROM "+s.table+" WHERE set_id = "+s.ph(1)+" ORDER BY share_index",
+		setID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var shares []goshamir.Share
+	for rows.Next() {
+		var index int64
+		var blob []byte
+		if err := rows.Scan(&index, &blob); err != nil {
+			return nil, err
+		}
+		if index < 1 || index > 255 {
+			return nil, fmt.Errorf("stored share index %d out of range", index)
+		}
+		share, err := s.open(setID, uint8(index), blob)
+		if err != nil {
+			return nil, err
+		}
+		shares = append(shares, share)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	if len(shares) == 0 {
+		return nil, ErrNotFound
+	}
+	return shares, nil
+}
+
+// Delete removes every share of the set.
+func (s *SQLStore) Delete(ctx context.Context, setID string) error {
+	if setID == "" {
+		return errors.New("set ID cannot be empty")
+	}
+	_, err := s.db.ExecContext(ctx, "DELETE FROM "+s.table+" WHERE set_id = "+s.ph(1), setID)
+	return err
+}
+
+// seal encodes a share for storage, encrypting it if a key is configured.
+func (s *SQLStore) seal(setID string, share goshamir.Share) ([]byte, error) {
+	envelope, err := share.MarshalBinary()
+	if err != nil {
+		return nil, err
+	}
+	if s.aead == nil {
+		return envelope, nil
+	}
+	defer clear(envelope)
+	return s.aead.Seal(bytes.Clone(encryptedRowMagic), nil, envelope, s.rowAD(setID, share.Index)), nil
+}
+
+// open reverses seal and checks that the share belongs to the row it was
+// read from.
+func (s *SQLStore) open(setID string, index uint8, blob []byte) (goshamir.Share, error) {
+	encrypted := bytes.HasPrefix(blob, encryptedRowMagic)
+	switch {
+	case s.aead != nil && !encrypted:
+		return goshamir.Share{}, fmt.Errorf("share %s/%d is not encrypted", setID, index)
+	case s.aead == nil && encrypted:
+		return goshamir.Share{}, fmt.Errorf("share %s/%d is encrypted but no key is configured", setID, index)
+	case encrypted:
+		plain, err := s.aead.Open(nil, nil, blob[len(encryptedRowMagic):], s.rowAD(setID, index))
+		if err != nil {
+			return goshamir.Share{}, fmt.Errorf("decrypting share %s/%d failed", setID, index)
+		}
+		defer clear(plain)
+		blob = plain
+	}
+
+	var share goshamir.Share
+	if err := share.UnmarshalBinary(blob); err != nil {
+		return goshamir.Share{}, fmt.Errorf("share %s/%d: %w", setID, index, err)
+	}
+	if share.Index != index {
+		return goshamir.Share{}, fmt.Errorf("share %s/%d holds index %d", setID, index, share.Index)
+	}
+	return share, nil
+}
+
+func (s *SQLStore) rowAD(setID string, index uint8) []byte {
+	return []byte("goshamir sqlstore\x00" + s.table + "\x00" + setID + "\x00" + strconv.Itoa(int(index)))
+}
+
+// ph returns the n-th (1-based) placeholder for the store's dialect.
+func (s *SQLStore) ph(n int) string {
+	if s.dialect == Postgres {
+		return "$" + strconv.Itoa(n)
+	}
+	return "?"
+}
+
+func (s *SQLStore) inTx(ctx context.Context, fn func(*sql.Tx) error) error {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	if err := fn(tx); err != nil {
+		tx.Rollback()
+		return err
+	}
+	return tx.Commit()
+}
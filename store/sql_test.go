@@ -0,0 +1,203 @@
+package store
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	goshamir "github.com/fawwazid/go-shamir"
+)
+
+// --- SQLStore Tests ---
+
+func newTestSQLStore(t *testing.T, opts ...SQLOption) (*SQLStore, *fakeDB) {
+	t.Helper()
+	db, state := openFakeDB(t.Name())
+	s, err := NewSQLStore(db, opts...)
+	if err != nil {
+		t.Fatalf("NewSQLStore failed: %v", err)
+	}
+	if err := s.Migrate(context.Background()); err != nil {
+		t.Fatalf("Migrate failed: %v", err)
+	}
+	return s, state
+}
+
+func TestSQLStore_RoundTrip(t *testing.T) {
+	ctx := context.Background()
+	s, _ := newTestSQLStore(t)
+	shares, _ := goshamir.Split([]byte("database secret"), 3, 2)
+
+	for _, share := range []goshamir.Share{shares[2], shares[0]} {
+		if err := s.Put(ctx, "set-1", share); err != nil {
+			t.Fatalf("Put failed: %v", err)
+		}
+	}
+	if err := s.Put(ctx, "set-1", shares[0]); !errors.Is(err, ErrExists) {
+		t.Errorf("Expected ErrExists, got %v", err)
+	}
+
+	got, err := s.Get(ctx, "set-1", 3)
+	if err != nil {
+		t.Fatalf("Get failed: %v", err)
+	}
+	if !bytes.Equal(got.Value, shares[2].Value) {
+		t.Error("Get returned a different share")
+	}
+	if _, err := s.Get(ctx, "set-1", 2); !errors.Is(err, ErrNotFound) {
+		t.Errorf("Expected ErrNotFound, got %v", err)
+	}
+
+	listed, err := s.List(ctx, "set-1")
+	if err != nil {
+		t.Fatalf("List failed: %v", err)
+	}
+	if len(listed) != 2 || listed[0].Index != 1 || listed[1].Index != 3 {
+		t.Fatalf("Unexpected listing %+v", listed)
+	}
+	recovered, err := goshamir.Combine(listed, 2)
+	if err != nil {
+		t.Fatalf("Combine failed: %v", err)
+	}
+	if string(recovered) != "database secret" {
+		t.Errorf("Expected %q, got %q", "database secret", recovered)
+	}
+
+	if err := s.Delete(ctx, "set-1"); err != nil {
+		t.Fatalf("Delete failed: %v", err)
+	}
+	if _, err := s.List(ctx, "set-1"); !errors.Is(err, ErrNotFound) {
+		t.Errorf("Expected ErrNotFound after Delete, got %v", err)
+	}
+}
+
+func TestSQLStore_OptimisticLocking(t *testing.T) {
+	ctx := context.Background()
+	s, _ := newTestSQLStore(t)
+	shares, _ := goshamir.Split([]byte("v1"), 3, 2)
+	replacement, _ := goshamir.Split([]byte("v2"), 3, 2)
+
+	if err := s.Put(ctx, "set", shares[0]); err != nil {
+		t.Fatalf("Put failed: %v", err)
+	}
+	_, version, err := s.GetVersion(ctx, "set", 1)
+	if err != nil {
+		t.Fatalf("GetVersion failed: %v", err)
+	}
+	if version != 1 {
+		t.Errorf("Expected version 1, got %d", version)
+	}
+
+	next, err := s.Update(ctx, "set", replacement[0], version)
+	if err != nil {
+		t.Fatalf("Update failed: %v", err)
+	}
+	if next != 2 {
+		t.Errorf("Expected version 2, got %d", next)
+	}
+	if _, err := s.Update(ctx, "set", shares[0], version); !errors.Is(err, ErrVersionConflict) {
+		t.Errorf("Expected ErrVersionConflict for stale version, got %v", err)
+	}
+	if _, err := s.Update(ctx, "set", shares[1], 1); !errors.Is(err, ErrNotFound) {
+		t.Errorf("Expected ErrNotFound for missing share, got %v", err)
+	}
+
+	got, _ := s.Get(ctx, "set", 1)
+	if !bytes.Equal(got.Value, replacement[0].Value) {
+		t.Error("Update did not replace the share")
+	}
+}
+
+func TestSQLStore_Encryption(t *testing.T) {
+	ctx := context.Background()
+	key := bytes.Repeat([]byte{7}, 32)
+	s, state := newTestSQLStore(t, WithEncryptionKey(key))
+	shares, _ := goshamir.Split([]byte("encrypted at rest"), 3, 2)
+
+	if err := s.Put(ctx, "set", shares[0]); err != nil {
+		t.Fatalf("Put failed: %v", err)
+	}
+	row := state.rows[fakeKey{"set", 1}]
+	if bytes.Contains(row.envelope, shares[0].Value) || !bytes.HasPrefix(row.envelope, encryptedRowMagic) {
+		t.Error("Stored row is not encrypted")
+	}
+	got, err := s.Get(ctx, "set", 1)
+	if err != nil {
+		t.Fatalf("Get failed: %v", err)
+	}
+	if !bytes.Equal(got.Value, shares[0].Value) {
+		t.Error("Decrypted share does not match")
+	}
+
+	// Moving the ciphertext to another row must be detected.
+	state.rows[fakeKey{"other", 1}] = row
+	if _, err := s.Get(ctx, "other", 1); err == nil {
+		t.Error("Expected error for row moved to another set")
+	}
+
+	db := s.db
+	plain, _ := NewSQLStore(db)
+	if _, err := plain.Get(ctx, "set", 1); err == nil {
+		t.Error("Expected error reading an encrypted row without a key")
+	}
+	wrongKey, _ := NewSQLStore(db, WithEncryptionKey(bytes.Repeat([]byte{8}, 32)))
+	if _, err := wrongKey.Get(ctx, "set", 1); err == nil {
+		t.Error("Expected error with the wrong key")
+	}
+	if _, err := NewSQLStore(db, WithEncryptionKey([]byte("short"))); err == nil {
+		t.Error("Expected error for invalid key size")
+	}
+}
+
+func TestSQLStore_Migrate(t *testing.T) {
+	ctx := context.Background()
+	s, state := newTestSQLStore(t, WithDialect(Postgres), WithTable("vault_shares"))
+
+	if err := s.Migrate(ctx); err != nil {
+		t.Fatalf("Second Migrate failed: %v", err)
+	}
+	if !state.tables["vault_shares"] || !state.tables["vault_shares_migrations"] {
+		t.Errorf("Expected tables to be created, got %v", state.tables)
+	}
+	if !strings.Contains(s.Migrations()[0], "BYTEA") {
+		t.Error("Expected BYTEA column for Postgres")
+	}
+
+	shares, _ := goshamir.Split([]byte("pg"), 3, 2)
+	if err := s.Put(ctx, "set", shares[0]); err != nil {
+		t.Fatalf("Put failed: %v", err)
+	}
+	last := state.queries[len(state.queries)-1]
+	if !strings.Contains(last, "$3") || strings.Contains(last, "?") {
+		t.Errorf("Expected Postgres placeholders, got %q", last)
+	}
+
+	if _, err := NewSQLStore(s.db, WithTable("shares; DROP TABLE x")); err == nil {
+		t.Error("Expected error for invalid table name")
+	}
+	if _, err := NewSQLStore(nil); err == nil {
+		t.Error("Expected error for nil database")
+	}
+}
+
+func TestSQLStore_DetectsCorruption(t *testing.T) {
+	ctx := context.Background()
+	s, state := newTestSQLStore(t)
+	shares, _ := goshamir.Split([]byte("bit rot"), 3, 2)
+	s.Put(ctx, "set", shares[0])
+	s.Put(ctx, "set", shares[1])
+
+	row := state.rows[fakeKey{"set", 1}]
+	row.envelope[8] ^= 1
+	if _, err := s.Get(ctx, "set", 1); !errors.Is(err, goshamir.ErrEnvelopeChecksum) {
+		t.Errorf("Expected ErrEnvelopeChecksum, got %v", err)
+	}
+
+	// A share stored under the wrong index is rejected.
+	state.rows[fakeKey{"set", 1}] = state.rows[fakeKey{"set", 2}]
+	if _, err := s.List(ctx, "set"); err == nil {
+		t.Error("Expected error for share stored under the wrong index")
+	}
+}
@@ -0,0 +1,51 @@
+// Package store persists shares outside the filesystem. A ShareStore keeps
+// the shares of many share sets, each identified by an application-chosen
+// set ID, and stores every share as its binary envelope (see
+// goshamir.Share.MarshalBinary) so that corruption is detected on load.
+//
+// A single store should normally hold at most one share of each set:
+// keeping a threshold of shares in one database puts the secret back
+// behind a single point of compromise.
+package store
+
+import (
+	"context"
+	"errors"
+
+	goshamir "github.com/fawwazid/go-shamir"
+)
+
+// ErrNotFound is returned when a share or share set is not in the store.
+var ErrNotFound = errors.New("share not found")
+
+// ErrExists is returned by Put when the store already holds a share with
+// the same set ID and index.
+var ErrExists = errors.New("share already exists")
+
+// ErrVersionConflict is returned when an update is based on a stale
+// version of a share, because it was modified concurrently.
+var ErrVersionConflict = errors.New("share was modified concurrently")
+
+// ShareStore stores shares keyed by set ID and share index.
+type ShareStore interface {
+	// Put stores a new share, failing with ErrExists if the set already
+	// holds a share with the same index.
+	Put(ctx context.Context, setID string, s goshamir.Share) error
+	// Get returns the share of the set with the given index.
+	Get(ctx context.Context, setID string, index uint8) (goshamir.Share, error)
+	// List returns every share of the set, ordered by index.
+	List(ctx context.Context, setID string) ([]goshamir.Share, error)
+	// Delete removes every share of the set. Deleting an unknown set is
+	// not an error.
+	Delete(ctx context.Context, setID string) error
+}
+
+func validateKey(setID string, index uint8) error {
+	if setID == "" {
+		return errors.New("set ID cannot be empty")
+	}
+	if index == 0 {
+		return errors.New("share index must be non-zero")
+	}
+	return nil
+}
@@ -0,0 +1,437 @@
+package goshamir
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+)
+
+// streamChunkSize is the number of secret bytes processed per chunk by the
+// streaming APIs.
+const streamChunkSize = 64 * 1024
+
+// errStreamPepper is returned by the streaming APIs when WithPepper is set.
+// Share streams are read back in fixed-size chunks, which peppered chunks,
+// each 28 bytes longer, would not line up with.
+var errStreamPepper = errors.New("pepper is not supported by share streams")
+
+// ProgressFunc receives progress updates from streaming operations.
+// bytesDone counts secret bytes processed so far and bytesTotal is the total
+// secret size, or -1 when it cannot be determined up front.
+type ProgressFunc func(bytesDone, bytesTotal int64)
+
+// WithProgress registers fn to be called after every chunk processed by
+// SplitStream and CombineStream.
+func WithProgress(fn ProgressFunc) Option {
+	return func(c *Config) {
+		c.Progress = fn
+	}
+}
+
+// SplitStream reads a secret from src and writes one share stream to each
+// writer in dst, so len(dst) is the total number of shares. The secret is
+// processed in fixed-size chunks and is never held in memory as a whole.
+//
+// Each share stream starts with a single byte holding the share index,
+// followed by the share value in the same layout as Share.Value. Streams of
+// schemes other than SchemeV1GF257 instead start with a zero byte, the
+// scheme and the share index. With
+// WithChunkMAC the value is framed as authenticated chunks, one per chunk of
+// the secret.
+func SplitStream(dst []io.Writer, src io.Reader, threshold int, opts ...Option) error {
+	if src == nil {
+		return errors.New("source reader cannot be nil")
+	}
+	splitter, err := NewSplitter(len(dst), threshold, opts...)
+	if err != nil {
+		return err
+	}
+	if len(splitter.config.Pepper) > 0 {
+		return errStreamPepper
+	}
+	out := make([]io.Writer, len(dst))
+	for i, w := range dst {
+		if w == nil {
+			return fmt.Errorf("share writer %d cannot be nil", i)
+		}
+		if _, err := w.Write(streamHeader(splitter.config.Scheme, uint8(i+1))); err != nil {
+			return fmt.Errorf("share %d: write failed: %w", i+1, err)
+		}
+		out[i] = splitter.shareWriter(w, uint8(i+1), 0)
+	}
+
+	progress := splitter.config.Progress
+	total := streamSize(src)
+	var done int64
+
+	chunk := make([]byte, streamChunkSize)
+	defer clear(chunk)
+	for {
+		n, err := io.ReadFull(src, chunk)
+		if n > 0 {
+			if err := splitter.writeChunk(out, chunk[:n]); err != nil {
+				return err
+			}
+			done += int64(n)
+			if progress != nil {
+				progress(done, total)
+			}
+		}
+		if err == io.EOF || err == io.ErrUnexpectedEOF {
+			break
+		}
+		if err != nil {
+			return fmt.Errorf("read secret: %w", err)
+		}
+	}
+
+	if done == 0 {
+		return errors.New("secret must not be empty")
+	}
+	if err := closeShareWriters(out); err != nil {
+		return err
+	}
+	splitter.config.logger().Info("shamir: share streams generated",
+		"shares", len(out), "threshold", threshold, "scheme", splitter.config.Scheme.String(), "bytes", done)
+	return nil
+}
+
+// shareWriter wraps the value portion of a share stream in a ChunkWriter
+// when chunk authentication is enabled. seq is the number of chunks already
+// present in the stream.
+func (s *Splitter) shareWriter(w io.Writer, index uint8, seq uint64) io.Writer {
+	if s.config.ChunkMACKey == nil {
+		return w
+	}
+	return newChunkWriterAt(w, chunkShareKey(s.config.ChunkMACKey, index), seq)
+}
+
+// closeShareWriters writes the final chunk of every chunked share stream.
+func closeShareWriters(out []io.Writer) error {
+	for i, w := range out {
+		if cw, ok := w.(*ChunkWriter); ok {
+			if err := cw.Close(); err != nil {
+				return fmt.Errorf("share %d: write failed: %w", i+1, err)
+			}
+		}
+	}
+	return nil
+}
+
+// writeChunk splits one chunk of the secret and appends each share's value
+// to its stream.
+func (s *Splitter) writeChunk(dst []io.Writer, chunk []byte) error {
+	shares, err := s.split(chunk, s.config.Rand)
+	if err != nil {
+		return err
+	}
+	defer s.Release(shares)
+
+	for i, share := range shares {
+		if _, err := dst[i].Write(share.Value); err != nil {
+			return fmt.Errorf("share %d: write failed: %w", share.Index, err)
+		}
+	}
+	return nil
+}
+
+// CombineStream reconstructs a secret from share streams produced by
+// SplitStream and writes it to dst. Like Combine, only the first threshold
+// readers are consumed.
+func CombineStream(dst io.Writer, srcs []io.Reader, threshold int, opts ...Option) (err error) {
+	defer recoverInternal(&err)
+
+	if dst == nil {
+		return errors.New("destination writer cannot be nil")
+	}
+	r, err := newCombineReader(srcs, threshold, opts...)
+	if err != nil {
+		return err
+	}
+	defer r.wipe()
+
+	for {
+		secret, err := r.nextChunk()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if _, err := dst.Write(secret); err != nil {
+			return fmt.Errorf("write secret: %w", err)
+		}
+	}
+}
+
+// CombineReader returns a reader yielding the secret reconstructed from
+// share streams produced by SplitStream, so the secret can be fed to a
+// decryptor or restore process without ever being held in memory as a
+// whole. Shares are read chunk by chunk as the returned reader is consumed.
+//
+// Parameter errors, invalid share streams and failed chunk authentication
+// are reported by Read.
+func CombineReader(shareReaders []io.Reader, threshold int, opts ...Option) io.Reader {
+	r, err := newCombineReader(shareReaders, threshold, opts...)
+	if err != nil {
+		return &combineReader{err: err}
+	}
+	return r
+}
+
+// combineReader reconstructs a secret one chunk at a time.
+type combineReader struct {
+	srcs          []io.Reader
+	threshold     int
+	config        Config
+	reconstructor *Reconstructor
+	shares        []Share
+	chunkLen      int
+	buf           []byte
+
+	secret   []byte
+	pending  []byte
+	done     int64
+	total    int64
+	finished bool
+	err      error
+}
+
+func newCombineReader(srcs []io.Reader, threshold int, opts ...Option) (*combineReader, error) {
+	cfg := NewConfig(opts...)
+	if err := validateStreamCombineParams(srcs, threshold); err != nil {
+		cfg.logger().Warn("shamir: invalid combine parameters", "threshold", threshold, "error", err)
+		return nil, err
+	}
+	if err := validateChunkMACKey(cfg); err != nil {
+		cfg.logger().Warn("shamir: invalid combine parameters", "error", err)
+		return nil, err
+	}
+	if len(cfg.Pepper) > 0 {
+		return nil, errStreamPepper
+	}
+	return &combineReader{
+		srcs:      append([]io.Reader(nil), srcs[:threshold]...),
+		threshold: threshold,
+		config:    cfg,
+		total:     -1,
+	}, nil
+}
+
+// validateStreamCombineParams checks the arguments of the streaming combine
+// APIs.
+func validateStreamCombineParams(srcs []io.Reader, threshold int) error {
+	if srcs == nil {
+		return errors.New("shares cannot be nil")
+	}
+	if threshold < MinThreshold {
+		return fmt.Errorf("threshold must be at least %d", MinThreshold)
+	}
+	if threshold > MaxShares {
+		return fmt.Errorf("threshold must be <= %d", MaxShares)
+	}
+	if len(srcs) < threshold {
+		return errors.New("insufficient shares: need at least threshold shares")
+	}
+	for i, r := range srcs[:threshold] {
+		if r == nil {
+			return fmt.Errorf("share reader %d cannot be nil", i)
+		}
+	}
+	return nil
+}
+
+func (r *combineReader) Read(p []byte) (n int, err error) {
+	defer recoverInternal(&err)
+
+	for len(r.pending) == 0 {
+		if r.err != nil {
+			return 0, r.err
+		}
+		r.pending, r.err = r.nextChunk()
+		if r.err != nil {
+			r.wipe()
+		}
+	}
+	n = copy(p, r.pending)
+	r.pending = r.pending[n:]
+	return n, nil
+}
+
+// start reads the stream headers and prepares the reconstructor.
+func (r *combineReader) start() error {
+	indices := make([]uint8, r.threshold)
+	r.shares = make([]Share, r.threshold)
+	for i, src := range r.srcs {
+		scheme, index, err := readStreamHeader(src)
+		if err != nil {
+			return fmt.Errorf("share %d: read header: %w", i, err)
+		}
+		indices[i] = index
+		r.shares[i] = Share{Index: index, Scheme: scheme}
+	}
+	reconstructor, err := newReconstructor(indices, r.config)
+	if err != nil {
+		return err
+	}
+	scheme, err := sharesScheme(r.shares)
+	if err != nil {
+		return err
+	}
+	r.reconstructor = reconstructor
+	r.chunkLen = streamChunkSize * scheme.bytesPerElement()
+	r.config.logger().Info("shamir: combining share streams",
+		"shares", r.threshold, "scheme", scheme.String())
+
+	if size := streamSize(r.srcs[0]); size >= 0 {
+		r.total = size / int64(scheme.bytesPerElement())
+		if r.config.ChunkMACKey != nil {
+			r.total = chunkedSecretSize(size, scheme)
+		}
+	}
+	if r.config.ChunkMACKey != nil {
+		for i, src := range r.srcs {
+			r.srcs[i] = NewChunkReader(src, chunkShareKey(r.config.ChunkMACKey, indices[i]))
+		}
+	}
+
+	r.buf = make([]byte, r.threshold*r.chunkLen)
+	return nil
+}
+
+// nextChunk reconstructs the next chunk of the secret. It returns io.EOF
+// once every share stream has been consumed. The returned slice is only
+// valid until the next call.
+func (r *combineReader) nextChunk() (secret []byte, err error) {
+	defer func() {
+		if err != nil && err != io.EOF {
+			r.logFailure(err)
+		}
+	}()
+	if r.finished {
+		r.wipe()
+		return nil, io.EOF
+	}
+	if r.reconstructor == nil {
+		if err := r.start(); err != nil {
+			return nil, err
+		}
+	}
+	clear(r.secret)
+
+	n, err := readShareChunk(r.srcs, r.shares, r.chunkLen, r.buf)
+	if err != nil {
+		return nil, err
+	}
+	if n == 0 {
+		r.finished = true
+		r.wipe()
+		if r.done == 0 {
+			return nil, errors.New("share value cannot be empty")
+		}
+		return nil, io.EOF
+	}
+
+	secret, err = r.reconstructor.Combine(r.shares)
+	if err != nil {
+		return nil, err
+	}
+	r.secret = secret
+	r.done += int64(len(secret))
+	if r.config.Progress != nil {
+		r.config.Progress(r.done, r.total)
+	}
+
+	// Unchunked streams end with a short chunk. Chunked streams are read
+	// until their final chunk has been verified, so truncation is always
+	// detected.
+	if n < r.chunkLen && r.config.ChunkMACKey == nil {
+		r.finished = true
+	}
+	return secret, nil
+}
+
+// logFailure reports a failed combine, singling out streams that failed
+// chunk authentication.
+func (r *combineReader) logFailure(err error) {
+	var chunkErr *ChunkError
+	if errors.As(err, &chunkErr) {
+		r.config.logger().Warn("shamir: share stream verification failed",
+			"chunk", chunkErr.Chunk, "error", err)
+		return
+	}
+	r.config.logger().Warn("shamir: combine failed", "error", err)
+}
+
+// wipe clears buffered share and secret material.
+func (r *combineReader) wipe() {
+	clear(r.buf)
+	clear(r.secret)
+}
+
+// readShareChunk reads the next chunk of up to chunkLen bytes from every
+// share stream into buf and points the shares' values at the data. It
+// returns the number of bytes read per share, which is zero once every
+// stream is exhausted.
+func readShareChunk(srcs []io.Reader, shares []Share, chunkLen int, buf []byte) (int, error) {
+	read := -1
+	for i, r := range srcs {
+		part := buf[i*chunkLen : (i+1)*chunkLen]
+		n, err := io.ReadFull(r, part)
+		if err != nil && err != io.EOF && err != io.ErrUnexpectedEOF {
+			return 0, fmt.Errorf("share %d: read failed: %w", i, err)
+		}
+		if read >= 0 && n != read {
+			return 0, fmt.Errorf("share %d has inconsistent length", i)
+		}
+		read = n
+		shares[i].Value = part[:n]
+	}
+	return read, nil
+}
+
+// streamHeader returns the header of a share stream. Share indices are never
+// zero, so a leading zero byte announces an explicit scheme while SchemeV1GF257
+// streams keep their original single-byte header.
+func streamHeader(scheme Scheme, index uint8) []byte {
+	if scheme == SchemeV1GF257 {
+		return []byte{index}
+	}
+	return []byte{0, uint8(scheme), index}
+}
+
+// readStreamHeader reads the header written by streamHeader.
+func readStreamHeader(r io.Reader) (Scheme, uint8, error) {
+	var header [3]byte
+	if _, err := io.ReadFull(r, header[:1]); err != nil {
+		return 0, 0, err
+	}
+	if header[0] != 0 {
+		return SchemeV1GF257, header[0], nil
+	}
+	if _, err := io.ReadFull(r, header[1:]); err != nil {
+		return 0, 0, err
+	}
+	return Scheme(header[1]), header[2], nil
+}
+
+// streamSize returns the number of bytes remaining in r, or -1 if r does not
+// expose its size.
+func streamSize(r io.Reader) int64 {
+	switch v := r.(type) {
+	case interface{ Len() int }:
+		return int64(v.Len())
+	case *os.File:
+		info, err := v.Stat()
+		if err != nil || !info.Mode().IsRegular() {
+			return -1
+		}
+		offset, err := v.Seek(0, io.SeekCurrent)
+		if err != nil {
+			return -1
+		}
+		return info.Size() - offset
+	}
+	return -1
+}
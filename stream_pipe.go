@@ -0,0 +1,60 @@
+package goshamir
+
+import "io"
+
+// splitWriter feeds the secret written to it into a SplitStream running in
+// its own goroutine.
+type splitWriter struct {
+	pw   *io.PipeWriter
+	done chan error
+}
+
+// SplitWriter returns a writer accepting the secret and one reader per share
+// stream, so a secret produced by another process (for example a database
+// dump) can be split while uploaders consume the shares. The share streams
+// have the layout documented on SplitStream and accept the same options.
+//
+// Writes block until every share reader has consumed the corresponding
+// output, so the readers must be drained concurrently. Close flushes the
+// final chunk, ends the share streams and reports any split error; the same
+// error is delivered to the share readers.
+func SplitWriter(totalShares, threshold int, opts ...Option) (io.WriteCloser, []io.Reader, error) {
+	if err := validateShareCounts(totalShares, threshold); err != nil {
+		return nil, nil, err
+	}
+
+	in, pw := io.Pipe()
+	readers := make([]io.Reader, totalShares)
+	writers := make([]io.Writer, totalShares)
+	closers := make([]*io.PipeWriter, totalShares)
+	for i := range readers {
+		r, w := io.Pipe()
+		readers[i], writers[i], closers[i] = r, w, w
+	}
+
+	w := &splitWriter{pw: pw, done: make(chan error, 1)}
+	go func() {
+		err := SplitStream(writers, in, threshold, opts...)
+		for _, c := range closers {
+			c.CloseWithError(err)
+		}
+		in.CloseWithError(err)
+		w.done <- err
+	}()
+	return w, readers, nil
+}
+
+func (w *splitWriter) Write(p []byte) (int, error) {
+	return w.pw.Write(p)
+}
+
+// Close signals the end of the secret and waits for the split to finish.
+func (w *splitWriter) Close() error {
+	if w.done == nil {
+		return nil
+	}
+	w.pw.Close()
+	err := <-w.done
+	w.done = nil
+	return err
+}
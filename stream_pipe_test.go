@@ -0,0 +1,80 @@
+package goshamir
+
+import (
+	"bytes"
+	"io"
+	"sync"
+	"testing"
+)
+
+// --- SplitWriter Tests ---
+
+func TestSplitWriter_ConcurrentReaders(t *testing.T) {
+	w, readers, err := SplitWriter(4, 2)
+	if err != nil {
+		t.Fatalf("SplitWriter failed: %v", err)
+	}
+
+	outputs := make([][]byte, len(readers))
+	var wg sync.WaitGroup
+	for i, r := range readers {
+		wg.Add(1)
+		go func(i int, r io.Reader) {
+			defer wg.Done()
+			data, err := io.ReadAll(r)
+			if err != nil {
+				t.Errorf("Share %d: read failed: %v", i+1, err)
+			}
+			outputs[i] = data
+		}(i, r)
+	}
+
+	secret := bytes.Repeat([]byte("piped dump data "), 10000)
+	for off := 0; off < len(secret); off += 4096 {
+		if _, err := w.Write(secret[off:min(off+4096, len(secret))]); err != nil {
+			t.Fatalf("Write failed: %v", err)
+		}
+	}
+	if err := w.Close(); err != nil {
+		t.Fatalf("Close failed: %v", err)
+	}
+	wg.Wait()
+
+	var out bytes.Buffer
+	srcs := []io.Reader{bytes.NewReader(outputs[3]), bytes.NewReader(outputs[1])}
+	if err := CombineStream(&out, srcs, 2); err != nil {
+		t.Fatalf("CombineStream failed: %v", err)
+	}
+	if !bytes.Equal(secret, out.Bytes()) {
+		t.Error("Recovered secret does not match original")
+	}
+}
+
+func TestSplitWriter_EmptySecret(t *testing.T) {
+	w, readers, err := SplitWriter(2, 2)
+	if err != nil {
+		t.Fatalf("SplitWriter failed: %v", err)
+	}
+
+	errs := make(chan error, len(readers))
+	for _, r := range readers {
+		go func(r io.Reader) {
+			_, err := io.ReadAll(r)
+			errs <- err
+		}(r)
+	}
+	if err := w.Close(); err == nil {
+		t.Error("Expected error for empty secret")
+	}
+	for range readers {
+		if err := <-errs; err == nil {
+			t.Error("Expected share reader to report the split error")
+		}
+	}
+}
+
+func TestSplitWriter_InvalidParams(t *testing.T) {
+	if _, _, err := SplitWriter(1, 2); err == nil {
+		t.Error("Expected error when totalShares < threshold")
+	}
+}
@@ -0,0 +1,247 @@
+package goshamir
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+)
+
+// checkpointVersion is the version byte of the StreamCheckpoint binary form.
+const checkpointVersion = 1
+
+// StreamCheckpoint records the position of a resumable streaming split.
+//
+// The checkpoint contains the seed from which every polynomial coefficient
+// of the split is derived. Together with any single share it reveals the
+// secret, so it must be protected at least as well as the secret itself and
+// destroyed once the split completes.
+type StreamCheckpoint struct {
+	// Seed keys the deterministic coefficient generator. Chunk c uses the
+	// AES-256-CTR keystream of Seed with nonce c.
+	Seed [32]byte
+	// Chunk is the number of chunks fully written to every share stream.
+	Chunk uint64
+	// BytesDone is the number of secret bytes consumed by those chunks.
+	BytesDone int64
+	// Offsets holds, for each share stream, the number of bytes written
+	// by those chunks including the stream header.
+	Offsets []int64
+}
+
+// StreamSplitter performs a streaming split that can be interrupted and
+// resumed. Coefficients for each chunk are derived from a secret seed and
+// the chunk counter, so resuming from a checkpoint produces exactly the
+// bytes an uninterrupted run would have written.
+//
+// A StreamSplitter is not safe for concurrent use.
+type StreamSplitter struct {
+	splitter *Splitter
+	cp       StreamCheckpoint
+	block    cipher.Block
+}
+
+// NewStreamSplitter starts a resumable streaming split with a fresh seed
+// drawn from the configured random source.
+func NewStreamSplitter(totalShares, threshold int, opts ...Option) (*StreamSplitter, error) {
+	splitter, err := NewSplitter(totalShares, threshold, opts...)
+	if err != nil {
+		return nil, err
+	}
+	cp := StreamCheckpoint{Offsets: make([]int64, totalShares)}
+	if _, err := io.ReadFull(splitter.config.Rand, cp.Seed[:]); err != nil {
+		return nil, fmt.Errorf("seed generation failed: %w", err)
+	}
+	return newStreamSplitter(splitter, cp)
+}
+
+// ResumeStreamSplitter continues a streaming split from cp. Before calling
+// Split, the caller must position the secret reader at cp.BytesDone and
+// truncate each share stream to its entry in cp.Offsets, discarding any
+// partially written chunk.
+func ResumeStreamSplitter(cp StreamCheckpoint, totalShares, threshold int, opts ...Option) (*StreamSplitter, error) {
+	splitter, err := NewSplitter(totalShares, threshold, opts...)
+	if err != nil {
+		return nil, err
+	}
+	if len(cp.Offsets) != totalShares {
+		return nil, fmt.Errorf("checkpoint has %d share offsets, expected %d", len(cp.Offsets), totalShares)
+	}
+	if cp.BytesDone < 0 {
+		return nil, errors.New("checkpoint byte count cannot be negative")
+	}
+	cp.Offsets = append([]int64(nil), cp.Offsets...)
+	return newStreamSplitter(splitter, cp)
+}
+
+func newStreamSplitter(splitter *Splitter, cp StreamCheckpoint) (*StreamSplitter, error) {
+	if len(splitter.config.Pepper) > 0 {
+		return nil, errStreamPepper
+	}
+	block, err := aes.NewCipher(cp.Seed[:])
+	if err != nil {
+		return nil, err
+	}
+	return &StreamSplitter{splitter: splitter, cp: cp, block: block}, nil
+}
+
+// Checkpoint returns the position reached so far. It is always consistent
+// with whole chunks, even when Split returned an error part way through a
+// chunk.
+func (s *StreamSplitter) Checkpoint() StreamCheckpoint {
+	cp := s.cp
+	cp.Offsets = append([]int64(nil), s.cp.Offsets...)
+	return cp
+}
+
+// Split reads the rest of the secret from src and appends to the share
+// streams in dst, advancing the checkpoint after every chunk. The stream
+// layout is the same as SplitStream's.
+func (s *StreamSplitter) Split(dst []io.Writer, src io.Reader) error {
+	if src == nil {
+		return errors.New("source reader cannot be nil")
+	}
+	if len(dst) != s.splitter.totalShares {
+		return fmt.Errorf("expected %d share writers, got %d", s.splitter.totalShares, len(dst))
+	}
+	for i, w := range dst {
+		if w == nil {
+			return fmt.Errorf("share writer %d cannot be nil", i)
+		}
+	}
+
+	out := make([]io.Writer, len(dst))
+	for i, w := range dst {
+		out[i] = s.splitter.shareWriter(w, uint8(i+1), s.cp.Chunk)
+		if s.cp.Offsets[i] != 0 {
+			continue
+		}
+		header := streamHeader(s.splitter.config.Scheme, uint8(i+1))
+		if _, err := w.Write(header); err != nil {
+			return fmt.Errorf("share %d: write failed: %w", i+1, err)
+		}
+		s.cp.Offsets[i] = int64(len(header))
+	}
+
+	progress := s.splitter.config.Progress
+	total := streamSize(src)
+	if total >= 0 {
+		total += s.cp.BytesDone
+	}
+
+	chunk := make([]byte, streamChunkSize)
+	defer clear(chunk)
+	for {
+		n, err := io.ReadFull(src, chunk)
+		if n > 0 {
+			if err := s.writeChunk(out, chunk[:n]); err != nil {
+				return err
+			}
+			if progress != nil {
+				progress(s.cp.BytesDone, total)
+			}
+		}
+		if err == io.EOF || err == io.ErrUnexpectedEOF {
+			break
+		}
+		if err != nil {
+			return fmt.Errorf("read secret: %w", err)
+		}
+	}
+
+	if s.cp.BytesDone == 0 {
+		return errors.New("secret must not be empty")
+	}
+	if err := closeShareWriters(out); err != nil {
+		return err
+	}
+	for i, w := range out {
+		if _, ok := w.(*ChunkWriter); ok {
+			s.cp.Offsets[i] += chunkOverhead
+		}
+	}
+	s.splitter.config.logger().Info("shamir: share streams generated",
+		"shares", len(out), "threshold", s.splitter.threshold,
+		"scheme", s.splitter.config.Scheme.String(), "bytes", s.cp.BytesDone)
+	return nil
+}
+
+func (s *StreamSplitter) writeChunk(dst []io.Writer, chunk []byte) error {
+	shares, err := s.splitter.split(chunk, s.chunkRandom(s.cp.Chunk))
+	if err != nil {
+		return err
+	}
+	defer s.splitter.Release(shares)
+
+	for i, share := range shares {
+		if _, err := dst[i].Write(share.Value); err != nil {
+			return fmt.Errorf("share %d: write failed: %w", share.Index, err)
+		}
+	}
+	overhead := int64(0)
+	if s.splitter.config.ChunkMACKey != nil {
+		overhead = chunkOverhead
+	}
+	for i, share := range shares {
+		s.cp.Offsets[i] += int64(len(share.Value)) + overhead
+	}
+	s.cp.Chunk++
+	s.cp.BytesDone += int64(len(chunk))
+	return nil
+}
+
+// chunkRandom returns the deterministic coefficient stream for a chunk.
+func (s *StreamSplitter) chunkRandom(chunk uint64) io.Reader {
+	var iv [aes.BlockSize]byte
+	binary.BigEndian.PutUint64(iv[:8], chunk)
+	return cipher.StreamReader{S: cipher.NewCTR(s.block, iv[:]), R: zeroReader{}}
+}
+
+// zeroReader is an endless stream of zero bytes, turning a keystream into a
+// reader.
+type zeroReader struct{}
+
+func (zeroReader) Read(p []byte) (int, error) {
+	clear(p)
+	return len(p), nil
+}
+
+// MarshalBinary encodes the checkpoint for persistence.
+func (cp StreamCheckpoint) MarshalBinary() ([]byte, error) {
+	if len(cp.Offsets) > MaxShares {
+		return nil, fmt.Errorf("totalShares must be <= %d", MaxShares)
+	}
+	buf := make([]byte, 0, 1+32+8+8+1+8*len(cp.Offsets))
+	buf = append(buf, checkpointVersion)
+	buf = append(buf, cp.Seed[:]...)
+	buf = binary.BigEndian.AppendUint64(buf, cp.Chunk)
+	buf = binary.BigEndian.AppendUint64(buf, uint64(cp.BytesDone))
+	buf = append(buf, uint8(len(cp.Offsets)))
+	for _, off := range cp.Offsets {
+		buf = binary.BigEndian.AppendUint64(buf, uint64(off))
+	}
+	return buf, nil
+}
+
+// UnmarshalBinary decodes a checkpoint produced by MarshalBinary.
+func (cp *StreamCheckpoint) UnmarshalBinary(data []byte) error {
+	const fixed = 1 + 32 + 8 + 8 + 1
+	if len(data) < fixed || data[0] != checkpointVersion {
+		return errors.New("invalid stream checkpoint")
+	}
+	count := int(data[fixed-1])
+	if len(data) != fixed+8*count {
+		return errors.New("invalid stream checkpoint")
+	}
+
+	copy(cp.Seed[:], data[1:33])
+	cp.Chunk = binary.BigEndian.Uint64(data[33:41])
+	cp.BytesDone = int64(binary.BigEndian.Uint64(data[41:49]))
+	cp.Offsets = make([]int64, count)
+	for i := range cp.Offsets {
+		cp.Offsets[i] = int64(binary.BigEndian.Uint64(data[fixed+8*i:]))
+	}
+	return nil
+}
@@ -0,0 +1,149 @@
+package goshamir
+
+import (
+	"bytes"
+	"crypto/rand"
+	"errors"
+	"io"
+	"testing"
+)
+
+// --- Resumable Stream Tests ---
+
+// limitedWriter fails once more than limit bytes have been written.
+type limitedWriter struct {
+	buf   bytes.Buffer
+	limit int
+}
+
+var errWriterFull = errors.New("writer full")
+
+func (w *limitedWriter) Write(p []byte) (int, error) {
+	if w.buf.Len()+len(p) > w.limit {
+		return 0, errWriterFull
+	}
+	return w.buf.Write(p)
+}
+
+func TestStreamSplitter_ResumeMatchesUninterrupted(t *testing.T) {
+	secret := make([]byte, 3*streamChunkSize+100)
+	if _, err := rand.Read(secret); err != nil {
+		t.Fatalf("Failed to generate random secret: %v", err)
+	}
+
+	// Interrupt the third share stream part way through the second chunk.
+	first, err := NewStreamSplitter(3, 2)
+	if err != nil {
+		t.Fatalf("NewStreamSplitter failed: %v", err)
+	}
+	partial := []*limitedWriter{
+		{limit: 1 << 30},
+		{limit: 1 << 30},
+		{limit: 1 + 2*streamChunkSize + 10},
+	}
+	err = first.Split([]io.Writer{partial[0], partial[1], partial[2]}, bytes.NewReader(secret))
+	if !errors.Is(err, errWriterFull) {
+		t.Fatalf("Expected interrupted split, got %v", err)
+	}
+
+	cp := first.Checkpoint()
+	if cp.Chunk != 1 || cp.BytesDone != streamChunkSize {
+		t.Fatalf("Unexpected checkpoint position: chunk %d, %d bytes", cp.Chunk, cp.BytesDone)
+	}
+
+	data, err := cp.MarshalBinary()
+	if err != nil {
+		t.Fatalf("MarshalBinary failed: %v", err)
+	}
+	var restored StreamCheckpoint
+	if err := restored.UnmarshalBinary(data); err != nil {
+		t.Fatalf("UnmarshalBinary failed: %v", err)
+	}
+
+	resumed, err := ResumeStreamSplitter(restored, 3, 2)
+	if err != nil {
+		t.Fatalf("ResumeStreamSplitter failed: %v", err)
+	}
+	outputs := make([]*bytes.Buffer, 3)
+	writers := make([]io.Writer, 3)
+	for i, w := range partial {
+		// Truncate to the checkpoint and keep appending.
+		outputs[i] = bytes.NewBuffer(w.buf.Bytes()[:restored.Offsets[i]])
+		writers[i] = outputs[i]
+	}
+	if err := resumed.Split(writers, bytes.NewReader(secret[restored.BytesDone:])); err != nil {
+		t.Fatalf("Resumed Split failed: %v", err)
+	}
+
+	// A run from scratch with the same seed must produce identical streams.
+	fresh, err := ResumeStreamSplitter(StreamCheckpoint{Seed: cp.Seed, Offsets: make([]int64, 3)}, 3, 2)
+	if err != nil {
+		t.Fatalf("ResumeStreamSplitter failed: %v", err)
+	}
+	expected := []io.Writer{new(bytes.Buffer), new(bytes.Buffer), new(bytes.Buffer)}
+	if err := fresh.Split(expected, bytes.NewReader(secret)); err != nil {
+		t.Fatalf("Split failed: %v", err)
+	}
+	for i := range outputs {
+		if !bytes.Equal(outputs[i].Bytes(), expected[i].(*bytes.Buffer).Bytes()) {
+			t.Errorf("Share %d: resumed output differs from uninterrupted output", i+1)
+		}
+	}
+
+	var out bytes.Buffer
+	readers := []io.Reader{bytes.NewReader(outputs[2].Bytes()), bytes.NewReader(outputs[0].Bytes())}
+	if err := CombineStream(&out, readers, 2); err != nil {
+		t.Fatalf("CombineStream failed: %v", err)
+	}
+	if !bytes.Equal(secret, out.Bytes()) {
+		t.Error("Recovered secret does not match original")
+	}
+}
+
+func TestStreamCheckpoint_UnmarshalInvalid(t *testing.T) {
+	var cp StreamCheckpoint
+	if err := cp.UnmarshalBinary([]byte{checkpointVersion}); err == nil {
+		t.Error("Expected error for truncated checkpoint")
+	}
+
+	data, _ := StreamCheckpoint{Offsets: make([]int64, 2)}.MarshalBinary()
+	if err := cp.UnmarshalBinary(data[:len(data)-1]); err == nil {
+		t.Error("Expected error for short offsets")
+	}
+}
+
+func TestResumeStreamSplitter_OffsetMismatch(t *testing.T) {
+	cp := StreamCheckpoint{Offsets: make([]int64, 2)}
+	if _, err := ResumeStreamSplitter(cp, 3, 2); err == nil {
+		t.Error("Expected error for mismatched share count")
+	}
+}
+
+func TestStreamSplitter_ChunkMACOffsets(t *testing.T) {
+	key := []byte("resume integrity key")
+	secret := make([]byte, streamChunkSize+3)
+
+	s, err := NewStreamSplitter(2, 2, WithChunkMAC(key))
+	if err != nil {
+		t.Fatalf("NewStreamSplitter failed: %v", err)
+	}
+	bufs := []*bytes.Buffer{new(bytes.Buffer), new(bytes.Buffer)}
+	if err := s.Split([]io.Writer{bufs[0], bufs[1]}, bytes.NewReader(secret)); err != nil {
+		t.Fatalf("Split failed: %v", err)
+	}
+	cp := s.Checkpoint()
+	for i, b := range bufs {
+		if cp.Offsets[i] != int64(b.Len()) {
+			t.Errorf("Share %d: checkpoint offset %d, stream length %d", i+1, cp.Offsets[i], b.Len())
+		}
+	}
+
+	var out bytes.Buffer
+	readers := []io.Reader{bytes.NewReader(bufs[0].Bytes()), bytes.NewReader(bufs[1].Bytes())}
+	if err := CombineStream(&out, readers, 2, WithChunkMAC(key)); err != nil {
+		t.Fatalf("CombineStream failed: %v", err)
+	}
+	if !bytes.Equal(secret, out.Bytes()) {
+		t.Error("Recovered secret does not match original")
+	}
+}
@@ -0,0 +1,166 @@
+package goshamir
+
+import (
+	"bytes"
+	"crypto/rand"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// --- Stream Tests ---
+
+func TestSplitStream_RoundTrip(t *testing.T) {
+	secret := make([]byte, 3*streamChunkSize+17)
+	if _, err := rand.Read(secret); err != nil {
+		t.Fatalf("Failed to generate random secret: %v", err)
+	}
+
+	bufs := make([]*bytes.Buffer, 5)
+	writers := make([]io.Writer, 5)
+	for i := range bufs {
+		bufs[i] = new(bytes.Buffer)
+		writers[i] = bufs[i]
+	}
+	if err := SplitStream(writers, bytes.NewReader(secret), 3); err != nil {
+		t.Fatalf("SplitStream failed: %v", err)
+	}
+	for i, b := range bufs {
+		if b.Len() != 1+2*len(secret) {
+			t.Errorf("Share %d: expected stream length %d, got %d", i, 1+2*len(secret), b.Len())
+		}
+	}
+
+	readers := []io.Reader{
+		bytes.NewReader(bufs[4].Bytes()),
+		bytes.NewReader(bufs[0].Bytes()),
+		bytes.NewReader(bufs[2].Bytes()),
+	}
+	var out bytes.Buffer
+	if err := CombineStream(&out, readers, 3); err != nil {
+		t.Fatalf("CombineStream failed: %v", err)
+	}
+	if !bytes.Equal(secret, out.Bytes()) {
+		t.Error("Recovered secret does not match original")
+	}
+}
+
+func TestSplitStream_Progress(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "secret.bin")
+	secret := make([]byte, 2*streamChunkSize+1)
+	if err := os.WriteFile(path, secret, 0o600); err != nil {
+		t.Fatalf("WriteFile failed: %v", err)
+	}
+	f, err := os.Open(path)
+	if err != nil {
+		t.Fatalf("Open failed: %v", err)
+	}
+	defer f.Close()
+
+	var calls []int64
+	progress := func(done, total int64) {
+		if total != int64(len(secret)) {
+			t.Errorf("Expected total %d, got %d", len(secret), total)
+		}
+		calls = append(calls, done)
+	}
+
+	writers := []io.Writer{io.Discard, io.Discard, io.Discard}
+	if err := SplitStream(writers, f, 2, WithProgress(progress)); err != nil {
+		t.Fatalf("SplitStream failed: %v", err)
+	}
+
+	want := []int64{streamChunkSize, 2 * streamChunkSize, 2*streamChunkSize + 1}
+	if len(calls) != len(want) {
+		t.Fatalf("Expected %d progress calls, got %v", len(want), calls)
+	}
+	for i := range want {
+		if calls[i] != want[i] {
+			t.Errorf("Call %d: expected %d, got %d", i, want[i], calls[i])
+		}
+	}
+}
+
+func TestSplitStream_UnknownTotal(t *testing.T) {
+	var total int64
+	progress := func(_, n int64) { total = n }
+	src := io.MultiReader(bytes.NewReader([]byte("unsized")))
+	writers := []io.Writer{io.Discard, io.Discard}
+	if err := SplitStream(writers, src, 2, WithProgress(progress)); err != nil {
+		t.Fatalf("SplitStream failed: %v", err)
+	}
+	if total != -1 {
+		t.Errorf("Expected unknown total -1, got %d", total)
+	}
+}
+
+func TestSplitStream_EmptySecret(t *testing.T) {
+	writers := []io.Writer{io.Discard, io.Discard}
+	if err := SplitStream(writers, bytes.NewReader(nil), 2); err == nil {
+		t.Error("Expected error for empty secret")
+	}
+}
+
+func TestCombineStream_InvalidStreams(t *testing.T) {
+	var out bytes.Buffer
+	if err := CombineStream(&out, []io.Reader{bytes.NewReader([]byte{1, 5, 0})}, 2); err == nil {
+		t.Error("Expected error for insufficient shares")
+	}
+
+	readers := []io.Reader{
+		bytes.NewReader([]byte{1, 5, 0}),
+		bytes.NewReader([]byte{1, 6, 0}),
+	}
+	if err := CombineStream(&out, readers, 2); err == nil {
+		t.Error("Expected error for duplicate indices")
+	}
+
+	readers = []io.Reader{
+		bytes.NewReader([]byte{1, 5, 0, 7, 0}),
+		bytes.NewReader([]byte{2, 6, 0}),
+	}
+	if err := CombineStream(&out, readers, 2); err == nil {
+		t.Error("Expected error for inconsistent stream lengths")
+	}
+}
+
+func TestCombineReader_StreamsSecret(t *testing.T) {
+	secret := make([]byte, 2*streamChunkSize+321)
+	if _, err := rand.Read(secret); err != nil {
+		t.Fatalf("Failed to generate random secret: %v", err)
+	}
+
+	for _, opts := range [][]Option{nil, {WithChunkMAC([]byte("key"))}} {
+		bufs := []*bytes.Buffer{new(bytes.Buffer), new(bytes.Buffer), new(bytes.Buffer)}
+		if err := SplitStream([]io.Writer{bufs[0], bufs[1], bufs[2]}, bytes.NewReader(secret), 2, opts...); err != nil {
+			t.Fatalf("SplitStream failed: %v", err)
+		}
+
+		r := CombineReader([]io.Reader{bufs[2], bufs[0]}, 2, opts...)
+		// Read in small, odd-sized pieces to exercise chunk boundaries.
+		var out bytes.Buffer
+		if _, err := io.CopyBuffer(&out, struct{ io.Reader }{r}, make([]byte, 1000)); err != nil {
+			t.Fatalf("Read failed: %v", err)
+		}
+		if !bytes.Equal(secret, out.Bytes()) {
+			t.Error("Recovered secret does not match original")
+		}
+	}
+}
+
+func TestCombineReader_ReportsErrorsOnRead(t *testing.T) {
+	r := CombineReader(nil, 2)
+	if _, err := r.Read(make([]byte, 1)); err == nil {
+		t.Error("Expected error for nil shares")
+	}
+
+	readers := []io.Reader{
+		bytes.NewReader([]byte{3, 5, 0}),
+		bytes.NewReader([]byte{3, 6, 0}),
+	}
+	if _, err := io.ReadAll(CombineReader(readers, 2)); err == nil {
+		t.Error("Expected error for duplicate indices")
+	}
+}
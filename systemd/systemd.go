@@ -0,0 +1,172 @@
+// Package systemd gates service start-up on a Shamir quorum using systemd
+// credentials. Shares are delivered to a unit with LoadCredential= or
+// LoadCredentialEncrypted=, read from $CREDENTIALS_DIRECTORY, and the
+// combined secret is written to a credential store directory from which
+// the real service loads it with its own LoadCredential= line:
+//
+//	# unseal.service
+//	[Service]
+//	Type=oneshot
+//	LoadCredentialEncrypted=share-alice:/etc/credstore.encrypted/share-alice
+//	LoadCredentialEncrypted=share-bob:/etc/credstore.encrypted/share-bob
+//	ExecStart=/usr/local/bin/unseal
+//
+//	# app.service
+//	[Unit]
+//	Requires=unseal.service
+//	After=unseal.service
+//	[Service]
+//	LoadCredential=db-key:/run/credstore/db-key
+package systemd
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	goshamir "github.com/fawwazid/go-shamir"
+)
+
+// DefaultCredentialStore is the runtime credential store directory that
+// systemd searches for credentials named in LoadCredential= without a path.
+const DefaultCredentialStore = "/run/credstore"
+
+// envelopeMagic starts a share stored in binary envelope form.
+var envelopeMagic = []byte("SH\x01")
+
+// ErrNoCredentials is returned when the process was not started with
+// systemd credentials.
+var ErrNoCredentials = errors.New("CREDENTIALS_DIRECTORY is not set")
+
+// CredentialsDirectory returns the directory holding the unit's
+// credentials.
+func CredentialsDirectory() (string, error) {
+	dir := os.Getenv("CREDENTIALS_DIRECTORY")
+	if dir == "" {
+		return "", ErrNoCredentials
+	}
+	return dir, nil
+}
+
+// ReadShares reads the shares held in the named credentials, or in every
+// credential of the unit if no names are given. A credential holds either
+// a share's binary envelope or its hex encoding, optionally preceded by a
+// label as printed by shamir split.
+func ReadShares(names ...string) ([]goshamir.Share, error) {
+	dir, err := CredentialsDirectory()
+	if err != nil {
+		return nil, err
+	}
+	if len(names) == 0 {
+		entries, err := os.ReadDir(dir)
+		if err != nil {
+			return nil, err
+		}
+		for _, e := range entries {
+			if e.Type().IsRegular() {
+				names = append(names, e.Name())
+			}
+		}
+	}
+
+	shares := make([]goshamir.Share, 0, len(names))
+	for _, name := range names {
+		if name == "" || filepath.Base(name) != name {
+			return nil, fmt.Errorf("invalid credential name %q", name)
+		}
+		data, err := os.ReadFile(filepath.Join(dir, name))
+		if err != nil {
+			return nil, err
+		}
+		share, err := parseShare(data)
+		clear(data)
+		if err != nil {
+			return nil, fmt.Errorf("credential %s: %w", name, err)
+		}
+		shares = append(shares, share)
+	}
+	if len(shares) == 0 {
+		return nil, errors.New("no share credentials found")
+	}
+	return shares, nil
+}
+
+func parseShare(data []byte) (goshamir.Share, error) {
+	if bytes.HasPrefix(data, envelopeMagic) {
+		var s goshamir.Share
+		err := s.UnmarshalBinary(data)
+		return s, err
+	}
+	var encoded string
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for scanner.Scan() {
+		if fields := strings.Fields(scanner.Text()); len(fields) > 0 {
+			if encoded != "" {
+				return goshamir.Share{}, errors.New("more than one share")
+			}
+			encoded = fields[len(fields)-1]
+		}
+	}
+	if encoded == "" {
+		return goshamir.Share{}, errors.New("no share found")
+	}
+	shares, err := goshamir.DecodeSharesFromHex([]string{encoded})
+	if err != nil {
+		return goshamir.Share{}, err
+	}
+	return shares[0], nil
+}
+
+// Combine reconstructs a secret from the shares in the named credentials,
+// or in every credential of the unit if no names are given.
+func Combine(threshold int, names ...string) ([]byte, error) {
+	shares, err := ReadShares(names...)
+	if err != nil {
+		return nil, err
+	}
+	return goshamir.Combine(shares, threshold)
+}
+
+// WriteCredential atomically writes secret as credential name in dir,
+// readable only by its owner, for other units to load with
+// LoadCredential=. An empty dir means DefaultCredentialStore, which is
+// created if missing. dir should be on a tmpfs such as /run so the secret
+// never reaches persistent storage.
+func WriteCredential(dir, name string, secret []byte) error {
+	if dir == "" {
+		dir = DefaultCredentialStore
+	}
+	if name == "" || filepath.Base(name) != name {
+		return fmt.Errorf("invalid credential name %q", name)
+	}
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return err
+	}
+
+	f, err := os.CreateTemp(dir, "."+name+".*.tmp")
+	if err != nil {
+		return err
+	}
+	tmp := f.Name()
+	defer os.Remove(tmp)
+	if err := f.Chmod(0o400); err != nil {
+		f.Close()
+		return err
+	}
+	if _, err := f.Write(secret); err != nil {
+		f.Close()
+		return err
+	}
+	if err := f.Sync(); err != nil {
+		f.Close()
+		return err
+	}
+	if err := f.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp, filepath.Join(dir, name))
+}
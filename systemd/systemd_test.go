@@ -0,0 +1,102 @@
+package systemd
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	goshamir "github.com/fawwazid/go-shamir"
+)
+
+// --- Credential Tests ---
+
+func TestCombine_FromCredentials(t *testing.T) {
+	shares, _ := goshamir.Split([]byte("db-key"), 3, 2)
+	encoded, _ := goshamir.EncodeSharesToHex(shares)
+	envelope, _ := shares[2].MarshalBinary()
+
+	dir := t.TempDir()
+	t.Setenv("CREDENTIALS_DIRECTORY", dir)
+	os.WriteFile(filepath.Join(dir, "share-alice"), []byte("alice "+encoded[0]+"\n"), 0o400)
+	os.WriteFile(filepath.Join(dir, "share-carol"), envelope, 0o400)
+	os.WriteFile(filepath.Join(dir, "unrelated"), []byte("not a share"), 0o400)
+
+	secret, err := Combine(2, "share-alice", "share-carol")
+	if err != nil {
+		t.Fatalf("Combine failed: %v", err)
+	}
+	if string(secret) != "db-key" {
+		t.Errorf("Expected %q, got %q", "db-key", secret)
+	}
+
+	if _, err := ReadShares(); err == nil {
+		t.Error("Expected error for a credential that is not a share")
+	}
+	os.Remove(filepath.Join(dir, "unrelated"))
+	all, err := ReadShares()
+	if err != nil {
+		t.Fatalf("ReadShares failed: %v", err)
+	}
+	if len(all) != 2 {
+		t.Errorf("Expected 2 shares, got %d", len(all))
+	}
+
+	if _, err := ReadShares("../etc/passwd"); err == nil {
+		t.Error("Expected error for path in credential name")
+	}
+	if _, err := ReadShares("missing"); err == nil {
+		t.Error("Expected error for missing credential")
+	}
+}
+
+func TestReadShares_NoCredentials(t *testing.T) {
+	t.Setenv("CREDENTIALS_DIRECTORY", "")
+	if _, err := ReadShares(); !errors.Is(err, ErrNoCredentials) {
+		t.Errorf("Expected ErrNoCredentials, got %v", err)
+	}
+}
+
+func TestParseShare_Invalid(t *testing.T) {
+	shares, _ := goshamir.Split([]byte("x"), 3, 2)
+	encoded, _ := goshamir.EncodeSharesToHex(shares)
+	for _, data := range []string{"", "\n\n", encoded[0] + "\n" + encoded[1], "SH\x01garbage"} {
+		if _, err := parseShare([]byte(data)); err == nil {
+			t.Errorf("Expected error for %q", data)
+		}
+	}
+}
+
+func TestWriteCredential(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "credstore")
+	if err := WriteCredential(dir, "db-key", []byte("secret")); err != nil {
+		t.Fatalf("WriteCredential failed: %v", err)
+	}
+	path := filepath.Join(dir, "db-key")
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("ReadFile failed: %v", err)
+	}
+	if string(data) != "secret" {
+		t.Errorf("Expected %q, got %q", "secret", data)
+	}
+	info, _ := os.Stat(path)
+	if info.Mode().Perm() != 0o400 {
+		t.Errorf("Expected mode 0400, got %v", info.Mode().Perm())
+	}
+	entries, _ := os.ReadDir(dir)
+	if len(entries) != 1 {
+		t.Errorf("Expected no temporary files, got %d entries", len(entries))
+	}
+
+	// Replacing an existing credential is atomic.
+	if err := WriteCredential(dir, "db-key", []byte("rotated")); err != nil {
+		t.Fatalf("WriteCredential failed: %v", err)
+	}
+	if data, _ := os.ReadFile(path); string(data) != "rotated" {
+		t.Errorf("Expected %q, got %q", "rotated", data)
+	}
+	if err := WriteCredential(dir, "a/b", nil); err == nil {
+		t.Error("Expected error for path in credential name")
+	}
+}
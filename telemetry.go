@@ -0,0 +1,108 @@
+package goshamir
+
+import (
+	"strings"
+	"sync/atomic"
+)
+
+// TelemetryKind classifies a TelemetryEvent.
+type TelemetryKind int
+
+const (
+	// TelemetryDeprecatedFormat reports a successful decode of a share in
+	// a format slated for removal: a SchemeV1GF257 share, or the version 1
+	// hex form decoded through package v2.
+	TelemetryDeprecatedFormat TelemetryKind = iota + 1
+	// TelemetryNearMiss reports input rejected by validation that was
+	// almost valid: hex shares that would decode after trimming whitespace
+	// or lowercasing, and envelopes that are well formed except for their
+	// checksum.
+	TelemetryNearMiss
+)
+
+// String returns a short name for the kind, such as "deprecated-format".
+func (k TelemetryKind) String() string {
+	switch k {
+	case TelemetryDeprecatedFormat:
+		return "deprecated-format"
+	case TelemetryNearMiss:
+		return "near-miss"
+	}
+	return "unknown"
+}
+
+// TelemetryEvent describes a deprecated-format decode or a near-miss
+// validation failure. Events never include share values, watermarks or
+// secrets.
+type TelemetryEvent struct {
+	Kind TelemetryKind
+	// Op is the function that observed the event, such as
+	// "DecodeSharesFromHex".
+	Op string
+	// Format is the share encoding involved: "hex" or "envelope".
+	Format string
+	// Scheme is the share's scheme, when it is known.
+	Scheme Scheme
+	// Reason explains the event.
+	Reason string
+}
+
+var telemetryHook atomic.Pointer[func(TelemetryEvent)]
+
+// SetTelemetryHook installs a callback invoked on deprecated-format decodes
+// and near-miss validation failures, so that applications embedding the
+// library can find where legacy or mangled shares still circulate before
+// old code paths are removed. Telemetry is off by default; a nil hook turns
+// it off again. The hook is process-wide, may be called concurrently from
+// any goroutine, and must return quickly. It never changes the result of
+// the call that reports the event.
+func SetTelemetryHook(hook func(TelemetryEvent)) {
+	if hook == nil {
+		telemetryHook.Store(nil)
+		return
+	}
+	telemetryHook.Store(&hook)
+}
+
+// ReportTelemetry passes e to the hook installed with SetTelemetryHook, if
+// any. It lets companion packages, such as package v2, report events of
+// their own.
+func ReportTelemetry(e TelemetryEvent) {
+	if hook := telemetryHook.Load(); hook != nil {
+		(*hook)(e)
+	}
+}
+
+func telemetryEnabled() bool {
+	return telemetryHook.Load() != nil
+}
+
+// reportHexNearMiss reports a hex share that failed to decode but would
+// have decoded after trimming whitespace or lowercasing.
+func reportHexNearMiss(encoded string) {
+	if !telemetryEnabled() {
+		return
+	}
+	var reasons []string
+	normalized := encoded
+	if trimmed := strings.TrimSpace(normalized); trimmed != normalized {
+		normalized = trimmed
+		reasons = append(reasons, "surrounding whitespace")
+	}
+	if lower := strings.ToLower(normalized); lower != normalized {
+		normalized = lower
+		reasons = append(reasons, "uppercase characters")
+	}
+	if len(reasons) == 0 {
+		return
+	}
+	if s, err := decodeShareFromHex(normalized); err == nil {
+		ReportTelemetry(TelemetryEvent{
+			Kind:   TelemetryNearMiss,
+			Op:     "DecodeSharesFromHex",
+			Format: "hex",
+			Scheme: schemeOf(s),
+			Reason: "share rejected for " + strings.Join(reasons, " and "),
+		})
+	}
+}
@@ -0,0 +1,94 @@
+package goshamir
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+// --- Telemetry Tests ---
+
+// recordTelemetry installs a hook collecting events for the rest of the test.
+func recordTelemetry(t *testing.T) *[]TelemetryEvent {
+	t.Helper()
+	var events []TelemetryEvent
+	SetTelemetryHook(func(e TelemetryEvent) { events = append(events, e) })
+	t.Cleanup(func() { SetTelemetryHook(nil) })
+	return &events
+}
+
+func TestTelemetry_DeprecatedFormat(t *testing.T) {
+	legacy, _ := Split([]byte("legacy"), 3, 2)
+	s, _ := NewSplitter(3, 2, WithScheme(SchemeV2GF256))
+	current, _ := s.Split([]byte("current"))
+	events := recordTelemetry(t)
+
+	encoded, _ := EncodeSharesToHex([]Share{legacy[0], current[0]})
+	if _, err := DecodeSharesFromHex(encoded); err != nil {
+		t.Fatalf("DecodeSharesFromHex failed: %v", err)
+	}
+	envelope, _ := legacy[1].MarshalBinary()
+	var decoded Share
+	if err := decoded.UnmarshalBinary(envelope); err != nil {
+		t.Fatalf("UnmarshalBinary failed: %v", err)
+	}
+
+	if len(*events) != 2 {
+		t.Fatalf("got %d events, want 2: %+v", len(*events), *events)
+	}
+	for i, format := range []string{"hex", "envelope"} {
+		e := (*events)[i]
+		if e.Kind != TelemetryDeprecatedFormat || e.Format != format || e.Scheme != SchemeV1GF257 {
+			t.Errorf("unexpected event %+v", e)
+		}
+	}
+}
+
+func TestTelemetry_NearMiss(t *testing.T) {
+	s, _ := NewSplitter(3, 2, WithScheme(SchemeV2GF256))
+	shares, _ := s.Split([]byte("secret"))
+	encoded, _ := EncodeSharesToHex(shares[:1])
+	envelope, _ := shares[1].MarshalBinary()
+	envelope[len(envelope)-1] ^= 1
+	events := recordTelemetry(t)
+
+	if _, err := DecodeSharesFromHex([]string{strings.ToUpper(encoded[0]) + " "}); err == nil {
+		t.Fatal("expected decode error")
+	}
+	var decoded Share
+	if err := decoded.UnmarshalBinary(envelope); err == nil {
+		t.Fatal("expected checksum error")
+	}
+	if _, err := DecodeSharesFromHex([]string{"garbage"}); err == nil {
+		t.Fatal("expected decode error")
+	}
+
+	if len(*events) != 2 {
+		t.Fatalf("got %d events, want 2: %+v", len(*events), *events)
+	}
+	hexEvent, envEvent := (*events)[0], (*events)[1]
+	if hexEvent.Kind != TelemetryNearMiss || !strings.Contains(hexEvent.Reason, "whitespace") || !strings.Contains(hexEvent.Reason, "uppercase") {
+		t.Errorf("unexpected hex event %+v", hexEvent)
+	}
+	if envEvent.Kind != TelemetryNearMiss || envEvent.Format != "envelope" || envEvent.Scheme != SchemeV2GF256 {
+		t.Errorf("unexpected envelope event %+v", envEvent)
+	}
+	for _, e := range *events {
+		if strings.Contains(e.Reason, encoded[0]) || bytes.Contains([]byte(e.Reason), shares[1].Value) {
+			t.Errorf("event leaks share data: %+v", e)
+		}
+	}
+}
+
+func TestTelemetry_Disabled(t *testing.T) {
+	events := recordTelemetry(t)
+	SetTelemetryHook(nil)
+	shares, _ := Split([]byte("secret"), 3, 2)
+	encoded, _ := EncodeSharesToHex(shares)
+	if _, err := DecodeSharesFromHex(encoded); err != nil {
+		t.Fatalf("DecodeSharesFromHex failed: %v", err)
+	}
+	if len(*events) != 0 {
+		t.Fatalf("expected no events after removing the hook, got %+v", *events)
+	}
+}
@@ -0,0 +1,183 @@
+// Package encrypt implements threshold ElGamal encryption. A decryption key
+// is split among n parties so that any k of them can jointly decrypt a
+// ciphertext by each contributing a partial decryption; the key itself is
+// never reconstructed.
+//
+// Encryption is hybrid: an ElGamal key encapsulation in the 2048-bit MODP
+// group of RFC 3526 yields a shared group element, which is hashed into an
+// AES-256-GCM key for the message.
+//
+// Partial decryptions are not verifiable: a dishonest party can make
+// Combine fail, but cannot make it return a wrong plaintext, because the
+// AEAD authenticates the message.
+package encrypt
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+
+	"github.com/fawwazid/go-shamir/internal/modp"
+)
+
+// ErrDecryption is returned by Combine when the partial decryptions do not
+// open the ciphertext.
+var ErrDecryption = errors.New("threshold decryption failed")
+
+// PublicKey is the encryption key of a threshold key pair.
+type PublicKey struct {
+	Y *big.Int
+}
+
+// KeyShare is one party's share of the decryption key. It must be kept
+// secret.
+type KeyShare struct {
+	Index uint8
+	X     *big.Int
+}
+
+// Ciphertext is a message encrypted to a PublicKey.
+type Ciphertext struct {
+	// C1 is the ephemeral ElGamal element g^r.
+	C1 *big.Int
+	// Sealed is the AES-GCM nonce followed by the sealed message.
+	Sealed []byte
+}
+
+// PartialDecryption is one party's contribution to decrypting a ciphertext.
+type PartialDecryption struct {
+	Index uint8
+	D     *big.Int
+}
+
+// GenerateKey creates a key pair whose decryption key is split into n key
+// shares, any k of which can decrypt. A nil rand means crypto/rand.Reader.
+func GenerateKey(n, k int, rand io.Reader) (*PublicKey, []KeyShare, error) {
+	x, err := modp.RandomScalar(rand)
+	if err != nil {
+		return nil, nil, err
+	}
+	scalars, coeffs, err := modp.SplitScalar(x, n, k, rand)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer func() {
+		for _, c := range coeffs {
+			c.SetInt64(0)
+		}
+	}()
+
+	shares := make([]KeyShare, n)
+	for i, s := range scalars {
+		shares[i] = KeyShare{Index: s.X, X: s.Y}
+	}
+	return &PublicKey{Y: modp.Exp(modp.G, x)}, shares, nil
+}
+
+// Encrypt encrypts msg to the public key. A nil rand means
+// crypto/rand.Reader.
+func (pk *PublicKey) Encrypt(rand io.Reader, msg []byte) (*Ciphertext, error) {
+	if !modp.IsElement(pk.Y) {
+		return nil, errors.New("invalid public key")
+	}
+	r, err := modp.RandomScalar(rand)
+	if err != nil {
+		return nil, err
+	}
+	c1 := modp.Exp(modp.G, r)
+	aead, err := messageAEAD(c1, modp.Exp(pk.Y, r))
+	if err != nil {
+		return nil, err
+	}
+
+	nonce := make([]byte, aead.NonceSize(), aead.NonceSize()+len(msg)+aead.Overhead())
+	if _, err := io.ReadFull(randOrDefault(rand), nonce); err != nil {
+		return nil, fmt.Errorf("nonce generation failed: %w", err)
+	}
+	return &Ciphertext{C1: c1, Sealed: aead.Seal(nonce, nonce, msg, modp.Encode(c1))}, nil
+}
+
+// PartialDecrypt computes the key share's partial decryption of ct.
+func (ks KeyShare) PartialDecrypt(ct *Ciphertext) (PartialDecryption, error) {
+	if ks.Index == 0 || ks.X == nil {
+		return PartialDecryption{}, errors.New("invalid key share")
+	}
+	if ct == nil || !modp.IsElement(ct.C1) {
+		return PartialDecryption{}, errors.New("invalid ciphertext")
+	}
+	return PartialDecryption{Index: ks.Index, D: modp.Exp(ct.C1, ks.X)}, nil
+}
+
+// Combine decrypts ct from the partial decryptions of at least threshold
+// distinct key shares. Only the first threshold partial decryptions are
+// used.
+func Combine(ct *Ciphertext, parts []PartialDecryption, threshold int) ([]byte, error) {
+	if threshold < 2 {
+		return nil, errors.New("threshold must be at least 2")
+	}
+	if len(parts) < threshold {
+		return nil, fmt.Errorf("need at least %d partial decryptions, got %d", threshold, len(parts))
+	}
+	if ct == nil || !modp.IsElement(ct.C1) {
+		return nil, errors.New("invalid ciphertext")
+	}
+
+	parts = parts[:threshold]
+	xs := make([]uint8, threshold)
+	for i, p := range parts {
+		if !modp.IsElement(p.D) {
+			return nil, fmt.Errorf("partial decryption %d is not a group element", p.Index)
+		}
+		xs[i] = p.Index
+	}
+	basis, err := modp.LagrangeAtZero(xs)
+	if err != nil {
+		return nil, err
+	}
+
+	// c1^x = prod d_i^lambda_i, since x = sum x_i * lambda_i.
+	shared := big.NewInt(1)
+	for i, p := range parts {
+		shared = modp.Mul(shared, modp.Exp(p.D, basis[i]))
+	}
+
+	aead, err := messageAEAD(ct.C1, shared)
+	if err != nil {
+		return nil, err
+	}
+	if len(ct.Sealed) < aead.NonceSize() {
+		return nil, ErrDecryption
+	}
+	nonce, sealed := ct.Sealed[:aead.NonceSize()], ct.Sealed[aead.NonceSize():]
+	msg, err := aead.Open(nil, nonce, sealed, modp.Encode(ct.C1))
+	if err != nil {
+		return nil, ErrDecryption
+	}
+	return msg, nil
+}
+
+// messageAEAD derives the AES-256-GCM cipher for a message from the
+// ephemeral element and the shared ElGamal element.
+func messageAEAD(c1, shared *big.Int) (cipher.AEAD, error) {
+	h := sha256.New()
+	h.Write([]byte("goshamir threshold elgamal"))
+	h.Write(modp.Encode(c1))
+	h.Write(modp.Encode(shared))
+	block, err := aes.NewCipher(h.Sum(nil))
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+func randOrDefault(r io.Reader) io.Reader {
+	if r == nil {
+		return rand.Reader
+	}
+	return r
+}
@@ -0,0 +1,82 @@
+package encrypt
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+)
+
+// --- Threshold Encryption Tests ---
+
+func TestThresholdEncryption(t *testing.T) {
+	pk, shares, err := GenerateKey(5, 3, nil)
+	if err != nil {
+		t.Fatalf("GenerateKey failed: %v", err)
+	}
+
+	ct, err := pk.Encrypt(nil, []byte("sealed bid: 42"))
+	if err != nil {
+		t.Fatalf("Encrypt failed: %v", err)
+	}
+
+	var parts []PartialDecryption
+	for _, i := range []int{4, 0, 2} {
+		p, err := shares[i].PartialDecrypt(ct)
+		if err != nil {
+			t.Fatalf("PartialDecrypt failed: %v", err)
+		}
+		parts = append(parts, p)
+	}
+
+	msg, err := Combine(ct, parts, 3)
+	if err != nil {
+		t.Fatalf("Combine failed: %v", err)
+	}
+	if string(msg) != "sealed bid: 42" {
+		t.Errorf("Expected %q, got %q", "sealed bid: 42", msg)
+	}
+
+	if _, err := Combine(ct, parts[:2], 3); err == nil {
+		t.Error("Expected error for too few partial decryptions")
+	}
+}
+
+func TestThresholdEncryption_Failures(t *testing.T) {
+	pk, shares, _ := GenerateKey(3, 2, nil)
+	ct, _ := pk.Encrypt(nil, []byte("message"))
+	p1, _ := shares[0].PartialDecrypt(ct)
+	p2, _ := shares[1].PartialDecrypt(ct)
+
+	// A wrong partial decryption cannot yield a wrong plaintext.
+	other, _ := pk.Encrypt(nil, []byte("other"))
+	bad, _ := shares[1].PartialDecrypt(other)
+	if _, err := Combine(ct, []PartialDecryption{p1, bad}, 2); !errors.Is(err, ErrDecryption) {
+		t.Errorf("Expected ErrDecryption for bad partial decryption, got %v", err)
+	}
+
+	if _, err := Combine(ct, []PartialDecryption{p1, p1}, 2); err == nil {
+		t.Error("Expected error for duplicate partial decryptions")
+	}
+
+	tampered := &Ciphertext{C1: ct.C1, Sealed: append([]byte(nil), ct.Sealed...)}
+	tampered.Sealed[len(tampered.Sealed)-1] ^= 1
+	if _, err := Combine(tampered, []PartialDecryption{p1, p2}, 2); !errors.Is(err, ErrDecryption) {
+		t.Errorf("Expected ErrDecryption for tampered ciphertext, got %v", err)
+	}
+
+	if _, err := shares[0].PartialDecrypt(&Ciphertext{C1: big.NewInt(1)}); err == nil {
+		t.Error("Expected error for invalid ciphertext element")
+	}
+	if _, err := shares[0].PartialDecrypt(nil); err == nil {
+		t.Error("Expected error for nil ciphertext")
+	}
+	if _, err := shares[0].PartialDecrypt(&Ciphertext{}); err == nil {
+		t.Error("Expected error for empty ciphertext")
+	}
+	if _, err := Combine(nil, []PartialDecryption{p1, p2}, 2); err == nil {
+		t.Error("Expected error for nil ciphertext")
+	}
+	if _, err := Combine(&Ciphertext{}, []PartialDecryption{p1, p2}, 2); err == nil {
+		t.Error("Expected error for empty ciphertext")
+	}
+}
@@ -0,0 +1,146 @@
+package goshamir
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+)
+
+// Tiered share layout: magic "ST", the hint threshold, then the hint and
+// secret shares as uvarint-prefixed envelopes.
+var tieredMagic = [2]byte{'S', 'T'}
+
+// TieredShare is a share of a secret together with a share of a
+// low-sensitivity hint about it, such as a key ID or a description, which
+// fewer custodians can reveal than the secret needs.
+type TieredShare struct {
+	// HintThreshold is the number of shares that reveal the hint.
+	HintThreshold int
+	// Hint is the share of the hint. With a hint threshold of 1 its value
+	// is the hint itself.
+	Hint Share
+	// Secret is the share of the secret.
+	Secret Share
+}
+
+// SplitTiered splits secret among totalShares custodians, any threshold of
+// whom can reconstruct it, and splits hint among the same custodians with
+// the lower hintThreshold. A help desk can then identify which secret a
+// customer is recovering from hintThreshold shares, with RevealHint, before
+// a full quorum is assembled. A hintThreshold of 1 gives every custodian
+// the hint in the clear. The hint and secret are split independently, so
+// the hint shares reveal nothing about the secret beyond the hint itself.
+// Options apply to both splits.
+func SplitTiered(secret, hint []byte, totalShares, hintThreshold, threshold int, opts ...Option) ([]TieredShare, error) {
+	if hintThreshold < 1 || hintThreshold >= threshold {
+		return nil, fmt.Errorf("hint threshold must be from 1 to %d, got %d", threshold-1, hintThreshold)
+	}
+	if len(hint) == 0 {
+		return nil, errors.New("hint cannot be empty")
+	}
+	s, err := NewSplitter(totalShares, threshold, opts...)
+	if err != nil {
+		return nil, err
+	}
+	shares, err := s.Split(secret)
+	if err != nil {
+		return nil, err
+	}
+
+	hints := make([]Share, totalShares)
+	if hintThreshold == 1 {
+		scheme := NewConfig(opts...).Scheme
+		for i := range hints {
+			hints[i] = Share{Index: shares[i].Index, Value: bytes.Clone(hint), Scheme: scheme}
+		}
+	} else {
+		hs, err := NewSplitter(totalShares, hintThreshold, opts...)
+		if err != nil {
+			return nil, err
+		}
+		if hints, err = hs.Split(hint); err != nil {
+			return nil, err
+		}
+	}
+
+	out := make([]TieredShare, totalShares)
+	for i := range out {
+		out[i] = TieredShare{HintThreshold: hintThreshold, Hint: hints[i], Secret: shares[i]}
+	}
+	return out, nil
+}
+
+// RevealHint reconstructs the hint from at least the hint threshold of
+// tiered shares.
+func RevealHint(shares []TieredShare) ([]byte, error) {
+	if len(shares) == 0 {
+		return nil, errors.New("shares cannot be empty")
+	}
+	threshold := shares[0].HintThreshold
+	for _, s := range shares {
+		if s.HintThreshold != threshold {
+			return nil, errors.New("tiered shares have different hint thresholds")
+		}
+	}
+	if threshold == 1 {
+		return bytes.Clone(shares[0].Hint.Value), nil
+	}
+	hints := make([]Share, len(shares))
+	for i, s := range shares {
+		hints[i] = s.Hint
+	}
+	return Combine(hints, threshold)
+}
+
+// CombineTiered reconstructs the secret from at least threshold tiered
+// shares.
+func CombineTiered(shares []TieredShare, threshold int) ([]byte, error) {
+	secrets := make([]Share, len(shares))
+	for i, s := range shares {
+		secrets[i] = s.Secret
+	}
+	return Combine(secrets, threshold)
+}
+
+// MarshalBinary encodes the tiered share as a single artifact holding the
+// envelopes of its hint and secret shares.
+func (t TieredShare) MarshalBinary() ([]byte, error) {
+	if t.HintThreshold < 1 || t.HintThreshold >= MaxShares {
+		return nil, fmt.Errorf("invalid hint threshold %d", t.HintThreshold)
+	}
+	buf := []byte{tieredMagic[0], tieredMagic[1], byte(t.HintThreshold)}
+	for _, s := range []Share{t.Hint, t.Secret} {
+		env, err := s.MarshalBinary()
+		if err != nil {
+			return nil, err
+		}
+		buf = binary.AppendUvarint(buf, uint64(len(env)))
+		buf = append(buf, env...)
+	}
+	return buf, nil
+}
+
+// UnmarshalBinary decodes a tiered share produced by MarshalBinary.
+func (t *TieredShare) UnmarshalBinary(data []byte) error {
+	if len(data) < 3 || data[0] != tieredMagic[0] || data[1] != tieredMagic[1] || data[2] == 0 {
+		return ErrInvalidEnvelope
+	}
+	var shares [2]Share
+	rest := data[3:]
+	for i := range shares {
+		n, size := binary.Uvarint(rest)
+		if size <= 0 || n > uint64(len(rest)-size) {
+			return ErrInvalidEnvelope
+		}
+		if err := shares[i].UnmarshalBinary(rest[size : size+int(n)]); err != nil {
+			return err
+		}
+		rest = rest[size+int(n):]
+	}
+	if len(rest) != 0 {
+		return ErrInvalidEnvelope
+	}
+	*t = TieredShare{HintThreshold: int(data[2]), Hint: shares[0], Secret: shares[1]}
+	return nil
+}
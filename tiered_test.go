@@ -0,0 +1,83 @@
+package goshamir
+
+import (
+	"bytes"
+	"testing"
+)
+
+// --- Tiered Share Tests ---
+
+func TestSplitTiered(t *testing.T) {
+	secret := []byte("customer master key")
+	hint := []byte("key-id: cust-4411")
+	shares, err := SplitTiered(secret, hint, 5, 2, 4)
+	if err != nil {
+		t.Fatalf("SplitTiered failed: %v", err)
+	}
+
+	got, err := RevealHint(shares[3:5])
+	if err != nil {
+		t.Fatalf("RevealHint failed: %v", err)
+	}
+	if !bytes.Equal(got, hint) {
+		t.Fatalf("Expected hint %q, got %q", hint, got)
+	}
+	if _, err := RevealHint(shares[:1]); err == nil {
+		t.Fatal("expected error revealing the hint from one share")
+	}
+	if _, err := CombineTiered(shares[:3], 4); err == nil {
+		t.Fatal("expected error combining below the threshold")
+	}
+	got, err = CombineTiered(shares[1:], 4)
+	if err != nil {
+		t.Fatalf("CombineTiered failed: %v", err)
+	}
+	if !bytes.Equal(got, secret) {
+		t.Fatalf("Expected %q, got %q", secret, got)
+	}
+}
+
+func TestSplitTiered_HintThresholdOne(t *testing.T) {
+	shares, err := SplitTiered([]byte("secret"), []byte("payroll"), 3, 1, 2, WithScheme(SchemeV2GF256))
+	if err != nil {
+		t.Fatalf("SplitTiered failed: %v", err)
+	}
+	if got, err := RevealHint(shares[2:]); err != nil || string(got) != "payroll" {
+		t.Fatalf("RevealHint = %q, %v", got, err)
+	}
+}
+
+func TestTieredShare_MarshalBinary(t *testing.T) {
+	shares, _ := SplitTiered([]byte("secret"), []byte("hint"), 3, 2, 3)
+	var decoded []TieredShare
+	for _, s := range shares {
+		data, err := s.MarshalBinary()
+		if err != nil {
+			t.Fatalf("MarshalBinary failed: %v", err)
+		}
+		var d TieredShare
+		if err := d.UnmarshalBinary(data); err != nil {
+			t.Fatalf("UnmarshalBinary failed: %v", err)
+		}
+		decoded = append(decoded, d)
+	}
+	if got, err := CombineTiered(decoded, 3); err != nil || string(got) != "secret" {
+		t.Fatalf("CombineTiered = %q, %v", got, err)
+	}
+	data, _ := shares[0].MarshalBinary()
+	var d TieredShare
+	if err := d.UnmarshalBinary(data[:len(data)-1]); err == nil {
+		t.Fatal("expected error for truncated share")
+	}
+}
+
+func TestSplitTiered_Invalid(t *testing.T) {
+	for _, hintThreshold := range []int{0, 3, 4} {
+		if _, err := SplitTiered([]byte("s"), []byte("h"), 5, hintThreshold, 3); err == nil {
+			t.Errorf("expected error for hint threshold %d", hintThreshold)
+		}
+	}
+	if _, err := SplitTiered([]byte("s"), nil, 5, 2, 3); err == nil {
+		t.Error("expected error for empty hint")
+	}
+}
@@ -0,0 +1,104 @@
+package timelock
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+	"time"
+
+	goshamir "github.com/fawwazid/go-shamir"
+)
+
+// fakeTle is a shell script emulating tle, prefixing ciphertexts with their
+// round and refusing to decrypt rounds after $FAKE_TLE_ROUND.
+const fakeTle = `#!/bin/sh
+case $1 in
+--encrypt) echo "$3"; cat ;;
+--decrypt)
+	tmp=$(mktemp); cat > "$tmp"
+	round=$(head -n 1 "$tmp")
+	if [ "$round" -gt "$FAKE_TLE_ROUND" ]; then
+		echo "too early to decrypt" >&2; rm "$tmp"; exit 1
+	fi
+	tail -n +2 "$tmp"; rm "$tmp" ;;
+esac
+`
+
+func newFakeTle(t *testing.T) DrandSealer {
+	t.Helper()
+	if runtime.GOOS == "windows" {
+		t.Skip("fake tle is a shell script")
+	}
+	tool := filepath.Join(t.TempDir(), "tle")
+	if err := os.WriteFile(tool, []byte(fakeTle), 0o700); err != nil {
+		t.Fatalf("WriteFile failed: %v", err)
+	}
+	return DrandSealer{Command: tool}
+}
+
+// --- Drand Tests ---
+
+func TestDrand_EmergencyShare(t *testing.T) {
+	ctx := context.Background()
+	drand := newFakeTle(t)
+	secret := []byte("break glass")
+
+	shares, err := goshamir.Split(secret, 3, 2)
+	if err != nil {
+		t.Fatalf("Split failed: %v", err)
+	}
+	emergency, err := SealShare(ctx, shares[2], 1000, drand)
+	if err != nil {
+		t.Fatalf("SealShare failed: %v", err)
+	}
+
+	t.Setenv("FAKE_TLE_ROUND", "999")
+	if _, err := Open(ctx, []Share{emergency}, drand); err != nil {
+		t.Fatalf("Open failed: %v", err)
+	}
+	if _, err := drand.Open(ctx, emergency.Epoch, emergency.Ciphertext); !errors.Is(err, ErrTooEarly) {
+		t.Fatalf("expected ErrTooEarly, got %v", err)
+	}
+
+	t.Setenv("FAKE_TLE_ROUND", "1000")
+	opened, err := Open(ctx, []Share{emergency}, drand)
+	if err != nil || len(opened) != 1 {
+		t.Fatalf("Open failed: %v", err)
+	}
+	got, err := goshamir.Combine([]goshamir.Share{shares[0], opened[0]}, 2)
+	if err != nil {
+		t.Fatalf("Combine failed: %v", err)
+	}
+	if !bytes.Equal(got, secret) {
+		t.Fatalf("Combine = %q, want %q", got, secret)
+	}
+}
+
+func TestDrand_Rounds(t *testing.T) {
+	d := DrandSealer{}
+	genesis := time.Unix(DrandQuicknetGenesis, 0)
+	if got := d.RoundAt(genesis); got != 1 {
+		t.Fatalf("RoundAt(genesis) = %d, want 1", got)
+	}
+	if got := d.RoundAt(genesis.Add(4 * time.Second)); got != 3 {
+		t.Fatalf("RoundAt = %d, want 3", got)
+	}
+	if got := d.RoundTime(3); !got.Equal(genesis.Add(6 * time.Second)) {
+		t.Fatalf("RoundTime = %v", got)
+	}
+	delay := genesis.Add(72 * time.Hour)
+	if got := d.RoundTime(d.RoundAt(delay)); got.Before(delay) {
+		t.Fatalf("round for %v is published at %v", delay, got)
+	}
+}
+
+func TestDrand_MissingCommand(t *testing.T) {
+	d := DrandSealer{Command: filepath.Join(t.TempDir(), "missing")}
+	if _, err := d.Seal(context.Background(), 1, []byte("x")); err == nil || errors.Is(err, ErrTooEarly) {
+		t.Fatalf("expected command failure, got %v", err)
+	}
+}
@@ -0,0 +1,343 @@
+// Package timelock splits a secret into shares that become usable at
+// different epochs, for embargoed releases: each share is encrypted under
+// the key of an epoch, which a timelock service publishes only once the
+// epoch has begun. Until then the share is useless, so the schedule, not
+// the custodians, decides when a quorum can first form.
+//
+// The dealer needs the epoch keys, or the service's public parameters, at
+// split time. With Schedule the service derives every epoch key from a
+// master key and refuses to release future ones; other services, such as a
+// public randomness beacon, plug in through the Sealer interface.
+package timelock
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/hkdf"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	goshamir "github.com/fawwazid/go-shamir"
+)
+
+// ErrTooEarly is returned when an epoch's key has not been released yet.
+var ErrTooEarly = errors.New("epoch key not released yet")
+
+// Sealer encrypts data so that it can only be decrypted once an epoch has
+// been reached.
+type Sealer interface {
+	Seal(ctx context.Context, epoch uint64, plaintext []byte) ([]byte, error)
+	// Open returns an error wrapping ErrTooEarly before the epoch.
+	Open(ctx context.Context, epoch uint64, ciphertext []byte) ([]byte, error)
+}
+
+// Share is a share sealed until an epoch.
+type Share struct {
+	Epoch      uint64 `json:"epoch"`
+	Index      uint8  `json:"index"`
+	Ciphertext []byte `json:"ciphertext"`
+}
+
+// Split splits secret into one share per entry of schedule, threshold of
+// which reconstruct it, and seals share i until epoch schedule[i]. The
+// secret first becomes recoverable at ReleaseEpoch(schedule, threshold).
+func Split(ctx context.Context, secret []byte, threshold int, schedule []uint64, sealer Sealer, opts ...goshamir.Option) ([]Share, error) {
+	splitter, err := goshamir.NewSplitter(len(schedule), threshold, opts...)
+	if err != nil {
+		return nil, err
+	}
+	shares, err := splitter.Split(secret)
+	if err != nil {
+		return nil, err
+	}
+	sealed := make([]Share, len(shares))
+	for i, s := range shares {
+		sealed[i], err = SealShare(ctx, s, schedule[i], sealer)
+		clear(s.Value)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return sealed, nil
+}
+
+// SealShare seals an existing share until epoch, such as an emergency
+// share that lets a reduced quorum recover the secret once a delay has
+// passed.
+func SealShare(ctx context.Context, share goshamir.Share, epoch uint64, sealer Sealer) (Share, error) {
+	envelope, err := share.MarshalBinary()
+	if err != nil {
+		return Share{}, err
+	}
+	defer clear(envelope)
+	ct, err := sealer.Seal(ctx, epoch, envelope)
+	if err != nil {
+		return Share{}, fmt.Errorf("sealing share %d: %w", share.Index, err)
+	}
+	return Share{Epoch: epoch, Index: share.Index, Ciphertext: ct}, nil
+}
+
+// ReleaseEpoch returns the first epoch at which threshold shares of a split
+// with the given schedule are usable.
+func ReleaseEpoch(schedule []uint64, threshold int) (uint64, error) {
+	if threshold < goshamir.MinThreshold || threshold > len(schedule) {
+		return 0, fmt.Errorf("invalid threshold %d for %d shares", threshold, len(schedule))
+	}
+	sorted := slices.Sorted(slices.Values(schedule))
+	return sorted[threshold-1], nil
+}
+
+// Open unseals the shares whose epoch has been reached, skipping the
+// others.
+func Open(ctx context.Context, shares []Share, sealer Sealer) ([]goshamir.Share, error) {
+	var opened []goshamir.Share
+	for _, s := range shares {
+		envelope, err := sealer.Open(ctx, s.Epoch, s.Ciphertext)
+		if errors.Is(err, ErrTooEarly) {
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("opening share %d: %w", s.Index, err)
+		}
+		var share goshamir.Share
+		err = share.UnmarshalBinary(envelope)
+		clear(envelope)
+		if err != nil {
+			return nil, fmt.Errorf("opening share %d: %w", s.Index, err)
+		}
+		if share.Index != s.Index {
+			return nil, fmt.Errorf("share %d holds index %d", s.Index, share.Index)
+		}
+		opened = append(opened, share)
+	}
+	return opened, nil
+}
+
+// Combine unseals the shares whose epoch has been reached and reconstructs
+// the secret. Before enough epochs have passed it returns an error
+// wrapping ErrTooEarly.
+func Combine(ctx context.Context, shares []Share, threshold int, sealer Sealer) ([]byte, error) {
+	opened, err := Open(ctx, shares, sealer)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		for _, s := range opened {
+			clear(s.Value)
+		}
+	}()
+	if len(opened) < threshold {
+		return nil, fmt.Errorf("%w: %d of %d needed shares usable", ErrTooEarly, len(opened), threshold)
+	}
+	return goshamir.Combine(opened, threshold)
+}
+
+// EpochKeys provides the secret key of each epoch, returning an error
+// wrapping ErrTooEarly for epochs not released yet.
+type EpochKeys interface {
+	EpochKey(ctx context.Context, epoch uint64) ([]byte, error)
+}
+
+// KeySealer is a Sealer encrypting with AES-256-GCM under a key derived
+// from the epoch key.
+type KeySealer struct {
+	Keys EpochKeys
+}
+
+// Seal implements Sealer. The dealer must be able to obtain future epoch
+// keys, as with Schedule.Dealer.
+func (s KeySealer) Seal(ctx context.Context, epoch uint64, plaintext []byte) ([]byte, error) {
+	aead, err := s.aead(ctx, epoch)
+	if err != nil {
+		return nil, err
+	}
+	nonce := make([]byte, aead.NonceSize(), aead.NonceSize()+len(plaintext)+aead.Overhead())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, err
+	}
+	return aead.Seal(nonce, nonce, plaintext, epochAD(epoch)), nil
+}
+
+// Open implements Sealer.
+func (s KeySealer) Open(ctx context.Context, epoch uint64, ciphertext []byte) ([]byte, error) {
+	aead, err := s.aead(ctx, epoch)
+	if err != nil {
+		return nil, err
+	}
+	if len(ciphertext) < aead.NonceSize() {
+		return nil, errors.New("ciphertext too short")
+	}
+	nonce, ct := ciphertext[:aead.NonceSize()], ciphertext[aead.NonceSize():]
+	plaintext, err := aead.Open(nil, nonce, ct, epochAD(epoch))
+	if err != nil {
+		return nil, errors.New("decryption failed: wrong epoch key or corrupt share")
+	}
+	return plaintext, nil
+}
+
+func (s KeySealer) aead(ctx context.Context, epoch uint64) (cipher.AEAD, error) {
+	key, err := s.Keys.EpochKey(ctx, epoch)
+	if err != nil {
+		return nil, err
+	}
+	defer clear(key)
+	derived, err := hkdf.Key(sha256.New, key, nil, "goshamir timelock share", 32)
+	if err != nil {
+		return nil, err
+	}
+	defer clear(derived)
+	block, err := aes.NewCipher(derived)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+func epochAD(epoch uint64) []byte {
+	return binary.BigEndian.AppendUint64([]byte("goshamir timelock epoch"), epoch)
+}
+
+// Schedule is the key service of a timelock: epoch n begins at
+// Genesis + n*Period, and its key, an HMAC-SHA256 of n under Master, is
+// released once it has begun. Serve it over HTTP with ServeHTTP and fetch
+// released keys with HTTPKeys.
+type Schedule struct {
+	Master  []byte
+	Genesis time.Time
+	Period  time.Duration
+	// Now returns the current time. Defaults to time.Now.
+	Now func() time.Time
+}
+
+// EpochAt returns the epoch in progress at t, or 0 before Genesis.
+func (s *Schedule) EpochAt(t time.Time) uint64 {
+	if t.Before(s.Genesis) || s.Period <= 0 {
+		return 0
+	}
+	return uint64(t.Sub(s.Genesis) / s.Period)
+}
+
+// Start returns the time at which epoch begins.
+func (s *Schedule) Start(epoch uint64) time.Time {
+	return s.Genesis.Add(time.Duration(epoch) * s.Period)
+}
+
+// EpochKey implements EpochKeys, refusing epochs that have not begun.
+func (s *Schedule) EpochKey(_ context.Context, epoch uint64) ([]byte, error) {
+	if s.now().Before(s.Start(epoch)) {
+		return nil, fmt.Errorf("%w: epoch %d begins at %s", ErrTooEarly, epoch, s.Start(epoch).Format(time.RFC3339))
+	}
+	return s.FutureKey(epoch)
+}
+
+func (s *Schedule) now() time.Time {
+	if s.Now != nil {
+		return s.Now()
+	}
+	return time.Now()
+}
+
+// FutureKey returns the key of any epoch, released or not, for the dealer
+// to seal shares with. It must not be exposed to custodians.
+func (s *Schedule) FutureKey(epoch uint64) ([]byte, error) {
+	if len(s.Master) < 16 {
+		return nil, errors.New("schedule master key must be at least 16 bytes")
+	}
+	if s.Period <= 0 {
+		return nil, errors.New("schedule period must be positive")
+	}
+	mac := hmac.New(sha256.New, s.Master)
+	mac.Write(binary.BigEndian.AppendUint64([]byte("goshamir timelock key"), epoch))
+	return mac.Sum(nil), nil
+}
+
+// Dealer returns EpochKeys serving the key of every epoch, released or
+// not, for sealing shares with KeySealer.
+func (s *Schedule) Dealer() EpochKeys {
+	return dealerKeys{s}
+}
+
+type dealerKeys struct{ s *Schedule }
+
+func (d dealerKeys) EpochKey(_ context.Context, epoch uint64) ([]byte, error) {
+	return d.s.FutureKey(epoch)
+}
+
+// ServeHTTP publishes released epoch keys: GET /{epoch} returns the key in
+// hex, or 425 Too Early before the epoch begins.
+func (s *Schedule) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	epoch, err := strconv.ParseUint(strings.TrimPrefix(r.URL.Path, "/"), 10, 64)
+	if err != nil {
+		http.Error(w, "invalid epoch", http.StatusBadRequest)
+		return
+	}
+	key, err := s.EpochKey(r.Context(), epoch)
+	if errors.Is(err, ErrTooEarly) {
+		w.Header().Set("Retry-After", strconv.Itoa(int(s.Start(epoch).Sub(s.now()).Seconds())+1))
+		http.Error(w, err.Error(), http.StatusTooEarly)
+		return
+	}
+	if err != nil {
+		http.Error(w, "key unavailable", http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Cache-Control", "public, max-age=31536000, immutable")
+	io.WriteString(w, hex.EncodeToString(key))
+}
+
+// HTTPKeys fetches released epoch keys from a Schedule served over HTTP.
+type HTTPKeys struct {
+	// URL is the base URL of the service; keys are fetched from URL/{epoch}.
+	URL string
+	// Client defaults to http.DefaultClient.
+	Client *http.Client
+}
+
+// EpochKey implements EpochKeys.
+func (k HTTPKeys) EpochKey(ctx context.Context, epoch uint64) ([]byte, error) {
+	client := k.Client
+	if client == nil {
+		client = http.DefaultClient
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, strings.TrimSuffix(k.URL, "/")+"/"+strconv.FormatUint(epoch, 10), nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	body, err := io.ReadAll(io.LimitReader(resp.Body, 1024))
+	if err != nil {
+		return nil, err
+	}
+	switch resp.StatusCode {
+	case http.StatusOK:
+	case http.StatusTooEarly:
+		return nil, fmt.Errorf("%w: epoch %d", ErrTooEarly, epoch)
+	default:
+		return nil, fmt.Errorf("fetching epoch %d key: %s", epoch, resp.Status)
+	}
+	key, err := hex.DecodeString(strings.TrimSpace(string(body)))
+	if err != nil || len(key) != sha256.Size {
+		return nil, fmt.Errorf("invalid key for epoch %d", epoch)
+	}
+	return key, nil
+}
@@ -0,0 +1,110 @@
+package timelock
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+// --- Timelock Tests ---
+
+func newTestSchedule(now *time.Time) *Schedule {
+	return &Schedule{
+		Master:  bytes.Repeat([]byte{7}, 32),
+		Genesis: time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC),
+		Period:  24 * time.Hour,
+		Now:     func() time.Time { return *now },
+	}
+}
+
+func TestTimelock_ReleaseOverEpochs(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	sched := newTestSchedule(&now)
+	secret := []byte("embargoed until friday")
+	schedule := []uint64{1, 3, 3, 5}
+
+	shares, err := Split(ctx, secret, 2, schedule, KeySealer{Keys: sched.Dealer()})
+	if err != nil {
+		t.Fatalf("Split failed: %v", err)
+	}
+	release, err := ReleaseEpoch(schedule, 2)
+	if err != nil {
+		t.Fatalf("ReleaseEpoch failed: %v", err)
+	}
+	if release != 3 {
+		t.Fatalf("release epoch = %d, want 3", release)
+	}
+
+	custodian := KeySealer{Keys: sched}
+	for _, epoch := range []uint64{0, 1, 2} {
+		now = sched.Start(epoch)
+		if _, err := Combine(ctx, shares, 2, custodian); !errors.Is(err, ErrTooEarly) {
+			t.Fatalf("epoch %d: expected ErrTooEarly, got %v", epoch, err)
+		}
+	}
+
+	now = sched.Start(3)
+	got, err := Combine(ctx, shares, 2, custodian)
+	if err != nil {
+		t.Fatalf("Combine failed: %v", err)
+	}
+	if !bytes.Equal(got, secret) {
+		t.Fatalf("Combine = %q, want %q", got, secret)
+	}
+}
+
+func TestTimelock_HTTPKeys(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2026, 1, 3, 0, 0, 0, 0, time.UTC)
+	sched := newTestSchedule(&now)
+	srv := httptest.NewServer(sched)
+	defer srv.Close()
+	keys := HTTPKeys{URL: srv.URL}
+
+	key, err := keys.EpochKey(ctx, 2)
+	if err != nil {
+		t.Fatalf("EpochKey failed: %v", err)
+	}
+	want, _ := sched.FutureKey(2)
+	if !bytes.Equal(key, want) {
+		t.Fatal("served key does not match schedule")
+	}
+	if _, err := keys.EpochKey(ctx, 3); !errors.Is(err, ErrTooEarly) {
+		t.Fatalf("expected ErrTooEarly, got %v", err)
+	}
+}
+
+func TestTimelock_EpochAt(t *testing.T) {
+	now := time.Time{}
+	sched := newTestSchedule(&now)
+	if got := sched.EpochAt(sched.Genesis.Add(-time.Hour)); got != 0 {
+		t.Fatalf("EpochAt before genesis = %d, want 0", got)
+	}
+	if got := sched.EpochAt(sched.Start(4).Add(time.Hour)); got != 4 {
+		t.Fatalf("EpochAt = %d, want 4", got)
+	}
+}
+
+func TestTimelock_WrongEpochRejected(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2027, 1, 1, 0, 0, 0, 0, time.UTC)
+	sched := newTestSchedule(&now)
+	shares, err := Split(ctx, []byte("secret"), 2, []uint64{1, 2, 3}, KeySealer{Keys: sched.Dealer()})
+	if err != nil {
+		t.Fatalf("Split failed: %v", err)
+	}
+	shares[0].Epoch = 2
+	if _, err := Open(ctx, shares, KeySealer{Keys: sched}); err == nil {
+		t.Fatal("expected error for share relabelled to another epoch")
+	}
+}
+
+func TestTimelock_ReleaseEpochInvalidThreshold(t *testing.T) {
+	if _, err := ReleaseEpoch([]uint64{1, 2}, 3); err == nil {
+		t.Fatal("expected error for threshold above share count")
+	}
+}
@@ -0,0 +1,370 @@
+package goshamir
+
+import (
+	"bytes"
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"net/http"
+	"time"
+)
+
+// maxTimestampResponse bounds the size of a TSA response.
+const maxTimestampResponse = 1 << 20
+
+var (
+	oidSHA256        = asn1.ObjectIdentifier{2, 16, 840, 1, 101, 3, 4, 2, 1}
+	oidSHA384        = asn1.ObjectIdentifier{2, 16, 840, 1, 101, 3, 4, 2, 2}
+	oidSHA512        = asn1.ObjectIdentifier{2, 16, 840, 1, 101, 3, 4, 2, 3}
+	oidSignedData    = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 7, 2}
+	oidTSTInfo       = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 9, 16, 1, 4}
+	oidContentType   = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 9, 3}
+	oidMessageDigest = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 9, 4}
+)
+
+// ErrTimestampInvalid is returned when a timestamp token does not verify.
+var ErrTimestampInvalid = errors.New("invalid timestamp token")
+
+// Timestamp is a verified RFC 3161 timestamp token.
+type Timestamp struct {
+	// Time is the time the TSA asserts the data existed at.
+	Time         time.Time
+	SerialNumber *big.Int
+	Policy       asn1.ObjectIdentifier
+	// Token is the DER-encoded token, suitable for storing alongside the
+	// timestamped data, for example in SealedBundle.TimestampToken.
+	Token []byte
+}
+
+// TimestampAuthority is an RFC 3161 time-stamping authority reachable over
+// HTTP. Timestamping a share set's Merkle root proves when the custody
+// arrangement was created.
+type TimestampAuthority struct {
+	// URL is the TSA endpoint.
+	URL string
+	// Client is the HTTP client to use. Defaults to http.DefaultClient.
+	Client *http.Client
+	// Roots verifies the TSA's certificate chain. Nil means the system
+	// roots.
+	Roots *x509.CertPool
+}
+
+// Timestamp obtains and verifies a timestamp token over the SHA-256 digest
+// of data, such as a ShareMerkleTree root or a share fingerprint.
+func (a *TimestampAuthority) Timestamp(ctx context.Context, data []byte) (*Timestamp, error) {
+	nonce, err := rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), 64))
+	if err != nil {
+		return nil, fmt.Errorf("nonce generation failed: %w", err)
+	}
+	digest := sha256.Sum256(data)
+	req, err := asn1.Marshal(timeStampReq{
+		Version:        1,
+		MessageImprint: messageImprint{HashAlgorithm: pkix.AlgorithmIdentifier{Algorithm: oidSHA256, Parameters: asn1.NullRawValue}, HashedMessage: digest[:]},
+		Nonce:          nonce,
+		CertReq:        true,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, a.URL, bytes.NewReader(req))
+	if err != nil {
+		return nil, err
+	}
+	httpReq.Header.Set("Content-Type", "application/timestamp-query")
+	client := a.Client
+	if client == nil {
+		client = http.DefaultClient
+	}
+	resp, err := client.Do(httpReq)
+	if err != nil {
+		return nil, fmt.Errorf("timestamp request failed: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("timestamp request failed: %s", resp.Status)
+	}
+	body, err := io.ReadAll(io.LimitReader(resp.Body, maxTimestampResponse))
+	if err != nil {
+		return nil, fmt.Errorf("reading timestamp response failed: %w", err)
+	}
+
+	var tsResp timeStampResp
+	if rest, err := asn1.Unmarshal(body, &tsResp); err != nil || len(rest) != 0 {
+		return nil, errors.New("malformed timestamp response")
+	}
+	// 0 is granted and 1 granted with modifications.
+	if tsResp.Status.Status > 1 {
+		return nil, fmt.Errorf("timestamp request rejected with status %d", tsResp.Status.Status)
+	}
+	if len(tsResp.Token.FullBytes) == 0 {
+		return nil, errors.New("timestamp response has no token")
+	}
+
+	ts, info, err := verifyTimestamp(tsResp.Token.FullBytes, data, a.Roots)
+	if err != nil {
+		return nil, err
+	}
+	if info.Nonce == nil || info.Nonce.Cmp(nonce) != 0 {
+		return nil, fmt.Errorf("%w: nonce mismatch", ErrTimestampInvalid)
+	}
+	return ts, nil
+}
+
+// VerifyTimestamp checks that token is a timestamp over data signed by a TSA
+// whose certificate chains to roots (nil means the system roots) and is
+// authorized for time stamping.
+func VerifyTimestamp(token, data []byte, roots *x509.CertPool) (*Timestamp, error) {
+	ts, _, err := verifyTimestamp(token, data, roots)
+	return ts, err
+}
+
+func verifyTimestamp(token, data []byte, roots *x509.CertPool) (*Timestamp, *tstInfo, error) {
+	invalid := func(reason string) error { return fmt.Errorf("%w: %s", ErrTimestampInvalid, reason) }
+
+	var ci contentInfo
+	if rest, err := asn1.Unmarshal(token, &ci); err != nil || len(rest) != 0 || !ci.ContentType.Equal(oidSignedData) {
+		return nil, nil, invalid("not a CMS signed-data structure")
+	}
+	var sd signedData
+	// RawValue keeps the explicit [0] wrapper; its contents are the
+	// SignedData.
+	if _, err := asn1.Unmarshal(ci.Content.Bytes, &sd); err != nil {
+		return nil, nil, invalid("malformed signed data")
+	}
+	if !sd.EncapContentInfo.EContentType.Equal(oidTSTInfo) || len(sd.SignerInfos) != 1 {
+		return nil, nil, invalid("unexpected signed content")
+	}
+	content := sd.EncapContentInfo.EContent
+
+	var info tstInfo
+	if _, err := asn1.Unmarshal(content, &info); err != nil {
+		return nil, nil, invalid("malformed TSTInfo")
+	}
+	imprintHash, err := hashForOID(info.MessageImprint.HashAlgorithm.Algorithm)
+	if err != nil {
+		return nil, nil, invalid(err.Error())
+	}
+	h := imprintHash.New()
+	h.Write(data)
+	if !bytes.Equal(h.Sum(nil), info.MessageImprint.HashedMessage) {
+		return nil, nil, invalid("message imprint does not match data")
+	}
+
+	certs, err := x509.ParseCertificates(sd.Certificates.Bytes)
+	if err != nil || len(certs) == 0 {
+		return nil, nil, invalid("token carries no TSA certificate")
+	}
+	si := sd.SignerInfos[0]
+	signer, err := findSigner(certs, si.SID)
+	if err != nil {
+		return nil, nil, invalid(err.Error())
+	}
+	if err := verifySignerInfo(signer, si, content); err != nil {
+		return nil, nil, invalid(err.Error())
+	}
+
+	intermediates := x509.NewCertPool()
+	for _, c := range certs {
+		intermediates.AddCert(c)
+	}
+	if _, err := signer.Verify(x509.VerifyOptions{
+		Roots:         roots,
+		Intermediates: intermediates,
+		CurrentTime:   info.GenTime,
+		KeyUsages:     []x509.ExtKeyUsage{x509.ExtKeyUsageTimeStamping},
+	}); err != nil {
+		return nil, nil, invalid(err.Error())
+	}
+
+	return &Timestamp{
+		Time:         info.GenTime,
+		SerialNumber: info.SerialNumber,
+		Policy:       info.Policy,
+		Token:        append([]byte(nil), token...),
+	}, &info, nil
+}
+
+// verifySignerInfo checks the signed attributes of si against content and
+// the signature over them against the signer's certificate.
+func verifySignerInfo(signer *x509.Certificate, si signerInfo, content []byte) error {
+	if len(si.SignedAttrs.Bytes) == 0 {
+		return errors.New("signer info has no signed attributes")
+	}
+	digestHash, err := hashForOID(si.DigestAlgorithm.Algorithm)
+	if err != nil {
+		return err
+	}
+
+	// The signature covers the attributes DER-encoded as a SET, not with
+	// the implicit [0] tag they are transmitted with.
+	attrsDER := append([]byte(nil), si.SignedAttrs.FullBytes...)
+	attrsDER[0] = 0x31
+	var attrs []attribute
+	if _, err := asn1.UnmarshalWithParams(attrsDER, &attrs, "set"); err != nil {
+		return errors.New("malformed signed attributes")
+	}
+	var digest []byte
+	var contentTypeOK bool
+	for _, a := range attrs {
+		switch {
+		case a.Type.Equal(oidMessageDigest):
+			if _, err := asn1.Unmarshal(a.Values.Bytes, &digest); err != nil {
+				return errors.New("malformed message digest attribute")
+			}
+		case a.Type.Equal(oidContentType):
+			var ct asn1.ObjectIdentifier
+			_, err := asn1.Unmarshal(a.Values.Bytes, &ct)
+			contentTypeOK = err == nil && ct.Equal(oidTSTInfo)
+		}
+	}
+	h := digestHash.New()
+	h.Write(content)
+	if !contentTypeOK || !bytes.Equal(digest, h.Sum(nil)) {
+		return errors.New("signed attributes do not match content")
+	}
+
+	var alg x509.SignatureAlgorithm
+	switch signer.PublicKey.(type) {
+	case *ecdsa.PublicKey:
+		alg = map[crypto.Hash]x509.SignatureAlgorithm{crypto.SHA256: x509.ECDSAWithSHA256, crypto.SHA384: x509.ECDSAWithSHA384, crypto.SHA512: x509.ECDSAWithSHA512}[digestHash]
+	case *rsa.PublicKey:
+		alg = map[crypto.Hash]x509.SignatureAlgorithm{crypto.SHA256: x509.SHA256WithRSA, crypto.SHA384: x509.SHA384WithRSA, crypto.SHA512: x509.SHA512WithRSA}[digestHash]
+	case ed25519.PublicKey:
+		alg = x509.PureEd25519
+	default:
+		return errors.New("unsupported TSA key type")
+	}
+	if err := signer.CheckSignature(alg, attrsDER, si.Signature); err != nil {
+		return errors.New("signature does not verify")
+	}
+	return nil
+}
+
+// findSigner returns the certificate identified by a SignerIdentifier.
+func findSigner(certs []*x509.Certificate, sid asn1.RawValue) (*x509.Certificate, error) {
+	if sid.Class == asn1.ClassContextSpecific && sid.Tag == 0 {
+		for _, c := range certs {
+			if bytes.Equal(c.SubjectKeyId, sid.Bytes) {
+				return c, nil
+			}
+		}
+		return nil, errors.New("signer certificate not found")
+	}
+	var ias issuerAndSerial
+	if _, err := asn1.Unmarshal(sid.FullBytes, &ias); err != nil {
+		return nil, errors.New("malformed signer identifier")
+	}
+	for _, c := range certs {
+		if bytes.Equal(c.RawIssuer, ias.Issuer.FullBytes) && c.SerialNumber.Cmp(ias.Serial) == 0 {
+			return c, nil
+		}
+	}
+	return nil, errors.New("signer certificate not found")
+}
+
+func hashForOID(oid asn1.ObjectIdentifier) (crypto.Hash, error) {
+	switch {
+	case oid.Equal(oidSHA256):
+		return crypto.SHA256, nil
+	case oid.Equal(oidSHA384):
+		return crypto.SHA384, nil
+	case oid.Equal(oidSHA512):
+		return crypto.SHA512, nil
+	}
+	return 0, fmt.Errorf("unsupported hash algorithm %v", oid)
+}
+
+// ASN.1 structures of RFC 3161 and RFC 5652.
+
+type messageImprint struct {
+	HashAlgorithm pkix.AlgorithmIdentifier
+	HashedMessage []byte
+}
+
+type timeStampReq struct {
+	Version        int
+	MessageImprint messageImprint
+	Nonce          *big.Int `asn1:"optional"`
+	CertReq        bool     `asn1:"optional,default:false"`
+}
+
+type pkiStatusInfo struct {
+	Status       int
+	StatusString []string       `asn1:"optional"`
+	FailInfo     asn1.BitString `asn1:"optional"`
+}
+
+type timeStampResp struct {
+	Status pkiStatusInfo
+	Token  asn1.RawValue `asn1:"optional"`
+}
+
+type contentInfo struct {
+	ContentType asn1.ObjectIdentifier
+	Content     asn1.RawValue `asn1:"explicit,tag:0"`
+}
+
+type encapContentInfo struct {
+	EContentType asn1.ObjectIdentifier
+	EContent     []byte `asn1:"explicit,optional,tag:0"`
+}
+
+type signedData struct {
+	Version          int
+	DigestAlgorithms []pkix.AlgorithmIdentifier `asn1:"set"`
+	EncapContentInfo encapContentInfo
+	Certificates     asn1.RawValue `asn1:"optional,tag:0"`
+	CRLs             asn1.RawValue `asn1:"optional,tag:1"`
+	SignerInfos      []signerInfo  `asn1:"set"`
+}
+
+type signerInfo struct {
+	Version            int
+	SID                asn1.RawValue
+	DigestAlgorithm    pkix.AlgorithmIdentifier
+	SignedAttrs        asn1.RawValue `asn1:"optional,tag:0"`
+	SignatureAlgorithm pkix.AlgorithmIdentifier
+	Signature          []byte
+	UnsignedAttrs      asn1.RawValue `asn1:"optional,tag:1"`
+}
+
+type issuerAndSerial struct {
+	Issuer asn1.RawValue
+	Serial *big.Int
+}
+
+type attribute struct {
+	Type   asn1.ObjectIdentifier
+	Values asn1.RawValue `asn1:"set"`
+}
+
+type tsAccuracy struct {
+	Seconds int `asn1:"optional"`
+	Millis  int `asn1:"optional,tag:0"`
+	Micros  int `asn1:"optional,tag:1"`
+}
+
+type tstInfo struct {
+	Version        int
+	Policy         asn1.ObjectIdentifier
+	MessageImprint messageImprint
+	SerialNumber   *big.Int
+	GenTime        time.Time     `asn1:"generalized"`
+	Accuracy       tsAccuracy    `asn1:"optional"`
+	Ordering       bool          `asn1:"optional,default:false"`
+	Nonce          *big.Int      `asn1:"optional"`
+	TSA            asn1.RawValue `asn1:"optional,explicit,tag:0"`
+	Extensions     asn1.RawValue `asn1:"optional,tag:1"`
+}
@@ -0,0 +1,206 @@
+package goshamir
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"errors"
+	"io"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+// testTSA is a minimal RFC 3161 time-stamping authority.
+type testTSA struct {
+	key   *ecdsa.PrivateKey
+	cert  *x509.Certificate
+	roots *x509.CertPool
+	// replay, if set, is returned instead of a fresh token.
+	replay []byte
+	last   []byte
+}
+
+func newTestTSA(t *testing.T) (*testTSA, *httptest.Server) {
+	t.Helper()
+	key, _ := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	tmpl := &x509.Certificate{
+		SerialNumber:          big.NewInt(42),
+		Subject:               pkix.Name{CommonName: "Test TSA"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageTimeStamping},
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+	if err != nil {
+		t.Fatalf("CreateCertificate failed: %v", err)
+	}
+	cert, _ := x509.ParseCertificate(der)
+	tsa := &testTSA{key: key, cert: cert, roots: x509.NewCertPool()}
+	tsa.roots.AddCert(cert)
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		var req timeStampReq
+		if _, err := asn1.Unmarshal(body, &req); err != nil {
+			http.Error(w, "bad request", http.StatusBadRequest)
+			return
+		}
+		token := tsa.replay
+		if token == nil {
+			token = tsa.sign(t, req)
+		}
+		tsa.last = token
+		resp, _ := asn1.Marshal(timeStampResp{Token: asn1.RawValue{FullBytes: token}})
+		w.Header().Set("Content-Type", "application/timestamp-reply")
+		w.Write(resp)
+	}))
+	t.Cleanup(srv.Close)
+	return tsa, srv
+}
+
+func (tsa *testTSA) sign(t *testing.T, req timeStampReq) []byte {
+	info, err := asn1.Marshal(tstInfo{
+		Version:        1,
+		Policy:         asn1.ObjectIdentifier{1, 2, 3, 4},
+		MessageImprint: req.MessageImprint,
+		SerialNumber:   big.NewInt(7),
+		GenTime:        time.Now().UTC().Truncate(time.Second),
+		Nonce:          req.Nonce,
+	})
+	if err != nil {
+		t.Fatalf("Marshal TSTInfo failed: %v", err)
+	}
+
+	digest := sha256.Sum256(info)
+	ct, _ := asn1.Marshal(oidTSTInfo)
+	md, _ := asn1.Marshal(digest[:])
+	attrs, _ := asn1.MarshalWithParams([]attribute{
+		{Type: oidContentType, Values: asn1.RawValue{Class: asn1.ClassUniversal, Tag: asn1.TagSet, IsCompound: true, Bytes: ct}},
+		{Type: oidMessageDigest, Values: asn1.RawValue{Class: asn1.ClassUniversal, Tag: asn1.TagSet, IsCompound: true, Bytes: md}},
+	}, "set")
+	attrsDigest := sha256.Sum256(attrs)
+	sig, _ := ecdsa.SignASN1(rand.Reader, tsa.key, attrsDigest[:])
+
+	sid, _ := asn1.Marshal(issuerAndSerial{Issuer: asn1.RawValue{FullBytes: tsa.cert.RawIssuer}, Serial: tsa.cert.SerialNumber})
+	signedAttrs := asn1.RawValue{Class: asn1.ClassContextSpecific, Tag: 0, IsCompound: true, Bytes: attrs[len(attrs)-lenContent(attrs):]}
+	sd, err := asn1.Marshal(signedData{
+		Version:          3,
+		DigestAlgorithms: []pkix.AlgorithmIdentifier{{Algorithm: oidSHA256}},
+		EncapContentInfo: encapContentInfo{EContentType: oidTSTInfo, EContent: info},
+		Certificates:     asn1.RawValue{Class: asn1.ClassContextSpecific, Tag: 0, IsCompound: true, Bytes: tsa.cert.Raw},
+		SignerInfos: []signerInfo{{
+			Version:            1,
+			SID:                asn1.RawValue{FullBytes: sid},
+			DigestAlgorithm:    pkix.AlgorithmIdentifier{Algorithm: oidSHA256},
+			SignedAttrs:        signedAttrs,
+			SignatureAlgorithm: pkix.AlgorithmIdentifier{Algorithm: asn1.ObjectIdentifier{1, 2, 840, 10045, 4, 3, 2}},
+			Signature:          sig,
+		}},
+	})
+	if err != nil {
+		t.Fatalf("Marshal SignedData failed: %v", err)
+	}
+	// RawValue contents are written verbatim, so apply the explicit tag by hand.
+	content, _ := asn1.Marshal(asn1.RawValue{Class: asn1.ClassContextSpecific, Tag: 0, IsCompound: true, Bytes: sd})
+	token, _ := asn1.Marshal(contentInfo{ContentType: oidSignedData, Content: asn1.RawValue{FullBytes: content}})
+	return token
+}
+
+// lenContent returns the length of the contents of a DER element.
+func lenContent(der []byte) int {
+	var v asn1.RawValue
+	asn1.Unmarshal(der, &v)
+	return len(v.Bytes)
+}
+
+// --- Timestamp Tests ---
+
+func TestTimestampAuthority(t *testing.T) {
+	tsa, srv := newTestTSA(t)
+	shares, _ := Split([]byte("secret"), 3, 2)
+	tree, _ := NewShareMerkleTree(shares)
+	root := tree.Root()
+
+	authority := &TimestampAuthority{URL: srv.URL, Roots: tsa.roots}
+	ts, err := authority.Timestamp(context.Background(), root)
+	if err != nil {
+		t.Fatalf("Timestamp failed: %v", err)
+	}
+	if time.Since(ts.Time) > time.Minute || ts.SerialNumber.Int64() != 7 {
+		t.Errorf("Unexpected timestamp %+v", ts)
+	}
+
+	// The token verifies offline against the same data.
+	if _, err := VerifyTimestamp(ts.Token, root, tsa.roots); err != nil {
+		t.Errorf("VerifyTimestamp failed: %v", err)
+	}
+	if _, err := VerifyTimestamp(ts.Token, []byte("other root"), tsa.roots); !errors.Is(err, ErrTimestampInvalid) {
+		t.Errorf("Expected ErrTimestampInvalid for other data, got %v", err)
+	}
+	if _, err := VerifyTimestamp(ts.Token, root, x509.NewCertPool()); !errors.Is(err, ErrTimestampInvalid) {
+		t.Errorf("Expected ErrTimestampInvalid for untrusted TSA, got %v", err)
+	}
+
+	tampered := append([]byte(nil), ts.Token...)
+	tampered[len(tampered)-10] ^= 1
+	if _, err := VerifyTimestamp(tampered, root, tsa.roots); err == nil {
+		t.Error("Expected error for tampered token")
+	}
+
+	// A replayed token fails the nonce check.
+	tsa.replay = tsa.last
+	if _, err := authority.Timestamp(context.Background(), root); !errors.Is(err, ErrTimestampInvalid) {
+		t.Errorf("Expected ErrTimestampInvalid for replayed token, got %v", err)
+	}
+}
+
+func TestSealedBundle_Timestamp(t *testing.T) {
+	tsa, srv := newTestTSA(t)
+	pub, priv, _ := ed25519.GenerateKey(nil)
+	shares, _ := Split([]byte("secret"), 2, 2)
+	bundles, _ := AssignShares(shares, []Custodian{{Name: "alice"}, {Name: "bob"}})
+	tree, _ := NewShareMerkleTree(shares)
+
+	ts, err := (&TimestampAuthority{URL: srv.URL, Roots: tsa.roots}).Timestamp(context.Background(), tree.Root())
+	if err != nil {
+		t.Fatalf("Timestamp failed: %v", err)
+	}
+	sealed, _ := SealBundle("set-1", bundles, priv)
+	sealed[0].TimestampToken = ts.Token
+	if err := VerifyBundle(sealed[0], pub); !errors.Is(err, ErrBadSeal) {
+		t.Errorf("Expected the token to be covered by the signature, got %v", err)
+	}
+	if err := sealed[0].Seal(priv); err != nil {
+		t.Fatalf("Seal failed: %v", err)
+	}
+
+	data, _ := sealed[0].MarshalBinary()
+	var restored SealedBundle
+	if err := restored.UnmarshalBinary(data); err != nil {
+		t.Fatalf("UnmarshalBinary failed: %v", err)
+	}
+	if err := VerifyBundle(restored, pub); err != nil {
+		t.Errorf("VerifyBundle failed: %v", err)
+	}
+	if _, err := VerifyTimestamp(restored.TimestampToken, tree.Root(), tsa.roots); err != nil {
+		t.Errorf("VerifyTimestamp failed: %v", err)
+	}
+
+	var signer crypto.Signer = priv
+	if _, err := SealBundle("set-1", bundles, signer); err != nil {
+		t.Errorf("SealBundle with crypto.Signer failed: %v", err)
+	}
+}
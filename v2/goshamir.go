@@ -0,0 +1,114 @@
+// Package goshamir is version 2 of the goshamir API: config-based calls
+// producing compact GF(2^8) shares, with a checksummed text and binary
+// encoding.
+//
+// Import it as
+//
+//	import goshamir "github.com/fawwazid/go-shamir/v2"
+//
+// The top-level functions of github.com/fawwazid/go-shamir are frozen for
+// compatibility: Split keeps producing GF(257) shares and the hex encoding
+// keeps its format. New features and format changes land here instead, so
+// upgrading never changes the shares existing code produces. Shares of
+// both versions are the same type, and Combine and Decode here accept
+// shares made by either, so callers can migrate one call site at a time
+// and re-split old sets with MigrateShares.
+package goshamir
+
+import (
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+
+	v1 "github.com/fawwazid/go-shamir"
+)
+
+// Share is a share of a secret. It is the same type as in version 1.
+type Share = v1.Share
+
+// Config configures Split and Combine. Combine only uses Threshold.
+type Config struct {
+	// Shares is the number of shares Split produces.
+	Shares int
+	// Threshold is the number of shares needed to reconstruct the secret.
+	Threshold int
+	// Rand is the source of randomness for polynomial coefficients.
+	// Defaults to crypto/rand.Reader.
+	Rand io.Reader
+	// Logger receives non-sensitive operational events of Split. Defaults
+	// to discarding them.
+	Logger *slog.Logger
+}
+
+// options translates the config into version 1 options.
+func (c Config) options() []v1.Option {
+	return []v1.Option{
+		v1.WithScheme(v1.SchemeV2GF256),
+		v1.WithRandom(c.Rand),
+		v1.WithLogger(c.Logger),
+	}
+}
+
+// Split divides secret into cfg.Shares shares of v1.SchemeV2GF256, any
+// cfg.Threshold of which reconstruct it. Each share value is as long as
+// the secret.
+func Split(secret []byte, cfg Config) ([]Share, error) {
+	s, err := v1.NewSplitter(cfg.Shares, cfg.Threshold, cfg.options()...)
+	if err != nil {
+		return nil, err
+	}
+	return s.Split(secret)
+}
+
+// Combine reconstructs the secret from at least cfg.Threshold shares.
+// Shares made by version 1 are accepted too.
+func Combine(shares []Share, cfg Config) ([]byte, error) {
+	if cfg.Threshold == 0 {
+		return nil, errors.New("config threshold must be set")
+	}
+	return v1.Combine(shares, cfg.Threshold)
+}
+
+// textEncoding encodes share envelopes as text.
+var textEncoding = base64.RawURLEncoding
+
+// Encode returns the text form of a share: its binary envelope, with a
+// CRC-32C checksum, in unpadded URL-safe base64.
+func Encode(s Share) (string, error) {
+	data, err := Marshal(s)
+	if err != nil {
+		return "", err
+	}
+	return textEncoding.EncodeToString(data), nil
+}
+
+// Decode parses a share encoded by Encode. The version 1 "index:hex" form
+// is accepted as well.
+func Decode(text string) (Share, error) {
+	data, err := textEncoding.DecodeString(text)
+	if err != nil {
+		shares, hexErr := v1.DecodeSharesFromHex([]string{text})
+		if hexErr != nil {
+			return Share{}, errors.New("share is neither a version 2 nor a version 1 encoding")
+		}
+		v1.ReportTelemetry(v1.TelemetryEvent{Kind: v1.TelemetryDeprecatedFormat, Op: "v2.Decode", Format: "hex", Scheme: shares[0].Scheme, Reason: "version 1 hex encoding"})
+		return shares[0], nil
+	}
+	return Unmarshal(data)
+}
+
+// Marshal returns the binary envelope of a share, as Share.MarshalBinary.
+func Marshal(s Share) ([]byte, error) {
+	return s.MarshalBinary()
+}
+
+// Unmarshal parses a binary envelope produced by Marshal.
+func Unmarshal(data []byte) (Share, error) {
+	var s Share
+	if err := s.UnmarshalBinary(data); err != nil {
+		return Share{}, fmt.Errorf("decoding share: %w", err)
+	}
+	return s, nil
+}
@@ -0,0 +1,121 @@
+package goshamir
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	v1 "github.com/fawwazid/go-shamir"
+)
+
+// --- Version 2 API Tests ---
+
+func TestSplitCombine(t *testing.T) {
+	secret := []byte("version two")
+	cfg := Config{Shares: 5, Threshold: 3}
+	shares, err := Split(secret, cfg)
+	if err != nil {
+		t.Fatalf("Split failed: %v", err)
+	}
+	for _, s := range shares {
+		if s.Scheme != v1.SchemeV2GF256 || len(s.Value) != len(secret) {
+			t.Fatalf("share %d is not compact: scheme %v, %d bytes", s.Index, s.Scheme, len(s.Value))
+		}
+	}
+
+	var decoded []Share
+	for _, s := range shares[2:] {
+		text, err := Encode(s)
+		if err != nil {
+			t.Fatalf("Encode failed: %v", err)
+		}
+		d, err := Decode(text)
+		if err != nil {
+			t.Fatalf("Decode failed: %v", err)
+		}
+		decoded = append(decoded, d)
+	}
+	recovered, err := Combine(decoded, cfg)
+	if err != nil {
+		t.Fatalf("Combine failed: %v", err)
+	}
+	if !bytes.Equal(recovered, secret) {
+		t.Fatalf("Expected %q, got %q", secret, recovered)
+	}
+}
+
+func TestCombine_AcceptsVersion1(t *testing.T) {
+	secret := []byte("legacy")
+	old, err := v1.Split(secret, 3, 2)
+	if err != nil {
+		t.Fatalf("Split failed: %v", err)
+	}
+	encoded, _ := v1.EncodeSharesToHex(old)
+	var shares []Share
+	for _, e := range encoded[1:] {
+		s, err := Decode(e)
+		if err != nil {
+			t.Fatalf("Decode of version 1 share failed: %v", err)
+		}
+		shares = append(shares, s)
+	}
+	recovered, err := Combine(shares, Config{Threshold: 2})
+	if err != nil {
+		t.Fatalf("Combine failed: %v", err)
+	}
+	if !bytes.Equal(recovered, secret) {
+		t.Fatalf("Expected %q, got %q", secret, recovered)
+	}
+}
+
+func TestCombine_RejectsPepperedShares(t *testing.T) {
+	s, _ := v1.NewSplitter(3, 2, v1.WithScheme(v1.SchemeV2GF256), v1.WithPepper([]byte("pepper")))
+	shares, err := s.Split([]byte("peppered"))
+	if err != nil {
+		t.Fatalf("Split failed: %v", err)
+	}
+	text, _ := Encode(shares[0])
+	decoded, _ := Decode(text)
+	var ce *v1.CombineError
+	if _, err := Combine([]Share{decoded, shares[1]}, Config{Threshold: 2}); !errors.As(err, &ce) || ce.Problem != v1.ProblemPeppered {
+		t.Fatalf("expected ProblemPeppered, got %v", err)
+	}
+}
+
+func TestConfig_Invalid(t *testing.T) {
+	if _, err := Split([]byte("x"), Config{Shares: 2, Threshold: 3}); err == nil {
+		t.Error("expected error for threshold above shares")
+	}
+	if _, err := Combine(nil, Config{}); err == nil {
+		t.Error("expected error for missing threshold")
+	}
+	if _, err := Decode("not a share!"); err == nil {
+		t.Error("expected error for invalid text")
+	}
+}
+
+func TestDecode_ReportsVersion1Telemetry(t *testing.T) {
+	var ops []string
+	v1.SetTelemetryHook(func(e v1.TelemetryEvent) {
+		if e.Kind == v1.TelemetryDeprecatedFormat {
+			ops = append(ops, e.Op)
+		}
+	})
+	defer v1.SetTelemetryHook(nil)
+
+	shares, _ := Split([]byte("secret"), Config{Shares: 2, Threshold: 2})
+	text, _ := Encode(shares[0])
+	if _, err := Decode(text); err != nil {
+		t.Fatalf("Decode failed: %v", err)
+	}
+	if len(ops) != 0 {
+		t.Fatalf("unexpected events for a version 2 encoding: %v", ops)
+	}
+	hex, _ := v1.EncodeSharesToHex(shares[:1])
+	if _, err := Decode(hex[0]); err != nil {
+		t.Fatalf("Decode failed: %v", err)
+	}
+	if len(ops) != 1 || ops[0] != "v2.Decode" {
+		t.Fatalf("got events %v, want one from v2.Decode", ops)
+	}
+}
@@ -0,0 +1,158 @@
+// Package commit defines a common interface for polynomial commitment
+// schemes, with Feldman, Pedersen and KZG implementations, so applications
+// can swap the commitment scheme of their verifiable secret sharing
+// without changing their split and verify call sites.
+//
+// Commitments and openings are opaque byte strings in the encoding of the
+// scheme that produced them. Feldman commitments need no opening, Pedersen
+// openings carry the blinding polynomial's value and KZG openings carry an
+// evaluation proof.
+package commit
+
+import (
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+)
+
+// ErrInvalidEval is returned when a claimed evaluation does not match a
+// commitment.
+var ErrInvalidEval = errors.New("evaluation does not match commitment")
+
+// Opener opens a committed polynomial at x, returning its value there and
+// the opening needed to verify it.
+type Opener func(x uint8) (y *big.Int, opening []byte, err error)
+
+// Commitment is a polynomial commitment scheme over the integers modulo a
+// prime.
+type Commitment interface {
+	// Order returns the prime modulus of the scalar field the committed
+	// polynomials are over.
+	Order() *big.Int
+	// Commit commits to the polynomial with coefficients coeffs, lowest
+	// degree first, and returns the encoded commitment and an Opener for
+	// it. Schemes that blind their commitments draw the blinding from
+	// rand; a nil rand means crypto/rand.Reader.
+	Commit(coeffs []*big.Int, rand io.Reader) ([]byte, Opener, error)
+	// VerifyEval checks that the polynomial committed to takes the value
+	// y at x, given the opening, returning ErrInvalidEval if it does not.
+	VerifyEval(commitment []byte, x uint8, y *big.Int, opening []byte) error
+}
+
+// Share is one party's share with the opening that verifies it against
+// the commitment.
+type Share struct {
+	Index   uint8
+	Value   *big.Int
+	Opening []byte
+}
+
+// Split shares secret among totalShares parties, any threshold of whom can
+// recover it, committing to the sharing polynomial with c. It returns the
+// shares and the commitment. A nil rand means crypto/rand.Reader.
+func Split(c Commitment, secret *big.Int, totalShares, threshold int, random io.Reader) ([]Share, []byte, error) {
+	order := c.Order()
+	if secret == nil || secret.Sign() < 0 || secret.Cmp(order) >= 0 {
+		return nil, nil, errors.New("secret must be in [0, order)")
+	}
+	if threshold < 2 || totalShares < threshold || totalShares > 255 {
+		return nil, nil, errors.New("invalid share parameters")
+	}
+	if random == nil {
+		random = rand.Reader
+	}
+
+	coeffs := make([]*big.Int, threshold)
+	coeffs[0] = new(big.Int).Set(secret)
+	for j := 1; j < threshold; j++ {
+		a, err := rand.Int(random, order)
+		if err != nil {
+			return nil, nil, fmt.Errorf("random coefficient generation failed: %w", err)
+		}
+		coeffs[j] = a
+	}
+	defer func() {
+		for _, a := range coeffs {
+			a.SetInt64(0)
+		}
+	}()
+
+	commitment, open, err := c.Commit(coeffs, random)
+	if err != nil {
+		return nil, nil, err
+	}
+	shares := make([]Share, totalShares)
+	for i := range shares {
+		y, opening, err := open(uint8(i + 1))
+		if err != nil {
+			return nil, nil, err
+		}
+		shares[i] = Share{Index: uint8(i + 1), Value: y, Opening: opening}
+	}
+	return shares, commitment, nil
+}
+
+// Verify checks share against commitment, returning ErrInvalidEval if it
+// does not lie on the committed polynomial.
+func Verify(c Commitment, commitment []byte, share Share) error {
+	if share.Index == 0 || share.Value == nil {
+		return ErrInvalidEval
+	}
+	return c.VerifyEval(commitment, share.Index, share.Value, share.Opening)
+}
+
+// Combine recovers the secret from the first threshold shares. Shares
+// should be verified first; Combine does not check openings.
+func Combine(c Commitment, shares []Share, threshold int) (*big.Int, error) {
+	if threshold < 2 {
+		return nil, errors.New("threshold must be at least 2")
+	}
+	if len(shares) < threshold {
+		return nil, fmt.Errorf("need at least %d shares, got %d", threshold, len(shares))
+	}
+	shares = shares[:threshold]
+	order := c.Order()
+	seen := make(map[uint8]bool, threshold)
+	for _, s := range shares {
+		if s.Index == 0 || seen[s.Index] || s.Value == nil {
+			return nil, errors.New("shares must have distinct non-zero indices and values")
+		}
+		seen[s.Index] = true
+	}
+
+	secret := new(big.Int)
+	for i, si := range shares {
+		num, den := big.NewInt(1), big.NewInt(1)
+		for j, sj := range shares {
+			if i == j {
+				continue
+			}
+			num.Mul(num, big.NewInt(int64(sj.Index)))
+			den.Mul(den, big.NewInt(int64(sj.Index)-int64(si.Index)))
+		}
+		den.Mod(den, order)
+		if den.ModInverse(den, order) == nil {
+			return nil, errors.New("order is not prime")
+		}
+		num.Mul(num, den)
+		num.Mul(num, si.Value)
+		secret.Add(secret, num)
+		secret.Mod(secret, order)
+	}
+	return secret, nil
+}
+
+// evaluate returns the value of the polynomial with coefficients coeffs at
+// x, modulo order.
+func evaluate(coeffs []*big.Int, x uint8, order *big.Int) *big.Int {
+	bx := big.NewInt(int64(x))
+	y := new(big.Int)
+	for j := len(coeffs) - 1; j >= 0; j-- {
+		y.Mul(y, bx)
+		y.Add(y, coeffs[j])
+		y.Mod(y, order)
+	}
+	return y
+}
@@ -0,0 +1,122 @@
+package commit
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/fawwazid/go-shamir/vss"
+	"github.com/fawwazid/go-shamir/vss/kzg"
+)
+
+// --- Commitment Tests ---
+
+// toyBackend is the insecure bilinear map of the kzg tests: both source
+// groups are the integers modulo a prime, with e(a, b) = a·b.
+type toyBackend struct{ r *big.Int }
+
+func (b toyBackend) mod(x *big.Int) *big.Int            { return x.Mod(x, b.r) }
+func (b toyBackend) Order() *big.Int                    { return b.r }
+func (b toyBackend) G1Add(x, y *big.Int) *big.Int       { return b.mod(new(big.Int).Add(x, y)) }
+func (b toyBackend) G1Neg(x *big.Int) *big.Int          { return b.mod(new(big.Int).Neg(x)) }
+func (b toyBackend) G1ScalarMul(p, k *big.Int) *big.Int { return b.mod(new(big.Int).Mul(p, k)) }
+func (b toyBackend) G2Add(x, y *big.Int) *big.Int       { return b.G1Add(x, y) }
+func (b toyBackend) G2Neg(x *big.Int) *big.Int          { return b.G1Neg(x) }
+func (b toyBackend) G2ScalarMul(p, k *big.Int) *big.Int { return b.G1ScalarMul(p, k) }
+func (b toyBackend) PairingCheck(a1, b1, a2, b2 *big.Int) bool {
+	return b.G1ScalarMul(a1, b1).Cmp(b.G1ScalarMul(a2, b2)) == 0
+}
+
+type toyEncoding struct{}
+
+func (toyEncoding) Encode(p *big.Int) []byte { return p.FillBytes(make([]byte, 16)) }
+func (toyEncoding) Decode(data []byte) (*big.Int, error) {
+	if len(data) != 16 {
+		return nil, errors.New("invalid point")
+	}
+	return new(big.Int).SetBytes(data), nil
+}
+
+func newToyKZG(t *testing.T) Commitment {
+	t.Helper()
+	b := toyBackend{r: new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 127), big.NewInt(1))}
+	tau := big.NewInt(987654321)
+	srs := kzg.SRS[*big.Int, *big.Int]{G2: big.NewInt(1), G2Tau: new(big.Int).Set(tau)}
+	power := big.NewInt(1)
+	for range 4 {
+		srs.G1Powers = append(srs.G1Powers, new(big.Int).Set(power))
+		power = b.G1ScalarMul(power, tau)
+	}
+	s, err := kzg.New[*big.Int, *big.Int](b, srs)
+	if err != nil {
+		t.Fatalf("kzg.New failed: %v", err)
+	}
+	return KZG(s, toyEncoding{})
+}
+
+func TestCommitment_Schemes(t *testing.T) {
+	schemes := map[string]Commitment{
+		"feldman":  Feldman(),
+		"pedersen": Pedersen(),
+		"kzg":      newToyKZG(t),
+	}
+	for name, c := range schemes {
+		t.Run(name, func(t *testing.T) {
+			secret := big.NewInt(0xC0FFEE)
+			shares, commitment, err := Split(c, secret, 5, 3, nil)
+			if err != nil {
+				t.Fatalf("Split failed: %v", err)
+			}
+			for _, s := range shares {
+				if err := Verify(c, commitment, s); err != nil {
+					t.Errorf("Verify of share %d failed: %v", s.Index, err)
+				}
+			}
+
+			bad := shares[1]
+			bad.Value = new(big.Int).Add(bad.Value, big.NewInt(1))
+			if err := Verify(c, commitment, bad); !errors.Is(err, ErrInvalidEval) {
+				t.Errorf("expected ErrInvalidEval for tampered value, got %v", err)
+			}
+			moved := shares[1]
+			moved.Index = 4
+			if err := Verify(c, commitment, moved); !errors.Is(err, ErrInvalidEval) {
+				t.Errorf("expected ErrInvalidEval for moved share, got %v", err)
+			}
+
+			recovered, err := Combine(c, []Share{shares[4], shares[0], shares[2]}, 3)
+			if err != nil {
+				t.Fatalf("Combine failed: %v", err)
+			}
+			if recovered.Cmp(secret) != 0 {
+				t.Errorf("Expected %v, got %v", secret, recovered)
+			}
+		})
+	}
+}
+
+func TestFeldman_MatchesVSS(t *testing.T) {
+	shares, commitment, err := Split(Feldman(), big.NewInt(77), 3, 2, nil)
+	if err != nil {
+		t.Fatalf("Split failed: %v", err)
+	}
+	var c vss.Commitment
+	if err := c.UnmarshalBinary(commitment); err != nil {
+		t.Fatalf("UnmarshalBinary failed: %v", err)
+	}
+	if err := c.Verify(vss.Share{Index: shares[0].Index, Value: shares[0].Value}); err != nil {
+		t.Fatalf("vss Verify failed: %v", err)
+	}
+}
+
+func TestPedersen_Hiding(t *testing.T) {
+	// The same secret committed twice gives unrelated commitments to it.
+	_, c1, _ := Split(Pedersen(), big.NewInt(1), 3, 2, nil)
+	_, c2, _ := Split(Pedersen(), big.NewInt(1), 3, 2, nil)
+	var v1, v2 vss.Commitment
+	_ = v1.UnmarshalBinary(c1)
+	_ = v2.UnmarshalBinary(c2)
+	if v1.PublicKey().Cmp(v2.PublicKey()) == 0 {
+		t.Fatal("Pedersen commitment to the secret is not blinded")
+	}
+}
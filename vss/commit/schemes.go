@@ -0,0 +1,215 @@
+package commit
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"errors"
+	"io"
+	"math/big"
+	"slices"
+
+	"github.com/fawwazid/go-shamir/internal/modp"
+	"github.com/fawwazid/go-shamir/vss"
+	"github.com/fawwazid/go-shamir/vss/kzg"
+)
+
+// Feldman returns the Feldman commitment scheme of package vss over the
+// 2048-bit MODP group: g^a_j for every coefficient. Commitments are
+// encoded as by vss.Commitment.MarshalBinary, and shares need no opening.
+// Feldman commitments are only computationally hiding: g^secret is public.
+func Feldman() Commitment {
+	return feldman{}
+}
+
+type feldman struct{}
+
+func (feldman) Order() *big.Int {
+	return new(big.Int).Set(modp.Q)
+}
+
+func (feldman) Commit(coeffs []*big.Int, _ io.Reader) ([]byte, Opener, error) {
+	if err := checkScalars(coeffs); err != nil {
+		return nil, nil, err
+	}
+	c := &vss.Commitment{Coefficients: make([]*big.Int, len(coeffs))}
+	for j, a := range coeffs {
+		c.Coefficients[j] = modp.Exp(modp.G, a)
+	}
+	data, err := c.MarshalBinary()
+	if err != nil {
+		return nil, nil, err
+	}
+	coeffs = cloneScalars(coeffs)
+	open := func(x uint8) (*big.Int, []byte, error) {
+		return evaluate(coeffs, x, modp.Q), nil, nil
+	}
+	return data, open, nil
+}
+
+func (feldman) VerifyEval(commitment []byte, x uint8, y *big.Int, opening []byte) error {
+	var c vss.Commitment
+	if err := c.UnmarshalBinary(commitment); err != nil {
+		return err
+	}
+	if len(opening) != 0 {
+		return ErrInvalidEval
+	}
+	if err := c.Verify(vss.Share{Index: x, Value: y}); err != nil {
+		return ErrInvalidEval
+	}
+	return nil
+}
+
+// pedersenH is the second generator of Pedersen commitments, derived from
+// a hash so that nobody knows its discrete logarithm to base G. Squaring
+// maps the hash output into the subgroup of quadratic residues.
+var pedersenH = func() *big.Int {
+	var buf []byte
+	for counter := byte(0); len(buf) < modp.ElementSize+32; counter++ {
+		sum := sha256.Sum256(append([]byte("goshamir pedersen h"), counter))
+		buf = append(buf, sum[:]...)
+	}
+	h := new(big.Int).SetBytes(buf)
+	h.Mod(h, modp.P)
+	return modp.Mul(h, h)
+}()
+
+// Pedersen returns the Pedersen commitment scheme over the 2048-bit MODP
+// group: g^a_j·h^b_j for every coefficient, with a random blinding
+// polynomial b. Unlike Feldman commitments they reveal nothing about the
+// secret, even to an unbounded adversary. Commitments are encoded like
+// Feldman's, and each opening is the blinding polynomial's value at the
+// share's index, as a fixed-size big-endian integer.
+func Pedersen() Commitment {
+	return pedersen{}
+}
+
+type pedersen struct{}
+
+func (pedersen) Order() *big.Int {
+	return new(big.Int).Set(modp.Q)
+}
+
+func (pedersen) Commit(coeffs []*big.Int, random io.Reader) ([]byte, Opener, error) {
+	if err := checkScalars(coeffs); err != nil {
+		return nil, nil, err
+	}
+	if random == nil {
+		random = rand.Reader
+	}
+	blinding := make([]*big.Int, len(coeffs))
+	c := &vss.Commitment{Coefficients: make([]*big.Int, len(coeffs))}
+	for j, a := range coeffs {
+		b, err := rand.Int(random, modp.Q)
+		if err != nil {
+			return nil, nil, err
+		}
+		blinding[j] = b
+		c.Coefficients[j] = modp.Mul(modp.Exp(modp.G, a), modp.Exp(pedersenH, b))
+	}
+	data, err := c.MarshalBinary()
+	if err != nil {
+		return nil, nil, err
+	}
+	coeffs = cloneScalars(coeffs)
+	open := func(x uint8) (*big.Int, []byte, error) {
+		return evaluate(coeffs, x, modp.Q), modp.Encode(evaluate(blinding, x, modp.Q)), nil
+	}
+	return data, open, nil
+}
+
+func (pedersen) VerifyEval(commitment []byte, x uint8, y *big.Int, opening []byte) error {
+	var c vss.Commitment
+	if err := c.UnmarshalBinary(commitment); err != nil {
+		return err
+	}
+	if x == 0 || y == nil || y.Sign() < 0 || y.Cmp(modp.Q) >= 0 || len(opening) != modp.ElementSize {
+		return ErrInvalidEval
+	}
+	t := new(big.Int).SetBytes(opening)
+	if t.Cmp(modp.Q) >= 0 {
+		return ErrInvalidEval
+	}
+	if modp.Mul(modp.Exp(modp.G, y), modp.Exp(pedersenH, t)).Cmp(c.ShareCommitment(x)) != 0 {
+		return ErrInvalidEval
+	}
+	return nil
+}
+
+// PointEncoding encodes elements of a curve group, for KZG commitments and
+// proofs.
+type PointEncoding[G any] interface {
+	Encode(p G) []byte
+	Decode(data []byte) (G, error)
+}
+
+// KZG returns a Commitment backed by the KZG scheme s, encoding
+// commitments and evaluation proofs with enc. The threshold is limited by
+// the scheme's reference string.
+func KZG[G1, G2 any](s *kzg.Scheme[G1, G2], enc PointEncoding[G1]) Commitment {
+	return kzgCommitment[G1, G2]{scheme: s, enc: enc}
+}
+
+type kzgCommitment[G1, G2 any] struct {
+	scheme *kzg.Scheme[G1, G2]
+	enc    PointEncoding[G1]
+}
+
+func (k kzgCommitment[G1, G2]) Order() *big.Int {
+	return k.scheme.Order()
+}
+
+func (k kzgCommitment[G1, G2]) Commit(coeffs []*big.Int, _ io.Reader) ([]byte, Opener, error) {
+	c, err := k.scheme.Commit(coeffs)
+	if err != nil {
+		return nil, nil, err
+	}
+	coeffs = cloneScalars(coeffs)
+	open := func(x uint8) (*big.Int, []byte, error) {
+		y, proof, err := k.scheme.Open(coeffs, big.NewInt(int64(x)))
+		if err != nil {
+			return nil, nil, err
+		}
+		return y, k.enc.Encode(proof), nil
+	}
+	return k.enc.Encode(c), open, nil
+}
+
+func (k kzgCommitment[G1, G2]) VerifyEval(commitment []byte, x uint8, y *big.Int, opening []byte) error {
+	c, err := k.enc.Decode(commitment)
+	if err != nil {
+		return err
+	}
+	proof, err := k.enc.Decode(opening)
+	if err != nil {
+		return ErrInvalidEval
+	}
+	if k.scheme.VerifyEval(c, big.NewInt(int64(x)), y, proof) != nil {
+		return ErrInvalidEval
+	}
+	return nil
+}
+
+// checkScalars checks that coeffs is a polynomial of a size vss supports
+// over the integers modulo modp.Q.
+func checkScalars(coeffs []*big.Int) error {
+	if len(coeffs) < 2 || len(coeffs) > 255 {
+		return errors.New("polynomial must have 2 to 255 coefficients")
+	}
+	for _, a := range coeffs {
+		if a == nil || a.Sign() < 0 || a.Cmp(modp.Q) >= 0 {
+			return errors.New("coefficients must be in [0, Q)")
+		}
+	}
+	return nil
+}
+
+// cloneScalars deep copies coeffs, so openers keep working after the
+// caller wipes its polynomial.
+func cloneScalars(coeffs []*big.Int) []*big.Int {
+	out := slices.Clone(coeffs)
+	for j, a := range out {
+		out[j] = new(big.Int).Set(a)
+	}
+	return out
+}
@@ -0,0 +1,265 @@
+// Package kzg implements verifiable secret sharing with Kate-Zaverucha-
+// Goldberg polynomial commitments. The whole sharing polynomial is
+// committed to by a single group element, and each share carries a
+// constant-size evaluation proof, so verifying a share costs one pairing
+// check regardless of the threshold.
+//
+// The package is generic over the pairing-friendly curve. Callers supply a
+// Backend wrapping the curve implementation they already use, such as
+// BLS12-381, together with the structured reference string from a trusted
+// setup ceremony.
+package kzg
+
+import (
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"slices"
+)
+
+// ErrInvalidProof is returned when a share's evaluation proof does not
+// verify against the commitment.
+var ErrInvalidProof = errors.New("kzg: invalid evaluation proof")
+
+// Backend provides the group and pairing operations of a pairing-friendly
+// curve with source groups G1 and G2 of prime order Order.
+type Backend[G1, G2 any] interface {
+	// Order returns the prime order of G1 and G2, which is the modulus of
+	// the scalar field.
+	Order() *big.Int
+	G1Add(a, b G1) G1
+	G1Neg(a G1) G1
+	// G1ScalarMul must accept k = 0, returning the identity.
+	G1ScalarMul(p G1, k *big.Int) G1
+	G2Add(a, b G2) G2
+	G2Neg(a G2) G2
+	G2ScalarMul(p G2, k *big.Int) G2
+	// PairingCheck reports whether e(a1, b1) == e(a2, b2).
+	PairingCheck(a1 G1, b1 G2, a2 G1, b2 G2) bool
+}
+
+// SRS is the structured reference string of a trusted setup with secret
+// τ: G1Powers[i] is [τ^i]G1 and G2Tau is [τ]G2. It supports polynomials of
+// degree up to len(G1Powers)-1, that is thresholds up to len(G1Powers).
+type SRS[G1, G2 any] struct {
+	G1Powers []G1
+	G2       G2
+	G2Tau    G2
+}
+
+// Share is one party's share with its evaluation proof.
+type Share[G1 any] struct {
+	Index uint8
+	Value *big.Int
+	// Proof is the commitment to (f(X) - Value) / (X - Index).
+	Proof G1
+}
+
+// Scheme shares secrets under a fixed backend and reference string.
+type Scheme[G1, G2 any] struct {
+	backend Backend[G1, G2]
+	srs     SRS[G1, G2]
+	order   *big.Int
+}
+
+// New returns a Scheme using backend and srs.
+func New[G1, G2 any](backend Backend[G1, G2], srs SRS[G1, G2]) (*Scheme[G1, G2], error) {
+	if backend == nil {
+		return nil, errors.New("kzg: backend cannot be nil")
+	}
+	if len(srs.G1Powers) < 2 {
+		return nil, errors.New("kzg: reference string must support degree 1")
+	}
+	order := backend.Order()
+	if order == nil || order.Sign() <= 0 || order.BitLen() <= 8 {
+		return nil, errors.New("kzg: invalid group order")
+	}
+	return &Scheme[G1, G2]{backend: backend, srs: srs, order: order}, nil
+}
+
+// Order returns the order of the backend's groups, the modulus of the
+// scalar field.
+func (s *Scheme[G1, G2]) Order() *big.Int {
+	return new(big.Int).Set(s.order)
+}
+
+// MaxThreshold returns the largest threshold the reference string supports.
+func (s *Scheme[G1, G2]) MaxThreshold() int {
+	return min(len(s.srs.G1Powers), 255)
+}
+
+// Split shares secret among totalShares parties, any threshold of whom can
+// recover it. It returns the shares, each with its evaluation proof, and
+// the commitment to the sharing polynomial. A nil rand means
+// crypto/rand.Reader.
+func (s *Scheme[G1, G2]) Split(secret *big.Int, totalShares, threshold int, random io.Reader) ([]Share[G1], G1, error) {
+	var none G1
+	if secret == nil || secret.Sign() < 0 || secret.Cmp(s.order) >= 0 {
+		return nil, none, errors.New("kzg: secret must be in [0, order)")
+	}
+	if threshold < 2 || totalShares < threshold || totalShares > 255 {
+		return nil, none, errors.New("kzg: invalid share parameters")
+	}
+	if threshold > s.MaxThreshold() {
+		return nil, none, fmt.Errorf("kzg: threshold %d exceeds reference string size %d", threshold, s.MaxThreshold())
+	}
+	if random == nil {
+		random = rand.Reader
+	}
+
+	coeffs := make([]*big.Int, threshold)
+	coeffs[0] = new(big.Int).Set(secret)
+	for j := 1; j < threshold; j++ {
+		c, err := rand.Int(random, s.order)
+		if err != nil {
+			return nil, none, fmt.Errorf("kzg: random coefficient generation failed: %w", err)
+		}
+		coeffs[j] = c
+	}
+	defer func() {
+		for _, c := range coeffs {
+			c.SetInt64(0)
+		}
+	}()
+
+	commitment := s.commit(coeffs)
+	shares := make([]Share[G1], totalShares)
+	for i := range shares {
+		y, proof, err := s.Open(coeffs, big.NewInt(int64(i+1)))
+		if err != nil {
+			return nil, commitment, err
+		}
+		shares[i] = Share[G1]{Index: uint8(i + 1), Value: y, Proof: proof}
+	}
+	return shares, commitment, nil
+}
+
+// VerifyShare checks share against commitment with a single pairing check,
+// e(C - [y]G1, G2) == e(π, [τ]G2 - [x]G2). It returns ErrInvalidProof if
+// the share is inconsistent with the commitment.
+func (s *Scheme[G1, G2]) VerifyShare(commitment G1, share Share[G1]) error {
+	if share.Index == 0 {
+		return ErrInvalidProof
+	}
+	return s.VerifyEval(commitment, big.NewInt(int64(share.Index)), share.Value, share.Proof)
+}
+
+// Commit returns the commitment to the polynomial with coefficients
+// coeffs, lowest degree first, for protocols that choose their own
+// polynomials.
+func (s *Scheme[G1, G2]) Commit(coeffs []*big.Int) (G1, error) {
+	var none G1
+	if len(coeffs) == 0 || len(coeffs) > len(s.srs.G1Powers) {
+		return none, fmt.Errorf("kzg: polynomial must have 1 to %d coefficients", len(s.srs.G1Powers))
+	}
+	return s.commit(coeffs), nil
+}
+
+// Open evaluates the polynomial with coefficients coeffs at x and returns
+// the value with its evaluation proof.
+func (s *Scheme[G1, G2]) Open(coeffs []*big.Int, x *big.Int) (*big.Int, G1, error) {
+	var none G1
+	if len(coeffs) == 0 || len(coeffs) > len(s.srs.G1Powers) {
+		return nil, none, fmt.Errorf("kzg: polynomial must have 1 to %d coefficients", len(s.srs.G1Powers))
+	}
+	if slices.Contains(coeffs, nil) {
+		return nil, none, errors.New("kzg: polynomial coefficients cannot be nil")
+	}
+	if x == nil {
+		return nil, none, errors.New("kzg: evaluation point cannot be nil")
+	}
+	y, quotient := s.divide(coeffs, x)
+	proof := s.commit(quotient)
+	for _, q := range quotient {
+		q.SetInt64(0)
+	}
+	return y, proof, nil
+}
+
+// VerifyEval checks that the polynomial committed to by commitment takes
+// the value y at x, given the evaluation proof from Open. It returns
+// ErrInvalidProof otherwise.
+func (s *Scheme[G1, G2]) VerifyEval(commitment G1, x, y *big.Int, proof G1) error {
+	if x == nil || y == nil || y.Sign() < 0 || y.Cmp(s.order) >= 0 {
+		return ErrInvalidProof
+	}
+	b := s.backend
+	lhs := b.G1Add(commitment, b.G1Neg(b.G1ScalarMul(s.srs.G1Powers[0], y)))
+	rhs := b.G2Add(s.srs.G2Tau, b.G2Neg(b.G2ScalarMul(s.srs.G2, x)))
+	if !b.PairingCheck(lhs, s.srs.G2, proof, rhs) {
+		return ErrInvalidProof
+	}
+	return nil
+}
+
+// Combine recovers the secret from the first threshold shares. Shares
+// should be verified first; Combine does not check proofs.
+func (s *Scheme[G1, G2]) Combine(shares []Share[G1], threshold int) (*big.Int, error) {
+	if threshold < 2 {
+		return nil, errors.New("kzg: threshold must be at least 2")
+	}
+	if len(shares) < threshold {
+		return nil, fmt.Errorf("kzg: need at least %d shares, got %d", threshold, len(shares))
+	}
+	shares = shares[:threshold]
+	seen := make(map[uint8]bool, threshold)
+	for _, sh := range shares {
+		if sh.Index == 0 || seen[sh.Index] || sh.Value == nil {
+			return nil, errors.New("kzg: shares must have distinct non-zero indices and values")
+		}
+		seen[sh.Index] = true
+	}
+
+	secret := new(big.Int)
+	for i, si := range shares {
+		num, den := big.NewInt(1), big.NewInt(1)
+		for j, sj := range shares {
+			if i == j {
+				continue
+			}
+			num.Mul(num, big.NewInt(int64(sj.Index)))
+			den.Mul(den, big.NewInt(int64(sj.Index)-int64(si.Index)))
+		}
+		den.Mod(den, s.order)
+		if den.ModInverse(den, s.order) == nil {
+			return nil, errors.New("kzg: group order is not prime")
+		}
+		num.Mul(num, den)
+		num.Mul(num, si.Value)
+		secret.Add(secret, num)
+		secret.Mod(secret, s.order)
+	}
+	return secret, nil
+}
+
+// commit returns Σ coeffs[i]·[τ^i]G1.
+func (s *Scheme[G1, G2]) commit(coeffs []*big.Int) G1 {
+	b := s.backend
+	acc := b.G1ScalarMul(s.srs.G1Powers[0], new(big.Int))
+	for i, c := range coeffs {
+		if c.Sign() != 0 {
+			acc = b.G1Add(acc, b.G1ScalarMul(s.srs.G1Powers[i], c))
+		}
+	}
+	return acc
+}
+
+// divide evaluates the polynomial with coefficients coeffs (lowest degree
+// first) at x and returns f(x) together with the coefficients of the
+// quotient (f(X) - f(x)) / (X - x), by synthetic division.
+func (s *Scheme[G1, G2]) divide(coeffs []*big.Int, x *big.Int) (*big.Int, []*big.Int) {
+	quotient := make([]*big.Int, len(coeffs)-1)
+	acc := new(big.Int)
+	for i := len(coeffs) - 1; i >= 1; i-- {
+		acc.Mul(acc, x)
+		acc.Add(acc, coeffs[i])
+		acc.Mod(acc, s.order)
+		quotient[i-1] = new(big.Int).Set(acc)
+	}
+	acc.Mul(acc, x)
+	acc.Add(acc, coeffs[0])
+	acc.Mod(acc, s.order)
+	return acc, quotient
+}
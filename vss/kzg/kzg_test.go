@@ -0,0 +1,125 @@
+package kzg
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+)
+
+// --- KZG Tests ---
+
+// toyBackend is a bilinear map for testing only: both source groups are
+// the additive group of integers modulo a prime r, with e(a, b) = a·b. It
+// satisfies the algebra KZG relies on but offers no security, since
+// discrete logarithms are trivial.
+type toyBackend struct{ r *big.Int }
+
+func newToyBackend() toyBackend {
+	// 2^127 - 1 is a Mersenne prime.
+	r := new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 127), big.NewInt(1))
+	return toyBackend{r: r}
+}
+
+func (b toyBackend) mod(x *big.Int) *big.Int { return x.Mod(x, b.r) }
+
+func (b toyBackend) Order() *big.Int                    { return b.r }
+func (b toyBackend) G1Add(x, y *big.Int) *big.Int       { return b.mod(new(big.Int).Add(x, y)) }
+func (b toyBackend) G1Neg(x *big.Int) *big.Int          { return b.mod(new(big.Int).Neg(x)) }
+func (b toyBackend) G1ScalarMul(p, k *big.Int) *big.Int { return b.mod(new(big.Int).Mul(p, k)) }
+func (b toyBackend) G2Add(x, y *big.Int) *big.Int       { return b.G1Add(x, y) }
+func (b toyBackend) G2Neg(x *big.Int) *big.Int          { return b.G1Neg(x) }
+func (b toyBackend) G2ScalarMul(p, k *big.Int) *big.Int { return b.G1ScalarMul(p, k) }
+func (b toyBackend) PairingCheck(a1, b1, a2, b2 *big.Int) bool {
+	return b.G1ScalarMul(a1, b1).Cmp(b.G1ScalarMul(a2, b2)) == 0
+}
+
+func newToyScheme(t *testing.T, maxThreshold int) *Scheme[*big.Int, *big.Int] {
+	t.Helper()
+	b := newToyBackend()
+	tau := big.NewInt(123456789)
+	srs := SRS[*big.Int, *big.Int]{G2: big.NewInt(1), G2Tau: new(big.Int).Set(tau)}
+	power := big.NewInt(1)
+	for range maxThreshold {
+		srs.G1Powers = append(srs.G1Powers, new(big.Int).Set(power))
+		power = b.G1ScalarMul(power, tau)
+	}
+	s, err := New[*big.Int, *big.Int](b, srs)
+	if err != nil {
+		t.Fatalf("New failed: %v", err)
+	}
+	return s
+}
+
+func TestKZG_SplitVerifyCombine(t *testing.T) {
+	s := newToyScheme(t, 4)
+	secret := big.NewInt(0xC0FFEE)
+
+	shares, commitment, err := s.Split(secret, 6, 4, nil)
+	if err != nil {
+		t.Fatalf("Split failed: %v", err)
+	}
+	for _, sh := range shares {
+		if err := s.VerifyShare(commitment, sh); err != nil {
+			t.Errorf("VerifyShare of share %d failed: %v", sh.Index, err)
+		}
+	}
+
+	recovered, err := s.Combine([]Share[*big.Int]{shares[5], shares[0], shares[3], shares[2]}, 4)
+	if err != nil {
+		t.Fatalf("Combine failed: %v", err)
+	}
+	if recovered.Cmp(secret) != 0 {
+		t.Errorf("Expected %v, got %v", secret, recovered)
+	}
+}
+
+func TestKZG_RejectsBadShares(t *testing.T) {
+	s := newToyScheme(t, 3)
+	shares, commitment, err := s.Split(big.NewInt(5), 3, 3, nil)
+	if err != nil {
+		t.Fatalf("Split failed: %v", err)
+	}
+
+	tampered := shares[0]
+	tampered.Value = new(big.Int).Add(tampered.Value, big.NewInt(1))
+	if err := s.VerifyShare(commitment, tampered); !errors.Is(err, ErrInvalidProof) {
+		t.Errorf("Expected ErrInvalidProof for tampered value, got %v", err)
+	}
+
+	swapped := shares[0]
+	swapped.Proof = shares[1].Proof
+	if err := s.VerifyShare(commitment, swapped); !errors.Is(err, ErrInvalidProof) {
+		t.Errorf("Expected ErrInvalidProof for swapped proof, got %v", err)
+	}
+
+	_, other, _ := s.Split(big.NewInt(5), 3, 3, nil)
+	if err := s.VerifyShare(other, shares[2]); !errors.Is(err, ErrInvalidProof) {
+		t.Errorf("Expected ErrInvalidProof against foreign commitment, got %v", err)
+	}
+}
+
+func TestKZG_InvalidParameters(t *testing.T) {
+	s := newToyScheme(t, 3)
+	if _, _, err := s.Split(big.NewInt(1), 5, 4, nil); err == nil {
+		t.Error("Expected error for threshold beyond reference string")
+	}
+	if _, _, err := s.Split(s.order, 3, 2, nil); err == nil {
+		t.Error("Expected error for secret outside the scalar field")
+	}
+	if _, err := New[*big.Int, *big.Int](newToyBackend(), SRS[*big.Int, *big.Int]{}); err == nil {
+		t.Error("Expected error for empty reference string")
+	}
+	shares, _, _ := s.Split(big.NewInt(1), 3, 2, nil)
+	if _, err := s.Combine([]Share[*big.Int]{shares[1], shares[1]}, 2); err == nil {
+		t.Error("Expected error for duplicate shares")
+	}
+	if _, _, err := s.Open(nil, big.NewInt(1)); err == nil {
+		t.Error("Expected error for empty polynomial")
+	}
+	if _, _, err := s.Open([]*big.Int{big.NewInt(1), nil}, big.NewInt(1)); err == nil {
+		t.Error("Expected error for nil coefficient")
+	}
+	if _, _, err := s.Open([]*big.Int{big.NewInt(1)}, nil); err == nil {
+		t.Error("Expected error for nil evaluation point")
+	}
+}
@@ -0,0 +1,114 @@
+package vss
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+	"io"
+	"math/big"
+
+	"github.com/fawwazid/go-shamir/internal/modp"
+)
+
+// ErrInvalidPossessionProof is returned when a proof of share possession
+// does not verify.
+var ErrInvalidPossessionProof = errors.New("invalid proof of share possession")
+
+// PossessionProof is a non-interactive Schnorr proof that its maker knows
+// the share at Index of a committed polynomial, that is the discrete
+// logarithm of Commitment.ShareCommitment(Index). It reveals nothing about
+// the share and can be checked by anyone holding the commitment, making it
+// a stronger possession attestation than a hash challenge, which needs a
+// verifier that saw the share.
+type PossessionProof struct {
+	Index uint8
+	C     *big.Int
+	R     *big.Int
+}
+
+// ProvePossession proves that s lies on the polynomial committed to by c.
+// The context, such as a verifier's nonce or the date, is bound into the
+// proof so it cannot be replayed elsewhere. A nil rand means
+// crypto/rand.Reader.
+func ProvePossession(s Share, c *Commitment, context []byte, rand io.Reader) (*PossessionProof, error) {
+	if err := c.Verify(s); err != nil {
+		return nil, err
+	}
+	w, err := modp.RandomScalar(rand)
+	if err != nil {
+		return nil, err
+	}
+	defer w.SetInt64(0)
+	challenge, err := possessionChallenge(c, s.Index, modp.Exp(modp.G, w), context)
+	if err != nil {
+		return nil, err
+	}
+
+	// r = w - challenge·value mod Q
+	r := new(big.Int).Mul(challenge, s.Value)
+	r.Sub(w, r)
+	r.Mod(r, modp.Q)
+	return &PossessionProof{Index: s.Index, C: challenge, R: r}, nil
+}
+
+// VerifyPossession checks a proof produced by ProvePossession for the same
+// context, returning ErrInvalidPossessionProof if it does not verify.
+func (c *Commitment) VerifyPossession(p *PossessionProof, context []byte) error {
+	if err := c.Validate(); err != nil {
+		return err
+	}
+	if p == nil || p.Index == 0 || p.C == nil || p.R == nil ||
+		p.C.Sign() < 0 || p.C.Cmp(modp.Q) >= 0 || p.R.Sign() < 0 || p.R.Cmp(modp.Q) >= 0 {
+		return ErrInvalidPossessionProof
+	}
+	// a = g^r·y^c, which equals g^w for an honest proof.
+	a := modp.Mul(modp.Exp(modp.G, p.R), modp.Exp(c.ShareCommitment(p.Index), p.C))
+	challenge, err := possessionChallenge(c, p.Index, a, context)
+	if err != nil {
+		return err
+	}
+	if challenge.Cmp(p.C) != 0 {
+		return ErrInvalidPossessionProof
+	}
+	return nil
+}
+
+// MarshalBinary encodes the proof as the index followed by the fixed-size
+// challenge and response.
+func (p *PossessionProof) MarshalBinary() ([]byte, error) {
+	if p.C == nil || p.R == nil {
+		return nil, errors.New("incomplete possession proof")
+	}
+	buf := []byte{p.Index}
+	buf = append(buf, modp.Encode(p.C)...)
+	return append(buf, modp.Encode(p.R)...), nil
+}
+
+// UnmarshalBinary decodes a proof produced by MarshalBinary.
+func (p *PossessionProof) UnmarshalBinary(data []byte) error {
+	if len(data) != 1+2*modp.ElementSize {
+		return errors.New("invalid possession proof encoding")
+	}
+	p.Index = data[0]
+	p.C = new(big.Int).SetBytes(data[1 : 1+modp.ElementSize])
+	p.R = new(big.Int).SetBytes(data[1+modp.ElementSize:])
+	return nil
+}
+
+// possessionChallenge hashes the commitment, the index, the prover's nonce
+// commitment and the context into a scalar.
+func possessionChallenge(c *Commitment, index uint8, a *big.Int, context []byte) (*big.Int, error) {
+	commitment, err := c.MarshalBinary()
+	if err != nil {
+		return nil, err
+	}
+	h := sha256.New()
+	h.Write([]byte("goshamir vss possession"))
+	h.Write(binary.BigEndian.AppendUint64(nil, uint64(len(context))))
+	h.Write(context)
+	h.Write(commitment)
+	h.Write([]byte{index})
+	h.Write(modp.Encode(a))
+	e := new(big.Int).SetBytes(h.Sum(nil))
+	return e.Mod(e, modp.Q), nil
+}
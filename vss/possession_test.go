@@ -0,0 +1,54 @@
+package vss
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+)
+
+// --- Possession Proof Tests ---
+
+func TestPossessionProof(t *testing.T) {
+	shares, c, err := Split(big.NewInt(424242), 4, 2, nil)
+	if err != nil {
+		t.Fatalf("Split failed: %v", err)
+	}
+	context := []byte("audit 2026-Q4")
+	proof, err := ProvePossession(shares[2], c, context, nil)
+	if err != nil {
+		t.Fatalf("ProvePossession failed: %v", err)
+	}
+
+	data, err := proof.MarshalBinary()
+	if err != nil {
+		t.Fatalf("MarshalBinary failed: %v", err)
+	}
+	var decoded PossessionProof
+	if err := decoded.UnmarshalBinary(data); err != nil {
+		t.Fatalf("UnmarshalBinary failed: %v", err)
+	}
+	if err := c.VerifyPossession(&decoded, context); err != nil {
+		t.Fatalf("VerifyPossession failed: %v", err)
+	}
+
+	if err := c.VerifyPossession(proof, []byte("another audit")); !errors.Is(err, ErrInvalidPossessionProof) {
+		t.Errorf("expected replay in another context to fail, got %v", err)
+	}
+	moved := *proof
+	moved.Index = shares[1].Index
+	if err := c.VerifyPossession(&moved, context); !errors.Is(err, ErrInvalidPossessionProof) {
+		t.Errorf("expected proof for another index to fail, got %v", err)
+	}
+	_, other, _ := Split(big.NewInt(424242), 4, 2, nil)
+	if err := other.VerifyPossession(proof, context); !errors.Is(err, ErrInvalidPossessionProof) {
+		t.Errorf("expected proof against another commitment to fail, got %v", err)
+	}
+}
+
+func TestProvePossession_RejectsInconsistentShare(t *testing.T) {
+	shares, c, _ := Split(big.NewInt(7), 3, 2, nil)
+	bad := Share{Index: shares[0].Index, Value: new(big.Int).Add(shares[0].Value, big.NewInt(1))}
+	if _, err := ProvePossession(bad, c, nil, nil); !errors.Is(err, ErrInvalidShare) {
+		t.Fatalf("expected ErrInvalidShare, got %v", err)
+	}
+}
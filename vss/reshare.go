@@ -0,0 +1,218 @@
+package vss
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+
+	"github.com/fawwazid/go-shamir/internal/modp"
+)
+
+// Resharing moves a shared secret from an old committee to a new one with
+// a different threshold and size, without ever reconstructing it.
+//
+// The protocol has two rounds. In the first, each old holder listed in
+// Dealers splits its own share with Deal, broadcasting a ReshareBroadcast
+// and sending each new holder its ReshareSubshare privately. In the second,
+// each new holder checks what it received with Verify and computes its new
+// share with NewShare. Anyone can derive the new committee's commitment
+// with NewCommitment; it commits to the same secret as OldCommitment.
+//
+// Once the new shares are in place the old holders must erase theirs, or
+// the old committee can still recover the secret.
+type Resharing struct {
+	// OldCommitment is the commitment to the current sharing.
+	OldCommitment *Commitment
+	// Dealers are the indices of exactly OldCommitment.Threshold() old
+	// holders taking part.
+	Dealers []uint8
+	// NewTotal and NewThreshold are the parameters of the new sharing.
+	NewTotal     int
+	NewThreshold int
+}
+
+// ReshareBroadcast is a dealer's public first-round message.
+type ReshareBroadcast struct {
+	Dealer     uint8
+	Commitment *Commitment
+}
+
+// ReshareSubshare is a dealer's private first-round message to the new
+// holder at index Recipient.
+type ReshareSubshare struct {
+	Dealer    uint8
+	Recipient uint8
+	Value     *big.Int
+}
+
+// validate checks the resharing parameters.
+func (rs *Resharing) validate() error {
+	if rs.OldCommitment == nil {
+		return errors.New("old commitment cannot be nil")
+	}
+	if err := rs.OldCommitment.Validate(); err != nil {
+		return err
+	}
+	if len(rs.Dealers) != rs.OldCommitment.Threshold() {
+		return fmt.Errorf("need exactly %d dealers, got %d", rs.OldCommitment.Threshold(), len(rs.Dealers))
+	}
+	if rs.NewThreshold < 2 || rs.NewTotal < rs.NewThreshold || rs.NewTotal > 255 {
+		return errors.New("invalid new share parameters")
+	}
+	_, err := modp.LagrangeAtZero(rs.Dealers)
+	return err
+}
+
+// weight returns the Lagrange coefficient of dealer within Dealers.
+func (rs *Resharing) weight(dealer uint8) (*big.Int, error) {
+	basis, err := modp.LagrangeAtZero(rs.Dealers)
+	if err != nil {
+		return nil, err
+	}
+	for i, d := range rs.Dealers {
+		if d == dealer {
+			return basis[i], nil
+		}
+	}
+	return nil, fmt.Errorf("%d is not a resharing dealer", dealer)
+}
+
+// Deal runs the first round for the old holder of share, which must be
+// one of the Dealers. The subshares are addressed to new indices
+// 1..NewTotal. A nil rand means crypto/rand.Reader.
+func (rs *Resharing) Deal(share Share, rand io.Reader) (*ReshareBroadcast, []ReshareSubshare, error) {
+	if err := rs.validate(); err != nil {
+		return nil, nil, err
+	}
+	if _, err := rs.weight(share.Index); err != nil {
+		return nil, nil, err
+	}
+	if err := rs.OldCommitment.Verify(share); err != nil {
+		return nil, nil, err
+	}
+	subshares, commitment, err := Split(share.Value, rs.NewTotal, rs.NewThreshold, rand)
+	if err != nil {
+		return nil, nil, err
+	}
+	out := make([]ReshareSubshare, len(subshares))
+	for i, s := range subshares {
+		out[i] = ReshareSubshare{Dealer: share.Index, Recipient: s.Index, Value: s.Value}
+	}
+	return &ReshareBroadcast{Dealer: share.Index, Commitment: commitment}, out, nil
+}
+
+// Verify checks a dealer's broadcast and the subshare it sent. The
+// broadcast must reshare exactly the dealer's committed old share, and the
+// subshare must lie on the broadcast polynomial.
+func (rs *Resharing) Verify(b *ReshareBroadcast, sub ReshareSubshare) error {
+	if err := rs.verifyBroadcast(b); err != nil {
+		return err
+	}
+	if sub.Dealer != b.Dealer {
+		return errors.New("subshare and broadcast are from different dealers")
+	}
+	return b.Commitment.Verify(Share{Index: sub.Recipient, Value: sub.Value})
+}
+
+// verifyBroadcast checks that b reshares the dealer's old share with the
+// new parameters.
+func (rs *Resharing) verifyBroadcast(b *ReshareBroadcast) error {
+	if err := rs.validate(); err != nil {
+		return err
+	}
+	if b == nil || b.Commitment == nil {
+		return errors.New("broadcast cannot be nil")
+	}
+	if _, err := rs.weight(b.Dealer); err != nil {
+		return err
+	}
+	if err := b.Commitment.Validate(); err != nil {
+		return err
+	}
+	if b.Commitment.Threshold() != rs.NewThreshold {
+		return fmt.Errorf("dealer %d used threshold %d, expected %d", b.Dealer, b.Commitment.Threshold(), rs.NewThreshold)
+	}
+	if b.Commitment.PublicKey().Cmp(rs.OldCommitment.ShareCommitment(b.Dealer)) != 0 {
+		return fmt.Errorf("%w: dealer %d did not reshare its committed share", ErrInvalidShare, b.Dealer)
+	}
+	return nil
+}
+
+// NewShare runs the second round for the new holder at recipient,
+// combining one verified subshare from every dealer.
+func (rs *Resharing) NewShare(recipient uint8, broadcasts []*ReshareBroadcast, subshares []ReshareSubshare) (Share, error) {
+	if err := rs.validate(); err != nil {
+		return Share{}, err
+	}
+	if recipient == 0 || int(recipient) > rs.NewTotal {
+		return Share{}, fmt.Errorf("recipient must be in [1, %d]", rs.NewTotal)
+	}
+	byDealer := make(map[uint8]*ReshareBroadcast, len(broadcasts))
+	for _, b := range broadcasts {
+		if b != nil {
+			byDealer[b.Dealer] = b
+		}
+	}
+
+	value := new(big.Int)
+	term := new(big.Int)
+	used := make(map[uint8]bool, len(rs.Dealers))
+	for _, sub := range subshares {
+		if sub.Recipient != recipient {
+			return Share{}, fmt.Errorf("subshare from dealer %d is for recipient %d", sub.Dealer, sub.Recipient)
+		}
+		if used[sub.Dealer] {
+			return Share{}, fmt.Errorf("duplicate subshare from dealer %d", sub.Dealer)
+		}
+		b, ok := byDealer[sub.Dealer]
+		if !ok {
+			return Share{}, fmt.Errorf("missing broadcast from dealer %d", sub.Dealer)
+		}
+		if err := rs.Verify(b, sub); err != nil {
+			return Share{}, err
+		}
+		w, err := rs.weight(sub.Dealer)
+		if err != nil {
+			return Share{}, err
+		}
+		term.Mul(sub.Value, w)
+		value.Add(value, term)
+		value.Mod(value, modp.Q)
+		used[sub.Dealer] = true
+	}
+	if len(used) != len(rs.Dealers) {
+		return Share{}, fmt.Errorf("need subshares from all %d dealers, got %d", len(rs.Dealers), len(used))
+	}
+	return Share{Index: recipient, Value: value}, nil
+}
+
+// NewCommitment derives the commitment to the new sharing from every
+// dealer's broadcast. Its PublicKey equals that of OldCommitment.
+func (rs *Resharing) NewCommitment(broadcasts []*ReshareBroadcast) (*Commitment, error) {
+	if len(broadcasts) != len(rs.Dealers) {
+		return nil, fmt.Errorf("need broadcasts from all %d dealers, got %d", len(rs.Dealers), len(broadcasts))
+	}
+	coeffs := make([]*big.Int, rs.NewThreshold)
+	for j := range coeffs {
+		coeffs[j] = big.NewInt(1)
+	}
+	seen := make(map[uint8]bool, len(broadcasts))
+	for _, b := range broadcasts {
+		if err := rs.verifyBroadcast(b); err != nil {
+			return nil, err
+		}
+		if seen[b.Dealer] {
+			return nil, fmt.Errorf("duplicate broadcast from dealer %d", b.Dealer)
+		}
+		seen[b.Dealer] = true
+		w, err := rs.weight(b.Dealer)
+		if err != nil {
+			return nil, err
+		}
+		for j, cj := range b.Commitment.Coefficients {
+			coeffs[j] = modp.Mul(coeffs[j], modp.Exp(cj, w))
+		}
+	}
+	return &Commitment{Coefficients: coeffs}, nil
+}
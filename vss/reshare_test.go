@@ -0,0 +1,94 @@
+package vss
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+)
+
+// --- Resharing Tests ---
+
+func TestResharing_ChangesCommittee(t *testing.T) {
+	secret := big.NewInt(0xDEADBEEF)
+	old, oldCommitment, err := Split(secret, 5, 3, nil)
+	if err != nil {
+		t.Fatalf("Split failed: %v", err)
+	}
+
+	rs := &Resharing{OldCommitment: oldCommitment, Dealers: []uint8{2, 4, 5}, NewTotal: 4, NewThreshold: 2}
+
+	// Round 1: every dealer reshares its share.
+	var broadcasts []*ReshareBroadcast
+	inbox := make(map[uint8][]ReshareSubshare)
+	for _, d := range rs.Dealers {
+		b, subs, err := rs.Deal(old[d-1], nil)
+		if err != nil {
+			t.Fatalf("Deal failed: %v", err)
+		}
+		broadcasts = append(broadcasts, b)
+		for _, s := range subs {
+			inbox[s.Recipient] = append(inbox[s.Recipient], s)
+		}
+	}
+
+	// Round 2: every new holder derives its share.
+	newCommitment, err := rs.NewCommitment(broadcasts)
+	if err != nil {
+		t.Fatalf("NewCommitment failed: %v", err)
+	}
+	if newCommitment.PublicKey().Cmp(oldCommitment.PublicKey()) != 0 {
+		t.Error("New commitment commits to a different secret")
+	}
+	var fresh []Share
+	for j := uint8(1); j <= 4; j++ {
+		s, err := rs.NewShare(j, broadcasts, inbox[j])
+		if err != nil {
+			t.Fatalf("NewShare failed: %v", err)
+		}
+		if err := newCommitment.Verify(s); err != nil {
+			t.Errorf("New share %d does not match new commitment: %v", j, err)
+		}
+		fresh = append(fresh, s)
+	}
+
+	recovered, err := Combine([]Share{fresh[3], fresh[0]}, 2)
+	if err != nil {
+		t.Fatalf("Combine failed: %v", err)
+	}
+	if recovered.Cmp(secret) != 0 {
+		t.Error("New committee recovered a different secret")
+	}
+}
+
+func TestResharing_RejectsCheatingDealer(t *testing.T) {
+	old, oldCommitment, _ := Split(big.NewInt(1), 3, 2, nil)
+	rs := &Resharing{OldCommitment: oldCommitment, Dealers: []uint8{1, 3}, NewTotal: 3, NewThreshold: 2}
+
+	// A dealer resharing some other value is caught by its broadcast.
+	b, subs, err := rs.Deal(old[0], nil)
+	if err != nil {
+		t.Fatalf("Deal failed: %v", err)
+	}
+	_, other, _ := Split(big.NewInt(99), 3, 2, nil)
+	forged := &ReshareBroadcast{Dealer: b.Dealer, Commitment: other}
+	if err := rs.Verify(forged, subs[0]); !errors.Is(err, ErrInvalidShare) {
+		t.Errorf("Expected ErrInvalidShare for forged broadcast, got %v", err)
+	}
+
+	// A corrupted subshare is caught against the broadcast.
+	subs[1].Value = new(big.Int).Add(subs[1].Value, big.NewInt(1))
+	if err := rs.Verify(b, subs[1]); !errors.Is(err, ErrInvalidShare) {
+		t.Errorf("Expected ErrInvalidShare for corrupted subshare, got %v", err)
+	}
+
+	if _, _, err := rs.Deal(old[1], nil); err == nil {
+		t.Error("Expected error for holder outside the dealer set")
+	}
+	if _, err := rs.NewShare(1, []*ReshareBroadcast{b}, subs[:1]); err == nil {
+		t.Error("Expected error for missing dealers")
+	}
+	bad := &Resharing{OldCommitment: oldCommitment, Dealers: []uint8{1}, NewTotal: 3, NewThreshold: 2}
+	if _, _, err := bad.Deal(old[0], nil); err == nil {
+		t.Error("Expected error for too few dealers")
+	}
+}
@@ -0,0 +1,186 @@
+// Package vss implements Feldman verifiable secret sharing. Alongside the
+// shares, Split returns a public commitment to the sharing polynomial, g^a_j
+// for every coefficient a_j, against which each share can be checked
+// without learning the secret. The commitment can be stored and used later
+// for consistency checks or as the basis of other protocols.
+//
+// Shares are scalars modulo the order of the 2048-bit MODP group of RFC
+// 3526. The byte-wise sharing of the root package cannot export hiding
+// commitments: each coefficient there takes one of at most 257 values, so
+// g^a_j would reveal it by exhaustive search. Secrets shared here are
+// scalars; a key of up to 255 bytes can be shared by reading it as a
+// big-endian integer.
+package vss
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+
+	"github.com/fawwazid/go-shamir/internal/modp"
+)
+
+// ErrInvalidShare is returned when a share is inconsistent with the
+// commitment.
+var ErrInvalidShare = errors.New("share does not match commitment")
+
+// Share is one party's share: the value of the sharing polynomial at Index,
+// modulo the group order.
+type Share struct {
+	Index uint8
+	Value *big.Int
+}
+
+// Commitment is the public commitment to a sharing polynomial.
+// Coefficients[j] is g^a_j, so Coefficients[0] commits to the secret.
+type Commitment struct {
+	Coefficients []*big.Int
+}
+
+// Split shares secret among totalShares parties, any threshold of whom can
+// recover it, and returns the commitment to the polynomial used. The secret
+// must be positive and smaller than the group order: a zero secret would
+// be committed to as the identity element, which is not a valid
+// commitment. A nil rand means crypto/rand.Reader.
+func Split(secret *big.Int, totalShares, threshold int, rand io.Reader) ([]Share, *Commitment, error) {
+	if secret == nil || secret.Sign() <= 0 || secret.Cmp(modp.Q) >= 0 {
+		return nil, nil, errors.New("secret must be in [1, Q)")
+	}
+	scalars, coeffs, err := modp.SplitScalar(secret, totalShares, threshold, rand)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	shares := make([]Share, len(scalars))
+	for i, s := range scalars {
+		shares[i] = Share{Index: s.X, Value: s.Y}
+	}
+	c := &Commitment{Coefficients: make([]*big.Int, len(coeffs))}
+	for j, a := range coeffs {
+		c.Coefficients[j] = modp.Exp(modp.G, a)
+		a.SetInt64(0)
+	}
+	return shares, c, nil
+}
+
+// Combine recovers the secret from the first threshold shares.
+func Combine(shares []Share, threshold int) (*big.Int, error) {
+	if threshold < 2 {
+		return nil, errors.New("threshold must be at least 2")
+	}
+	if len(shares) < threshold {
+		return nil, fmt.Errorf("need at least %d shares, got %d", threshold, len(shares))
+	}
+	shares = shares[:threshold]
+	xs := make([]uint8, threshold)
+	for i, s := range shares {
+		if s.Value == nil {
+			return nil, fmt.Errorf("share %d has no value", s.Index)
+		}
+		xs[i] = s.Index
+	}
+	basis, err := modp.LagrangeAtZero(xs)
+	if err != nil {
+		return nil, err
+	}
+
+	secret := new(big.Int)
+	term := new(big.Int)
+	for i, s := range shares {
+		term.Mul(s.Value, basis[i])
+		secret.Add(secret, term)
+		secret.Mod(secret, modp.Q)
+	}
+	return secret, nil
+}
+
+// Threshold returns the number of shares needed to recover the secret.
+func (c *Commitment) Threshold() int {
+	return len(c.Coefficients)
+}
+
+// PublicKey returns g^secret, the commitment to the secret.
+func (c *Commitment) PublicKey() *big.Int {
+	return c.Coefficients[0]
+}
+
+// ShareCommitment returns g^f(index), the value a share at index must
+// match: the product of Coefficients[j]^(index^j).
+func (c *Commitment) ShareCommitment(index uint8) *big.Int {
+	x := big.NewInt(int64(index))
+	power := big.NewInt(1)
+	acc := big.NewInt(1)
+	for _, cj := range c.Coefficients {
+		acc = modp.Mul(acc, modp.Exp(cj, power))
+		power.Mul(power, x)
+		power.Mod(power, modp.Q)
+	}
+	return acc
+}
+
+// Verify checks s against the commitment, returning ErrInvalidShare if it
+// does not lie on the committed polynomial.
+func (c *Commitment) Verify(s Share) error {
+	if err := c.Validate(); err != nil {
+		return err
+	}
+	if s.Index == 0 || s.Value == nil || s.Value.Sign() < 0 || s.Value.Cmp(modp.Q) >= 0 {
+		return ErrInvalidShare
+	}
+	if modp.Exp(modp.G, s.Value).Cmp(c.ShareCommitment(s.Index)) != 0 {
+		return ErrInvalidShare
+	}
+	return nil
+}
+
+// Validate checks that the commitment is well formed: between 2 and 255
+// coefficients, each an element of the group.
+func (c *Commitment) Validate() error {
+	if len(c.Coefficients) < 2 || len(c.Coefficients) > 255 {
+		return errors.New("invalid commitment size")
+	}
+	for j, cj := range c.Coefficients {
+		if !modp.IsElement(cj) {
+			return fmt.Errorf("commitment coefficient %d is not a group element", j)
+		}
+	}
+	return nil
+}
+
+// MarshalBinary encodes the commitment as a 2-byte big-endian coefficient
+// count followed by the fixed-size coefficients.
+func (c *Commitment) MarshalBinary() ([]byte, error) {
+	if err := c.Validate(); err != nil {
+		return nil, err
+	}
+	buf := binary.BigEndian.AppendUint16(nil, uint16(len(c.Coefficients)))
+	for _, cj := range c.Coefficients {
+		buf = append(buf, modp.Encode(cj)...)
+	}
+	return buf, nil
+}
+
+// UnmarshalBinary decodes and validates a commitment produced by
+// MarshalBinary.
+func (c *Commitment) UnmarshalBinary(data []byte) error {
+	if len(data) < 2 {
+		return errors.New("commitment too short")
+	}
+	count := int(binary.BigEndian.Uint16(data))
+	if count < 2 || count > 255 || len(data) != 2+count*modp.ElementSize {
+		return errors.New("invalid commitment encoding")
+	}
+	coeffs := make([]*big.Int, count)
+	for j := range coeffs {
+		off := 2 + j*modp.ElementSize
+		cj, err := modp.Decode(data[off : off+modp.ElementSize])
+		if err != nil {
+			return fmt.Errorf("commitment coefficient %d: %w", j, err)
+		}
+		coeffs[j] = cj
+	}
+	c.Coefficients = coeffs
+	return nil
+}
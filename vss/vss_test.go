@@ -0,0 +1,119 @@
+package vss
+
+import (
+	"crypto/rand"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/fawwazid/go-shamir/internal/modp"
+)
+
+// --- Verifiable Secret Sharing Tests ---
+
+func TestSplitVerifyCombine(t *testing.T) {
+	key := make([]byte, 32)
+	if _, err := rand.Read(key); err != nil {
+		t.Fatalf("Failed to generate key: %v", err)
+	}
+	secret := new(big.Int).SetBytes(key)
+
+	shares, c, err := Split(secret, 5, 3, nil)
+	if err != nil {
+		t.Fatalf("Split failed: %v", err)
+	}
+	if c.Threshold() != 3 {
+		t.Errorf("Expected threshold 3, got %d", c.Threshold())
+	}
+	if c.PublicKey().Cmp(modp.Exp(modp.G, secret)) != 0 {
+		t.Error("PublicKey does not commit to the secret")
+	}
+	for _, s := range shares {
+		if err := c.Verify(s); err != nil {
+			t.Errorf("Verify of share %d failed: %v", s.Index, err)
+		}
+	}
+
+	recovered, err := Combine([]Share{shares[4], shares[1], shares[2]}, 3)
+	if err != nil {
+		t.Fatalf("Combine failed: %v", err)
+	}
+	if recovered.Cmp(secret) != 0 {
+		t.Error("Recovered secret does not match original")
+	}
+}
+
+func TestVerify_RejectsInconsistentShares(t *testing.T) {
+	shares, c, err := Split(big.NewInt(42), 3, 2, nil)
+	if err != nil {
+		t.Fatalf("Split failed: %v", err)
+	}
+
+	tampered := Share{Index: shares[0].Index, Value: new(big.Int).Add(shares[0].Value, big.NewInt(1))}
+	if err := c.Verify(tampered); !errors.Is(err, ErrInvalidShare) {
+		t.Errorf("Expected ErrInvalidShare for tampered value, got %v", err)
+	}
+	moved := Share{Index: 3, Value: shares[0].Value}
+	if err := c.Verify(moved); !errors.Is(err, ErrInvalidShare) {
+		t.Errorf("Expected ErrInvalidShare for wrong index, got %v", err)
+	}
+	if err := c.Verify(Share{Index: 1}); !errors.Is(err, ErrInvalidShare) {
+		t.Errorf("Expected ErrInvalidShare for missing value, got %v", err)
+	}
+
+	// Shares from another dealing do not match this commitment.
+	other, _, _ := Split(big.NewInt(42), 3, 2, nil)
+	if err := c.Verify(other[1]); !errors.Is(err, ErrInvalidShare) {
+		t.Errorf("Expected ErrInvalidShare for foreign share, got %v", err)
+	}
+}
+
+func TestCommitment_MarshalBinary(t *testing.T) {
+	shares, c, err := Split(big.NewInt(7), 4, 3, nil)
+	if err != nil {
+		t.Fatalf("Split failed: %v", err)
+	}
+	data, err := c.MarshalBinary()
+	if err != nil {
+		t.Fatalf("MarshalBinary failed: %v", err)
+	}
+	if len(data) != 2+3*modp.ElementSize {
+		t.Errorf("Unexpected encoding length %d", len(data))
+	}
+
+	var decoded Commitment
+	if err := decoded.UnmarshalBinary(data); err != nil {
+		t.Fatalf("UnmarshalBinary failed: %v", err)
+	}
+	if err := decoded.Verify(shares[3]); err != nil {
+		t.Errorf("Verify with decoded commitment failed: %v", err)
+	}
+
+	// P-1 has order 2, so it lies outside the prime-order subgroup.
+	copy(data[2:], modp.Encode(new(big.Int).Sub(modp.P, big.NewInt(1))))
+	if err := decoded.UnmarshalBinary(data); err == nil {
+		t.Error("Expected error for coefficient outside the group")
+	}
+	if err := decoded.UnmarshalBinary(data[:10]); err == nil {
+		t.Error("Expected error for truncated encoding")
+	}
+}
+
+func TestSplit_InvalidParameters(t *testing.T) {
+	if _, _, err := Split(modp.Q, 3, 2, nil); err == nil {
+		t.Error("Expected error for secret outside the scalar field")
+	}
+	if _, _, err := Split(big.NewInt(-1), 3, 2, nil); err == nil {
+		t.Error("Expected error for negative secret")
+	}
+	if _, _, err := Split(big.NewInt(0), 3, 2, nil); err == nil {
+		t.Error("Expected error for zero secret")
+	}
+	if _, _, err := Split(big.NewInt(1), 2, 3, nil); err == nil {
+		t.Error("Expected error for threshold above total shares")
+	}
+	shares, _, _ := Split(big.NewInt(1), 3, 2, nil)
+	if _, err := Combine([]Share{shares[0], shares[0]}, 2); err == nil {
+		t.Error("Expected error for duplicate shares")
+	}
+}
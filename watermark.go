@@ -0,0 +1,58 @@
+package goshamir
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"errors"
+)
+
+// WatermarkSize is the size in bytes of share watermarks.
+const WatermarkSize = 16
+
+// ErrUnknownWatermark is returned by TraceWatermark when a share's watermark
+// matches none of the custodians.
+var ErrUnknownWatermark = errors.New("watermark does not match any custodian")
+
+// WatermarkBundles returns copies of bundles whose shares carry a watermark
+// binding the share to its custodian: an HMAC-SHA256 under the dealer's key
+// of the custodian's name and the share. If a share later appears in
+// public, TraceWatermark identifies the custodian it was issued to.
+//
+// Custodians cannot forge each other's watermarks without the key, but a
+// custodian can strip the watermark before leaking a share. Recording each
+// share's fingerprint (see InspectShare) at issue time traces stripped
+// shares as well.
+func WatermarkBundles(key []byte, bundles []CustodianBundle) ([]CustodianBundle, error) {
+	if len(key) == 0 {
+		return nil, errors.New("watermark key cannot be empty")
+	}
+	marked := make([]CustodianBundle, len(bundles))
+	for i, b := range bundles {
+		marked[i] = b
+		marked[i].Share.Watermark = shareWatermark(key, b.Custodian.Name, b.Share)
+	}
+	return marked, nil
+}
+
+// TraceWatermark returns the custodian whose watermark s carries.
+func TraceWatermark(key []byte, s Share, custodians []Custodian) (Custodian, error) {
+	if len(s.Watermark) == 0 {
+		return Custodian{}, errors.New("share has no watermark")
+	}
+	for _, c := range custodians {
+		if hmac.Equal(s.Watermark, shareWatermark(key, c.Name, s)) {
+			return c, nil
+		}
+	}
+	return Custodian{}, ErrUnknownWatermark
+}
+
+func shareWatermark(key []byte, custodian string, s Share) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte("goshamir watermark"))
+	mac.Write([]byte{byte(len(custodian) >> 8), byte(len(custodian))})
+	mac.Write([]byte(custodian))
+	mac.Write([]byte{byte(schemeOf(s)), s.Index})
+	mac.Write(s.Value)
+	return mac.Sum(nil)[:WatermarkSize]
+}
@@ -0,0 +1,63 @@
+package goshamir
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+// --- Watermark Tests ---
+
+func TestWatermarkBundles(t *testing.T) {
+	shares, _ := Split([]byte("secret"), 3, 2)
+	custodians := []Custodian{{Name: "alice"}, {Name: "bob"}, {Name: "carol"}}
+	bundles, _ := AssignShares(shares, custodians)
+	key := []byte("dealer key")
+
+	marked, err := WatermarkBundles(key, bundles)
+	if err != nil {
+		t.Fatalf("WatermarkBundles failed: %v", err)
+	}
+	if bundles[0].Share.Watermark != nil {
+		t.Error("WatermarkBundles modified its input")
+	}
+
+	// A leaked share survives encoding and still combines.
+	encoded, _ := EncodeSharesToHex([]Share{marked[1].Share, marked[2].Share})
+	if !strings.Contains(encoded[0], "#") {
+		t.Errorf("Expected watermark in encoding, got %q", encoded[0])
+	}
+	leaked, err := DecodeSharesFromHex(encoded)
+	if err != nil {
+		t.Fatalf("Decode failed: %v", err)
+	}
+	if secret, err := Combine(leaked, 2); err != nil || string(secret) != "secret" {
+		t.Errorf("Combine of watermarked shares failed: %v", err)
+	}
+
+	who, err := TraceWatermark(key, leaked[0], custodians)
+	if err != nil {
+		t.Fatalf("TraceWatermark failed: %v", err)
+	}
+	if who.Name != "bob" {
+		t.Errorf("Expected bob, got %s", who.Name)
+	}
+
+	if _, err := TraceWatermark([]byte("other key"), leaked[0], custodians); !errors.Is(err, ErrUnknownWatermark) {
+		t.Errorf("Expected ErrUnknownWatermark, got %v", err)
+	}
+	if _, err := TraceWatermark(key, shares[0], custodians); err == nil {
+		t.Error("Expected error for share without watermark")
+	}
+	if _, err := WatermarkBundles(nil, bundles); err == nil {
+		t.Error("Expected error for empty key")
+	}
+}
+
+func TestDecodeSharesFromHex_InvalidWatermark(t *testing.T) {
+	for _, input := range []string{"1:abcd#", "1:abcd#zz", "v2:1:abcd#01#02"} {
+		if _, err := DecodeSharesFromHex([]string{input}); err == nil {
+			t.Errorf("Expected error for %q", input)
+		}
+	}
+}
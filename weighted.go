@@ -0,0 +1,115 @@
+package goshamir
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+)
+
+// Weighted share layout: magic "SW", a count byte, then the count points as
+// uvarint-prefixed share envelopes, each protected by its own checksum.
+var weightedMagic = [2]byte{'S', 'W'}
+
+// WeightedShare is one custodian's logical share holding several points of
+// the sharing polynomial, so it counts as many times toward the threshold
+// as it has points. It expresses simple weighted policies, such as a
+// director counting as two officers, without hierarchical splits.
+type WeightedShare struct {
+	Points []Share
+}
+
+// Weight returns how many points the share holds.
+func (w WeightedShare) Weight() int {
+	return len(w.Points)
+}
+
+// SplitWeighted splits secret among custodians with the given weights: the
+// custodian at position i receives weights[i] points, and any custodians
+// whose weights add up to threshold can reconstruct the secret. The
+// weights may add up to at most MaxShares. Options apply as for
+// NewSplitter.
+func SplitWeighted(secret []byte, weights []int, threshold int, opts ...Option) ([]WeightedShare, error) {
+	total := 0
+	for i, w := range weights {
+		if w < 1 {
+			return nil, fmt.Errorf("weight of custodian %d must be at least 1", i)
+		}
+		total += w
+		if total > MaxShares {
+			return nil, fmt.Errorf("weights must add up to at most %d", MaxShares)
+		}
+	}
+	s, err := NewSplitter(total, threshold, opts...)
+	if err != nil {
+		return nil, err
+	}
+	shares, err := s.Split(secret)
+	if err != nil {
+		return nil, err
+	}
+	out := make([]WeightedShare, len(weights))
+	next := 0
+	for i, w := range weights {
+		out[i] = WeightedShare{Points: shares[next : next+w : next+w]}
+		next += w
+	}
+	return out, nil
+}
+
+// ExpandWeighted returns the points of every weighted share, ready for
+// Combine and the other functions taking shares.
+func ExpandWeighted(shares []WeightedShare) []Share {
+	var out []Share
+	for _, w := range shares {
+		out = append(out, w.Points...)
+	}
+	return out
+}
+
+// CombineWeighted reconstructs the secret from weighted shares whose
+// weights add up to at least threshold.
+func CombineWeighted(shares []WeightedShare, threshold int) ([]byte, error) {
+	return Combine(ExpandWeighted(shares), threshold)
+}
+
+// MarshalBinary encodes the weighted share as a single artifact holding
+// the envelope of each point.
+func (w WeightedShare) MarshalBinary() ([]byte, error) {
+	if len(w.Points) == 0 || len(w.Points) > MaxShares {
+		return nil, errors.New("weighted share must hold 1 to 255 points")
+	}
+	buf := []byte{weightedMagic[0], weightedMagic[1], byte(len(w.Points))}
+	for _, p := range w.Points {
+		envelope, err := p.MarshalBinary()
+		if err != nil {
+			return nil, fmt.Errorf("point %d: %w", p.Index, err)
+		}
+		buf = binary.AppendUvarint(buf, uint64(len(envelope)))
+		buf = append(buf, envelope...)
+	}
+	return buf, nil
+}
+
+// UnmarshalBinary decodes a weighted share produced by MarshalBinary.
+func (w *WeightedShare) UnmarshalBinary(data []byte) error {
+	if len(data) < 3 || data[0] != weightedMagic[0] || data[1] != weightedMagic[1] || data[2] == 0 {
+		return ErrInvalidEnvelope
+	}
+	points := make([]Share, data[2])
+	data = data[3:]
+	for i := range points {
+		n, size := binary.Uvarint(data)
+		if size <= 0 || n > uint64(len(data)-size) {
+			return ErrInvalidEnvelope
+		}
+		if err := points[i].UnmarshalBinary(data[size : size+int(n)]); err != nil {
+			return err
+		}
+		data = data[size+int(n):]
+	}
+	if len(data) != 0 {
+		return ErrInvalidEnvelope
+	}
+	w.Points = points
+	return nil
+}
@@ -0,0 +1,62 @@
+package goshamir
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+)
+
+// --- Weighted Share Tests ---
+
+func TestSplitWeighted(t *testing.T) {
+	secret := []byte("weighted policy")
+	// A director counts as two officers; any 3 votes reconstruct.
+	shares, err := SplitWeighted(secret, []int{2, 1, 1, 1}, 3, WithScheme(SchemeV2GF256))
+	if err != nil {
+		t.Fatalf("SplitWeighted failed: %v", err)
+	}
+	if shares[0].Weight() != 2 || shares[3].Weight() != 1 {
+		t.Fatalf("unexpected weights %d, %d", shares[0].Weight(), shares[3].Weight())
+	}
+
+	// Director and one officer.
+	data, err := shares[0].MarshalBinary()
+	if err != nil {
+		t.Fatalf("MarshalBinary failed: %v", err)
+	}
+	var director WeightedShare
+	if err := director.UnmarshalBinary(data); err != nil {
+		t.Fatalf("UnmarshalBinary failed: %v", err)
+	}
+	got, err := CombineWeighted([]WeightedShare{director, shares[2]}, 3)
+	if err != nil {
+		t.Fatalf("CombineWeighted failed: %v", err)
+	}
+	if !bytes.Equal(got, secret) {
+		t.Fatalf("Expected %q, got %q", secret, got)
+	}
+
+	// Three officers without the director.
+	if got, err := CombineWeighted(shares[1:], 3); err != nil || !bytes.Equal(got, secret) {
+		t.Fatalf("CombineWeighted of officers = %q, %v", got, err)
+	}
+
+	// Two officers are not enough.
+	var ce *CombineError
+	if _, err := CombineWeighted(shares[1:3], 3); !errors.As(err, &ce) || ce.Problem != ProblemInsufficientShares {
+		t.Fatalf("expected insufficient shares, got %v", err)
+	}
+}
+
+func TestSplitWeighted_Invalid(t *testing.T) {
+	if _, err := SplitWeighted([]byte("x"), []int{2, 0}, 2); err == nil {
+		t.Error("expected error for zero weight")
+	}
+	if _, err := SplitWeighted([]byte("x"), []int{200, 100}, 2); err == nil {
+		t.Error("expected error for weights above MaxShares")
+	}
+	var w WeightedShare
+	if err := w.UnmarshalBinary([]byte("SW\x01\x05abc")); !errors.Is(err, ErrInvalidEnvelope) {
+		t.Errorf("expected ErrInvalidEnvelope, got %v", err)
+	}
+}
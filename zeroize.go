@@ -0,0 +1,54 @@
+package goshamir
+
+import "runtime"
+
+// valueCleanup is the handle of the cleanup registered for a share value.
+// Every copy of the Share points to it, so it becomes unreachable only when
+// the last copy does.
+type valueCleanup struct {
+	handle runtime.Cleanup
+}
+
+// WithZeroizeOnCleanup registers a cleanup, with runtime.AddCleanup, that
+// zeroes the value of each share a Splitter produces once no copy of the
+// Share is reachable any more. It is a backstop for applications that
+// forget to wipe shares, not a replacement for wiping them: the garbage
+// collector decides when, and whether, the cleanup runs.
+//
+// The cleanup follows the Share, not its Value: a Value slice kept after
+// every Share holding it has been dropped may be zeroed while still in use.
+// Splitter.Release cancels the cleanup of the shares it recycles.
+func WithZeroizeOnCleanup() Option {
+	return func(c *Config) {
+		c.ZeroizeOnCleanup = true
+	}
+}
+
+// ZeroizeOnCleanup registers the cleanup of WithZeroizeOnCleanup for
+// shares obtained elsewhere, such as decoded from storage. Shares that
+// already have one are left unchanged.
+func ZeroizeOnCleanup(shares []Share) {
+	for i := range shares {
+		if shares[i].cleanup == nil && len(shares[i].Value) > 0 {
+			attachCleanup(&shares[i])
+		}
+	}
+}
+
+func attachCleanup(s *Share) {
+	c := &valueCleanup{}
+	c.handle = runtime.AddCleanup(c, wipeValue, s.Value)
+	s.cleanup = c
+}
+
+// stopCleanup cancels the share's cleanup, for buffers that are recycled.
+func stopCleanup(s *Share) {
+	if s.cleanup != nil {
+		s.cleanup.handle.Stop()
+		s.cleanup = nil
+	}
+}
+
+func wipeValue(value []byte) {
+	clear(value)
+}
@@ -0,0 +1,83 @@
+package goshamir
+
+import (
+	"bytes"
+	"runtime"
+	"testing"
+	"time"
+)
+
+// --- WithZeroizeOnCleanup Tests ---
+
+// waitZeroed collects garbage until value is all zeros or a deadline
+// passes.
+func waitZeroed(t *testing.T, value []byte) {
+	t.Helper()
+	deadline := time.Now().Add(5 * time.Second)
+	for time.Now().Before(deadline) {
+		runtime.GC()
+		if bytes.Count(value, []byte{0}) == len(value) {
+			return
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	t.Fatal("share value was not zeroed after the share became unreachable")
+}
+
+func TestWithZeroizeOnCleanup_WipesUnreachableShares(t *testing.T) {
+	splitter, err := NewSplitter(3, 2, WithZeroizeOnCleanup())
+	if err != nil {
+		t.Fatalf("NewSplitter failed: %v", err)
+	}
+	shares, err := splitter.Split([]byte("forgotten"))
+	if err != nil {
+		t.Fatalf("Split failed: %v", err)
+	}
+	secret, err := Combine(shares[:2], 2)
+	if err != nil || string(secret) != "forgotten" {
+		t.Fatalf("Combine failed: %q, %v", secret, err)
+	}
+	value := shares[0].Value
+	shares = nil
+	waitZeroed(t, value)
+}
+
+func TestWithZeroizeOnCleanup_CopiesKeepValueAlive(t *testing.T) {
+	splitter, _ := NewSplitter(3, 2, WithZeroizeOnCleanup())
+	shares, _ := splitter.Split([]byte("kept"))
+	kept := shares[1]
+	want := bytes.Clone(kept.Value)
+	shares = nil
+	for range 3 {
+		runtime.GC()
+		time.Sleep(10 * time.Millisecond)
+	}
+	if !bytes.Equal(kept.Value, want) {
+		t.Fatal("value zeroed while a copy of the share was reachable")
+	}
+	runtime.KeepAlive(kept)
+}
+
+func TestWithZeroizeOnCleanup_ReleaseStopsCleanup(t *testing.T) {
+	splitter, _ := NewSplitter(3, 2, WithZeroizeOnCleanup(), WithBufferPool(true))
+	shares, _ := splitter.Split([]byte("pooled"))
+	splitter.Release(shares)
+	for i, s := range shares {
+		if s.cleanup != nil {
+			t.Fatalf("share %d still has a cleanup after Release", i)
+		}
+	}
+}
+
+func TestZeroizeOnCleanup_DecodedShares(t *testing.T) {
+	shares, _ := Split([]byte("decoded"), 3, 2)
+	encoded, _ := EncodeSharesToHex(shares)
+	decoded, err := DecodeSharesFromHex(encoded)
+	if err != nil {
+		t.Fatalf("DecodeSharesFromHex failed: %v", err)
+	}
+	ZeroizeOnCleanup(decoded)
+	value := decoded[2].Value
+	decoded = nil
+	waitZeroed(t, value)
+}